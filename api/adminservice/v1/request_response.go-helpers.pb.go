@@ -3297,3 +3297,1039 @@ func (this *ForceUnloadTaskQueuePartitionResponse) Equal(that interface{}) bool
 
 	return proto.Equal(this, that1)
 }
+
+// Marshal an object of type MoveTaskQueueTasksRequest to the protobuf v3 wire format
+func (val *MoveTaskQueueTasksRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type MoveTaskQueueTasksRequest from the protobuf v3 wire format
+func (val *MoveTaskQueueTasksRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *MoveTaskQueueTasksRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two MoveTaskQueueTasksRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *MoveTaskQueueTasksRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *MoveTaskQueueTasksRequest
+	switch t := that.(type) {
+	case *MoveTaskQueueTasksRequest:
+		that1 = t
+	case MoveTaskQueueTasksRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type MoveTaskQueueTasksResponse to the protobuf v3 wire format
+func (val *MoveTaskQueueTasksResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type MoveTaskQueueTasksResponse from the protobuf v3 wire format
+func (val *MoveTaskQueueTasksResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *MoveTaskQueueTasksResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two MoveTaskQueueTasksResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *MoveTaskQueueTasksResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *MoveTaskQueueTasksResponse
+	switch t := that.(type) {
+	case *MoveTaskQueueTasksResponse:
+		that1 = t
+	case MoveTaskQueueTasksResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type UpdateWorkerDispatchLimitsRequest to the protobuf v3 wire format
+func (val *UpdateWorkerDispatchLimitsRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type UpdateWorkerDispatchLimitsRequest from the protobuf v3 wire format
+func (val *UpdateWorkerDispatchLimitsRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *UpdateWorkerDispatchLimitsRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two UpdateWorkerDispatchLimitsRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *UpdateWorkerDispatchLimitsRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *UpdateWorkerDispatchLimitsRequest
+	switch t := that.(type) {
+	case *UpdateWorkerDispatchLimitsRequest:
+		that1 = t
+	case UpdateWorkerDispatchLimitsRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type UpdateWorkerDispatchLimitsResponse to the protobuf v3 wire format
+func (val *UpdateWorkerDispatchLimitsResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type UpdateWorkerDispatchLimitsResponse from the protobuf v3 wire format
+func (val *UpdateWorkerDispatchLimitsResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *UpdateWorkerDispatchLimitsResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two UpdateWorkerDispatchLimitsResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *UpdateWorkerDispatchLimitsResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *UpdateWorkerDispatchLimitsResponse
+	switch t := that.(type) {
+	case *UpdateWorkerDispatchLimitsResponse:
+		that1 = t
+	case UpdateWorkerDispatchLimitsResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type DescribeWorkerDispatchLimitsRequest to the protobuf v3 wire format
+func (val *DescribeWorkerDispatchLimitsRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type DescribeWorkerDispatchLimitsRequest from the protobuf v3 wire format
+func (val *DescribeWorkerDispatchLimitsRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *DescribeWorkerDispatchLimitsRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two DescribeWorkerDispatchLimitsRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *DescribeWorkerDispatchLimitsRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *DescribeWorkerDispatchLimitsRequest
+	switch t := that.(type) {
+	case *DescribeWorkerDispatchLimitsRequest:
+		that1 = t
+	case DescribeWorkerDispatchLimitsRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type DescribeWorkerDispatchLimitsResponse to the protobuf v3 wire format
+func (val *DescribeWorkerDispatchLimitsResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type DescribeWorkerDispatchLimitsResponse from the protobuf v3 wire format
+func (val *DescribeWorkerDispatchLimitsResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *DescribeWorkerDispatchLimitsResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two DescribeWorkerDispatchLimitsResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *DescribeWorkerDispatchLimitsResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *DescribeWorkerDispatchLimitsResponse
+	switch t := that.(type) {
+	case *DescribeWorkerDispatchLimitsResponse:
+		that1 = t
+	case DescribeWorkerDispatchLimitsResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ChangeSearchAttributeTypeRequest to the protobuf v3 wire format
+func (val *ChangeSearchAttributeTypeRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ChangeSearchAttributeTypeRequest from the protobuf v3 wire format
+func (val *ChangeSearchAttributeTypeRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ChangeSearchAttributeTypeRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ChangeSearchAttributeTypeRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ChangeSearchAttributeTypeRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ChangeSearchAttributeTypeRequest
+	switch t := that.(type) {
+	case *ChangeSearchAttributeTypeRequest:
+		that1 = t
+	case ChangeSearchAttributeTypeRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ChangeSearchAttributeTypeResponse to the protobuf v3 wire format
+func (val *ChangeSearchAttributeTypeResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ChangeSearchAttributeTypeResponse from the protobuf v3 wire format
+func (val *ChangeSearchAttributeTypeResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ChangeSearchAttributeTypeResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ChangeSearchAttributeTypeResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ChangeSearchAttributeTypeResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ChangeSearchAttributeTypeResponse
+	switch t := that.(type) {
+	case *ChangeSearchAttributeTypeResponse:
+		that1 = t
+	case ChangeSearchAttributeTypeResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type DescribeSearchAttributeTypeChangeRequest to the protobuf v3 wire format
+func (val *DescribeSearchAttributeTypeChangeRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type DescribeSearchAttributeTypeChangeRequest from the protobuf v3 wire format
+func (val *DescribeSearchAttributeTypeChangeRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *DescribeSearchAttributeTypeChangeRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two DescribeSearchAttributeTypeChangeRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *DescribeSearchAttributeTypeChangeRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *DescribeSearchAttributeTypeChangeRequest
+	switch t := that.(type) {
+	case *DescribeSearchAttributeTypeChangeRequest:
+		that1 = t
+	case DescribeSearchAttributeTypeChangeRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type DescribeSearchAttributeTypeChangeResponse to the protobuf v3 wire format
+func (val *DescribeSearchAttributeTypeChangeResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type DescribeSearchAttributeTypeChangeResponse from the protobuf v3 wire format
+func (val *DescribeSearchAttributeTypeChangeResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *DescribeSearchAttributeTypeChangeResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two DescribeSearchAttributeTypeChangeResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *DescribeSearchAttributeTypeChangeResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *DescribeSearchAttributeTypeChangeResponse
+	switch t := that.(type) {
+	case *DescribeSearchAttributeTypeChangeResponse:
+		that1 = t
+	case DescribeSearchAttributeTypeChangeResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type RebuildVisibilityRequest to the protobuf v3 wire format
+func (val *RebuildVisibilityRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type RebuildVisibilityRequest from the protobuf v3 wire format
+func (val *RebuildVisibilityRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *RebuildVisibilityRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two RebuildVisibilityRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *RebuildVisibilityRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *RebuildVisibilityRequest
+	switch t := that.(type) {
+	case *RebuildVisibilityRequest:
+		that1 = t
+	case RebuildVisibilityRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type RebuildVisibilityResponse to the protobuf v3 wire format
+func (val *RebuildVisibilityResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type RebuildVisibilityResponse from the protobuf v3 wire format
+func (val *RebuildVisibilityResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *RebuildVisibilityResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two RebuildVisibilityResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *RebuildVisibilityResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *RebuildVisibilityResponse
+	switch t := that.(type) {
+	case *RebuildVisibilityResponse:
+		that1 = t
+	case RebuildVisibilityResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type DescribeVisibilityRebuildRequest to the protobuf v3 wire format
+func (val *DescribeVisibilityRebuildRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type DescribeVisibilityRebuildRequest from the protobuf v3 wire format
+func (val *DescribeVisibilityRebuildRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *DescribeVisibilityRebuildRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two DescribeVisibilityRebuildRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *DescribeVisibilityRebuildRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *DescribeVisibilityRebuildRequest
+	switch t := that.(type) {
+	case *DescribeVisibilityRebuildRequest:
+		that1 = t
+	case DescribeVisibilityRebuildRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type DescribeVisibilityRebuildResponse to the protobuf v3 wire format
+func (val *DescribeVisibilityRebuildResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type DescribeVisibilityRebuildResponse from the protobuf v3 wire format
+func (val *DescribeVisibilityRebuildResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *DescribeVisibilityRebuildResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two DescribeVisibilityRebuildResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *DescribeVisibilityRebuildResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *DescribeVisibilityRebuildResponse
+	switch t := that.(type) {
+	case *DescribeVisibilityRebuildResponse:
+		that1 = t
+	case DescribeVisibilityRebuildResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ExplainVisibilityQueryRequest to the protobuf v3 wire format
+func (val *ExplainVisibilityQueryRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ExplainVisibilityQueryRequest from the protobuf v3 wire format
+func (val *ExplainVisibilityQueryRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ExplainVisibilityQueryRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ExplainVisibilityQueryRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ExplainVisibilityQueryRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ExplainVisibilityQueryRequest
+	switch t := that.(type) {
+	case *ExplainVisibilityQueryRequest:
+		that1 = t
+	case ExplainVisibilityQueryRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ExplainVisibilityQueryResponse to the protobuf v3 wire format
+func (val *ExplainVisibilityQueryResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ExplainVisibilityQueryResponse from the protobuf v3 wire format
+func (val *ExplainVisibilityQueryResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ExplainVisibilityQueryResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ExplainVisibilityQueryResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ExplainVisibilityQueryResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ExplainVisibilityQueryResponse
+	switch t := that.(type) {
+	case *ExplainVisibilityQueryResponse:
+		that1 = t
+	case ExplainVisibilityQueryResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type AdvanceNamespaceClockRequest to the protobuf v3 wire format
+func (val *AdvanceNamespaceClockRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type AdvanceNamespaceClockRequest from the protobuf v3 wire format
+func (val *AdvanceNamespaceClockRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *AdvanceNamespaceClockRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two AdvanceNamespaceClockRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *AdvanceNamespaceClockRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *AdvanceNamespaceClockRequest
+	switch t := that.(type) {
+	case *AdvanceNamespaceClockRequest:
+		that1 = t
+	case AdvanceNamespaceClockRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type AdvanceNamespaceClockResponse to the protobuf v3 wire format
+func (val *AdvanceNamespaceClockResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type AdvanceNamespaceClockResponse from the protobuf v3 wire format
+func (val *AdvanceNamespaceClockResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *AdvanceNamespaceClockResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two AdvanceNamespaceClockResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *AdvanceNamespaceClockResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *AdvanceNamespaceClockResponse
+	switch t := that.(type) {
+	case *AdvanceNamespaceClockResponse:
+		that1 = t
+	case AdvanceNamespaceClockResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type PauseWorkflowExecutionRequest to the protobuf v3 wire format
+func (val *PauseWorkflowExecutionRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type PauseWorkflowExecutionRequest from the protobuf v3 wire format
+func (val *PauseWorkflowExecutionRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *PauseWorkflowExecutionRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two PauseWorkflowExecutionRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *PauseWorkflowExecutionRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *PauseWorkflowExecutionRequest
+	switch t := that.(type) {
+	case *PauseWorkflowExecutionRequest:
+		that1 = t
+	case PauseWorkflowExecutionRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type PauseWorkflowExecutionResponse to the protobuf v3 wire format
+func (val *PauseWorkflowExecutionResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type PauseWorkflowExecutionResponse from the protobuf v3 wire format
+func (val *PauseWorkflowExecutionResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *PauseWorkflowExecutionResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two PauseWorkflowExecutionResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *PauseWorkflowExecutionResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *PauseWorkflowExecutionResponse
+	switch t := that.(type) {
+	case *PauseWorkflowExecutionResponse:
+		that1 = t
+	case PauseWorkflowExecutionResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type UnpauseWorkflowExecutionRequest to the protobuf v3 wire format
+func (val *UnpauseWorkflowExecutionRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type UnpauseWorkflowExecutionRequest from the protobuf v3 wire format
+func (val *UnpauseWorkflowExecutionRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *UnpauseWorkflowExecutionRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two UnpauseWorkflowExecutionRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *UnpauseWorkflowExecutionRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *UnpauseWorkflowExecutionRequest
+	switch t := that.(type) {
+	case *UnpauseWorkflowExecutionRequest:
+		that1 = t
+	case UnpauseWorkflowExecutionRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type UnpauseWorkflowExecutionResponse to the protobuf v3 wire format
+func (val *UnpauseWorkflowExecutionResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type UnpauseWorkflowExecutionResponse from the protobuf v3 wire format
+func (val *UnpauseWorkflowExecutionResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *UnpauseWorkflowExecutionResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two UnpauseWorkflowExecutionResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *UnpauseWorkflowExecutionResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *UnpauseWorkflowExecutionResponse
+	switch t := that.(type) {
+	case *UnpauseWorkflowExecutionResponse:
+		that1 = t
+	case UnpauseWorkflowExecutionResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type BatchPauseWorkflowExecutionsRequest to the protobuf v3 wire format
+func (val *BatchPauseWorkflowExecutionsRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type BatchPauseWorkflowExecutionsRequest from the protobuf v3 wire format
+func (val *BatchPauseWorkflowExecutionsRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *BatchPauseWorkflowExecutionsRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two BatchPauseWorkflowExecutionsRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *BatchPauseWorkflowExecutionsRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *BatchPauseWorkflowExecutionsRequest
+	switch t := that.(type) {
+	case *BatchPauseWorkflowExecutionsRequest:
+		that1 = t
+	case BatchPauseWorkflowExecutionsRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type BatchPauseWorkflowExecutionsResponse to the protobuf v3 wire format
+func (val *BatchPauseWorkflowExecutionsResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type BatchPauseWorkflowExecutionsResponse from the protobuf v3 wire format
+func (val *BatchPauseWorkflowExecutionsResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *BatchPauseWorkflowExecutionsResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two BatchPauseWorkflowExecutionsResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *BatchPauseWorkflowExecutionsResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *BatchPauseWorkflowExecutionsResponse
+	switch t := that.(type) {
+	case *BatchPauseWorkflowExecutionsResponse:
+		that1 = t
+	case BatchPauseWorkflowExecutionsResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ForkWorkflowExecutionRequest to the protobuf v3 wire format
+func (val *ForkWorkflowExecutionRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ForkWorkflowExecutionRequest from the protobuf v3 wire format
+func (val *ForkWorkflowExecutionRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ForkWorkflowExecutionRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ForkWorkflowExecutionRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ForkWorkflowExecutionRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ForkWorkflowExecutionRequest
+	switch t := that.(type) {
+	case *ForkWorkflowExecutionRequest:
+		that1 = t
+	case ForkWorkflowExecutionRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ForkWorkflowExecutionResponse to the protobuf v3 wire format
+func (val *ForkWorkflowExecutionResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ForkWorkflowExecutionResponse from the protobuf v3 wire format
+func (val *ForkWorkflowExecutionResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ForkWorkflowExecutionResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ForkWorkflowExecutionResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ForkWorkflowExecutionResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ForkWorkflowExecutionResponse
+	switch t := that.(type) {
+	case *ForkWorkflowExecutionResponse:
+		that1 = t
+	case ForkWorkflowExecutionResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type PreviewResetWorkflowExecutionRequest to the protobuf v3 wire format
+func (val *PreviewResetWorkflowExecutionRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type PreviewResetWorkflowExecutionRequest from the protobuf v3 wire format
+func (val *PreviewResetWorkflowExecutionRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *PreviewResetWorkflowExecutionRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two PreviewResetWorkflowExecutionRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *PreviewResetWorkflowExecutionRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *PreviewResetWorkflowExecutionRequest
+	switch t := that.(type) {
+	case *PreviewResetWorkflowExecutionRequest:
+		that1 = t
+	case PreviewResetWorkflowExecutionRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type PreviewResetWorkflowExecutionResponse to the protobuf v3 wire format
+func (val *PreviewResetWorkflowExecutionResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type PreviewResetWorkflowExecutionResponse from the protobuf v3 wire format
+func (val *PreviewResetWorkflowExecutionResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *PreviewResetWorkflowExecutionResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two PreviewResetWorkflowExecutionResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *PreviewResetWorkflowExecutionResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *PreviewResetWorkflowExecutionResponse
+	switch t := that.(type) {
+	case *PreviewResetWorkflowExecutionResponse:
+		that1 = t
+	case PreviewResetWorkflowExecutionResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
@@ -63,6 +63,20 @@ const (
 	AdminService_GenerateLastHistoryReplicationTasks_FullMethodName = "/temporal.server.api.adminservice.v1.AdminService/GenerateLastHistoryReplicationTasks"
 	AdminService_DescribeTaskQueuePartition_FullMethodName          = "/temporal.server.api.adminservice.v1.AdminService/DescribeTaskQueuePartition"
 	AdminService_ForceUnloadTaskQueuePartition_FullMethodName       = "/temporal.server.api.adminservice.v1.AdminService/ForceUnloadTaskQueuePartition"
+	AdminService_MoveTaskQueueTasks_FullMethodName                  = "/temporal.server.api.adminservice.v1.AdminService/MoveTaskQueueTasks"
+	AdminService_UpdateWorkerDispatchLimits_FullMethodName          = "/temporal.server.api.adminservice.v1.AdminService/UpdateWorkerDispatchLimits"
+	AdminService_DescribeWorkerDispatchLimits_FullMethodName        = "/temporal.server.api.adminservice.v1.AdminService/DescribeWorkerDispatchLimits"
+	AdminService_ChangeSearchAttributeType_FullMethodName           = "/temporal.server.api.adminservice.v1.AdminService/ChangeSearchAttributeType"
+	AdminService_DescribeSearchAttributeTypeChange_FullMethodName   = "/temporal.server.api.adminservice.v1.AdminService/DescribeSearchAttributeTypeChange"
+	AdminService_RebuildVisibility_FullMethodName                   = "/temporal.server.api.adminservice.v1.AdminService/RebuildVisibility"
+	AdminService_DescribeVisibilityRebuild_FullMethodName           = "/temporal.server.api.adminservice.v1.AdminService/DescribeVisibilityRebuild"
+	AdminService_ExplainVisibilityQuery_FullMethodName              = "/temporal.server.api.adminservice.v1.AdminService/ExplainVisibilityQuery"
+	AdminService_AdvanceNamespaceClock_FullMethodName               = "/temporal.server.api.adminservice.v1.AdminService/AdvanceNamespaceClock"
+	AdminService_PauseWorkflowExecution_FullMethodName              = "/temporal.server.api.adminservice.v1.AdminService/PauseWorkflowExecution"
+	AdminService_UnpauseWorkflowExecution_FullMethodName            = "/temporal.server.api.adminservice.v1.AdminService/UnpauseWorkflowExecution"
+	AdminService_BatchPauseWorkflowExecutions_FullMethodName        = "/temporal.server.api.adminservice.v1.AdminService/BatchPauseWorkflowExecutions"
+	AdminService_ForkWorkflowExecution_FullMethodName               = "/temporal.server.api.adminservice.v1.AdminService/ForkWorkflowExecution"
+	AdminService_PreviewResetWorkflowExecution_FullMethodName       = "/temporal.server.api.adminservice.v1.AdminService/PreviewResetWorkflowExecution"
 )
 
 // AdminServiceClient is the client API for AdminService service.
@@ -153,6 +167,50 @@ type AdminServiceClient interface {
 	GenerateLastHistoryReplicationTasks(ctx context.Context, in *GenerateLastHistoryReplicationTasksRequest, opts ...grpc.CallOption) (*GenerateLastHistoryReplicationTasksResponse, error)
 	DescribeTaskQueuePartition(ctx context.Context, in *DescribeTaskQueuePartitionRequest, opts ...grpc.CallOption) (*DescribeTaskQueuePartitionResponse, error)
 	ForceUnloadTaskQueuePartition(ctx context.Context, in *ForceUnloadTaskQueuePartitionRequest, opts ...grpc.CallOption) (*ForceUnloadTaskQueuePartitionResponse, error)
+	// MoveTaskQueueTasks moves, or copies, the persisted backlog tasks selected by the filters from a task queue
+	// partition to another task queue, e.g. after a task queue name was misconfigured or a worker fleet was retired.
+	// Moves one page of the backlog per call.
+	MoveTaskQueueTasks(ctx context.Context, in *MoveTaskQueueTasksRequest, opts ...grpc.CallOption) (*MoveTaskQueueTasksResponse, error)
+	// UpdateWorkerDispatchLimits sets the max tasks per second and max in-flight tasks dispatched to the pollers of
+	// each worker identity and worker build ID of a task queue type. The limits are stored in the task queue user
+	// data and replace the ones in dynamic config.
+	UpdateWorkerDispatchLimits(ctx context.Context, in *UpdateWorkerDispatchLimitsRequest, opts ...grpc.CallOption) (*UpdateWorkerDispatchLimitsResponse, error)
+	// DescribeWorkerDispatchLimits returns the worker dispatch limits stored in the user data of a task queue type.
+	DescribeWorkerDispatchLimits(ctx context.Context, in *DescribeWorkerDispatchLimitsRequest, opts ...grpc.CallOption) (*DescribeWorkerDispatchLimitsResponse, error)
+	// ChangeSearchAttributeType starts changing the type of a custom search attribute of a namespace without losing
+	// its values. The change is done by a system workflow, one change per namespace can run at a time.
+	// It belongs to the OperatorService, whose protos are defined in go.temporal.io/api.
+	ChangeSearchAttributeType(ctx context.Context, in *ChangeSearchAttributeTypeRequest, opts ...grpc.CallOption) (*ChangeSearchAttributeTypeResponse, error)
+	// DescribeSearchAttributeTypeChange returns the progress of the last search attribute type change of a namespace.
+	DescribeSearchAttributeTypeChange(ctx context.Context, in *DescribeSearchAttributeTypeChangeRequest, opts ...grpc.CallOption) (*DescribeSearchAttributeTypeChangeResponse, error)
+	// RebuildVisibility starts rebuilding the visibility records of a namespace from the mutable state of its
+	// executions. The rebuild is done by a system workflow, one rebuild per namespace can run at a time.
+	RebuildVisibility(ctx context.Context, in *RebuildVisibilityRequest, opts ...grpc.CallOption) (*RebuildVisibilityResponse, error)
+	// DescribeVisibilityRebuild returns the progress of the last visibility rebuild of a namespace.
+	DescribeVisibilityRebuild(ctx context.Context, in *DescribeVisibilityRebuildRequest, opts ...grpc.CallOption) (*DescribeVisibilityRebuildResponse, error)
+	// ExplainVisibilityQuery returns the query of the visibility store for a list filter of a namespace, without
+	// running it. It belongs to the OperatorService too, whose protos are defined in go.temporal.io/api.
+	ExplainVisibilityQuery(ctx context.Context, in *ExplainVisibilityQueryRequest, opts ...grpc.CallOption) (*ExplainVisibilityQueryResponse, error)
+	// AdvanceNamespaceClock moves the virtual clock of a test namespace forward. The namespace must have the virtual
+	// clock enabled in dynamic config. Timers already scheduled keep their fire time until the tasks of their workflow
+	// are refreshed (RefreshWorkflowTasks) once the history service loaded the new offset. The change isn't replicated
+	// to other clusters.
+	AdvanceNamespaceClock(ctx context.Context, in *AdvanceNamespaceClockRequest, opts ...grpc.CallOption) (*AdvanceNamespaceClockResponse, error)
+	// PauseWorkflowExecution pauses a running workflow execution. While the workflow is paused, workflow tasks,
+	// activities and timers aren't dispatched, and signals and updates are buffered until it's unpaused.
+	// The WorkflowService API, which would also record pause events in the history, is defined in go.temporal.io/api.
+	PauseWorkflowExecution(ctx context.Context, in *PauseWorkflowExecutionRequest, opts ...grpc.CallOption) (*PauseWorkflowExecutionResponse, error)
+	// UnpauseWorkflowExecution resumes a paused workflow execution.
+	UnpauseWorkflowExecution(ctx context.Context, in *UnpauseWorkflowExecutionRequest, opts ...grpc.CallOption) (*UnpauseWorkflowExecutionResponse, error)
+	// BatchPauseWorkflowExecutions starts a batch operation pausing, or unpausing, the selected workflow executions.
+	BatchPauseWorkflowExecutions(ctx context.Context, in *BatchPauseWorkflowExecutionsRequest, opts ...grpc.CallOption) (*BatchPauseWorkflowExecutionsResponse, error)
+	// ForkWorkflowExecution creates a new workflow from the history of a workflow up to a given event, e.g. to debug
+	// or replay a workflow from the middle of its history. The source workflow isn't changed.
+	ForkWorkflowExecution(ctx context.Context, in *ForkWorkflowExecutionRequest, opts ...grpc.CallOption) (*ForkWorkflowExecutionResponse, error)
+	// PreviewResetWorkflowExecution is a dry run of ResetWorkflowExecution: it returns the events which would be
+	// reapplied or dropped, the pending activities and child workflows which would be abandoned, and the mutable state
+	// the new run would be created with. The workflow isn't changed.
+	PreviewResetWorkflowExecution(ctx context.Context, in *PreviewResetWorkflowExecutionRequest, opts ...grpc.CallOption) (*PreviewResetWorkflowExecutionResponse, error)
 }
 
 type adminServiceClient struct {
@@ -572,6 +630,132 @@ func (c *adminServiceClient) ForceUnloadTaskQueuePartition(ctx context.Context,
 	return out, nil
 }
 
+func (c *adminServiceClient) MoveTaskQueueTasks(ctx context.Context, in *MoveTaskQueueTasksRequest, opts ...grpc.CallOption) (*MoveTaskQueueTasksResponse, error) {
+	out := new(MoveTaskQueueTasksResponse)
+	err := c.cc.Invoke(ctx, AdminService_MoveTaskQueueTasks_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) UpdateWorkerDispatchLimits(ctx context.Context, in *UpdateWorkerDispatchLimitsRequest, opts ...grpc.CallOption) (*UpdateWorkerDispatchLimitsResponse, error) {
+	out := new(UpdateWorkerDispatchLimitsResponse)
+	err := c.cc.Invoke(ctx, AdminService_UpdateWorkerDispatchLimits_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) DescribeWorkerDispatchLimits(ctx context.Context, in *DescribeWorkerDispatchLimitsRequest, opts ...grpc.CallOption) (*DescribeWorkerDispatchLimitsResponse, error) {
+	out := new(DescribeWorkerDispatchLimitsResponse)
+	err := c.cc.Invoke(ctx, AdminService_DescribeWorkerDispatchLimits_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ChangeSearchAttributeType(ctx context.Context, in *ChangeSearchAttributeTypeRequest, opts ...grpc.CallOption) (*ChangeSearchAttributeTypeResponse, error) {
+	out := new(ChangeSearchAttributeTypeResponse)
+	err := c.cc.Invoke(ctx, AdminService_ChangeSearchAttributeType_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) DescribeSearchAttributeTypeChange(ctx context.Context, in *DescribeSearchAttributeTypeChangeRequest, opts ...grpc.CallOption) (*DescribeSearchAttributeTypeChangeResponse, error) {
+	out := new(DescribeSearchAttributeTypeChangeResponse)
+	err := c.cc.Invoke(ctx, AdminService_DescribeSearchAttributeTypeChange_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) RebuildVisibility(ctx context.Context, in *RebuildVisibilityRequest, opts ...grpc.CallOption) (*RebuildVisibilityResponse, error) {
+	out := new(RebuildVisibilityResponse)
+	err := c.cc.Invoke(ctx, AdminService_RebuildVisibility_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) DescribeVisibilityRebuild(ctx context.Context, in *DescribeVisibilityRebuildRequest, opts ...grpc.CallOption) (*DescribeVisibilityRebuildResponse, error) {
+	out := new(DescribeVisibilityRebuildResponse)
+	err := c.cc.Invoke(ctx, AdminService_DescribeVisibilityRebuild_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ExplainVisibilityQuery(ctx context.Context, in *ExplainVisibilityQueryRequest, opts ...grpc.CallOption) (*ExplainVisibilityQueryResponse, error) {
+	out := new(ExplainVisibilityQueryResponse)
+	err := c.cc.Invoke(ctx, AdminService_ExplainVisibilityQuery_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) AdvanceNamespaceClock(ctx context.Context, in *AdvanceNamespaceClockRequest, opts ...grpc.CallOption) (*AdvanceNamespaceClockResponse, error) {
+	out := new(AdvanceNamespaceClockResponse)
+	err := c.cc.Invoke(ctx, AdminService_AdvanceNamespaceClock_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) PauseWorkflowExecution(ctx context.Context, in *PauseWorkflowExecutionRequest, opts ...grpc.CallOption) (*PauseWorkflowExecutionResponse, error) {
+	out := new(PauseWorkflowExecutionResponse)
+	err := c.cc.Invoke(ctx, AdminService_PauseWorkflowExecution_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) UnpauseWorkflowExecution(ctx context.Context, in *UnpauseWorkflowExecutionRequest, opts ...grpc.CallOption) (*UnpauseWorkflowExecutionResponse, error) {
+	out := new(UnpauseWorkflowExecutionResponse)
+	err := c.cc.Invoke(ctx, AdminService_UnpauseWorkflowExecution_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) BatchPauseWorkflowExecutions(ctx context.Context, in *BatchPauseWorkflowExecutionsRequest, opts ...grpc.CallOption) (*BatchPauseWorkflowExecutionsResponse, error) {
+	out := new(BatchPauseWorkflowExecutionsResponse)
+	err := c.cc.Invoke(ctx, AdminService_BatchPauseWorkflowExecutions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ForkWorkflowExecution(ctx context.Context, in *ForkWorkflowExecutionRequest, opts ...grpc.CallOption) (*ForkWorkflowExecutionResponse, error) {
+	out := new(ForkWorkflowExecutionResponse)
+	err := c.cc.Invoke(ctx, AdminService_ForkWorkflowExecution_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) PreviewResetWorkflowExecution(ctx context.Context, in *PreviewResetWorkflowExecutionRequest, opts ...grpc.CallOption) (*PreviewResetWorkflowExecutionResponse, error) {
+	out := new(PreviewResetWorkflowExecutionResponse)
+	err := c.cc.Invoke(ctx, AdminService_PreviewResetWorkflowExecution_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AdminServiceServer is the server API for AdminService service.
 // All implementations must embed UnimplementedAdminServiceServer
 // for forward compatibility
@@ -660,6 +844,50 @@ type AdminServiceServer interface {
 	GenerateLastHistoryReplicationTasks(context.Context, *GenerateLastHistoryReplicationTasksRequest) (*GenerateLastHistoryReplicationTasksResponse, error)
 	DescribeTaskQueuePartition(context.Context, *DescribeTaskQueuePartitionRequest) (*DescribeTaskQueuePartitionResponse, error)
 	ForceUnloadTaskQueuePartition(context.Context, *ForceUnloadTaskQueuePartitionRequest) (*ForceUnloadTaskQueuePartitionResponse, error)
+	// MoveTaskQueueTasks moves, or copies, the persisted backlog tasks selected by the filters from a task queue
+	// partition to another task queue, e.g. after a task queue name was misconfigured or a worker fleet was retired.
+	// Moves one page of the backlog per call.
+	MoveTaskQueueTasks(context.Context, *MoveTaskQueueTasksRequest) (*MoveTaskQueueTasksResponse, error)
+	// UpdateWorkerDispatchLimits sets the max tasks per second and max in-flight tasks dispatched to the pollers of
+	// each worker identity and worker build ID of a task queue type. The limits are stored in the task queue user
+	// data and replace the ones in dynamic config.
+	UpdateWorkerDispatchLimits(context.Context, *UpdateWorkerDispatchLimitsRequest) (*UpdateWorkerDispatchLimitsResponse, error)
+	// DescribeWorkerDispatchLimits returns the worker dispatch limits stored in the user data of a task queue type.
+	DescribeWorkerDispatchLimits(context.Context, *DescribeWorkerDispatchLimitsRequest) (*DescribeWorkerDispatchLimitsResponse, error)
+	// ChangeSearchAttributeType starts changing the type of a custom search attribute of a namespace without losing
+	// its values. The change is done by a system workflow, one change per namespace can run at a time.
+	// It belongs to the OperatorService, whose protos are defined in go.temporal.io/api.
+	ChangeSearchAttributeType(context.Context, *ChangeSearchAttributeTypeRequest) (*ChangeSearchAttributeTypeResponse, error)
+	// DescribeSearchAttributeTypeChange returns the progress of the last search attribute type change of a namespace.
+	DescribeSearchAttributeTypeChange(context.Context, *DescribeSearchAttributeTypeChangeRequest) (*DescribeSearchAttributeTypeChangeResponse, error)
+	// RebuildVisibility starts rebuilding the visibility records of a namespace from the mutable state of its
+	// executions. The rebuild is done by a system workflow, one rebuild per namespace can run at a time.
+	RebuildVisibility(context.Context, *RebuildVisibilityRequest) (*RebuildVisibilityResponse, error)
+	// DescribeVisibilityRebuild returns the progress of the last visibility rebuild of a namespace.
+	DescribeVisibilityRebuild(context.Context, *DescribeVisibilityRebuildRequest) (*DescribeVisibilityRebuildResponse, error)
+	// ExplainVisibilityQuery returns the query of the visibility store for a list filter of a namespace, without
+	// running it. It belongs to the OperatorService too, whose protos are defined in go.temporal.io/api.
+	ExplainVisibilityQuery(context.Context, *ExplainVisibilityQueryRequest) (*ExplainVisibilityQueryResponse, error)
+	// AdvanceNamespaceClock moves the virtual clock of a test namespace forward. The namespace must have the virtual
+	// clock enabled in dynamic config. Timers already scheduled keep their fire time until the tasks of their workflow
+	// are refreshed (RefreshWorkflowTasks) once the history service loaded the new offset. The change isn't replicated
+	// to other clusters.
+	AdvanceNamespaceClock(context.Context, *AdvanceNamespaceClockRequest) (*AdvanceNamespaceClockResponse, error)
+	// PauseWorkflowExecution pauses a running workflow execution. While the workflow is paused, workflow tasks,
+	// activities and timers aren't dispatched, and signals and updates are buffered until it's unpaused.
+	// The WorkflowService API, which would also record pause events in the history, is defined in go.temporal.io/api.
+	PauseWorkflowExecution(context.Context, *PauseWorkflowExecutionRequest) (*PauseWorkflowExecutionResponse, error)
+	// UnpauseWorkflowExecution resumes a paused workflow execution.
+	UnpauseWorkflowExecution(context.Context, *UnpauseWorkflowExecutionRequest) (*UnpauseWorkflowExecutionResponse, error)
+	// BatchPauseWorkflowExecutions starts a batch operation pausing, or unpausing, the selected workflow executions.
+	BatchPauseWorkflowExecutions(context.Context, *BatchPauseWorkflowExecutionsRequest) (*BatchPauseWorkflowExecutionsResponse, error)
+	// ForkWorkflowExecution creates a new workflow from the history of a workflow up to a given event, e.g. to debug
+	// or replay a workflow from the middle of its history. The source workflow isn't changed.
+	ForkWorkflowExecution(context.Context, *ForkWorkflowExecutionRequest) (*ForkWorkflowExecutionResponse, error)
+	// PreviewResetWorkflowExecution is a dry run of ResetWorkflowExecution: it returns the events which would be
+	// reapplied or dropped, the pending activities and child workflows which would be abandoned, and the mutable state
+	// the new run would be created with. The workflow isn't changed.
+	PreviewResetWorkflowExecution(context.Context, *PreviewResetWorkflowExecutionRequest) (*PreviewResetWorkflowExecutionResponse, error)
 	mustEmbedUnimplementedAdminServiceServer()
 }
 
@@ -796,6 +1024,48 @@ func (UnimplementedAdminServiceServer) DescribeTaskQueuePartition(context.Contex
 func (UnimplementedAdminServiceServer) ForceUnloadTaskQueuePartition(context.Context, *ForceUnloadTaskQueuePartitionRequest) (*ForceUnloadTaskQueuePartitionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ForceUnloadTaskQueuePartition not implemented")
 }
+func (UnimplementedAdminServiceServer) MoveTaskQueueTasks(context.Context, *MoveTaskQueueTasksRequest) (*MoveTaskQueueTasksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MoveTaskQueueTasks not implemented")
+}
+func (UnimplementedAdminServiceServer) UpdateWorkerDispatchLimits(context.Context, *UpdateWorkerDispatchLimitsRequest) (*UpdateWorkerDispatchLimitsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateWorkerDispatchLimits not implemented")
+}
+func (UnimplementedAdminServiceServer) DescribeWorkerDispatchLimits(context.Context, *DescribeWorkerDispatchLimitsRequest) (*DescribeWorkerDispatchLimitsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DescribeWorkerDispatchLimits not implemented")
+}
+func (UnimplementedAdminServiceServer) ChangeSearchAttributeType(context.Context, *ChangeSearchAttributeTypeRequest) (*ChangeSearchAttributeTypeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangeSearchAttributeType not implemented")
+}
+func (UnimplementedAdminServiceServer) DescribeSearchAttributeTypeChange(context.Context, *DescribeSearchAttributeTypeChangeRequest) (*DescribeSearchAttributeTypeChangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DescribeSearchAttributeTypeChange not implemented")
+}
+func (UnimplementedAdminServiceServer) RebuildVisibility(context.Context, *RebuildVisibilityRequest) (*RebuildVisibilityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RebuildVisibility not implemented")
+}
+func (UnimplementedAdminServiceServer) DescribeVisibilityRebuild(context.Context, *DescribeVisibilityRebuildRequest) (*DescribeVisibilityRebuildResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DescribeVisibilityRebuild not implemented")
+}
+func (UnimplementedAdminServiceServer) ExplainVisibilityQuery(context.Context, *ExplainVisibilityQueryRequest) (*ExplainVisibilityQueryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExplainVisibilityQuery not implemented")
+}
+func (UnimplementedAdminServiceServer) AdvanceNamespaceClock(context.Context, *AdvanceNamespaceClockRequest) (*AdvanceNamespaceClockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdvanceNamespaceClock not implemented")
+}
+func (UnimplementedAdminServiceServer) PauseWorkflowExecution(context.Context, *PauseWorkflowExecutionRequest) (*PauseWorkflowExecutionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseWorkflowExecution not implemented")
+}
+func (UnimplementedAdminServiceServer) UnpauseWorkflowExecution(context.Context, *UnpauseWorkflowExecutionRequest) (*UnpauseWorkflowExecutionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnpauseWorkflowExecution not implemented")
+}
+func (UnimplementedAdminServiceServer) BatchPauseWorkflowExecutions(context.Context, *BatchPauseWorkflowExecutionsRequest) (*BatchPauseWorkflowExecutionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchPauseWorkflowExecutions not implemented")
+}
+func (UnimplementedAdminServiceServer) ForkWorkflowExecution(context.Context, *ForkWorkflowExecutionRequest) (*ForkWorkflowExecutionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ForkWorkflowExecution not implemented")
+}
+func (UnimplementedAdminServiceServer) PreviewResetWorkflowExecution(context.Context, *PreviewResetWorkflowExecutionRequest) (*PreviewResetWorkflowExecutionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PreviewResetWorkflowExecution not implemented")
+}
 func (UnimplementedAdminServiceServer) mustEmbedUnimplementedAdminServiceServer() {}
 
 // UnsafeAdminServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -1591,6 +1861,258 @@ func _AdminService_ForceUnloadTaskQueuePartition_Handler(srv interface{}, ctx co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AdminService_MoveTaskQueueTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MoveTaskQueueTasksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).MoveTaskQueueTasks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_MoveTaskQueueTasks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).MoveTaskQueueTasks(ctx, req.(*MoveTaskQueueTasksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_UpdateWorkerDispatchLimits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateWorkerDispatchLimitsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).UpdateWorkerDispatchLimits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_UpdateWorkerDispatchLimits_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).UpdateWorkerDispatchLimits(ctx, req.(*UpdateWorkerDispatchLimitsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_DescribeWorkerDispatchLimits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeWorkerDispatchLimitsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).DescribeWorkerDispatchLimits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_DescribeWorkerDispatchLimits_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).DescribeWorkerDispatchLimits(ctx, req.(*DescribeWorkerDispatchLimitsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ChangeSearchAttributeType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangeSearchAttributeTypeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ChangeSearchAttributeType(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ChangeSearchAttributeType_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ChangeSearchAttributeType(ctx, req.(*ChangeSearchAttributeTypeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_DescribeSearchAttributeTypeChange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeSearchAttributeTypeChangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).DescribeSearchAttributeTypeChange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_DescribeSearchAttributeTypeChange_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).DescribeSearchAttributeTypeChange(ctx, req.(*DescribeSearchAttributeTypeChangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_RebuildVisibility_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RebuildVisibilityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).RebuildVisibility(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_RebuildVisibility_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).RebuildVisibility(ctx, req.(*RebuildVisibilityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_DescribeVisibilityRebuild_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeVisibilityRebuildRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).DescribeVisibilityRebuild(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_DescribeVisibilityRebuild_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).DescribeVisibilityRebuild(ctx, req.(*DescribeVisibilityRebuildRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ExplainVisibilityQuery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExplainVisibilityQueryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ExplainVisibilityQuery(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ExplainVisibilityQuery_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ExplainVisibilityQuery(ctx, req.(*ExplainVisibilityQueryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_AdvanceNamespaceClock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdvanceNamespaceClockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).AdvanceNamespaceClock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_AdvanceNamespaceClock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).AdvanceNamespaceClock(ctx, req.(*AdvanceNamespaceClockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_PauseWorkflowExecution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PauseWorkflowExecutionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).PauseWorkflowExecution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_PauseWorkflowExecution_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).PauseWorkflowExecution(ctx, req.(*PauseWorkflowExecutionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_UnpauseWorkflowExecution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnpauseWorkflowExecutionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).UnpauseWorkflowExecution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_UnpauseWorkflowExecution_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).UnpauseWorkflowExecution(ctx, req.(*UnpauseWorkflowExecutionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_BatchPauseWorkflowExecutions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchPauseWorkflowExecutionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).BatchPauseWorkflowExecutions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_BatchPauseWorkflowExecutions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).BatchPauseWorkflowExecutions(ctx, req.(*BatchPauseWorkflowExecutionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ForkWorkflowExecution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ForkWorkflowExecutionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ForkWorkflowExecution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ForkWorkflowExecution_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ForkWorkflowExecution(ctx, req.(*ForkWorkflowExecutionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_PreviewResetWorkflowExecution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PreviewResetWorkflowExecutionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).PreviewResetWorkflowExecution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_PreviewResetWorkflowExecution_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).PreviewResetWorkflowExecution(ctx, req.(*PreviewResetWorkflowExecutionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // AdminService_ServiceDesc is the grpc.ServiceDesc for AdminService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1766,6 +2288,62 @@ var AdminService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ForceUnloadTaskQueuePartition",
 			Handler:    _AdminService_ForceUnloadTaskQueuePartition_Handler,
 		},
+		{
+			MethodName: "MoveTaskQueueTasks",
+			Handler:    _AdminService_MoveTaskQueueTasks_Handler,
+		},
+		{
+			MethodName: "UpdateWorkerDispatchLimits",
+			Handler:    _AdminService_UpdateWorkerDispatchLimits_Handler,
+		},
+		{
+			MethodName: "DescribeWorkerDispatchLimits",
+			Handler:    _AdminService_DescribeWorkerDispatchLimits_Handler,
+		},
+		{
+			MethodName: "ChangeSearchAttributeType",
+			Handler:    _AdminService_ChangeSearchAttributeType_Handler,
+		},
+		{
+			MethodName: "DescribeSearchAttributeTypeChange",
+			Handler:    _AdminService_DescribeSearchAttributeTypeChange_Handler,
+		},
+		{
+			MethodName: "RebuildVisibility",
+			Handler:    _AdminService_RebuildVisibility_Handler,
+		},
+		{
+			MethodName: "DescribeVisibilityRebuild",
+			Handler:    _AdminService_DescribeVisibilityRebuild_Handler,
+		},
+		{
+			MethodName: "ExplainVisibilityQuery",
+			Handler:    _AdminService_ExplainVisibilityQuery_Handler,
+		},
+		{
+			MethodName: "AdvanceNamespaceClock",
+			Handler:    _AdminService_AdvanceNamespaceClock_Handler,
+		},
+		{
+			MethodName: "PauseWorkflowExecution",
+			Handler:    _AdminService_PauseWorkflowExecution_Handler,
+		},
+		{
+			MethodName: "UnpauseWorkflowExecution",
+			Handler:    _AdminService_UnpauseWorkflowExecution_Handler,
+		},
+		{
+			MethodName: "BatchPauseWorkflowExecutions",
+			Handler:    _AdminService_BatchPauseWorkflowExecutions_Handler,
+		},
+		{
+			MethodName: "ForkWorkflowExecution",
+			Handler:    _AdminService_ForkWorkflowExecution_Handler,
+		},
+		{
+			MethodName: "PreviewResetWorkflowExecution",
+			Handler:    _AdminService_PreviewResetWorkflowExecution_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -103,6 +103,46 @@ func (mr *MockAdminServiceClientMockRecorder) AddTasks(ctx, in any, opts ...any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddTasks", reflect.TypeOf((*MockAdminServiceClient)(nil).AddTasks), varargs...)
 }
 
+// AdvanceNamespaceClock mocks base method.
+func (m *MockAdminServiceClient) AdvanceNamespaceClock(ctx context.Context, in *adminservice.AdvanceNamespaceClockRequest, opts ...grpc.CallOption) (*adminservice.AdvanceNamespaceClockResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AdvanceNamespaceClock", varargs...)
+	ret0, _ := ret[0].(*adminservice.AdvanceNamespaceClockResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AdvanceNamespaceClock indicates an expected call of AdvanceNamespaceClock.
+func (mr *MockAdminServiceClientMockRecorder) AdvanceNamespaceClock(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AdvanceNamespaceClock", reflect.TypeOf((*MockAdminServiceClient)(nil).AdvanceNamespaceClock), varargs...)
+}
+
+// BatchPauseWorkflowExecutions mocks base method.
+func (m *MockAdminServiceClient) BatchPauseWorkflowExecutions(ctx context.Context, in *adminservice.BatchPauseWorkflowExecutionsRequest, opts ...grpc.CallOption) (*adminservice.BatchPauseWorkflowExecutionsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "BatchPauseWorkflowExecutions", varargs...)
+	ret0, _ := ret[0].(*adminservice.BatchPauseWorkflowExecutionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BatchPauseWorkflowExecutions indicates an expected call of BatchPauseWorkflowExecutions.
+func (mr *MockAdminServiceClientMockRecorder) BatchPauseWorkflowExecutions(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchPauseWorkflowExecutions", reflect.TypeOf((*MockAdminServiceClient)(nil).BatchPauseWorkflowExecutions), varargs...)
+}
+
 // CancelDLQJob mocks base method.
 func (m *MockAdminServiceClient) CancelDLQJob(ctx context.Context, in *adminservice.CancelDLQJobRequest, opts ...grpc.CallOption) (*adminservice.CancelDLQJobResponse, error) {
 	m.ctrl.T.Helper()
@@ -123,6 +163,26 @@ func (mr *MockAdminServiceClientMockRecorder) CancelDLQJob(ctx, in any, opts ...
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelDLQJob", reflect.TypeOf((*MockAdminServiceClient)(nil).CancelDLQJob), varargs...)
 }
 
+// ChangeSearchAttributeType mocks base method.
+func (m *MockAdminServiceClient) ChangeSearchAttributeType(ctx context.Context, in *adminservice.ChangeSearchAttributeTypeRequest, opts ...grpc.CallOption) (*adminservice.ChangeSearchAttributeTypeResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ChangeSearchAttributeType", varargs...)
+	ret0, _ := ret[0].(*adminservice.ChangeSearchAttributeTypeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ChangeSearchAttributeType indicates an expected call of ChangeSearchAttributeType.
+func (mr *MockAdminServiceClientMockRecorder) ChangeSearchAttributeType(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangeSearchAttributeType", reflect.TypeOf((*MockAdminServiceClient)(nil).ChangeSearchAttributeType), varargs...)
+}
+
 // CloseShard mocks base method.
 func (m *MockAdminServiceClient) CloseShard(ctx context.Context, in *adminservice.CloseShardRequest, opts ...grpc.CallOption) (*adminservice.CloseShardResponse, error) {
 	m.ctrl.T.Helper()
@@ -263,6 +323,26 @@ func (mr *MockAdminServiceClientMockRecorder) DescribeMutableState(ctx, in any,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeMutableState", reflect.TypeOf((*MockAdminServiceClient)(nil).DescribeMutableState), varargs...)
 }
 
+// DescribeSearchAttributeTypeChange mocks base method.
+func (m *MockAdminServiceClient) DescribeSearchAttributeTypeChange(ctx context.Context, in *adminservice.DescribeSearchAttributeTypeChangeRequest, opts ...grpc.CallOption) (*adminservice.DescribeSearchAttributeTypeChangeResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeSearchAttributeTypeChange", varargs...)
+	ret0, _ := ret[0].(*adminservice.DescribeSearchAttributeTypeChangeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeSearchAttributeTypeChange indicates an expected call of DescribeSearchAttributeTypeChange.
+func (mr *MockAdminServiceClientMockRecorder) DescribeSearchAttributeTypeChange(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeSearchAttributeTypeChange", reflect.TypeOf((*MockAdminServiceClient)(nil).DescribeSearchAttributeTypeChange), varargs...)
+}
+
 // DescribeTaskQueuePartition mocks base method.
 func (m *MockAdminServiceClient) DescribeTaskQueuePartition(ctx context.Context, in *adminservice.DescribeTaskQueuePartitionRequest, opts ...grpc.CallOption) (*adminservice.DescribeTaskQueuePartitionResponse, error) {
 	m.ctrl.T.Helper()
@@ -283,6 +363,66 @@ func (mr *MockAdminServiceClientMockRecorder) DescribeTaskQueuePartition(ctx, in
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeTaskQueuePartition", reflect.TypeOf((*MockAdminServiceClient)(nil).DescribeTaskQueuePartition), varargs...)
 }
 
+// DescribeVisibilityRebuild mocks base method.
+func (m *MockAdminServiceClient) DescribeVisibilityRebuild(ctx context.Context, in *adminservice.DescribeVisibilityRebuildRequest, opts ...grpc.CallOption) (*adminservice.DescribeVisibilityRebuildResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeVisibilityRebuild", varargs...)
+	ret0, _ := ret[0].(*adminservice.DescribeVisibilityRebuildResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeVisibilityRebuild indicates an expected call of DescribeVisibilityRebuild.
+func (mr *MockAdminServiceClientMockRecorder) DescribeVisibilityRebuild(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeVisibilityRebuild", reflect.TypeOf((*MockAdminServiceClient)(nil).DescribeVisibilityRebuild), varargs...)
+}
+
+// DescribeWorkerDispatchLimits mocks base method.
+func (m *MockAdminServiceClient) DescribeWorkerDispatchLimits(ctx context.Context, in *adminservice.DescribeWorkerDispatchLimitsRequest, opts ...grpc.CallOption) (*adminservice.DescribeWorkerDispatchLimitsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeWorkerDispatchLimits", varargs...)
+	ret0, _ := ret[0].(*adminservice.DescribeWorkerDispatchLimitsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeWorkerDispatchLimits indicates an expected call of DescribeWorkerDispatchLimits.
+func (mr *MockAdminServiceClientMockRecorder) DescribeWorkerDispatchLimits(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeWorkerDispatchLimits", reflect.TypeOf((*MockAdminServiceClient)(nil).DescribeWorkerDispatchLimits), varargs...)
+}
+
+// ExplainVisibilityQuery mocks base method.
+func (m *MockAdminServiceClient) ExplainVisibilityQuery(ctx context.Context, in *adminservice.ExplainVisibilityQueryRequest, opts ...grpc.CallOption) (*adminservice.ExplainVisibilityQueryResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ExplainVisibilityQuery", varargs...)
+	ret0, _ := ret[0].(*adminservice.ExplainVisibilityQueryResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExplainVisibilityQuery indicates an expected call of ExplainVisibilityQuery.
+func (mr *MockAdminServiceClientMockRecorder) ExplainVisibilityQuery(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExplainVisibilityQuery", reflect.TypeOf((*MockAdminServiceClient)(nil).ExplainVisibilityQuery), varargs...)
+}
+
 // ForceUnloadTaskQueuePartition mocks base method.
 func (m *MockAdminServiceClient) ForceUnloadTaskQueuePartition(ctx context.Context, in *adminservice.ForceUnloadTaskQueuePartitionRequest, opts ...grpc.CallOption) (*adminservice.ForceUnloadTaskQueuePartitionResponse, error) {
 	m.ctrl.T.Helper()
@@ -303,6 +443,26 @@ func (mr *MockAdminServiceClientMockRecorder) ForceUnloadTaskQueuePartition(ctx,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ForceUnloadTaskQueuePartition", reflect.TypeOf((*MockAdminServiceClient)(nil).ForceUnloadTaskQueuePartition), varargs...)
 }
 
+// ForkWorkflowExecution mocks base method.
+func (m *MockAdminServiceClient) ForkWorkflowExecution(ctx context.Context, in *adminservice.ForkWorkflowExecutionRequest, opts ...grpc.CallOption) (*adminservice.ForkWorkflowExecutionResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ForkWorkflowExecution", varargs...)
+	ret0, _ := ret[0].(*adminservice.ForkWorkflowExecutionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ForkWorkflowExecution indicates an expected call of ForkWorkflowExecution.
+func (mr *MockAdminServiceClientMockRecorder) ForkWorkflowExecution(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ForkWorkflowExecution", reflect.TypeOf((*MockAdminServiceClient)(nil).ForkWorkflowExecution), varargs...)
+}
+
 // GenerateLastHistoryReplicationTasks mocks base method.
 func (m *MockAdminServiceClient) GenerateLastHistoryReplicationTasks(ctx context.Context, in *adminservice.GenerateLastHistoryReplicationTasksRequest, opts ...grpc.CallOption) (*adminservice.GenerateLastHistoryReplicationTasksResponse, error) {
 	m.ctrl.T.Helper()
@@ -683,6 +843,66 @@ func (mr *MockAdminServiceClientMockRecorder) MergeDLQTasks(ctx, in any, opts ..
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MergeDLQTasks", reflect.TypeOf((*MockAdminServiceClient)(nil).MergeDLQTasks), varargs...)
 }
 
+// MoveTaskQueueTasks mocks base method.
+func (m *MockAdminServiceClient) MoveTaskQueueTasks(ctx context.Context, in *adminservice.MoveTaskQueueTasksRequest, opts ...grpc.CallOption) (*adminservice.MoveTaskQueueTasksResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "MoveTaskQueueTasks", varargs...)
+	ret0, _ := ret[0].(*adminservice.MoveTaskQueueTasksResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MoveTaskQueueTasks indicates an expected call of MoveTaskQueueTasks.
+func (mr *MockAdminServiceClientMockRecorder) MoveTaskQueueTasks(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveTaskQueueTasks", reflect.TypeOf((*MockAdminServiceClient)(nil).MoveTaskQueueTasks), varargs...)
+}
+
+// PauseWorkflowExecution mocks base method.
+func (m *MockAdminServiceClient) PauseWorkflowExecution(ctx context.Context, in *adminservice.PauseWorkflowExecutionRequest, opts ...grpc.CallOption) (*adminservice.PauseWorkflowExecutionResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PauseWorkflowExecution", varargs...)
+	ret0, _ := ret[0].(*adminservice.PauseWorkflowExecutionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PauseWorkflowExecution indicates an expected call of PauseWorkflowExecution.
+func (mr *MockAdminServiceClientMockRecorder) PauseWorkflowExecution(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PauseWorkflowExecution", reflect.TypeOf((*MockAdminServiceClient)(nil).PauseWorkflowExecution), varargs...)
+}
+
+// PreviewResetWorkflowExecution mocks base method.
+func (m *MockAdminServiceClient) PreviewResetWorkflowExecution(ctx context.Context, in *adminservice.PreviewResetWorkflowExecutionRequest, opts ...grpc.CallOption) (*adminservice.PreviewResetWorkflowExecutionResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PreviewResetWorkflowExecution", varargs...)
+	ret0, _ := ret[0].(*adminservice.PreviewResetWorkflowExecutionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PreviewResetWorkflowExecution indicates an expected call of PreviewResetWorkflowExecution.
+func (mr *MockAdminServiceClientMockRecorder) PreviewResetWorkflowExecution(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PreviewResetWorkflowExecution", reflect.TypeOf((*MockAdminServiceClient)(nil).PreviewResetWorkflowExecution), varargs...)
+}
+
 // PurgeDLQMessages mocks base method.
 func (m *MockAdminServiceClient) PurgeDLQMessages(ctx context.Context, in *adminservice.PurgeDLQMessagesRequest, opts ...grpc.CallOption) (*adminservice.PurgeDLQMessagesResponse, error) {
 	m.ctrl.T.Helper()
@@ -763,6 +983,26 @@ func (mr *MockAdminServiceClientMockRecorder) RebuildMutableState(ctx, in any, o
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RebuildMutableState", reflect.TypeOf((*MockAdminServiceClient)(nil).RebuildMutableState), varargs...)
 }
 
+// RebuildVisibility mocks base method.
+func (m *MockAdminServiceClient) RebuildVisibility(ctx context.Context, in *adminservice.RebuildVisibilityRequest, opts ...grpc.CallOption) (*adminservice.RebuildVisibilityResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RebuildVisibility", varargs...)
+	ret0, _ := ret[0].(*adminservice.RebuildVisibilityResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RebuildVisibility indicates an expected call of RebuildVisibility.
+func (mr *MockAdminServiceClientMockRecorder) RebuildVisibility(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RebuildVisibility", reflect.TypeOf((*MockAdminServiceClient)(nil).RebuildVisibility), varargs...)
+}
+
 // RefreshWorkflowTasks mocks base method.
 func (m *MockAdminServiceClient) RefreshWorkflowTasks(ctx context.Context, in *adminservice.RefreshWorkflowTasksRequest, opts ...grpc.CallOption) (*adminservice.RefreshWorkflowTasksResponse, error) {
 	m.ctrl.T.Helper()
@@ -903,6 +1143,46 @@ func (mr *MockAdminServiceClientMockRecorder) SyncWorkflowState(ctx, in any, opt
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SyncWorkflowState", reflect.TypeOf((*MockAdminServiceClient)(nil).SyncWorkflowState), varargs...)
 }
 
+// UnpauseWorkflowExecution mocks base method.
+func (m *MockAdminServiceClient) UnpauseWorkflowExecution(ctx context.Context, in *adminservice.UnpauseWorkflowExecutionRequest, opts ...grpc.CallOption) (*adminservice.UnpauseWorkflowExecutionResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UnpauseWorkflowExecution", varargs...)
+	ret0, _ := ret[0].(*adminservice.UnpauseWorkflowExecutionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UnpauseWorkflowExecution indicates an expected call of UnpauseWorkflowExecution.
+func (mr *MockAdminServiceClientMockRecorder) UnpauseWorkflowExecution(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnpauseWorkflowExecution", reflect.TypeOf((*MockAdminServiceClient)(nil).UnpauseWorkflowExecution), varargs...)
+}
+
+// UpdateWorkerDispatchLimits mocks base method.
+func (m *MockAdminServiceClient) UpdateWorkerDispatchLimits(ctx context.Context, in *adminservice.UpdateWorkerDispatchLimitsRequest, opts ...grpc.CallOption) (*adminservice.UpdateWorkerDispatchLimitsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateWorkerDispatchLimits", varargs...)
+	ret0, _ := ret[0].(*adminservice.UpdateWorkerDispatchLimitsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateWorkerDispatchLimits indicates an expected call of UpdateWorkerDispatchLimits.
+func (mr *MockAdminServiceClientMockRecorder) UpdateWorkerDispatchLimits(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWorkerDispatchLimits", reflect.TypeOf((*MockAdminServiceClient)(nil).UpdateWorkerDispatchLimits), varargs...)
+}
+
 // MockAdminService_StreamWorkflowReplicationMessagesClient is a mock of AdminService_StreamWorkflowReplicationMessagesClient interface.
 type MockAdminService_StreamWorkflowReplicationMessagesClient struct {
 	ctrl     *gomock.Controller
@@ -1110,6 +1390,36 @@ func (mr *MockAdminServiceServerMockRecorder) AddTasks(arg0, arg1 any) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddTasks", reflect.TypeOf((*MockAdminServiceServer)(nil).AddTasks), arg0, arg1)
 }
 
+// AdvanceNamespaceClock mocks base method.
+func (m *MockAdminServiceServer) AdvanceNamespaceClock(arg0 context.Context, arg1 *adminservice.AdvanceNamespaceClockRequest) (*adminservice.AdvanceNamespaceClockResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AdvanceNamespaceClock", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.AdvanceNamespaceClockResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AdvanceNamespaceClock indicates an expected call of AdvanceNamespaceClock.
+func (mr *MockAdminServiceServerMockRecorder) AdvanceNamespaceClock(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AdvanceNamespaceClock", reflect.TypeOf((*MockAdminServiceServer)(nil).AdvanceNamespaceClock), arg0, arg1)
+}
+
+// BatchPauseWorkflowExecutions mocks base method.
+func (m *MockAdminServiceServer) BatchPauseWorkflowExecutions(arg0 context.Context, arg1 *adminservice.BatchPauseWorkflowExecutionsRequest) (*adminservice.BatchPauseWorkflowExecutionsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BatchPauseWorkflowExecutions", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.BatchPauseWorkflowExecutionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BatchPauseWorkflowExecutions indicates an expected call of BatchPauseWorkflowExecutions.
+func (mr *MockAdminServiceServerMockRecorder) BatchPauseWorkflowExecutions(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchPauseWorkflowExecutions", reflect.TypeOf((*MockAdminServiceServer)(nil).BatchPauseWorkflowExecutions), arg0, arg1)
+}
+
 // CancelDLQJob mocks base method.
 func (m *MockAdminServiceServer) CancelDLQJob(arg0 context.Context, arg1 *adminservice.CancelDLQJobRequest) (*adminservice.CancelDLQJobResponse, error) {
 	m.ctrl.T.Helper()
@@ -1125,6 +1435,21 @@ func (mr *MockAdminServiceServerMockRecorder) CancelDLQJob(arg0, arg1 any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelDLQJob", reflect.TypeOf((*MockAdminServiceServer)(nil).CancelDLQJob), arg0, arg1)
 }
 
+// ChangeSearchAttributeType mocks base method.
+func (m *MockAdminServiceServer) ChangeSearchAttributeType(arg0 context.Context, arg1 *adminservice.ChangeSearchAttributeTypeRequest) (*adminservice.ChangeSearchAttributeTypeResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChangeSearchAttributeType", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.ChangeSearchAttributeTypeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ChangeSearchAttributeType indicates an expected call of ChangeSearchAttributeType.
+func (mr *MockAdminServiceServerMockRecorder) ChangeSearchAttributeType(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangeSearchAttributeType", reflect.TypeOf((*MockAdminServiceServer)(nil).ChangeSearchAttributeType), arg0, arg1)
+}
+
 // CloseShard mocks base method.
 func (m *MockAdminServiceServer) CloseShard(arg0 context.Context, arg1 *adminservice.CloseShardRequest) (*adminservice.CloseShardResponse, error) {
 	m.ctrl.T.Helper()
@@ -1230,6 +1555,21 @@ func (mr *MockAdminServiceServerMockRecorder) DescribeMutableState(arg0, arg1 an
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeMutableState", reflect.TypeOf((*MockAdminServiceServer)(nil).DescribeMutableState), arg0, arg1)
 }
 
+// DescribeSearchAttributeTypeChange mocks base method.
+func (m *MockAdminServiceServer) DescribeSearchAttributeTypeChange(arg0 context.Context, arg1 *adminservice.DescribeSearchAttributeTypeChangeRequest) (*adminservice.DescribeSearchAttributeTypeChangeResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeSearchAttributeTypeChange", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.DescribeSearchAttributeTypeChangeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeSearchAttributeTypeChange indicates an expected call of DescribeSearchAttributeTypeChange.
+func (mr *MockAdminServiceServerMockRecorder) DescribeSearchAttributeTypeChange(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeSearchAttributeTypeChange", reflect.TypeOf((*MockAdminServiceServer)(nil).DescribeSearchAttributeTypeChange), arg0, arg1)
+}
+
 // DescribeTaskQueuePartition mocks base method.
 func (m *MockAdminServiceServer) DescribeTaskQueuePartition(arg0 context.Context, arg1 *adminservice.DescribeTaskQueuePartitionRequest) (*adminservice.DescribeTaskQueuePartitionResponse, error) {
 	m.ctrl.T.Helper()
@@ -1245,6 +1585,51 @@ func (mr *MockAdminServiceServerMockRecorder) DescribeTaskQueuePartition(arg0, a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeTaskQueuePartition", reflect.TypeOf((*MockAdminServiceServer)(nil).DescribeTaskQueuePartition), arg0, arg1)
 }
 
+// DescribeVisibilityRebuild mocks base method.
+func (m *MockAdminServiceServer) DescribeVisibilityRebuild(arg0 context.Context, arg1 *adminservice.DescribeVisibilityRebuildRequest) (*adminservice.DescribeVisibilityRebuildResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeVisibilityRebuild", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.DescribeVisibilityRebuildResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeVisibilityRebuild indicates an expected call of DescribeVisibilityRebuild.
+func (mr *MockAdminServiceServerMockRecorder) DescribeVisibilityRebuild(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeVisibilityRebuild", reflect.TypeOf((*MockAdminServiceServer)(nil).DescribeVisibilityRebuild), arg0, arg1)
+}
+
+// DescribeWorkerDispatchLimits mocks base method.
+func (m *MockAdminServiceServer) DescribeWorkerDispatchLimits(arg0 context.Context, arg1 *adminservice.DescribeWorkerDispatchLimitsRequest) (*adminservice.DescribeWorkerDispatchLimitsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeWorkerDispatchLimits", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.DescribeWorkerDispatchLimitsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeWorkerDispatchLimits indicates an expected call of DescribeWorkerDispatchLimits.
+func (mr *MockAdminServiceServerMockRecorder) DescribeWorkerDispatchLimits(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeWorkerDispatchLimits", reflect.TypeOf((*MockAdminServiceServer)(nil).DescribeWorkerDispatchLimits), arg0, arg1)
+}
+
+// ExplainVisibilityQuery mocks base method.
+func (m *MockAdminServiceServer) ExplainVisibilityQuery(arg0 context.Context, arg1 *adminservice.ExplainVisibilityQueryRequest) (*adminservice.ExplainVisibilityQueryResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExplainVisibilityQuery", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.ExplainVisibilityQueryResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExplainVisibilityQuery indicates an expected call of ExplainVisibilityQuery.
+func (mr *MockAdminServiceServerMockRecorder) ExplainVisibilityQuery(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExplainVisibilityQuery", reflect.TypeOf((*MockAdminServiceServer)(nil).ExplainVisibilityQuery), arg0, arg1)
+}
+
 // ForceUnloadTaskQueuePartition mocks base method.
 func (m *MockAdminServiceServer) ForceUnloadTaskQueuePartition(arg0 context.Context, arg1 *adminservice.ForceUnloadTaskQueuePartitionRequest) (*adminservice.ForceUnloadTaskQueuePartitionResponse, error) {
 	m.ctrl.T.Helper()
@@ -1260,6 +1645,21 @@ func (mr *MockAdminServiceServerMockRecorder) ForceUnloadTaskQueuePartition(arg0
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ForceUnloadTaskQueuePartition", reflect.TypeOf((*MockAdminServiceServer)(nil).ForceUnloadTaskQueuePartition), arg0, arg1)
 }
 
+// ForkWorkflowExecution mocks base method.
+func (m *MockAdminServiceServer) ForkWorkflowExecution(arg0 context.Context, arg1 *adminservice.ForkWorkflowExecutionRequest) (*adminservice.ForkWorkflowExecutionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ForkWorkflowExecution", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.ForkWorkflowExecutionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ForkWorkflowExecution indicates an expected call of ForkWorkflowExecution.
+func (mr *MockAdminServiceServerMockRecorder) ForkWorkflowExecution(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ForkWorkflowExecution", reflect.TypeOf((*MockAdminServiceServer)(nil).ForkWorkflowExecution), arg0, arg1)
+}
+
 // GenerateLastHistoryReplicationTasks mocks base method.
 func (m *MockAdminServiceServer) GenerateLastHistoryReplicationTasks(arg0 context.Context, arg1 *adminservice.GenerateLastHistoryReplicationTasksRequest) (*adminservice.GenerateLastHistoryReplicationTasksResponse, error) {
 	m.ctrl.T.Helper()
@@ -1545,6 +1945,51 @@ func (mr *MockAdminServiceServerMockRecorder) MergeDLQTasks(arg0, arg1 any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MergeDLQTasks", reflect.TypeOf((*MockAdminServiceServer)(nil).MergeDLQTasks), arg0, arg1)
 }
 
+// MoveTaskQueueTasks mocks base method.
+func (m *MockAdminServiceServer) MoveTaskQueueTasks(arg0 context.Context, arg1 *adminservice.MoveTaskQueueTasksRequest) (*adminservice.MoveTaskQueueTasksResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MoveTaskQueueTasks", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.MoveTaskQueueTasksResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MoveTaskQueueTasks indicates an expected call of MoveTaskQueueTasks.
+func (mr *MockAdminServiceServerMockRecorder) MoveTaskQueueTasks(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveTaskQueueTasks", reflect.TypeOf((*MockAdminServiceServer)(nil).MoveTaskQueueTasks), arg0, arg1)
+}
+
+// PauseWorkflowExecution mocks base method.
+func (m *MockAdminServiceServer) PauseWorkflowExecution(arg0 context.Context, arg1 *adminservice.PauseWorkflowExecutionRequest) (*adminservice.PauseWorkflowExecutionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PauseWorkflowExecution", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.PauseWorkflowExecutionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PauseWorkflowExecution indicates an expected call of PauseWorkflowExecution.
+func (mr *MockAdminServiceServerMockRecorder) PauseWorkflowExecution(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PauseWorkflowExecution", reflect.TypeOf((*MockAdminServiceServer)(nil).PauseWorkflowExecution), arg0, arg1)
+}
+
+// PreviewResetWorkflowExecution mocks base method.
+func (m *MockAdminServiceServer) PreviewResetWorkflowExecution(arg0 context.Context, arg1 *adminservice.PreviewResetWorkflowExecutionRequest) (*adminservice.PreviewResetWorkflowExecutionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PreviewResetWorkflowExecution", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.PreviewResetWorkflowExecutionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PreviewResetWorkflowExecution indicates an expected call of PreviewResetWorkflowExecution.
+func (mr *MockAdminServiceServerMockRecorder) PreviewResetWorkflowExecution(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PreviewResetWorkflowExecution", reflect.TypeOf((*MockAdminServiceServer)(nil).PreviewResetWorkflowExecution), arg0, arg1)
+}
+
 // PurgeDLQMessages mocks base method.
 func (m *MockAdminServiceServer) PurgeDLQMessages(arg0 context.Context, arg1 *adminservice.PurgeDLQMessagesRequest) (*adminservice.PurgeDLQMessagesResponse, error) {
 	m.ctrl.T.Helper()
@@ -1605,6 +2050,21 @@ func (mr *MockAdminServiceServerMockRecorder) RebuildMutableState(arg0, arg1 any
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RebuildMutableState", reflect.TypeOf((*MockAdminServiceServer)(nil).RebuildMutableState), arg0, arg1)
 }
 
+// RebuildVisibility mocks base method.
+func (m *MockAdminServiceServer) RebuildVisibility(arg0 context.Context, arg1 *adminservice.RebuildVisibilityRequest) (*adminservice.RebuildVisibilityResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RebuildVisibility", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.RebuildVisibilityResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RebuildVisibility indicates an expected call of RebuildVisibility.
+func (mr *MockAdminServiceServerMockRecorder) RebuildVisibility(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RebuildVisibility", reflect.TypeOf((*MockAdminServiceServer)(nil).RebuildVisibility), arg0, arg1)
+}
+
 // RefreshWorkflowTasks mocks base method.
 func (m *MockAdminServiceServer) RefreshWorkflowTasks(arg0 context.Context, arg1 *adminservice.RefreshWorkflowTasksRequest) (*adminservice.RefreshWorkflowTasksResponse, error) {
 	m.ctrl.T.Helper()
@@ -1709,6 +2169,36 @@ func (mr *MockAdminServiceServerMockRecorder) SyncWorkflowState(arg0, arg1 any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SyncWorkflowState", reflect.TypeOf((*MockAdminServiceServer)(nil).SyncWorkflowState), arg0, arg1)
 }
 
+// UnpauseWorkflowExecution mocks base method.
+func (m *MockAdminServiceServer) UnpauseWorkflowExecution(arg0 context.Context, arg1 *adminservice.UnpauseWorkflowExecutionRequest) (*adminservice.UnpauseWorkflowExecutionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnpauseWorkflowExecution", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.UnpauseWorkflowExecutionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UnpauseWorkflowExecution indicates an expected call of UnpauseWorkflowExecution.
+func (mr *MockAdminServiceServerMockRecorder) UnpauseWorkflowExecution(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnpauseWorkflowExecution", reflect.TypeOf((*MockAdminServiceServer)(nil).UnpauseWorkflowExecution), arg0, arg1)
+}
+
+// UpdateWorkerDispatchLimits mocks base method.
+func (m *MockAdminServiceServer) UpdateWorkerDispatchLimits(arg0 context.Context, arg1 *adminservice.UpdateWorkerDispatchLimitsRequest) (*adminservice.UpdateWorkerDispatchLimitsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateWorkerDispatchLimits", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.UpdateWorkerDispatchLimitsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateWorkerDispatchLimits indicates an expected call of UpdateWorkerDispatchLimits.
+func (mr *MockAdminServiceServerMockRecorder) UpdateWorkerDispatchLimits(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWorkerDispatchLimits", reflect.TypeOf((*MockAdminServiceServer)(nil).UpdateWorkerDispatchLimits), arg0, arg1)
+}
+
 // mustEmbedUnimplementedAdminServiceServer mocks base method.
 func (m *MockAdminServiceServer) mustEmbedUnimplementedAdminServiceServer() {
 	m.ctrl.T.Helper()
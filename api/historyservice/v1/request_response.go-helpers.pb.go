@@ -5554,3 +5554,521 @@ func (this *UpdateWorkflowExecutionOptionsResponse) Equal(that interface{}) bool
 
 	return proto.Equal(this, that1)
 }
+
+// Marshal an object of type MoveSearchAttributeValueRequest to the protobuf v3 wire format
+func (val *MoveSearchAttributeValueRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type MoveSearchAttributeValueRequest from the protobuf v3 wire format
+func (val *MoveSearchAttributeValueRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *MoveSearchAttributeValueRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two MoveSearchAttributeValueRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *MoveSearchAttributeValueRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *MoveSearchAttributeValueRequest
+	switch t := that.(type) {
+	case *MoveSearchAttributeValueRequest:
+		that1 = t
+	case MoveSearchAttributeValueRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type MoveSearchAttributeValueResponse to the protobuf v3 wire format
+func (val *MoveSearchAttributeValueResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type MoveSearchAttributeValueResponse from the protobuf v3 wire format
+func (val *MoveSearchAttributeValueResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *MoveSearchAttributeValueResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two MoveSearchAttributeValueResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *MoveSearchAttributeValueResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *MoveSearchAttributeValueResponse
+	switch t := that.(type) {
+	case *MoveSearchAttributeValueResponse:
+		that1 = t
+	case MoveSearchAttributeValueResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type DeleteOrphanedCurrentExecutionRequest to the protobuf v3 wire format
+func (val *DeleteOrphanedCurrentExecutionRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type DeleteOrphanedCurrentExecutionRequest from the protobuf v3 wire format
+func (val *DeleteOrphanedCurrentExecutionRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *DeleteOrphanedCurrentExecutionRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two DeleteOrphanedCurrentExecutionRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *DeleteOrphanedCurrentExecutionRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *DeleteOrphanedCurrentExecutionRequest
+	switch t := that.(type) {
+	case *DeleteOrphanedCurrentExecutionRequest:
+		that1 = t
+	case DeleteOrphanedCurrentExecutionRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type DeleteOrphanedCurrentExecutionResponse to the protobuf v3 wire format
+func (val *DeleteOrphanedCurrentExecutionResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type DeleteOrphanedCurrentExecutionResponse from the protobuf v3 wire format
+func (val *DeleteOrphanedCurrentExecutionResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *DeleteOrphanedCurrentExecutionResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two DeleteOrphanedCurrentExecutionResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *DeleteOrphanedCurrentExecutionResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *DeleteOrphanedCurrentExecutionResponse
+	switch t := that.(type) {
+	case *DeleteOrphanedCurrentExecutionResponse:
+		that1 = t
+	case DeleteOrphanedCurrentExecutionResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type DeleteOrphanedHistoryTaskRequest to the protobuf v3 wire format
+func (val *DeleteOrphanedHistoryTaskRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type DeleteOrphanedHistoryTaskRequest from the protobuf v3 wire format
+func (val *DeleteOrphanedHistoryTaskRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *DeleteOrphanedHistoryTaskRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two DeleteOrphanedHistoryTaskRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *DeleteOrphanedHistoryTaskRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *DeleteOrphanedHistoryTaskRequest
+	switch t := that.(type) {
+	case *DeleteOrphanedHistoryTaskRequest:
+		that1 = t
+	case DeleteOrphanedHistoryTaskRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type DeleteOrphanedHistoryTaskResponse to the protobuf v3 wire format
+func (val *DeleteOrphanedHistoryTaskResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type DeleteOrphanedHistoryTaskResponse from the protobuf v3 wire format
+func (val *DeleteOrphanedHistoryTaskResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *DeleteOrphanedHistoryTaskResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two DeleteOrphanedHistoryTaskResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *DeleteOrphanedHistoryTaskResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *DeleteOrphanedHistoryTaskResponse
+	switch t := that.(type) {
+	case *DeleteOrphanedHistoryTaskResponse:
+		that1 = t
+	case DeleteOrphanedHistoryTaskResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type PauseWorkflowExecutionRequest to the protobuf v3 wire format
+func (val *PauseWorkflowExecutionRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type PauseWorkflowExecutionRequest from the protobuf v3 wire format
+func (val *PauseWorkflowExecutionRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *PauseWorkflowExecutionRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two PauseWorkflowExecutionRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *PauseWorkflowExecutionRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *PauseWorkflowExecutionRequest
+	switch t := that.(type) {
+	case *PauseWorkflowExecutionRequest:
+		that1 = t
+	case PauseWorkflowExecutionRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type PauseWorkflowExecutionResponse to the protobuf v3 wire format
+func (val *PauseWorkflowExecutionResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type PauseWorkflowExecutionResponse from the protobuf v3 wire format
+func (val *PauseWorkflowExecutionResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *PauseWorkflowExecutionResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two PauseWorkflowExecutionResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *PauseWorkflowExecutionResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *PauseWorkflowExecutionResponse
+	switch t := that.(type) {
+	case *PauseWorkflowExecutionResponse:
+		that1 = t
+	case PauseWorkflowExecutionResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type UnpauseWorkflowExecutionRequest to the protobuf v3 wire format
+func (val *UnpauseWorkflowExecutionRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type UnpauseWorkflowExecutionRequest from the protobuf v3 wire format
+func (val *UnpauseWorkflowExecutionRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *UnpauseWorkflowExecutionRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two UnpauseWorkflowExecutionRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *UnpauseWorkflowExecutionRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *UnpauseWorkflowExecutionRequest
+	switch t := that.(type) {
+	case *UnpauseWorkflowExecutionRequest:
+		that1 = t
+	case UnpauseWorkflowExecutionRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type UnpauseWorkflowExecutionResponse to the protobuf v3 wire format
+func (val *UnpauseWorkflowExecutionResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type UnpauseWorkflowExecutionResponse from the protobuf v3 wire format
+func (val *UnpauseWorkflowExecutionResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *UnpauseWorkflowExecutionResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two UnpauseWorkflowExecutionResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *UnpauseWorkflowExecutionResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *UnpauseWorkflowExecutionResponse
+	switch t := that.(type) {
+	case *UnpauseWorkflowExecutionResponse:
+		that1 = t
+	case UnpauseWorkflowExecutionResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ForkWorkflowExecutionRequest to the protobuf v3 wire format
+func (val *ForkWorkflowExecutionRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ForkWorkflowExecutionRequest from the protobuf v3 wire format
+func (val *ForkWorkflowExecutionRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ForkWorkflowExecutionRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ForkWorkflowExecutionRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ForkWorkflowExecutionRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ForkWorkflowExecutionRequest
+	switch t := that.(type) {
+	case *ForkWorkflowExecutionRequest:
+		that1 = t
+	case ForkWorkflowExecutionRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ForkWorkflowExecutionResponse to the protobuf v3 wire format
+func (val *ForkWorkflowExecutionResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ForkWorkflowExecutionResponse from the protobuf v3 wire format
+func (val *ForkWorkflowExecutionResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ForkWorkflowExecutionResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ForkWorkflowExecutionResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ForkWorkflowExecutionResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ForkWorkflowExecutionResponse
+	switch t := that.(type) {
+	case *ForkWorkflowExecutionResponse:
+		that1 = t
+	case ForkWorkflowExecutionResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type PreviewResetWorkflowExecutionRequest to the protobuf v3 wire format
+func (val *PreviewResetWorkflowExecutionRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type PreviewResetWorkflowExecutionRequest from the protobuf v3 wire format
+func (val *PreviewResetWorkflowExecutionRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *PreviewResetWorkflowExecutionRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two PreviewResetWorkflowExecutionRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *PreviewResetWorkflowExecutionRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *PreviewResetWorkflowExecutionRequest
+	switch t := that.(type) {
+	case *PreviewResetWorkflowExecutionRequest:
+		that1 = t
+	case PreviewResetWorkflowExecutionRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type PreviewResetWorkflowExecutionResponse to the protobuf v3 wire format
+func (val *PreviewResetWorkflowExecutionResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type PreviewResetWorkflowExecutionResponse from the protobuf v3 wire format
+func (val *PreviewResetWorkflowExecutionResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *PreviewResetWorkflowExecutionResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two PreviewResetWorkflowExecutionResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *PreviewResetWorkflowExecutionResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *PreviewResetWorkflowExecutionResponse
+	switch t := that.(type) {
+	case *PreviewResetWorkflowExecutionResponse:
+		that1 = t
+	case PreviewResetWorkflowExecutionResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
@@ -91,6 +91,13 @@ const (
 	HistoryService_PauseActivity_FullMethodName                          = "/temporal.server.api.historyservice.v1.HistoryService/PauseActivity"
 	HistoryService_UnpauseActivity_FullMethodName                        = "/temporal.server.api.historyservice.v1.HistoryService/UnpauseActivity"
 	HistoryService_ResetActivity_FullMethodName                          = "/temporal.server.api.historyservice.v1.HistoryService/ResetActivity"
+	HistoryService_MoveSearchAttributeValue_FullMethodName               = "/temporal.server.api.historyservice.v1.HistoryService/MoveSearchAttributeValue"
+	HistoryService_DeleteOrphanedCurrentExecution_FullMethodName         = "/temporal.server.api.historyservice.v1.HistoryService/DeleteOrphanedCurrentExecution"
+	HistoryService_DeleteOrphanedHistoryTask_FullMethodName              = "/temporal.server.api.historyservice.v1.HistoryService/DeleteOrphanedHistoryTask"
+	HistoryService_PauseWorkflowExecution_FullMethodName                 = "/temporal.server.api.historyservice.v1.HistoryService/PauseWorkflowExecution"
+	HistoryService_UnpauseWorkflowExecution_FullMethodName               = "/temporal.server.api.historyservice.v1.HistoryService/UnpauseWorkflowExecution"
+	HistoryService_ForkWorkflowExecution_FullMethodName                  = "/temporal.server.api.historyservice.v1.HistoryService/ForkWorkflowExecution"
+	HistoryService_PreviewResetWorkflowExecution_FullMethodName          = "/temporal.server.api.historyservice.v1.HistoryService/PreviewResetWorkflowExecution"
 )
 
 // HistoryServiceClient is the client API for HistoryService service.
@@ -369,6 +376,28 @@ type HistoryServiceClient interface {
 	// (-- api-linter: core::0134::method-signature=disabled
 	// (-- api-linter: core::0134::response-message-name=disabled
 	ResetActivity(ctx context.Context, in *ResetActivityRequest, opts ...grpc.CallOption) (*ResetActivityResponse, error)
+	// MoveSearchAttributeValue moves the value of a custom search attribute field in the mutable state of a running
+	// workflow execution to another field, converting it to the type of that field. It's used when a search
+	// attribute is mapped to a field of another type. The value of the target field is kept if it's already set.
+	MoveSearchAttributeValue(ctx context.Context, in *MoveSearchAttributeValueRequest, opts ...grpc.CallOption) (*MoveSearchAttributeValueResponse, error)
+	// DeleteOrphanedCurrentExecution deletes the current execution record of a workflow ID if the run it points to
+	// doesn't exist. The owner of the shard checks its ownership and the run before the record is deleted.
+	DeleteOrphanedCurrentExecution(ctx context.Context, in *DeleteOrphanedCurrentExecutionRequest, opts ...grpc.CallOption) (*DeleteOrphanedCurrentExecutionResponse, error)
+	// DeleteOrphanedHistoryTask deletes a history task if its workflow run doesn't exist. The owner of the shard
+	// checks its ownership and the run before the task is deleted.
+	DeleteOrphanedHistoryTask(ctx context.Context, in *DeleteOrphanedHistoryTaskRequest, opts ...grpc.CallOption) (*DeleteOrphanedHistoryTaskResponse, error)
+	// PauseWorkflowExecution pauses a running workflow execution. While the workflow is paused, workflow tasks,
+	// activities and timers aren't dispatched, and signals and updates are buffered until it's unpaused.
+	PauseWorkflowExecution(ctx context.Context, in *PauseWorkflowExecutionRequest, opts ...grpc.CallOption) (*PauseWorkflowExecutionResponse, error)
+	// UnpauseWorkflowExecution resumes a paused workflow execution, and regenerates the tasks which were dropped
+	// while it was paused.
+	UnpauseWorkflowExecution(ctx context.Context, in *UnpauseWorkflowExecutionRequest, opts ...grpc.CallOption) (*UnpauseWorkflowExecutionResponse, error)
+	// ForkWorkflowExecution creates a new workflow from the history of a workflow up to a given event. The source
+	// workflow isn't changed. The request is handled by the shard of the new workflow.
+	ForkWorkflowExecution(ctx context.Context, in *ForkWorkflowExecutionRequest, opts ...grpc.CallOption) (*ForkWorkflowExecutionResponse, error)
+	// PreviewResetWorkflowExecution returns what ResetWorkflowExecution would do with the request, without mutating
+	// the workflow.
+	PreviewResetWorkflowExecution(ctx context.Context, in *PreviewResetWorkflowExecutionRequest, opts ...grpc.CallOption) (*PreviewResetWorkflowExecutionResponse, error)
 }
 
 type historyServiceClient struct {
@@ -1040,6 +1069,69 @@ func (c *historyServiceClient) ResetActivity(ctx context.Context, in *ResetActiv
 	return out, nil
 }
 
+func (c *historyServiceClient) MoveSearchAttributeValue(ctx context.Context, in *MoveSearchAttributeValueRequest, opts ...grpc.CallOption) (*MoveSearchAttributeValueResponse, error) {
+	out := new(MoveSearchAttributeValueResponse)
+	err := c.cc.Invoke(ctx, HistoryService_MoveSearchAttributeValue_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *historyServiceClient) DeleteOrphanedCurrentExecution(ctx context.Context, in *DeleteOrphanedCurrentExecutionRequest, opts ...grpc.CallOption) (*DeleteOrphanedCurrentExecutionResponse, error) {
+	out := new(DeleteOrphanedCurrentExecutionResponse)
+	err := c.cc.Invoke(ctx, HistoryService_DeleteOrphanedCurrentExecution_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *historyServiceClient) DeleteOrphanedHistoryTask(ctx context.Context, in *DeleteOrphanedHistoryTaskRequest, opts ...grpc.CallOption) (*DeleteOrphanedHistoryTaskResponse, error) {
+	out := new(DeleteOrphanedHistoryTaskResponse)
+	err := c.cc.Invoke(ctx, HistoryService_DeleteOrphanedHistoryTask_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *historyServiceClient) PauseWorkflowExecution(ctx context.Context, in *PauseWorkflowExecutionRequest, opts ...grpc.CallOption) (*PauseWorkflowExecutionResponse, error) {
+	out := new(PauseWorkflowExecutionResponse)
+	err := c.cc.Invoke(ctx, HistoryService_PauseWorkflowExecution_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *historyServiceClient) UnpauseWorkflowExecution(ctx context.Context, in *UnpauseWorkflowExecutionRequest, opts ...grpc.CallOption) (*UnpauseWorkflowExecutionResponse, error) {
+	out := new(UnpauseWorkflowExecutionResponse)
+	err := c.cc.Invoke(ctx, HistoryService_UnpauseWorkflowExecution_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *historyServiceClient) ForkWorkflowExecution(ctx context.Context, in *ForkWorkflowExecutionRequest, opts ...grpc.CallOption) (*ForkWorkflowExecutionResponse, error) {
+	out := new(ForkWorkflowExecutionResponse)
+	err := c.cc.Invoke(ctx, HistoryService_ForkWorkflowExecution_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *historyServiceClient) PreviewResetWorkflowExecution(ctx context.Context, in *PreviewResetWorkflowExecutionRequest, opts ...grpc.CallOption) (*PreviewResetWorkflowExecutionResponse, error) {
+	out := new(PreviewResetWorkflowExecutionResponse)
+	err := c.cc.Invoke(ctx, HistoryService_PreviewResetWorkflowExecution_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // HistoryServiceServer is the server API for HistoryService service.
 // All implementations must embed UnimplementedHistoryServiceServer
 // for forward compatibility
@@ -1316,6 +1408,28 @@ type HistoryServiceServer interface {
 	// (-- api-linter: core::0134::method-signature=disabled
 	// (-- api-linter: core::0134::response-message-name=disabled
 	ResetActivity(context.Context, *ResetActivityRequest) (*ResetActivityResponse, error)
+	// MoveSearchAttributeValue moves the value of a custom search attribute field in the mutable state of a running
+	// workflow execution to another field, converting it to the type of that field. It's used when a search
+	// attribute is mapped to a field of another type. The value of the target field is kept if it's already set.
+	MoveSearchAttributeValue(context.Context, *MoveSearchAttributeValueRequest) (*MoveSearchAttributeValueResponse, error)
+	// DeleteOrphanedCurrentExecution deletes the current execution record of a workflow ID if the run it points to
+	// doesn't exist. The owner of the shard checks its ownership and the run before the record is deleted.
+	DeleteOrphanedCurrentExecution(context.Context, *DeleteOrphanedCurrentExecutionRequest) (*DeleteOrphanedCurrentExecutionResponse, error)
+	// DeleteOrphanedHistoryTask deletes a history task if its workflow run doesn't exist. The owner of the shard
+	// checks its ownership and the run before the task is deleted.
+	DeleteOrphanedHistoryTask(context.Context, *DeleteOrphanedHistoryTaskRequest) (*DeleteOrphanedHistoryTaskResponse, error)
+	// PauseWorkflowExecution pauses a running workflow execution. While the workflow is paused, workflow tasks,
+	// activities and timers aren't dispatched, and signals and updates are buffered until it's unpaused.
+	PauseWorkflowExecution(context.Context, *PauseWorkflowExecutionRequest) (*PauseWorkflowExecutionResponse, error)
+	// UnpauseWorkflowExecution resumes a paused workflow execution, and regenerates the tasks which were dropped
+	// while it was paused.
+	UnpauseWorkflowExecution(context.Context, *UnpauseWorkflowExecutionRequest) (*UnpauseWorkflowExecutionResponse, error)
+	// ForkWorkflowExecution creates a new workflow from the history of a workflow up to a given event. The source
+	// workflow isn't changed. The request is handled by the shard of the new workflow.
+	ForkWorkflowExecution(context.Context, *ForkWorkflowExecutionRequest) (*ForkWorkflowExecutionResponse, error)
+	// PreviewResetWorkflowExecution returns what ResetWorkflowExecution would do with the request, without mutating
+	// the workflow.
+	PreviewResetWorkflowExecution(context.Context, *PreviewResetWorkflowExecutionRequest) (*PreviewResetWorkflowExecutionResponse, error)
 	mustEmbedUnimplementedHistoryServiceServer()
 }
 
@@ -1536,6 +1650,27 @@ func (UnimplementedHistoryServiceServer) UnpauseActivity(context.Context, *Unpau
 func (UnimplementedHistoryServiceServer) ResetActivity(context.Context, *ResetActivityRequest) (*ResetActivityResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ResetActivity not implemented")
 }
+func (UnimplementedHistoryServiceServer) MoveSearchAttributeValue(context.Context, *MoveSearchAttributeValueRequest) (*MoveSearchAttributeValueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MoveSearchAttributeValue not implemented")
+}
+func (UnimplementedHistoryServiceServer) DeleteOrphanedCurrentExecution(context.Context, *DeleteOrphanedCurrentExecutionRequest) (*DeleteOrphanedCurrentExecutionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteOrphanedCurrentExecution not implemented")
+}
+func (UnimplementedHistoryServiceServer) DeleteOrphanedHistoryTask(context.Context, *DeleteOrphanedHistoryTaskRequest) (*DeleteOrphanedHistoryTaskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteOrphanedHistoryTask not implemented")
+}
+func (UnimplementedHistoryServiceServer) PauseWorkflowExecution(context.Context, *PauseWorkflowExecutionRequest) (*PauseWorkflowExecutionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseWorkflowExecution not implemented")
+}
+func (UnimplementedHistoryServiceServer) UnpauseWorkflowExecution(context.Context, *UnpauseWorkflowExecutionRequest) (*UnpauseWorkflowExecutionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnpauseWorkflowExecution not implemented")
+}
+func (UnimplementedHistoryServiceServer) ForkWorkflowExecution(context.Context, *ForkWorkflowExecutionRequest) (*ForkWorkflowExecutionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ForkWorkflowExecution not implemented")
+}
+func (UnimplementedHistoryServiceServer) PreviewResetWorkflowExecution(context.Context, *PreviewResetWorkflowExecutionRequest) (*PreviewResetWorkflowExecutionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PreviewResetWorkflowExecution not implemented")
+}
 func (UnimplementedHistoryServiceServer) mustEmbedUnimplementedHistoryServiceServer() {}
 
 // UnsafeHistoryServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -2835,6 +2970,132 @@ func _HistoryService_ResetActivity_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _HistoryService_MoveSearchAttributeValue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MoveSearchAttributeValueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HistoryServiceServer).MoveSearchAttributeValue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HistoryService_MoveSearchAttributeValue_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HistoryServiceServer).MoveSearchAttributeValue(ctx, req.(*MoveSearchAttributeValueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HistoryService_DeleteOrphanedCurrentExecution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteOrphanedCurrentExecutionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HistoryServiceServer).DeleteOrphanedCurrentExecution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HistoryService_DeleteOrphanedCurrentExecution_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HistoryServiceServer).DeleteOrphanedCurrentExecution(ctx, req.(*DeleteOrphanedCurrentExecutionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HistoryService_DeleteOrphanedHistoryTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteOrphanedHistoryTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HistoryServiceServer).DeleteOrphanedHistoryTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HistoryService_DeleteOrphanedHistoryTask_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HistoryServiceServer).DeleteOrphanedHistoryTask(ctx, req.(*DeleteOrphanedHistoryTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HistoryService_PauseWorkflowExecution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PauseWorkflowExecutionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HistoryServiceServer).PauseWorkflowExecution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HistoryService_PauseWorkflowExecution_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HistoryServiceServer).PauseWorkflowExecution(ctx, req.(*PauseWorkflowExecutionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HistoryService_UnpauseWorkflowExecution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnpauseWorkflowExecutionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HistoryServiceServer).UnpauseWorkflowExecution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HistoryService_UnpauseWorkflowExecution_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HistoryServiceServer).UnpauseWorkflowExecution(ctx, req.(*UnpauseWorkflowExecutionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HistoryService_ForkWorkflowExecution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ForkWorkflowExecutionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HistoryServiceServer).ForkWorkflowExecution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HistoryService_ForkWorkflowExecution_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HistoryServiceServer).ForkWorkflowExecution(ctx, req.(*ForkWorkflowExecutionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HistoryService_PreviewResetWorkflowExecution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PreviewResetWorkflowExecutionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HistoryServiceServer).PreviewResetWorkflowExecution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HistoryService_PreviewResetWorkflowExecution_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HistoryServiceServer).PreviewResetWorkflowExecution(ctx, req.(*PreviewResetWorkflowExecutionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // HistoryService_ServiceDesc is the grpc.ServiceDesc for HistoryService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -3122,6 +3383,34 @@ var HistoryService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ResetActivity",
 			Handler:    _HistoryService_ResetActivity_Handler,
 		},
+		{
+			MethodName: "MoveSearchAttributeValue",
+			Handler:    _HistoryService_MoveSearchAttributeValue_Handler,
+		},
+		{
+			MethodName: "DeleteOrphanedCurrentExecution",
+			Handler:    _HistoryService_DeleteOrphanedCurrentExecution_Handler,
+		},
+		{
+			MethodName: "DeleteOrphanedHistoryTask",
+			Handler:    _HistoryService_DeleteOrphanedHistoryTask_Handler,
+		},
+		{
+			MethodName: "PauseWorkflowExecution",
+			Handler:    _HistoryService_PauseWorkflowExecution_Handler,
+		},
+		{
+			MethodName: "UnpauseWorkflowExecution",
+			Handler:    _HistoryService_UnpauseWorkflowExecution_Handler,
+		},
+		{
+			MethodName: "ForkWorkflowExecution",
+			Handler:    _HistoryService_ForkWorkflowExecution_Handler,
+		},
+		{
+			MethodName: "PreviewResetWorkflowExecution",
+			Handler:    _HistoryService_PreviewResetWorkflowExecution_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -143,6 +143,46 @@ func (mr *MockHistoryServiceClientMockRecorder) DeleteDLQTasks(ctx, in any, opts
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDLQTasks", reflect.TypeOf((*MockHistoryServiceClient)(nil).DeleteDLQTasks), varargs...)
 }
 
+// DeleteOrphanedCurrentExecution mocks base method.
+func (m *MockHistoryServiceClient) DeleteOrphanedCurrentExecution(ctx context.Context, in *historyservice.DeleteOrphanedCurrentExecutionRequest, opts ...grpc.CallOption) (*historyservice.DeleteOrphanedCurrentExecutionResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteOrphanedCurrentExecution", varargs...)
+	ret0, _ := ret[0].(*historyservice.DeleteOrphanedCurrentExecutionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteOrphanedCurrentExecution indicates an expected call of DeleteOrphanedCurrentExecution.
+func (mr *MockHistoryServiceClientMockRecorder) DeleteOrphanedCurrentExecution(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteOrphanedCurrentExecution", reflect.TypeOf((*MockHistoryServiceClient)(nil).DeleteOrphanedCurrentExecution), varargs...)
+}
+
+// DeleteOrphanedHistoryTask mocks base method.
+func (m *MockHistoryServiceClient) DeleteOrphanedHistoryTask(ctx context.Context, in *historyservice.DeleteOrphanedHistoryTaskRequest, opts ...grpc.CallOption) (*historyservice.DeleteOrphanedHistoryTaskResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteOrphanedHistoryTask", varargs...)
+	ret0, _ := ret[0].(*historyservice.DeleteOrphanedHistoryTaskResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteOrphanedHistoryTask indicates an expected call of DeleteOrphanedHistoryTask.
+func (mr *MockHistoryServiceClientMockRecorder) DeleteOrphanedHistoryTask(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteOrphanedHistoryTask", reflect.TypeOf((*MockHistoryServiceClient)(nil).DeleteOrphanedHistoryTask), varargs...)
+}
+
 // DeleteWorkflowExecution mocks base method.
 func (m *MockHistoryServiceClient) DeleteWorkflowExecution(ctx context.Context, in *historyservice.DeleteWorkflowExecutionRequest, opts ...grpc.CallOption) (*historyservice.DeleteWorkflowExecutionResponse, error) {
 	m.ctrl.T.Helper()
@@ -283,6 +323,26 @@ func (mr *MockHistoryServiceClientMockRecorder) ForceDeleteWorkflowExecution(ctx
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ForceDeleteWorkflowExecution", reflect.TypeOf((*MockHistoryServiceClient)(nil).ForceDeleteWorkflowExecution), varargs...)
 }
 
+// ForkWorkflowExecution mocks base method.
+func (m *MockHistoryServiceClient) ForkWorkflowExecution(ctx context.Context, in *historyservice.ForkWorkflowExecutionRequest, opts ...grpc.CallOption) (*historyservice.ForkWorkflowExecutionResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ForkWorkflowExecution", varargs...)
+	ret0, _ := ret[0].(*historyservice.ForkWorkflowExecutionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ForkWorkflowExecution indicates an expected call of ForkWorkflowExecution.
+func (mr *MockHistoryServiceClientMockRecorder) ForkWorkflowExecution(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ForkWorkflowExecution", reflect.TypeOf((*MockHistoryServiceClient)(nil).ForkWorkflowExecution), varargs...)
+}
+
 // GenerateLastHistoryReplicationTasks mocks base method.
 func (m *MockHistoryServiceClient) GenerateLastHistoryReplicationTasks(ctx context.Context, in *historyservice.GenerateLastHistoryReplicationTasksRequest, opts ...grpc.CallOption) (*historyservice.GenerateLastHistoryReplicationTasksResponse, error) {
 	m.ctrl.T.Helper()
@@ -663,6 +723,26 @@ func (mr *MockHistoryServiceClientMockRecorder) MergeDLQMessages(ctx, in any, op
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MergeDLQMessages", reflect.TypeOf((*MockHistoryServiceClient)(nil).MergeDLQMessages), varargs...)
 }
 
+// MoveSearchAttributeValue mocks base method.
+func (m *MockHistoryServiceClient) MoveSearchAttributeValue(ctx context.Context, in *historyservice.MoveSearchAttributeValueRequest, opts ...grpc.CallOption) (*historyservice.MoveSearchAttributeValueResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "MoveSearchAttributeValue", varargs...)
+	ret0, _ := ret[0].(*historyservice.MoveSearchAttributeValueResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MoveSearchAttributeValue indicates an expected call of MoveSearchAttributeValue.
+func (mr *MockHistoryServiceClientMockRecorder) MoveSearchAttributeValue(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveSearchAttributeValue", reflect.TypeOf((*MockHistoryServiceClient)(nil).MoveSearchAttributeValue), varargs...)
+}
+
 // PauseActivity mocks base method.
 func (m *MockHistoryServiceClient) PauseActivity(ctx context.Context, in *historyservice.PauseActivityRequest, opts ...grpc.CallOption) (*historyservice.PauseActivityResponse, error) {
 	m.ctrl.T.Helper()
@@ -683,6 +763,26 @@ func (mr *MockHistoryServiceClientMockRecorder) PauseActivity(ctx, in any, opts
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PauseActivity", reflect.TypeOf((*MockHistoryServiceClient)(nil).PauseActivity), varargs...)
 }
 
+// PauseWorkflowExecution mocks base method.
+func (m *MockHistoryServiceClient) PauseWorkflowExecution(ctx context.Context, in *historyservice.PauseWorkflowExecutionRequest, opts ...grpc.CallOption) (*historyservice.PauseWorkflowExecutionResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PauseWorkflowExecution", varargs...)
+	ret0, _ := ret[0].(*historyservice.PauseWorkflowExecutionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PauseWorkflowExecution indicates an expected call of PauseWorkflowExecution.
+func (mr *MockHistoryServiceClientMockRecorder) PauseWorkflowExecution(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PauseWorkflowExecution", reflect.TypeOf((*MockHistoryServiceClient)(nil).PauseWorkflowExecution), varargs...)
+}
+
 // PollMutableState mocks base method.
 func (m *MockHistoryServiceClient) PollMutableState(ctx context.Context, in *historyservice.PollMutableStateRequest, opts ...grpc.CallOption) (*historyservice.PollMutableStateResponse, error) {
 	m.ctrl.T.Helper()
@@ -723,6 +823,26 @@ func (mr *MockHistoryServiceClientMockRecorder) PollWorkflowExecutionUpdate(ctx,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PollWorkflowExecutionUpdate", reflect.TypeOf((*MockHistoryServiceClient)(nil).PollWorkflowExecutionUpdate), varargs...)
 }
 
+// PreviewResetWorkflowExecution mocks base method.
+func (m *MockHistoryServiceClient) PreviewResetWorkflowExecution(ctx context.Context, in *historyservice.PreviewResetWorkflowExecutionRequest, opts ...grpc.CallOption) (*historyservice.PreviewResetWorkflowExecutionResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PreviewResetWorkflowExecution", varargs...)
+	ret0, _ := ret[0].(*historyservice.PreviewResetWorkflowExecutionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PreviewResetWorkflowExecution indicates an expected call of PreviewResetWorkflowExecution.
+func (mr *MockHistoryServiceClientMockRecorder) PreviewResetWorkflowExecution(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PreviewResetWorkflowExecution", reflect.TypeOf((*MockHistoryServiceClient)(nil).PreviewResetWorkflowExecution), varargs...)
+}
+
 // PurgeDLQMessages mocks base method.
 func (m *MockHistoryServiceClient) PurgeDLQMessages(ctx context.Context, in *historyservice.PurgeDLQMessagesRequest, opts ...grpc.CallOption) (*historyservice.PurgeDLQMessagesResponse, error) {
 	m.ctrl.T.Helper()
@@ -1363,6 +1483,26 @@ func (mr *MockHistoryServiceClientMockRecorder) UnpauseActivity(ctx, in any, opt
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnpauseActivity", reflect.TypeOf((*MockHistoryServiceClient)(nil).UnpauseActivity), varargs...)
 }
 
+// UnpauseWorkflowExecution mocks base method.
+func (m *MockHistoryServiceClient) UnpauseWorkflowExecution(ctx context.Context, in *historyservice.UnpauseWorkflowExecutionRequest, opts ...grpc.CallOption) (*historyservice.UnpauseWorkflowExecutionResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UnpauseWorkflowExecution", varargs...)
+	ret0, _ := ret[0].(*historyservice.UnpauseWorkflowExecutionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UnpauseWorkflowExecution indicates an expected call of UnpauseWorkflowExecution.
+func (mr *MockHistoryServiceClientMockRecorder) UnpauseWorkflowExecution(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnpauseWorkflowExecution", reflect.TypeOf((*MockHistoryServiceClient)(nil).UnpauseWorkflowExecution), varargs...)
+}
+
 // UpdateActivityOptions mocks base method.
 func (m *MockHistoryServiceClient) UpdateActivityOptions(ctx context.Context, in *historyservice.UpdateActivityOptionsRequest, opts ...grpc.CallOption) (*historyservice.UpdateActivityOptionsResponse, error) {
 	m.ctrl.T.Helper()
@@ -1700,6 +1840,36 @@ func (mr *MockHistoryServiceServerMockRecorder) DeleteDLQTasks(arg0, arg1 any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDLQTasks", reflect.TypeOf((*MockHistoryServiceServer)(nil).DeleteDLQTasks), arg0, arg1)
 }
 
+// DeleteOrphanedCurrentExecution mocks base method.
+func (m *MockHistoryServiceServer) DeleteOrphanedCurrentExecution(arg0 context.Context, arg1 *historyservice.DeleteOrphanedCurrentExecutionRequest) (*historyservice.DeleteOrphanedCurrentExecutionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteOrphanedCurrentExecution", arg0, arg1)
+	ret0, _ := ret[0].(*historyservice.DeleteOrphanedCurrentExecutionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteOrphanedCurrentExecution indicates an expected call of DeleteOrphanedCurrentExecution.
+func (mr *MockHistoryServiceServerMockRecorder) DeleteOrphanedCurrentExecution(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteOrphanedCurrentExecution", reflect.TypeOf((*MockHistoryServiceServer)(nil).DeleteOrphanedCurrentExecution), arg0, arg1)
+}
+
+// DeleteOrphanedHistoryTask mocks base method.
+func (m *MockHistoryServiceServer) DeleteOrphanedHistoryTask(arg0 context.Context, arg1 *historyservice.DeleteOrphanedHistoryTaskRequest) (*historyservice.DeleteOrphanedHistoryTaskResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteOrphanedHistoryTask", arg0, arg1)
+	ret0, _ := ret[0].(*historyservice.DeleteOrphanedHistoryTaskResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteOrphanedHistoryTask indicates an expected call of DeleteOrphanedHistoryTask.
+func (mr *MockHistoryServiceServerMockRecorder) DeleteOrphanedHistoryTask(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteOrphanedHistoryTask", reflect.TypeOf((*MockHistoryServiceServer)(nil).DeleteOrphanedHistoryTask), arg0, arg1)
+}
+
 // DeleteWorkflowExecution mocks base method.
 func (m *MockHistoryServiceServer) DeleteWorkflowExecution(arg0 context.Context, arg1 *historyservice.DeleteWorkflowExecutionRequest) (*historyservice.DeleteWorkflowExecutionResponse, error) {
 	m.ctrl.T.Helper()
@@ -1805,6 +1975,21 @@ func (mr *MockHistoryServiceServerMockRecorder) ForceDeleteWorkflowExecution(arg
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ForceDeleteWorkflowExecution", reflect.TypeOf((*MockHistoryServiceServer)(nil).ForceDeleteWorkflowExecution), arg0, arg1)
 }
 
+// ForkWorkflowExecution mocks base method.
+func (m *MockHistoryServiceServer) ForkWorkflowExecution(arg0 context.Context, arg1 *historyservice.ForkWorkflowExecutionRequest) (*historyservice.ForkWorkflowExecutionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ForkWorkflowExecution", arg0, arg1)
+	ret0, _ := ret[0].(*historyservice.ForkWorkflowExecutionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ForkWorkflowExecution indicates an expected call of ForkWorkflowExecution.
+func (mr *MockHistoryServiceServerMockRecorder) ForkWorkflowExecution(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ForkWorkflowExecution", reflect.TypeOf((*MockHistoryServiceServer)(nil).ForkWorkflowExecution), arg0, arg1)
+}
+
 // GenerateLastHistoryReplicationTasks mocks base method.
 func (m *MockHistoryServiceServer) GenerateLastHistoryReplicationTasks(arg0 context.Context, arg1 *historyservice.GenerateLastHistoryReplicationTasksRequest) (*historyservice.GenerateLastHistoryReplicationTasksResponse, error) {
 	m.ctrl.T.Helper()
@@ -2090,6 +2275,21 @@ func (mr *MockHistoryServiceServerMockRecorder) MergeDLQMessages(arg0, arg1 any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MergeDLQMessages", reflect.TypeOf((*MockHistoryServiceServer)(nil).MergeDLQMessages), arg0, arg1)
 }
 
+// MoveSearchAttributeValue mocks base method.
+func (m *MockHistoryServiceServer) MoveSearchAttributeValue(arg0 context.Context, arg1 *historyservice.MoveSearchAttributeValueRequest) (*historyservice.MoveSearchAttributeValueResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MoveSearchAttributeValue", arg0, arg1)
+	ret0, _ := ret[0].(*historyservice.MoveSearchAttributeValueResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MoveSearchAttributeValue indicates an expected call of MoveSearchAttributeValue.
+func (mr *MockHistoryServiceServerMockRecorder) MoveSearchAttributeValue(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveSearchAttributeValue", reflect.TypeOf((*MockHistoryServiceServer)(nil).MoveSearchAttributeValue), arg0, arg1)
+}
+
 // PauseActivity mocks base method.
 func (m *MockHistoryServiceServer) PauseActivity(arg0 context.Context, arg1 *historyservice.PauseActivityRequest) (*historyservice.PauseActivityResponse, error) {
 	m.ctrl.T.Helper()
@@ -2105,6 +2305,21 @@ func (mr *MockHistoryServiceServerMockRecorder) PauseActivity(arg0, arg1 any) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PauseActivity", reflect.TypeOf((*MockHistoryServiceServer)(nil).PauseActivity), arg0, arg1)
 }
 
+// PauseWorkflowExecution mocks base method.
+func (m *MockHistoryServiceServer) PauseWorkflowExecution(arg0 context.Context, arg1 *historyservice.PauseWorkflowExecutionRequest) (*historyservice.PauseWorkflowExecutionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PauseWorkflowExecution", arg0, arg1)
+	ret0, _ := ret[0].(*historyservice.PauseWorkflowExecutionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PauseWorkflowExecution indicates an expected call of PauseWorkflowExecution.
+func (mr *MockHistoryServiceServerMockRecorder) PauseWorkflowExecution(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PauseWorkflowExecution", reflect.TypeOf((*MockHistoryServiceServer)(nil).PauseWorkflowExecution), arg0, arg1)
+}
+
 // PollMutableState mocks base method.
 func (m *MockHistoryServiceServer) PollMutableState(arg0 context.Context, arg1 *historyservice.PollMutableStateRequest) (*historyservice.PollMutableStateResponse, error) {
 	m.ctrl.T.Helper()
@@ -2135,6 +2350,21 @@ func (mr *MockHistoryServiceServerMockRecorder) PollWorkflowExecutionUpdate(arg0
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PollWorkflowExecutionUpdate", reflect.TypeOf((*MockHistoryServiceServer)(nil).PollWorkflowExecutionUpdate), arg0, arg1)
 }
 
+// PreviewResetWorkflowExecution mocks base method.
+func (m *MockHistoryServiceServer) PreviewResetWorkflowExecution(arg0 context.Context, arg1 *historyservice.PreviewResetWorkflowExecutionRequest) (*historyservice.PreviewResetWorkflowExecutionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PreviewResetWorkflowExecution", arg0, arg1)
+	ret0, _ := ret[0].(*historyservice.PreviewResetWorkflowExecutionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PreviewResetWorkflowExecution indicates an expected call of PreviewResetWorkflowExecution.
+func (mr *MockHistoryServiceServerMockRecorder) PreviewResetWorkflowExecution(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PreviewResetWorkflowExecution", reflect.TypeOf((*MockHistoryServiceServer)(nil).PreviewResetWorkflowExecution), arg0, arg1)
+}
+
 // PurgeDLQMessages mocks base method.
 func (m *MockHistoryServiceServer) PurgeDLQMessages(arg0 context.Context, arg1 *historyservice.PurgeDLQMessagesRequest) (*historyservice.PurgeDLQMessagesResponse, error) {
 	m.ctrl.T.Helper()
@@ -2614,6 +2844,21 @@ func (mr *MockHistoryServiceServerMockRecorder) UnpauseActivity(arg0, arg1 any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnpauseActivity", reflect.TypeOf((*MockHistoryServiceServer)(nil).UnpauseActivity), arg0, arg1)
 }
 
+// UnpauseWorkflowExecution mocks base method.
+func (m *MockHistoryServiceServer) UnpauseWorkflowExecution(arg0 context.Context, arg1 *historyservice.UnpauseWorkflowExecutionRequest) (*historyservice.UnpauseWorkflowExecutionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnpauseWorkflowExecution", arg0, arg1)
+	ret0, _ := ret[0].(*historyservice.UnpauseWorkflowExecutionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UnpauseWorkflowExecution indicates an expected call of UnpauseWorkflowExecution.
+func (mr *MockHistoryServiceServerMockRecorder) UnpauseWorkflowExecution(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnpauseWorkflowExecution", reflect.TypeOf((*MockHistoryServiceServer)(nil).UnpauseWorkflowExecution), arg0, arg1)
+}
+
 // UpdateActivityOptions mocks base method.
 func (m *MockHistoryServiceServer) UpdateActivityOptions(arg0 context.Context, arg1 *historyservice.UpdateActivityOptionsRequest) (*historyservice.UpdateActivityOptionsResponse, error) {
 	m.ctrl.T.Helper()
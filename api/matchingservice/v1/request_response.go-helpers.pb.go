@@ -1559,6 +1559,228 @@ func (this *ForceUnloadTaskQueuePartitionResponse) Equal(that interface{}) bool
 	return proto.Equal(this, that1)
 }
 
+// Marshal an object of type MoveTaskQueueTasksRequest to the protobuf v3 wire format
+func (val *MoveTaskQueueTasksRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type MoveTaskQueueTasksRequest from the protobuf v3 wire format
+func (val *MoveTaskQueueTasksRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *MoveTaskQueueTasksRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two MoveTaskQueueTasksRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *MoveTaskQueueTasksRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *MoveTaskQueueTasksRequest
+	switch t := that.(type) {
+	case *MoveTaskQueueTasksRequest:
+		that1 = t
+	case MoveTaskQueueTasksRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type MoveTaskQueueTasksResponse to the protobuf v3 wire format
+func (val *MoveTaskQueueTasksResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type MoveTaskQueueTasksResponse from the protobuf v3 wire format
+func (val *MoveTaskQueueTasksResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *MoveTaskQueueTasksResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two MoveTaskQueueTasksResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *MoveTaskQueueTasksResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *MoveTaskQueueTasksResponse
+	switch t := that.(type) {
+	case *MoveTaskQueueTasksResponse:
+		that1 = t
+	case MoveTaskQueueTasksResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ReleaseWorkerInFlightTaskRequest to the protobuf v3 wire format
+func (val *ReleaseWorkerInFlightTaskRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ReleaseWorkerInFlightTaskRequest from the protobuf v3 wire format
+func (val *ReleaseWorkerInFlightTaskRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ReleaseWorkerInFlightTaskRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ReleaseWorkerInFlightTaskRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ReleaseWorkerInFlightTaskRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ReleaseWorkerInFlightTaskRequest
+	switch t := that.(type) {
+	case *ReleaseWorkerInFlightTaskRequest:
+		that1 = t
+	case ReleaseWorkerInFlightTaskRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ReleaseWorkerInFlightTaskResponse to the protobuf v3 wire format
+func (val *ReleaseWorkerInFlightTaskResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ReleaseWorkerInFlightTaskResponse from the protobuf v3 wire format
+func (val *ReleaseWorkerInFlightTaskResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ReleaseWorkerInFlightTaskResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ReleaseWorkerInFlightTaskResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ReleaseWorkerInFlightTaskResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ReleaseWorkerInFlightTaskResponse
+	switch t := that.(type) {
+	case *ReleaseWorkerInFlightTaskResponse:
+		that1 = t
+	case ReleaseWorkerInFlightTaskResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type UpdateWorkerDispatchLimitsRequest to the protobuf v3 wire format
+func (val *UpdateWorkerDispatchLimitsRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type UpdateWorkerDispatchLimitsRequest from the protobuf v3 wire format
+func (val *UpdateWorkerDispatchLimitsRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *UpdateWorkerDispatchLimitsRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two UpdateWorkerDispatchLimitsRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *UpdateWorkerDispatchLimitsRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *UpdateWorkerDispatchLimitsRequest
+	switch t := that.(type) {
+	case *UpdateWorkerDispatchLimitsRequest:
+		that1 = t
+	case UpdateWorkerDispatchLimitsRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type UpdateWorkerDispatchLimitsResponse to the protobuf v3 wire format
+func (val *UpdateWorkerDispatchLimitsResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type UpdateWorkerDispatchLimitsResponse from the protobuf v3 wire format
+func (val *UpdateWorkerDispatchLimitsResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *UpdateWorkerDispatchLimitsResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two UpdateWorkerDispatchLimitsResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *UpdateWorkerDispatchLimitsResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *UpdateWorkerDispatchLimitsResponse
+	switch t := that.(type) {
+	case *UpdateWorkerDispatchLimitsResponse:
+		that1 = t
+	case UpdateWorkerDispatchLimitsResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
 // Marshal an object of type UpdateTaskQueueUserDataRequest to the protobuf v3 wire format
 func (val *UpdateTaskQueueUserDataRequest) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
@@ -45,6 +45,9 @@ const (
 	MatchingService_ForceLoadTaskQueuePartition_FullMethodName            = "/temporal.server.api.matchingservice.v1.MatchingService/ForceLoadTaskQueuePartition"
 	MatchingService_ForceUnloadTaskQueue_FullMethodName                   = "/temporal.server.api.matchingservice.v1.MatchingService/ForceUnloadTaskQueue"
 	MatchingService_ForceUnloadTaskQueuePartition_FullMethodName          = "/temporal.server.api.matchingservice.v1.MatchingService/ForceUnloadTaskQueuePartition"
+	MatchingService_MoveTaskQueueTasks_FullMethodName                     = "/temporal.server.api.matchingservice.v1.MatchingService/MoveTaskQueueTasks"
+	MatchingService_ReleaseWorkerInFlightTask_FullMethodName              = "/temporal.server.api.matchingservice.v1.MatchingService/ReleaseWorkerInFlightTask"
+	MatchingService_UpdateWorkerDispatchLimits_FullMethodName             = "/temporal.server.api.matchingservice.v1.MatchingService/UpdateWorkerDispatchLimits"
 	MatchingService_UpdateTaskQueueUserData_FullMethodName                = "/temporal.server.api.matchingservice.v1.MatchingService/UpdateTaskQueueUserData"
 	MatchingService_ReplicateTaskQueueUserData_FullMethodName             = "/temporal.server.api.matchingservice.v1.MatchingService/ReplicateTaskQueueUserData"
 	MatchingService_CheckTaskQueueUserDataPropagation_FullMethodName      = "/temporal.server.api.matchingservice.v1.MatchingService/CheckTaskQueueUserDataPropagation"
@@ -142,6 +145,15 @@ type MatchingServiceClient interface {
 	ForceUnloadTaskQueue(ctx context.Context, in *ForceUnloadTaskQueueRequest, opts ...grpc.CallOption) (*ForceUnloadTaskQueueResponse, error)
 	// Force unloading a task queue partition.
 	ForceUnloadTaskQueuePartition(ctx context.Context, in *ForceUnloadTaskQueuePartitionRequest, opts ...grpc.CallOption) (*ForceUnloadTaskQueuePartitionResponse, error)
+	// Moves, or copies, the persisted backlog tasks selected by the filters from a task queue partition to another
+	// task queue. Routed to the owner of the source partition. Moves one page of the backlog per call.
+	MoveTaskQueueTasks(ctx context.Context, in *MoveTaskQueueTasksRequest, opts ...grpc.CallOption) (*MoveTaskQueueTasksResponse, error)
+	// Releases the in-flight slot a task holds in the limits of its worker, once the worker responded to the task.
+	// Routed to the owner of the partition that dispatched the task.
+	ReleaseWorkerInFlightTask(ctx context.Context, in *ReleaseWorkerInFlightTaskRequest, opts ...grpc.CallOption) (*ReleaseWorkerInFlightTaskResponse, error)
+	// Sets the dispatch limits of the workers polling a task queue type in the task queue user data.
+	// Routed to user data owner (root partition of workflow task queue).
+	UpdateWorkerDispatchLimits(ctx context.Context, in *UpdateWorkerDispatchLimitsRequest, opts ...grpc.CallOption) (*UpdateWorkerDispatchLimitsResponse, error)
 	// Update task queue user data in owning node for all updates in namespace.
 	// All user data updates must first go through the task queue owner using the `UpdateWorkerBuildIdCompatibility`
 	// API.
@@ -426,6 +438,33 @@ func (c *matchingServiceClient) ForceUnloadTaskQueuePartition(ctx context.Contex
 	return out, nil
 }
 
+func (c *matchingServiceClient) MoveTaskQueueTasks(ctx context.Context, in *MoveTaskQueueTasksRequest, opts ...grpc.CallOption) (*MoveTaskQueueTasksResponse, error) {
+	out := new(MoveTaskQueueTasksResponse)
+	err := c.cc.Invoke(ctx, MatchingService_MoveTaskQueueTasks_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *matchingServiceClient) ReleaseWorkerInFlightTask(ctx context.Context, in *ReleaseWorkerInFlightTaskRequest, opts ...grpc.CallOption) (*ReleaseWorkerInFlightTaskResponse, error) {
+	out := new(ReleaseWorkerInFlightTaskResponse)
+	err := c.cc.Invoke(ctx, MatchingService_ReleaseWorkerInFlightTask_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *matchingServiceClient) UpdateWorkerDispatchLimits(ctx context.Context, in *UpdateWorkerDispatchLimitsRequest, opts ...grpc.CallOption) (*UpdateWorkerDispatchLimitsResponse, error) {
+	out := new(UpdateWorkerDispatchLimitsResponse)
+	err := c.cc.Invoke(ctx, MatchingService_UpdateWorkerDispatchLimits_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *matchingServiceClient) UpdateTaskQueueUserData(ctx context.Context, in *UpdateTaskQueueUserDataRequest, opts ...grpc.CallOption) (*UpdateTaskQueueUserDataResponse, error) {
 	out := new(UpdateTaskQueueUserDataResponse)
 	err := c.cc.Invoke(ctx, MatchingService_UpdateTaskQueueUserData_FullMethodName, in, out, opts...)
@@ -577,6 +616,15 @@ type MatchingServiceServer interface {
 	ForceUnloadTaskQueue(context.Context, *ForceUnloadTaskQueueRequest) (*ForceUnloadTaskQueueResponse, error)
 	// Force unloading a task queue partition.
 	ForceUnloadTaskQueuePartition(context.Context, *ForceUnloadTaskQueuePartitionRequest) (*ForceUnloadTaskQueuePartitionResponse, error)
+	// Moves, or copies, the persisted backlog tasks selected by the filters from a task queue partition to another
+	// task queue. Routed to the owner of the source partition. Moves one page of the backlog per call.
+	MoveTaskQueueTasks(context.Context, *MoveTaskQueueTasksRequest) (*MoveTaskQueueTasksResponse, error)
+	// Releases the in-flight slot a task holds in the limits of its worker, once the worker responded to the task.
+	// Routed to the owner of the partition that dispatched the task.
+	ReleaseWorkerInFlightTask(context.Context, *ReleaseWorkerInFlightTaskRequest) (*ReleaseWorkerInFlightTaskResponse, error)
+	// Sets the dispatch limits of the workers polling a task queue type in the task queue user data.
+	// Routed to user data owner (root partition of workflow task queue).
+	UpdateWorkerDispatchLimits(context.Context, *UpdateWorkerDispatchLimitsRequest) (*UpdateWorkerDispatchLimitsResponse, error)
 	// Update task queue user data in owning node for all updates in namespace.
 	// All user data updates must first go through the task queue owner using the `UpdateWorkerBuildIdCompatibility`
 	// API.
@@ -708,6 +756,15 @@ func (UnimplementedMatchingServiceServer) ForceUnloadTaskQueue(context.Context,
 func (UnimplementedMatchingServiceServer) ForceUnloadTaskQueuePartition(context.Context, *ForceUnloadTaskQueuePartitionRequest) (*ForceUnloadTaskQueuePartitionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ForceUnloadTaskQueuePartition not implemented")
 }
+func (UnimplementedMatchingServiceServer) MoveTaskQueueTasks(context.Context, *MoveTaskQueueTasksRequest) (*MoveTaskQueueTasksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MoveTaskQueueTasks not implemented")
+}
+func (UnimplementedMatchingServiceServer) ReleaseWorkerInFlightTask(context.Context, *ReleaseWorkerInFlightTaskRequest) (*ReleaseWorkerInFlightTaskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReleaseWorkerInFlightTask not implemented")
+}
+func (UnimplementedMatchingServiceServer) UpdateWorkerDispatchLimits(context.Context, *UpdateWorkerDispatchLimitsRequest) (*UpdateWorkerDispatchLimitsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateWorkerDispatchLimits not implemented")
+}
 func (UnimplementedMatchingServiceServer) UpdateTaskQueueUserData(context.Context, *UpdateTaskQueueUserDataRequest) (*UpdateTaskQueueUserDataResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateTaskQueueUserData not implemented")
 }
@@ -1192,6 +1249,60 @@ func _MatchingService_ForceUnloadTaskQueuePartition_Handler(srv interface{}, ctx
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MatchingService_MoveTaskQueueTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MoveTaskQueueTasksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MatchingServiceServer).MoveTaskQueueTasks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MatchingService_MoveTaskQueueTasks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MatchingServiceServer).MoveTaskQueueTasks(ctx, req.(*MoveTaskQueueTasksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MatchingService_ReleaseWorkerInFlightTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReleaseWorkerInFlightTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MatchingServiceServer).ReleaseWorkerInFlightTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MatchingService_ReleaseWorkerInFlightTask_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MatchingServiceServer).ReleaseWorkerInFlightTask(ctx, req.(*ReleaseWorkerInFlightTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MatchingService_UpdateWorkerDispatchLimits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateWorkerDispatchLimitsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MatchingServiceServer).UpdateWorkerDispatchLimits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MatchingService_UpdateWorkerDispatchLimits_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MatchingServiceServer).UpdateWorkerDispatchLimits(ctx, req.(*UpdateWorkerDispatchLimitsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MatchingService_UpdateTaskQueueUserData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(UpdateTaskQueueUserDataRequest)
 	if err := dec(in); err != nil {
@@ -1425,6 +1536,18 @@ var MatchingService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ForceUnloadTaskQueuePartition",
 			Handler:    _MatchingService_ForceUnloadTaskQueuePartition_Handler,
 		},
+		{
+			MethodName: "MoveTaskQueueTasks",
+			Handler:    _MatchingService_MoveTaskQueueTasks_Handler,
+		},
+		{
+			MethodName: "ReleaseWorkerInFlightTask",
+			Handler:    _MatchingService_ReleaseWorkerInFlightTask_Handler,
+		},
+		{
+			MethodName: "UpdateWorkerDispatchLimits",
+			Handler:    _MatchingService_UpdateWorkerDispatchLimits_Handler,
+		},
 		{
 			MethodName: "UpdateTaskQueueUserData",
 			Handler:    _MatchingService_UpdateTaskQueueUserData_Handler,
@@ -422,6 +422,26 @@ func (mr *MockMatchingServiceClientMockRecorder) ListTaskQueuePartitions(ctx, in
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTaskQueuePartitions", reflect.TypeOf((*MockMatchingServiceClient)(nil).ListTaskQueuePartitions), varargs...)
 }
 
+// MoveTaskQueueTasks mocks base method.
+func (m *MockMatchingServiceClient) MoveTaskQueueTasks(ctx context.Context, in *matchingservice.MoveTaskQueueTasksRequest, opts ...grpc.CallOption) (*matchingservice.MoveTaskQueueTasksResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "MoveTaskQueueTasks", varargs...)
+	ret0, _ := ret[0].(*matchingservice.MoveTaskQueueTasksResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MoveTaskQueueTasks indicates an expected call of MoveTaskQueueTasks.
+func (mr *MockMatchingServiceClientMockRecorder) MoveTaskQueueTasks(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveTaskQueueTasks", reflect.TypeOf((*MockMatchingServiceClient)(nil).MoveTaskQueueTasks), varargs...)
+}
+
 // PollActivityTaskQueue mocks base method.
 func (m *MockMatchingServiceClient) PollActivityTaskQueue(ctx context.Context, in *matchingservice.PollActivityTaskQueueRequest, opts ...grpc.CallOption) (*matchingservice.PollActivityTaskQueueResponse, error) {
 	m.ctrl.T.Helper()
@@ -502,6 +522,26 @@ func (mr *MockMatchingServiceClientMockRecorder) QueryWorkflow(ctx, in any, opts
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryWorkflow", reflect.TypeOf((*MockMatchingServiceClient)(nil).QueryWorkflow), varargs...)
 }
 
+// ReleaseWorkerInFlightTask mocks base method.
+func (m *MockMatchingServiceClient) ReleaseWorkerInFlightTask(ctx context.Context, in *matchingservice.ReleaseWorkerInFlightTaskRequest, opts ...grpc.CallOption) (*matchingservice.ReleaseWorkerInFlightTaskResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ReleaseWorkerInFlightTask", varargs...)
+	ret0, _ := ret[0].(*matchingservice.ReleaseWorkerInFlightTaskResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReleaseWorkerInFlightTask indicates an expected call of ReleaseWorkerInFlightTask.
+func (mr *MockMatchingServiceClientMockRecorder) ReleaseWorkerInFlightTask(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseWorkerInFlightTask", reflect.TypeOf((*MockMatchingServiceClient)(nil).ReleaseWorkerInFlightTask), varargs...)
+}
+
 // ReplicateTaskQueueUserData mocks base method.
 func (m *MockMatchingServiceClient) ReplicateTaskQueueUserData(ctx context.Context, in *matchingservice.ReplicateTaskQueueUserDataRequest, opts ...grpc.CallOption) (*matchingservice.ReplicateTaskQueueUserDataResponse, error) {
 	m.ctrl.T.Helper()
@@ -662,6 +702,26 @@ func (mr *MockMatchingServiceClientMockRecorder) UpdateWorkerBuildIdCompatibilit
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWorkerBuildIdCompatibility", reflect.TypeOf((*MockMatchingServiceClient)(nil).UpdateWorkerBuildIdCompatibility), varargs...)
 }
 
+// UpdateWorkerDispatchLimits mocks base method.
+func (m *MockMatchingServiceClient) UpdateWorkerDispatchLimits(ctx context.Context, in *matchingservice.UpdateWorkerDispatchLimitsRequest, opts ...grpc.CallOption) (*matchingservice.UpdateWorkerDispatchLimitsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateWorkerDispatchLimits", varargs...)
+	ret0, _ := ret[0].(*matchingservice.UpdateWorkerDispatchLimitsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateWorkerDispatchLimits indicates an expected call of UpdateWorkerDispatchLimits.
+func (mr *MockMatchingServiceClientMockRecorder) UpdateWorkerDispatchLimits(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWorkerDispatchLimits", reflect.TypeOf((*MockMatchingServiceClient)(nil).UpdateWorkerDispatchLimits), varargs...)
+}
+
 // UpdateWorkerVersioningRules mocks base method.
 func (m *MockMatchingServiceClient) UpdateWorkerVersioningRules(ctx context.Context, in *matchingservice.UpdateWorkerVersioningRulesRequest, opts ...grpc.CallOption) (*matchingservice.UpdateWorkerVersioningRulesResponse, error) {
 	m.ctrl.T.Helper()
@@ -991,6 +1051,21 @@ func (mr *MockMatchingServiceServerMockRecorder) ListTaskQueuePartitions(arg0, a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTaskQueuePartitions", reflect.TypeOf((*MockMatchingServiceServer)(nil).ListTaskQueuePartitions), arg0, arg1)
 }
 
+// MoveTaskQueueTasks mocks base method.
+func (m *MockMatchingServiceServer) MoveTaskQueueTasks(arg0 context.Context, arg1 *matchingservice.MoveTaskQueueTasksRequest) (*matchingservice.MoveTaskQueueTasksResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MoveTaskQueueTasks", arg0, arg1)
+	ret0, _ := ret[0].(*matchingservice.MoveTaskQueueTasksResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MoveTaskQueueTasks indicates an expected call of MoveTaskQueueTasks.
+func (mr *MockMatchingServiceServerMockRecorder) MoveTaskQueueTasks(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveTaskQueueTasks", reflect.TypeOf((*MockMatchingServiceServer)(nil).MoveTaskQueueTasks), arg0, arg1)
+}
+
 // PollActivityTaskQueue mocks base method.
 func (m *MockMatchingServiceServer) PollActivityTaskQueue(arg0 context.Context, arg1 *matchingservice.PollActivityTaskQueueRequest) (*matchingservice.PollActivityTaskQueueResponse, error) {
 	m.ctrl.T.Helper()
@@ -1051,6 +1126,21 @@ func (mr *MockMatchingServiceServerMockRecorder) QueryWorkflow(arg0, arg1 any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryWorkflow", reflect.TypeOf((*MockMatchingServiceServer)(nil).QueryWorkflow), arg0, arg1)
 }
 
+// ReleaseWorkerInFlightTask mocks base method.
+func (m *MockMatchingServiceServer) ReleaseWorkerInFlightTask(arg0 context.Context, arg1 *matchingservice.ReleaseWorkerInFlightTaskRequest) (*matchingservice.ReleaseWorkerInFlightTaskResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseWorkerInFlightTask", arg0, arg1)
+	ret0, _ := ret[0].(*matchingservice.ReleaseWorkerInFlightTaskResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReleaseWorkerInFlightTask indicates an expected call of ReleaseWorkerInFlightTask.
+func (mr *MockMatchingServiceServerMockRecorder) ReleaseWorkerInFlightTask(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseWorkerInFlightTask", reflect.TypeOf((*MockMatchingServiceServer)(nil).ReleaseWorkerInFlightTask), arg0, arg1)
+}
+
 // ReplicateTaskQueueUserData mocks base method.
 func (m *MockMatchingServiceServer) ReplicateTaskQueueUserData(arg0 context.Context, arg1 *matchingservice.ReplicateTaskQueueUserDataRequest) (*matchingservice.ReplicateTaskQueueUserDataResponse, error) {
 	m.ctrl.T.Helper()
@@ -1171,6 +1261,21 @@ func (mr *MockMatchingServiceServerMockRecorder) UpdateWorkerBuildIdCompatibilit
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWorkerBuildIdCompatibility", reflect.TypeOf((*MockMatchingServiceServer)(nil).UpdateWorkerBuildIdCompatibility), arg0, arg1)
 }
 
+// UpdateWorkerDispatchLimits mocks base method.
+func (m *MockMatchingServiceServer) UpdateWorkerDispatchLimits(arg0 context.Context, arg1 *matchingservice.UpdateWorkerDispatchLimitsRequest) (*matchingservice.UpdateWorkerDispatchLimitsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateWorkerDispatchLimits", arg0, arg1)
+	ret0, _ := ret[0].(*matchingservice.UpdateWorkerDispatchLimitsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateWorkerDispatchLimits indicates an expected call of UpdateWorkerDispatchLimits.
+func (mr *MockMatchingServiceServerMockRecorder) UpdateWorkerDispatchLimits(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWorkerDispatchLimits", reflect.TypeOf((*MockMatchingServiceServer)(nil).UpdateWorkerDispatchLimits), arg0, arg1)
+}
+
 // UpdateWorkerVersioningRules mocks base method.
 func (m *MockMatchingServiceServer) UpdateWorkerVersioningRules(arg0 context.Context, arg1 *matchingservice.UpdateWorkerVersioningRulesRequest) (*matchingservice.UpdateWorkerVersioningRulesResponse, error) {
 	m.ctrl.T.Helper()
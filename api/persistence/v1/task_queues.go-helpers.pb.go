@@ -227,6 +227,80 @@ func (this *DeploymentData) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
+// Marshal an object of type WorkerDispatchLimit to the protobuf v3 wire format
+func (val *WorkerDispatchLimit) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type WorkerDispatchLimit from the protobuf v3 wire format
+func (val *WorkerDispatchLimit) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *WorkerDispatchLimit) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two WorkerDispatchLimit values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *WorkerDispatchLimit) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *WorkerDispatchLimit
+	switch t := that.(type) {
+	case *WorkerDispatchLimit:
+		that1 = t
+	case WorkerDispatchLimit:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type WorkerDispatchLimits to the protobuf v3 wire format
+func (val *WorkerDispatchLimits) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type WorkerDispatchLimits from the protobuf v3 wire format
+func (val *WorkerDispatchLimits) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *WorkerDispatchLimits) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two WorkerDispatchLimits values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *WorkerDispatchLimits) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *WorkerDispatchLimits
+	switch t := that.(type) {
+	case *WorkerDispatchLimits:
+		that1 = t
+	case WorkerDispatchLimits:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
 // Marshal an object of type TaskQueueTypeUserData to the protobuf v3 wire format
 func (val *TaskQueueTypeUserData) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
@@ -11,6 +11,7 @@ import (
 	sync "sync"
 	unsafe "unsafe"
 
+	v13 "go.temporal.io/api/taskqueue/v1"
 	v12 "go.temporal.io/server/api/clock/v1"
 	v1 "go.temporal.io/server/api/history/v1"
 	v11 "go.temporal.io/server/api/persistence/v1"
@@ -256,8 +257,12 @@ type Task struct {
 	StartedEventId   int64                  `protobuf:"varint,10,opt,name=started_event_id,json=startedEventId,proto3" json:"started_event_id,omitempty"`
 	Version          int64                  `protobuf:"varint,11,opt,name=version,proto3" json:"version,omitempty"`
 	StartedTime      *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=started_time,json=startedTime,proto3" json:"started_time,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+	// Set when the task counts towards the in-flight limit of its worker in matching, so that
+	// it's released in the task queue partition that dispatched it when the worker responds.
+	InFlightTaskQueue *v13.TaskQueue `protobuf:"bytes,13,opt,name=in_flight_task_queue,json=inFlightTaskQueue,proto3" json:"in_flight_task_queue,omitempty"`
+	InFlightId        string         `protobuf:"bytes,14,opt,name=in_flight_id,json=inFlightId,proto3" json:"in_flight_id,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
 func (x *Task) Reset() {
@@ -374,6 +379,20 @@ func (x *Task) GetStartedTime() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *Task) GetInFlightTaskQueue() *v13.TaskQueue {
+	if x != nil {
+		return x.InFlightTaskQueue
+	}
+	return nil
+}
+
+func (x *Task) GetInFlightId() string {
+	if x != nil {
+		return x.InFlightId
+	}
+	return ""
+}
+
 type QueryTask struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	NamespaceId   string                 `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
@@ -637,7 +656,7 @@ var File_temporal_server_api_token_v1_message_proto protoreflect.FileDescriptor
 
 const file_temporal_server_api_token_v1_message_proto_rawDesc = "" +
 	"\n" +
-	"*temporal/server/api/token/v1/message.proto\x12\x1ctemporal.server.api.token.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a*temporal/server/api/clock/v1/message.proto\x1a,temporal/server/api/history/v1/message.proto\x1a,temporal/server/api/persistence/v1/hsm.proto\"\xc1\x04\n" +
+	"*temporal/server/api/token/v1/message.proto\x12\x1ctemporal.server.api.token.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a'temporal/api/taskqueue/v1/message.proto\x1a*temporal/server/api/clock/v1/message.proto\x1a,temporal/server/api/history/v1/message.proto\x1a,temporal/server/api/persistence/v1/hsm.proto\"\xc1\x04\n" +
 	"\x13HistoryContinuation\x12\x15\n" +
 	"\x06run_id\x18\x01 \x01(\tR\x05runId\x12$\n" +
 	"\x0efirst_event_id\x18\x02 \x01(\x03R\ffirstEventId\x12\"\n" +
@@ -662,7 +681,7 @@ const file_temporal_server_api_token_v1_message_proto_rawDesc = "" +
 	"endEventId\x12*\n" +
 	"\x11end_event_version\x18\a \x01(\x03R\x0fendEventVersion\x12+\n" +
 	"\x11persistence_token\x18\b \x01(\fR\x10persistenceToken\x12]\n" +
-	"\x11version_histories\x18\t \x01(\v20.temporal.server.api.history.v1.VersionHistoriesR\x10versionHistoriesJ\x04\b\x01\x10\x02\"\xd8\x03\n" +
+	"\x11version_histories\x18\t \x01(\v20.temporal.server.api.history.v1.VersionHistoriesR\x10versionHistoriesJ\x04\b\x01\x10\x02\"\xd1\x04\n" +
 	"\x04Task\x12!\n" +
 	"\fnamespace_id\x18\x01 \x01(\tR\vnamespaceId\x12\x1f\n" +
 	"\vworkflow_id\x18\x02 \x01(\tR\n" +
@@ -678,7 +697,10 @@ const file_temporal_server_api_token_v1_message_proto_rawDesc = "" +
 	"\x10started_event_id\x18\n" +
 	" \x01(\x03R\x0estartedEventId\x12\x18\n" +
 	"\aversion\x18\v \x01(\x03R\aversion\x12=\n" +
-	"\fstarted_time\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\vstartedTime\"f\n" +
+	"\fstarted_time\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\vstartedTime\x12U\n" +
+	"\x14in_flight_task_queue\x18\r \x01(\v2$.temporal.api.taskqueue.v1.TaskQueueR\x11inFlightTaskQueue\x12 \n" +
+	"\fin_flight_id\x18\x0e \x01(\tR\n" +
+	"inFlightId\"f\n" +
 	"\tQueryTask\x12!\n" +
 	"\fnamespace_id\x18\x01 \x01(\tR\vnamespaceId\x12\x1d\n" +
 	"\n" +
@@ -728,7 +750,8 @@ var file_temporal_server_api_token_v1_message_proto_goTypes = []any{
 	(*v1.VersionHistories)(nil),          // 10: temporal.server.api.history.v1.VersionHistories
 	(*v12.VectorClock)(nil),              // 11: temporal.server.api.clock.v1.VectorClock
 	(*timestamppb.Timestamp)(nil),        // 12: google.protobuf.Timestamp
-	(*v11.StateMachineRef)(nil),          // 13: temporal.server.api.persistence.v1.StateMachineRef
+	(*v13.TaskQueue)(nil),                // 13: temporal.api.taskqueue.v1.TaskQueue
+	(*v11.StateMachineRef)(nil),          // 14: temporal.server.api.persistence.v1.StateMachineRef
 }
 var file_temporal_server_api_token_v1_message_proto_depIdxs = []int32{
 	7,  // 0: temporal.server.api.token.v1.HistoryContinuation.transient_workflow_task:type_name -> temporal.server.api.history.v1.TransientWorkflowTaskInfo
@@ -737,12 +760,13 @@ var file_temporal_server_api_token_v1_message_proto_depIdxs = []int32{
 	10, // 3: temporal.server.api.token.v1.RawHistoryContinuation.version_histories:type_name -> temporal.server.api.history.v1.VersionHistories
 	11, // 4: temporal.server.api.token.v1.Task.clock:type_name -> temporal.server.api.clock.v1.VectorClock
 	12, // 5: temporal.server.api.token.v1.Task.started_time:type_name -> google.protobuf.Timestamp
-	13, // 6: temporal.server.api.token.v1.NexusOperationCompletion.ref:type_name -> temporal.server.api.persistence.v1.StateMachineRef
-	7,  // [7:7] is the sub-list for method output_type
-	7,  // [7:7] is the sub-list for method input_type
-	7,  // [7:7] is the sub-list for extension type_name
-	7,  // [7:7] is the sub-list for extension extendee
-	0,  // [0:7] is the sub-list for field type_name
+	13, // 6: temporal.server.api.token.v1.Task.in_flight_task_queue:type_name -> temporal.api.taskqueue.v1.TaskQueue
+	14, // 7: temporal.server.api.token.v1.NexusOperationCompletion.ref:type_name -> temporal.server.api.persistence.v1.StateMachineRef
+	8,  // [8:8] is the sub-list for method output_type
+	8,  // [8:8] is the sub-list for method input_type
+	8,  // [8:8] is the sub-list for extension type_name
+	8,  // [8:8] is the sub-list for extension extendee
+	0,  // [0:8] is the sub-list for field type_name
 }
 
 func init() { file_temporal_server_api_token_v1_message_proto_init() }
@@ -5,7 +5,7 @@ import (
 
 	"go.temporal.io/api/serviceerror"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
-	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/shardmap"
 )
 
 var (
@@ -22,7 +22,6 @@ type EntityKey struct {
 type ComponentRef struct {
 	EntityKey
 
-	shardID int32
 	// archetype is the fully qualified type name of the root component.
 	// It is used to look up the component's registered sharding function,
 	// which determines the shardID of the entity that contains the referenced component.
@@ -98,24 +97,16 @@ func (r *ComponentRef) ShardingKey(
 }
 
 // ShardID returns the shardID of the run that contains the referenced component
-// given the total number of shards in the system.
+// in the given shard map. It isn't cached, the shard of a run changes when its shard is split.
 func (r *ComponentRef) ShardID(
 	registry *Registry,
-	numberOfShards int32,
+	shardMap *shardmap.ShardMap,
 ) (int32, error) {
-	if r.shardID != 0 {
-		return r.shardID, nil
-	}
 	shardingKey, err := r.ShardingKey(registry)
 	if err != nil {
 		return 0, err
 	}
-
-	r.shardID = common.ShardingKeyToShard(
-		shardingKey,
-		numberOfShards,
-	)
-	return r.shardID, nil
+	return shardMap.ShardingKeyToShard(shardingKey), nil
 }
 
 func (r *ComponentRef) serialize() ([]byte, error) {
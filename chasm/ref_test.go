@@ -6,6 +6,8 @@ import (
 
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/shardmap"
 	"go.temporal.io/server/common/testing/testvars"
 	"go.uber.org/mock/gomock"
 )
@@ -62,10 +64,20 @@ func (s *componentRefSuite) TestShardID() {
 	}
 	ref := NewComponentRef[*TestComponent](entityKey)
 
-	shardID, err := ref.ShardID(s.registry, 10)
+	shardingKey, err := ref.ShardingKey(s.registry)
+	s.NoError(err)
+
+	shardMap := shardmap.NewShardMap(10)
+	shardID, err := ref.ShardID(s.registry, shardMap)
 	s.NoError(err)
+	s.Equal(common.ShardingKeyToShard(shardingKey, 10), shardID)
 
-	// Here we are just checking that the shardID field is populated in the ref.
-	// The actual shardID value is not that important.
-	s.Equal(shardID, ref.shardID)
+	// The shard changes once the shard of the run is split.
+	shardMap, err = shardMap.StartSplit(2)
+	s.NoError(err)
+	shardMap, err = shardMap.CompleteShardSplit(shardID)
+	s.NoError(err)
+	shardID, err = ref.ShardID(s.registry, shardMap)
+	s.NoError(err)
+	s.Equal(common.ShardingKeyToShard(shardingKey, 20), shardID)
 }
@@ -39,6 +39,26 @@ func (c *clientImpl) AddTasks(
 	return c.client.AddTasks(ctx, request, opts...)
 }
 
+func (c *clientImpl) AdvanceNamespaceClock(
+	ctx context.Context,
+	request *adminservice.AdvanceNamespaceClockRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.AdvanceNamespaceClockResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.AdvanceNamespaceClock(ctx, request, opts...)
+}
+
+func (c *clientImpl) BatchPauseWorkflowExecutions(
+	ctx context.Context,
+	request *adminservice.BatchPauseWorkflowExecutionsRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.BatchPauseWorkflowExecutionsResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.BatchPauseWorkflowExecutions(ctx, request, opts...)
+}
+
 func (c *clientImpl) CancelDLQJob(
 	ctx context.Context,
 	request *adminservice.CancelDLQJobRequest,
@@ -49,6 +69,16 @@ func (c *clientImpl) CancelDLQJob(
 	return c.client.CancelDLQJob(ctx, request, opts...)
 }
 
+func (c *clientImpl) ChangeSearchAttributeType(
+	ctx context.Context,
+	request *adminservice.ChangeSearchAttributeTypeRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ChangeSearchAttributeTypeResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.ChangeSearchAttributeType(ctx, request, opts...)
+}
+
 func (c *clientImpl) CloseShard(
 	ctx context.Context,
 	request *adminservice.CloseShardRequest,
@@ -119,6 +149,16 @@ func (c *clientImpl) DescribeMutableState(
 	return c.client.DescribeMutableState(ctx, request, opts...)
 }
 
+func (c *clientImpl) DescribeSearchAttributeTypeChange(
+	ctx context.Context,
+	request *adminservice.DescribeSearchAttributeTypeChangeRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DescribeSearchAttributeTypeChangeResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.DescribeSearchAttributeTypeChange(ctx, request, opts...)
+}
+
 func (c *clientImpl) DescribeTaskQueuePartition(
 	ctx context.Context,
 	request *adminservice.DescribeTaskQueuePartitionRequest,
@@ -129,6 +169,36 @@ func (c *clientImpl) DescribeTaskQueuePartition(
 	return c.client.DescribeTaskQueuePartition(ctx, request, opts...)
 }
 
+func (c *clientImpl) DescribeVisibilityRebuild(
+	ctx context.Context,
+	request *adminservice.DescribeVisibilityRebuildRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DescribeVisibilityRebuildResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.DescribeVisibilityRebuild(ctx, request, opts...)
+}
+
+func (c *clientImpl) DescribeWorkerDispatchLimits(
+	ctx context.Context,
+	request *adminservice.DescribeWorkerDispatchLimitsRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DescribeWorkerDispatchLimitsResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.DescribeWorkerDispatchLimits(ctx, request, opts...)
+}
+
+func (c *clientImpl) ExplainVisibilityQuery(
+	ctx context.Context,
+	request *adminservice.ExplainVisibilityQueryRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ExplainVisibilityQueryResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.ExplainVisibilityQuery(ctx, request, opts...)
+}
+
 func (c *clientImpl) ForceUnloadTaskQueuePartition(
 	ctx context.Context,
 	request *adminservice.ForceUnloadTaskQueuePartitionRequest,
@@ -139,6 +209,16 @@ func (c *clientImpl) ForceUnloadTaskQueuePartition(
 	return c.client.ForceUnloadTaskQueuePartition(ctx, request, opts...)
 }
 
+func (c *clientImpl) ForkWorkflowExecution(
+	ctx context.Context,
+	request *adminservice.ForkWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ForkWorkflowExecutionResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.ForkWorkflowExecution(ctx, request, opts...)
+}
+
 func (c *clientImpl) GenerateLastHistoryReplicationTasks(
 	ctx context.Context,
 	request *adminservice.GenerateLastHistoryReplicationTasksRequest,
@@ -329,6 +409,36 @@ func (c *clientImpl) MergeDLQTasks(
 	return c.client.MergeDLQTasks(ctx, request, opts...)
 }
 
+func (c *clientImpl) MoveTaskQueueTasks(
+	ctx context.Context,
+	request *adminservice.MoveTaskQueueTasksRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.MoveTaskQueueTasksResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.MoveTaskQueueTasks(ctx, request, opts...)
+}
+
+func (c *clientImpl) PauseWorkflowExecution(
+	ctx context.Context,
+	request *adminservice.PauseWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.PauseWorkflowExecutionResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.PauseWorkflowExecution(ctx, request, opts...)
+}
+
+func (c *clientImpl) PreviewResetWorkflowExecution(
+	ctx context.Context,
+	request *adminservice.PreviewResetWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.PreviewResetWorkflowExecutionResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.PreviewResetWorkflowExecution(ctx, request, opts...)
+}
+
 func (c *clientImpl) PurgeDLQMessages(
 	ctx context.Context,
 	request *adminservice.PurgeDLQMessagesRequest,
@@ -369,6 +479,16 @@ func (c *clientImpl) RebuildMutableState(
 	return c.client.RebuildMutableState(ctx, request, opts...)
 }
 
+func (c *clientImpl) RebuildVisibility(
+	ctx context.Context,
+	request *adminservice.RebuildVisibilityRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.RebuildVisibilityResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.RebuildVisibility(ctx, request, opts...)
+}
+
 func (c *clientImpl) RefreshWorkflowTasks(
 	ctx context.Context,
 	request *adminservice.RefreshWorkflowTasksRequest,
@@ -428,3 +548,23 @@ func (c *clientImpl) SyncWorkflowState(
 	defer cancel()
 	return c.client.SyncWorkflowState(ctx, request, opts...)
 }
+
+func (c *clientImpl) UnpauseWorkflowExecution(
+	ctx context.Context,
+	request *adminservice.UnpauseWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.UnpauseWorkflowExecutionResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.UnpauseWorkflowExecution(ctx, request, opts...)
+}
+
+func (c *clientImpl) UpdateWorkerDispatchLimits(
+	ctx context.Context,
+	request *adminservice.UpdateWorkerDispatchLimitsRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.UpdateWorkerDispatchLimitsResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.UpdateWorkerDispatchLimits(ctx, request, opts...)
+}
@@ -51,6 +51,34 @@ func (c *metricClient) AddTasks(
 	return c.client.AddTasks(ctx, request, opts...)
 }
 
+func (c *metricClient) AdvanceNamespaceClock(
+	ctx context.Context,
+	request *adminservice.AdvanceNamespaceClockRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.AdvanceNamespaceClockResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientAdvanceNamespaceClock")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.AdvanceNamespaceClock(ctx, request, opts...)
+}
+
+func (c *metricClient) BatchPauseWorkflowExecutions(
+	ctx context.Context,
+	request *adminservice.BatchPauseWorkflowExecutionsRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.BatchPauseWorkflowExecutionsResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientBatchPauseWorkflowExecutions")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.BatchPauseWorkflowExecutions(ctx, request, opts...)
+}
+
 func (c *metricClient) CancelDLQJob(
 	ctx context.Context,
 	request *adminservice.CancelDLQJobRequest,
@@ -65,6 +93,20 @@ func (c *metricClient) CancelDLQJob(
 	return c.client.CancelDLQJob(ctx, request, opts...)
 }
 
+func (c *metricClient) ChangeSearchAttributeType(
+	ctx context.Context,
+	request *adminservice.ChangeSearchAttributeTypeRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.ChangeSearchAttributeTypeResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientChangeSearchAttributeType")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.ChangeSearchAttributeType(ctx, request, opts...)
+}
+
 func (c *metricClient) CloseShard(
 	ctx context.Context,
 	request *adminservice.CloseShardRequest,
@@ -163,6 +205,20 @@ func (c *metricClient) DescribeMutableState(
 	return c.client.DescribeMutableState(ctx, request, opts...)
 }
 
+func (c *metricClient) DescribeSearchAttributeTypeChange(
+	ctx context.Context,
+	request *adminservice.DescribeSearchAttributeTypeChangeRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.DescribeSearchAttributeTypeChangeResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientDescribeSearchAttributeTypeChange")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.DescribeSearchAttributeTypeChange(ctx, request, opts...)
+}
+
 func (c *metricClient) DescribeTaskQueuePartition(
 	ctx context.Context,
 	request *adminservice.DescribeTaskQueuePartitionRequest,
@@ -177,6 +233,48 @@ func (c *metricClient) DescribeTaskQueuePartition(
 	return c.client.DescribeTaskQueuePartition(ctx, request, opts...)
 }
 
+func (c *metricClient) DescribeVisibilityRebuild(
+	ctx context.Context,
+	request *adminservice.DescribeVisibilityRebuildRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.DescribeVisibilityRebuildResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientDescribeVisibilityRebuild")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.DescribeVisibilityRebuild(ctx, request, opts...)
+}
+
+func (c *metricClient) DescribeWorkerDispatchLimits(
+	ctx context.Context,
+	request *adminservice.DescribeWorkerDispatchLimitsRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.DescribeWorkerDispatchLimitsResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientDescribeWorkerDispatchLimits")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.DescribeWorkerDispatchLimits(ctx, request, opts...)
+}
+
+func (c *metricClient) ExplainVisibilityQuery(
+	ctx context.Context,
+	request *adminservice.ExplainVisibilityQueryRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.ExplainVisibilityQueryResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientExplainVisibilityQuery")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.ExplainVisibilityQuery(ctx, request, opts...)
+}
+
 func (c *metricClient) ForceUnloadTaskQueuePartition(
 	ctx context.Context,
 	request *adminservice.ForceUnloadTaskQueuePartitionRequest,
@@ -191,6 +289,20 @@ func (c *metricClient) ForceUnloadTaskQueuePartition(
 	return c.client.ForceUnloadTaskQueuePartition(ctx, request, opts...)
 }
 
+func (c *metricClient) ForkWorkflowExecution(
+	ctx context.Context,
+	request *adminservice.ForkWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.ForkWorkflowExecutionResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientForkWorkflowExecution")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.ForkWorkflowExecution(ctx, request, opts...)
+}
+
 func (c *metricClient) GenerateLastHistoryReplicationTasks(
 	ctx context.Context,
 	request *adminservice.GenerateLastHistoryReplicationTasksRequest,
@@ -457,6 +569,48 @@ func (c *metricClient) MergeDLQTasks(
 	return c.client.MergeDLQTasks(ctx, request, opts...)
 }
 
+func (c *metricClient) MoveTaskQueueTasks(
+	ctx context.Context,
+	request *adminservice.MoveTaskQueueTasksRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.MoveTaskQueueTasksResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientMoveTaskQueueTasks")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.MoveTaskQueueTasks(ctx, request, opts...)
+}
+
+func (c *metricClient) PauseWorkflowExecution(
+	ctx context.Context,
+	request *adminservice.PauseWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.PauseWorkflowExecutionResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientPauseWorkflowExecution")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.PauseWorkflowExecution(ctx, request, opts...)
+}
+
+func (c *metricClient) PreviewResetWorkflowExecution(
+	ctx context.Context,
+	request *adminservice.PreviewResetWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.PreviewResetWorkflowExecutionResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientPreviewResetWorkflowExecution")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.PreviewResetWorkflowExecution(ctx, request, opts...)
+}
+
 func (c *metricClient) PurgeDLQMessages(
 	ctx context.Context,
 	request *adminservice.PurgeDLQMessagesRequest,
@@ -513,6 +667,20 @@ func (c *metricClient) RebuildMutableState(
 	return c.client.RebuildMutableState(ctx, request, opts...)
 }
 
+func (c *metricClient) RebuildVisibility(
+	ctx context.Context,
+	request *adminservice.RebuildVisibilityRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.RebuildVisibilityResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientRebuildVisibility")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.RebuildVisibility(ctx, request, opts...)
+}
+
 func (c *metricClient) RefreshWorkflowTasks(
 	ctx context.Context,
 	request *adminservice.RefreshWorkflowTasksRequest,
@@ -596,3 +764,31 @@ func (c *metricClient) SyncWorkflowState(
 
 	return c.client.SyncWorkflowState(ctx, request, opts...)
 }
+
+func (c *metricClient) UnpauseWorkflowExecution(
+	ctx context.Context,
+	request *adminservice.UnpauseWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.UnpauseWorkflowExecutionResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientUnpauseWorkflowExecution")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.UnpauseWorkflowExecution(ctx, request, opts...)
+}
+
+func (c *metricClient) UpdateWorkerDispatchLimits(
+	ctx context.Context,
+	request *adminservice.UpdateWorkerDispatchLimitsRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.UpdateWorkerDispatchLimitsResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientUpdateWorkerDispatchLimits")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.UpdateWorkerDispatchLimits(ctx, request, opts...)
+}
@@ -56,6 +56,36 @@ func (c *retryableClient) AddTasks(
 	return resp, err
 }
 
+func (c *retryableClient) AdvanceNamespaceClock(
+	ctx context.Context,
+	request *adminservice.AdvanceNamespaceClockRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.AdvanceNamespaceClockResponse, error) {
+	var resp *adminservice.AdvanceNamespaceClockResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.AdvanceNamespaceClock(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) BatchPauseWorkflowExecutions(
+	ctx context.Context,
+	request *adminservice.BatchPauseWorkflowExecutionsRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.BatchPauseWorkflowExecutionsResponse, error) {
+	var resp *adminservice.BatchPauseWorkflowExecutionsResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.BatchPauseWorkflowExecutions(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) CancelDLQJob(
 	ctx context.Context,
 	request *adminservice.CancelDLQJobRequest,
@@ -71,6 +101,21 @@ func (c *retryableClient) CancelDLQJob(
 	return resp, err
 }
 
+func (c *retryableClient) ChangeSearchAttributeType(
+	ctx context.Context,
+	request *adminservice.ChangeSearchAttributeTypeRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ChangeSearchAttributeTypeResponse, error) {
+	var resp *adminservice.ChangeSearchAttributeTypeResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.ChangeSearchAttributeType(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) CloseShard(
 	ctx context.Context,
 	request *adminservice.CloseShardRequest,
@@ -176,6 +221,21 @@ func (c *retryableClient) DescribeMutableState(
 	return resp, err
 }
 
+func (c *retryableClient) DescribeSearchAttributeTypeChange(
+	ctx context.Context,
+	request *adminservice.DescribeSearchAttributeTypeChangeRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DescribeSearchAttributeTypeChangeResponse, error) {
+	var resp *adminservice.DescribeSearchAttributeTypeChangeResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.DescribeSearchAttributeTypeChange(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) DescribeTaskQueuePartition(
 	ctx context.Context,
 	request *adminservice.DescribeTaskQueuePartitionRequest,
@@ -191,6 +251,51 @@ func (c *retryableClient) DescribeTaskQueuePartition(
 	return resp, err
 }
 
+func (c *retryableClient) DescribeVisibilityRebuild(
+	ctx context.Context,
+	request *adminservice.DescribeVisibilityRebuildRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DescribeVisibilityRebuildResponse, error) {
+	var resp *adminservice.DescribeVisibilityRebuildResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.DescribeVisibilityRebuild(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) DescribeWorkerDispatchLimits(
+	ctx context.Context,
+	request *adminservice.DescribeWorkerDispatchLimitsRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DescribeWorkerDispatchLimitsResponse, error) {
+	var resp *adminservice.DescribeWorkerDispatchLimitsResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.DescribeWorkerDispatchLimits(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) ExplainVisibilityQuery(
+	ctx context.Context,
+	request *adminservice.ExplainVisibilityQueryRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ExplainVisibilityQueryResponse, error) {
+	var resp *adminservice.ExplainVisibilityQueryResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.ExplainVisibilityQuery(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) ForceUnloadTaskQueuePartition(
 	ctx context.Context,
 	request *adminservice.ForceUnloadTaskQueuePartitionRequest,
@@ -206,6 +311,21 @@ func (c *retryableClient) ForceUnloadTaskQueuePartition(
 	return resp, err
 }
 
+func (c *retryableClient) ForkWorkflowExecution(
+	ctx context.Context,
+	request *adminservice.ForkWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ForkWorkflowExecutionResponse, error) {
+	var resp *adminservice.ForkWorkflowExecutionResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.ForkWorkflowExecution(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) GenerateLastHistoryReplicationTasks(
 	ctx context.Context,
 	request *adminservice.GenerateLastHistoryReplicationTasksRequest,
@@ -491,6 +611,51 @@ func (c *retryableClient) MergeDLQTasks(
 	return resp, err
 }
 
+func (c *retryableClient) MoveTaskQueueTasks(
+	ctx context.Context,
+	request *adminservice.MoveTaskQueueTasksRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.MoveTaskQueueTasksResponse, error) {
+	var resp *adminservice.MoveTaskQueueTasksResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.MoveTaskQueueTasks(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) PauseWorkflowExecution(
+	ctx context.Context,
+	request *adminservice.PauseWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.PauseWorkflowExecutionResponse, error) {
+	var resp *adminservice.PauseWorkflowExecutionResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.PauseWorkflowExecution(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) PreviewResetWorkflowExecution(
+	ctx context.Context,
+	request *adminservice.PreviewResetWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.PreviewResetWorkflowExecutionResponse, error) {
+	var resp *adminservice.PreviewResetWorkflowExecutionResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.PreviewResetWorkflowExecution(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) PurgeDLQMessages(
 	ctx context.Context,
 	request *adminservice.PurgeDLQMessagesRequest,
@@ -551,6 +716,21 @@ func (c *retryableClient) RebuildMutableState(
 	return resp, err
 }
 
+func (c *retryableClient) RebuildVisibility(
+	ctx context.Context,
+	request *adminservice.RebuildVisibilityRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.RebuildVisibilityResponse, error) {
+	var resp *adminservice.RebuildVisibilityResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.RebuildVisibility(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) RefreshWorkflowTasks(
 	ctx context.Context,
 	request *adminservice.RefreshWorkflowTasksRequest,
@@ -640,3 +820,33 @@ func (c *retryableClient) SyncWorkflowState(
 	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
 	return resp, err
 }
+
+func (c *retryableClient) UnpauseWorkflowExecution(
+	ctx context.Context,
+	request *adminservice.UnpauseWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.UnpauseWorkflowExecutionResponse, error) {
+	var resp *adminservice.UnpauseWorkflowExecutionResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.UnpauseWorkflowExecution(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) UpdateWorkerDispatchLimits(
+	ctx context.Context,
+	request *adminservice.UpdateWorkerDispatchLimitsRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.UpdateWorkerDispatchLimitsResponse, error) {
+	var resp *adminservice.UpdateWorkerDispatchLimitsResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.UpdateWorkerDispatchLimits(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
@@ -20,6 +20,7 @@ import (
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/primitives"
+	"go.temporal.io/server/common/shardmap"
 	"go.temporal.io/server/common/testing/testhooks"
 	"google.golang.org/grpc"
 )
@@ -43,7 +44,7 @@ type (
 			metricsHandler metrics.Handler,
 			dc *dynamicconfig.Collection,
 			testHooks testhooks.TestHooks,
-			numberOfHistoryShards int32,
+			shardMapProvider *shardmap.Provider,
 			logger log.Logger,
 			throttledLogger log.Logger,
 		) Factory
@@ -53,14 +54,14 @@ type (
 	NamespaceIDToNameFunc func(id namespace.ID) (namespace.Name, error)
 
 	rpcClientFactory struct {
-		rpcFactory            common.RPCFactory
-		monitor               membership.Monitor
-		metricsHandler        metrics.Handler
-		dynConfig             *dynamicconfig.Collection
-		testHooks             testhooks.TestHooks
-		numberOfHistoryShards int32
-		logger                log.Logger
-		throttledLogger       log.Logger
+		rpcFactory       common.RPCFactory
+		monitor          membership.Monitor
+		metricsHandler   metrics.Handler
+		dynConfig        *dynamicconfig.Collection
+		testHooks        testhooks.TestHooks
+		shardMapProvider *shardmap.Provider
+		logger           log.Logger
+		throttledLogger  log.Logger
 	}
 
 	factoryProviderImpl struct {
@@ -83,19 +84,19 @@ func (p *factoryProviderImpl) NewFactory(
 	metricsHandler metrics.Handler,
 	dc *dynamicconfig.Collection,
 	testHooks testhooks.TestHooks,
-	numberOfHistoryShards int32,
+	shardMapProvider *shardmap.Provider,
 	logger log.Logger,
 	throttledLogger log.Logger,
 ) Factory {
 	return &rpcClientFactory{
-		rpcFactory:            rpcFactory,
-		monitor:               monitor,
-		metricsHandler:        metricsHandler,
-		dynConfig:             dc,
-		testHooks:             testHooks,
-		numberOfHistoryShards: numberOfHistoryShards,
-		logger:                logger,
-		throttledLogger:       throttledLogger,
+		rpcFactory:       rpcFactory,
+		monitor:          monitor,
+		metricsHandler:   metricsHandler,
+		dynConfig:        dc,
+		testHooks:        testHooks,
+		shardMapProvider: shardMapProvider,
+		logger:           logger,
+		throttledLogger:  throttledLogger,
 	}
 }
 
@@ -108,7 +109,7 @@ func (cf *rpcClientFactory) NewHistoryClientWithTimeout(timeout time.Duration) (
 		cf.dynConfig,
 		resolver,
 		cf.logger,
-		cf.numberOfHistoryShards,
+		cf.shardMapProvider,
 		cf.rpcFactory,
 		timeout,
 	)
@@ -40,6 +40,7 @@ type (
 		goros                  goro.Group
 		historyServiceResolver membership.ServiceResolver
 		logger                 log.Logger
+		shardOwners            *membership.ShardOwners
 		membershipUpdateCh     chan *membership.ChangedEvent
 		staleTTL               dynamicconfig.DurationPropertyFn
 	}
@@ -50,6 +51,7 @@ const cachingRedirectorListener = "cachingRedirectorListener"
 func newCachingRedirector(
 	connections connectionPool,
 	historyServiceResolver membership.ServiceResolver,
+	shardOwners *membership.ShardOwners,
 	logger log.Logger,
 	staleTTL dynamicconfig.DurationPropertyFn,
 ) *cachingRedirector {
@@ -57,6 +59,7 @@ func newCachingRedirector(
 		connections:            connections,
 		historyServiceResolver: historyServiceResolver,
 		logger:                 logger,
+		shardOwners:            shardOwners,
 		membershipUpdateCh:     make(chan *membership.ChangedEvent, 1),
 		staleTTL:               staleTTL,
 	}
@@ -143,7 +146,7 @@ func (r *cachingRedirector) getOrCreateEntry(shardID int32) (cacheEntry, error)
 		delete(r.mu.cache, shardID)
 	}
 
-	address, err := shardLookup(r.historyServiceResolver, shardID)
+	address, err := shardLookup(r.shardOwners, shardID)
 	if err != nil {
 		return cacheEntry{}, err
 	}
@@ -234,6 +237,7 @@ func (r *cachingRedirector) eventLoop(ctx context.Context) error {
 		case <-ctx.Done():
 			return nil
 		case <-r.membershipUpdateCh:
+			r.shardOwners.Refresh()
 			r.staleCheck()
 		}
 	}
@@ -254,7 +258,7 @@ func (r *cachingRedirector) staleCheck() {
 			continue
 		}
 		if staleTTL > 0 {
-			addr, err := shardLookup(r.historyServiceResolver, shardID)
+			addr, err := shardLookup(r.shardOwners, shardID)
 			if err != nil || addr != entry.address {
 				entry.staleAt = now.Add(staleTTL)
 				r.mu.cache[shardID] = entry
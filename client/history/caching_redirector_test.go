@@ -55,6 +55,7 @@ func (s *cachingRedirectorSuite) newCachingDirector(staleTTL time.Duration) *cac
 	return newCachingRedirector(
 		s.connections,
 		s.resolver,
+		membership.NewShardOwners(s.resolver, dynamicconfig.GetBoolPropertyFn(false), nil),
 		s.logger,
 		dynamicconfig.GetDurationPropertyFn(staleTTL),
 	)
@@ -102,7 +103,7 @@ func cacheRetainingTest(s *cachingRedirectorSuite, opErr error, verify func(erro
 		}
 		return opErr
 	}
-	r := newCachingRedirector(s.connections, s.resolver, s.logger, dynamicconfig.GetDurationPropertyFn(0))
+	r := newCachingRedirector(s.connections, s.resolver, membership.NewShardOwners(s.resolver, dynamicconfig.GetBoolPropertyFn(false), nil), s.logger, dynamicconfig.GetDurationPropertyFn(0))
 	defer r.stop()
 
 	for i := 0; i < 3; i++ {
@@ -13,13 +13,13 @@ import (
 	"go.temporal.io/api/serviceerror"
 	"go.temporal.io/server/api/historyservice/v1"
 	replicationspb "go.temporal.io/server/api/replication/v1"
-	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/debug"
 	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/headers"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/membership"
+	"go.temporal.io/server/common/shardmap"
 	"go.temporal.io/server/common/tasktoken"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/metadata"
@@ -35,12 +35,12 @@ const (
 )
 
 type clientImpl struct {
-	connections     connectionPool
-	logger          log.Logger
-	numberOfShards  int32
-	redirector      redirector
-	timeout         time.Duration
-	tokenSerializer *tasktoken.Serializer
+	connections      connectionPool
+	logger           log.Logger
+	shardMapProvider *shardmap.Provider
+	redirector       redirector
+	timeout          time.Duration
+	tokenSerializer  *tasktoken.Serializer
 }
 
 // NewClient creates a new history service gRPC client
@@ -48,11 +48,16 @@ func NewClient(
 	dc *dynamicconfig.Collection,
 	historyServiceResolver membership.ServiceResolver,
 	logger log.Logger,
-	numberOfShards int32,
+	shardMapProvider *shardmap.Provider,
 	rpcFactory RPCFactory,
 	timeout time.Duration,
 ) historyservice.HistoryServiceClient {
 	connections := newConnectionPool(historyServiceResolver, rpcFactory)
+	shardOwners := membership.NewShardOwners(
+		historyServiceResolver,
+		dynamicconfig.ShardRebalancerEnabled.Get(dc),
+		shardMapProvider.RingShard,
+	)
 
 	var redirector redirector
 	if dynamicconfig.HistoryClientOwnershipCachingEnabled.Get(dc)() {
@@ -60,21 +65,22 @@ func NewClient(
 		redirector = newCachingRedirector(
 			connections,
 			historyServiceResolver,
+			shardOwners,
 			logger,
 			dynamicconfig.HistoryClientOwnershipCachingStaleTTL.Get(dc),
 		)
 	} else {
 		logger.Info("historyClient: ownership caching disabled")
-		redirector = newBasicRedirector(connections, historyServiceResolver)
+		redirector = newBasicRedirector(connections, shardOwners)
 	}
 
 	return &clientImpl{
-		connections:     connections,
-		logger:          logger,
-		numberOfShards:  numberOfShards,
-		redirector:      redirector,
-		timeout:         timeout,
-		tokenSerializer: tasktoken.NewSerializer(),
+		connections:      connections,
+		logger:           logger,
+		shardMapProvider: shardMapProvider,
+		redirector:       redirector,
+		timeout:          timeout,
+		tokenSerializer:  tasktoken.NewSerializer(),
 	}
 }
 
@@ -249,7 +255,7 @@ func (c *clientImpl) StreamWorkflowReplicationMessages(
 // getRandomShard returns a random shard ID for history APIs that are shard-agnostic (e.g. namespace or DLQ v2 APIs).
 func (c *clientImpl) getRandomShard() int32 {
 	// Add 1 at the end because shard IDs are 1-indexed.
-	return int32(rand.Intn(int(c.numberOfShards)) + 1)
+	return int32(rand.Intn(int(c.shardMapProvider.TotalShardCount())) + 1)
 }
 
 func (c *clientImpl) createContext(parent context.Context) (context.Context, context.CancelFunc) {
@@ -257,7 +263,7 @@ func (c *clientImpl) createContext(parent context.Context) (context.Context, con
 }
 
 func (c *clientImpl) shardIDFromWorkflowID(namespaceID, workflowID string) int32 {
-	return common.WorkflowIDToHistoryShard(namespaceID, workflowID, c.numberOfShards)
+	return c.shardMapProvider.WorkflowIDToHistoryShard(namespaceID, workflowID)
 }
 
 func checkShardID(shardID int32) error {
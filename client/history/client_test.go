@@ -12,6 +12,7 @@ import (
 	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/membership"
+	"go.temporal.io/server/common/shardmap"
 	"go.temporal.io/server/common/testing/nettest"
 	"go.uber.org/mock/gomock"
 	"google.golang.org/grpc"
@@ -37,7 +38,7 @@ func TestErrLookup(t *testing.T) {
 		dynamicconfig.NewNoopCollection(),
 		serviceResolver,
 		log.NewTestLogger(),
-		1,
+		shardmap.NewProvider(1),
 		nil,
 		time.Duration(0),
 	)
@@ -127,7 +128,7 @@ func TestShardAgnosticConnectionStrategy(t *testing.T) {
 				dynamicconfig.NewNoopCollection(),
 				serviceResolver,
 				log.NewTestLogger(),
-				2,
+				shardmap.NewProvider(2),
 				rpcFactory,
 				time.Second,
 			)
@@ -21,6 +21,7 @@ import (
 	"go.temporal.io/server/common/membership"
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/persistence/persistencetest"
+	"go.temporal.io/server/common/shardmap"
 	"go.temporal.io/server/common/testing/nettest"
 	historyserver "go.temporal.io/server/service/history"
 	"go.temporal.io/server/service/history/tasks"
@@ -157,7 +158,7 @@ func createClient(ctrl *gomock.Controller, listener *nettest.PipeListener) histo
 		dynamicconfig.NewNoopCollection(),
 		serviceResolver,
 		log.NewTestLogger(),
-		1,
+		shardmap.NewProvider(1),
 		rpcFactory,
 		time.Second,
 	)
@@ -79,6 +79,34 @@ func (c *metricClient) DeleteDLQTasks(
 	return c.client.DeleteDLQTasks(ctx, request, opts...)
 }
 
+func (c *metricClient) DeleteOrphanedCurrentExecution(
+	ctx context.Context,
+	request *historyservice.DeleteOrphanedCurrentExecutionRequest,
+	opts ...grpc.CallOption,
+) (_ *historyservice.DeleteOrphanedCurrentExecutionResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "HistoryClientDeleteOrphanedCurrentExecution")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.DeleteOrphanedCurrentExecution(ctx, request, opts...)
+}
+
+func (c *metricClient) DeleteOrphanedHistoryTask(
+	ctx context.Context,
+	request *historyservice.DeleteOrphanedHistoryTaskRequest,
+	opts ...grpc.CallOption,
+) (_ *historyservice.DeleteOrphanedHistoryTaskResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "HistoryClientDeleteOrphanedHistoryTask")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.DeleteOrphanedHistoryTask(ctx, request, opts...)
+}
+
 func (c *metricClient) DeleteWorkflowExecution(
 	ctx context.Context,
 	request *historyservice.DeleteWorkflowExecutionRequest,
@@ -177,6 +205,20 @@ func (c *metricClient) ForceDeleteWorkflowExecution(
 	return c.client.ForceDeleteWorkflowExecution(ctx, request, opts...)
 }
 
+func (c *metricClient) ForkWorkflowExecution(
+	ctx context.Context,
+	request *historyservice.ForkWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (_ *historyservice.ForkWorkflowExecutionResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "HistoryClientForkWorkflowExecution")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.ForkWorkflowExecution(ctx, request, opts...)
+}
+
 func (c *metricClient) GenerateLastHistoryReplicationTasks(
 	ctx context.Context,
 	request *historyservice.GenerateLastHistoryReplicationTasksRequest,
@@ -443,6 +485,20 @@ func (c *metricClient) MergeDLQMessages(
 	return c.client.MergeDLQMessages(ctx, request, opts...)
 }
 
+func (c *metricClient) MoveSearchAttributeValue(
+	ctx context.Context,
+	request *historyservice.MoveSearchAttributeValueRequest,
+	opts ...grpc.CallOption,
+) (_ *historyservice.MoveSearchAttributeValueResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "HistoryClientMoveSearchAttributeValue")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.MoveSearchAttributeValue(ctx, request, opts...)
+}
+
 func (c *metricClient) PauseActivity(
 	ctx context.Context,
 	request *historyservice.PauseActivityRequest,
@@ -457,6 +513,20 @@ func (c *metricClient) PauseActivity(
 	return c.client.PauseActivity(ctx, request, opts...)
 }
 
+func (c *metricClient) PauseWorkflowExecution(
+	ctx context.Context,
+	request *historyservice.PauseWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (_ *historyservice.PauseWorkflowExecutionResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "HistoryClientPauseWorkflowExecution")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.PauseWorkflowExecution(ctx, request, opts...)
+}
+
 func (c *metricClient) PollMutableState(
 	ctx context.Context,
 	request *historyservice.PollMutableStateRequest,
@@ -485,6 +555,20 @@ func (c *metricClient) PollWorkflowExecutionUpdate(
 	return c.client.PollWorkflowExecutionUpdate(ctx, request, opts...)
 }
 
+func (c *metricClient) PreviewResetWorkflowExecution(
+	ctx context.Context,
+	request *historyservice.PreviewResetWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (_ *historyservice.PreviewResetWorkflowExecutionResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "HistoryClientPreviewResetWorkflowExecution")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.PreviewResetWorkflowExecution(ctx, request, opts...)
+}
+
 func (c *metricClient) PurgeDLQMessages(
 	ctx context.Context,
 	request *historyservice.PurgeDLQMessagesRequest,
@@ -919,6 +1003,20 @@ func (c *metricClient) UnpauseActivity(
 	return c.client.UnpauseActivity(ctx, request, opts...)
 }
 
+func (c *metricClient) UnpauseWorkflowExecution(
+	ctx context.Context,
+	request *historyservice.UnpauseWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (_ *historyservice.UnpauseWorkflowExecutionResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "HistoryClientUnpauseWorkflowExecution")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.UnpauseWorkflowExecution(ctx, request, opts...)
+}
+
 func (c *metricClient) UpdateActivityOptions(
 	ctx context.Context,
 	request *historyservice.UpdateActivityOptionsRequest,
@@ -6,7 +6,6 @@ import (
 
 	"go.temporal.io/server/api/historyservice/v1"
 	"go.temporal.io/server/common"
-	"go.temporal.io/server/common/convert"
 	"go.temporal.io/server/common/membership"
 	serviceerrors "go.temporal.io/server/common/serviceerror"
 )
@@ -24,13 +23,13 @@ type (
 	clientOperation func(ctx context.Context, client historyservice.HistoryServiceClient) error
 
 	basicRedirector struct {
-		connections            connectionPool
-		historyServiceResolver membership.ServiceResolver
+		connections connectionPool
+		shardOwners *membership.ShardOwners
 	}
 )
 
-func shardLookup(resolver membership.ServiceResolver, shardID int32) (rpcAddress, error) {
-	hostInfo, err := resolver.Lookup(convert.Int32ToString(shardID))
+func shardLookup(shardOwners *membership.ShardOwners, shardID int32) (rpcAddress, error) {
+	hostInfo, err := shardOwners.Lookup(shardID)
 	if err != nil {
 		return "", err
 	}
@@ -39,11 +38,11 @@ func shardLookup(resolver membership.ServiceResolver, shardID int32) (rpcAddress
 
 func newBasicRedirector(
 	connections connectionPool,
-	historyServiceResolver membership.ServiceResolver,
+	shardOwners *membership.ShardOwners,
 ) *basicRedirector {
 	return &basicRedirector{
-		connections:            connections,
-		historyServiceResolver: historyServiceResolver,
+		connections: connections,
+		shardOwners: shardOwners,
 	}
 }
 
@@ -51,7 +50,7 @@ func (r *basicRedirector) clientForShardID(shardID int32) (historyservice.Histor
 	if err := checkShardID(shardID); err != nil {
 		return nil, err
 	}
-	address, err := shardLookup(r.historyServiceResolver, shardID)
+	address, err := shardLookup(r.shardOwners, shardID)
 	if err != nil {
 		return nil, err
 	}
@@ -63,7 +62,7 @@ func (r *basicRedirector) execute(ctx context.Context, shardID int32, op clientO
 	if err := checkShardID(shardID); err != nil {
 		return err
 	}
-	address, err := shardLookup(r.historyServiceResolver, shardID)
+	address, err := shardLookup(r.shardOwners, shardID)
 	if err != nil {
 		return err
 	}
@@ -12,6 +12,7 @@ import (
 	"go.temporal.io/server/api/historyservice/v1"
 	"go.temporal.io/server/api/historyservicemock/v1"
 	"go.temporal.io/server/common/convert"
+	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/membership"
 	serviceerrors "go.temporal.io/server/common/serviceerror"
 	"go.uber.org/mock/gomock"
@@ -46,7 +47,7 @@ func (s *basicRedirectorSuite) TearDownTest() {
 }
 
 func (s *basicRedirectorSuite) TestShardCheck() {
-	r := newBasicRedirector(s.connections, s.resolver)
+	r := newBasicRedirector(s.connections, membership.NewShardOwners(s.resolver, dynamicconfig.GetBoolPropertyFn(false), nil))
 
 	invalErr := &serviceerror.InvalidArgument{}
 	err := r.execute(
@@ -79,7 +80,7 @@ func opErrorTest(s *basicRedirectorSuite, clientOp clientOperation, verify func(
 		Return(clientConn).
 		Times(1)
 
-	r := newBasicRedirector(s.connections, s.resolver)
+	r := newBasicRedirector(s.connections, membership.NewShardOwners(s.resolver, dynamicconfig.GetBoolPropertyFn(false), nil))
 
 	ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
 	defer cancel()
@@ -133,7 +134,7 @@ func (s *basicRedirectorSuite) TestShardOwnershipLostErrors() {
 		Return(clientConn1).
 		Times(2)
 
-	r := newBasicRedirector(s.connections, s.resolver)
+	r := newBasicRedirector(s.connections, membership.NewShardOwners(s.resolver, dynamicconfig.GetBoolPropertyFn(false), nil))
 	attempt := 1
 	doExecute := func() error {
 		return r.execute(
@@ -195,7 +196,7 @@ func (s *basicRedirectorSuite) TestClientForTargetByShard() {
 		getOrCreateClientConn(testAddr).
 		Return(clientConn)
 
-	r := newBasicRedirector(s.connections, s.resolver)
+	r := newBasicRedirector(s.connections, membership.NewShardOwners(s.resolver, dynamicconfig.GetBoolPropertyFn(false), nil))
 	cli, err := r.clientForShardID(shardID)
 	s.NoError(err)
 	s.Equal(mockClient, cli)
@@ -86,6 +86,36 @@ func (c *retryableClient) DeleteDLQTasks(
 	return resp, err
 }
 
+func (c *retryableClient) DeleteOrphanedCurrentExecution(
+	ctx context.Context,
+	request *historyservice.DeleteOrphanedCurrentExecutionRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.DeleteOrphanedCurrentExecutionResponse, error) {
+	var resp *historyservice.DeleteOrphanedCurrentExecutionResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.DeleteOrphanedCurrentExecution(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) DeleteOrphanedHistoryTask(
+	ctx context.Context,
+	request *historyservice.DeleteOrphanedHistoryTaskRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.DeleteOrphanedHistoryTaskResponse, error) {
+	var resp *historyservice.DeleteOrphanedHistoryTaskResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.DeleteOrphanedHistoryTask(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) DeleteWorkflowExecution(
 	ctx context.Context,
 	request *historyservice.DeleteWorkflowExecutionRequest,
@@ -191,6 +221,21 @@ func (c *retryableClient) ForceDeleteWorkflowExecution(
 	return resp, err
 }
 
+func (c *retryableClient) ForkWorkflowExecution(
+	ctx context.Context,
+	request *historyservice.ForkWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.ForkWorkflowExecutionResponse, error) {
+	var resp *historyservice.ForkWorkflowExecutionResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.ForkWorkflowExecution(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) GenerateLastHistoryReplicationTasks(
 	ctx context.Context,
 	request *historyservice.GenerateLastHistoryReplicationTasksRequest,
@@ -476,6 +521,21 @@ func (c *retryableClient) MergeDLQMessages(
 	return resp, err
 }
 
+func (c *retryableClient) MoveSearchAttributeValue(
+	ctx context.Context,
+	request *historyservice.MoveSearchAttributeValueRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.MoveSearchAttributeValueResponse, error) {
+	var resp *historyservice.MoveSearchAttributeValueResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.MoveSearchAttributeValue(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) PauseActivity(
 	ctx context.Context,
 	request *historyservice.PauseActivityRequest,
@@ -491,6 +551,21 @@ func (c *retryableClient) PauseActivity(
 	return resp, err
 }
 
+func (c *retryableClient) PauseWorkflowExecution(
+	ctx context.Context,
+	request *historyservice.PauseWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.PauseWorkflowExecutionResponse, error) {
+	var resp *historyservice.PauseWorkflowExecutionResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.PauseWorkflowExecution(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) PollMutableState(
 	ctx context.Context,
 	request *historyservice.PollMutableStateRequest,
@@ -521,6 +596,21 @@ func (c *retryableClient) PollWorkflowExecutionUpdate(
 	return resp, err
 }
 
+func (c *retryableClient) PreviewResetWorkflowExecution(
+	ctx context.Context,
+	request *historyservice.PreviewResetWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.PreviewResetWorkflowExecutionResponse, error) {
+	var resp *historyservice.PreviewResetWorkflowExecutionResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.PreviewResetWorkflowExecution(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) PurgeDLQMessages(
 	ctx context.Context,
 	request *historyservice.PurgeDLQMessagesRequest,
@@ -986,6 +1076,21 @@ func (c *retryableClient) UnpauseActivity(
 	return resp, err
 }
 
+func (c *retryableClient) UnpauseWorkflowExecution(
+	ctx context.Context,
+	request *historyservice.UnpauseWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.UnpauseWorkflowExecutionResponse, error) {
+	var resp *historyservice.UnpauseWorkflowExecutionResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.UnpauseWorkflowExecution(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) UpdateActivityOptions(
 	ctx context.Context,
 	request *historyservice.UpdateActivityOptionsRequest,
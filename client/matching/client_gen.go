@@ -344,6 +344,23 @@ func (c *clientImpl) ListTaskQueuePartitions(
 	return client.ListTaskQueuePartitions(ctx, request, opts...)
 }
 
+func (c *clientImpl) MoveTaskQueueTasks(
+	ctx context.Context,
+	request *matchingservice.MoveTaskQueueTasksRequest,
+	opts ...grpc.CallOption,
+) (*matchingservice.MoveTaskQueueTasksResponse, error) {
+
+	p := tqid.PartitionFromPartitionProto(request.GetSourcePartition(), request.GetNamespaceId())
+
+	client, err := c.getClientForTaskQueuePartition(p)
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.MoveTaskQueueTasks(ctx, request, opts...)
+}
+
 func (c *clientImpl) PollNexusTaskQueue(
 	ctx context.Context,
 	request *matchingservice.PollNexusTaskQueueRequest,
@@ -364,6 +381,26 @@ func (c *clientImpl) PollNexusTaskQueue(
 	return client.PollNexusTaskQueue(ctx, request, opts...)
 }
 
+func (c *clientImpl) ReleaseWorkerInFlightTask(
+	ctx context.Context,
+	request *matchingservice.ReleaseWorkerInFlightTaskRequest,
+	opts ...grpc.CallOption,
+) (*matchingservice.ReleaseWorkerInFlightTaskResponse, error) {
+
+	p, err := tqid.PartitionFromProto(request.GetTaskQueue(), request.GetNamespaceId(), request.GetTaskQueueType())
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := c.getClientForTaskQueuePartition(p)
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.ReleaseWorkerInFlightTask(ctx, request, opts...)
+}
+
 func (c *clientImpl) ReplicateTaskQueueUserData(
 	ctx context.Context,
 	request *matchingservice.ReplicateTaskQueueUserDataRequest,
@@ -524,6 +561,26 @@ func (c *clientImpl) UpdateWorkerBuildIdCompatibility(
 	return client.UpdateWorkerBuildIdCompatibility(ctx, request, opts...)
 }
 
+func (c *clientImpl) UpdateWorkerDispatchLimits(
+	ctx context.Context,
+	request *matchingservice.UpdateWorkerDispatchLimitsRequest,
+	opts ...grpc.CallOption,
+) (*matchingservice.UpdateWorkerDispatchLimitsResponse, error) {
+
+	p, err := tqid.NormalPartitionFromRpcName(request.GetTaskQueue(), request.GetNamespaceId(), enumspb.TASK_QUEUE_TYPE_WORKFLOW)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := c.getClientForTaskQueuePartition(p)
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.UpdateWorkerDispatchLimits(ctx, request, opts...)
+}
+
 func (c *clientImpl) UpdateWorkerVersioningRules(
 	ctx context.Context,
 	request *matchingservice.UpdateWorkerVersioningRulesRequest,
@@ -247,6 +247,20 @@ func (c *metricClient) ListTaskQueuePartitions(
 	return c.client.ListTaskQueuePartitions(ctx, request, opts...)
 }
 
+func (c *metricClient) MoveTaskQueueTasks(
+	ctx context.Context,
+	request *matchingservice.MoveTaskQueueTasksRequest,
+	opts ...grpc.CallOption,
+) (_ *matchingservice.MoveTaskQueueTasksResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "MatchingClientMoveTaskQueueTasks")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.MoveTaskQueueTasks(ctx, request, opts...)
+}
+
 func (c *metricClient) PollNexusTaskQueue(
 	ctx context.Context,
 	request *matchingservice.PollNexusTaskQueueRequest,
@@ -261,6 +275,20 @@ func (c *metricClient) PollNexusTaskQueue(
 	return c.client.PollNexusTaskQueue(ctx, request, opts...)
 }
 
+func (c *metricClient) ReleaseWorkerInFlightTask(
+	ctx context.Context,
+	request *matchingservice.ReleaseWorkerInFlightTaskRequest,
+	opts ...grpc.CallOption,
+) (_ *matchingservice.ReleaseWorkerInFlightTaskResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "MatchingClientReleaseWorkerInFlightTask")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.ReleaseWorkerInFlightTask(ctx, request, opts...)
+}
+
 func (c *metricClient) ReplicateTaskQueueUserData(
 	ctx context.Context,
 	request *matchingservice.ReplicateTaskQueueUserDataRequest,
@@ -373,6 +401,20 @@ func (c *metricClient) UpdateWorkerBuildIdCompatibility(
 	return c.client.UpdateWorkerBuildIdCompatibility(ctx, request, opts...)
 }
 
+func (c *metricClient) UpdateWorkerDispatchLimits(
+	ctx context.Context,
+	request *matchingservice.UpdateWorkerDispatchLimitsRequest,
+	opts ...grpc.CallOption,
+) (_ *matchingservice.UpdateWorkerDispatchLimitsResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "MatchingClientUpdateWorkerDispatchLimits")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.UpdateWorkerDispatchLimits(ctx, request, opts...)
+}
+
 func (c *metricClient) UpdateWorkerVersioningRules(
 	ctx context.Context,
 	request *matchingservice.UpdateWorkerVersioningRulesRequest,
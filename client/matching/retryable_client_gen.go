@@ -296,6 +296,21 @@ func (c *retryableClient) ListTaskQueuePartitions(
 	return resp, err
 }
 
+func (c *retryableClient) MoveTaskQueueTasks(
+	ctx context.Context,
+	request *matchingservice.MoveTaskQueueTasksRequest,
+	opts ...grpc.CallOption,
+) (*matchingservice.MoveTaskQueueTasksResponse, error) {
+	var resp *matchingservice.MoveTaskQueueTasksResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.MoveTaskQueueTasks(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) PollActivityTaskQueue(
 	ctx context.Context,
 	request *matchingservice.PollActivityTaskQueueRequest,
@@ -356,6 +371,21 @@ func (c *retryableClient) QueryWorkflow(
 	return resp, err
 }
 
+func (c *retryableClient) ReleaseWorkerInFlightTask(
+	ctx context.Context,
+	request *matchingservice.ReleaseWorkerInFlightTaskRequest,
+	opts ...grpc.CallOption,
+) (*matchingservice.ReleaseWorkerInFlightTaskResponse, error) {
+	var resp *matchingservice.ReleaseWorkerInFlightTaskResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.ReleaseWorkerInFlightTask(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) ReplicateTaskQueueUserData(
 	ctx context.Context,
 	request *matchingservice.ReplicateTaskQueueUserDataRequest,
@@ -476,6 +506,21 @@ func (c *retryableClient) UpdateWorkerBuildIdCompatibility(
 	return resp, err
 }
 
+func (c *retryableClient) UpdateWorkerDispatchLimits(
+	ctx context.Context,
+	request *matchingservice.UpdateWorkerDispatchLimitsRequest,
+	opts ...grpc.CallOption,
+) (*matchingservice.UpdateWorkerDispatchLimitsResponse, error) {
+	var resp *matchingservice.UpdateWorkerDispatchLimitsResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.UpdateWorkerDispatchLimits(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) UpdateWorkerVersioningRules(
 	ctx context.Context,
 	request *matchingservice.UpdateWorkerVersioningRulesRequest,
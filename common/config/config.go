@@ -266,12 +266,33 @@ type (
 		DataStores map[string]DataStore `yaml:"datastores"`
 		// TransactionSizeLimit is the largest allowed transaction size
 		TransactionSizeLimit dynamicconfig.IntPropertyFn `yaml:"-" json:"-"`
+		// BlobCompression is the codec used to compress history events and mutable state blobs of a namespace
+		BlobCompression dynamicconfig.StringPropertyFnWithNamespaceIDFilter `yaml:"-" json:"-"`
+		// Encryption contains the config for encrypting history events and mutable state at rest
+		Encryption *PersistenceEncryption `yaml:"encryption"`
+		// BlobEncryption controls whether history events and mutable state blobs of a namespace are encrypted
+		BlobEncryption dynamicconfig.BoolPropertyFnWithNamespaceIDFilter `yaml:"-" json:"-"`
+	}
+
+	// PersistenceEncryption is the configuration for envelope encryption of persisted blobs. Blobs are
+	// encrypted with per-namespace data keys, which are in turn wrapped by a key provider.
+	PersistenceEncryption struct {
+		// KeyProvider is the name of the key provider used to wrap data keys. Supported values: "local".
+		KeyProvider string `yaml:"keyProvider" validate:"nonzero"`
+		// KeyFile is the path of the key file used by the local key provider. The file is reloaded when it
+		// changes, so key encryption keys can be rotated without a restart.
+		KeyFile string `yaml:"keyFile"`
+		// DataKeyRotationInterval is how long a namespace data key is used to encrypt new blobs before a new
+		// one is generated (default: 24 hours)
+		DataKeyRotationInterval time.Duration `yaml:"dataKeyRotationInterval"`
 	}
 
 	// DataStore is the configuration for a single datastore
 	DataStore struct {
 		// FaultInjection contains the config for fault injector wrapper.
 		FaultInjection *FaultInjection `yaml:"faultInjection"`
+		// Recording contains the config for the call recorder wrapper.
+		Recording *Recording `yaml:"recording"`
 		// Cassandra contains the config for a cassandra datastore
 		Cassandra *Cassandra `yaml:"cassandra"`
 		// SQL contains the config for a SQL based datastore
@@ -298,6 +319,8 @@ type (
 		*/
 		// This will cause the UpdateShard method of the ShardStore to always return ShardOwnershipLostError.
 		// See config/development-cass-es-fi.yaml for a more detailed example.
+		// Faults can also be injected at runtime with the system.persistenceFaultInjectionRules dynamic config,
+		// which is applied whenever this section is set, even if it has no targets.
 		Targets FaultInjectionTargets `yaml:"targets"`
 	}
 
@@ -342,6 +365,17 @@ type (
 		Seed int64 `yaml:"seed"`
 	}
 
+	// Recording is the config for recording the calls made to data stores. Every request and its response or
+	// error is appended to a file, which can be served back by the "replay" custom datastore to reproduce an
+	// issue without a copy of the database.
+	Recording struct {
+		// Path is the file the calls are appended to.
+		Path string `yaml:"path" validate:"nonzero"`
+		// DataStores is the list of data stores to record, e.g. "ExecutionStore". All data stores are recorded
+		// if it's empty. See DataStoreName for the list of valid datastore names.
+		DataStores []DataStoreName `yaml:"dataStores"`
+	}
+
 	// Cassandra contains configuration to connect to Cassandra cluster
 	Cassandra struct {
 		// Hosts is a csv of cassandra endpoints
@@ -428,6 +462,22 @@ type (
 		TaskScanPartitions int `yaml:"taskScanPartitions"`
 		// TLS is the configuration for TLS connections
 		TLS *auth.TLS `yaml:"tls"`
+		// ReadReplicas is the configuration of the read replicas of the database. Optional.
+		ReadReplicas *SQLReadReplicas `yaml:"readReplicas"`
+	}
+
+	// SQLReadReplicas is the configuration for sending reads that tolerate stale data, i.e. visibility list and
+	// count queries and history reads of closed workflows, to read replicas of a SQL database. Everything else is
+	// sent to the primary, as are the reads of replicas that are unreachable or too far behind.
+	SQLReadReplicas struct {
+		// ConnectAddrs are the remote addrs of the read replicas. They are connected to with the other settings of
+		// the primary, e.g. User, Password and TLS.
+		ConnectAddrs []string `yaml:"connectAddrs" validate:"nonzero"`
+		// MaxReplicationLag is how far a replica can be behind the primary before reads fall back to the primary.
+		// Defaults to 10s.
+		MaxReplicationLag time.Duration `yaml:"maxReplicationLag"`
+		// LagCheckInterval is how often the replication lag of the replicas is checked. Defaults to 5s.
+		LagCheckInterval time.Duration `yaml:"lagCheckInterval"`
 	}
 
 	// CustomDatastoreConfig is the configuration for connecting to a custom datastore that is not supported by temporal core
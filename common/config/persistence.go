@@ -15,6 +15,9 @@ const (
 	StoreTypeSQL = "sql"
 	// StoreTypeNoSQL refers to nosql based storage as persistence store
 	StoreTypeNoSQL = "nosql"
+
+	// EncryptionKeyProviderLocal refers to the key provider that reads key encryption keys from a local key file
+	EncryptionKeyProviderLocal = "local"
 )
 
 var ErrPersistenceConfig = errors.New("persistence config error")
@@ -102,6 +105,27 @@ func (c *Persistence) Validate() error {
 			return fmt.Errorf("%w: datastore %q: %s", ErrPersistenceConfig, st, err.Error())
 		}
 	}
+
+	if c.Encryption != nil {
+		if err := c.Encryption.validate(); err != nil {
+			return fmt.Errorf("%w: encryption: %s", ErrPersistenceConfig, err.Error())
+		}
+	}
+	return nil
+}
+
+func (e *PersistenceEncryption) validate() error {
+	switch e.KeyProvider {
+	case EncryptionKeyProviderLocal:
+		if e.KeyFile == "" {
+			return errors.New("keyFile must be specified for the local key provider")
+		}
+	default:
+		return fmt.Errorf("unknown key provider %q", e.KeyProvider)
+	}
+	if e.DataKeyRotationInterval < 0 {
+		return errors.New("dataKeyRotationInterval must not be negative")
+	}
 	return nil
 }
 
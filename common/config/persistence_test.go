@@ -3,6 +3,7 @@ package config
 import (
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/gocql/gocql"
 )
@@ -206,3 +207,53 @@ func TestCassandraStoreConsistency_validate(t *testing.T) {
 		})
 	}
 }
+
+func TestPersistenceEncryption_validate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		settings *PersistenceEncryption
+		wantErr  bool
+	}{
+		{
+			name: "happy path",
+			settings: &PersistenceEncryption{
+				KeyProvider: EncryptionKeyProviderLocal,
+				KeyFile:     "/etc/temporal/keys.yaml",
+			},
+			wantErr: false,
+		},
+		{
+			name: "missing key file",
+			settings: &PersistenceEncryption{
+				KeyProvider: EncryptionKeyProviderLocal,
+			},
+			wantErr: true,
+		},
+		{
+			name: "unknown key provider",
+			settings: &PersistenceEncryption{
+				KeyProvider: "kms",
+				KeyFile:     "/etc/temporal/keys.yaml",
+			},
+			wantErr: true,
+		},
+		{
+			name: "negative rotation interval",
+			settings: &PersistenceEncryption{
+				KeyProvider:             EncryptionKeyProviderLocal,
+				KeyFile:                 "/etc/temporal/keys.yaml",
+				DataKeyRotationInterval: -time.Hour,
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.settings.validate(); (err != nil) != tt.wantErr {
+				t.Errorf("PersistenceEncryption.validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -75,6 +75,15 @@ var (
 		false,
 		`VisibilityEnableShadowReadMode is the config to enable shadow read from secondary visibility`,
 	)
+	VisibilityShadowReadVerificationRate = NewNamespaceFloatSetting(
+		"system.visibilityShadowReadVerificationRate",
+		0,
+		`VisibilityShadowReadVerificationRate is the fraction of ListWorkflowExecutions and CountWorkflowExecutions requests
+whose shadow read result is compared with the result of the read visibility store, between 0 and 1. Mismatches are
+reported in the visibility_shadow_read_mismatches metric and logged. It only applies when
+system.visibilityEnableShadowReadMode is enabled. Only the first page of ListWorkflowExecutions is compared, since page
+tokens are specific to a store.`,
+	)
 	SecondaryVisibilityWritingMode = NewGlobalStringSetting(
 		"system.secondaryVisibilityWritingMode",
 		"off",
@@ -138,6 +147,24 @@ for signal / start / signal with start API if namespace is not active`,
 		primitives.DefaultTransactionSizeLimit,
 		`TransactionSizeLimit is the largest allowed transaction size to persistence`,
 	)
+	PersistenceBlobCompression = NewNamespaceIDStringSetting(
+		"system.persistenceBlobCompression",
+		"none",
+		`PersistenceBlobCompression is the codec used to compress history events and mutable state blobs of a namespace
+before they are written to persistence. Supported values are "none", "snappy" and "zstd". Blobs record the codec they
+were written with, so compressed and uncompressed blobs can be read side by side. Only enable this once every host in
+the cluster runs a version that can read compressed blobs, otherwise hosts that haven't been upgraded yet will fail to
+decode them.`,
+	)
+	PersistenceBlobEncryption = NewNamespaceIDBoolSetting(
+		"system.persistenceBlobEncryption",
+		false,
+		`PersistenceBlobEncryption controls whether history events and mutable state blobs of a namespace are encrypted
+before they are written to persistence. It only has an effect if persistence.encryption is set in the static config.
+Encrypted and plaintext blobs can be read side by side. Only enable this once every host in the cluster runs a version
+that can read encrypted blobs and has the encryption config, otherwise hosts that haven't been upgraded yet will fail to
+decode them.`,
+	)
 	DisallowQuery = NewNamespaceBoolSetting(
 		"system.disallowQuery",
 		false,
@@ -1291,6 +1318,68 @@ second per poller by one physical queue manager`,
 		5,
 		`Number of simple priority levels (requires new matcher)`,
 	)
+	MatchingEnableFairness = NewTaskQueueBoolSetting(
+		"matching.enableFairness",
+		false,
+		`MatchingEnableFairness enables fairness between the tasks of a priority level (requires new matcher).
+Tasks are dispatched in weighted round-robin across their fairness keys, and the persisted backlog of each key
+is read from its own subqueue. History sets the fairness key of a task from the search attribute named by
+history.fairnessKeySearchAttribute. When disabled, tasks of a priority level are dispatched in task ID order.`,
+	)
+	MatchingMaxFairnessSubqueues = NewTaskQueueIntSetting(
+		"matching.maxFairnessSubqueues",
+		100,
+		`MatchingMaxFairnessSubqueues is the maximum number of fairness keys with their own backlog subqueue in a
+physical task queue. Subqueues are never removed: once the limit is reached, the tasks of new keys are
+persisted in the subqueue of their priority level without a key, and are still dispatched fairly once loaded.`,
+	)
+	MatchingFairnessKeyWeights = NewTaskQueueTypedSetting(
+		"matching.fairnessKeyWeights",
+		map[string]float64(nil),
+		`MatchingFairnessKeyWeights is a map from fairness key to weight (requires new matcher). Tasks of a
+priority level are dispatched in weighted round-robin across fairness keys. Keys not in the map, and keys
+with a non-positive weight, have a weight of 1. Backlog gauges are emitted for each key in the map.`,
+	)
+	MatchingWorkerIdentityDispatchRate = NewTaskQueueTypedSetting(
+		"matching.workerIdentityDispatchRate",
+		map[string]float64(nil),
+		`MatchingWorkerIdentityDispatchRate is a map from worker identity to the max rate of tasks dispatched to
+the pollers with that identity, in tasks per second across all partitions (requires new matcher). The "*" key
+sets the rate of the identities not in the map. Non-positive rates are not enforced. Limits set in the task queue
+user data take precedence over this setting.`,
+	)
+	MatchingWorkerBuildIDDispatchRate = NewTaskQueueTypedSetting(
+		"matching.workerBuildIDDispatchRate",
+		map[string]float64(nil),
+		`MatchingWorkerBuildIDDispatchRate is a map from worker build ID to the max rate of tasks dispatched to
+the pollers with that build ID, in tasks per second across all partitions (requires new matcher). The "*" key
+sets the rate of the build IDs not in the map. Non-positive rates are not enforced. Limits set in the task queue
+user data take precedence over this setting.`,
+	)
+	MatchingWorkerIdentityMaxInFlight = NewTaskQueueTypedSetting(
+		"matching.workerIdentityMaxInFlight",
+		map[string]int(nil),
+		`MatchingWorkerIdentityMaxInFlight is a map from worker identity to the max number of workflow and activity
+tasks dispatched to the pollers with that identity that the workers haven't responded to yet, across all partitions
+(requires new matcher). The "*" key sets the limit of the identities not in the map. Non-positive limits are not
+enforced. Limits set in the task queue user data take precedence over this setting.`,
+	)
+	MatchingWorkerBuildIDMaxInFlight = NewTaskQueueTypedSetting(
+		"matching.workerBuildIDMaxInFlight",
+		map[string]int(nil),
+		`MatchingWorkerBuildIDMaxInFlight is a map from worker build ID to the max number of workflow and activity
+tasks dispatched to the pollers with that build ID that the workers haven't responded to yet, across all partitions
+(requires new matcher). The "*" key sets the limit of the build IDs not in the map. Non-positive limits are not
+enforced. Limits set in the task queue user data take precedence over this setting.`,
+	)
+	MatchingWorkerInFlightTimeout = NewTaskQueueDurationSetting(
+		"matching.workerInFlightTimeout",
+		10*time.Minute,
+		`MatchingWorkerInFlightTimeout is how long a task dispatched to a worker counts against the max in-flight
+limits of its worker identity and build ID if the worker doesn't respond to it and the task has no timeout of its
+own. Once started, a workflow task expires after its workflow task timeout and an activity task after its
+start-to-close timeout, or heartbeat timeout if no start-to-close timeout is set (requires new matcher).`,
+	)
 	MatchingBacklogTaskForwardTimeout = NewTaskQueueDurationSetting(
 		"matching.backlogTaskForwardTimeout",
 		60*time.Second,
@@ -1487,6 +1576,55 @@ checks while a shard is lingering.`,
 will temporarily delay closing shards after a membership update, awaiting a
 shard ownership lost error from persistence. If set to zero, shards will not delay closing.
 Do NOT use non-zero value with persistence layers that are missing AssertShardOwnership support.`,
+	)
+	ShardLoadReportInterval = NewGlobalDurationSetting(
+		"history.shardLoadReportInterval",
+		10*time.Second,
+		`ShardLoadReportInterval is the interval at which the load of each shard (requests per second,
+persistence latency and task backlog) is measured and published.`,
+	)
+	ShardRebalancerEnabled = NewGlobalBoolSetting(
+		"history.shardRebalancerEnabled",
+		false,
+		`ShardRebalancerEnabled enables the shard rebalancer, which lets idle hosts take over the hottest shard
+of overloaded hosts. When disabled, the host releases the shards it took over.`,
+	)
+	ShardRebalancerLoadThreshold = NewGlobalFloatSetting(
+		"history.shardRebalancerLoadThreshold",
+		0.25,
+		`ShardRebalancerLoadThreshold is the fraction of the average host load above which a host is overloaded,
+and below which a host is idle.`,
+	)
+	ShardRebalancerSustainedIntervals = NewGlobalIntSetting(
+		"history.shardRebalancerSustainedIntervals",
+		3,
+		`ShardRebalancerSustainedIntervals is the number of consecutive load report intervals a host must be
+idle before taking over a shard, or overloaded before releasing a shard it took over.`,
+	)
+	ShardRebalancerMinHoldTime = NewGlobalDurationSetting(
+		"history.shardRebalancerMinHoldTime",
+		10*time.Minute,
+		`ShardRebalancerMinHoldTime is the minimum time a host keeps a shard it took over before releasing it.`,
+	)
+	ShardRebalancerMaxTakenOverShards = NewGlobalIntSetting(
+		"history.shardRebalancerMaxTakenOverShards",
+		8,
+		`ShardRebalancerMaxTakenOverShards is the maximum number of shards a host can take over. It can't
+exceed 16, the shard IDs are published in a membership label of limited size.`,
+	)
+	ShardSplitTargetShardCount = NewGlobalIntSetting(
+		"history.shardSplitTargetShardCount",
+		0,
+		`ShardSplitTargetShardCount is the number of history shards the cluster is split into. It must be a multiple
+of the current shard count. Each shard is split into its child shards by the host which owns it: its executions are
+moved to its child shards one workflow at a time, and each workflow is routed to its child shard as soon as its runs
+are moved. 0 disables shard splits.`,
+	)
+	ShardSplitInterval = NewGlobalDurationSetting(
+		"history.shardSplitInterval",
+		time.Minute,
+		`ShardSplitInterval is the interval at which a host checks for a shard split to start, and moves the
+executions of the shards it owns which are being split.`,
 	)
 	ShardFinalizerTimeout = NewGlobalDurationSetting(
 		"history.shardFinalizerTimeout",
@@ -2134,6 +2272,22 @@ This configuration will be become the default behavior in the next release and r
 		true,
 		`Allows resetting of workflows with pending children when set to true`,
 	)
+	EnableVirtualClock = NewNamespaceBoolSetting(
+		"history.enableVirtualClock",
+		false,
+		`EnableVirtualClock marks the namespace as a test namespace with a virtual clock. Workflow timers, workflow
+backoffs, activity retry backoffs and workflow run and execution timeouts follow the virtual clock, which is ahead of
+the real clock by the duration in the "temporal.virtualClockOffset" namespace data. Advancing the offset (e.g. with
+"tdbg namespace advance-clock") skips time for the namespace. Workflow task and activity timeouts keep following the
+real clock. Do not enable for production namespaces.`,
+	)
+	FairnessKeySearchAttribute = NewNamespaceStringSetting(
+		"history.fairnessKeySearchAttribute",
+		"",
+		`FairnessKeySearchAttribute is the name of a Keyword search attribute whose value is the fairness key of the
+workflow and activity tasks of a workflow. Matching reads the backlog of each fairness key separately when
+matching.enableFairness is on. Tasks of workflows without the search attribute have no fairness key.`,
+	)
 	HistoryMaxAutoResetPoints = NewNamespaceIntSetting(
 		"history.historyMaxAutoResetPoints",
 		primitives.DefaultHistoryMaxAutoResetPoints,
@@ -2581,6 +2735,25 @@ Should be at least WorkerESProcessorFlushInterval+<time to process request>.`,
 		false,
 		`ExecutionsScannerEnabled indicates if executions scanner should be started as part of worker.Scanner`,
 	)
+	OrphanScannerEnabled = NewGlobalBoolSetting(
+		"worker.orphanScannerEnabled",
+		false,
+		`OrphanScannerEnabled indicates if orphan scanner should be started as part of worker.Scanner. It looks for
+current executions and history tasks whose workflow execution doesn't exist. History branches without a workflow
+execution are found by the history scanner.`,
+	)
+	OrphanScannerRepairEnabled = NewGlobalBoolSetting(
+		"worker.orphanScannerRepairEnabled",
+		false,
+		`OrphanScannerRepairEnabled indicates if orphan scanner deletes the orphaned data it finds. If disabled, orphaned
+data is only reported in logs and metrics.`,
+	)
+	HistoryScannerOrphanDeletionEnabled = NewGlobalBoolSetting(
+		"worker.historyScannerOrphanDeletionEnabled",
+		true,
+		`HistoryScannerOrphanDeletionEnabled indicates if history scanner deletes the history branches whose workflow
+execution doesn't exist. If disabled, they are only reported in logs and metrics.`,
+	)
 	HistoryScannerDataMinAge = NewGlobalDurationSetting(
 		"worker.historyScannerDataMinAge",
 		60*24*time.Hour,
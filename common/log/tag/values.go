@@ -19,6 +19,9 @@ var (
 	WorkflowActionWorkflowPropertiesModified     = workflowAction("add-workflow-properties-modified-event")
 	WorkflowActionWorkflowOptionsUpdated         = workflowAction("add-workflow-options-updated-event")
 
+	// workflow pause / unpause
+	WorkflowActionWorkflowPropertiesModifiedExternally = workflowAction("add-workflow-properties-modified-externally-event")
+
 	// workflow update
 	WorkflowActionUpdateAccepted  = workflowAction("add-workflow-update-accepted-event")
 	WorkflowActionUpdateCompleted = workflowAction("add-workflow-update-completed-event")
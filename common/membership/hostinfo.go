@@ -10,6 +10,13 @@ type HostInfo interface {
 	GetAddress() string
 }
 
+// LabeledHostInfo is a HostInfo which carries the labels set by the host through Monitor.SetLabel.
+type LabeledHostInfo interface {
+	HostInfo
+	// Label returns the value of the label, and whether the label is set.
+	Label(key string) (string, bool)
+}
+
 // NewHostInfoFromAddress creates a new HostInfo instance from a socket address.
 func NewHostInfoFromAddress(address string) HostInfo {
 	return hostAddress(address)
@@ -58,6 +58,9 @@ type (
 		WaitUntilInitialized(context.Context) error
 		// SetDraining sets the draining state (synchronized through ringpop)
 		SetDraining(draining bool) error
+		// SetLabel sets a label of this host (synchronized through ringpop). An empty value removes the label.
+		// Labels of other hosts are returned by the Label method of their LabeledHostInfo.
+		SetLabel(key string, value string) error
 		// ApproximateMaxPropagationTime returns an approximate upper bound on propagation time
 		// for updates to membership information. This is _not_ a guarantee! This value is only
 		// provided to help with startup/shutdown timing as a best-effort.
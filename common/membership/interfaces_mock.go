@@ -129,6 +129,20 @@ func (mr *MockMonitorMockRecorder) SetDraining(draining any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetDraining", reflect.TypeOf((*MockMonitor)(nil).SetDraining), draining)
 }
 
+// SetLabel mocks base method.
+func (m *MockMonitor) SetLabel(key, value string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetLabel", key, value)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetLabel indicates an expected call of SetLabel.
+func (mr *MockMonitorMockRecorder) SetLabel(key, value any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetLabel", reflect.TypeOf((*MockMonitor)(nil).SetLabel), key, value)
+}
+
 // Start mocks base method.
 func (m *MockMonitor) Start() {
 	m.ctrl.T.Helper()
@@ -0,0 +1,123 @@
+package membership
+
+import (
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	// TakenOverShardsLabelKey is the membership label of the history shards a host took over from their owner in
+	// the membership ring, as comma separated shard IDs.
+	TakenOverShardsLabelKey = "takenOverShards"
+
+	// shardOwnersRefreshInterval is how long the taken over shards are cached for between membership changes.
+	shardOwnersRefreshInterval = time.Second
+)
+
+type (
+	// ShardOwners looks up the owners of history shards. A shard is owned by the host which took it over through
+	// its TakenOverShardsLabelKey label if any, otherwise by the owner of the shard in the membership ring. All the
+	// components which route requests to shards or verify their ownership must use the same lookup, or they would
+	// disagree on the owner of the taken over shards.
+	ShardOwners struct {
+		resolver        ServiceResolver
+		takeoverEnabled func() bool
+		ringShard       func(shardID int32) int32
+		takenOverShards atomic.Pointer[takenOverShards]
+	}
+
+	takenOverShards struct {
+		owners      map[int32]HostInfo
+		refreshedAt time.Time
+	}
+)
+
+// NewShardOwners returns the ShardOwners of the history service resolver. The takeover labels are only used while
+// takeoverEnabled returns true. ringShard returns the shard whose owner in the membership ring owns a shard, which is
+// the shard itself if ringShard is nil. It lets the child shards of a shard split be owned by the owner of their
+// parent shard.
+func NewShardOwners(
+	historyServiceResolver ServiceResolver,
+	takeoverEnabled func() bool,
+	ringShard func(shardID int32) int32,
+) *ShardOwners {
+	if ringShard == nil {
+		ringShard = func(shardID int32) int32 { return shardID }
+	}
+	return &ShardOwners{
+		resolver:        historyServiceResolver,
+		takeoverEnabled: takeoverEnabled,
+		ringShard:       ringShard,
+	}
+}
+
+// Lookup returns the host which owns the shard.
+func (s *ShardOwners) Lookup(shardID int32) (HostInfo, error) {
+	shardID = s.ringShard(shardID)
+	if s.takeoverEnabled() {
+		if owner, ok := s.TakenOverShards()[shardID]; ok {
+			return owner, nil
+		}
+	}
+	return s.resolver.Lookup(strconv.FormatInt(int64(shardID), 10))
+}
+
+// TakenOverShards returns the owners of the shards taken over by the available hosts. They are refreshed from the
+// membership labels at most every second, call Refresh to force a refresh on a membership change.
+func (s *ShardOwners) TakenOverShards() map[int32]HostInfo {
+	if cached := s.takenOverShards.Load(); cached != nil && time.Since(cached.refreshedAt) < shardOwnersRefreshInterval {
+		return cached.owners
+	}
+	return s.Refresh()
+}
+
+// Refresh reloads the shards taken over by the available hosts from their membership labels and returns their
+// owners.
+func (s *ShardOwners) Refresh() map[int32]HostInfo {
+	owners := make(map[int32]HostInfo)
+	if s.takeoverEnabled() {
+		for _, hostInfo := range s.resolver.AvailableMembers() {
+			for _, shardID := range GetTakenOverShards(hostInfo) {
+				// While a label update propagates, two hosts may have taken over the same shard: all hosts must
+				// agree on its owner.
+				if owner, ok := owners[shardID]; !ok || hostInfo.Identity() < owner.Identity() {
+					owners[shardID] = hostInfo
+				}
+			}
+		}
+	}
+	s.takenOverShards.Store(&takenOverShards{owners: owners, refreshedAt: time.Now()})
+	return owners
+}
+
+// GetTakenOverShards returns the shards the host took over, from its membership label.
+func GetTakenOverShards(hostInfo HostInfo) []int32 {
+	labeledHostInfo, ok := hostInfo.(LabeledHostInfo)
+	if !ok {
+		return nil
+	}
+	value, ok := labeledHostInfo.Label(TakenOverShardsLabelKey)
+	if !ok || value == "" {
+		return nil
+	}
+	var shardIDs []int32
+	for _, field := range strings.Split(value, ",") {
+		shardID, err := strconv.ParseInt(field, 10, 32)
+		if err != nil || shardID <= 0 {
+			continue
+		}
+		shardIDs = append(shardIDs, int32(shardID))
+	}
+	return shardIDs
+}
+
+// EncodeTakenOverShards returns the membership label value of the shards taken over by a host.
+func EncodeTakenOverShards(shardIDs []int32) string {
+	fields := make([]string, 0, len(shardIDs))
+	for _, shardID := range shardIDs {
+		fields = append(fields, strconv.FormatInt(int64(shardID), 10))
+	}
+	return strings.Join(fields, ",")
+}
@@ -0,0 +1,93 @@
+package membership
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+type testLabeledHost struct {
+	identity string
+	labels   map[string]string
+}
+
+func (h testLabeledHost) Identity() string {
+	return h.identity
+}
+
+func (h testLabeledHost) GetAddress() string {
+	return h.identity
+}
+
+func (h testLabeledHost) Label(key string) (string, bool) {
+	value, ok := h.labels[key]
+	return value, ok
+}
+
+func TestShardOwners_Lookup(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	resolver := NewMockServiceResolver(ctrl)
+	ringOwner := NewHostInfoFromAddress("ring")
+	resolver.EXPECT().Lookup("3").Return(ringOwner, nil).AnyTimes()
+	resolver.EXPECT().Lookup("5").Return(ringOwner, nil).AnyTimes()
+	resolver.EXPECT().AvailableMembers().Return([]HostInfo{
+		ringOwner,
+		testLabeledHost{identity: "b", labels: map[string]string{TakenOverShardsLabelKey: "3,4"}},
+		testLabeledHost{identity: "a", labels: map[string]string{TakenOverShardsLabelKey: "3"}},
+	}).AnyTimes()
+
+	enabled := true
+	shardOwners := NewShardOwners(resolver, func() bool { return enabled }, nil)
+
+	// concurrent takeovers resolve to the smallest identity
+	owner, err := shardOwners.Lookup(3)
+	require.NoError(t, err)
+	require.Equal(t, "a", owner.Identity())
+	owner, err = shardOwners.Lookup(4)
+	require.NoError(t, err)
+	require.Equal(t, "b", owner.Identity())
+	owner, err = shardOwners.Lookup(5)
+	require.NoError(t, err)
+	require.Equal(t, "ring", owner.Identity())
+
+	enabled = false
+	owner, err = shardOwners.Lookup(3)
+	require.NoError(t, err)
+	require.Equal(t, "ring", owner.Identity())
+	require.Empty(t, shardOwners.Refresh())
+}
+
+func TestShardOwners_LookupRingShard(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	resolver := NewMockServiceResolver(ctrl)
+	resolver.EXPECT().Lookup("2").Return(NewHostInfoFromAddress("parent"), nil).Times(2)
+	resolver.EXPECT().AvailableMembers().Return(nil).AnyTimes()
+
+	// child shards 6 and 10 of shard 2 are owned by the owner of shard 2
+	shardOwners := NewShardOwners(resolver, func() bool { return true }, func(shardID int32) int32 {
+		return (shardID-1)%4 + 1
+	})
+	for _, shardID := range []int32{6, 10} {
+		owner, err := shardOwners.Lookup(shardID)
+		require.NoError(t, err)
+		require.Equal(t, "parent", owner.Identity())
+	}
+}
+
+func TestTakenOverShardsLabel(t *testing.T) {
+	t.Parallel()
+
+	label := EncodeTakenOverShards([]int32{3, 12, 4096})
+	require.Equal(t, "3,12,4096", label)
+	require.Equal(t, []int32{3, 12, 4096}, GetTakenOverShards(testLabeledHost{
+		identity: "host",
+		labels:   map[string]string{TakenOverShardsLabelKey: label},
+	}))
+	require.Empty(t, GetTakenOverShards(testLabeledHost{identity: "host"}))
+	require.Empty(t, GetTakenOverShards(NewHostInfoFromAddress("host")))
+}
@@ -72,6 +72,10 @@ func (s *staticMonitor) SetDraining(draining bool) error {
 	return nil
 }
 
+func (s *staticMonitor) SetLabel(key string, value string) error {
+	return nil
+}
+
 func (s *staticMonitor) ApproximateMaxPropagationTime() time.Duration {
 	return 0
 }
@@ -27,6 +27,7 @@ const (
 	resourceExhaustedScopeTag   = "resource_exhausted_scope"
 	PartitionTagName            = "partition"
 	PriorityTagName             = "priority"
+	FairnessKeyTagName          = "fairness_key"
 )
 
 // This package should hold all the metrics and tags for temporal
@@ -78,6 +79,8 @@ const (
 	TaskQueueScavengerScope = "TaskQueueScavenger"
 	// ExecutionsScavengerScope is scope used by all metrics emitted by worker.executions.Scavenger module
 	ExecutionsScavengerScope = "ExecutionsScavenger"
+	// OrphanScavengerScope is scope used by all metrics emitted by worker.orphans.Scavenger module
+	OrphanScavengerScope = "OrphanScavenger"
 )
 
 const (
@@ -163,6 +166,8 @@ const (
 	PersistenceGetCurrentExecutionScope = "GetCurrentExecution"
 	// PersistenceListConcreteExecutionsScope tracks ListConcreteExecutions calls made by service to persistence layer
 	PersistenceListConcreteExecutionsScope = "ListConcreteExecutions"
+	// PersistenceListCurrentExecutionsScope tracks ListCurrentExecutions calls made by service to persistence layer
+	PersistenceListCurrentExecutionsScope = "ListCurrentExecutions"
 	// PersistenceAddTasksScope tracks AddTasks calls made by service to persistence layer
 	PersistenceAddTasksScope = "AddTasks"
 	// PersistenceGetTransferTasksScope tracks GetTransferTasks calls made by service to persistence layer
@@ -280,6 +285,12 @@ const (
 	VisibilityPersistenceCountWorkflowExecutionsScope = "CountWorkflowExecutions"
 	// VisibilityPersistenceGetWorkflowExecutionScope tracks GetWorkflowExecution calls made by service to visibility persistence layer
 	VisibilityPersistenceGetWorkflowExecutionScope = "GetWorkflowExecution"
+	// VisibilityPersistenceCopySearchAttributeValuesScope tracks CopySearchAttributeValues calls made by service to visibility persistence layer
+	VisibilityPersistenceCopySearchAttributeValuesScope = "CopySearchAttributeValues"
+	// VisibilityPersistenceClearSearchAttributeValuesScope tracks ClearSearchAttributeValues calls made by service to visibility persistence layer
+	VisibilityPersistenceClearSearchAttributeValuesScope = "ClearSearchAttributeValues"
+	// VisibilityPersistenceExplainQueryScope tracks ExplainQuery calls made by service to visibility persistence layer
+	VisibilityPersistenceExplainQueryScope = "ExplainQuery"
 )
 
 // Common
@@ -483,6 +494,10 @@ const (
 	ArchiverArchivalWorkflowScope = "ArchiverArchivalWorkflow"
 	// AddSearchAttributesWorkflowScope is scope used by all metrics emitted by worker.AddSearchAttributesWorkflowScope module
 	AddSearchAttributesWorkflowScope = "AddSearchAttributesWorkflow"
+	// RebuildVisibilityWorkflowScope is scope used by all metrics emitted by worker.RebuildVisibilityWorkflow module
+	RebuildVisibilityWorkflowScope = "RebuildVisibilityWorkflow"
+	// ChangeSearchAttributeTypeWorkflowScope is scope used by all metrics emitted by worker.ChangeSearchAttributeTypeWorkflow module
+	ChangeSearchAttributeTypeWorkflowScope = "ChangeSearchAttributeTypeWorkflow"
 	// BatcherScope is scope used by all metrics emitted by worker.Batcher module
 	BatcherScope = "Batcher"
 	// ElasticsearchBulkProcessor is scope used by all metric emitted by Elasticsearch bulk processor
@@ -960,6 +975,12 @@ var (
 	OutOfOrderBufferedEventsCounter                = NewCounterDef("out_of_order_buffered_events")
 	ShardLingerSuccess                             = NewTimerDef("shard_linger_success")
 	ShardLingerTimeouts                            = NewCounterDef("shard_linger_timeouts")
+	ShardLoadRequestsPerSecond                     = NewDimensionlessHistogramDef("shard_load_requests_per_second")
+	ShardLoadPersistenceLatency                    = NewTimerDef("shard_load_persistence_latency")
+	ShardLoadTaskBacklog                           = NewDimensionlessHistogramDef("shard_load_task_backlog")
+	HostShardLoadGauge                             = NewGaugeDef("host_shard_load")
+	ShardRebalancerTakeOvers                       = NewCounterDef("shard_rebalancer_take_overs")
+	ShardRebalancerReleases                        = NewCounterDef("shard_rebalancer_releases")
 	DynamicRateLimiterMultiplier                   = NewGaugeDef("dynamic_rate_limit_multiplier")
 	DLQWrites                                      = NewCounterDef(
 		"dlq_writes",
@@ -1032,6 +1053,8 @@ var (
 	TaskDispatchLatencyPerTaskQueue        = NewTimerDef("task_dispatch_latency")
 	ApproximateBacklogCount                = NewGaugeDef("approximate_backlog_count")
 	ApproximateBacklogAgeSeconds           = NewGaugeDef("approximate_backlog_age_seconds")
+	FairnessKeyBacklogCount                = NewGaugeDef("fairness_key_backlog_count")
+	FairnessKeyApproximateBacklogCount     = NewGaugeDef("fairness_key_approximate_backlog_count")
 	NonRetryableTasks                      = NewCounterDef(
 		"non_retryable_tasks",
 		WithDescription("The number of non-retryable matching tasks which are dropped due to specific errors"))
@@ -1068,11 +1091,20 @@ var (
 	HistoryScavengerSuccessCount                    = NewCounterDef("scavenger_success")
 	HistoryScavengerErrorCount                      = NewCounterDef("scavenger_errors")
 	HistoryScavengerSkipCount                       = NewCounterDef("scavenger_skips")
+	HistoryScavengerOrphanCount                     = NewCounterDef("scavenger_orphans")
 	ExecutionsOutstandingCount                      = NewGaugeDef("executions_outstanding")
 	ScavengerValidationRequestsCount                = NewCounterDef("scavenger_validation_requests")
 	ScavengerValidationFailuresCount                = NewCounterDef("scavenger_validation_failures")
 	ScavengerValidationSkipsCount                   = NewCounterDef("scavenger_validation_skips")
+	OrphanScavengerScannedCount                     = NewCounterDef("orphan_scavenger_scanned")
+	OrphanScavengerOrphanedCount                    = NewCounterDef("orphan_scavenger_orphaned")
+	OrphanScavengerRepairedCount                    = NewCounterDef("orphan_scavenger_repaired")
+	OrphanScavengerErrorCount                       = NewCounterDef("orphan_scavenger_errors")
 	AddSearchAttributesFailuresCount                = NewCounterDef("add_search_attributes_failures")
+	RebuildVisibilityRecords                        = NewCounterDef("rebuild_visibility_records")
+	RebuildVisibilityFailures                       = NewCounterDef("rebuild_visibility_failures")
+	ChangeSearchAttributeTypeUpdatedRecords         = NewCounterDef("change_search_attribute_type_updated_records")
+	ChangeSearchAttributeTypeFailedRecords          = NewCounterDef("change_search_attribute_type_failed_records")
 
 	// Delete Namespace metrics.
 	ReclaimResourcesNamespaceDeleteSuccessCount = NewCounterDef(
@@ -1217,6 +1249,26 @@ var (
 	CassandraSessionRefreshFailures        = NewCounterDef("cassandra_session_refresh_failures")
 	PersistenceSessionRefreshFailures      = NewCounterDef("persistence_session_refresh_failures")
 	PersistenceSessionRefreshAttempts      = NewCounterDef("persistence_session_refresh_attempts")
+	PersistenceReadReplicaLag              = NewGaugeDef(
+		"persistence_read_replica_lag_seconds",
+		WithDescription("Replication lag of a SQL read replica, keyed by `read_replica`"),
+	)
+	PersistenceReadReplicaFallbacks = NewCounterDef(
+		"persistence_read_replica_fallbacks",
+		WithDescription("Reads sent to the primary because no read replica was healthy or the read failed on the replica"),
+	)
+	VisibilityShadowReadComparisons = NewCounterDef(
+		"visibility_shadow_read_comparisons",
+		WithDescription("Shadow visibility reads whose result was compared with the result of the read visibility store, keyed by `operation`"),
+	)
+	VisibilityShadowReadMismatches = NewCounterDef(
+		"visibility_shadow_read_mismatches",
+		WithDescription("Shadow visibility reads whose result differed from the result of the read visibility store, keyed by `operation`"),
+	)
+	VisibilityShadowReadErrors = NewCounterDef(
+		"visibility_shadow_read_errors",
+		WithDescription("Shadow visibility reads that failed while the read of the read visibility store succeeded, keyed by `operation`"),
+	)
 
 	// Common service base metrics
 	RestartCount           = NewCounterDef("restarts")
@@ -255,6 +255,10 @@ func TaskPriorityTag(value string) Tag {
 	return &tagImpl{key: TaskPriorityTagName, value: value}
 }
 
+func FairnessKeyTag(value string) Tag {
+	return &tagImpl{key: FairnessKeyTagName, value: value}
+}
+
 func QueueReaderIDTag(readerID int64) Tag {
 	return &tagImpl{key: QueueReaderIDTagName, value: strconv.Itoa(int(readerID))}
 }
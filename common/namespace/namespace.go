@@ -71,6 +71,11 @@ const (
 	ReplicationPolicyOneCluster ReplicationPolicy = 0
 	// ReplicationPolicyMultiCluster indicate that workflows need to be replicated
 	ReplicationPolicyMultiCluster ReplicationPolicy = 1
+
+	// VirtualClockOffsetDataKey is the namespace data key holding how far the virtual clock of a test namespace is
+	// ahead of the real clock, as a duration string, e.g. "72h". It is only honored when the virtual clock is enabled
+	// for the namespace in dynamic config.
+	VirtualClockOffsetDataKey = "temporal.virtualClockOffset"
 )
 
 func NewID() ID {
@@ -263,6 +268,16 @@ func (ns *Namespace) GetCustomData(key string) string {
 	return ns.info.Data[key]
 }
 
+// VirtualClockOffset returns the offset of the virtual clock of the namespace from the namespace data.
+// Missing, invalid and negative offsets are 0: the virtual clock can only move forward.
+func (ns *Namespace) VirtualClockOffset() time.Duration {
+	offset, err := time.ParseDuration(ns.GetCustomData(VirtualClockOffsetDataKey))
+	if err != nil || offset < 0 {
+		return 0
+	}
+	return offset
+}
+
 // Retention returns retention duration for this namespace.
 func (ns *Namespace) Retention() time.Duration {
 	if ns.config.Retention == nil {
@@ -118,3 +118,17 @@ func TestNamespace_GetCustomData(t *testing.T) {
 	data2 := ns.GetCustomData("fake")
 	assert.Equal(t, "", data2)
 }
+
+func TestNamespace_VirtualClockOffset(t *testing.T) {
+	base := base(t)
+	require.Zero(t, base.VirtualClockOffset())
+
+	ns := base.Clone(namespace.WithData(namespace.VirtualClockOffsetDataKey, "72h"))
+	require.Equal(t, 72*time.Hour, ns.VirtualClockOffset())
+
+	ns = base.Clone(namespace.WithData(namespace.VirtualClockOffsetDataKey, "three days"))
+	require.Zero(t, ns.VirtualClockOffset())
+
+	ns = base.Clone(namespace.WithData(namespace.VirtualClockOffsetDataKey, "-1h"))
+	require.Zero(t, ns.VirtualClockOffset())
+}
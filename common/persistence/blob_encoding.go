@@ -0,0 +1,196 @@
+package persistence
+
+import (
+	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence/serialization"
+)
+
+// encodeBlob compresses the blob with the codec configured for the namespace and then encrypts it, if
+// encryption is enabled for the namespace. Blobs are always readable regardless of the current config,
+// the codec and the encryption key are recorded in the blob itself.
+func (m *executionManagerImpl) encodeBlob(
+	namespaceID string,
+	blob *commonpb.DataBlob,
+) (*commonpb.DataBlob, error) {
+	if blob == nil {
+		return nil, nil
+	}
+
+	blob, err := m.compressBlob(namespaceID, blob)
+	if err != nil {
+		return nil, err
+	}
+	if m.blobEncryptor == nil {
+		return blob, nil
+	}
+	return m.blobEncryptor.Encrypt(namespaceID, blob)
+}
+
+// decryptBlob reverts the encryption applied by encodeBlob. Decompression is left to the serializer, which
+// handles both compressed and uncompressed blobs.
+func (m *executionManagerImpl) decryptBlob(
+	blob *commonpb.DataBlob,
+) (*commonpb.DataBlob, error) {
+	if m.blobEncryptor == nil || blob == nil {
+		return blob, nil
+	}
+	return m.blobEncryptor.Decrypt(blob)
+}
+
+func (m *executionManagerImpl) compressBlob(
+	namespaceID string,
+	blob *commonpb.DataBlob,
+) (*commonpb.DataBlob, error) {
+	if m.blobCompression == nil {
+		return blob, nil
+	}
+
+	compressionType, err := serialization.ParseCompressionType(m.blobCompression(namespace.ID(namespaceID)))
+	if err != nil {
+		// Don't fail writes because of a bad config value, fall back to uncompressed blobs.
+		m.logger.Warn("Invalid persistence blob compression config, blobs will not be compressed.",
+			tag.WorkflowNamespaceID(namespaceID),
+			tag.Error(err),
+		)
+		return blob, nil
+	}
+	return serialization.CompressBlob(blob, compressionType)
+}
+
+func (m *executionManagerImpl) encodeBlobMap(
+	namespaceID string,
+	blobs map[int64]*commonpb.DataBlob,
+) error {
+	for key, blob := range blobs {
+		encoded, err := m.encodeBlob(namespaceID, blob)
+		if err != nil {
+			return err
+		}
+		blobs[key] = encoded
+	}
+	return nil
+}
+
+func (m *executionManagerImpl) decryptBlobMap(
+	blobs map[int64]*commonpb.DataBlob,
+) error {
+	for key, blob := range blobs {
+		decrypted, err := m.decryptBlob(blob)
+		if err != nil {
+			return err
+		}
+		blobs[key] = decrypted
+	}
+	return nil
+}
+
+func (m *executionManagerImpl) encodeWorkflowMutation(
+	mutation *InternalWorkflowMutation,
+) error {
+	var err error
+	namespaceID := mutation.NamespaceID
+
+	if mutation.ExecutionInfoBlob, err = m.encodeBlob(namespaceID, mutation.ExecutionInfoBlob); err != nil {
+		return err
+	}
+	if mutation.NewBufferedEvents, err = m.encodeBlob(namespaceID, mutation.NewBufferedEvents); err != nil {
+		return err
+	}
+	for key, blob := range mutation.UpsertTimerInfos {
+		if mutation.UpsertTimerInfos[key], err = m.encodeBlob(namespaceID, blob); err != nil {
+			return err
+		}
+	}
+	for _, blobs := range []map[int64]*commonpb.DataBlob{
+		mutation.UpsertActivityInfos,
+		mutation.UpsertChildExecutionInfos,
+		mutation.UpsertRequestCancelInfos,
+		mutation.UpsertSignalInfos,
+	} {
+		if err := m.encodeBlobMap(namespaceID, blobs); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *executionManagerImpl) encodeWorkflowSnapshot(
+	snapshot *InternalWorkflowSnapshot,
+) error {
+	var err error
+	namespaceID := snapshot.NamespaceID
+
+	if snapshot.ExecutionInfoBlob, err = m.encodeBlob(namespaceID, snapshot.ExecutionInfoBlob); err != nil {
+		return err
+	}
+	for key, blob := range snapshot.TimerInfos {
+		if snapshot.TimerInfos[key], err = m.encodeBlob(namespaceID, blob); err != nil {
+			return err
+		}
+	}
+	for _, blobs := range []map[int64]*commonpb.DataBlob{
+		snapshot.ActivityInfos,
+		snapshot.ChildExecutionInfos,
+		snapshot.RequestCancelInfos,
+		snapshot.SignalInfos,
+	} {
+		if err := m.encodeBlobMap(namespaceID, blobs); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *executionManagerImpl) decryptWorkflowMutableState(
+	state *InternalWorkflowMutableState,
+) error {
+	if m.blobEncryptor == nil {
+		return nil
+	}
+
+	var err error
+	if state.ExecutionInfo, err = m.decryptBlob(state.ExecutionInfo); err != nil {
+		return err
+	}
+	for i, blob := range state.BufferedEvents {
+		if state.BufferedEvents[i], err = m.decryptBlob(blob); err != nil {
+			return err
+		}
+	}
+	for key, blob := range state.TimerInfos {
+		if state.TimerInfos[key], err = m.decryptBlob(blob); err != nil {
+			return err
+		}
+	}
+	for _, blobs := range []map[int64]*commonpb.DataBlob{
+		state.ActivityInfos,
+		state.ChildExecutionInfos,
+		state.RequestCancelInfos,
+		state.SignalInfos,
+	} {
+		if err := m.decryptBlobMap(blobs); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// decodeHistoryNodes makes sure raw history returned to callers is neither encrypted nor compressed,
+// since those blobs are handed out to replication and clients unmodified.
+func (m *executionManagerImpl) decodeHistoryNodes(
+	nodes []InternalHistoryNode,
+) error {
+	for i := range nodes {
+		events, err := m.decryptBlob(nodes[i].Events)
+		if err != nil {
+			return err
+		}
+		if events, err = serialization.DecompressBlob(events); err != nil {
+			return err
+		}
+		nodes[i].Events = events
+	}
+	return nil
+}
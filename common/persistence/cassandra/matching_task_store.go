@@ -34,6 +34,13 @@ const (
 		`and task_id >= ? ` +
 		`and task_id < ?`
 
+	templateCompleteTaskQuery = `DELETE FROM tasks ` +
+		`WHERE namespace_id = ? ` +
+		`AND task_queue_name = ? ` +
+		`AND task_queue_type = ? ` +
+		`AND type = ? ` +
+		`AND task_id = ?`
+
 	templateCompleteTasksLessThanQuery = `DELETE FROM tasks ` +
 		`WHERE namespace_id = ? ` +
 		`AND task_queue_name = ? ` +
@@ -488,6 +495,25 @@ func (d *MatchingTaskStore) CompleteTasksLessThan(
 	return p.UnknownNumRowsAffected, nil
 }
 
+// CompleteTask deletes a single task
+func (d *MatchingTaskStore) CompleteTask(
+	ctx context.Context,
+	request *p.CompleteTaskRequest,
+) error {
+	query := d.Session.Query(
+		templateCompleteTaskQuery,
+		request.NamespaceID,
+		request.TaskQueueName,
+		request.TaskType,
+		rowTypeTaskInSubqueue(request.Subqueue),
+		request.TaskID,
+	).WithContext(ctx)
+	if err := query.Exec(); err != nil {
+		return gocql.ConvertError("CompleteTask", err)
+	}
+	return nil
+}
+
 func (d *MatchingTaskStore) GetTaskQueueUserData(
 	ctx context.Context,
 	request *p.GetTaskQueueUserDataRequest,
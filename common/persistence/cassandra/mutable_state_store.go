@@ -82,6 +82,11 @@ const (
 		`WHERE shard_id = ? ` +
 		`and type = ?`
 
+	templateListCurrentExecutionsQuery = `SELECT namespace_id, workflow_id, run_id, current_run_id, execution_state, execution_state_encoding ` +
+		`FROM executions ` +
+		`WHERE shard_id = ? ` +
+		`and type = ?`
+
 	// TODO deprecate templateUpdateWorkflowExecutionQueryDeprecated in favor of templateUpdateWorkflowExecutionQuery
 	// Deprecated.
 	templateUpdateWorkflowExecutionQueryDeprecated = `UPDATE executions ` +
@@ -1062,6 +1067,51 @@ func (d *MutableStateStore) ListConcreteExecutions(
 	return response, nil
 }
 
+func (d *MutableStateStore) ListCurrentExecutions(
+	ctx context.Context,
+	request *p.ListCurrentExecutionsRequest,
+) (*p.InternalListCurrentExecutionsResponse, error) {
+	query := d.Session.Query(
+		templateListCurrentExecutionsQuery,
+		request.ShardID,
+		rowTypeExecution,
+	).WithContext(ctx)
+	iter := query.PageSize(request.PageSize).PageState(request.PageToken).Iter()
+
+	// current executions share the partition of the concrete executions of the shard, so a page
+	// can hold fewer current executions than the page size
+	response := &p.InternalListCurrentExecutionsResponse{}
+	if len(iter.PageState()) > 0 {
+		response.NextPageToken = iter.PageState()
+	}
+	result := make(map[string]interface{})
+	for iter.MapScan(result) {
+		if gocql.UUIDToString(result["run_id"]) == permanentRunID {
+			executionStateBlob, err := executionStateBlobFromRow(result)
+			if err != nil {
+				return nil, serviceerror.NewUnavailable(fmt.Sprintf("ListCurrentExecutions operation failed. Error: %v", err))
+			}
+			executionState, err := serialization.WorkflowExecutionStateFromBlob(executionStateBlob.Data, executionStateBlob.EncodingType.String())
+			if err != nil {
+				return nil, err
+			}
+			workflowID, _ := result["workflow_id"].(string)
+			response.Executions = append(response.Executions, &p.CurrentWorkflowExecution{
+				NamespaceID: gocql.UUIDToString(result["namespace_id"]),
+				WorkflowID:  workflowID,
+				RunID:       gocql.UUIDToString(result["current_run_id"]),
+				State:       executionState.State,
+				Status:      executionState.Status,
+			})
+		}
+		result = make(map[string]interface{})
+	}
+	if err := iter.Close(); err != nil {
+		return nil, gocql.ConvertError("ListCurrentExecutions", err)
+	}
+	return response, nil
+}
+
 func mutableStateFromRow(
 	result map[string]interface{},
 ) (*p.InternalWorkflowMutableState, error) {
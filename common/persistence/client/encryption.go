@@ -0,0 +1,42 @@
+package client
+
+import (
+	"fmt"
+
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/persistence/encryption"
+)
+
+// newBlobEncryptor returns the BlobEncryptor for the persistence config, or nil if encryption at rest
+// is not configured.
+func newBlobEncryptor(cfg *config.Persistence) (encryption.BlobEncryptor, error) {
+	if cfg == nil || cfg.Encryption == nil {
+		return nil, nil
+	}
+
+	var keyProvider encryption.KeyProvider
+	var err error
+	switch cfg.Encryption.KeyProvider {
+	case config.EncryptionKeyProviderLocal:
+		keyProvider, err = encryption.NewLocalKeyProvider(cfg.Encryption.KeyFile)
+	default:
+		err = fmt.Errorf("unknown persistence encryption key provider: %q", cfg.Encryption.KeyProvider)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	enabled := cfg.BlobEncryption
+	if enabled == nil {
+		// same as the dynamic config default, blobs are only encrypted once encryption is turned on
+		enabled = dynamicconfig.GetBoolPropertyFnFilteredByNamespaceID(false)
+	}
+	return encryption.NewBlobEncryptor(
+		keyProvider,
+		enabled,
+		cfg.Encryption.DataKeyRotationInterval,
+		clock.NewRealTimeSource(),
+	), nil
+}
@@ -8,10 +8,13 @@ import (
 	"go.temporal.io/server/common/config"
 	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/persistence/cassandra"
 	"go.temporal.io/server/common/persistence/faultinjection"
+	"go.temporal.io/server/common/persistence/kv"
+	"go.temporal.io/server/common/persistence/recording"
 	"go.temporal.io/server/common/persistence/serialization"
 	"go.temporal.io/server/common/persistence/sql"
 	"go.temporal.io/server/common/persistence/telemetry"
@@ -159,6 +162,7 @@ func DataStoreFactoryProvider(
 	r resolver.ServiceResolver,
 	cfg *config.Persistence,
 	abstractDataStoreFactory AbstractDataStoreFactory,
+	dc *dynamicconfig.Collection,
 	logger log.Logger,
 	metricsHandler metrics.Handler,
 	tracerProvider trace.TracerProvider,
@@ -172,13 +176,31 @@ func DataStoreFactoryProvider(
 	case defaultStoreCfg.SQL != nil:
 		dataStoreFactory = sql.NewFactory(*defaultStoreCfg.SQL, r, string(clusterName), logger, metricsHandler)
 	case defaultStoreCfg.CustomDataStoreConfig != nil:
+		if abstractDataStoreFactory == nil {
+			// the replay datastore and the embedded key-value store are built in, they don't need to be provided
+			// by the server options
+			switch defaultStoreCfg.CustomDataStoreConfig.Name {
+			case recording.ReplayPluginName:
+				abstractDataStoreFactory = recording.AbstractReplayDataStoreFactory{}
+			case kv.PluginName:
+				abstractDataStoreFactory = kv.AbstractDataStoreFactory{}
+			}
+		}
 		dataStoreFactory = abstractDataStoreFactory.NewFactory(*defaultStoreCfg.CustomDataStoreConfig, r, string(clusterName), logger, metricsHandler)
 	default:
 		logger.Fatal("invalid config: one of cassandra or sql params must be specified for default data store")
 	}
 
-	if defaultStoreCfg.FaultInjection != nil {
-		dataStoreFactory = faultinjection.NewFaultInjectionDatastoreFactory(defaultStoreCfg.FaultInjection, dataStoreFactory)
+	if defaultStoreCfg.FaultInjection != nil || faultinjection.DynamicRulesEnabled.Get(dc)() {
+		dataStoreFactory = faultinjection.NewFaultInjectionDatastoreFactory(defaultStoreCfg.FaultInjection, dataStoreFactory, dc, logger)
+	}
+
+	if defaultStoreCfg.Recording != nil {
+		recordingFactory, err := recording.NewRecordingDataStoreFactory(defaultStoreCfg.Recording, dataStoreFactory, logger)
+		if err != nil {
+			logger.Fatal("unable to open persistence recording", tag.Error(err))
+		}
+		dataStoreFactory = recordingFactory
 	}
 
 	tracer := tracerProvider.Tracer(otel.ComponentPersistence)
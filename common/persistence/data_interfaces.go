@@ -311,6 +311,28 @@ type (
 		PageToken []byte
 	}
 
+	// ListCurrentExecutionsRequest is request to ListCurrentExecutions
+	ListCurrentExecutionsRequest struct {
+		ShardID   int32
+		PageSize  int
+		PageToken []byte
+	}
+
+	// ListCurrentExecutionsResponse is response to ListCurrentExecutions
+	ListCurrentExecutionsResponse struct {
+		Executions []*CurrentWorkflowExecution
+		PageToken  []byte
+	}
+
+	// CurrentWorkflowExecution is the current execution record of a workflow ID
+	CurrentWorkflowExecution struct {
+		NamespaceID string
+		WorkflowID  string
+		RunID       string
+		State       enumsspb.WorkflowExecutionState
+		Status      enumspb.WorkflowExecutionStatus
+	}
+
 	// WorkflowEvents is used as generic workflow history events transaction container
 	WorkflowEvents struct {
 		NamespaceID string
@@ -614,12 +636,6 @@ type (
 		NextPageToken []byte
 	}
 
-	// CompleteTaskRequest is used to complete a task
-	CompleteTaskRequest struct {
-		TaskQueue *TaskQueueKey
-		TaskID    int64
-	}
-
 	// CompleteTasksLessThanRequest contains the request params needed to invoke CompleteTasksLessThan API
 	CompleteTasksLessThanRequest struct {
 		NamespaceID        string
@@ -630,6 +646,15 @@ type (
 		Limit              int // Limit on the max number of tasks that can be completed. Required param
 	}
 
+	// CompleteTaskRequest contains the request params needed to invoke CompleteTask API
+	CompleteTaskRequest struct {
+		NamespaceID   string
+		TaskQueueName string
+		TaskType      enumspb.TaskQueueType
+		Subqueue      int
+		TaskID        int64
+	}
+
 	// CreateNamespaceRequest is used to create the namespace
 	CreateNamespaceRequest struct {
 		Namespace         *persistencespb.NamespaceDetail
@@ -744,6 +769,8 @@ type (
 	AppendHistoryNodesRequest struct {
 		// The shard to get history node data
 		ShardID int32
+		// The namespace of the workflow, it selects the compression and encryption of the events
+		NamespaceID string
 		// true if this is the first append request to the branch
 		IsNewBranch bool
 		// the info for clean up data in background
@@ -768,6 +795,8 @@ type (
 	AppendRawHistoryNodesRequest struct {
 		// The shard to get history node data
 		ShardID int32
+		// The namespace of the workflow, it selects the compression and encryption of the events
+		NamespaceID string
 		// true if this is the first append request to the branch
 		IsNewBranch bool
 		// the info for clean up data in background
@@ -862,6 +891,8 @@ type (
 		HistoryEventBlobs []*commonpb.DataBlob
 		// NodeIDs is the first event id of each history blob
 		NodeIDs []int64
+		// TransactionIDs is the transaction ID of each history blob
+		TransactionIDs []int64
 		// Token to read next page if there are more events beyond page size.
 		// Use this to set NextPageToken on ReadHistoryBranchRequest to read the next page.
 		// Empty means we have reached the last page, not need to continue
@@ -1092,6 +1123,7 @@ type (
 		// Scan operations
 
 		ListConcreteExecutions(ctx context.Context, request *ListConcreteExecutionsRequest) (*ListConcreteExecutionsResponse, error)
+		ListCurrentExecutions(ctx context.Context, request *ListCurrentExecutionsRequest) (*ListCurrentExecutionsResponse, error)
 
 		// Tasks related APIs
 
@@ -1155,6 +1187,9 @@ type (
 		//  - UnknownNumRowsAffected (this means all rows below value are deleted)
 		//  - number of rows deleted, which may be equal to limit
 		CompleteTasksLessThan(ctx context.Context, request *CompleteTasksLessThanRequest) (int, error)
+		// CompleteTask deletes a single task, e.g. a backlog task which was moved to another task queue. It doesn't
+		// fail if the task doesn't exist.
+		CompleteTask(ctx context.Context, request *CompleteTaskRequest) error
 
 		// GetTaskQueueUserData gets versioned user data.
 		// This data would only exist if a user uses APIs that generate it, such as the worker versioning related APIs.
@@ -0,0 +1,263 @@
+package encryption
+
+import (
+	"context"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/server/common/cache"
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/namespace"
+)
+
+// Encrypted blobs keep their original EncodingType and wrap the (possibly compressed) payload in an envelope:
+//
+//	0x00 | encryptionMagic | version | key ID length | key ID | wrapped key length (uint16) | wrapped key | nonce | ciphertext
+//
+// The leading zero byte can never start a valid proto3 message or a JSON document, so encrypted blobs can be
+// told apart from plaintext ones and both can be read side by side. The wrapped data key is stored in every
+// blob, so a blob can always be decrypted as long as the key provider still has the key encryption key.
+
+const (
+	encryptionMagic   byte = 'E'
+	encryptionVersion byte = 1
+
+	dataKeySize = 32
+
+	// DefaultDataKeyRotationInterval is how long a namespace data key is used to encrypt new blobs.
+	DefaultDataKeyRotationInterval = 24 * time.Hour
+
+	keyProviderTimeout    = 10 * time.Second
+	unwrappedKeyCacheSize = 4096
+)
+
+var (
+	errMalformedEnvelope = errors.New("malformed encrypted blob")
+)
+
+type (
+	// BlobEncryptor encrypts and decrypts persisted blobs with per-namespace data keys. Data keys are
+	// generated by the encryptor, wrapped by the KeyProvider and rotated after a configurable interval.
+	BlobEncryptor interface {
+		// Encrypt encrypts the blob if encryption is enabled for the namespace, otherwise the blob is returned as is.
+		Encrypt(namespaceID string, blob *commonpb.DataBlob) (*commonpb.DataBlob, error)
+		// Decrypt decrypts the blob if it was encrypted, otherwise the blob is returned as is.
+		Decrypt(blob *commonpb.DataBlob) (*commonpb.DataBlob, error)
+	}
+
+	blobEncryptorImpl struct {
+		keyProvider      KeyProvider
+		enabled          dynamicconfig.BoolPropertyFnWithNamespaceIDFilter
+		rotationInterval time.Duration
+		timeSource       clock.TimeSource
+
+		sync.Mutex
+		// dataKeys is the data key currently used for encryption, by namespace ID
+		dataKeys map[string]*dataKey
+		// unwrappedKeys caches the cipher for wrapped data keys seen on reads, by key ID and wrapped key
+		unwrappedKeys cache.Cache
+	}
+
+	dataKey struct {
+		aead       cipher.AEAD
+		keyID      string
+		wrappedKey []byte
+		expireTime time.Time
+	}
+)
+
+var _ BlobEncryptor = (*blobEncryptorImpl)(nil)
+
+// NewBlobEncryptor returns a new BlobEncryptor.
+func NewBlobEncryptor(
+	keyProvider KeyProvider,
+	enabled dynamicconfig.BoolPropertyFnWithNamespaceIDFilter,
+	rotationInterval time.Duration,
+	timeSource clock.TimeSource,
+) BlobEncryptor {
+	if rotationInterval <= 0 {
+		rotationInterval = DefaultDataKeyRotationInterval
+	}
+	return &blobEncryptorImpl{
+		keyProvider:      keyProvider,
+		enabled:          enabled,
+		rotationInterval: rotationInterval,
+		timeSource:       timeSource,
+		dataKeys:         make(map[string]*dataKey),
+		unwrappedKeys:    cache.New(unwrappedKeyCacheSize, nil),
+	}
+}
+
+// IsEncrypted returns true if data was written by a BlobEncryptor.
+func IsEncrypted(data []byte) bool {
+	return len(data) >= 2 && data[0] == 0 && data[1] == encryptionMagic
+}
+
+func (e *blobEncryptorImpl) Encrypt(
+	namespaceID string,
+	blob *commonpb.DataBlob,
+) (*commonpb.DataBlob, error) {
+	if blob == nil || len(blob.Data) == 0 || IsEncrypted(blob.Data) {
+		return blob, nil
+	}
+	if e.enabled == nil || !e.enabled(namespace.ID(namespaceID)) {
+		return blob, nil
+	}
+
+	key, err := e.getDataKey(namespaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	nonceSize := key.aead.NonceSize()
+	headerSize := 3 + 1 + len(key.keyID) + 2 + len(key.wrappedKey)
+	data := make([]byte, 0, headerSize+nonceSize+len(blob.Data)+key.aead.Overhead())
+	data = append(data, 0, encryptionMagic, encryptionVersion)
+	data = append(data, byte(len(key.keyID)))
+	data = append(data, key.keyID...)
+	data = binary.BigEndian.AppendUint16(data, uint16(len(key.wrappedKey)))
+	data = append(data, key.wrappedKey...)
+
+	nonce := data[len(data) : len(data)+nonceSize]
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	data = data[:len(data)+nonceSize]
+	data = key.aead.Seal(data, nonce, blob.Data, nil)
+
+	return &commonpb.DataBlob{
+		EncodingType: blob.EncodingType,
+		Data:         data,
+	}, nil
+}
+
+func (e *blobEncryptorImpl) Decrypt(
+	blob *commonpb.DataBlob,
+) (*commonpb.DataBlob, error) {
+	if blob == nil || !IsEncrypted(blob.Data) {
+		return blob, nil
+	}
+
+	keyID, wrappedKey, payload, err := parseEnvelope(blob.Data)
+	if err != nil {
+		return nil, err
+	}
+	aead, err := e.getUnwrappedKey(keyID, wrappedKey)
+	if err != nil {
+		return nil, err
+	}
+	if len(payload) < aead.NonceSize() {
+		return nil, errMalformedEnvelope
+	}
+	nonce, ciphertext := payload[:aead.NonceSize()], payload[aead.NonceSize():]
+	data, err := aead.Open(nil, nonce, ciphertext, nil)
+	if err != nil {
+		return nil, fmt.Errorf("unable to decrypt blob: %w", err)
+	}
+
+	return &commonpb.DataBlob{
+		EncodingType: blob.EncodingType,
+		Data:         data,
+	}, nil
+}
+
+func (e *blobEncryptorImpl) getDataKey(
+	namespaceID string,
+) (*dataKey, error) {
+	e.Lock()
+	defer e.Unlock()
+
+	now := e.timeSource.Now()
+	if key, ok := e.dataKeys[namespaceID]; ok && now.Before(key.expireTime) {
+		return key, nil
+	}
+
+	plaintextKey := make([]byte, dataKeySize)
+	if _, err := rand.Read(plaintextKey); err != nil {
+		return nil, err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), keyProviderTimeout)
+	defer cancel()
+	keyID, wrappedKey, err := e.keyProvider.WrapKey(ctx, namespaceID, plaintextKey)
+	if err != nil {
+		return nil, fmt.Errorf("unable to wrap data key: %w", err)
+	}
+	if len(keyID) > 255 || len(wrappedKey) > 65535 {
+		return nil, errors.New("key ID or wrapped data key returned by key provider is too long")
+	}
+	aead, err := newAEAD(plaintextKey)
+	if err != nil {
+		return nil, err
+	}
+
+	key := &dataKey{
+		aead:       aead,
+		keyID:      keyID,
+		wrappedKey: wrappedKey,
+		expireTime: now.Add(e.rotationInterval),
+	}
+	e.dataKeys[namespaceID] = key
+	e.unwrappedKeys.Put(unwrappedKeyCacheKey(keyID, wrappedKey), aead)
+	return key, nil
+}
+
+func (e *blobEncryptorImpl) getUnwrappedKey(
+	keyID string,
+	wrappedKey []byte,
+) (cipher.AEAD, error) {
+	cacheKey := unwrappedKeyCacheKey(keyID, wrappedKey)
+	if aead, ok := e.unwrappedKeys.Get(cacheKey).(cipher.AEAD); ok {
+		return aead, nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), keyProviderTimeout)
+	defer cancel()
+	plaintextKey, err := e.keyProvider.UnwrapKey(ctx, keyID, wrappedKey)
+	if err != nil {
+		return nil, fmt.Errorf("unable to unwrap data key: %w", err)
+	}
+	aead, err := newAEAD(plaintextKey)
+	if err != nil {
+		return nil, err
+	}
+	e.unwrappedKeys.Put(cacheKey, aead)
+	return aead, nil
+}
+
+func parseEnvelope(
+	data []byte,
+) (keyID string, wrappedKey []byte, payload []byte, err error) {
+	if len(data) < 4 || data[2] != encryptionVersion {
+		return "", nil, nil, errMalformedEnvelope
+	}
+	data = data[3:]
+
+	keyIDLen := int(data[0])
+	data = data[1:]
+	if len(data) < keyIDLen+2 {
+		return "", nil, nil, errMalformedEnvelope
+	}
+	keyID = string(data[:keyIDLen])
+	data = data[keyIDLen:]
+
+	wrappedKeyLen := int(binary.BigEndian.Uint16(data))
+	data = data[2:]
+	if len(data) < wrappedKeyLen {
+		return "", nil, nil, errMalformedEnvelope
+	}
+	return keyID, data[:wrappedKeyLen], data[wrappedKeyLen:], nil
+}
+
+func unwrappedKeyCacheKey(
+	keyID string,
+	wrappedKey []byte,
+) string {
+	return keyID + "/" + string(wrappedKey)
+}
@@ -0,0 +1,114 @@
+package encryption
+
+import (
+	"bytes"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/namespace"
+)
+
+func newTestEncryptor(t *testing.T, timeSource clock.TimeSource) BlobEncryptor {
+	path := filepath.Join(t.TempDir(), "keys.yaml")
+	writeKeyFile(t, path, "key-1", map[string][]byte{"key-1": newTestKey(t)})
+	provider, err := NewLocalKeyProvider(path)
+	require.NoError(t, err)
+
+	enabled := func(namespaceID namespace.ID) bool {
+		return namespaceID != "plaintext-namespace-id"
+	}
+	return NewBlobEncryptor(provider, enabled, time.Hour, timeSource)
+}
+
+func TestBlobEncryptor_RoundTrip(t *testing.T) {
+	encryptor := newTestEncryptor(t, clock.NewRealTimeSource())
+	data := bytes.Repeat([]byte("mutable-state"), 64)
+	blob := &commonpb.DataBlob{EncodingType: enumspb.ENCODING_TYPE_PROTO3, Data: data}
+
+	encrypted, err := encryptor.Encrypt("namespace-id", blob)
+	require.NoError(t, err)
+	require.True(t, IsEncrypted(encrypted.Data))
+	require.False(t, bytes.Contains(encrypted.Data, []byte("mutable-state")))
+	require.Equal(t, enumspb.ENCODING_TYPE_PROTO3, encrypted.EncodingType)
+
+	decrypted, err := encryptor.Decrypt(encrypted)
+	require.NoError(t, err)
+	require.Equal(t, data, decrypted.Data)
+	require.Equal(t, enumspb.ENCODING_TYPE_PROTO3, decrypted.EncodingType)
+
+	// another encryptor sharing the key file can decrypt the blob, e.g. after a restart
+	other := NewBlobEncryptor(encryptor.(*blobEncryptorImpl).keyProvider, dynamicconfig.GetBoolPropertyFnFilteredByNamespaceID(false), 0, clock.NewRealTimeSource())
+	decrypted, err = other.Decrypt(encrypted)
+	require.NoError(t, err)
+	require.Equal(t, data, decrypted.Data)
+}
+
+func TestBlobEncryptor_Disabled(t *testing.T) {
+	encryptor := newTestEncryptor(t, clock.NewRealTimeSource())
+	blob := &commonpb.DataBlob{EncodingType: enumspb.ENCODING_TYPE_PROTO3, Data: []byte("plaintext")}
+
+	result, err := encryptor.Encrypt("plaintext-namespace-id", blob)
+	require.NoError(t, err)
+	require.Same(t, blob, result)
+
+	// plaintext blobs are returned as is on reads
+	result, err = encryptor.Decrypt(blob)
+	require.NoError(t, err)
+	require.Same(t, blob, result)
+}
+
+func TestBlobEncryptor_DataKeyRotation(t *testing.T) {
+	timeSource := clock.NewEventTimeSource().Update(time.Now())
+	encryptor := newTestEncryptor(t, timeSource)
+	blob := &commonpb.DataBlob{EncodingType: enumspb.ENCODING_TYPE_PROTO3, Data: []byte("history-event")}
+
+	encrypted1, err := encryptor.Encrypt("namespace-id", blob)
+	require.NoError(t, err)
+	encrypted2, err := encryptor.Encrypt("namespace-id", blob)
+	require.NoError(t, err)
+	_, wrappedKey1, _, err := parseEnvelope(encrypted1.Data)
+	require.NoError(t, err)
+	_, wrappedKey2, _, err := parseEnvelope(encrypted2.Data)
+	require.NoError(t, err)
+	require.Equal(t, wrappedKey1, wrappedKey2)
+
+	// each namespace has its own data key
+	encrypted3, err := encryptor.Encrypt("other-namespace-id", blob)
+	require.NoError(t, err)
+	_, wrappedKey3, _, err := parseEnvelope(encrypted3.Data)
+	require.NoError(t, err)
+	require.NotEqual(t, wrappedKey1, wrappedKey3)
+
+	timeSource.Advance(2 * time.Hour)
+	encrypted4, err := encryptor.Encrypt("namespace-id", blob)
+	require.NoError(t, err)
+	_, wrappedKey4, _, err := parseEnvelope(encrypted4.Data)
+	require.NoError(t, err)
+	require.NotEqual(t, wrappedKey1, wrappedKey4)
+
+	for _, encrypted := range []*commonpb.DataBlob{encrypted1, encrypted3, encrypted4} {
+		decrypted, err := encryptor.Decrypt(encrypted)
+		require.NoError(t, err)
+		require.Equal(t, blob.Data, decrypted.Data)
+	}
+}
+
+func TestBlobEncryptor_Tampered(t *testing.T) {
+	encryptor := newTestEncryptor(t, clock.NewRealTimeSource())
+	blob := &commonpb.DataBlob{EncodingType: enumspb.ENCODING_TYPE_PROTO3, Data: []byte("history-event")}
+
+	encrypted, err := encryptor.Encrypt("namespace-id", blob)
+	require.NoError(t, err)
+	encrypted.Data[len(encrypted.Data)-1] ^= 0xff
+	_, err = encryptor.Decrypt(encrypted)
+	require.Error(t, err)
+
+	_, err = encryptor.Decrypt(&commonpb.DataBlob{Data: []byte{0, encryptionMagic, encryptionVersion, 10}})
+	require.Error(t, err)
+}
@@ -0,0 +1,18 @@
+package encryption
+
+import (
+	"context"
+)
+
+type (
+	// KeyProvider wraps and unwraps data keys with key encryption keys it manages. Implementations
+	// must keep every key that was ever used to wrap a data key, so that old blobs remain readable
+	// after a key rotation.
+	KeyProvider interface {
+		// WrapKey encrypts a namespace data key with the currently active key encryption key. It returns the ID
+		// of the key encryption key that was used, which is later passed back to UnwrapKey.
+		WrapKey(ctx context.Context, namespaceID string, dataKey []byte) (keyID string, wrappedKey []byte, err error)
+		// UnwrapKey decrypts a data key previously returned by WrapKey.
+		UnwrapKey(ctx context.Context, keyID string, wrappedKey []byte) ([]byte, error)
+	}
+)
@@ -0,0 +1,167 @@
+package encryption
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	// localKeyFileCheckInterval is how often the local key provider checks the key file for changes.
+	localKeyFileCheckInterval = time.Minute
+)
+
+type (
+	// localKeyFile is the format of the key file read by the local key provider:
+	//
+	//	activeKeyID: key-2
+	//	keys:
+	//	  key-1: <base64 encoded 32 byte key>
+	//	  key-2: <base64 encoded 32 byte key>
+	//
+	// To rotate keys, add a new key and point activeKeyID to it. Old keys must stay in the file for as long
+	// as there are blobs whose data keys were wrapped with them.
+	localKeyFile struct {
+		ActiveKeyID string            `yaml:"activeKeyID"`
+		Keys        map[string]string `yaml:"keys"`
+	}
+
+	localKeyProvider struct {
+		path string
+
+		sync.RWMutex
+		activeKeyID string
+		keys        map[string]cipher.AEAD
+		modTime     time.Time
+		lastCheck   time.Time
+	}
+)
+
+var _ KeyProvider = (*localKeyProvider)(nil)
+
+// NewLocalKeyProvider returns a KeyProvider that wraps data keys with AES-256-GCM using key encryption
+// keys read from a local key file. The file is reloaded when it changes.
+func NewLocalKeyProvider(path string) (KeyProvider, error) {
+	p := &localKeyProvider{path: path}
+	if err := p.reload(); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// WrapKey implements KeyProvider. The namespace ID is not used, all namespaces share the active key.
+func (p *localKeyProvider) WrapKey(_ context.Context, _ string, dataKey []byte) (string, []byte, error) {
+	p.maybeReload()
+
+	p.RLock()
+	defer p.RUnlock()
+
+	aead := p.keys[p.activeKeyID]
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", nil, err
+	}
+	return p.activeKeyID, aead.Seal(nonce, nonce, dataKey, []byte(p.activeKeyID)), nil
+}
+
+// UnwrapKey implements KeyProvider.
+func (p *localKeyProvider) UnwrapKey(_ context.Context, keyID string, wrappedKey []byte) ([]byte, error) {
+	p.maybeReload()
+
+	p.RLock()
+	aead, ok := p.keys[keyID]
+	p.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("key encryption key %q not found in key file %v", keyID, p.path)
+	}
+
+	if len(wrappedKey) < aead.NonceSize() {
+		return nil, errors.New("wrapped data key is too short")
+	}
+	nonce, ciphertext := wrappedKey[:aead.NonceSize()], wrappedKey[aead.NonceSize():]
+	return aead.Open(nil, nonce, ciphertext, []byte(keyID))
+}
+
+func (p *localKeyProvider) maybeReload() {
+	p.RLock()
+	shouldCheck := time.Since(p.lastCheck) >= localKeyFileCheckInterval
+	p.RUnlock()
+	if !shouldCheck {
+		return
+	}
+	// Keep using the keys that are already loaded if the file is temporarily unreadable or invalid,
+	// NewLocalKeyProvider has verified that there is a usable key file.
+	_ = p.reload()
+}
+
+func (p *localKeyProvider) reload() error {
+	p.Lock()
+	defer p.Unlock()
+	p.lastCheck = time.Now()
+
+	info, err := os.Stat(p.path)
+	if err != nil {
+		return fmt.Errorf("unable to read key file: %w", err)
+	}
+	if p.keys != nil && info.ModTime().Equal(p.modTime) {
+		return nil
+	}
+
+	content, err := os.ReadFile(p.path)
+	if err != nil {
+		return fmt.Errorf("unable to read key file: %w", err)
+	}
+	activeKeyID, keys, err := parseLocalKeyFile(content)
+	if err != nil {
+		return fmt.Errorf("invalid key file %v: %w", p.path, err)
+	}
+
+	p.activeKeyID = activeKeyID
+	p.keys = keys
+	p.modTime = info.ModTime()
+	return nil
+}
+
+func parseLocalKeyFile(content []byte) (string, map[string]cipher.AEAD, error) {
+	var file localKeyFile
+	if err := yaml.Unmarshal(content, &file); err != nil {
+		return "", nil, err
+	}
+	if _, ok := file.Keys[file.ActiveKeyID]; !ok {
+		return "", nil, fmt.Errorf("active key %q is not defined", file.ActiveKeyID)
+	}
+
+	keys := make(map[string]cipher.AEAD, len(file.Keys))
+	for keyID, encodedKey := range file.Keys {
+		key, err := base64.StdEncoding.DecodeString(encodedKey)
+		if err != nil {
+			return "", nil, fmt.Errorf("key %q is not valid base64: %w", keyID, err)
+		}
+		aead, err := newAEAD(key)
+		if err != nil {
+			return "", nil, fmt.Errorf("key %q: %w", keyID, err)
+		}
+		keys[keyID] = aead
+	}
+	return file.ActiveKeyID, keys, nil
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	if len(key) != dataKeySize {
+		return nil, fmt.Errorf("key must be %d bytes, got %d", dataKeySize, len(key))
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
@@ -0,0 +1,96 @@
+package encryption
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func writeKeyFile(t *testing.T, path string, activeKeyID string, keys map[string][]byte) {
+	content := fmt.Sprintf("activeKeyID: %s\nkeys:\n", activeKeyID)
+	for keyID, key := range keys {
+		content += fmt.Sprintf("  %s: %s\n", keyID, base64.StdEncoding.EncodeToString(key))
+	}
+	require.NoError(t, os.WriteFile(path, []byte(content), 0600))
+}
+
+func newTestKey(t *testing.T) []byte {
+	key := make([]byte, dataKeySize)
+	_, err := rand.Read(key)
+	require.NoError(t, err)
+	return key
+}
+
+func TestLocalKeyProvider_WrapUnwrap(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "keys.yaml")
+	writeKeyFile(t, path, "key-1", map[string][]byte{"key-1": newTestKey(t)})
+
+	provider, err := NewLocalKeyProvider(path)
+	require.NoError(t, err)
+
+	dataKey := newTestKey(t)
+	keyID, wrappedKey, err := provider.WrapKey(context.Background(), "namespace-id", dataKey)
+	require.NoError(t, err)
+	require.Equal(t, "key-1", keyID)
+	require.NotContains(t, string(wrappedKey), string(dataKey))
+
+	unwrappedKey, err := provider.UnwrapKey(context.Background(), keyID, wrappedKey)
+	require.NoError(t, err)
+	require.Equal(t, dataKey, unwrappedKey)
+
+	_, err = provider.UnwrapKey(context.Background(), "key-2", wrappedKey)
+	require.Error(t, err)
+}
+
+func TestLocalKeyProvider_Rotation(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "keys.yaml")
+	key1 := newTestKey(t)
+	writeKeyFile(t, path, "key-1", map[string][]byte{"key-1": key1})
+
+	provider, err := NewLocalKeyProvider(path)
+	require.NoError(t, err)
+
+	dataKey := newTestKey(t)
+	_, wrappedKey1, err := provider.WrapKey(context.Background(), "namespace-id", dataKey)
+	require.NoError(t, err)
+
+	writeKeyFile(t, path, "key-2", map[string][]byte{"key-1": key1, "key-2": newTestKey(t)})
+	require.NoError(t, os.Chtimes(path, time.Now(), time.Now().Add(time.Minute)))
+	require.NoError(t, provider.(*localKeyProvider).reload())
+
+	keyID, wrappedKey2, err := provider.WrapKey(context.Background(), "namespace-id", dataKey)
+	require.NoError(t, err)
+	require.Equal(t, "key-2", keyID)
+
+	// data keys wrapped with the old key are still readable
+	unwrappedKey, err := provider.UnwrapKey(context.Background(), "key-1", wrappedKey1)
+	require.NoError(t, err)
+	require.Equal(t, dataKey, unwrappedKey)
+	unwrappedKey, err = provider.UnwrapKey(context.Background(), "key-2", wrappedKey2)
+	require.NoError(t, err)
+	require.Equal(t, dataKey, unwrappedKey)
+}
+
+func TestLocalKeyProvider_InvalidKeyFile(t *testing.T) {
+	dir := t.TempDir()
+
+	_, err := NewLocalKeyProvider(filepath.Join(dir, "missing.yaml"))
+	require.Error(t, err)
+
+	path := filepath.Join(dir, "no-active-key.yaml")
+	writeKeyFile(t, path, "key-2", map[string][]byte{"key-1": newTestKey(t)})
+	_, err = NewLocalKeyProvider(path)
+	require.Error(t, err)
+
+	path = filepath.Join(dir, "short-key.yaml")
+	writeKeyFile(t, path, "key-1", map[string][]byte{"key-1": []byte("too-short")})
+	_, err = NewLocalKeyProvider(path)
+	require.Error(t, err)
+}
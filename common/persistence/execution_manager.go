@@ -18,6 +18,7 @@ import (
 	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/persistence/encryption"
 	"go.temporal.io/server/common/persistence/serialization"
 	"go.temporal.io/server/common/persistence/versionhistory"
 	"go.temporal.io/server/service/history/tasks"
@@ -32,6 +33,8 @@ type (
 		logger                log.Logger
 		pagingTokenSerializer *jsonHistoryTokenSerializer
 		transactionSizeLimit  dynamicconfig.IntPropertyFn
+		blobCompression       dynamicconfig.StringPropertyFnWithNamespaceIDFilter
+		blobEncryptor         encryption.BlobEncryptor
 	}
 )
 
@@ -44,6 +47,8 @@ func NewExecutionManager(
 	eventBlobCache XDCCache,
 	logger log.Logger,
 	transactionSizeLimit dynamicconfig.IntPropertyFn,
+	blobCompression dynamicconfig.StringPropertyFnWithNamespaceIDFilter,
+	blobEncryptor encryption.BlobEncryptor,
 ) ExecutionManager {
 	return &executionManagerImpl{
 		serializer:            serializer,
@@ -52,6 +57,8 @@ func NewExecutionManager(
 		logger:                logger,
 		pagingTokenSerializer: newJSONHistoryTokenSerializer(),
 		transactionSizeLimit:  transactionSizeLimit,
+		blobCompression:       blobCompression,
+		blobEncryptor:         blobEncryptor,
 	}
 }
 
@@ -439,7 +446,7 @@ func (m *executionManagerImpl) serializeWorkflowEventBatches(
 	xdcKVs := make(map[XDCCacheKey]XDCCacheValue, len(eventBatches))
 	workflowNewEvents := make([]*InternalAppendHistoryNodesRequest, 0, len(eventBatches))
 	for _, workflowEvents := range eventBatches {
-		newEvents, err := m.serializeWorkflowEvents(shardID, workflowEvents)
+		newEvents, eventsBlob, err := m.serializeWorkflowEvents(shardID, workflowEvents)
 		if err != nil {
 			return nil, nil, nil, err
 		}
@@ -458,13 +465,13 @@ func (m *executionManagerImpl) serializeWorkflowEventBatches(
 		)] = NewXDCCacheValue(
 			baseWorkflowInfo,
 			versionHistoryItems,
-			[]*commonpb.DataBlob{newEvents.Node.Events},
+			[]*commonpb.DataBlob{eventsBlob},
 			workflowEvents.Events[len(workflowEvents.Events)-1].EventId+1,
 		)
 		newEvents.ShardID = shardID
-		workflowNewEvents = append(workflowNewEvents, newEvents)
-		historyStatistics.SizeDiff += len(newEvents.Node.Events.Data)
+		historyStatistics.SizeDiff += len(eventsBlob.Data)
 		historyStatistics.CountDiff += len(workflowEvents.Events)
+		workflowNewEvents = append(workflowNewEvents, newEvents)
 	}
 	return xdcKVs, workflowNewEvents, &historyStatistics, nil
 }
@@ -504,13 +511,14 @@ func (m *executionManagerImpl) DeserializeBufferedEvents( // unexport
 func (m *executionManagerImpl) serializeWorkflowEvents(
 	shardID int32,
 	workflowEvents *WorkflowEvents,
-) (*InternalAppendHistoryNodesRequest, error) {
+) (*InternalAppendHistoryNodesRequest, *commonpb.DataBlob, error) {
 	if len(workflowEvents.Events) == 0 {
-		return nil, nil // allow update workflow without events
+		return nil, nil, nil // allow update workflow without events
 	}
 
 	request := &AppendHistoryNodesRequest{
 		ShardID:           shardID,
+		NamespaceID:       workflowEvents.NamespaceID,
 		BranchToken:       workflowEvents.BranchToken,
 		Events:            workflowEvents.Events,
 		PrevTransactionID: workflowEvents.PrevTxnID,
@@ -644,6 +652,9 @@ func (m *executionManagerImpl) SerializeWorkflowMutation( // unexport
 		return nil, err
 	}
 
+	if err := m.encodeWorkflowMutation(result); err != nil {
+		return nil, err
+	}
 	return result, nil
 }
 
@@ -741,6 +752,9 @@ func (m *executionManagerImpl) SerializeWorkflowSnapshot( // unexport
 		return nil, err
 	}
 
+	if err := m.encodeWorkflowSnapshot(result); err != nil {
+		return nil, err
+	}
 	return result, nil
 }
 
@@ -804,6 +818,20 @@ func (m *executionManagerImpl) ListConcreteExecutions(
 	return newResponse, nil
 }
 
+func (m *executionManagerImpl) ListCurrentExecutions(
+	ctx context.Context,
+	request *ListCurrentExecutionsRequest,
+) (*ListCurrentExecutionsResponse, error) {
+	response, err := m.persistence.ListCurrentExecutions(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	return &ListCurrentExecutionsResponse{
+		Executions: response.Executions,
+		PageToken:  response.NextPageToken,
+	}, nil
+}
+
 func (m *executionManagerImpl) AddHistoryTasks(
 	ctx context.Context,
 	input *AddHistoryTasksRequest,
@@ -996,6 +1024,10 @@ func (m *executionManagerImpl) trimHistoryNode(
 }
 
 func (m *executionManagerImpl) toWorkflowMutableState(internState *InternalWorkflowMutableState) (*persistencespb.WorkflowMutableState, error) {
+	if err := m.decryptWorkflowMutableState(internState); err != nil {
+		return nil, err
+	}
+
 	state := &persistencespb.WorkflowMutableState{
 		ActivityInfos:       make(map[int64]*persistencespb.ActivityInfo),
 		TimerInfos:          make(map[string]*persistencespb.TimerInfo),
@@ -1,7 +1,10 @@
 package faultinjection
 
 import (
+	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/persistence"
 )
 
@@ -9,6 +12,7 @@ type (
 	FaultInjectionDataStoreFactory struct {
 		baseFactory persistence.DataStoreFactory
 		fiConfig    *config.FaultInjection
+		rules       *dynamicRules
 
 		taskStore          persistence.TaskStore
 		shardStore         persistence.ShardStore
@@ -21,17 +25,23 @@ type (
 	}
 )
 
+// NewFaultInjectionDatastoreFactory wraps the data stores of baseFactory to inject the faults of fiConfig, which can
+// be nil, and of the DynamicRules.
 func NewFaultInjectionDatastoreFactory(
 	fiConfig *config.FaultInjection,
 	baseFactory persistence.DataStoreFactory,
+	dc *dynamicconfig.Collection,
+	logger log.Logger,
 ) *FaultInjectionDataStoreFactory {
 	return &FaultInjectionDataStoreFactory{
 		baseFactory: baseFactory,
 		fiConfig:    fiConfig,
+		rules:       newDynamicRules(dc, clock.NewRealTimeSource(), logger),
 	}
 }
 
 func (d *FaultInjectionDataStoreFactory) Close() {
+	d.rules.close()
 	d.baseFactory.Close()
 }
 
@@ -41,14 +51,7 @@ func (d *FaultInjectionDataStoreFactory) NewTaskStore() (persistence.TaskStore,
 		if err != nil {
 			return nil, err
 		}
-		if storeConfig, ok := d.fiConfig.Targets.DataStores[config.TaskStoreName]; ok && len(storeConfig.Methods) > 0 {
-			d.taskStore = newFaultInjectionTaskStore(
-				baseStore,
-				newStoreFaultGenerator(&storeConfig),
-			)
-		} else {
-			d.taskStore = baseStore
-		}
+		d.taskStore = newFaultInjectionTaskStore(baseStore, d.newFaultGenerator(config.TaskStoreName))
 	}
 	return d.taskStore, nil
 }
@@ -59,31 +62,18 @@ func (d *FaultInjectionDataStoreFactory) NewShardStore() (persistence.ShardStore
 		if err != nil {
 			return nil, err
 		}
-		if storeConfig, ok := d.fiConfig.Targets.DataStores[config.ShardStoreName]; ok && len(storeConfig.Methods) > 0 {
-			d.shardStore = newFaultInjectionShardStore(
-				baseStore,
-				newStoreFaultGenerator(&storeConfig),
-			)
-		} else {
-			d.shardStore = baseStore
-		}
+		d.shardStore = newFaultInjectionShardStore(baseStore, d.newFaultGenerator(config.ShardStoreName))
 	}
 	return d.shardStore, nil
 }
+
 func (d *FaultInjectionDataStoreFactory) NewMetadataStore() (persistence.MetadataStore, error) {
 	if d.metadataStore == nil {
 		baseStore, err := d.baseFactory.NewMetadataStore()
 		if err != nil {
 			return nil, err
 		}
-		if storeConfig, ok := d.fiConfig.Targets.DataStores[config.MetadataStoreName]; ok && len(storeConfig.Methods) > 0 {
-			d.metadataStore = newFaultInjectionMetadataStore(
-				baseStore,
-				newStoreFaultGenerator(&storeConfig),
-			)
-		} else {
-			d.metadataStore = baseStore
-		}
+		d.metadataStore = newFaultInjectionMetadataStore(baseStore, d.newFaultGenerator(config.MetadataStoreName))
 	}
 	return d.metadataStore, nil
 }
@@ -94,14 +84,7 @@ func (d *FaultInjectionDataStoreFactory) NewExecutionStore() (persistence.Execut
 		if err != nil {
 			return nil, err
 		}
-		if storeConfig, ok := d.fiConfig.Targets.DataStores[config.ExecutionStoreName]; ok && len(storeConfig.Methods) > 0 {
-			d.executionStore = newFaultInjectionExecutionStore(
-				baseStore,
-				newStoreFaultGenerator(&storeConfig),
-			)
-		} else {
-			d.executionStore = baseStore
-		}
+		d.executionStore = newFaultInjectionExecutionStore(baseStore, d.newFaultGenerator(config.ExecutionStoreName))
 	}
 	return d.executionStore, nil
 }
@@ -112,14 +95,7 @@ func (d *FaultInjectionDataStoreFactory) NewQueue(queueType persistence.QueueTyp
 		if err != nil {
 			return baseQueue, err
 		}
-		if storeConfig, ok := d.fiConfig.Targets.DataStores[config.QueueName]; ok && len(storeConfig.Methods) > 0 {
-			d.queue = newFaultInjectionQueue(
-				baseQueue,
-				newStoreFaultGenerator(&storeConfig),
-			)
-		} else {
-			d.queue = baseQueue
-		}
+		d.queue = newFaultInjectionQueue(baseQueue, d.newFaultGenerator(config.QueueName))
 	}
 	return d.queue, nil
 }
@@ -130,14 +106,7 @@ func (d *FaultInjectionDataStoreFactory) NewQueueV2() (persistence.QueueV2, erro
 		if err != nil {
 			return baseQueue, err
 		}
-		if storeConfig, ok := d.fiConfig.Targets.DataStores[config.QueueV2Name]; ok && len(storeConfig.Methods) > 0 {
-			d.queueV2 = newFaultInjectionQueueV2(
-				baseQueue,
-				newStoreFaultGenerator(&storeConfig),
-			)
-		} else {
-			d.queueV2 = baseQueue
-		}
+		d.queueV2 = newFaultInjectionQueueV2(baseQueue, d.newFaultGenerator(config.QueueV2Name))
 	}
 	return d.queueV2, nil
 }
@@ -148,14 +117,7 @@ func (d *FaultInjectionDataStoreFactory) NewClusterMetadataStore() (persistence.
 		if err != nil {
 			return nil, err
 		}
-		if storeConfig, ok := d.fiConfig.Targets.DataStores[config.ClusterMDStoreName]; ok && len(storeConfig.Methods) > 0 {
-			d.clusterMDStore = newFaultInjectionClusterMetadataStore(
-				baseStore,
-				newStoreFaultGenerator(&storeConfig),
-			)
-		} else {
-			d.clusterMDStore = baseStore
-		}
+		d.clusterMDStore = newFaultInjectionClusterMetadataStore(baseStore, d.newFaultGenerator(config.ClusterMDStoreName))
 	}
 	return d.clusterMDStore, nil
 }
@@ -166,14 +128,21 @@ func (d *FaultInjectionDataStoreFactory) NewNexusEndpointStore() (persistence.Ne
 		if err != nil {
 			return nil, err
 		}
-		if storeConfig, ok := d.fiConfig.Targets.DataStores[config.NexusEndpointStoreName]; ok && len(storeConfig.Methods) > 0 {
-			d.nexusEndpointStore = newFaultInjectionNexusEndpointStore(
-				baseStore,
-				newStoreFaultGenerator(&storeConfig),
-			)
-		} else {
-			d.nexusEndpointStore = baseStore
-		}
+		d.nexusEndpointStore = newFaultInjectionNexusEndpointStore(baseStore, d.newFaultGenerator(config.NexusEndpointStoreName))
 	}
 	return d.nexusEndpointStore, nil
 }
+
+// newFaultGenerator returns the fault generator of a data store. Stores are always wrapped because dynamic rules
+// can be added at any time, statically configured faults take precedence over them.
+func (d *FaultInjectionDataStoreFactory) newFaultGenerator(dataStoreName config.DataStoreName) faultGenerator {
+	dynamicGenerator := newDynamicFaultGenerator(dataStoreName, d.rules)
+	if d.fiConfig == nil {
+		return dynamicGenerator
+	}
+	storeConfig, ok := d.fiConfig.Targets.DataStores[dataStoreName]
+	if !ok || len(storeConfig.Methods) == 0 {
+		return dynamicGenerator
+	}
+	return compositeFaultGenerator{newStoreFaultGenerator(&storeConfig), dynamicGenerator}
+}
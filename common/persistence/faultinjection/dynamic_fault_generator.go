@@ -0,0 +1,314 @@
+package faultinjection
+
+import (
+	"fmt"
+	"math/rand"
+	"reflect"
+	"slices"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+)
+
+type (
+	// Rule injects a fault into the calls of data store methods that match it. Empty fields match any call.
+	Rule struct {
+		// Store is the name of the data store, e.g. "ExecutionStore". See config.DataStoreName for the list of
+		// valid datastore names.
+		Store config.DataStoreName
+		// Method is the name of the data store method, e.g. "UpdateWorkflowExecution".
+		Method string
+		// NamespaceID only matches calls with a request for this namespace.
+		NamespaceID string
+		// ShardID only matches calls with a request for this shard.
+		ShardID int32
+		// Error is the name of the error to return, e.g. "Timeout". See FaultInjectionMethodConfig.Errors for the
+		// list of valid error names. No error is returned if it's empty, which is useful to only inject latency.
+		Error string
+		// Rate is the probability of the fault being injected into a matching call, 1.0 if it's not set.
+		Rate float64
+		// Latency is added to matching calls before they are executed or the error is returned. The call fails
+		// with a persistence.TimeoutError if its context is done first.
+		Latency time.Duration
+		// StartTime and EndTime are the RFC3339 times the rule is active between.
+		StartTime string
+		EndTime   string
+		// Duration is how long the rule is active for after it was added to the dynamic config.
+		Duration time.Duration
+	}
+
+	// dynamicRules are the rules of the DynamicRules dynamic config, they are updated without a restart.
+	dynamicRules struct {
+		sync.Mutex
+		timeSource clock.TimeSource
+		logger     log.Logger
+		cancel     func()
+		firstSeen  map[Rule]time.Time
+		rules      atomic.Pointer[[]*dynamicRule]
+	}
+
+	dynamicRule struct {
+		Rule
+		fault fault
+		start time.Time
+		end   time.Time
+	}
+
+	// dynamicFaultGenerator is an implementation of faultGenerator that will inject faults into the persistence
+	// layer using the dynamic rules of a data store.
+	dynamicFaultGenerator struct {
+		dataStoreName config.DataStoreName
+		rules         *dynamicRules
+	}
+
+	// compositeFaultGenerator returns the first fault generated by its generators.
+	compositeFaultGenerator []faultGenerator
+
+	requestScope struct {
+		shardID     int32
+		namespaceID string
+	}
+
+	requestScopeFields struct {
+		shardID     []int
+		namespaceID []int
+	}
+)
+
+// DynamicRules are the fault injection rules of all data stores. They are only applied if the data stores are
+// wrapped for fault injection, see DynamicRulesEnabled.
+var DynamicRules = dynamicconfig.NewGlobalTypedSetting(
+	"system.persistenceFaultInjectionRules",
+	[]Rule(nil),
+	`PersistenceFaultInjectionRules is a list of rules for injecting faults into persistence calls at runtime, the first
+rule that matches a call is applied. It only has an effect if PersistenceFaultInjectionRulesEnabled is true or
+persistence.datastores.<name>.faultInjection is set in the static config. Fields: Store, Method, NamespaceID, ShardID, Error, Rate, Latency, StartTime, EndTime, Duration. See
+faultinjection.Rule comments for more details. For example, to fail UpdateWorkflowExecution calls of shard 7 for 30
+seconds: [{Store: ExecutionStore, Method: UpdateWorkflowExecution, ShardID: 7, Error: Unavailable, Duration: 30s}]`,
+)
+
+// DynamicRulesEnabled wraps the data stores for fault injection at startup, so that DynamicRules can be added
+// later without a static faultInjection config.
+var DynamicRulesEnabled = dynamicconfig.NewGlobalBoolSetting(
+	"system.persistenceFaultInjectionRulesEnabled",
+	false,
+	`PersistenceFaultInjectionRulesEnabled wraps the persistence data stores so that PersistenceFaultInjectionRules are
+applied, even if persistence.datastores.<name>.faultInjection isn't set in the static config. It's only read at
+startup. The wrapped data stores check for matching rules on every call, so it should only be enabled in test
+clusters.`,
+)
+
+// requestScopeFieldsCache caches the requestScopeFields of request types.
+var requestScopeFieldsCache sync.Map
+
+func newDynamicRules(
+	dc *dynamicconfig.Collection,
+	timeSource clock.TimeSource,
+	logger log.Logger,
+) *dynamicRules {
+	r := &dynamicRules{
+		timeSource: timeSource,
+		logger:     logger,
+	}
+	rules, cancel := DynamicRules.Subscribe(dc)(r.update)
+	r.cancel = cancel
+	r.update(rules)
+	return r
+}
+
+func (r *dynamicRules) close() {
+	if r.cancel != nil {
+		r.cancel()
+	}
+}
+
+func (r *dynamicRules) update(rules []Rule) {
+	r.Lock()
+	defer r.Unlock()
+
+	now := r.timeSource.Now()
+	firstSeen := make(map[Rule]time.Time, len(rules))
+	compiled := make([]*dynamicRule, 0, len(rules))
+	for _, rule := range rules {
+		seen, ok := r.firstSeen[rule]
+		if !ok {
+			seen = now
+		}
+		firstSeen[rule] = seen
+
+		c, err := newDynamicRule(rule, seen)
+		if err != nil {
+			r.logger.Warn("Ignoring invalid persistence fault injection rule", tag.Value(rule), tag.Error(err))
+			continue
+		}
+		compiled = append(compiled, c)
+	}
+	r.firstSeen = firstSeen
+	r.rules.Store(&compiled)
+}
+
+func newDynamicRule(rule Rule, firstSeen time.Time) (*dynamicRule, error) {
+	rate := rule.Rate
+	if rate == 0 {
+		rate = 1
+	}
+	if rate < 0 || rate > 1 {
+		return nil, fmt.Errorf("rate %v is not between 0 and 1", rule.Rate)
+	}
+
+	c := &dynamicRule{Rule: rule}
+	if rule.Error != "" {
+		methodName := rule.Method
+		if methodName == "" {
+			methodName = "*"
+		}
+		f, err := parseFault(rule.Error, rate, methodName)
+		if err != nil {
+			return nil, err
+		}
+		c.fault = f
+	} else if rule.Latency <= 0 {
+		return nil, fmt.Errorf("rule has neither an error nor a latency")
+	}
+	c.fault.rate = rate
+	c.fault.latency = rule.Latency
+
+	var err error
+	if rule.StartTime != "" {
+		if c.start, err = time.Parse(time.RFC3339, rule.StartTime); err != nil {
+			return nil, err
+		}
+	}
+	if rule.EndTime != "" {
+		if c.end, err = time.Parse(time.RFC3339, rule.EndTime); err != nil {
+			return nil, err
+		}
+	}
+	if rule.Duration > 0 {
+		end := firstSeen.Add(rule.Duration)
+		if c.end.IsZero() || end.Before(c.end) {
+			c.end = end
+		}
+	}
+	return c, nil
+}
+
+func (r *dynamicRule) isActive(now time.Time) bool {
+	return (r.start.IsZero() || !now.Before(r.start)) && (r.end.IsZero() || now.Before(r.end))
+}
+
+func newDynamicFaultGenerator(dataStoreName config.DataStoreName, rules *dynamicRules) *dynamicFaultGenerator {
+	return &dynamicFaultGenerator{
+		dataStoreName: dataStoreName,
+		rules:         rules,
+	}
+}
+
+// generate returns the fault of the first active rule that matches the call, if its rate is sampled.
+func (g *dynamicFaultGenerator) generate(methodName string, request any) *fault {
+	rules := g.rules.rules.Load()
+	if rules == nil || len(*rules) == 0 {
+		return nil
+	}
+
+	now := g.rules.timeSource.Now()
+	var scope *requestScope
+	for _, rule := range *rules {
+		if (rule.Store != "" && rule.Store != g.dataStoreName) ||
+			(rule.Method != "" && rule.Method != methodName) ||
+			!rule.isActive(now) {
+			continue
+		}
+		if rule.ShardID != 0 || rule.NamespaceID != "" {
+			if scope == nil {
+				s := getRequestScope(request)
+				scope = &s
+			}
+			if (rule.ShardID != 0 && rule.ShardID != scope.shardID) ||
+				(rule.NamespaceID != "" && rule.NamespaceID != scope.namespaceID) {
+				continue
+			}
+		}
+		if rule.fault.rate < 1 && rand.Float64() >= rule.fault.rate {
+			continue
+		}
+		return &rule.fault
+	}
+	return nil
+}
+
+func (g compositeFaultGenerator) generate(methodName string, request any) *fault {
+	for _, generator := range g {
+		if f := generator.generate(methodName, request); f != nil {
+			return f
+		}
+	}
+	return nil
+}
+
+// getRequestScope returns the shard and namespace of a request. They are the ShardID and NamespaceID fields of
+// the request, or of the structs it contains, e.g. InternalUpdateWorkflowExecutionRequest.UpdateWorkflowMutation.
+func getRequestScope(request any) requestScope {
+	v := reflect.ValueOf(request)
+	for v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			return requestScope{}
+		}
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		return requestScope{}
+	}
+
+	var fields requestScopeFields
+	if cached, ok := requestScopeFieldsCache.Load(v.Type()); ok {
+		fields = cached.(requestScopeFields)
+	} else {
+		fields = findRequestScopeFields(v.Type())
+		requestScopeFieldsCache.Store(v.Type(), fields)
+	}
+
+	var scope requestScope
+	if fields.shardID != nil {
+		scope.shardID = int32(v.FieldByIndex(fields.shardID).Int())
+	}
+	if fields.namespaceID != nil {
+		scope.namespaceID = v.FieldByIndex(fields.namespaceID).String()
+	}
+	return scope
+}
+
+func findRequestScopeFields(t reflect.Type) requestScopeFields {
+	var fields requestScopeFields
+	var nested []reflect.StructField
+	visit := func(field reflect.StructField, index []int) {
+		switch {
+		case field.Name == "ShardID" && field.Type.Kind() == reflect.Int32 && fields.shardID == nil:
+			fields.shardID = index
+		case field.Name == "NamespaceID" && field.Type.Kind() == reflect.String && fields.namespaceID == nil:
+			fields.namespaceID = index
+		}
+	}
+
+	// fields of the request take precedence over fields of the structs it contains
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		visit(field, field.Index)
+		if field.IsExported() && field.Type.Kind() == reflect.Struct {
+			nested = append(nested, field)
+		}
+	}
+	for _, parent := range nested {
+		for i := 0; i < parent.Type.NumField(); i++ {
+			field := parent.Type.Field(i)
+			visit(field, append(slices.Clone(parent.Index), field.Index...))
+		}
+	}
+	return fields
+}
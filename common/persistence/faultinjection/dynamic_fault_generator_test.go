@@ -0,0 +1,171 @@
+package faultinjection
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/persistence"
+)
+
+func newTestDynamicRules(t *testing.T, timeSource clock.TimeSource, rules ...Rule) *dynamicRules {
+	r := newDynamicRules(dynamicconfig.NewNoopCollection(), timeSource, log.NewNoopLogger())
+	t.Cleanup(r.close)
+	r.update(rules)
+	return r
+}
+
+func TestDynamicFaultGenerator_Scope(t *testing.T) {
+	t.Parallel()
+
+	rules := newTestDynamicRules(t, clock.NewRealTimeSource(), Rule{
+		Store:       config.ExecutionStoreName,
+		Method:      "UpdateWorkflowExecution",
+		NamespaceID: "namespace-id",
+		ShardID:     7,
+		Error:       "Unavailable",
+	})
+	generator := newDynamicFaultGenerator(config.ExecutionStoreName, rules)
+
+	request := &persistence.InternalUpdateWorkflowExecutionRequest{
+		ShardID: 7,
+		UpdateWorkflowMutation: persistence.InternalWorkflowMutation{
+			NamespaceID: "namespace-id",
+		},
+	}
+	f := generator.generate("UpdateWorkflowExecution", request)
+	require.NotNil(t, f)
+	var unavailable *serviceerror.Unavailable
+	require.ErrorAs(t, f.inject(context.Background(), func() error { return nil }), &unavailable)
+
+	request.ShardID = 8
+	require.Nil(t, generator.generate("UpdateWorkflowExecution", request))
+	request.ShardID = 7
+	request.UpdateWorkflowMutation.NamespaceID = "other-namespace-id"
+	require.Nil(t, generator.generate("UpdateWorkflowExecution", request))
+	require.Nil(t, generator.generate("UpdateWorkflowExecution", nil))
+	require.Nil(t, generator.generate("GetWorkflowExecution", &persistence.GetWorkflowExecutionRequest{
+		ShardID:     7,
+		NamespaceID: "namespace-id",
+	}))
+	require.Nil(t, newDynamicFaultGenerator(config.TaskStoreName, rules).generate("UpdateWorkflowExecution", request))
+}
+
+func TestDynamicFaultGenerator_TimeWindow(t *testing.T) {
+	t.Parallel()
+
+	timeSource := clock.NewEventTimeSource().Update(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))
+	rule := Rule{
+		Method:   "UpdateShard",
+		Error:    "ShardOwnershipLost",
+		Duration: 30 * time.Second,
+	}
+	windowRule := Rule{
+		Method:    "AssertShardOwnership",
+		Error:     "Timeout",
+		StartTime: "2025-01-01T00:01:00Z",
+		EndTime:   "2025-01-01T00:02:00Z",
+	}
+	rules := newTestDynamicRules(t, timeSource, rule, windowRule)
+	generator := newDynamicFaultGenerator(config.ShardStoreName, rules)
+
+	require.NotNil(t, generator.generate("UpdateShard", nil))
+	require.Nil(t, generator.generate("AssertShardOwnership", nil))
+
+	// the duration of a rule starts when it's added, not when the rules are updated
+	timeSource.Advance(20 * time.Second)
+	rules.update([]Rule{rule, windowRule})
+	require.NotNil(t, generator.generate("UpdateShard", nil))
+	timeSource.Advance(10 * time.Second)
+	require.Nil(t, generator.generate("UpdateShard", nil))
+
+	timeSource.Advance(30 * time.Second)
+	require.NotNil(t, generator.generate("AssertShardOwnership", nil))
+	timeSource.Advance(time.Minute)
+	require.Nil(t, generator.generate("AssertShardOwnership", nil))
+}
+
+func TestDynamicFaultGenerator_Latency(t *testing.T) {
+	t.Parallel()
+
+	rules := newTestDynamicRules(t, clock.NewRealTimeSource(), Rule{Latency: time.Hour})
+	f := newDynamicFaultGenerator(config.QueueV2Name, rules).generate("ReadMessages", nil)
+	require.NotNil(t, f)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	executed := false
+	err := f.inject(ctx, func() error {
+		executed = true
+		return nil
+	})
+	var timeoutErr *persistence.TimeoutError
+	require.ErrorAs(t, err, &timeoutErr)
+	require.False(t, executed)
+
+	rules.update([]Rule{{Latency: time.Millisecond}})
+	f = newDynamicFaultGenerator(config.QueueV2Name, rules).generate("ReadMessages", nil)
+	errOp := errors.New("operation error")
+	require.ErrorIs(t, f.inject(context.Background(), func() error { return errOp }), errOp)
+}
+
+func TestDynamicFaultGenerator_InvalidRules(t *testing.T) {
+	t.Parallel()
+
+	rules := newTestDynamicRules(t, clock.NewRealTimeSource(),
+		Rule{Error: "NotAnError"},
+		Rule{Error: "Timeout", Rate: 2},
+		Rule{Error: "Timeout", StartTime: "yesterday"},
+		Rule{Method: "ReadMessages"},
+	)
+	require.Empty(t, *rules.rules.Load())
+}
+
+func TestDynamicFaultGenerator_DynamicConfig(t *testing.T) {
+	t.Parallel()
+
+	dc := dynamicconfig.NewCollection(dynamicconfig.StaticClient{
+		DynamicRules.Key(): []any{
+			map[string]any{
+				"store":    "ExecutionStore",
+				"method":   "UpdateWorkflowExecution",
+				"shardID":  7,
+				"error":    "Unavailable",
+				"duration": "30s",
+			},
+		},
+	}, log.NewNoopLogger())
+	rules := newDynamicRules(dc, clock.NewRealTimeSource(), log.NewNoopLogger())
+	defer rules.close()
+
+	require.Len(t, *rules.rules.Load(), 1)
+	generator := newDynamicFaultGenerator(config.ExecutionStoreName, rules)
+	require.NotNil(t, generator.generate("UpdateWorkflowExecution", &persistence.InternalUpdateWorkflowExecutionRequest{ShardID: 7}))
+}
+
+func TestDynamicFaultGenerator_WithoutStaticConfig(t *testing.T) {
+	t.Parallel()
+
+	dc := dynamicconfig.NewCollection(dynamicconfig.StaticClient{
+		DynamicRules.Key(): []any{
+			map[string]any{
+				"store":  "ShardStore",
+				"method": "GetOrCreateShard",
+				"error":  "Unavailable",
+			},
+		},
+	}, log.NewNoopLogger())
+	factory := NewFaultInjectionDatastoreFactory(nil, nil, dc, log.NewNoopLogger())
+	defer factory.rules.close()
+
+	generator := factory.newFaultGenerator(config.ShardStoreName)
+	require.NotNil(t, generator.generate("GetOrCreateShard", &persistence.InternalGetOrCreateShardRequest{ShardID: 1}))
+	require.Nil(t, generator.generate("UpdateShard", &persistence.InternalUpdateShardRequest{ShardID: 1}))
+}
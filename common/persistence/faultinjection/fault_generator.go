@@ -2,6 +2,6 @@ package faultinjection
 
 type (
 	faultGenerator interface {
-		generate(methodName string) *fault
+		generate(methodName string, request any) *fault
 	}
 )
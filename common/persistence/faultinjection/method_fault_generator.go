@@ -43,7 +43,7 @@ func newMethodFaultGenerator(faults []fault, seed int64) *methodFaultGenerator {
 	}
 }
 
-func (p *methodFaultGenerator) generate(_ string) *fault {
+func (p *methodFaultGenerator) generate(_ string, _ any) *fault {
 	if p.rate <= 0 {
 		return nil
 	}
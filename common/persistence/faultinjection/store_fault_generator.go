@@ -32,10 +32,10 @@ func newStoreFaultGenerator(cfg *config.FaultInjectionDataStoreConfig) *storeFau
 // If no errors are configured for the method, or if there are some errors configured for this method,
 // but no error is sampled, then this method returns nil.
 // When this method returns nil, this causes the persistence layer to use the real implementation.
-func (d *storeFaultGenerator) generate(methodName string) *fault {
+func (d *storeFaultGenerator) generate(methodName string, request any) *fault {
 	methodGenerator, ok := d.methodFaultGenerators[methodName]
 	if !ok {
 		return nil
 	}
-	return methodGenerator.generate(methodName)
+	return methodGenerator.generate(methodName, request)
 }
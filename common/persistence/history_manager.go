@@ -317,16 +317,18 @@ func (m *executionManagerImpl) deserializeBranchInfos(
 	return branchInfos, nil
 }
 
+// serializeAppendHistoryNodesRequest returns the request to the store, with the events blob compressed and
+// encrypted, along with the events blob as it is returned to callers reading raw history.
 func (m *executionManagerImpl) serializeAppendHistoryNodesRequest(
 	request *AppendHistoryNodesRequest,
-) (*InternalAppendHistoryNodesRequest, error) {
+) (*InternalAppendHistoryNodesRequest, *commonpb.DataBlob, error) {
 	branch, err := m.GetHistoryBranchUtil().ParseHistoryBranchInfo(request.BranchToken)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	if len(request.Events) == 0 {
-		return nil, &InvalidPersistenceRequestError{
+		return nil, nil, &InvalidPersistenceRequestError{
 			Msg: "events to be appended cannot be empty",
 		}
 	}
@@ -337,18 +339,18 @@ func (m *executionManagerImpl) serializeAppendHistoryNodesRequest(
 	lastID := nodeID - 1
 
 	if nodeID <= 0 {
-		return nil, &InvalidPersistenceRequestError{
+		return nil, nil, &InvalidPersistenceRequestError{
 			Msg: "eventID cannot be less than 1",
 		}
 	}
 	for _, e := range request.Events {
 		if e.Version != version {
-			return nil, &InvalidPersistenceRequestError{
+			return nil, nil, &InvalidPersistenceRequestError{
 				Msg: "event version must be the same inside a batch",
 			}
 		}
 		if e.EventId != lastID+1 {
-			return nil, &InvalidPersistenceRequestError{
+			return nil, nil, &InvalidPersistenceRequestError{
 				Msg: "event ID must be continous",
 			}
 		}
@@ -358,12 +360,12 @@ func (m *executionManagerImpl) serializeAppendHistoryNodesRequest(
 	// nodeID will be the first eventID
 	blob, err := m.serializer.SerializeEvents(request.Events, enumspb.ENCODING_TYPE_PROTO3)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	size := len(blob.Data)
 	sizeLimit := m.transactionSizeLimit()
 	if size > sizeLimit {
-		return nil, &TransactionSizeLimitError{
+		return nil, nil, &TransactionSizeLimitError{
 			Msg: fmt.Sprintf("transaction size of %v bytes exceeds limit of %v bytes", size, sizeLimit),
 		}
 	}
@@ -381,6 +383,9 @@ func (m *executionManagerImpl) serializeAppendHistoryNodesRequest(
 		},
 		ShardID: request.ShardID,
 	}
+	if req.Node.Events, err = m.encodeBlob(request.NamespaceID, blob); err != nil {
+		return nil, nil, err
+	}
 
 	if req.IsNewBranch {
 		// TreeInfo is only needed for new branch
@@ -391,18 +396,18 @@ func (m *executionManagerImpl) serializeAppendHistoryNodesRequest(
 			Info:        request.Info,
 		}, enumspb.ENCODING_TYPE_PROTO3)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 		req.TreeInfo = treeInfoBlob
 	}
 
 	if nodeID < GetBeginNodeID(branch) {
-		return nil, &InvalidPersistenceRequestError{
+		return nil, nil, &InvalidPersistenceRequestError{
 			Msg: "cannot append to ancestors' nodes",
 		}
 	}
 
-	return req, nil
+	return req, blob, nil
 }
 
 func (m *executionManagerImpl) serializeAppendRawHistoryNodesRequest(
@@ -449,6 +454,9 @@ func (m *executionManagerImpl) serializeAppendRawHistoryNodesRequest(
 		},
 		ShardID: request.ShardID,
 	}
+	if req.Node.Events, err = m.encodeBlob(request.NamespaceID, request.History); err != nil {
+		return nil, err
+	}
 
 	if req.IsNewBranch {
 		// TreeInfo is only needed for new branch
@@ -479,7 +487,7 @@ func (m *executionManagerImpl) AppendHistoryNodes(
 	request *AppendHistoryNodesRequest,
 ) (*AppendHistoryNodesResponse, error) {
 
-	req, err := m.serializeAppendHistoryNodesRequest(request)
+	req, events, err := m.serializeAppendHistoryNodesRequest(request)
 
 	if err != nil {
 		return nil, err
@@ -488,7 +496,7 @@ func (m *executionManagerImpl) AppendHistoryNodes(
 	err = m.persistence.AppendHistoryNodes(ctx, req)
 
 	return &AppendHistoryNodesResponse{
-		Size: len(req.Node.Events.Data),
+		Size: len(events.Data),
 	}, err
 }
 
@@ -543,7 +551,7 @@ func (m *executionManagerImpl) ReadRawHistoryBranch(
 	request *ReadHistoryBranchRequest,
 ) (*ReadRawHistoryBranchResponse, error) {
 
-	dataBlobs, _, nodeIDs, token, dataSize, err := m.readRawHistoryBranchAndFilter(ctx, request)
+	dataBlobs, transactionIDs, nodeIDs, token, dataSize, err := m.readRawHistoryBranchAndFilter(ctx, request)
 	if err != nil {
 		return nil, err
 	}
@@ -556,6 +564,7 @@ func (m *executionManagerImpl) ReadRawHistoryBranch(
 	return &ReadRawHistoryBranchResponse{
 		HistoryEventBlobs: dataBlobs,
 		NodeIDs:           nodeIDs,
+		TransactionIDs:    transactionIDs,
 		NextPageToken:     nextPageToken,
 		Size:              dataSize,
 	}, nil
@@ -655,6 +664,9 @@ func (m *executionManagerImpl) readRawHistoryBranch(
 	if err != nil {
 		return nil, nil, err
 	}
+	if err := m.decodeHistoryNodes(resp.Nodes); err != nil {
+		return nil, nil, err
+	}
 	token.StoreToken = resp.NextPageToken
 	return resp.Nodes, token, nil
 }
@@ -716,6 +728,9 @@ func (m *executionManagerImpl) readRawHistoryBranchReverse(
 	if err != nil {
 		return nil, nil, err
 	}
+	if err := m.decodeHistoryNodes(resp.Nodes); err != nil {
+		return nil, nil, err
+	}
 	token.StoreToken = resp.NextPageToken
 	return resp.Nodes, token, nil
 }
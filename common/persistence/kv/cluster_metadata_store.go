@@ -0,0 +1,238 @@
+package kv
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net"
+	"time"
+
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/server/common/log"
+	p "go.temporal.io/server/common/persistence"
+	kvdb "go.temporal.io/server/common/persistence/nosql/nosqlplugin/kv"
+)
+
+type (
+	ClusterMetadataStore struct {
+		kvStore
+		logger log.Logger
+	}
+
+	clusterMetadataRecord struct {
+		ClusterMetadata blob  `json:"clusterMetadata"`
+		Version         int64 `json:"version"`
+	}
+
+	clusterMemberRecord struct {
+		Role          p.ServiceType `json:"role"`
+		HostID        []byte        `json:"hostId"`
+		RPCAddress    string        `json:"rpcAddress"`
+		RPCPort       uint16        `json:"rpcPort"`
+		SessionStart  time.Time     `json:"sessionStart"`
+		LastHeartbeat time.Time     `json:"lastHeartbeat"`
+		RecordExpiry  time.Time     `json:"recordExpiry"`
+	}
+)
+
+var _ p.ClusterMetadataStore = (*ClusterMetadataStore)(nil)
+
+// NewClusterMetadataStore creates an instance of ClusterMetadataStore
+func NewClusterMetadataStore(
+	db *kvdb.DB,
+	logger log.Logger,
+) *ClusterMetadataStore {
+	return &ClusterMetadataStore{
+		kvStore: kvStore{db: db},
+		logger:  logger,
+	}
+}
+
+func clusterMetadataKey(clusterName string) key {
+	return newKey(prefixClusterMetadata).str(clusterName)
+}
+
+func clusterMemberKey(hostID []byte) key {
+	return newKey(prefixClusterMembership).str(string(hostID))
+}
+
+// clusterMembershipRanges are the transaction ranges of the cluster membership table, which is pruned as a
+// whole.
+func clusterMembershipRanges() []key {
+	return []key{newKey(prefixClusterMembership)}
+}
+
+func (s *ClusterMetadataStore) ListClusterMetadata(
+	_ context.Context,
+	request *p.InternalListClusterMetadataRequest,
+) (*p.InternalListClusterMetadataResponse, error) {
+	prefix := newKey(prefixClusterMetadata)
+	lower, err := pageLowerBound(prefix, request.NextPageToken)
+	if err != nil {
+		return nil, err
+	}
+	records, err := scanRecords[clusterMetadataRecord](s.db, lower, prefix.prefixEnd(), false, request.PageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &p.InternalListClusterMetadataResponse{}
+	for _, record := range records {
+		response.ClusterMetadata = append(response.ClusterMetadata, &p.InternalGetClusterMetadataResponse{
+			ClusterMetadata: record.value.ClusterMetadata.dataBlob(),
+			Version:         record.value.Version,
+		})
+	}
+	if len(records) > 0 && len(records) == request.PageSize {
+		response.NextPageToken = records[len(records)-1].key
+	}
+	return response, nil
+}
+
+func (s *ClusterMetadataStore) GetClusterMetadata(
+	_ context.Context,
+	request *p.InternalGetClusterMetadataRequest,
+) (*p.InternalGetClusterMetadataResponse, error) {
+	var clusterMetadata clusterMetadataRecord
+	found, err := getRecord(s.db, clusterMetadataKey(request.ClusterName), &clusterMetadata)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, serviceerror.NewNotFound(fmt.Sprintf("GetClusterMetadata operation failed. Cluster metadata of %v not found", request.ClusterName))
+	}
+	return &p.InternalGetClusterMetadataResponse{
+		ClusterMetadata: clusterMetadata.ClusterMetadata.dataBlob(),
+		Version:         clusterMetadata.Version,
+	}, nil
+}
+
+func (s *ClusterMetadataStore) SaveClusterMetadata(
+	_ context.Context,
+	request *p.InternalSaveClusterMetadataRequest,
+) (bool, error) {
+	err := s.update("SaveClusterMetadata", []key{clusterMetadataKey(request.ClusterName)}, func(txn *kvdb.Txn) error {
+		var existing clusterMetadataRecord
+		if _, err := getRecord(txn, clusterMetadataKey(request.ClusterName), &existing); err != nil {
+			return err
+		}
+		if request.Version != existing.Version {
+			return serviceerror.NewUnavailable(fmt.Sprintf("SaveClusterMetadata encountered version mismatch, expected %v but got %v.",
+				request.Version, existing.Version))
+		}
+		return setRecord(txn, clusterMetadataKey(request.ClusterName), &clusterMetadataRecord{
+			ClusterMetadata: newBlob(request.ClusterMetadata),
+			Version:         request.Version + 1,
+		})
+	})
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (s *ClusterMetadataStore) DeleteClusterMetadata(
+	_ context.Context,
+	request *p.InternalDeleteClusterMetadataRequest,
+) error {
+	return s.update("DeleteClusterMetadata", []key{clusterMetadataKey(request.ClusterName)}, func(txn *kvdb.Txn) error {
+		txn.Delete(clusterMetadataKey(request.ClusterName))
+		return nil
+	})
+}
+
+func (s *ClusterMetadataStore) GetClusterMembers(
+	_ context.Context,
+	request *p.GetClusterMembersRequest,
+) (*p.GetClusterMembersResponse, error) {
+	prefix := newKey(prefixClusterMembership)
+	lower, err := pageLowerBound(prefix, request.NextPageToken)
+	if err != nil {
+		return nil, err
+	}
+	upper := prefix.prefixEnd()
+	if request.HostIDEquals != nil {
+		lower = clusterMemberKey(request.HostIDEquals)
+		upper = lower.next()
+	}
+
+	now := time.Now().UTC()
+	var lastHeartbeatAfter time.Time
+	if request.LastHeartbeatWithin > 0 {
+		lastHeartbeatAfter = now.Add(-request.LastHeartbeatWithin)
+	}
+
+	// members are filtered after the scan, the limit of the scan can't be used
+	records, err := scanRecords[clusterMemberRecord](s.db, lower, upper, false, 0)
+	if err != nil {
+		return nil, err
+	}
+	response := &p.GetClusterMembersResponse{}
+	for _, record := range records {
+		member := record.value
+		if !member.RecordExpiry.After(now) ||
+			(request.RoleEquals != p.All && member.Role != request.RoleEquals) ||
+			(request.RPCAddressEquals != nil && member.RPCAddress != request.RPCAddressEquals.String()) ||
+			!member.SessionStart.After(request.SessionStartedAfter) ||
+			!member.LastHeartbeat.After(lastHeartbeatAfter) {
+			continue
+		}
+		response.ActiveMembers = append(response.ActiveMembers, &p.ClusterMember{
+			HostID:        member.HostID,
+			Role:          member.Role,
+			RPCAddress:    net.ParseIP(member.RPCAddress),
+			RPCPort:       member.RPCPort,
+			SessionStart:  member.SessionStart,
+			LastHeartbeat: member.LastHeartbeat,
+			RecordExpiry:  member.RecordExpiry,
+		})
+		if request.PageSize > 0 && len(response.ActiveMembers) == request.PageSize {
+			response.NextPageToken = record.key
+			break
+		}
+	}
+	return response, nil
+}
+
+func (s *ClusterMetadataStore) UpsertClusterMembership(
+	_ context.Context,
+	request *p.UpsertClusterMembershipRequest,
+) error {
+	now := time.Now().UTC()
+	return s.update("UpsertClusterMembership", clusterMembershipRanges(), func(txn *kvdb.Txn) error {
+		return setRecord(txn, clusterMemberKey(request.HostID), &clusterMemberRecord{
+			Role:          request.Role,
+			HostID:        bytes.Clone(request.HostID),
+			RPCAddress:    request.RPCAddress.String(),
+			RPCPort:       request.RPCPort,
+			SessionStart:  request.SessionStart,
+			LastHeartbeat: now,
+			RecordExpiry:  now.Add(request.RecordExpiry),
+		})
+	})
+}
+
+func (s *ClusterMetadataStore) PruneClusterMembership(
+	_ context.Context,
+	request *p.PruneClusterMembershipRequest,
+) error {
+	now := time.Now().UTC()
+	return s.update("PruneClusterMembership", clusterMembershipRanges(), func(txn *kvdb.Txn) error {
+		prefix := newKey(prefixClusterMembership)
+		records, err := scanPrefix[clusterMemberRecord](txn, prefix, 0)
+		if err != nil {
+			return err
+		}
+		pruned := 0
+		for _, record := range records {
+			if request.MaxRecordsPruned > 0 && pruned >= request.MaxRecordsPruned {
+				break
+			}
+			if record.value.RecordExpiry.Before(now) {
+				txn.Delete(record.key)
+				pruned++
+			}
+		}
+		return nil
+	})
+}
@@ -0,0 +1,168 @@
+package kv
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/api/serviceerror"
+	p "go.temporal.io/server/common/persistence"
+	kvdb "go.temporal.io/server/common/persistence/nosql/nosqlplugin/kv"
+)
+
+type (
+	// reader is implemented by both kvdb.DB and kvdb.Txn.
+	reader interface {
+		Get(key []byte) ([]byte, error)
+		Scan(lower []byte, upper []byte, reverse bool, fn func(key []byte, value []byte) bool) error
+	}
+
+	// blob is the stored form of a DataBlob.
+	blob struct {
+		Data     []byte `json:"d,omitempty"`
+		Encoding string `json:"e,omitempty"`
+	}
+
+	record[T any] struct {
+		key   key
+		value T
+	}
+
+	// kvStore is embedded by all stores.
+	kvStore struct {
+		db *kvdb.DB
+	}
+)
+
+func newBlob(dataBlob *commonpb.DataBlob) blob {
+	if dataBlob == nil {
+		return blob{}
+	}
+	return blob{Data: dataBlob.Data, Encoding: dataBlob.EncodingType.String()}
+}
+
+func (b blob) dataBlob() *commonpb.DataBlob {
+	return p.NewDataBlob(b.Data, b.Encoding)
+}
+
+func (s *kvStore) GetName() string {
+	return PluginName
+}
+
+// Close is a no-op, the database is closed by the Factory.
+func (s *kvStore) Close() {
+}
+
+// update runs fn in a read-write transaction on the key ranges. Errors returned by fn are returned as is,
+// storage errors are converted to Unavailable errors.
+func (s *kvStore) update(operation string, ranges []key, fn func(txn *kvdb.Txn) error) error {
+	var fnErr error
+	err := s.db.Update(txnRanges(ranges), func(txn *kvdb.Txn) error {
+		fnErr = fn(txn)
+		return fnErr
+	})
+	if err != nil && fnErr == nil {
+		return storageError(operation, err)
+	}
+	return err
+}
+
+// view runs fn in a read-only transaction on the key ranges, so that all reads of fn observe the same state.
+func (s *kvStore) view(operation string, ranges []key, fn func(r reader) error) error {
+	var fnErr error
+	err := s.db.View(txnRanges(ranges), func(txn *kvdb.Txn) error {
+		fnErr = fn(txn)
+		return fnErr
+	})
+	if err != nil && fnErr == nil {
+		return storageError(operation, err)
+	}
+	return err
+}
+
+// txnRanges converts key prefixes to transaction ranges. Transactions on the same rows must use the same
+// prefixes, the stores use the helpers next to the key functions of each table for that.
+func txnRanges(prefixes []key) [][]byte {
+	ranges := make([][]byte, 0, len(prefixes))
+	for _, prefix := range prefixes {
+		ranges = append(ranges, prefix)
+	}
+	return ranges
+}
+
+func storageError(operation string, err error) error {
+	return serviceerror.NewUnavailable(fmt.Sprintf("%v operation failed. Error: %v", operation, err))
+}
+
+// getRecord reads and decodes the value of the key, it returns false if the key doesn't exist.
+func getRecord[T any](r reader, k key, value *T) (bool, error) {
+	data, err := r.Get(k)
+	if errors.Is(err, kvdb.ErrNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, storageError("Get", err)
+	}
+	if err := json.Unmarshal(data, value); err != nil {
+		return false, serviceerror.NewInternal(fmt.Sprintf("unable to decode record: %v", err))
+	}
+	return true, nil
+}
+
+func setRecord[T any](txn *kvdb.Txn, k key, value *T) error {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return serviceerror.NewInternal(fmt.Sprintf("unable to encode record: %v", err))
+	}
+	txn.Set(k, data)
+	return nil
+}
+
+// scanRecords reads and decodes up to limit records with keys in [lower, upper), a non-positive limit
+// means no limit and a nil upper bound means no upper bound.
+func scanRecords[T any](r reader, lower key, upper key, reverse bool, limit int) ([]record[T], error) {
+	var records []record[T]
+	var decodeErr error
+	err := r.Scan(lower, upper, reverse, func(k []byte, data []byte) bool {
+		var value T
+		if decodeErr = json.Unmarshal(data, &value); decodeErr != nil {
+			return false
+		}
+		records = append(records, record[T]{key: k, value: value})
+		return limit <= 0 || len(records) < limit
+	})
+	if err != nil {
+		return nil, storageError("Scan", err)
+	}
+	if decodeErr != nil {
+		return nil, serviceerror.NewInternal(fmt.Sprintf("unable to decode record: %v", decodeErr))
+	}
+	return records, nil
+}
+
+// scanPrefix reads and decodes up to limit records with keys starting with prefix.
+func scanPrefix[T any](r reader, prefix key, limit int) ([]record[T], error) {
+	return scanRecords[T](r, prefix, prefix.prefixEnd(), false, limit)
+}
+
+// deletePrefix deletes all keys starting with prefix.
+func deletePrefix(txn *kvdb.Txn, prefix key) error {
+	if err := txn.DeleteRange(prefix, prefix.prefixEnd()); err != nil {
+		return storageError("DeleteRange", err)
+	}
+	return nil
+}
+
+// pageLowerBound returns the key to resume a scan of the keys starting with prefix from. Page tokens are the
+// last key of the previous page.
+func pageLowerBound(prefix key, pageToken []byte) (key, error) {
+	if len(pageToken) == 0 {
+		return prefix, nil
+	}
+	if !bytes.HasPrefix(pageToken, prefix) {
+		return nil, serviceerror.NewInvalidArgument("invalid page token")
+	}
+	return key(pageToken).next(), nil
+}
@@ -0,0 +1,1010 @@
+package kv
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/api/serviceerror"
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/log"
+	p "go.temporal.io/server/common/persistence"
+	kvdb "go.temporal.io/server/common/persistence/nosql/nosqlplugin/kv"
+	"go.temporal.io/server/common/persistence/serialization"
+)
+
+// Tags of the mutable state maps, all entries of a workflow execution share the executionMapPrefix of the
+// execution.
+const (
+	mapActivityInfo       byte = 'a'
+	mapTimerInfo          byte = 't'
+	mapChildExecutionInfo byte = 'c'
+	mapRequestCancelInfo  byte = 'r'
+	mapSignalInfo         byte = 's'
+	mapSignalRequestedID  byte = 'q'
+	mapChasmNode          byte = 'h'
+	mapBufferedEvents     byte = 'b'
+)
+
+type (
+	ExecutionStore struct {
+		kvStore
+		p.HistoryBranchUtilImpl
+		logger log.Logger
+	}
+
+	currentExecutionRecord struct {
+		NamespaceID      string `json:"namespaceId"`
+		WorkflowID       string `json:"workflowId"`
+		RunID            string `json:"runId"`
+		LastWriteVersion int64  `json:"lastWriteVersion"`
+		ExecutionState   blob   `json:"executionState"`
+	}
+
+	executionRecord struct {
+		ExecutionInfo    blob  `json:"executionInfo"`
+		ExecutionState   blob  `json:"executionState"`
+		NextEventID      int64 `json:"nextEventId"`
+		LastWriteVersion int64 `json:"lastWriteVersion"`
+		DBRecordVersion  int64 `json:"dbRecordVersion"`
+		Checksum         blob  `json:"checksum"`
+	}
+
+	// mapEntryRecord is an entry of one of the mutable state maps, either IntKey or StrKey is set depending on
+	// the map. Metadata is only used by chasm nodes.
+	mapEntryRecord struct {
+		IntKey   int64  `json:"i,omitempty"`
+		StrKey   string `json:"k,omitempty"`
+		Value    blob   `json:"v"`
+		Metadata blob   `json:"m"`
+	}
+)
+
+var _ p.ExecutionStore = (*ExecutionStore)(nil)
+
+// NewExecutionStore creates an instance of ExecutionStore
+func NewExecutionStore(
+	db *kvdb.DB,
+	logger log.Logger,
+) *ExecutionStore {
+	return &ExecutionStore{
+		kvStore: kvStore{db: db},
+		logger:  logger,
+	}
+}
+
+func currentExecutionKey(shardID int32, namespaceID string, workflowID string) key {
+	return newKey(prefixCurrentExecution).int32(shardID).str(namespaceID).str(workflowID)
+}
+
+func executionKey(shardID int32, namespaceID string, workflowID string, runID string) key {
+	return newKey(prefixExecution).int32(shardID).str(namespaceID).str(workflowID).str(runID)
+}
+
+// executionRanges are the transaction ranges of the executions of a shard, with their map entries and tasks.
+// They include the shard record, so that the range ID checked by a write can't change until it commits.
+func executionRanges(shardID int32) []key {
+	return slices.Concat(
+		shardRanges(shardID),
+		[]key{
+			newKey(prefixCurrentExecution).int32(shardID),
+			newKey(prefixExecution).int32(shardID),
+			newKey(prefixExecutionMap).int32(shardID),
+		},
+		historyTaskRanges(shardID),
+	)
+}
+
+// executionMapPrefix returns the prefix of the mutable state map entries of the execution.
+func executionMapPrefix(executionKey key) key {
+	return append(newKey(prefixExecutionMap), executionKey[1:]...)
+}
+
+func (s *ExecutionStore) CreateWorkflowExecution(
+	ctx context.Context,
+	request *p.InternalCreateWorkflowExecutionRequest,
+) (*p.InternalCreateWorkflowExecutionResponse, error) {
+	// first append history
+	for _, req := range request.NewWorkflowNewEvents {
+		if err := s.AppendHistoryNodes(ctx, req); err != nil {
+			return nil, err
+		}
+	}
+
+	err := s.update("CreateWorkflowExecution", executionRanges(request.ShardID), func(txn *kvdb.Txn) error {
+		if err := checkShardRangeID(txn, request.ShardID, request.RangeID); err != nil {
+			return err
+		}
+		return s.createWorkflowExecutionTxn(txn, request)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &p.InternalCreateWorkflowExecutionResponse{}, nil
+}
+
+func (s *ExecutionStore) createWorkflowExecutionTxn(
+	txn *kvdb.Txn,
+	request *p.InternalCreateWorkflowExecutionRequest,
+) error {
+	newWorkflow := &request.NewWorkflowSnapshot
+	workflowID := newWorkflow.WorkflowID
+	currentKey := currentExecutionKey(request.ShardID, newWorkflow.NamespaceID, workflowID)
+
+	var current currentExecutionRecord
+	found, err := getRecord(txn, currentKey, &current)
+	if err != nil {
+		return err
+	}
+
+	// current run ID, last write version, current workflow state check
+	switch request.Mode {
+	case p.CreateWorkflowModeBrandNew:
+		if found && current.RunID != request.PreviousRunID {
+			return currentWorkflowConflictError(
+				&current,
+				fmt.Sprintf(
+					"Workflow execution creation condition failed. workflow ID: %v, current run ID: %v, request run ID: %v",
+					workflowID,
+					current.RunID,
+					request.PreviousRunID,
+				),
+			)
+		}
+
+	case p.CreateWorkflowModeUpdateCurrent:
+		if !found {
+			return currentWorkflowConflictError(nil, "")
+		}
+		if current.RunID != request.PreviousRunID {
+			return currentWorkflowConflictError(
+				&current,
+				fmt.Sprintf(
+					"Workflow execution creation condition failed. workflow ID: %v, current run ID: %v, request run ID: %v",
+					workflowID,
+					current.RunID,
+					request.PreviousRunID,
+				),
+			)
+		}
+		if request.PreviousLastWriteVersion != current.LastWriteVersion {
+			return currentWorkflowConflictError(
+				&current,
+				fmt.Sprintf(
+					"Workflow execution creation condition failed. workflow ID: %v, current last write version: %v, request last write version: %v",
+					workflowID,
+					current.LastWriteVersion,
+					request.PreviousLastWriteVersion,
+				),
+			)
+		}
+		currentState, err := current.executionState()
+		if err != nil {
+			return err
+		}
+		if currentState.State != enumsspb.WORKFLOW_EXECUTION_STATE_COMPLETED {
+			return currentWorkflowConflictError(
+				&current,
+				fmt.Sprintf(
+					"Workflow execution creation condition failed. workflow ID: %v, current state: %v, request state: %v",
+					workflowID,
+					currentState.State,
+					enumsspb.WORKFLOW_EXECUTION_STATE_COMPLETED,
+				),
+			)
+		}
+
+	case p.CreateWorkflowModeBypassCurrent:
+		if found {
+			if err := assertRunIDMismatch(newWorkflow.ExecutionState.RunId, &current); err != nil {
+				return err
+			}
+		}
+
+	default:
+		return serviceerror.NewInternal(fmt.Sprintf("CreteWorkflowExecution: unknown mode: %v", request.Mode))
+	}
+
+	if request.Mode != p.CreateWorkflowModeBypassCurrent {
+		if err := setRecord(txn, currentKey, &currentExecutionRecord{
+			NamespaceID:      newWorkflow.NamespaceID,
+			WorkflowID:       workflowID,
+			RunID:            newWorkflow.ExecutionState.RunId,
+			LastWriteVersion: newWorkflow.LastWriteVersion,
+			ExecutionState:   newBlob(newWorkflow.ExecutionStateBlob),
+		}); err != nil {
+			return err
+		}
+	}
+	return applyWorkflowSnapshotAsNew(txn, request.ShardID, newWorkflow)
+}
+
+func (s *ExecutionStore) UpdateWorkflowExecution(
+	ctx context.Context,
+	request *p.InternalUpdateWorkflowExecutionRequest,
+) error {
+	// first append history
+	for _, req := range request.UpdateWorkflowNewEvents {
+		if err := s.AppendHistoryNodes(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, req := range request.NewWorkflowNewEvents {
+		if err := s.AppendHistoryNodes(ctx, req); err != nil {
+			return err
+		}
+	}
+
+	return s.update("UpdateWorkflowExecution", executionRanges(request.ShardID), func(txn *kvdb.Txn) error {
+		if err := checkShardRangeID(txn, request.ShardID, request.RangeID); err != nil {
+			return err
+		}
+		return s.updateWorkflowExecutionTxn(txn, request)
+	})
+}
+
+func (s *ExecutionStore) updateWorkflowExecutionTxn(
+	txn *kvdb.Txn,
+	request *p.InternalUpdateWorkflowExecutionRequest,
+) error {
+	updateWorkflow := &request.UpdateWorkflowMutation
+	newWorkflow := request.NewWorkflowSnapshot
+	namespaceID := updateWorkflow.NamespaceID
+	workflowID := updateWorkflow.WorkflowID
+	runID := updateWorkflow.ExecutionState.RunId
+	shardID := request.ShardID
+
+	switch request.Mode {
+	case p.UpdateWorkflowModeIgnoreCurrent:
+		// noop
+
+	case p.UpdateWorkflowModeBypassCurrent:
+		if err := assertNotCurrentExecution(txn, shardID, namespaceID, workflowID, runID); err != nil {
+			return err
+		}
+
+	case p.UpdateWorkflowModeUpdateCurrent:
+		current := &currentExecutionRecord{
+			RunID:            runID,
+			LastWriteVersion: updateWorkflow.LastWriteVersion,
+			ExecutionState:   newBlob(updateWorkflow.ExecutionStateBlob),
+		}
+		if newWorkflow != nil {
+			if newWorkflow.NamespaceID != namespaceID {
+				return serviceerror.NewUnavailable("UpdateWorkflowExecution: cannot continue as new to another namespace")
+			}
+			current = &currentExecutionRecord{
+				RunID:            newWorkflow.ExecutionState.RunId,
+				LastWriteVersion: newWorkflow.LastWriteVersion,
+				ExecutionState:   newBlob(newWorkflow.ExecutionStateBlob),
+			}
+		}
+		if err := assertRunIDAndUpdateCurrentExecution(txn, shardID, namespaceID, workflowID, current, runID); err != nil {
+			return err
+		}
+
+	default:
+		return serviceerror.NewUnavailable(fmt.Sprintf("UpdateWorkflowExecution: unknown mode: %v", request.Mode))
+	}
+
+	if err := applyWorkflowMutation(txn, shardID, updateWorkflow); err != nil {
+		return err
+	}
+	if newWorkflow != nil {
+		if err := applyWorkflowSnapshotAsNew(txn, shardID, newWorkflow); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *ExecutionStore) ConflictResolveWorkflowExecution(
+	ctx context.Context,
+	request *p.InternalConflictResolveWorkflowExecutionRequest,
+) error {
+	// first append history
+	for _, req := range request.CurrentWorkflowEventsNewEvents {
+		if err := s.AppendHistoryNodes(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, req := range request.ResetWorkflowEventsNewEvents {
+		if err := s.AppendHistoryNodes(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, req := range request.NewWorkflowEventsNewEvents {
+		if err := s.AppendHistoryNodes(ctx, req); err != nil {
+			return err
+		}
+	}
+
+	return s.update("ConflictResolveWorkflowExecution", executionRanges(request.ShardID), func(txn *kvdb.Txn) error {
+		if err := checkShardRangeID(txn, request.ShardID, request.RangeID); err != nil {
+			return err
+		}
+		return s.conflictResolveWorkflowExecutionTxn(txn, request)
+	})
+}
+
+func (s *ExecutionStore) conflictResolveWorkflowExecutionTxn(
+	txn *kvdb.Txn,
+	request *p.InternalConflictResolveWorkflowExecutionRequest,
+) error {
+	currentWorkflow := request.CurrentWorkflowMutation
+	resetWorkflow := &request.ResetWorkflowSnapshot
+	newWorkflow := request.NewWorkflowSnapshot
+	shardID := request.ShardID
+	namespaceID := resetWorkflow.NamespaceID
+	workflowID := resetWorkflow.WorkflowID
+
+	switch request.Mode {
+	case p.ConflictResolveWorkflowModeBypassCurrent:
+		if err := assertNotCurrentExecution(txn, shardID, namespaceID, workflowID, resetWorkflow.ExecutionState.RunId); err != nil {
+			return err
+		}
+
+	case p.ConflictResolveWorkflowModeUpdateCurrent:
+		current := &currentExecutionRecord{
+			RunID:            resetWorkflow.ExecutionState.RunId,
+			LastWriteVersion: resetWorkflow.LastWriteVersion,
+			ExecutionState:   newBlob(resetWorkflow.ExecutionStateBlob),
+		}
+		if newWorkflow != nil {
+			current = &currentExecutionRecord{
+				RunID:            newWorkflow.ExecutionState.RunId,
+				LastWriteVersion: newWorkflow.LastWriteVersion,
+				ExecutionState:   newBlob(newWorkflow.ExecutionStateBlob),
+			}
+		}
+
+		// reset workflow is current unless the current workflow is given
+		prevRunID := resetWorkflow.ExecutionState.RunId
+		if currentWorkflow != nil {
+			prevRunID = currentWorkflow.ExecutionState.RunId
+		}
+		if err := assertRunIDAndUpdateCurrentExecution(txn, shardID, namespaceID, workflowID, current, prevRunID); err != nil {
+			return err
+		}
+
+	default:
+		return serviceerror.NewUnavailable(fmt.Sprintf("ConflictResolveWorkflowExecution: unknown mode: %v", request.Mode))
+	}
+
+	if err := applyWorkflowSnapshotAsReset(txn, shardID, resetWorkflow); err != nil {
+		return err
+	}
+	if currentWorkflow != nil {
+		if err := applyWorkflowMutation(txn, shardID, currentWorkflow); err != nil {
+			return err
+		}
+	}
+	if newWorkflow != nil {
+		if err := applyWorkflowSnapshotAsNew(txn, shardID, newWorkflow); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *ExecutionStore) SetWorkflowExecution(
+	_ context.Context,
+	request *p.InternalSetWorkflowExecutionRequest,
+) error {
+	return s.update("SetWorkflowExecution", executionRanges(request.ShardID), func(txn *kvdb.Txn) error {
+		if err := checkShardRangeID(txn, request.ShardID, request.RangeID); err != nil {
+			return err
+		}
+		return applyWorkflowSnapshotAsReset(txn, request.ShardID, &request.SetWorkflowSnapshot)
+	})
+}
+
+func (s *ExecutionStore) GetWorkflowExecution(
+	_ context.Context,
+	request *p.GetWorkflowExecutionRequest,
+) (*p.InternalGetWorkflowExecutionResponse, error) {
+	var state *p.InternalWorkflowMutableState
+	err := s.view("GetWorkflowExecution", executionRanges(request.ShardID), func(r reader) error {
+		executionKey := executionKey(request.ShardID, request.NamespaceID, request.WorkflowID, request.RunID)
+		var execution executionRecord
+		found, err := getRecord(r, executionKey, &execution)
+		if err != nil {
+			return err
+		}
+		if !found {
+			return serviceerror.NewNotFound(fmt.Sprintf(
+				"Workflow executionsRow not found.  WorkflowId: %v, RunId: %v",
+				request.WorkflowID,
+				request.RunID,
+			))
+		}
+		state, err = readWorkflowMutableState(r, executionKey, &execution)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &p.InternalGetWorkflowExecutionResponse{
+		State:           state,
+		DBRecordVersion: state.DBRecordVersion,
+	}, nil
+}
+
+func (s *ExecutionStore) GetCurrentExecution(
+	_ context.Context,
+	request *p.GetCurrentExecutionRequest,
+) (*p.InternalGetCurrentExecutionResponse, error) {
+	var current currentExecutionRecord
+	found, err := getRecord(s.db, currentExecutionKey(request.ShardID, request.NamespaceID, request.WorkflowID), &current)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, serviceerror.NewNotFound(fmt.Sprintf(
+			"current workflow execution not found. WorkflowId: %v",
+			request.WorkflowID,
+		))
+	}
+	executionState, err := current.executionState()
+	if err != nil {
+		return nil, err
+	}
+	return &p.InternalGetCurrentExecutionResponse{
+		RunID: current.RunID,
+		ExecutionState: &persistencespb.WorkflowExecutionState{
+			CreateRequestId: executionState.CreateRequestId,
+			State:           executionState.State,
+			Status:          executionState.Status,
+		},
+	}, nil
+}
+
+func (s *ExecutionStore) DeleteWorkflowExecution(
+	_ context.Context,
+	request *p.DeleteWorkflowExecutionRequest,
+) error {
+	return s.update("DeleteWorkflowExecution", executionRanges(request.ShardID), func(txn *kvdb.Txn) error {
+		executionKey := executionKey(request.ShardID, request.NamespaceID, request.WorkflowID, request.RunID)
+		txn.Delete(executionKey)
+		return deletePrefix(txn, executionMapPrefix(executionKey))
+	})
+}
+
+// DeleteCurrentWorkflowExecution deletes the current execution only if it still points to the given run.
+func (s *ExecutionStore) DeleteCurrentWorkflowExecution(
+	_ context.Context,
+	request *p.DeleteCurrentWorkflowExecutionRequest,
+) error {
+	return s.update("DeleteCurrentWorkflowExecution", executionRanges(request.ShardID), func(txn *kvdb.Txn) error {
+		currentKey := currentExecutionKey(request.ShardID, request.NamespaceID, request.WorkflowID)
+		var current currentExecutionRecord
+		found, err := getRecord(txn, currentKey, &current)
+		if err != nil {
+			return err
+		}
+		if found && current.RunID == request.RunID {
+			txn.Delete(currentKey)
+		}
+		return nil
+	})
+}
+
+func (s *ExecutionStore) ListConcreteExecutions(
+	_ context.Context,
+	request *p.ListConcreteExecutionsRequest,
+) (*p.InternalListConcreteExecutionsResponse, error) {
+	response := &p.InternalListConcreteExecutionsResponse{}
+	err := s.view("ListConcreteExecutions", executionRanges(request.ShardID), func(r reader) error {
+		prefix := newKey(prefixExecution).int32(request.ShardID)
+		lower, err := pageLowerBound(prefix, request.PageToken)
+		if err != nil {
+			return err
+		}
+		executions, err := scanRecords[executionRecord](r, lower, prefix.prefixEnd(), false, request.PageSize)
+		if err != nil {
+			return err
+		}
+		for _, execution := range executions {
+			state, err := readWorkflowMutableState(r, execution.key, &execution.value)
+			if err != nil {
+				return err
+			}
+			response.States = append(response.States, state)
+		}
+		if len(executions) > 0 && len(executions) == request.PageSize {
+			response.NextPageToken = executions[len(executions)-1].key
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+func (s *ExecutionStore) ListCurrentExecutions(
+	_ context.Context,
+	request *p.ListCurrentExecutionsRequest,
+) (*p.InternalListCurrentExecutionsResponse, error) {
+	prefix := newKey(prefixCurrentExecution).int32(request.ShardID)
+	lower, err := pageLowerBound(prefix, request.PageToken)
+	if err != nil {
+		return nil, err
+	}
+	records, err := scanRecords[currentExecutionRecord](s.db, lower, prefix.prefixEnd(), false, request.PageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &p.InternalListCurrentExecutionsResponse{
+		Executions: make([]*p.CurrentWorkflowExecution, 0, len(records)),
+	}
+	for _, record := range records {
+		executionState, err := record.value.executionState()
+		if err != nil {
+			return nil, err
+		}
+		response.Executions = append(response.Executions, &p.CurrentWorkflowExecution{
+			NamespaceID: record.value.NamespaceID,
+			WorkflowID:  record.value.WorkflowID,
+			RunID:       record.value.RunID,
+			State:       executionState.State,
+			Status:      executionState.Status,
+		})
+	}
+	if len(records) > 0 && len(records) == request.PageSize {
+		response.NextPageToken = records[len(records)-1].key
+	}
+	return response, nil
+}
+
+func (r *currentExecutionRecord) executionState() (*persistencespb.WorkflowExecutionState, error) {
+	return serialization.WorkflowExecutionStateFromBlob(r.ExecutionState.Data, r.ExecutionState.Encoding)
+}
+
+func currentWorkflowConflictError(
+	current *currentExecutionRecord,
+	message string,
+) error {
+	if current == nil {
+		return &p.CurrentWorkflowConditionFailedError{Msg: message}
+	}
+
+	executionState, err := current.executionState()
+	if err != nil {
+		return err
+	}
+	var startTime *time.Time
+	if executionState.StartTime != nil {
+		t := executionState.StartTime.AsTime()
+		startTime = &t
+	}
+	return &p.CurrentWorkflowConditionFailedError{
+		Msg:              message,
+		RequestIDs:       executionState.RequestIds,
+		RunID:            current.RunID,
+		State:            executionState.State,
+		Status:           executionState.Status,
+		LastWriteVersion: current.LastWriteVersion,
+		StartTime:        startTime,
+	}
+}
+
+func assertRunIDMismatch(requestRunID string, current *currentExecutionRecord) error {
+	if current.RunID == requestRunID {
+		return currentWorkflowConflictError(
+			current,
+			fmt.Sprintf(
+				"assertRunIDMismatch failed. request run ID: %v, current run ID: %v",
+				requestRunID,
+				current.RunID,
+			),
+		)
+	}
+	return nil
+}
+
+func assertNotCurrentExecution(
+	txn *kvdb.Txn,
+	shardID int32,
+	namespaceID string,
+	workflowID string,
+	runID string,
+) error {
+	var current currentExecutionRecord
+	found, err := getRecord(txn, currentExecutionKey(shardID, namespaceID, workflowID), &current)
+	if err != nil || !found {
+		// allow bypassing no current record
+		return err
+	}
+	return assertRunIDMismatch(runID, &current)
+}
+
+func assertRunIDAndUpdateCurrentExecution(
+	txn *kvdb.Txn,
+	shardID int32,
+	namespaceID string,
+	workflowID string,
+	newCurrent *currentExecutionRecord,
+	previousRunID string,
+) error {
+	currentKey := currentExecutionKey(shardID, namespaceID, workflowID)
+	var current currentExecutionRecord
+	found, err := getRecord(txn, currentKey, &current)
+	if err != nil {
+		return err
+	}
+	if !found {
+		return serviceerror.NewUnavailable(fmt.Sprintf(
+			"assertCurrentExecution failed. Unable to load current record. WorkflowId: %v",
+			workflowID,
+		))
+	}
+	if current.RunID != previousRunID {
+		return currentWorkflowConflictError(
+			&current,
+			fmt.Sprintf(
+				"assertRunIDAndUpdateCurrentExecution failed. current run ID: %v, request run ID: %v",
+				current.RunID,
+				previousRunID,
+			),
+		)
+	}
+	newCurrent.NamespaceID = namespaceID
+	newCurrent.WorkflowID = workflowID
+	return setRecord(txn, currentKey, newCurrent)
+}
+
+// lockAndCheckExecution checks the condition of a write to an existing execution, the execution can't change
+// until the transaction commits.
+func lockAndCheckExecution(
+	txn *kvdb.Txn,
+	shardID int32,
+	namespaceID string,
+	workflowID string,
+	runID string,
+	condition int64,
+	dbRecordVersion int64,
+) error {
+	var execution executionRecord
+	found, err := getRecord(txn, executionKey(shardID, namespaceID, workflowID, runID), &execution)
+	if err != nil {
+		return err
+	}
+	if !found {
+		return &p.ConditionFailedError{
+			Msg: fmt.Sprintf("WriteLockExecutions failed. Unable to lock (shard, namespace, workflow, run) = (%v,%v,%v,%v) which does not exist.",
+				shardID,
+				namespaceID,
+				workflowID,
+				runID),
+		}
+	}
+
+	if dbRecordVersion == 0 {
+		if execution.NextEventID != condition {
+			return &p.WorkflowConditionFailedError{
+				Msg:             fmt.Sprintf("lockAndCheckExecution failed. Next_event_id was %v when it should have been %v.", execution.NextEventID, condition),
+				NextEventID:     execution.NextEventID,
+				DBRecordVersion: execution.DBRecordVersion,
+			}
+		}
+	} else {
+		dbRecordVersion -= 1
+		if execution.DBRecordVersion != dbRecordVersion {
+			return &p.WorkflowConditionFailedError{
+				Msg:             fmt.Sprintf("lockAndCheckExecution failed. DBRecordVersion expected: %v, actually %v.", dbRecordVersion, execution.DBRecordVersion),
+				NextEventID:     execution.NextEventID,
+				DBRecordVersion: execution.DBRecordVersion,
+			}
+		}
+	}
+	return nil
+}
+
+func applyWorkflowSnapshotAsNew(
+	txn *kvdb.Txn,
+	shardID int32,
+	snapshot *p.InternalWorkflowSnapshot,
+) error {
+	executionKey := executionKey(shardID, snapshot.NamespaceID, snapshot.WorkflowID, snapshot.ExecutionState.RunId)
+	var existing executionRecord
+	found, err := getRecord(txn, executionKey, &existing)
+	if err != nil {
+		return err
+	}
+	if found {
+		return &p.WorkflowConditionFailedError{
+			Msg:             fmt.Sprintf("Workflow execution already running. WorkflowId: %v", snapshot.WorkflowID),
+			NextEventID:     0,
+			DBRecordVersion: 0,
+		}
+	}
+	return setWorkflowSnapshot(txn, shardID, executionKey, snapshot)
+}
+
+func applyWorkflowSnapshotAsReset(
+	txn *kvdb.Txn,
+	shardID int32,
+	snapshot *p.InternalWorkflowSnapshot,
+) error {
+	runID := snapshot.ExecutionState.RunId
+	if err := lockAndCheckExecution(
+		txn,
+		shardID,
+		snapshot.NamespaceID,
+		snapshot.WorkflowID,
+		runID,
+		snapshot.Condition,
+		snapshot.DBRecordVersion,
+	); err != nil {
+		return err
+	}
+	return setWorkflowSnapshot(txn, shardID, executionKey(shardID, snapshot.NamespaceID, snapshot.WorkflowID, runID), snapshot)
+}
+
+// setWorkflowSnapshot writes the execution and replaces all of its map entries.
+func setWorkflowSnapshot(
+	txn *kvdb.Txn,
+	shardID int32,
+	executionKey key,
+	snapshot *p.InternalWorkflowSnapshot,
+) error {
+	if err := setRecord(txn, executionKey, &executionRecord{
+		ExecutionInfo:    newBlob(snapshot.ExecutionInfoBlob),
+		ExecutionState:   newBlob(snapshot.ExecutionStateBlob),
+		NextEventID:      snapshot.NextEventID,
+		LastWriteVersion: snapshot.LastWriteVersion,
+		DBRecordVersion:  snapshot.DBRecordVersion,
+		Checksum:         newBlob(snapshot.Checksum),
+	}); err != nil {
+		return err
+	}
+	if err := applyTasks(txn, shardID, snapshot.Tasks); err != nil {
+		return err
+	}
+
+	prefix := executionMapPrefix(executionKey)
+	if err := deletePrefix(txn, prefix); err != nil {
+		return err
+	}
+	if err := upsertMapEntries(txn, prefix, mapActivityInfo, snapshot.ActivityInfos); err != nil {
+		return err
+	}
+	if err := upsertMapEntries(txn, prefix, mapTimerInfo, snapshot.TimerInfos); err != nil {
+		return err
+	}
+	if err := upsertMapEntries(txn, prefix, mapChildExecutionInfo, snapshot.ChildExecutionInfos); err != nil {
+		return err
+	}
+	if err := upsertMapEntries(txn, prefix, mapRequestCancelInfo, snapshot.RequestCancelInfos); err != nil {
+		return err
+	}
+	if err := upsertMapEntries(txn, prefix, mapSignalInfo, snapshot.SignalInfos); err != nil {
+		return err
+	}
+	if err := upsertSignalRequestedIDs(txn, prefix, snapshot.SignalRequestedIDs); err != nil {
+		return err
+	}
+	return upsertChasmNodes(txn, prefix, snapshot.ChasmNodes)
+}
+
+func applyWorkflowMutation(
+	txn *kvdb.Txn,
+	shardID int32,
+	mutation *p.InternalWorkflowMutation,
+) error {
+	namespaceID := mutation.NamespaceID
+	workflowID := mutation.WorkflowID
+	runID := mutation.ExecutionState.RunId
+
+	if err := lockAndCheckExecution(
+		txn,
+		shardID,
+		namespaceID,
+		workflowID,
+		runID,
+		mutation.Condition,
+		mutation.DBRecordVersion,
+	); err != nil {
+		return err
+	}
+
+	executionKey := executionKey(shardID, namespaceID, workflowID, runID)
+	if err := setRecord(txn, executionKey, &executionRecord{
+		ExecutionInfo:    newBlob(mutation.ExecutionInfoBlob),
+		ExecutionState:   newBlob(mutation.ExecutionStateBlob),
+		NextEventID:      mutation.NextEventID,
+		LastWriteVersion: mutation.LastWriteVersion,
+		DBRecordVersion:  mutation.DBRecordVersion,
+		Checksum:         newBlob(mutation.Checksum),
+	}); err != nil {
+		return err
+	}
+	if err := applyTasks(txn, shardID, mutation.Tasks); err != nil {
+		return err
+	}
+
+	prefix := executionMapPrefix(executionKey)
+	if err := upsertMapEntries(txn, prefix, mapActivityInfo, mutation.UpsertActivityInfos); err != nil {
+		return err
+	}
+	deleteMapEntries(txn, prefix, mapActivityInfo, mutation.DeleteActivityInfos)
+	if err := upsertMapEntries(txn, prefix, mapTimerInfo, mutation.UpsertTimerInfos); err != nil {
+		return err
+	}
+	deleteMapEntries(txn, prefix, mapTimerInfo, mutation.DeleteTimerInfos)
+	if err := upsertMapEntries(txn, prefix, mapChildExecutionInfo, mutation.UpsertChildExecutionInfos); err != nil {
+		return err
+	}
+	deleteMapEntries(txn, prefix, mapChildExecutionInfo, mutation.DeleteChildExecutionInfos)
+	if err := upsertMapEntries(txn, prefix, mapRequestCancelInfo, mutation.UpsertRequestCancelInfos); err != nil {
+		return err
+	}
+	deleteMapEntries(txn, prefix, mapRequestCancelInfo, mutation.DeleteRequestCancelInfos)
+	if err := upsertMapEntries(txn, prefix, mapSignalInfo, mutation.UpsertSignalInfos); err != nil {
+		return err
+	}
+	deleteMapEntries(txn, prefix, mapSignalInfo, mutation.DeleteSignalInfos)
+	if err := upsertSignalRequestedIDs(txn, prefix, mutation.UpsertSignalRequestedIDs); err != nil {
+		return err
+	}
+	deleteMapEntries(txn, prefix, mapSignalRequestedID, mutation.DeleteSignalRequestedIDs)
+	if err := upsertChasmNodes(txn, prefix, mutation.UpsertChasmNodes); err != nil {
+		return err
+	}
+	deleteMapEntries(txn, prefix, mapChasmNode, mutation.DeleteChasmNodes)
+
+	if mutation.ClearBufferedEvents {
+		if err := deletePrefix(txn, prefix.tag(mapBufferedEvents)); err != nil {
+			return err
+		}
+	}
+	return appendBufferedEvents(txn, prefix, mutation.NewBufferedEvents)
+}
+
+func mapEntryKey[K int64 | string](prefix key, mapTag byte, k K) (key, mapEntryRecord) {
+	switch k := any(k).(type) {
+	case int64:
+		return prefix.tag(mapTag).int64(k), mapEntryRecord{IntKey: k}
+	default:
+		s := k.(string)
+		return prefix.tag(mapTag).str(s), mapEntryRecord{StrKey: s}
+	}
+}
+
+func upsertMapEntries[K int64 | string](
+	txn *kvdb.Txn,
+	prefix key,
+	mapTag byte,
+	entries map[K]*commonpb.DataBlob,
+) error {
+	for k, value := range entries {
+		entryKey, entry := mapEntryKey(prefix, mapTag, k)
+		entry.Value = newBlob(value)
+		if err := setRecord(txn, entryKey, &entry); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func deleteMapEntries[K int64 | string](
+	txn *kvdb.Txn,
+	prefix key,
+	mapTag byte,
+	keys map[K]struct{},
+) {
+	for k := range keys {
+		entryKey, _ := mapEntryKey(prefix, mapTag, k)
+		txn.Delete(entryKey)
+	}
+}
+
+func upsertSignalRequestedIDs(
+	txn *kvdb.Txn,
+	prefix key,
+	signalRequestedIDs map[string]struct{},
+) error {
+	for signalRequestedID := range signalRequestedIDs {
+		entryKey, entry := mapEntryKey(prefix, mapSignalRequestedID, signalRequestedID)
+		if err := setRecord(txn, entryKey, &entry); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func upsertChasmNodes(
+	txn *kvdb.Txn,
+	prefix key,
+	nodes map[string]p.InternalChasmNode,
+) error {
+	for path, node := range nodes {
+		entryKey, entry := mapEntryKey(prefix, mapChasmNode, path)
+		entry.Value = newBlob(node.Data)
+		entry.Metadata = newBlob(node.Metadata)
+		if err := setRecord(txn, entryKey, &entry); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// appendBufferedEvents adds a batch of buffered events after the existing ones.
+func appendBufferedEvents(
+	txn *kvdb.Txn,
+	prefix key,
+	events *commonpb.DataBlob,
+) error {
+	if events == nil {
+		return nil
+	}
+	bufferedEventsPrefix := prefix.tag(mapBufferedEvents)
+	last, err := scanRecords[mapEntryRecord](txn, bufferedEventsPrefix, bufferedEventsPrefix.prefixEnd(), true, 1)
+	if err != nil {
+		return err
+	}
+	var sequence int64
+	if len(last) > 0 {
+		sequence = last[0].value.IntKey + 1
+	}
+	entryKey, entry := mapEntryKey(prefix, mapBufferedEvents, sequence)
+	entry.Value = newBlob(events)
+	return setRecord(txn, entryKey, &entry)
+}
+
+func readWorkflowMutableState(
+	r reader,
+	executionKey key,
+	execution *executionRecord,
+) (*p.InternalWorkflowMutableState, error) {
+	state := &p.InternalWorkflowMutableState{
+		ActivityInfos:       make(map[int64]*commonpb.DataBlob),
+		TimerInfos:          make(map[string]*commonpb.DataBlob),
+		ChildExecutionInfos: make(map[int64]*commonpb.DataBlob),
+		RequestCancelInfos:  make(map[int64]*commonpb.DataBlob),
+		SignalInfos:         make(map[int64]*commonpb.DataBlob),
+		ChasmNodes:          make(map[string]p.InternalChasmNode),
+		ExecutionInfo:       execution.ExecutionInfo.dataBlob(),
+		ExecutionState:      execution.ExecutionState.dataBlob(),
+		NextEventID:         execution.NextEventID,
+		Checksum:            execution.Checksum.dataBlob(),
+		DBRecordVersion:     execution.DBRecordVersion,
+	}
+
+	prefix := executionMapPrefix(executionKey)
+	entries, err := scanPrefix[mapEntryRecord](r, prefix, 0)
+	if err != nil {
+		return nil, err
+	}
+	for _, entry := range entries {
+		value := entry.value
+		switch entry.key[len(prefix)] {
+		case mapActivityInfo:
+			state.ActivityInfos[value.IntKey] = value.Value.dataBlob()
+		case mapTimerInfo:
+			state.TimerInfos[value.StrKey] = value.Value.dataBlob()
+		case mapChildExecutionInfo:
+			state.ChildExecutionInfos[value.IntKey] = value.Value.dataBlob()
+		case mapRequestCancelInfo:
+			state.RequestCancelInfos[value.IntKey] = value.Value.dataBlob()
+		case mapSignalInfo:
+			state.SignalInfos[value.IntKey] = value.Value.dataBlob()
+		case mapSignalRequestedID:
+			state.SignalRequestedIDs = append(state.SignalRequestedIDs, value.StrKey)
+		case mapChasmNode:
+			state.ChasmNodes[value.StrKey] = p.InternalChasmNode{
+				Metadata: value.Metadata.dataBlob(),
+				Data:     value.Value.dataBlob(),
+			}
+		case mapBufferedEvents:
+			// entries are ordered by sequence
+			state.BufferedEvents = append(state.BufferedEvents, value.Value.dataBlob())
+		}
+	}
+	return state, nil
+}
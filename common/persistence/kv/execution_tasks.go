@@ -0,0 +1,270 @@
+package kv
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"go.temporal.io/api/serviceerror"
+	p "go.temporal.io/server/common/persistence"
+	kvdb "go.temporal.io/server/common/persistence/nosql/nosqlplugin/kv"
+	"go.temporal.io/server/common/persistence/serialization"
+	"go.temporal.io/server/service/history/tasks"
+)
+
+type (
+	// historyTaskRecord is a history task of any category, scheduled tasks are ordered by fire time and then
+	// task ID, immediate tasks by task ID only.
+	historyTaskRecord struct {
+		FireTime int64 `json:"fireTime,omitempty"`
+		TaskID   int64 `json:"taskId"`
+		Task     blob  `json:"task"`
+	}
+)
+
+// historyTaskRanges are the transaction ranges of the history tasks of a shard.
+func historyTaskRanges(shardID int32) []key {
+	return []key{newKey(prefixHistoryTask).int32(shardID)}
+}
+
+// replicationDLQTaskRanges are the transaction ranges of the replication DLQ tasks of a shard.
+func replicationDLQTaskRanges(shardID int32) []key {
+	return []key{newKey(prefixReplicationDLQTask).int32(shardID)}
+}
+
+func historyTaskPrefix(shardID int32, category tasks.Category) key {
+	return newKey(prefixHistoryTask).int32(shardID).int32(int32(category.ID()))
+}
+
+func historyTaskKey(prefix key, categoryType tasks.CategoryType, taskKey tasks.Key) key {
+	if categoryType == tasks.CategoryTypeScheduled {
+		return prefix.int64(taskKey.FireTime.UnixNano()).int64(taskKey.TaskID)
+	}
+	return prefix.int64(taskKey.TaskID)
+}
+
+func replicationDLQTaskPrefix(shardID int32, sourceClusterName string) key {
+	return newKey(prefixReplicationDLQTask).int32(shardID).str(sourceClusterName)
+}
+
+func (s *ExecutionStore) AddHistoryTasks(
+	_ context.Context,
+	request *p.InternalAddHistoryTasksRequest,
+) error {
+	return s.update("AddHistoryTasks", executionRanges(request.ShardID), func(txn *kvdb.Txn) error {
+		if err := checkShardRangeID(txn, request.ShardID, request.RangeID); err != nil {
+			return err
+		}
+		return applyTasks(txn, request.ShardID, request.Tasks)
+	})
+}
+
+func applyTasks(
+	txn *kvdb.Txn,
+	shardID int32,
+	insertTasks map[tasks.Category][]p.InternalHistoryTask,
+) error {
+	for category, tasksByCategory := range insertTasks {
+		categoryType := category.Type()
+		if categoryType != tasks.CategoryTypeImmediate && categoryType != tasks.CategoryTypeScheduled {
+			return serviceerror.NewInternal(fmt.Sprintf("Unknown task category type: %v", category))
+		}
+		prefix := historyTaskPrefix(shardID, category)
+		for _, task := range tasksByCategory {
+			record := historyTaskRecord{TaskID: task.Key.TaskID, Task: newBlob(task.Blob)}
+			if categoryType == tasks.CategoryTypeScheduled {
+				record.FireTime = task.Key.FireTime.UnixNano()
+			}
+			if err := setRecord(txn, historyTaskKey(prefix, categoryType, task.Key), &record); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (s *ExecutionStore) GetHistoryTasks(
+	_ context.Context,
+	request *p.GetHistoryTasksRequest,
+) (*p.InternalGetHistoryTasksResponse, error) {
+	categoryType := request.TaskCategory.Type()
+	if categoryType != tasks.CategoryTypeImmediate && categoryType != tasks.CategoryTypeScheduled {
+		return nil, serviceerror.NewInternal(fmt.Sprintf("Unknown task category type: %v", request.TaskCategory))
+	}
+
+	prefix := historyTaskPrefix(request.ShardID, request.TaskCategory)
+	minTaskKey := request.InclusiveMinTaskKey
+	maxTaskKey := request.ExclusiveMaxTaskKey
+	if categoryType == tasks.CategoryTypeScheduled {
+		// scheduled tasks are read by fire time only
+		minTaskKey = tasks.NewKey(minTaskKey.FireTime, math.MinInt64)
+		maxTaskKey = tasks.NewKey(maxTaskKey.FireTime, math.MinInt64)
+	}
+	return getHistoryTasks(
+		s.db,
+		prefix,
+		historyTaskKey(prefix, categoryType, minTaskKey),
+		historyTaskKey(prefix, categoryType, maxTaskKey),
+		request.BatchSize,
+		request.NextPageToken,
+	)
+}
+
+func getHistoryTasks(
+	r reader,
+	prefix key,
+	inclusiveMin key,
+	exclusiveMax key,
+	batchSize int,
+	pageToken []byte,
+) (*p.InternalGetHistoryTasksResponse, error) {
+	lower, err := pageLowerBound(prefix, pageToken)
+	if err != nil {
+		return nil, err
+	}
+	if len(pageToken) == 0 {
+		lower = inclusiveMin
+	}
+
+	records, err := scanRecords[historyTaskRecord](r, lower, exclusiveMax, false, batchSize)
+	if err != nil {
+		return nil, err
+	}
+	response := &p.InternalGetHistoryTasksResponse{Tasks: make([]p.InternalHistoryTask, 0, len(records))}
+	for _, record := range records {
+		// the fire time of immediate tasks is zero, which is the DefaultFireTime
+		response.Tasks = append(response.Tasks, p.InternalHistoryTask{
+			Key:  tasks.NewKey(time.Unix(0, record.value.FireTime).UTC(), record.value.TaskID),
+			Blob: record.value.Task.dataBlob(),
+		})
+	}
+	if len(records) > 0 && len(records) == batchSize {
+		response.NextPageToken = records[len(records)-1].key
+	}
+	return response, nil
+}
+
+func (s *ExecutionStore) CompleteHistoryTask(
+	_ context.Context,
+	request *p.CompleteHistoryTaskRequest,
+) error {
+	categoryType := request.TaskCategory.Type()
+	if categoryType != tasks.CategoryTypeImmediate && categoryType != tasks.CategoryTypeScheduled {
+		return serviceerror.NewInternal(fmt.Sprintf("Unknown task category type: %v", request.TaskCategory))
+	}
+
+	return s.update("CompleteHistoryTask", historyTaskRanges(request.ShardID), func(txn *kvdb.Txn) error {
+		prefix := historyTaskPrefix(request.ShardID, request.TaskCategory)
+		txn.Delete(historyTaskKey(prefix, categoryType, request.TaskKey))
+		return nil
+	})
+}
+
+func (s *ExecutionStore) RangeCompleteHistoryTasks(
+	_ context.Context,
+	request *p.RangeCompleteHistoryTasksRequest,
+) error {
+	categoryType := request.TaskCategory.Type()
+	if categoryType != tasks.CategoryTypeImmediate && categoryType != tasks.CategoryTypeScheduled {
+		return serviceerror.NewInternal(fmt.Sprintf("Unknown task category type: %v", request.TaskCategory))
+	}
+
+	prefix := historyTaskPrefix(request.ShardID, request.TaskCategory)
+	minTaskKey := request.InclusiveMinTaskKey
+	maxTaskKey := request.ExclusiveMaxTaskKey
+	if categoryType == tasks.CategoryTypeScheduled {
+		// scheduled tasks are deleted by fire time only
+		minTaskKey = tasks.NewKey(minTaskKey.FireTime, math.MinInt64)
+		maxTaskKey = tasks.NewKey(maxTaskKey.FireTime, math.MinInt64)
+	}
+	return s.update("RangeCompleteHistoryTasks", historyTaskRanges(request.ShardID), func(txn *kvdb.Txn) error {
+		if err := txn.DeleteRange(
+			historyTaskKey(prefix, categoryType, minTaskKey),
+			historyTaskKey(prefix, categoryType, maxTaskKey),
+		); err != nil {
+			return storageError("RangeCompleteHistoryTasks", err)
+		}
+		return nil
+	})
+}
+
+func (s *ExecutionStore) PutReplicationTaskToDLQ(
+	_ context.Context,
+	request *p.PutReplicationTaskToDLQRequest,
+) error {
+	replicationTask := request.TaskInfo
+	taskBlob, err := serialization.ReplicationTaskInfoToBlob(replicationTask)
+	if err != nil {
+		return err
+	}
+
+	// Tasks are immutable. So it's fine if we already persisted it before.
+	// This can happen when tasks are retried (ack and cleanup can have lag on source side).
+	return s.update("PutReplicationTaskToDLQ", replicationDLQTaskRanges(request.ShardID), func(txn *kvdb.Txn) error {
+		return setRecord(
+			txn,
+			replicationDLQTaskPrefix(request.ShardID, request.SourceClusterName).int64(replicationTask.GetTaskId()),
+			&historyTaskRecord{TaskID: replicationTask.GetTaskId(), Task: newBlob(taskBlob)},
+		)
+	})
+}
+
+func (s *ExecutionStore) GetReplicationTasksFromDLQ(
+	_ context.Context,
+	request *p.GetReplicationTasksFromDLQRequest,
+) (*p.InternalGetHistoryTasksResponse, error) {
+	prefix := replicationDLQTaskPrefix(request.ShardID, request.SourceClusterName)
+	return getHistoryTasks(
+		s.db,
+		prefix,
+		prefix.int64(request.InclusiveMinTaskKey.TaskID),
+		prefix.int64(request.ExclusiveMaxTaskKey.TaskID),
+		request.BatchSize,
+		request.NextPageToken,
+	)
+}
+
+func (s *ExecutionStore) DeleteReplicationTaskFromDLQ(
+	_ context.Context,
+	request *p.DeleteReplicationTaskFromDLQRequest,
+) error {
+	return s.update("DeleteReplicationTaskFromDLQ", replicationDLQTaskRanges(request.ShardID), func(txn *kvdb.Txn) error {
+		txn.Delete(replicationDLQTaskPrefix(request.ShardID, request.SourceClusterName).int64(request.TaskKey.TaskID))
+		return nil
+	})
+}
+
+func (s *ExecutionStore) RangeDeleteReplicationTaskFromDLQ(
+	_ context.Context,
+	request *p.RangeDeleteReplicationTaskFromDLQRequest,
+) error {
+	prefix := replicationDLQTaskPrefix(request.ShardID, request.SourceClusterName)
+	return s.update("RangeDeleteReplicationTaskFromDLQ", replicationDLQTaskRanges(request.ShardID), func(txn *kvdb.Txn) error {
+		if err := txn.DeleteRange(
+			prefix.int64(request.InclusiveMinTaskKey.TaskID),
+			prefix.int64(request.ExclusiveMaxTaskKey.TaskID),
+		); err != nil {
+			return storageError("RangeDeleteReplicationTaskFromDLQ", err)
+		}
+		return nil
+	})
+}
+
+func (s *ExecutionStore) IsReplicationDLQEmpty(
+	_ context.Context,
+	request *p.GetReplicationTasksFromDLQRequest,
+) (bool, error) {
+	prefix := replicationDLQTaskPrefix(request.ShardID, request.SourceClusterName)
+	records, err := scanRecords[historyTaskRecord](
+		s.db,
+		prefix.int64(request.InclusiveMinTaskKey.TaskID),
+		prefix.prefixEnd(),
+		false,
+		1,
+	)
+	if err != nil {
+		return false, err
+	}
+	return len(records) == 0, nil
+}
@@ -0,0 +1,210 @@
+package kv
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/metrics"
+	p "go.temporal.io/server/common/persistence"
+	kvdb "go.temporal.io/server/common/persistence/nosql/nosqlplugin/kv"
+	"go.temporal.io/server/common/resolver"
+)
+
+const (
+	// PluginName is the name of the custom datastore that selects the embedded key-value store.
+	PluginName = "kv"
+
+	// Options of the custom datastore config.
+	optionPath         = "path"
+	optionSyncWrites   = "syncWrites"
+	optionMemTableSize = "memTableSizeBytes"
+	optionMaxTables    = "maxTables"
+
+	memoryPathPrefix = "memory:"
+)
+
+type (
+	// Factory vends datastore implementations backed by the embedded key-value store
+	Factory struct {
+		sync.RWMutex
+		path        string
+		clusterName string
+		logger      log.Logger
+		db          *kvdb.DB
+	}
+
+	// AbstractDataStoreFactory creates factories for custom datastores named PluginName. It is used by default
+	// when the server is not given an AbstractDataStoreFactory.
+	AbstractDataStoreFactory struct{}
+
+	sharedDB struct {
+		db       *kvdb.DB
+		refCount int
+	}
+)
+
+var (
+	// The services of a single binary deployment each create their own factory, but the database files can
+	// only be opened once, so databases are shared by path.
+	sharedDBsLock sync.Mutex
+	sharedDBs     = make(map[string]*sharedDB)
+)
+
+// NewFactory implements client.AbstractDataStoreFactory.
+func (AbstractDataStoreFactory) NewFactory(
+	cfg config.CustomDatastoreConfig,
+	_ resolver.ServiceResolver,
+	clusterName string,
+	logger log.Logger,
+	_ metrics.Handler,
+) p.DataStoreFactory {
+	factory, err := NewFactory(cfg, clusterName, logger)
+	if err != nil {
+		logger.Fatal("unable to open kv datastore", tag.Error(err))
+	}
+	return factory
+}
+
+// NewFactory returns an instance of a factory object which can be used to create data stores that are backed
+// by the embedded key-value store. The "path" option is the directory of the database, databases with the
+// "memory:" path prefix are kept in memory and shared by name within the process.
+func NewFactory(
+	cfg config.CustomDatastoreConfig,
+	clusterName string,
+	logger log.Logger,
+) (*Factory, error) {
+	opts, err := parseOptions(cfg.Options)
+	if err != nil {
+		return nil, err
+	}
+	db, err := openSharedDB(opts)
+	if err != nil {
+		return nil, err
+	}
+	return &Factory{
+		path:        opts.Path,
+		clusterName: clusterName,
+		logger:      logger,
+		db:          db,
+	}, nil
+}
+
+func parseOptions(options map[string]any) (kvdb.Options, error) {
+	var opts kvdb.Options
+	var ok bool
+	if opts.Path, ok = options[optionPath].(string); !ok || opts.Path == "" {
+		return opts, fmt.Errorf("kv datastore: option %q is required", optionPath)
+	}
+	if value, found := options[optionSyncWrites]; found {
+		if opts.SyncWrites, ok = value.(bool); !ok {
+			return opts, fmt.Errorf("kv datastore: option %q must be a bool", optionSyncWrites)
+		}
+	}
+	for name, target := range map[string]*int{optionMemTableSize: &opts.MemTableSize, optionMaxTables: &opts.MaxTables} {
+		value, found := options[name]
+		if !found {
+			continue
+		}
+		if *target, ok = value.(int); !ok {
+			return opts, fmt.Errorf("kv datastore: option %q must be an int", name)
+		}
+	}
+	return opts, nil
+}
+
+func openSharedDB(opts kvdb.Options) (*kvdb.DB, error) {
+	sharedDBsLock.Lock()
+	defer sharedDBsLock.Unlock()
+
+	if shared, ok := sharedDBs[opts.Path]; ok {
+		shared.refCount++
+		return shared.db, nil
+	}
+	path := opts.Path
+	if isMemoryPath(path) {
+		opts.Path = ""
+	}
+	db, err := kvdb.Open(opts)
+	if err != nil {
+		return nil, err
+	}
+	sharedDBs[path] = &sharedDB{db: db, refCount: 1}
+	return db, nil
+}
+
+func closeSharedDB(path string) error {
+	sharedDBsLock.Lock()
+	defer sharedDBsLock.Unlock()
+
+	shared, ok := sharedDBs[path]
+	if !ok {
+		return nil
+	}
+	shared.refCount--
+	if shared.refCount > 0 {
+		return nil
+	}
+	delete(sharedDBs, path)
+	return shared.db.Close()
+}
+
+func isMemoryPath(path string) bool {
+	return strings.HasPrefix(path, memoryPathPrefix)
+}
+
+// NewTaskStore returns a new task store
+func (f *Factory) NewTaskStore() (p.TaskStore, error) {
+	return NewTaskStore(f.db, f.logger), nil
+}
+
+// NewShardStore returns a new shard store
+func (f *Factory) NewShardStore() (p.ShardStore, error) {
+	return NewShardStore(f.clusterName, f.db, f.logger), nil
+}
+
+// NewMetadataStore returns a metadata store
+func (f *Factory) NewMetadataStore() (p.MetadataStore, error) {
+	return NewMetadataStore(f.db, f.logger), nil
+}
+
+// NewClusterMetadataStore returns a cluster metadata store
+func (f *Factory) NewClusterMetadataStore() (p.ClusterMetadataStore, error) {
+	return NewClusterMetadataStore(f.db, f.logger), nil
+}
+
+// NewExecutionStore returns a new ExecutionStore.
+func (f *Factory) NewExecutionStore() (p.ExecutionStore, error) {
+	return NewExecutionStore(f.db, f.logger), nil
+}
+
+// NewQueue returns a new queue backed by the key-value store
+func (f *Factory) NewQueue(queueType p.QueueType) (p.Queue, error) {
+	return NewQueueStore(queueType, f.db, f.logger), nil
+}
+
+// NewQueueV2 returns a new data-access object for queues and messages. It will never return an error.
+func (f *Factory) NewQueueV2() (p.QueueV2, error) {
+	return NewQueueV2Store(f.db, f.logger), nil
+}
+
+// NewNexusEndpointStore returns a new NexusEndpointStore
+func (f *Factory) NewNexusEndpointStore() (p.NexusEndpointStore, error) {
+	return NewNexusEndpointStore(f.db, f.logger), nil
+}
+
+// Close closes the factory
+func (f *Factory) Close() {
+	f.Lock()
+	defer f.Unlock()
+	if f.db == nil {
+		return
+	}
+	if err := closeSharedDB(f.path); err != nil {
+		f.logger.Error("unable to close kv datastore", tag.Error(err))
+	}
+	f.db = nil
+}
@@ -0,0 +1,261 @@
+package kv
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/api/serviceerror"
+	p "go.temporal.io/server/common/persistence"
+	kvdb "go.temporal.io/server/common/persistence/nosql/nosqlplugin/kv"
+)
+
+type (
+	historyNodeRecord struct {
+		NodeID    int64 `json:"nodeId"`
+		TxnID     int64 `json:"txnId"`
+		PrevTxnID int64 `json:"prevTxnId"`
+		Events    blob  `json:"events"`
+	}
+
+	historyTreeRecord struct {
+		TreeID   string `json:"treeId"`
+		BranchID string `json:"branchId"`
+		TreeInfo blob   `json:"treeInfo"`
+	}
+)
+
+func historyBranchPrefix(shardID int32, treeID string, branchID string) key {
+	return newKey(prefixHistoryNode).int32(shardID).str(treeID).str(branchID)
+}
+
+// historyNodeKey orders the nodes of a branch by node ID and then by descending transaction ID, so that the
+// node written by the latest transaction comes first.
+func historyNodeKey(branchPrefix key, nodeID int64, txnID int64) key {
+	return branchPrefix.int64(nodeID).int64(-txnID)
+}
+
+// historyTreeRanges are the transaction ranges of the nodes and branches of a history tree.
+func historyTreeRanges(shardID int32, treeID string) []key {
+	return []key{
+		newKey(prefixHistoryNode).int32(shardID).str(treeID),
+		newKey(prefixHistoryTree).int32(shardID).str(treeID),
+	}
+}
+
+func historyTreeKey(shardID int32, treeID string, branchID string) key {
+	return newKey(prefixHistoryTree).int32(shardID).str(treeID).str(branchID)
+}
+
+// AppendHistoryNodes add(or override) a node to a history branch
+func (s *ExecutionStore) AppendHistoryNodes(
+	_ context.Context,
+	request *p.InternalAppendHistoryNodesRequest,
+) error {
+	branchInfo := request.BranchInfo
+	node := request.Node
+	nodeKey := historyNodeKey(
+		historyBranchPrefix(request.ShardID, branchInfo.GetTreeId(), branchInfo.GetBranchId()),
+		node.NodeID,
+		node.TransactionID,
+	)
+	nodeRecord := &historyNodeRecord{
+		NodeID:    node.NodeID,
+		TxnID:     node.TransactionID,
+		PrevTxnID: node.PrevTransactionID,
+		Events:    newBlob(node.Events),
+	}
+
+	return s.update("AppendHistoryNodes", historyTreeRanges(request.ShardID, branchInfo.GetTreeId()), func(txn *kvdb.Txn) error {
+		if !request.IsNewBranch {
+			var existing historyNodeRecord
+			found, err := getRecord(txn, nodeKey, &existing)
+			if err != nil {
+				return err
+			}
+			if found {
+				return &p.ConditionFailedError{Msg: fmt.Sprintf("AppendHistoryNodes: row already exist: node ID %v, transaction ID %v", node.NodeID, node.TransactionID)}
+			}
+			return setRecord(txn, nodeKey, nodeRecord)
+		}
+
+		if err := setRecord(txn, nodeKey, nodeRecord); err != nil {
+			return err
+		}
+		return setRecord(txn, historyTreeKey(request.ShardID, branchInfo.GetTreeId(), branchInfo.GetBranchId()), &historyTreeRecord{
+			TreeID:   branchInfo.GetTreeId(),
+			BranchID: branchInfo.GetBranchId(),
+			TreeInfo: newBlob(request.TreeInfo),
+		})
+	})
+}
+
+// DeleteHistoryNodes delete a node from history node table
+func (s *ExecutionStore) DeleteHistoryNodes(
+	_ context.Context,
+	request *p.InternalDeleteHistoryNodesRequest,
+) error {
+	branchInfo := request.BranchInfo
+	if request.NodeID < p.GetBeginNodeID(branchInfo) {
+		return &p.InvalidPersistenceRequestError{
+			Msg: "cannot append to ancestors' nodes",
+		}
+	}
+
+	return s.update("DeleteHistoryNodes", historyTreeRanges(request.ShardID, branchInfo.GetTreeId()), func(txn *kvdb.Txn) error {
+		txn.Delete(historyNodeKey(
+			historyBranchPrefix(request.ShardID, branchInfo.GetTreeId(), branchInfo.GetBranchId()),
+			request.NodeID,
+			request.TransactionID,
+		))
+		return nil
+	})
+}
+
+// ReadHistoryBranch returns history node data for a branch. Page tokens are the key of the last node of the
+// previous page.
+func (s *ExecutionStore) ReadHistoryBranch(
+	_ context.Context,
+	request *p.InternalReadHistoryBranchRequest,
+) (*p.InternalReadHistoryBranchResponse, error) {
+	branch, err := s.GetHistoryBranchUtil().ParseHistoryBranchInfo(request.BranchToken)
+	if err != nil {
+		return nil, err
+	}
+
+	prefix := historyBranchPrefix(request.ShardID, branch.TreeId, request.BranchID)
+	lower := prefix.int64(request.MinNodeID)
+	upper := prefix.int64(request.MaxNodeID)
+	if len(request.NextPageToken) > 0 {
+		if !bytes.HasPrefix(request.NextPageToken, prefix) {
+			return nil, serviceerror.NewInvalidArgument("invalid page token")
+		}
+		if request.ReverseOrder {
+			upper = request.NextPageToken
+		} else {
+			lower = key(request.NextPageToken).next()
+		}
+	}
+
+	records, err := scanRecords[historyNodeRecord](s.db, lower, upper, request.ReverseOrder, request.PageSize)
+	if err != nil {
+		return nil, err
+	}
+	nodes := make([]p.InternalHistoryNode, 0, len(records))
+	for _, record := range records {
+		node := p.InternalHistoryNode{
+			NodeID:            record.value.NodeID,
+			PrevTransactionID: record.value.PrevTxnID,
+			TransactionID:     record.value.TxnID,
+		}
+		if !request.MetadataOnly {
+			node.Events = record.value.Events.dataBlob()
+		}
+		nodes = append(nodes, node)
+	}
+
+	response := &p.InternalReadHistoryBranchResponse{Nodes: nodes}
+	if request.PageSize > 0 && len(records) == request.PageSize {
+		response.NextPageToken = records[len(records)-1].key
+	}
+	return response, nil
+}
+
+// ForkHistoryBranch forks a new branch from an existing branch, nodes of the ancestors are shared so only the
+// branch info of the new branch is written.
+func (s *ExecutionStore) ForkHistoryBranch(
+	_ context.Context,
+	request *p.InternalForkHistoryBranchRequest,
+) error {
+	treeID := request.ForkBranchInfo.GetTreeId()
+	return s.update("ForkHistoryBranch", historyTreeRanges(request.ShardID, treeID), func(txn *kvdb.Txn) error {
+		return setRecord(txn, historyTreeKey(request.ShardID, treeID, request.NewBranchID), &historyTreeRecord{
+			TreeID:   treeID,
+			BranchID: request.NewBranchID,
+			TreeInfo: newBlob(request.TreeInfo),
+		})
+	})
+}
+
+// DeleteHistoryBranch removes a branch
+func (s *ExecutionStore) DeleteHistoryBranch(
+	_ context.Context,
+	request *p.InternalDeleteHistoryBranchRequest,
+) error {
+	treeID := request.BranchInfo.GetTreeId()
+	return s.update("DeleteHistoryBranch", historyTreeRanges(request.ShardID, treeID), func(txn *kvdb.Txn) error {
+		txn.Delete(historyTreeKey(request.ShardID, treeID, request.BranchInfo.GetBranchId()))
+
+		// delete each branch range
+		for _, branchRange := range request.BranchRanges {
+			prefix := historyBranchPrefix(request.ShardID, treeID, branchRange.BranchId)
+			if err := txn.DeleteRange(prefix.int64(branchRange.BeginNodeId), prefix.prefixEnd()); err != nil {
+				return storageError("DeleteHistoryBranch", err)
+			}
+		}
+		return nil
+	})
+}
+
+// GetHistoryTreeContainingBranch returns all branch information of a tree
+func (s *ExecutionStore) GetHistoryTreeContainingBranch(
+	_ context.Context,
+	request *p.InternalGetHistoryTreeContainingBranchRequest,
+) (*p.InternalGetHistoryTreeContainingBranchResponse, error) {
+	branch, err := s.GetHistoryBranchUtil().ParseHistoryBranchInfo(request.BranchToken)
+	if err != nil {
+		return nil, err
+	}
+
+	records, err := scanPrefix[historyTreeRecord](s.db, newKey(prefixHistoryTree).int32(request.ShardID).str(branch.TreeId), 0)
+	if err != nil {
+		return nil, err
+	}
+	treeInfos := make([]*commonpb.DataBlob, 0, len(records))
+	for _, record := range records {
+		treeInfos = append(treeInfos, record.value.TreeInfo.dataBlob())
+	}
+	return &p.InternalGetHistoryTreeContainingBranchResponse{
+		TreeInfos: treeInfos,
+	}, nil
+}
+
+// GetAllHistoryTreeBranches returns the branches of all shards.
+func (s *ExecutionStore) GetAllHistoryTreeBranches(
+	_ context.Context,
+	request *p.GetAllHistoryTreeBranchesRequest,
+) (*p.InternalGetAllHistoryTreeBranchesResponse, error) {
+	pageSize := request.PageSize
+	if pageSize <= 0 {
+		return nil, fmt.Errorf("PageSize must be greater than 0, but was %d", pageSize)
+	}
+
+	prefix := newKey(prefixHistoryTree)
+	lower, err := pageLowerBound(prefix, request.NextPageToken)
+	if err != nil {
+		return nil, err
+	}
+	records, err := scanRecords[historyTreeRecord](s.db, lower, prefix.prefixEnd(), false, pageSize)
+	if err != nil {
+		return nil, err
+	}
+	branches := make([]p.InternalHistoryBranchDetail, 0, len(records))
+	for _, record := range records {
+		branches = append(branches, p.InternalHistoryBranchDetail{
+			TreeID:   record.value.TreeID,
+			BranchID: record.value.BranchID,
+			Data:     record.value.TreeInfo.Data,
+			Encoding: record.value.TreeInfo.Encoding,
+		})
+	}
+
+	response := &p.InternalGetAllHistoryTreeBranchesResponse{
+		Branches: branches,
+	}
+	// if we filled the page with rows, then set the next page token
+	if len(records) == pageSize {
+		response.NextPageToken = records[len(records)-1].key
+	}
+	return response, nil
+}
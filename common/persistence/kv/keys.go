@@ -0,0 +1,92 @@
+package kv
+
+import (
+	"bytes"
+	"encoding/binary"
+)
+
+// Every table of the store is a range of keys starting with its own prefix byte. Key components are
+// encoded so that the byte order of keys matches the natural order of their components:
+//   - integers are big endian with the sign bit flipped,
+//   - strings escape 0x00 as 0x00 0xff and are terminated by 0x00 0x01, so that no string is a prefix of
+//     another one.
+const (
+	prefixShard                   byte = 's'
+	prefixCurrentExecution        byte = 'c'
+	prefixExecution               byte = 'e'
+	prefixExecutionMap            byte = 'm'
+	prefixHistoryTask             byte = 't'
+	prefixReplicationDLQTask      byte = 'd'
+	prefixHistoryNode             byte = 'n'
+	prefixHistoryTree             byte = 'r'
+	prefixTaskQueue               byte = 'T'
+	prefixTask                    byte = 'K'
+	prefixTaskQueueUserData       byte = 'U'
+	prefixBuildIDToTaskQueue      byte = 'B'
+	prefixNamespace               byte = 'N'
+	prefixNamespaceName           byte = 'M'
+	prefixNamespaceMetadata       byte = 'V'
+	prefixClusterMetadata         byte = 'C'
+	prefixClusterMembership       byte = 'P'
+	prefixQueueMessage            byte = 'Q'
+	prefixQueueMetadata           byte = 'A'
+	prefixQueueV2Message          byte = 'w'
+	prefixQueueV2Metadata         byte = 'W'
+	prefixNexusEndpoint           byte = 'X'
+	prefixNexusEndpointsTableInfo byte = 'x'
+)
+
+type (
+	key []byte
+)
+
+func newKey(prefix byte) key {
+	return key{prefix}
+}
+
+// Keys are built by appending components to a shared prefix, appending must never write to the backing
+// array of the prefix.
+func (k key) clip() key {
+	return k[:len(k):len(k)]
+}
+
+func (k key) int32(v int32) key {
+	return binary.BigEndian.AppendUint32(k.clip(), uint32(v)^(1<<31))
+}
+
+func (k key) int64(v int64) key {
+	return binary.BigEndian.AppendUint64(k.clip(), uint64(v)^(1<<63))
+}
+
+func (k key) str(s string) key {
+	k = k.clip()
+	for i := 0; i < len(s); i++ {
+		k = append(k, s[i])
+		if s[i] == 0 {
+			k = append(k, 0xff)
+		}
+	}
+	return append(k, 0, 1)
+}
+
+// tag appends a single byte, it is used to split a key range into sub ranges.
+func (k key) tag(b byte) key {
+	return append(k.clip(), b)
+}
+
+// next returns the smallest key greater than k.
+func (k key) next() key {
+	return append(bytes.Clone(k), 0)
+}
+
+// prefixEnd returns the smallest key greater than all keys starting with k, or nil if there is none.
+func (k key) prefixEnd() key {
+	end := bytes.Clone(k)
+	for i := len(end) - 1; i >= 0; i-- {
+		if end[i] < 0xff {
+			end[i]++
+			return end[:i+1]
+		}
+	}
+	return nil
+}
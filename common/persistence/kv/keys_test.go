@@ -0,0 +1,60 @@
+package kv
+
+import (
+	"bytes"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestKeyOrder(t *testing.T) {
+	ordered := []key{
+		newKey(prefixTask).int64(math.MinInt64),
+		newKey(prefixTask).int64(-1),
+		newKey(prefixTask).int64(0),
+		newKey(prefixTask).int64(1),
+		newKey(prefixTask).int64(math.MaxInt64),
+	}
+	for i := 1; i < len(ordered); i++ {
+		require.Negative(t, bytes.Compare(ordered[i-1], ordered[i]))
+	}
+
+	ordered = []key{
+		newKey(prefixTaskQueue).str(""),
+		newKey(prefixTaskQueue).str("a"),
+		newKey(prefixTaskQueue).str("a\x00"),
+		newKey(prefixTaskQueue).str("a\x00b"),
+		newKey(prefixTaskQueue).str("ab"),
+		newKey(prefixTaskQueue).str("b"),
+	}
+	for i := 1; i < len(ordered); i++ {
+		require.Negative(t, bytes.Compare(ordered[i-1], ordered[i]))
+	}
+}
+
+func TestKeyStringIsNotPrefix(t *testing.T) {
+	prefix := newKey(prefixQueueV2Message).int32(1).str("queue")
+	other := newKey(prefixQueueV2Message).int32(1).str("queue2").int64(0)
+	require.False(t, bytes.HasPrefix(other, prefix))
+	require.True(t, bytes.HasPrefix(prefix.int64(0), prefix))
+}
+
+func TestKeyAppendDoesNotAlias(t *testing.T) {
+	prefix := make(key, 1, 64)
+	prefix[0] = prefixTask
+	first := prefix.int64(1)
+	second := prefix.int64(2)
+	require.Equal(t, newKey(prefixTask).int64(1), first)
+	require.Equal(t, newKey(prefixTask).int64(2), second)
+}
+
+func TestKeyPrefixEnd(t *testing.T) {
+	require.Equal(t, key{'a', 2}, key{'a', 1}.prefixEnd())
+	require.Equal(t, key{'b'}, key{'a', 0xff}.prefixEnd())
+	require.Nil(t, key{0xff, 0xff}.prefixEnd())
+
+	k := newKey(prefixTask).int64(5)
+	require.Positive(t, bytes.Compare(k.next(), k))
+	require.Negative(t, bytes.Compare(k.next(), newKey(prefixTask).int64(6)))
+}
@@ -0,0 +1,302 @@
+package kv
+
+import (
+	"context"
+	"fmt"
+
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/server/common/log"
+	p "go.temporal.io/server/common/persistence"
+	kvdb "go.temporal.io/server/common/persistence/nosql/nosqlplugin/kv"
+)
+
+type (
+	MetadataStore struct {
+		kvStore
+		logger log.Logger
+	}
+
+	namespaceRecord struct {
+		ID                  string `json:"id"`
+		Name                string `json:"name"`
+		Namespace           blob   `json:"namespace"`
+		IsGlobal            bool   `json:"isGlobal"`
+		NotificationVersion int64  `json:"notificationVersion"`
+	}
+
+	namespaceNameRecord struct {
+		ID string `json:"id"`
+	}
+
+	namespaceMetadataRecord struct {
+		NotificationVersion int64 `json:"notificationVersion"`
+	}
+)
+
+var _ p.MetadataStore = (*MetadataStore)(nil)
+
+// NewMetadataStore creates an instance of MetadataStore
+func NewMetadataStore(
+	db *kvdb.DB,
+	logger log.Logger,
+) *MetadataStore {
+	return &MetadataStore{
+		kvStore: kvStore{db: db},
+		logger:  logger,
+	}
+}
+
+func namespaceKey(id string) key {
+	return newKey(prefixNamespace).str(id)
+}
+
+func namespaceNameKey(name string) key {
+	return newKey(prefixNamespaceName).str(name)
+}
+
+func namespaceMetadataKey() key {
+	return newKey(prefixNamespaceMetadata)
+}
+
+// namespaceRanges are the transaction ranges of the namespaces table. Namespace writes are rare and all of
+// them update the notification version, so they lock the whole table.
+func namespaceRanges() []key {
+	return []key{newKey(prefixNamespace), newKey(prefixNamespaceName), namespaceMetadataKey()}
+}
+
+func (m *MetadataStore) CreateNamespace(
+	_ context.Context,
+	request *p.InternalCreateNamespaceRequest,
+) (*p.CreateNamespaceResponse, error) {
+	err := m.update("CreateNamespace", namespaceRanges(), func(txn *kvdb.Txn) error {
+		var metadata namespaceMetadataRecord
+		if _, err := getRecord(txn, namespaceMetadataKey(), &metadata); err != nil {
+			return err
+		}
+
+		var existing namespaceRecord
+		idFound, err := getRecord(txn, namespaceKey(request.ID), &existing)
+		if err != nil {
+			return err
+		}
+		var existingName namespaceNameRecord
+		nameFound, err := getRecord(txn, namespaceNameKey(request.Name), &existingName)
+		if err != nil {
+			return err
+		}
+		if idFound || nameFound {
+			return serviceerror.NewNamespaceAlreadyExists(fmt.Sprintf("name: %v", request.Name))
+		}
+
+		if err := setRecord(txn, namespaceKey(request.ID), &namespaceRecord{
+			ID:                  request.ID,
+			Name:                request.Name,
+			Namespace:           newBlob(request.Namespace),
+			IsGlobal:            request.IsGlobal,
+			NotificationVersion: metadata.NotificationVersion,
+		}); err != nil {
+			return err
+		}
+		if err := setRecord(txn, namespaceNameKey(request.Name), &namespaceNameRecord{ID: request.ID}); err != nil {
+			return err
+		}
+		return setRecord(txn, namespaceMetadataKey(), &namespaceMetadataRecord{
+			NotificationVersion: metadata.NotificationVersion + 1,
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &p.CreateNamespaceResponse{ID: request.ID}, nil
+}
+
+func (m *MetadataStore) GetNamespace(
+	_ context.Context,
+	request *p.GetNamespaceRequest,
+) (*p.InternalGetNamespaceResponse, error) {
+	var identity string
+	switch {
+	case request.Name != "" && request.ID != "":
+		return nil, serviceerror.NewInvalidArgument("GetNamespace operation failed.  Both ID and Name specified in request.")
+	case request.Name != "":
+		identity = request.Name
+	case request.ID != "":
+		identity = request.ID
+	default:
+		return nil, serviceerror.NewInvalidArgument("GetNamespace operation failed.  Both ID and Name are empty.")
+	}
+
+	var response *p.InternalGetNamespaceResponse
+	err := m.view("GetNamespace", namespaceRanges(), func(r reader) error {
+		id := request.ID
+		if request.Name != "" {
+			var name namespaceNameRecord
+			found, err := getRecord(r, namespaceNameKey(request.Name), &name)
+			if err != nil {
+				return err
+			}
+			if !found {
+				return serviceerror.NewNamespaceNotFound(identity)
+			}
+			id = name.ID
+		}
+
+		var namespace namespaceRecord
+		found, err := getRecord(r, namespaceKey(id), &namespace)
+		if err != nil {
+			return err
+		}
+		if !found {
+			return serviceerror.NewNamespaceNotFound(identity)
+		}
+		response = namespace.getNamespaceResponse()
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+func (m *MetadataStore) UpdateNamespace(
+	_ context.Context,
+	request *p.InternalUpdateNamespaceRequest,
+) error {
+	return m.updateNamespace(request, "UpdateNamespace")
+}
+
+func (m *MetadataStore) RenameNamespace(
+	_ context.Context,
+	request *p.InternalRenameNamespaceRequest,
+) error {
+	return m.updateNamespace(request.InternalUpdateNamespaceRequest, "RenameNamespace")
+}
+
+func (m *MetadataStore) updateNamespace(
+	request *p.InternalUpdateNamespaceRequest,
+	operation string,
+) error {
+	return m.update(operation, namespaceRanges(), func(txn *kvdb.Txn) error {
+		var metadata namespaceMetadataRecord
+		if _, err := getRecord(txn, namespaceMetadataKey(), &metadata); err != nil {
+			return err
+		}
+		if metadata.NotificationVersion != request.NotificationVersion {
+			return fmt.Errorf(
+				"conditional update error: expect: %v, actual: %v",
+				request.NotificationVersion,
+				metadata.NotificationVersion,
+			)
+		}
+
+		var existing namespaceRecord
+		found, err := getRecord(txn, namespaceKey(request.Id), &existing)
+		if err != nil {
+			return err
+		}
+		if !found {
+			return serviceerror.NewNamespaceNotFound(request.Id)
+		}
+		if existing.Name != request.Name {
+			var name namespaceNameRecord
+			nameFound, err := getRecord(txn, namespaceNameKey(request.Name), &name)
+			if err != nil {
+				return err
+			}
+			if nameFound {
+				return serviceerror.NewNamespaceAlreadyExists(fmt.Sprintf("name: %v", request.Name))
+			}
+			txn.Delete(namespaceNameKey(existing.Name))
+			if err := setRecord(txn, namespaceNameKey(request.Name), &namespaceNameRecord{ID: request.Id}); err != nil {
+				return err
+			}
+		}
+
+		if err := setRecord(txn, namespaceKey(request.Id), &namespaceRecord{
+			ID:                  request.Id,
+			Name:                request.Name,
+			Namespace:           newBlob(request.Namespace),
+			IsGlobal:            request.IsGlobal,
+			NotificationVersion: request.NotificationVersion,
+		}); err != nil {
+			return err
+		}
+		return setRecord(txn, namespaceMetadataKey(), &namespaceMetadataRecord{
+			NotificationVersion: metadata.NotificationVersion + 1,
+		})
+	})
+}
+
+func (m *MetadataStore) DeleteNamespace(
+	_ context.Context,
+	request *p.DeleteNamespaceRequest,
+) error {
+	return m.update("DeleteNamespace", namespaceRanges(), func(txn *kvdb.Txn) error {
+		var namespace namespaceRecord
+		found, err := getRecord(txn, namespaceKey(request.ID), &namespace)
+		if err != nil || !found {
+			return err
+		}
+		txn.Delete(namespaceKey(request.ID))
+		txn.Delete(namespaceNameKey(namespace.Name))
+		return nil
+	})
+}
+
+func (m *MetadataStore) DeleteNamespaceByName(
+	_ context.Context,
+	request *p.DeleteNamespaceByNameRequest,
+) error {
+	return m.update("DeleteNamespaceByName", namespaceRanges(), func(txn *kvdb.Txn) error {
+		var name namespaceNameRecord
+		found, err := getRecord(txn, namespaceNameKey(request.Name), &name)
+		if err != nil || !found {
+			return err
+		}
+		txn.Delete(namespaceKey(name.ID))
+		txn.Delete(namespaceNameKey(request.Name))
+		return nil
+	})
+}
+
+func (m *MetadataStore) GetMetadata(
+	_ context.Context,
+) (*p.GetMetadataResponse, error) {
+	var metadata namespaceMetadataRecord
+	if _, err := getRecord(m.db, namespaceMetadataKey(), &metadata); err != nil {
+		return nil, err
+	}
+	return &p.GetMetadataResponse{NotificationVersion: metadata.NotificationVersion}, nil
+}
+
+func (m *MetadataStore) ListNamespaces(
+	_ context.Context,
+	request *p.InternalListNamespacesRequest,
+) (*p.InternalListNamespacesResponse, error) {
+	prefix := newKey(prefixNamespace)
+	lower, err := pageLowerBound(prefix, request.NextPageToken)
+	if err != nil {
+		return nil, err
+	}
+	records, err := scanRecords[namespaceRecord](m.db, lower, prefix.prefixEnd(), false, request.PageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &p.InternalListNamespacesResponse{}
+	for _, record := range records {
+		response.Namespaces = append(response.Namespaces, record.value.getNamespaceResponse())
+	}
+	if len(records) > 0 && len(records) == request.PageSize {
+		response.NextPageToken = records[len(records)-1].key
+	}
+	return response, nil
+}
+
+func (r *namespaceRecord) getNamespaceResponse() *p.InternalGetNamespaceResponse {
+	return &p.InternalGetNamespaceResponse{
+		Namespace:           r.Namespace.dataBlob(),
+		IsGlobal:            r.IsGlobal,
+		NotificationVersion: r.NotificationVersion,
+	}
+}
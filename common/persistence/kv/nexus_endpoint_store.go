@@ -0,0 +1,191 @@
+package kv
+
+import (
+	"context"
+	"fmt"
+
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/server/common/log"
+	p "go.temporal.io/server/common/persistence"
+	kvdb "go.temporal.io/server/common/persistence/nosql/nosqlplugin/kv"
+)
+
+type (
+	NexusEndpointStore struct {
+		kvStore
+		logger log.Logger
+	}
+
+	nexusEndpointRecord struct {
+		ID       string `json:"id"`
+		Version  int64  `json:"version"`
+		Endpoint blob   `json:"endpoint"`
+	}
+
+	nexusEndpointsTableInfoRecord struct {
+		Version int64 `json:"version"`
+	}
+)
+
+var _ p.NexusEndpointStore = (*NexusEndpointStore)(nil)
+
+// NewNexusEndpointStore creates an instance of NexusEndpointStore
+func NewNexusEndpointStore(
+	db *kvdb.DB,
+	logger log.Logger,
+) *NexusEndpointStore {
+	return &NexusEndpointStore{
+		kvStore: kvStore{db: db},
+		logger:  logger,
+	}
+}
+
+func nexusEndpointKey(id string) key {
+	return newKey(prefixNexusEndpoint).str(id)
+}
+
+func nexusEndpointsTableInfoKey() key {
+	return newKey(prefixNexusEndpointsTableInfo)
+}
+
+// nexusEndpointRanges are the transaction ranges of the endpoints table. Every write increments the version of
+// the table, so writes lock the whole table.
+func nexusEndpointRanges() []key {
+	return []key{newKey(prefixNexusEndpoint), nexusEndpointsTableInfoKey()}
+}
+
+func (s *NexusEndpointStore) CreateOrUpdateNexusEndpoint(
+	_ context.Context,
+	request *p.InternalCreateOrUpdateNexusEndpointRequest,
+) error {
+	return s.update("CreateOrUpdateNexusEndpoint", nexusEndpointRanges(), func(txn *kvdb.Txn) error {
+		if err := incrementNexusEndpointsTableVersion(txn, request.LastKnownTableVersion); err != nil {
+			return err
+		}
+
+		var existing nexusEndpointRecord
+		found, err := getRecord(txn, nexusEndpointKey(request.Endpoint.ID), &existing)
+		if err != nil {
+			return err
+		}
+		if found != (request.Endpoint.Version != 0) || existing.Version != request.Endpoint.Version {
+			return p.ErrNexusEndpointVersionConflict
+		}
+		return setRecord(txn, nexusEndpointKey(request.Endpoint.ID), &nexusEndpointRecord{
+			ID:       request.Endpoint.ID,
+			Version:  request.Endpoint.Version + 1,
+			Endpoint: newBlob(request.Endpoint.Data),
+		})
+	})
+}
+
+func (s *NexusEndpointStore) GetNexusEndpoint(
+	_ context.Context,
+	request *p.GetNexusEndpointRequest,
+) (*p.InternalNexusEndpoint, error) {
+	var endpoint nexusEndpointRecord
+	found, err := getRecord(s.db, nexusEndpointKey(request.ID), &endpoint)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, serviceerror.NewNotFound(fmt.Sprintf("Nexus endpoint with ID `%v` not found", request.ID))
+	}
+	return endpoint.internalNexusEndpoint(), nil
+}
+
+func (s *NexusEndpointStore) ListNexusEndpoints(
+	_ context.Context,
+	request *p.ListNexusEndpointsRequest,
+) (*p.InternalListNexusEndpointsResponse, error) {
+	prefix := newKey(prefixNexusEndpoint)
+	lower, err := pageLowerBound(prefix, request.NextPageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	var response p.InternalListNexusEndpointsResponse
+	var records []record[nexusEndpointRecord]
+	err = s.view("ListNexusEndpoints", nexusEndpointRanges(), func(r reader) error {
+		var tableInfo nexusEndpointsTableInfoRecord
+		if _, err := getRecord(r, nexusEndpointsTableInfoKey(), &tableInfo); err != nil {
+			return err
+		}
+		response.TableVersion = tableInfo.Version
+		if request.LastKnownTableVersion != 0 && request.LastKnownTableVersion != tableInfo.Version {
+			return p.ErrNexusTableVersionConflict
+		}
+
+		if request.PageSize > 0 {
+			// PageSize could be zero when fetching just the table version.
+			var err error
+			records, err = scanRecords[nexusEndpointRecord](r, lower, prefix.prefixEnd(), false, request.PageSize)
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		return &response, err
+	}
+
+	if len(records) > 0 && len(records) == request.PageSize {
+		response.NextPageToken = records[len(records)-1].key
+	}
+	response.Endpoints = make([]p.InternalNexusEndpoint, len(records))
+	for i, record := range records {
+		response.Endpoints[i] = *record.value.internalNexusEndpoint()
+	}
+	return &response, nil
+}
+
+func (s *NexusEndpointStore) DeleteNexusEndpoint(
+	_ context.Context,
+	request *p.DeleteNexusEndpointRequest,
+) error {
+	return s.update("DeleteNexusEndpoint", nexusEndpointRanges(), func(txn *kvdb.Txn) error {
+		if err := incrementNexusEndpointsTableVersion(txn, request.LastKnownTableVersion); err != nil {
+			return err
+		}
+
+		var existing nexusEndpointRecord
+		found, err := getRecord(txn, nexusEndpointKey(request.ID), &existing)
+		if err != nil {
+			return err
+		}
+		if !found {
+			return serviceerror.NewNotFound(fmt.Sprintf("nexus endpoint not found for ID: %v", request.ID))
+		}
+		txn.Delete(nexusEndpointKey(request.ID))
+		return nil
+	})
+}
+
+// incrementNexusEndpointsTableVersion increments the version of the endpoints table, a lastKnownTableVersion of zero
+// initializes it.
+func incrementNexusEndpointsTableVersion(
+	txn *kvdb.Txn,
+	lastKnownTableVersion int64,
+) error {
+	var tableInfo nexusEndpointsTableInfoRecord
+	found, err := getRecord(txn, nexusEndpointsTableInfoKey(), &tableInfo)
+	if err != nil {
+		return err
+	}
+	if lastKnownTableVersion == 0 && found {
+		return &p.ConditionFailedError{Msg: "nexus endpoints table version already initialized"}
+	}
+	if tableInfo.Version != lastKnownTableVersion {
+		return p.ErrNexusTableVersionConflict
+	}
+	return setRecord(txn, nexusEndpointsTableInfoKey(), &nexusEndpointsTableInfoRecord{
+		Version: lastKnownTableVersion + 1,
+	})
+}
+
+func (r *nexusEndpointRecord) internalNexusEndpoint() *p.InternalNexusEndpoint {
+	return &p.InternalNexusEndpoint{
+		ID:      r.ID,
+		Version: r.Version,
+		Data:    r.Endpoint.dataBlob(),
+	}
+}
@@ -0,0 +1,313 @@
+package kv
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+
+	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/server/common/log"
+	p "go.temporal.io/server/common/persistence"
+	kvdb "go.temporal.io/server/common/persistence/nosql/nosqlplugin/kv"
+)
+
+type (
+	QueueStore struct {
+		kvStore
+		queueType p.QueueType
+		logger    log.Logger
+	}
+
+	queueMessageRecord struct {
+		MessageID int64 `json:"messageId"`
+		Message   blob  `json:"message"`
+	}
+
+	queueMetadataRecord struct {
+		Metadata blob  `json:"metadata"`
+		Version  int64 `json:"version"`
+	}
+)
+
+var _ p.Queue = (*QueueStore)(nil)
+
+// NewQueueStore creates an instance of QueueStore
+func NewQueueStore(
+	queueType p.QueueType,
+	db *kvdb.DB,
+	logger log.Logger,
+) *QueueStore {
+	return &QueueStore{
+		kvStore:   kvStore{db: db},
+		queueType: queueType,
+		logger:    logger,
+	}
+}
+
+func queueMessagePrefix(queueType p.QueueType) key {
+	return newKey(prefixQueueMessage).int32(int32(queueType))
+}
+
+func queueMetadataKey(queueType p.QueueType) key {
+	return newKey(prefixQueueMetadata).int32(int32(queueType))
+}
+
+// ranges are the transaction ranges of the messages and metadata of the queue and its DLQ.
+func (q *QueueStore) ranges() []key {
+	return []key{
+		queueMessagePrefix(q.queueType),
+		queueMetadataKey(q.queueType),
+		queueMessagePrefix(q.getDLQTypeFromQueueType()),
+		queueMetadataKey(q.getDLQTypeFromQueueType()),
+	}
+}
+
+func (q *QueueStore) Init(
+	_ context.Context,
+	blob *commonpb.DataBlob,
+) error {
+	return q.update("Init", q.ranges(), func(txn *kvdb.Txn) error {
+		for _, queueType := range []p.QueueType{q.queueType, q.getDLQTypeFromQueueType()} {
+			var metadata queueMetadataRecord
+			found, err := getRecord(txn, queueMetadataKey(queueType), &metadata)
+			if err != nil {
+				return err
+			}
+			if found {
+				continue
+			}
+			if err := setRecord(txn, queueMetadataKey(queueType), &queueMetadataRecord{Metadata: newBlob(blob)}); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (q *QueueStore) EnqueueMessage(
+	_ context.Context,
+	blob *commonpb.DataBlob,
+) error {
+	return q.update("EnqueueMessage", q.ranges(), func(txn *kvdb.Txn) error {
+		_, err := enqueueMessage(txn, q.queueType, blob)
+		return err
+	})
+}
+
+func (q *QueueStore) ReadMessages(
+	_ context.Context,
+	lastMessageID int64,
+	pageSize int,
+) ([]*p.QueueMessage, error) {
+	return readMessages(q.db, q.queueType, lastMessageID, p.MaxQueueMessageID, pageSize)
+}
+
+func (q *QueueStore) DeleteMessagesBefore(
+	_ context.Context,
+	messageID int64,
+) error {
+	return q.update("DeleteMessagesBefore", q.ranges(), func(txn *kvdb.Txn) error {
+		prefix := queueMessagePrefix(q.queueType)
+		if err := txn.DeleteRange(prefix, prefix.int64(messageID)); err != nil {
+			return storageError("DeleteMessagesBefore", err)
+		}
+		return nil
+	})
+}
+
+func (q *QueueStore) UpdateAckLevel(
+	_ context.Context,
+	metadata *p.InternalQueueMetadata,
+) error {
+	return q.update("UpdateAckLevel", q.ranges(), func(txn *kvdb.Txn) error {
+		var existing queueMetadataRecord
+		found, err := getRecord(txn, queueMetadataKey(q.queueType), &existing)
+		if err != nil {
+			return err
+		}
+		if !found || existing.Version != metadata.Version {
+			return &p.ConditionFailedError{Msg: "UpdateAckLevel operation encountered concurrent write."}
+		}
+		return setRecord(txn, queueMetadataKey(q.queueType), &queueMetadataRecord{
+			Metadata: newBlob(metadata.Blob),
+			Version:  metadata.Version + 1,
+		})
+	})
+}
+
+func (q *QueueStore) GetAckLevels(
+	_ context.Context,
+) (*p.InternalQueueMetadata, error) {
+	return q.getQueueMetadata("GetAckLevels", q.queueType)
+}
+
+func (q *QueueStore) EnqueueMessageToDLQ(
+	_ context.Context,
+	blob *commonpb.DataBlob,
+) (int64, error) {
+	var messageID int64
+	err := q.update("EnqueueMessageToDLQ", q.ranges(), func(txn *kvdb.Txn) error {
+		var err error
+		messageID, err = enqueueMessage(txn, q.getDLQTypeFromQueueType(), blob)
+		return err
+	})
+	if err != nil {
+		return p.EmptyQueueMessageID, err
+	}
+	return messageID, nil
+}
+
+func (q *QueueStore) ReadMessagesFromDLQ(
+	_ context.Context,
+	firstMessageID int64,
+	lastMessageID int64,
+	pageSize int,
+	pageToken []byte,
+) ([]*p.QueueMessage, []byte, error) {
+	if len(pageToken) != 0 {
+		if len(pageToken) != 8 {
+			return nil, nil, serviceerror.NewInternal(fmt.Sprintf("invalid next page token %v", pageToken))
+		}
+		firstMessageID = int64(binary.BigEndian.Uint64(pageToken))
+	}
+
+	messages, err := readMessages(q.db, q.getDLQTypeFromQueueType(), firstMessageID, lastMessageID, pageSize)
+	if err != nil {
+		return nil, nil, err
+	}
+	var nextPageToken []byte
+	if len(messages) > 0 && len(messages) >= pageSize {
+		nextPageToken = binary.BigEndian.AppendUint64(nil, uint64(messages[len(messages)-1].ID))
+	}
+	return messages, nextPageToken, nil
+}
+
+func (q *QueueStore) DeleteMessageFromDLQ(
+	_ context.Context,
+	messageID int64,
+) error {
+	return q.update("DeleteMessageFromDLQ", q.ranges(), func(txn *kvdb.Txn) error {
+		txn.Delete(queueMessagePrefix(q.getDLQTypeFromQueueType()).int64(messageID))
+		return nil
+	})
+}
+
+// RangeDeleteMessagesFromDLQ deletes the messages in (firstMessageID, lastMessageID].
+func (q *QueueStore) RangeDeleteMessagesFromDLQ(
+	_ context.Context,
+	firstMessageID int64,
+	lastMessageID int64,
+) error {
+	return q.update("RangeDeleteMessagesFromDLQ", q.ranges(), func(txn *kvdb.Txn) error {
+		prefix := queueMessagePrefix(q.getDLQTypeFromQueueType())
+		if err := txn.DeleteRange(prefix.int64(firstMessageID).next(), prefix.int64(lastMessageID).next()); err != nil {
+			return storageError("RangeDeleteMessagesFromDLQ", err)
+		}
+		return nil
+	})
+}
+
+func (q *QueueStore) UpdateDLQAckLevel(
+	_ context.Context,
+	metadata *p.InternalQueueMetadata,
+) error {
+	return q.update("UpdateDLQAckLevel", q.ranges(), func(txn *kvdb.Txn) error {
+		var existing queueMetadataRecord
+		found, err := getRecord(txn, queueMetadataKey(q.getDLQTypeFromQueueType()), &existing)
+		if err != nil {
+			return err
+		}
+		if !found {
+			return serviceerror.NewUnavailable(fmt.Sprintf("UpdateDLQAckLevel operation failed. DLQ metadata of %v not found", q.queueType))
+		}
+		return setRecord(txn, queueMetadataKey(q.getDLQTypeFromQueueType()), &queueMetadataRecord{
+			Metadata: newBlob(metadata.Blob),
+			Version:  existing.Version + 1,
+		})
+	})
+}
+
+func (q *QueueStore) GetDLQAckLevels(
+	_ context.Context,
+) (*p.InternalQueueMetadata, error) {
+	return q.getQueueMetadata("GetDLQAckLevels", q.getDLQTypeFromQueueType())
+}
+
+func (q *QueueStore) getDLQTypeFromQueueType() p.QueueType {
+	return -q.queueType
+}
+
+func (q *QueueStore) getQueueMetadata(
+	operation string,
+	queueType p.QueueType,
+) (*p.InternalQueueMetadata, error) {
+	var metadata queueMetadataRecord
+	found, err := getRecord(q.db, queueMetadataKey(queueType), &metadata)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, serviceerror.NewUnavailable(fmt.Sprintf("%v operation failed. Queue metadata of %v not found", operation, queueType))
+	}
+	return &p.InternalQueueMetadata{
+		Blob:    metadata.Metadata.dataBlob(),
+		Version: metadata.Version,
+	}, nil
+}
+
+// enqueueMessage appends the message after the last message of the queue and returns its ID.
+func enqueueMessage(
+	txn *kvdb.Txn,
+	queueType p.QueueType,
+	blob *commonpb.DataBlob,
+) (int64, error) {
+	prefix := queueMessagePrefix(queueType)
+	last, err := scanRecords[queueMessageRecord](txn, prefix, prefix.prefixEnd(), true, 1)
+	if err != nil {
+		return 0, err
+	}
+	messageID := p.EmptyQueueMessageID + 1
+	if len(last) > 0 {
+		messageID = last[0].value.MessageID + 1
+	}
+	if err := setRecord(txn, prefix.int64(messageID), &queueMessageRecord{
+		MessageID: messageID,
+		Message:   newBlob(blob),
+	}); err != nil {
+		return 0, err
+	}
+	return messageID, nil
+}
+
+// readMessages reads up to pageSize messages with IDs in (exclusiveMinMessageID, inclusiveMaxMessageID].
+func readMessages(
+	r reader,
+	queueType p.QueueType,
+	exclusiveMinMessageID int64,
+	inclusiveMaxMessageID int64,
+	pageSize int,
+) ([]*p.QueueMessage, error) {
+	prefix := queueMessagePrefix(queueType)
+	records, err := scanRecords[queueMessageRecord](
+		r,
+		prefix.int64(exclusiveMinMessageID).next(),
+		prefix.int64(inclusiveMaxMessageID).next(),
+		false,
+		pageSize,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	var messages []*p.QueueMessage
+	for _, record := range records {
+		messages = append(messages, &p.QueueMessage{
+			QueueType: queueType,
+			ID:        record.value.MessageID,
+			Data:      record.value.Message.Data,
+			Encoding:  record.value.Message.Encoding,
+		})
+	}
+	return messages, nil
+}
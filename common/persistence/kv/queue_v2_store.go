@@ -0,0 +1,356 @@
+package kv
+
+import (
+	"context"
+	"fmt"
+
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/log"
+	p "go.temporal.io/server/common/persistence"
+	kvdb "go.temporal.io/server/common/persistence/nosql/nosqlplugin/kv"
+	"go.temporal.io/server/common/persistence/serialization"
+)
+
+type (
+	QueueV2Store struct {
+		kvStore
+		logger log.Logger
+	}
+
+	queueV2MetadataRecord struct {
+		QueueName string `json:"queueName"`
+		Metadata  blob   `json:"metadata"`
+	}
+)
+
+var _ p.QueueV2 = (*QueueV2Store)(nil)
+
+// NewQueueV2Store creates an instance of QueueV2Store
+func NewQueueV2Store(
+	db *kvdb.DB,
+	logger log.Logger,
+) *QueueV2Store {
+	return &QueueV2Store{
+		kvStore: kvStore{db: db},
+		logger:  logger,
+	}
+}
+
+func queueV2MessagePrefix(queueType p.QueueV2Type, queueName string) key {
+	return newKey(prefixQueueV2Message).int32(int32(queueType)).str(queueName)
+}
+
+func queueV2MetadataPrefix(queueType p.QueueV2Type) key {
+	return newKey(prefixQueueV2Metadata).int32(int32(queueType))
+}
+
+// queueV2Ranges are the transaction ranges of the messages and metadata of a queue.
+func queueV2Ranges(queueType p.QueueV2Type, queueName string) []key {
+	return []key{queueV2MessagePrefix(queueType, queueName), queueV2MetadataPrefix(queueType).str(queueName)}
+}
+
+func (q *QueueV2Store) EnqueueMessage(
+	_ context.Context,
+	request *p.InternalEnqueueMessageRequest,
+) (*p.InternalEnqueueMessageResponse, error) {
+	var messageID int64
+	err := q.update("EnqueueMessage", queueV2Ranges(request.QueueType, request.QueueName), func(txn *kvdb.Txn) error {
+		if _, err := getQueueV2Metadata(txn, request.QueueType, request.QueueName); err != nil {
+			return err
+		}
+		var err error
+		messageID, err = getNextQueueV2MessageID(txn, request.QueueType, request.QueueName)
+		if err != nil {
+			return err
+		}
+		return setRecord(
+			txn,
+			queueV2MessagePrefix(request.QueueType, request.QueueName).int64(messageID),
+			&queueMessageRecord{MessageID: messageID, Message: newBlob(request.Blob)},
+		)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &p.InternalEnqueueMessageResponse{Metadata: p.MessageMetadata{ID: messageID}}, nil
+}
+
+func (q *QueueV2Store) ReadMessages(
+	_ context.Context,
+	request *p.InternalReadMessagesRequest,
+) (*p.InternalReadMessagesResponse, error) {
+	if request.PageSize <= 0 {
+		return nil, p.ErrNonPositiveReadQueueMessagesPageSize
+	}
+
+	var messages []p.QueueV2Message
+	err := q.view("ReadMessages", queueV2Ranges(request.QueueType, request.QueueName), func(r reader) error {
+		qm, err := getQueueV2Metadata(r, request.QueueType, request.QueueName)
+		if err != nil {
+			return err
+		}
+		minMessageID, err := p.GetMinMessageIDToReadForQueueV2(
+			request.QueueType,
+			request.QueueName,
+			request.NextPageToken,
+			qm,
+		)
+		if err != nil {
+			return err
+		}
+
+		prefix := queueV2MessagePrefix(request.QueueType, request.QueueName)
+		records, err := scanRecords[queueMessageRecord](r, prefix.int64(minMessageID), prefix.prefixEnd(), false, request.PageSize)
+		if err != nil {
+			return err
+		}
+		for _, record := range records {
+			messages = append(messages, p.QueueV2Message{
+				MetaData: p.MessageMetadata{ID: record.value.MessageID},
+				Data:     record.value.Message.dataBlob(),
+			})
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &p.InternalReadMessagesResponse{
+		Messages:      messages,
+		NextPageToken: p.GetNextPageTokenForReadMessages(messages),
+	}, nil
+}
+
+func (q *QueueV2Store) CreateQueue(
+	_ context.Context,
+	request *p.InternalCreateQueueRequest,
+) (*p.InternalCreateQueueResponse, error) {
+	err := q.update("CreateQueue", queueV2Ranges(request.QueueType, request.QueueName), func(txn *kvdb.Txn) error {
+		metadataKey := queueV2MetadataPrefix(request.QueueType).str(request.QueueName)
+		var existing queueV2MetadataRecord
+		found, err := getRecord(txn, metadataKey, &existing)
+		if err != nil {
+			return err
+		}
+		if found {
+			return fmt.Errorf(
+				"%w: queue type %v and name %v",
+				p.ErrQueueAlreadyExists,
+				request.QueueType,
+				request.QueueName,
+			)
+		}
+		return setQueueV2Metadata(txn, request.QueueType, request.QueueName, &persistencespb.Queue{
+			Partitions: map[int32]*persistencespb.QueuePartition{
+				0: {
+					MinMessageId: p.FirstQueueMessageID,
+				},
+			},
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &p.InternalCreateQueueResponse{}, nil
+}
+
+func (q *QueueV2Store) RangeDeleteMessages(
+	_ context.Context,
+	request *p.InternalRangeDeleteMessagesRequest,
+) (*p.InternalRangeDeleteMessagesResponse, error) {
+	if request.InclusiveMaxMessageMetadata.ID < p.FirstQueueMessageID {
+		return nil, fmt.Errorf(
+			"%w: id is %d but must be >= %d",
+			p.ErrInvalidQueueRangeDeleteMaxMessageID,
+			request.InclusiveMaxMessageMetadata.ID,
+			p.FirstQueueMessageID,
+		)
+	}
+
+	var resp *p.InternalRangeDeleteMessagesResponse
+	err := q.update("RangeDeleteMessages", queueV2Ranges(request.QueueType, request.QueueName), func(txn *kvdb.Txn) error {
+		qm, err := getQueueV2Metadata(txn, request.QueueType, request.QueueName)
+		if err != nil {
+			return err
+		}
+		partition, err := p.GetPartitionForQueueV2(request.QueueType, request.QueueName, qm)
+		if err != nil {
+			return err
+		}
+		maxMessageID, ok, err := getMaxQueueV2MessageID(txn, request.QueueType, request.QueueName)
+		if err != nil || !ok {
+			return err
+		}
+		deleteRange, ok := p.GetDeleteRange(p.DeleteRequest{
+			LastIDToDeleteInclusive: request.InclusiveMaxMessageMetadata.ID,
+			ExistingMessageRange: p.InclusiveMessageRange{
+				MinMessageID: partition.MinMessageId,
+				MaxMessageID: maxMessageID,
+			},
+		})
+		if !ok {
+			resp = &p.InternalRangeDeleteMessagesResponse{MessagesDeleted: 0}
+			return nil
+		}
+
+		prefix := queueV2MessagePrefix(request.QueueType, request.QueueName)
+		if err := txn.DeleteRange(
+			prefix.int64(deleteRange.MinMessageID),
+			prefix.int64(deleteRange.MaxMessageID).next(),
+		); err != nil {
+			return storageError("RangeDeleteMessages", err)
+		}
+		partition.MinMessageId = deleteRange.NewMinMessageID
+		if err := setQueueV2Metadata(txn, request.QueueType, request.QueueName, qm); err != nil {
+			return err
+		}
+		resp = &p.InternalRangeDeleteMessagesResponse{MessagesDeleted: deleteRange.MessagesToDelete}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (q *QueueV2Store) ListQueues(
+	_ context.Context,
+	request *p.InternalListQueuesRequest,
+) (*p.InternalListQueuesResponse, error) {
+	if request.PageSize <= 0 {
+		return nil, p.ErrNonPositiveListQueuesPageSize
+	}
+	offset, err := p.GetOffsetForListQueues(request.NextPageToken)
+	if err != nil {
+		return nil, err
+	}
+	if offset < 0 {
+		return nil, p.ErrNegativeListQueuesOffset
+	}
+
+	// Queues are listed without locking them, the message counts may miss the writes made while listing.
+	records, err := scanPrefix[queueV2MetadataRecord](q.db, queueV2MetadataPrefix(request.QueueType), int(offset)+request.PageSize)
+	if err != nil {
+		return nil, err
+	}
+	var queues []p.QueueInfo
+	for _, record := range records[min(int(offset), len(records)):] {
+		qm, err := decodeQueueV2Metadata(request.QueueType, record.value)
+		if err != nil {
+			return nil, err
+		}
+		partition, err := p.GetPartitionForQueueV2(request.QueueType, record.value.QueueName, qm)
+		if err != nil {
+			return nil, err
+		}
+		nextMessageID, err := getNextQueueV2MessageID(q.db, request.QueueType, record.value.QueueName)
+		if err != nil {
+			return nil, err
+		}
+		queues = append(queues, p.QueueInfo{
+			QueueName:    record.value.QueueName,
+			MessageCount: nextMessageID - partition.MinMessageId,
+		})
+	}
+
+	var nextPageToken []byte
+	if len(queues) > 0 {
+		nextPageToken = p.GetNextPageTokenForListQueues(offset + int64(len(queues)))
+	}
+	return &p.InternalListQueuesResponse{
+		Queues:        queues,
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+func getQueueV2Metadata(
+	r reader,
+	queueType p.QueueV2Type,
+	queueName string,
+) (*persistencespb.Queue, error) {
+	var metadata queueV2MetadataRecord
+	found, err := getRecord(r, queueV2MetadataPrefix(queueType).str(queueName), &metadata)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, p.NewQueueNotFoundError(queueType, queueName)
+	}
+	return decodeQueueV2Metadata(queueType, metadata)
+}
+
+func decodeQueueV2Metadata(
+	queueType p.QueueV2Type,
+	metadata queueV2MetadataRecord,
+) (*persistencespb.Queue, error) {
+	if metadata.Metadata.Encoding != enumspb.ENCODING_TYPE_PROTO3.String() {
+		return nil, fmt.Errorf(
+			"queue with type %v and name %v has invalid encoding: %w",
+			queueType,
+			metadata.QueueName,
+			serialization.NewUnknownEncodingTypeError(metadata.Metadata.Encoding, enumspb.ENCODING_TYPE_PROTO3),
+		)
+	}
+	qm := &persistencespb.Queue{}
+	if err := qm.Unmarshal(metadata.Metadata.Data); err != nil {
+		return nil, serialization.NewDeserializationError(
+			enumspb.ENCODING_TYPE_PROTO3,
+			fmt.Errorf("unmarshal payload for queue with type %v and name %v failed: %w",
+				queueType,
+				metadata.QueueName,
+				err),
+		)
+	}
+	return qm, nil
+}
+
+func setQueueV2Metadata(
+	txn *kvdb.Txn,
+	queueType p.QueueV2Type,
+	queueName string,
+	qm *persistencespb.Queue,
+) error {
+	data, err := qm.Marshal()
+	if err != nil {
+		return serialization.NewSerializationError(enumspb.ENCODING_TYPE_PROTO3, err)
+	}
+	return setRecord(txn, queueV2MetadataPrefix(queueType).str(queueName), &queueV2MetadataRecord{
+		QueueName: queueName,
+		Metadata: newBlob(&commonpb.DataBlob{
+			EncodingType: enumspb.ENCODING_TYPE_PROTO3,
+			Data:         data,
+		}),
+	})
+}
+
+// getMaxQueueV2MessageID returns the ID of the last message of the queue, and false if the queue is empty. The last
+// message is never deleted by RangeDeleteMessages, so message IDs are never reused.
+func getMaxQueueV2MessageID(
+	r reader,
+	queueType p.QueueV2Type,
+	queueName string,
+) (int64, bool, error) {
+	prefix := queueV2MessagePrefix(queueType, queueName)
+	records, err := scanRecords[queueMessageRecord](r, prefix, prefix.prefixEnd(), true, 1)
+	if err != nil || len(records) == 0 {
+		return 0, false, err
+	}
+	return records[0].value.MessageID, true, nil
+}
+
+func getNextQueueV2MessageID(
+	r reader,
+	queueType p.QueueV2Type,
+	queueName string,
+) (int64, error) {
+	maxMessageID, ok, err := getMaxQueueV2MessageID(r, queueType, queueName)
+	if err != nil {
+		return 0, err
+	}
+	if !ok {
+		return p.FirstQueueMessageID, nil
+	}
+	return maxMessageID + 1, nil
+}
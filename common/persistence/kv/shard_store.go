@@ -0,0 +1,131 @@
+package kv
+
+import (
+	"context"
+	"fmt"
+
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/server/common/log"
+	p "go.temporal.io/server/common/persistence"
+	kvdb "go.temporal.io/server/common/persistence/nosql/nosqlplugin/kv"
+)
+
+type (
+	ShardStore struct {
+		kvStore
+		clusterName string
+		logger      log.Logger
+	}
+
+	shardRecord struct {
+		RangeID   int64 `json:"rangeId"`
+		ShardInfo blob  `json:"shardInfo"`
+	}
+)
+
+var _ p.ShardStore = (*ShardStore)(nil)
+
+func NewShardStore(
+	clusterName string,
+	db *kvdb.DB,
+	logger log.Logger,
+) *ShardStore {
+	return &ShardStore{
+		kvStore:     kvStore{db: db},
+		clusterName: clusterName,
+		logger:      logger,
+	}
+}
+
+func shardKey(shardID int32) key {
+	return newKey(prefixShard).int32(shardID)
+}
+
+// shardRanges are the transaction ranges of the shard record.
+func shardRanges(shardID int32) []key {
+	return []key{shardKey(shardID)}
+}
+
+func (s *ShardStore) GetClusterName() string {
+	return s.clusterName
+}
+
+func (s *ShardStore) GetOrCreateShard(
+	_ context.Context,
+	request *p.InternalGetOrCreateShardRequest,
+) (*p.InternalGetOrCreateShardResponse, error) {
+	var response *p.InternalGetOrCreateShardResponse
+	err := s.update("GetOrCreateShard", shardRanges(request.ShardID), func(txn *kvdb.Txn) error {
+		var shard shardRecord
+		found, err := getRecord(txn, shardKey(request.ShardID), &shard)
+		if err != nil {
+			return err
+		}
+		if found {
+			response = &p.InternalGetOrCreateShardResponse{ShardInfo: shard.ShardInfo.dataBlob()}
+			return nil
+		}
+
+		if request.CreateShardInfo == nil {
+			return serviceerror.NewNotFound(fmt.Sprintf("GetOrCreateShard: ShardID %v not found", request.ShardID))
+		}
+		rangeID, shardInfo, err := request.CreateShardInfo()
+		if err != nil {
+			return serviceerror.NewUnavailable(fmt.Sprintf("GetOrCreateShard: failed to encode shard info for ShardID %v. Error: %v", request.ShardID, err))
+		}
+		shard = shardRecord{RangeID: rangeID, ShardInfo: newBlob(shardInfo)}
+		response = &p.InternalGetOrCreateShardResponse{ShardInfo: shardInfo}
+		return setRecord(txn, shardKey(request.ShardID), &shard)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+func (s *ShardStore) UpdateShard(
+	_ context.Context,
+	request *p.InternalUpdateShardRequest,
+) error {
+	return s.update("UpdateShard", shardRanges(request.ShardID), func(txn *kvdb.Txn) error {
+		if err := checkShardRangeID(txn, request.ShardID, request.PreviousRangeID); err != nil {
+			return err
+		}
+		return setRecord(txn, shardKey(request.ShardID), &shardRecord{
+			RangeID:   request.RangeID,
+			ShardInfo: newBlob(request.ShardInfo),
+		})
+	})
+}
+
+// AssertShardOwnership is a no-op, range IDs are checked by every execution write.
+func (s *ShardStore) AssertShardOwnership(
+	_ context.Context,
+	_ *p.AssertShardOwnershipRequest,
+) error {
+	return nil
+}
+
+// checkShardRangeID checks that the shard is still owned by the caller. The transaction must lock the
+// shardRanges of the shard, so that the shard can't be stolen until the transaction commits.
+func checkShardRangeID(
+	txn *kvdb.Txn,
+	shardID int32,
+	rangeID int64,
+) error {
+	var shard shardRecord
+	found, err := getRecord(txn, shardKey(shardID), &shard)
+	if err != nil {
+		return err
+	}
+	if !found {
+		return serviceerror.NewUnavailable(fmt.Sprintf("Failed to lock shard with ID %v that does not exist.", shardID))
+	}
+	if shard.RangeID != rangeID {
+		return &p.ShardOwnershipLostError{
+			ShardID: shardID,
+			Msg:     fmt.Sprintf("Failed to lock shard. Previous range ID: %v; new range ID: %v", rangeID, shard.RangeID),
+		}
+	}
+	return nil
+}
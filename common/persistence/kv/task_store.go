@@ -0,0 +1,441 @@
+package kv
+
+import (
+	"context"
+	"fmt"
+
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/server/common/log"
+	p "go.temporal.io/server/common/persistence"
+	kvdb "go.temporal.io/server/common/persistence/nosql/nosqlplugin/kv"
+)
+
+type (
+	TaskStore struct {
+		kvStore
+		logger log.Logger
+	}
+
+	taskQueueRecord struct {
+		RangeID       int64 `json:"rangeId"`
+		TaskQueueInfo blob  `json:"taskQueueInfo"`
+	}
+
+	taskRecord struct {
+		Task blob `json:"task"`
+	}
+
+	taskQueueUserDataRecord struct {
+		TaskQueue string `json:"taskQueue"`
+		Version   int64  `json:"version"`
+		UserData  blob   `json:"userData"`
+	}
+
+	buildIDToTaskQueueRecord struct {
+		TaskQueue string `json:"taskQueue"`
+	}
+)
+
+var _ p.TaskStore = (*TaskStore)(nil)
+
+// NewTaskStore creates an instance of TaskStore
+func NewTaskStore(
+	db *kvdb.DB,
+	logger log.Logger,
+) *TaskStore {
+	return &TaskStore{
+		kvStore: kvStore{db: db},
+		logger:  logger,
+	}
+}
+
+func taskQueueKey(namespaceID string, taskQueue string, taskType enumspb.TaskQueueType) key {
+	return newKey(prefixTaskQueue).str(namespaceID).str(taskQueue).int32(int32(taskType))
+}
+
+func taskPrefix(namespaceID string, taskQueue string, taskType enumspb.TaskQueueType, subqueue int) key {
+	return newKey(prefixTask).str(namespaceID).str(taskQueue).int32(int32(taskType)).int32(int32(subqueue))
+}
+
+// taskQueueRanges are the transaction ranges of a task queue and its tasks.
+func taskQueueRanges(namespaceID string, taskQueue string, taskType enumspb.TaskQueueType) []key {
+	return []key{
+		taskQueueKey(namespaceID, taskQueue, taskType),
+		newKey(prefixTask).str(namespaceID).str(taskQueue).int32(int32(taskType)),
+	}
+}
+
+// userDataRanges are the transaction ranges of the user data of the task queues of a namespace.
+func userDataRanges(namespaceID string) []key {
+	return []key{
+		newKey(prefixTaskQueueUserData).str(namespaceID),
+		newKey(prefixBuildIDToTaskQueue).str(namespaceID),
+	}
+}
+
+func taskQueueUserDataKey(namespaceID string, taskQueue string) key {
+	return newKey(prefixTaskQueueUserData).str(namespaceID).str(taskQueue)
+}
+
+func buildIDToTaskQueuePrefix(namespaceID string, buildID string) key {
+	return newKey(prefixBuildIDToTaskQueue).str(namespaceID).str(buildID)
+}
+
+func (s *TaskStore) CreateTaskQueue(
+	_ context.Context,
+	request *p.InternalCreateTaskQueueRequest,
+) error {
+	return s.update("CreateTaskQueue", taskQueueRanges(request.NamespaceID, request.TaskQueue, request.TaskType), func(txn *kvdb.Txn) error {
+		taskQueueKey := taskQueueKey(request.NamespaceID, request.TaskQueue, request.TaskType)
+		var existing taskQueueRecord
+		found, err := getRecord(txn, taskQueueKey, &existing)
+		if err != nil {
+			return err
+		}
+		if found {
+			return &p.ConditionFailedError{
+				Msg: fmt.Sprintf("CreateTaskQueue: task queue %v of type %v already exists", request.TaskQueue, request.TaskType),
+			}
+		}
+		return setRecord(txn, taskQueueKey, &taskQueueRecord{
+			RangeID:       request.RangeID,
+			TaskQueueInfo: newBlob(request.TaskQueueInfo),
+		})
+	})
+}
+
+func (s *TaskStore) GetTaskQueue(
+	_ context.Context,
+	request *p.InternalGetTaskQueueRequest,
+) (*p.InternalGetTaskQueueResponse, error) {
+	var taskQueue taskQueueRecord
+	found, err := getRecord(s.db, taskQueueKey(request.NamespaceID, request.TaskQueue, request.TaskType), &taskQueue)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, serviceerror.NewNotFound(
+			fmt.Sprintf("GetTaskQueue operation failed. TaskQueue: %v, TaskQueueType: %v",
+				request.TaskQueue, request.TaskType))
+	}
+	return &p.InternalGetTaskQueueResponse{
+		RangeID:       taskQueue.RangeID,
+		TaskQueueInfo: taskQueue.TaskQueueInfo.dataBlob(),
+	}, nil
+}
+
+func (s *TaskStore) UpdateTaskQueue(
+	_ context.Context,
+	request *p.InternalUpdateTaskQueueRequest,
+) (*p.UpdateTaskQueueResponse, error) {
+	err := s.update("UpdateTaskQueue", taskQueueRanges(request.NamespaceID, request.TaskQueue, request.TaskType), func(txn *kvdb.Txn) error {
+		taskQueueKey := taskQueueKey(request.NamespaceID, request.TaskQueue, request.TaskType)
+		if err := checkTaskQueueRangeID(txn, taskQueueKey, request.PrevRangeID); err != nil {
+			return err
+		}
+		return setRecord(txn, taskQueueKey, &taskQueueRecord{
+			RangeID:       request.RangeID,
+			TaskQueueInfo: newBlob(request.TaskQueueInfo),
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &p.UpdateTaskQueueResponse{}, nil
+}
+
+func (s *TaskStore) ListTaskQueue(
+	_ context.Context,
+	request *p.ListTaskQueueRequest,
+) (*p.InternalListTaskQueueResponse, error) {
+	prefix := newKey(prefixTaskQueue)
+	lower, err := pageLowerBound(prefix, request.PageToken)
+	if err != nil {
+		return nil, err
+	}
+	records, err := scanRecords[taskQueueRecord](s.db, lower, prefix.prefixEnd(), false, request.PageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &p.InternalListTaskQueueResponse{
+		Items: make([]*p.InternalListTaskQueueItem, 0, len(records)),
+	}
+	for _, record := range records {
+		response.Items = append(response.Items, &p.InternalListTaskQueueItem{
+			RangeID:   record.value.RangeID,
+			TaskQueue: record.value.TaskQueueInfo.dataBlob(),
+		})
+	}
+	if len(records) > 0 && len(records) == request.PageSize {
+		response.NextPageToken = records[len(records)-1].key
+	}
+	return response, nil
+}
+
+func (s *TaskStore) DeleteTaskQueue(
+	_ context.Context,
+	request *p.DeleteTaskQueueRequest,
+) error {
+	taskQueue := request.TaskQueue
+	ranges := taskQueueRanges(taskQueue.NamespaceID, taskQueue.TaskQueueName, taskQueue.TaskQueueType)
+	return s.update("DeleteTaskQueue", ranges, func(txn *kvdb.Txn) error {
+		taskQueueKey := taskQueueKey(taskQueue.NamespaceID, taskQueue.TaskQueueName, taskQueue.TaskQueueType)
+		if err := checkTaskQueueRangeID(txn, taskQueueKey, request.RangeID); err != nil {
+			return err
+		}
+		txn.Delete(taskQueueKey)
+		return nil
+	})
+}
+
+func (s *TaskStore) CreateTasks(
+	_ context.Context,
+	request *p.InternalCreateTasksRequest,
+) (*p.CreateTasksResponse, error) {
+	err := s.update("CreateTasks", taskQueueRanges(request.NamespaceID, request.TaskQueue, request.TaskType), func(txn *kvdb.Txn) error {
+		if err := checkTaskQueueRangeID(
+			txn,
+			taskQueueKey(request.NamespaceID, request.TaskQueue, request.TaskType),
+			request.RangeID,
+		); err != nil {
+			return err
+		}
+		for _, task := range request.Tasks {
+			taskKey := taskPrefix(request.NamespaceID, request.TaskQueue, request.TaskType, task.Subqueue).int64(task.TaskId)
+			if err := setRecord(txn, taskKey, &taskRecord{Task: newBlob(task.Task)}); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &p.CreateTasksResponse{UpdatedMetadata: false}, nil
+}
+
+func (s *TaskStore) GetTasks(
+	_ context.Context,
+	request *p.GetTasksRequest,
+) (*p.InternalGetTasksResponse, error) {
+	prefix := taskPrefix(request.NamespaceID, request.TaskQueue, request.TaskType, request.Subqueue)
+	lower := prefix.int64(request.InclusiveMinTaskID)
+	if len(request.NextPageToken) != 0 {
+		var err error
+		if lower, err = pageLowerBound(prefix, request.NextPageToken); err != nil {
+			return nil, err
+		}
+	}
+
+	records, err := scanRecords[taskRecord](s.db, lower, prefix.int64(request.ExclusiveMaxTaskID), false, request.PageSize)
+	if err != nil {
+		return nil, err
+	}
+	response := &p.InternalGetTasksResponse{
+		Tasks: make([]*commonpb.DataBlob, 0, len(records)),
+	}
+	for _, record := range records {
+		response.Tasks = append(response.Tasks, record.value.Task.dataBlob())
+	}
+	if len(records) > 0 && len(records) == request.PageSize {
+		response.NextPageToken = records[len(records)-1].key
+	}
+	return response, nil
+}
+
+func (s *TaskStore) CompleteTasksLessThan(
+	_ context.Context,
+	request *p.CompleteTasksLessThanRequest,
+) (int, error) {
+	var deleted int
+	err := s.update("CompleteTasksLessThan", taskQueueRanges(request.NamespaceID, request.TaskQueueName, request.TaskType), func(txn *kvdb.Txn) error {
+		prefix := taskPrefix(request.NamespaceID, request.TaskQueueName, request.TaskType, request.Subqueue)
+		records, err := scanRecords[taskRecord](txn, prefix, prefix.int64(request.ExclusiveMaxTaskID), false, request.Limit)
+		if err != nil {
+			return err
+		}
+		for _, record := range records {
+			txn.Delete(record.key)
+		}
+		deleted = len(records)
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return deleted, nil
+}
+
+// CompleteTask deletes a single task, it doesn't fail if the task doesn't exist.
+func (s *TaskStore) CompleteTask(
+	_ context.Context,
+	request *p.CompleteTaskRequest,
+) error {
+	return s.update("CompleteTask", taskQueueRanges(request.NamespaceID, request.TaskQueueName, request.TaskType), func(txn *kvdb.Txn) error {
+		txn.Delete(taskPrefix(request.NamespaceID, request.TaskQueueName, request.TaskType, request.Subqueue).int64(request.TaskID))
+		return nil
+	})
+}
+
+func (s *TaskStore) GetTaskQueueUserData(
+	_ context.Context,
+	request *p.GetTaskQueueUserDataRequest,
+) (*p.InternalGetTaskQueueUserDataResponse, error) {
+	var userData taskQueueUserDataRecord
+	found, err := getRecord(s.db, taskQueueUserDataKey(request.NamespaceID, request.TaskQueue), &userData)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, serviceerror.NewNotFound(fmt.Sprintf("task queue user data not found for %v.%v", request.NamespaceID, request.TaskQueue))
+	}
+	return &p.InternalGetTaskQueueUserDataResponse{
+		Version:  userData.Version,
+		UserData: userData.UserData.dataBlob(),
+	}, nil
+}
+
+func (s *TaskStore) UpdateTaskQueueUserData(
+	_ context.Context,
+	request *p.InternalUpdateTaskQueueUserDataRequest,
+) error {
+	err := s.update("UpdateTaskQueueUserData", userDataRanges(request.NamespaceID), func(txn *kvdb.Txn) error {
+		for taskQueue, update := range request.Updates {
+			// note these are in a transaction: if one fails the others will be rolled back
+			err := updateTaskQueueUserData(txn, request.NamespaceID, taskQueue, update)
+			if p.IsConflictErr(err) && update.Conflicting != nil {
+				*update.Conflicting = true
+			}
+			if err != nil {
+				return err
+			}
+			for _, buildID := range update.BuildIdsAdded {
+				if err := setRecord(
+					txn,
+					buildIDToTaskQueuePrefix(request.NamespaceID, buildID).str(taskQueue),
+					&buildIDToTaskQueueRecord{TaskQueue: taskQueue},
+				); err != nil {
+					return err
+				}
+			}
+			for _, buildID := range update.BuildIdsRemoved {
+				txn.Delete(buildIDToTaskQueuePrefix(request.NamespaceID, buildID).str(taskQueue))
+			}
+		}
+		return nil
+	})
+	// only set Applied if the whole transaction succeeded
+	for _, update := range request.Updates {
+		if update.Applied != nil {
+			*update.Applied = err == nil
+		}
+	}
+	return err
+}
+
+// updateTaskQueueUserData writes the user data if the stored version matches the version of the update, a
+// version of zero means that there must be no user data yet.
+func updateTaskQueueUserData(
+	txn *kvdb.Txn,
+	namespaceID string,
+	taskQueue string,
+	update *p.InternalSingleTaskQueueUserDataUpdate,
+) error {
+	userDataKey := taskQueueUserDataKey(namespaceID, taskQueue)
+	var existing taskQueueUserDataRecord
+	found, err := getRecord(txn, userDataKey, &existing)
+	if err != nil {
+		return err
+	}
+	if found != (update.Version != 0) || existing.Version != update.Version {
+		return &p.ConditionFailedError{
+			Msg: fmt.Sprintf("task queue user data version was %v when it should have been %v", existing.Version, update.Version),
+		}
+	}
+	return setRecord(txn, userDataKey, &taskQueueUserDataRecord{
+		TaskQueue: taskQueue,
+		Version:   update.Version + 1,
+		UserData:  newBlob(update.UserData),
+	})
+}
+
+func (s *TaskStore) ListTaskQueueUserDataEntries(
+	_ context.Context,
+	request *p.ListTaskQueueUserDataEntriesRequest,
+) (*p.InternalListTaskQueueUserDataEntriesResponse, error) {
+	prefix := newKey(prefixTaskQueueUserData).str(request.NamespaceID)
+	lower, err := pageLowerBound(prefix, request.NextPageToken)
+	if err != nil {
+		return nil, err
+	}
+	records, err := scanRecords[taskQueueUserDataRecord](s.db, lower, prefix.prefixEnd(), false, request.PageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &p.InternalListTaskQueueUserDataEntriesResponse{
+		Entries: make([]p.InternalTaskQueueUserDataEntry, 0, len(records)),
+	}
+	for _, record := range records {
+		response.Entries = append(response.Entries, p.InternalTaskQueueUserDataEntry{
+			TaskQueue: record.value.TaskQueue,
+			Data:      record.value.UserData.dataBlob(),
+			Version:   record.value.Version,
+		})
+	}
+	if len(records) > 0 && len(records) == request.PageSize {
+		response.NextPageToken = records[len(records)-1].key
+	}
+	return response, nil
+}
+
+func (s *TaskStore) GetTaskQueuesByBuildId(
+	_ context.Context,
+	request *p.GetTaskQueuesByBuildIdRequest,
+) ([]string, error) {
+	records, err := scanPrefix[buildIDToTaskQueueRecord](s.db, buildIDToTaskQueuePrefix(request.NamespaceID, request.BuildID), 0)
+	if err != nil {
+		return nil, err
+	}
+	taskQueues := make([]string, 0, len(records))
+	for _, record := range records {
+		taskQueues = append(taskQueues, record.value.TaskQueue)
+	}
+	return taskQueues, nil
+}
+
+func (s *TaskStore) CountTaskQueuesByBuildId(
+	ctx context.Context,
+	request *p.CountTaskQueuesByBuildIdRequest,
+) (int, error) {
+	taskQueues, err := s.GetTaskQueuesByBuildId(ctx, &p.GetTaskQueuesByBuildIdRequest{
+		NamespaceID: request.NamespaceID,
+		BuildID:     request.BuildID,
+	})
+	return len(taskQueues), err
+}
+
+// checkTaskQueueRangeID checks that the task queue is still owned by the caller.
+func checkTaskQueueRangeID(
+	txn *kvdb.Txn,
+	taskQueueKey key,
+	rangeID int64,
+) error {
+	var taskQueue taskQueueRecord
+	found, err := getRecord(txn, taskQueueKey, &taskQueue)
+	if err != nil {
+		return err
+	}
+	if !found {
+		return &p.ConditionFailedError{Msg: "Task queue does not exists"}
+	}
+	if taskQueue.RangeID != rangeID {
+		return &p.ConditionFailedError{
+			Msg: fmt.Sprintf("Task queue range ID was %v when it was should have been %v", taskQueue.RangeID, rangeID),
+		}
+	}
+	return nil
+}
@@ -0,0 +1,694 @@
+// Package kv is a small embedded, ordered key-value store used by the kv persistence plugin.
+//
+// It is a log-structured merge tree: committed transactions are appended to a write-ahead log and applied
+// to a sorted memtable, full memtables are flushed to immutable sorted table files in the background, and
+// table files are merged by a background size-tiered compaction. Neither flushes nor compactions block
+// transactions, which only wait when flushes fall behind the incoming writes.
+//
+// Transactions declare the key ranges they use, and only transactions on the same ranges are serialized.
+// The API is deliberately narrow (point reads, ordered range scans and transactions on key ranges) so that
+// the persistence stores built on top of it don't depend on the storage engine.
+package kv
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"sync/atomic"
+)
+
+const (
+	// DefaultMemTableSize is the size in bytes after which the memtable is flushed to a table file.
+	DefaultMemTableSize = 64 * 1024 * 1024
+	// DefaultMaxTables is the number of table files after which they are compacted.
+	DefaultMaxTables = 8
+
+	// maxImmutableMemTables is the number of memtables waiting to be flushed after which commits wait for
+	// a flush to complete.
+	maxImmutableMemTables = 2
+	// compactionSizeRatio bounds the size of the tables added to a compaction relative to the tables picked
+	// before them, so that large old tables are rewritten rarely.
+	compactionSizeRatio = 4
+
+	manifestFileName = "MANIFEST"
+	tableFileSuffix  = ".sst"
+	walFileSuffix    = ".log"
+)
+
+var (
+	// ErrNotFound is returned when a key does not exist.
+	ErrNotFound = errors.New("kv: key not found")
+	// ErrClosed is returned when the database is used after it was closed.
+	ErrClosed = errors.New("kv: database is closed")
+	// ErrKeyNotInRanges is returned when a transaction uses a key outside of its ranges.
+	ErrKeyNotInRanges = errors.New("kv: key is not in the ranges of the transaction")
+	// ErrReadOnlyTxn is returned when a read-only transaction writes.
+	ErrReadOnlyTxn = errors.New("kv: transaction is read-only")
+)
+
+type (
+	// Options configure a DB.
+	Options struct {
+		// Path is the directory holding the database files. An empty path keeps all data in memory.
+		Path string
+		// SyncWrites makes every commit wait for the write-ahead log to be synced to disk.
+		SyncWrites bool
+		// MemTableSize is the size in bytes after which the memtable is flushed, DefaultMemTableSize if zero.
+		MemTableSize int
+		// MaxTables is the number of table files after which they are compacted, DefaultMaxTables if zero.
+		MaxTables int
+	}
+
+	// DB is an embedded ordered key-value store. It is safe for concurrent use.
+	DB struct {
+		opts  Options
+		locks rangeLocks
+
+		// commitLock serializes commits, which append to the write-ahead log and apply to the memtable.
+		commitLock sync.Mutex
+		wal        *writeAheadLog // guarded by commitLock
+
+		// manifestLock serializes the changes of the files of the tree: memtable rotations, flushes and
+		// compactions. It is acquired after commitLock and before mu.
+		manifestLock sync.Mutex
+		manifest     manifest
+
+		// mu guards the fields below. It is only held to read or replace them, never during I/O.
+		mu        sync.RWMutex
+		current   *version
+		closed    bool
+		bgErr     error
+		flushDone *sync.Cond
+
+		flushWork   chan struct{}
+		compactWork chan struct{}
+		stop        chan struct{}
+		wg          sync.WaitGroup
+	}
+
+	// version is an immutable view of the memtables and tables of the tree. Readers hold a reference to the
+	// version they read, which keeps its tables open until they are done, even if a compaction replaced them.
+	version struct {
+		mem    *memTable
+		imm    []*memTable // newest first, waiting to be flushed
+		tables []*table    // newest first
+		refs   atomic.Int32
+	}
+
+	manifest struct {
+		Tables         []string `json:"tables"` // newest first
+		WALs           []string `json:"wals"`   // oldest first, the last one is appended to
+		NextFileNumber int      `json:"nextFileNumber"`
+	}
+)
+
+// Open opens the database at opts.Path, creating it if it doesn't exist.
+func Open(opts Options) (*DB, error) {
+	if opts.MemTableSize <= 0 {
+		opts.MemTableSize = DefaultMemTableSize
+	}
+	if opts.MaxTables <= 0 {
+		opts.MaxTables = DefaultMaxTables
+	}
+	db := &DB{opts: opts}
+	db.flushDone = sync.NewCond(&db.mu)
+	if opts.Path == "" {
+		db.installVersion(&version{mem: newMemTable()})
+		return db, nil
+	}
+
+	if err := os.MkdirAll(opts.Path, 0o755); err != nil {
+		return nil, err
+	}
+	content, err := os.ReadFile(filepath.Join(opts.Path, manifestFileName))
+	switch {
+	case err == nil:
+		if err := json.Unmarshal(content, &db.manifest); err != nil {
+			return nil, fmt.Errorf("kv: invalid manifest: %w", err)
+		}
+	case errors.Is(err, os.ErrNotExist):
+	default:
+		return nil, err
+	}
+	if len(db.manifest.WALs) == 0 {
+		db.manifest.WALs = []string{db.nextFileName(walFileSuffix)}
+		if err := db.writeManifest(db.manifest); err != nil {
+			return nil, err
+		}
+	}
+
+	v := &version{mem: newMemTable()}
+	closeTables := func() {
+		for _, t := range v.tables {
+			_ = t.file.Close()
+		}
+	}
+	for _, name := range db.manifest.Tables {
+		t, err := openTable(filepath.Join(opts.Path, name), name)
+		if err != nil {
+			closeTables()
+			return nil, err
+		}
+		v.tables = append(v.tables, t)
+	}
+	// The memtables that weren't flushed before the database was closed are replayed into one memtable,
+	// which is flushed as a whole and then removes all their logs.
+	for _, name := range db.manifest.WALs {
+		if err := replayWriteAheadLog(filepath.Join(opts.Path, name), v.mem); err != nil {
+			closeTables()
+			return nil, err
+		}
+	}
+	v.mem.logs = slices.Clone(db.manifest.WALs)
+	last := db.manifest.WALs[len(db.manifest.WALs)-1]
+	if db.wal, err = openWriteAheadLog(filepath.Join(opts.Path, last), last, opts.SyncWrites); err != nil {
+		closeTables()
+		return nil, err
+	}
+	db.installVersion(v)
+	db.removeObsoleteFiles()
+
+	db.flushWork = make(chan struct{}, 1)
+	db.compactWork = make(chan struct{}, 1)
+	db.stop = make(chan struct{})
+	db.wg.Add(2)
+	go db.flushLoop()
+	go db.compactLoop()
+	if len(v.tables) > opts.MaxTables {
+		notify(db.compactWork)
+	}
+	return db, nil
+}
+
+// Get returns the value of the key, or ErrNotFound.
+func (db *DB) Get(key []byte) ([]byte, error) {
+	v, err := db.acquireVersion()
+	if err != nil {
+		return nil, err
+	}
+	defer v.release()
+	return v.get(string(key))
+}
+
+// Scan calls fn for all keys in [lower, upper) in key order, or in reverse key order, until fn returns
+// false. A nil upper bound means no upper bound. fn must not use the database.
+//
+// Scan doesn't lock any range, it observes all transactions committed before it started and may observe
+// some of those committed while it runs. Use View for a consistent read of a range.
+func (db *DB) Scan(lower []byte, upper []byte, reverse bool, fn func(key []byte, value []byte) bool) error {
+	return db.scan(nil, lower, upper, reverse, fn)
+}
+
+// Update runs fn in a read-write transaction on the key ranges, which are key prefixes. Transactions on the
+// same ranges run one after the other. Ranges are locked by their exact prefix, so the transactions using a
+// set of keys must all declare the same prefix for them, e.g. always the table and shard of a row rather than
+// sometimes the table alone. The writes of the transaction are committed atomically if fn returns nil, and
+// discarded otherwise.
+func (db *DB) Update(ranges [][]byte, fn func(txn *Txn) error) error {
+	unlock := db.locks.lock(ranges, true)
+	defer unlock()
+
+	txn := &Txn{db: db, ranges: ranges, writable: true, writes: newMemTable()}
+	if err := fn(txn); err != nil {
+		return err
+	}
+	if txn.err != nil {
+		return txn.err
+	}
+	if txn.writes.len() == 0 {
+		return nil
+	}
+	return db.commit(txn.writes)
+}
+
+// View runs fn in a read-only transaction on the key ranges. Read-only transactions on the same ranges run
+// concurrently, but not concurrently with read-write transactions on them.
+func (db *DB) View(ranges [][]byte, fn func(txn *Txn) error) error {
+	unlock := db.locks.lock(ranges, false)
+	defer unlock()
+
+	txn := &Txn{db: db, ranges: ranges, writes: newMemTable()}
+	if err := fn(txn); err != nil {
+		return err
+	}
+	return txn.err
+}
+
+// Close closes the database. Committed transactions are durable as long as the write-ahead log reached the
+// disk, which is always the case with SyncWrites. Memtables that weren't flushed yet are replayed from their
+// logs when the database is opened again.
+func (db *DB) Close() error {
+	db.commitLock.Lock()
+	defer db.commitLock.Unlock()
+
+	db.mu.Lock()
+	if db.closed {
+		db.mu.Unlock()
+		return nil
+	}
+	db.closed = true
+	db.flushDone.Broadcast()
+	db.mu.Unlock()
+
+	if db.stop != nil {
+		close(db.stop)
+		db.wg.Wait()
+	}
+	var err error
+	if db.wal != nil {
+		err = db.wal.close()
+	}
+	db.mu.Lock()
+	db.current.release()
+	db.current = nil
+	db.mu.Unlock()
+	return err
+}
+
+func (db *DB) acquireVersion() (*version, error) {
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+	if db.closed {
+		return nil, ErrClosed
+	}
+	db.current.refs.Add(1)
+	return db.current, nil
+}
+
+// installVersion makes v the current version, db.mu must be held.
+func (db *DB) installVersion(v *version) {
+	for _, t := range v.tables {
+		t.ref()
+	}
+	v.refs.Store(1)
+	if old := db.current; old != nil {
+		old.release()
+	}
+	db.current = v
+}
+
+func (v *version) release() {
+	if v.refs.Add(-1) > 0 {
+		return
+	}
+	for _, t := range v.tables {
+		t.unref()
+	}
+}
+
+func (v *version) get(key string) ([]byte, error) {
+	if e, ok := v.mem.get(key); ok {
+		return liveValue(e)
+	}
+	for _, m := range v.imm {
+		if e, ok := m.get(key); ok {
+			return liveValue(e)
+		}
+	}
+	for _, t := range v.tables {
+		e, ok, err := t.get(key)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			return liveValue(e)
+		}
+	}
+	return nil, ErrNotFound
+}
+
+// cursor merges the pending writes (if any), the memtables and all tables, newest first.
+func (v *version) cursor(pending *memTable, lower string, upper string, reverse bool) cursor {
+	cursors := make([]cursor, 0, len(v.imm)+len(v.tables)+2)
+	if pending != nil {
+		cursors = append(cursors, pending.cursor(lower, upper, reverse))
+	}
+	cursors = append(cursors, v.mem.cursor(lower, upper, reverse))
+	for _, m := range v.imm {
+		cursors = append(cursors, m.cursor(lower, upper, reverse))
+	}
+	for _, t := range v.tables {
+		cursors = append(cursors, t.cursor(lower, upper, reverse))
+	}
+	return newMergeCursor(cursors, reverse)
+}
+
+func liveValue(e entry) ([]byte, error) {
+	if e.deleted {
+		return nil, ErrNotFound
+	}
+	return e.value, nil
+}
+
+func (db *DB) scan(
+	pending *memTable,
+	lower []byte,
+	upper []byte,
+	reverse bool,
+	fn func(key []byte, value []byte) bool,
+) error {
+	v, err := db.acquireVersion()
+	if err != nil {
+		return err
+	}
+	defer v.release()
+	return scanCursor(v.cursor(pending, string(lower), string(upper), reverse), fn)
+}
+
+func (db *DB) commit(writes *memTable) error {
+	db.commitLock.Lock()
+	defer db.commitLock.Unlock()
+
+	if err := db.makeRoomForWrite(); err != nil {
+		return err
+	}
+	if db.wal != nil {
+		if err := db.wal.append(encodeBatch(writes)); err != nil {
+			return err
+		}
+	}
+	// The memtable only changes in makeRoomForWrite, which runs under commitLock.
+	db.mu.RLock()
+	mem := db.current.mem
+	db.mu.RUnlock()
+	for c := writes.cursor("", "", false); c.Valid(); c.Next() {
+		mem.put(c.Key(), c.Entry())
+	}
+	return nil
+}
+
+// makeRoomForWrite rotates the memtable when it is full. Commits wait when the flushes can't keep up with
+// them, which bounds the memory held by memtables. commitLock must be held.
+func (db *DB) makeRoomForWrite() error {
+	db.mu.Lock()
+	for {
+		switch {
+		case db.closed:
+			db.mu.Unlock()
+			return ErrClosed
+		case db.bgErr != nil:
+			db.mu.Unlock()
+			return db.bgErr
+		case db.wal == nil || db.current.mem.size.Load() < int64(db.opts.MemTableSize):
+			db.mu.Unlock()
+			return nil
+		case len(db.current.imm) >= maxImmutableMemTables:
+			db.flushDone.Wait()
+			continue
+		}
+		break
+	}
+	db.mu.Unlock()
+	return db.rotateMemTable()
+}
+
+// rotateMemTable starts a new memtable and write-ahead log, and hands the full memtable to the flusher.
+func (db *DB) rotateMemTable() error {
+	db.manifestLock.Lock()
+	defer db.manifestLock.Unlock()
+
+	name := db.nextFileName(walFileSuffix)
+	path := filepath.Join(db.opts.Path, name)
+	wal, err := openWriteAheadLog(path, name, db.opts.SyncWrites)
+	if err != nil {
+		return err
+	}
+	m := db.manifest
+	m.WALs = append(slices.Clone(m.WALs), name)
+	if err := db.writeManifest(m); err != nil {
+		_ = wal.close()
+		_ = os.Remove(path)
+		return err
+	}
+	db.manifest = m
+	_ = db.wal.close()
+	db.wal = wal
+
+	mem := newMemTable()
+	mem.logs = []string{name}
+	db.mu.Lock()
+	current := db.current
+	db.installVersion(&version{
+		mem:    mem,
+		imm:    append([]*memTable{current.mem}, current.imm...),
+		tables: current.tables,
+	})
+	db.mu.Unlock()
+	notify(db.flushWork)
+	return nil
+}
+
+func (db *DB) flushLoop() {
+	defer db.wg.Done()
+	for {
+		select {
+		case <-db.stop:
+			return
+		case <-db.flushWork:
+		}
+		for {
+			select {
+			case <-db.stop:
+				return
+			default:
+			}
+			db.mu.RLock()
+			imm := db.current.imm
+			db.mu.RUnlock()
+			if len(imm) == 0 {
+				break
+			}
+			if err := db.flush(imm[len(imm)-1]); err != nil {
+				db.mu.Lock()
+				db.bgErr = fmt.Errorf("kv: flush failed: %w", err)
+				db.flushDone.Broadcast()
+				db.mu.Unlock()
+				return
+			}
+		}
+	}
+}
+
+// flush writes the oldest immutable memtable to a new table and removes its write-ahead logs.
+func (db *DB) flush(mem *memTable) error {
+	db.manifestLock.Lock()
+	name := db.nextFileName(tableFileSuffix)
+	db.manifestLock.Unlock()
+
+	path := filepath.Join(db.opts.Path, name)
+	if err := writeTable(path, mem.cursor("", "", false), false); err != nil {
+		return err
+	}
+	t, err := openTable(path, name)
+	if err != nil {
+		_ = os.Remove(path)
+		return err
+	}
+
+	db.manifestLock.Lock()
+	defer db.manifestLock.Unlock()
+	m := db.manifest
+	m.Tables = append([]string{name}, m.Tables...)
+	m.WALs = slices.DeleteFunc(slices.Clone(m.WALs), func(wal string) bool {
+		return slices.Contains(mem.logs, wal)
+	})
+	if err := db.writeManifest(m); err != nil {
+		_ = t.file.Close()
+		_ = os.Remove(path)
+		return err
+	}
+	db.manifest = m
+
+	db.mu.Lock()
+	current := db.current
+	v := &version{
+		mem:    current.mem,
+		imm:    slices.DeleteFunc(slices.Clone(current.imm), func(imm *memTable) bool { return imm == mem }),
+		tables: append([]*table{t}, current.tables...),
+	}
+	db.installVersion(v)
+	db.flushDone.Broadcast()
+	db.mu.Unlock()
+
+	for _, wal := range mem.logs {
+		_ = os.Remove(filepath.Join(db.opts.Path, wal))
+	}
+	if len(v.tables) > db.opts.MaxTables {
+		notify(db.compactWork)
+	}
+	return nil
+}
+
+func (db *DB) compactLoop() {
+	defer db.wg.Done()
+	for {
+		select {
+		case <-db.stop:
+			return
+		case <-db.compactWork:
+		}
+		for {
+			select {
+			case <-db.stop:
+				return
+			default:
+			}
+			// A failed compaction leaves the tables as they are and is retried after the next flush.
+			if compacted, err := db.compact(); err != nil || !compacted {
+				break
+			}
+		}
+	}
+}
+
+// compact merges a run of the newest tables into one table. It returns false if there was nothing to compact.
+func (db *DB) compact() (bool, error) {
+	v, err := db.acquireVersion()
+	if err != nil {
+		return false, err
+	}
+	// v keeps the tables of the run open while they are merged.
+	defer v.release()
+	n := pickCompaction(v.tables, db.opts.MaxTables)
+	if n == 0 {
+		return false, nil
+	}
+	run := v.tables[:n]
+	// Tombstones shadow the older tables, they can only be dropped when there are none.
+	dropTombstones := n == len(v.tables)
+
+	db.manifestLock.Lock()
+	name := db.nextFileName(tableFileSuffix)
+	db.manifestLock.Unlock()
+
+	cursors := make([]cursor, 0, len(run))
+	for _, t := range run {
+		cursors = append(cursors, t.cursor("", "", false))
+	}
+	path := filepath.Join(db.opts.Path, name)
+	if err := writeTable(path, newMergeCursor(cursors, false), dropTombstones); err != nil {
+		return false, err
+	}
+	compacted, err := openTable(path, name)
+	if err != nil {
+		_ = os.Remove(path)
+		return false, err
+	}
+
+	db.manifestLock.Lock()
+	defer db.manifestLock.Unlock()
+	// Flushes added tables in front of the run while it was merged, the run itself can only be replaced by
+	// this compaction.
+	db.mu.RLock()
+	current := db.current
+	db.mu.RUnlock()
+	start := slices.Index(current.tables, run[0])
+	tables := slices.Concat(current.tables[:start], []*table{compacted}, current.tables[start+n:])
+	m := db.manifest
+	m.Tables = make([]string, 0, len(tables))
+	for _, t := range tables {
+		m.Tables = append(m.Tables, t.name)
+	}
+	if err := db.writeManifest(m); err != nil {
+		_ = compacted.file.Close()
+		_ = os.Remove(path)
+		return false, err
+	}
+	db.manifest = m
+	for _, t := range run {
+		t.obsolete.Store(true)
+	}
+
+	db.mu.Lock()
+	db.installVersion(&version{mem: current.mem, imm: current.imm, tables: tables})
+	db.mu.Unlock()
+	return true, nil
+}
+
+// pickCompaction returns the number of newest tables to merge, or 0 if there are few enough tables. It picks
+// enough tables to get back to maxTables, and then keeps adding older tables while they are not much larger
+// than the tables picked so far (size-tiered compaction).
+func pickCompaction(tables []*table, maxTables int) int {
+	if len(tables) <= maxTables {
+		return 0
+	}
+	minCount := len(tables) - maxTables + 1
+	n, size := 0, int64(0)
+	for n < len(tables) && (n < minCount || tables[n].size <= compactionSizeRatio*size) {
+		size += tables[n].size
+		n++
+	}
+	return n
+}
+
+func notify(ch chan struct{}) {
+	select {
+	case ch <- struct{}{}:
+	default:
+	}
+}
+
+// nextFileName allocates a file name, manifestLock must be held.
+func (db *DB) nextFileName(suffix string) string {
+	db.manifest.NextFileNumber++
+	return fmt.Sprintf("%06d%s", db.manifest.NextFileNumber, suffix)
+}
+
+// writeManifest atomically replaces the manifest, which is the commit point of memtable rotations, flushes
+// and compactions.
+func (db *DB) writeManifest(m manifest) error {
+	content, err := json.Marshal(m)
+	if err != nil {
+		return err
+	}
+	tmpPath := filepath.Join(db.opts.Path, manifestFileName+".tmp")
+	file, err := os.OpenFile(tmpPath, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0o644)
+	if err != nil {
+		return err
+	}
+	if _, err := file.Write(content); err != nil {
+		_ = file.Close()
+		return err
+	}
+	if err := file.Sync(); err != nil {
+		_ = file.Close()
+		return err
+	}
+	if err := file.Close(); err != nil {
+		return err
+	}
+	if err := os.Rename(tmpPath, filepath.Join(db.opts.Path, manifestFileName)); err != nil {
+		return err
+	}
+	if dir, err := os.Open(db.opts.Path); err == nil {
+		_ = dir.Sync()
+		_ = dir.Close()
+	}
+	return nil
+}
+
+// removeObsoleteFiles removes tables and logs left behind by a flush or compaction that didn't complete.
+func (db *DB) removeObsoleteFiles() {
+	live := make(map[string]struct{})
+	for _, name := range slices.Concat(db.manifest.Tables, db.manifest.WALs) {
+		live[name] = struct{}{}
+	}
+	entries, err := os.ReadDir(db.opts.Path)
+	if err != nil {
+		return
+	}
+	for _, e := range entries {
+		name := e.Name()
+		if !strings.HasSuffix(name, tableFileSuffix) && !strings.HasSuffix(name, walFileSuffix) {
+			continue
+		}
+		if _, ok := live[name]; !ok {
+			_ = os.Remove(filepath.Join(db.opts.Path, name))
+		}
+	}
+}
@@ -0,0 +1,411 @@
+package kv
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// allKeys is the range of all keys.
+var allKeys = [][]byte{{}}
+
+func TestDB_GetSetDelete(t *testing.T) {
+	db, err := Open(Options{})
+	require.NoError(t, err)
+	defer func() { _ = db.Close() }()
+
+	_, err = db.Get([]byte("a"))
+	require.ErrorIs(t, err, ErrNotFound)
+
+	require.NoError(t, db.Update(allKeys, func(txn *Txn) error {
+		txn.Set([]byte("a"), []byte("1"))
+		txn.Set([]byte("b"), []byte("2"))
+		return nil
+	}))
+	value, err := db.Get([]byte("a"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("1"), value)
+
+	require.NoError(t, db.Update(allKeys, func(txn *Txn) error {
+		txn.Delete([]byte("a"))
+		return nil
+	}))
+	_, err = db.Get([]byte("a"))
+	require.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestDB_UpdateRollback(t *testing.T) {
+	db, err := Open(Options{})
+	require.NoError(t, err)
+	defer func() { _ = db.Close() }()
+
+	errAbort := errors.New("abort")
+	err = db.Update(allKeys, func(txn *Txn) error {
+		txn.Set([]byte("a"), []byte("1"))
+		value, err := txn.Get([]byte("a"))
+		require.NoError(t, err)
+		require.Equal(t, []byte("1"), value)
+		return errAbort
+	})
+	require.ErrorIs(t, err, errAbort)
+	_, err = db.Get([]byte("a"))
+	require.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestDB_Scan(t *testing.T) {
+	db, err := Open(Options{})
+	require.NoError(t, err)
+	defer func() { _ = db.Close() }()
+
+	require.NoError(t, db.Update(allKeys, func(txn *Txn) error {
+		for _, key := range []string{"a", "b", "c", "d", "e"} {
+			txn.Set([]byte(key), []byte(key))
+		}
+		return nil
+	}))
+
+	require.Equal(t, []string{"b", "c", "d"}, scanKeys(t, db, "b", "e", false))
+	require.Equal(t, []string{"d", "c", "b"}, scanKeys(t, db, "b", "e", true))
+	require.Equal(t, []string{"c", "d", "e"}, scanKeys(t, db, "c", "", false))
+	require.Equal(t, []string{"e", "d", "c", "b", "a"}, scanKeys(t, db, "", "", true))
+	require.Empty(t, scanKeys(t, db, "x", "z", false))
+	require.Empty(t, scanKeys(t, db, "x", "z", true))
+
+	// transaction scans merge pending writes
+	require.NoError(t, db.Update(allKeys, func(txn *Txn) error {
+		txn.Delete([]byte("b"))
+		txn.Set([]byte("bb"), nil)
+		var keys []string
+		require.NoError(t, txn.Scan([]byte("a"), []byte("c"), false, func(key []byte, _ []byte) bool {
+			keys = append(keys, string(key))
+			return true
+		}))
+		require.Equal(t, []string{"a", "bb"}, keys)
+		return txn.DeleteRange([]byte("c"), nil)
+	}))
+	require.Equal(t, []string{"a", "bb"}, scanKeys(t, db, "", "", false))
+}
+
+func TestDB_Ranges(t *testing.T) {
+	db, err := Open(Options{})
+	require.NoError(t, err)
+	defer func() { _ = db.Close() }()
+
+	require.NoError(t, db.Update([][]byte{[]byte("a/"), []byte("b/")}, func(txn *Txn) error {
+		txn.Set([]byte("a/1"), nil)
+		txn.Set([]byte("b/1"), nil)
+		return nil
+	}))
+
+	err = db.Update([][]byte{[]byte("a/")}, func(txn *Txn) error {
+		txn.Set([]byte("a/2"), nil)
+		txn.Set([]byte("b/2"), nil)
+		return nil
+	})
+	require.ErrorIs(t, err, ErrKeyNotInRanges)
+	_, err = db.Get([]byte("a/2"))
+	require.ErrorIs(t, err, ErrNotFound)
+
+	err = db.View([][]byte{[]byte("a/")}, func(txn *Txn) error {
+		_, err := txn.Get([]byte("b/1"))
+		require.ErrorIs(t, err, ErrKeyNotInRanges)
+		// scans must stay inside the ranges too
+		return txn.Scan([]byte("a/"), nil, false, func([]byte, []byte) bool { return true })
+	})
+	require.ErrorIs(t, err, ErrKeyNotInRanges)
+
+	err = db.View([][]byte{[]byte("a/")}, func(txn *Txn) error {
+		txn.Set([]byte("a/2"), nil)
+		return nil
+	})
+	require.ErrorIs(t, err, ErrReadOnlyTxn)
+}
+
+func TestDB_TransactionsOnDifferentRangesRunConcurrently(t *testing.T) {
+	db, err := Open(Options{})
+	require.NoError(t, err)
+	defer func() { _ = db.Close() }()
+
+	started := make(chan struct{})
+	release := make(chan struct{})
+	done := make(chan error)
+	go func() {
+		done <- db.Update([][]byte{[]byte("a/")}, func(txn *Txn) error {
+			txn.Set([]byte("a/1"), []byte("1"))
+			close(started)
+			<-release
+			return nil
+		})
+	}()
+	<-started
+
+	// not blocked by the transaction on a/
+	require.NoError(t, db.Update([][]byte{[]byte("b/")}, func(txn *Txn) error {
+		txn.Set([]byte("b/1"), []byte("1"))
+		return nil
+	}))
+	value, err := db.Get([]byte("b/1"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("1"), value)
+
+	// blocked by the transaction on a/
+	viewed := make(chan struct{})
+	go func() {
+		_ = db.View([][]byte{[]byte("a/")}, func(txn *Txn) error {
+			_, err := txn.Get([]byte("a/1"))
+			require.NoError(t, err)
+			return nil
+		})
+		close(viewed)
+	}()
+	select {
+	case <-viewed:
+		t.Fatal("read-only transaction ran concurrently with a read-write transaction on the same range")
+	case <-time.After(50 * time.Millisecond):
+	}
+	close(release)
+	require.NoError(t, <-done)
+	<-viewed
+}
+
+func TestDB_Reopen(t *testing.T) {
+	path := t.TempDir()
+	db, err := Open(Options{Path: path, SyncWrites: true})
+	require.NoError(t, err)
+	require.NoError(t, db.Update(allKeys, func(txn *Txn) error {
+		txn.Set([]byte("a"), []byte("1"))
+		txn.Set([]byte("b"), []byte("2"))
+		return nil
+	}))
+	require.NoError(t, db.Update(allKeys, func(txn *Txn) error {
+		txn.Delete([]byte("a"))
+		return nil
+	}))
+	require.NoError(t, db.Close())
+
+	db, err = Open(Options{Path: path})
+	require.NoError(t, err)
+	defer func() { _ = db.Close() }()
+	require.Equal(t, []string{"b"}, scanKeys(t, db, "", "", false))
+}
+
+func TestDB_TornWriteAheadLog(t *testing.T) {
+	path := t.TempDir()
+	db, err := Open(Options{Path: path})
+	require.NoError(t, err)
+	require.NoError(t, db.Update(allKeys, func(txn *Txn) error {
+		txn.Set([]byte("a"), []byte("1"))
+		return nil
+	}))
+	require.NoError(t, db.Update(allKeys, func(txn *Txn) error {
+		txn.Set([]byte("b"), []byte("2"))
+		return nil
+	}))
+	walPath := filepath.Join(path, db.wal.name)
+	require.NoError(t, db.Close())
+
+	info, err := os.Stat(walPath)
+	require.NoError(t, err)
+	require.NoError(t, os.Truncate(walPath, info.Size()-1))
+
+	db, err = Open(Options{Path: path})
+	require.NoError(t, err)
+	require.Equal(t, []string{"a"}, scanKeys(t, db, "", "", false))
+	// the log is writable after the torn record was dropped
+	require.NoError(t, db.Update(allKeys, func(txn *Txn) error {
+		txn.Set([]byte("c"), []byte("3"))
+		return nil
+	}))
+	require.NoError(t, db.Close())
+
+	db, err = Open(Options{Path: path})
+	require.NoError(t, err)
+	defer func() { _ = db.Close() }()
+	require.Equal(t, []string{"a", "c"}, scanKeys(t, db, "", "", false))
+}
+
+func TestDB_FlushAndCompaction(t *testing.T) {
+	path := t.TempDir()
+	opts := Options{Path: path, MemTableSize: 256, MaxTables: 3}
+	db, err := Open(opts)
+	require.NoError(t, err)
+
+	expected := make(map[string]string)
+	for i := 0; i < 500; i++ {
+		key := fmt.Sprintf("key-%03d", i%50)
+		value := fmt.Sprintf("value-%d", i)
+		require.NoError(t, db.Update(allKeys, func(txn *Txn) error {
+			if i%7 == 0 {
+				txn.Delete([]byte(key))
+				return nil
+			}
+			txn.Set([]byte(key), []byte(value))
+			return nil
+		}))
+		if i%7 == 0 {
+			delete(expected, key)
+		} else {
+			expected[key] = value
+		}
+	}
+	waitForBackgroundWork(t, db)
+	requireContent(t, db, expected)
+	require.NoError(t, db.Close())
+
+	db, err = Open(opts)
+	require.NoError(t, err)
+	defer func() { _ = db.Close() }()
+	requireContent(t, db, expected)
+
+	files, err := os.ReadDir(path)
+	require.NoError(t, err)
+	// manifest, logs and live tables only
+	require.Len(t, files, 1+len(db.manifest.WALs)+len(db.manifest.Tables))
+}
+
+func TestDB_ConcurrentReadsAndWritesDuringCompaction(t *testing.T) {
+	db, err := Open(Options{Path: t.TempDir(), MemTableSize: 1024, MaxTables: 2})
+	require.NoError(t, err)
+	defer func() { _ = db.Close() }()
+
+	const writers, writes = 4, 300
+	var wg sync.WaitGroup
+	for w := 0; w < writers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			prefix := []byte(fmt.Sprintf("w%d/", w))
+			for i := 0; i < writes; i++ {
+				require.NoError(t, db.Update([][]byte{prefix}, func(txn *Txn) error {
+					txn.Set(fmt.Appendf(prefix, "%04d", i), []byte(fmt.Sprint(i)))
+					return nil
+				}))
+				// the writes of a transaction are visible to the transactions after it
+				require.NoError(t, db.View([][]byte{prefix}, func(txn *Txn) error {
+					value, err := txn.Get(fmt.Appendf(prefix, "%04d", i))
+					require.NoError(t, err)
+					require.Equal(t, fmt.Sprint(i), string(value))
+					return nil
+				}))
+			}
+		}()
+	}
+	stop := make(chan struct{})
+	readDone := make(chan struct{})
+	go func() {
+		defer close(readDone)
+		for {
+			select {
+			case <-stop:
+				return
+			default:
+			}
+			// keys come out in order while tables are flushed and compacted under the scan
+			var last string
+			require.NoError(t, db.Scan(nil, nil, false, func(key []byte, _ []byte) bool {
+				require.Less(t, last, string(key))
+				last = string(key)
+				return true
+			}))
+		}
+	}()
+	wg.Wait()
+	close(stop)
+	<-readDone
+
+	waitForBackgroundWork(t, db)
+	for w := 0; w < writers; w++ {
+		keys := scanKeys(t, db, fmt.Sprintf("w%d/", w), fmt.Sprintf("w%d0", w), false)
+		require.Len(t, keys, writes)
+	}
+}
+
+func TestDB_TablesHoldOnlyIndexAndFilterInMemory(t *testing.T) {
+	db, err := Open(Options{Path: t.TempDir(), MemTableSize: 1 << 20})
+	require.NoError(t, err)
+	defer func() { _ = db.Close() }()
+
+	const keys = 20000
+	require.NoError(t, db.Update(allKeys, func(txn *Txn) error {
+		for i := 0; i < keys; i++ {
+			txn.Set(fmt.Appendf(nil, "key-%06d", i), fmt.Appendf(nil, "value-%06d", i))
+		}
+		return nil
+	}))
+	// the next commit rotates the full memtable
+	require.NoError(t, db.Update(allKeys, func(txn *Txn) error {
+		txn.Set([]byte("last"), nil)
+		return nil
+	}))
+	waitForBackgroundWork(t, db)
+
+	db.mu.RLock()
+	tables := db.current.tables
+	db.mu.RUnlock()
+	require.Len(t, tables, 1)
+	require.Equal(t, uint64(keys), tables[0].count)
+	require.Less(t, len(tables[0].index), keys/50)
+	require.Less(t, len(tables[0].filter), 2*keys)
+
+	value, err := db.Get([]byte("key-012345"))
+	require.NoError(t, err)
+	require.Equal(t, "value-012345", string(value))
+	_, err = db.Get([]byte("key-99999"))
+	require.ErrorIs(t, err, ErrNotFound)
+	require.Equal(t, []string{"key-019998", "key-019999"}, scanKeys(t, db, "key-019998", "l", false))
+}
+
+func TestPickCompaction(t *testing.T) {
+	tables := func(sizes ...int64) []*table {
+		var result []*table
+		for _, size := range sizes {
+			result = append(result, &table{size: size})
+		}
+		return result
+	}
+	require.Equal(t, 0, pickCompaction(tables(1, 1, 1), 3))
+	require.Equal(t, 2, pickCompaction(tables(1, 1, 100, 1000), 3))
+	require.Equal(t, 3, pickCompaction(tables(1, 1, 5, 1000), 3))
+	require.Equal(t, 4, pickCompaction(tables(1, 1, 5, 20), 3))
+}
+
+func waitForBackgroundWork(t *testing.T, db *DB) {
+	require.Eventually(t, func() bool {
+		db.mu.RLock()
+		defer db.mu.RUnlock()
+		return len(db.current.imm) == 0 && len(db.current.tables) <= db.opts.MaxTables
+	}, 10*time.Second, 10*time.Millisecond)
+}
+
+func requireContent(t *testing.T, db *DB, expected map[string]string) {
+	actual := make(map[string]string)
+	require.NoError(t, db.Scan(nil, nil, false, func(key []byte, value []byte) bool {
+		actual[string(key)] = string(value)
+		return true
+	}))
+	require.Equal(t, expected, actual)
+	for key, value := range expected {
+		v, err := db.Get([]byte(key))
+		require.NoError(t, err)
+		require.Equal(t, value, string(v))
+	}
+}
+
+func scanKeys(t *testing.T, db *DB, lower string, upper string, reverse bool) []string {
+	var upperBound []byte
+	if upper != "" {
+		upperBound = []byte(upper)
+	}
+	var keys []string
+	require.NoError(t, db.Scan([]byte(lower), upperBound, reverse, func(key []byte, _ []byte) bool {
+		keys = append(keys, string(key))
+		return true
+	}))
+	return keys
+}
@@ -0,0 +1,92 @@
+package kv
+
+type (
+	// cursor iterates over the entries of a single memtable or table in key order, or in reverse key order.
+	// A cursor that fails to read becomes invalid and reports the error from Err.
+	cursor interface {
+		Valid() bool
+		Key() string
+		Entry() entry
+		Next()
+		Err() error
+	}
+
+	// mergeCursor merges cursors ordered from newest to oldest. When several cursors are positioned at the
+	// same key the entry of the newest one wins and the others are skipped.
+	mergeCursor struct {
+		cursors []cursor
+		reverse bool
+		current int
+	}
+)
+
+func newMergeCursor(cursors []cursor, reverse bool) *mergeCursor {
+	m := &mergeCursor{cursors: cursors, reverse: reverse}
+	m.findCurrent()
+	return m
+}
+
+func (m *mergeCursor) findCurrent() {
+	m.current = -1
+	if m.Err() != nil {
+		return
+	}
+	for i, c := range m.cursors {
+		if !c.Valid() {
+			continue
+		}
+		if m.current == -1 {
+			m.current = i
+			continue
+		}
+		key, currentKey := c.Key(), m.cursors[m.current].Key()
+		if (!m.reverse && key < currentKey) || (m.reverse && key > currentKey) {
+			m.current = i
+		}
+	}
+}
+
+func (m *mergeCursor) Valid() bool {
+	return m.current != -1
+}
+
+func (m *mergeCursor) Key() string {
+	return m.cursors[m.current].Key()
+}
+
+func (m *mergeCursor) Entry() entry {
+	return m.cursors[m.current].Entry()
+}
+
+func (m *mergeCursor) Next() {
+	key := m.Key()
+	for _, c := range m.cursors {
+		if c.Valid() && c.Key() == key {
+			c.Next()
+		}
+	}
+	m.findCurrent()
+}
+
+func (m *mergeCursor) Err() error {
+	for _, c := range m.cursors {
+		if err := c.Err(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// scanCursor calls fn for every live entry of the cursor until fn returns false.
+func scanCursor(c cursor, fn func(key []byte, value []byte) bool) error {
+	for ; c.Valid(); c.Next() {
+		e := c.Entry()
+		if e.deleted {
+			continue
+		}
+		if !fn([]byte(c.Key()), e.value) {
+			return nil
+		}
+	}
+	return c.Err()
+}
@@ -0,0 +1,54 @@
+package kv
+
+import (
+	"hash/fnv"
+	"slices"
+	"sync"
+)
+
+const (
+	lockStripeCount = 1024
+)
+
+type (
+	// rangeLocks are the locks of key ranges held by transactions. A transaction declares the key prefixes it
+	// reads and writes, e.g. a table, or the rows of a shard in a table, and only transactions on the same
+	// ranges wait for each other. Ranges are mapped to a fixed set of lock stripes, and the stripes of a
+	// transaction are always locked in increasing order, so transactions can't deadlock.
+	rangeLocks struct {
+		stripes [lockStripeCount]sync.RWMutex
+	}
+)
+
+func stripesOf(ranges [][]byte) []int {
+	stripes := make([]int, 0, len(ranges))
+	for _, r := range ranges {
+		h := fnv.New32a()
+		_, _ = h.Write(r)
+		stripes = append(stripes, int(h.Sum32()%lockStripeCount))
+	}
+	slices.Sort(stripes)
+	return slices.Compact(stripes)
+}
+
+// lock locks the ranges, exclusively for writers and shared for readers, and returns the function that
+// unlocks them.
+func (l *rangeLocks) lock(ranges [][]byte, exclusive bool) func() {
+	stripes := stripesOf(ranges)
+	for _, s := range stripes {
+		if exclusive {
+			l.stripes[s].Lock()
+		} else {
+			l.stripes[s].RLock()
+		}
+	}
+	return func() {
+		for _, s := range stripes {
+			if exclusive {
+				l.stripes[s].Unlock()
+			} else {
+				l.stripes[s].RUnlock()
+			}
+		}
+	}
+}
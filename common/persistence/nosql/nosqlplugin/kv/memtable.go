@@ -0,0 +1,200 @@
+package kv
+
+import (
+	"math/rand/v2"
+	"sync/atomic"
+)
+
+const (
+	maxSkipListHeight = 12
+	// skipListNodeOverhead approximates the memory used by a skip list node besides its key and value, it's
+	// counted in the memtable size.
+	skipListNodeOverhead = 64
+)
+
+type (
+	// entry is a single versionless record, a deleted entry is a tombstone that shadows older tables.
+	entry struct {
+		value   []byte
+		deleted bool
+	}
+
+	// memTable is the sorted in-memory write buffer of the LSM tree. It is a skip list that supports a single
+	// writer concurrently with any number of readers: nodes are never removed and links are published with
+	// atomic stores, so readers never observe a partially linked node. It is also used to hold the pending
+	// writes of a transaction.
+	memTable struct {
+		head   *skipListNode
+		height atomic.Int32
+		size   atomic.Int64
+		count  atomic.Int64
+		rand   *rand.Rand // only used by the writer
+
+		// logs are the write-ahead logs holding the writes of the memtable, they are removed once the memtable
+		// is flushed to a table.
+		logs []string
+	}
+
+	skipListNode struct {
+		key   string
+		entry atomic.Pointer[entry]
+		next  []atomic.Pointer[skipListNode]
+	}
+
+	memTableCursor struct {
+		memTable *memTable
+		node     *skipListNode
+		reverse  bool
+		lower    string
+		upper    string
+	}
+)
+
+func newMemTable() *memTable {
+	m := &memTable{
+		head: &skipListNode{next: make([]atomic.Pointer[skipListNode], maxSkipListHeight)},
+		rand: rand.New(rand.NewPCG(rand.Uint64(), rand.Uint64())),
+	}
+	m.height.Store(1)
+	return m
+}
+
+// put sets the entry of the key. It must not be called concurrently with itself.
+func (m *memTable) put(key string, e entry) {
+	var prev [maxSkipListHeight]*skipListNode
+	node := m.findGreaterOrEqual(key, prev[:])
+	if node != nil && node.key == key {
+		old := node.entry.Swap(&e)
+		m.size.Add(int64(len(e.value) - len(old.value)))
+		return
+	}
+
+	height := m.randomHeight()
+	if current := int(m.height.Load()); height > current {
+		for level := current; level < height; level++ {
+			prev[level] = m.head
+		}
+		m.height.Store(int32(height))
+	}
+	node = &skipListNode{key: key, next: make([]atomic.Pointer[skipListNode], height)}
+	node.entry.Store(&e)
+	for level := 0; level < height; level++ {
+		node.next[level].Store(prev[level].next[level].Load())
+		prev[level].next[level].Store(node)
+	}
+	m.size.Add(int64(len(key)+len(e.value)) + skipListNodeOverhead)
+	m.count.Add(1)
+}
+
+func (m *memTable) get(key string) (entry, bool) {
+	node := m.findGreaterOrEqual(key, nil)
+	if node == nil || node.key != key {
+		return entry{}, false
+	}
+	return *node.entry.Load(), true
+}
+
+func (m *memTable) len() int {
+	return int(m.count.Load())
+}
+
+func (m *memTable) randomHeight() int {
+	height := 1
+	for height < maxSkipListHeight && m.rand.IntN(4) == 0 {
+		height++
+	}
+	return height
+}
+
+// findGreaterOrEqual returns the first node with a key greater than or equal to key, or nil. If prev is not
+// nil, it is filled with the last node before that key at every level.
+func (m *memTable) findGreaterOrEqual(key string, prev []*skipListNode) *skipListNode {
+	x := m.head
+	level := int(m.height.Load()) - 1
+	for {
+		next := x.next[level].Load()
+		if next != nil && next.key < key {
+			x = next
+			continue
+		}
+		if prev != nil {
+			prev[level] = x
+		}
+		if level == 0 {
+			return next
+		}
+		level--
+	}
+}
+
+// findLessThan returns the last node with a key less than key, or the last node if key is empty. It returns
+// nil if there is no such node.
+func (m *memTable) findLessThan(key string) *skipListNode {
+	x := m.head
+	level := int(m.height.Load()) - 1
+	for {
+		next := x.next[level].Load()
+		if next != nil && (key == "" || next.key < key) {
+			x = next
+			continue
+		}
+		if level == 0 {
+			if x == m.head {
+				return nil
+			}
+			return x
+		}
+		level--
+	}
+}
+
+// cursor returns a cursor over keys in [lower, upper), an empty upper bound means no upper bound.
+func (m *memTable) cursor(lower string, upper string, reverse bool) cursor {
+	c := &memTableCursor{memTable: m, reverse: reverse, lower: lower, upper: upper}
+	if reverse {
+		c.node = m.findLessThan(upper)
+	} else {
+		c.node = m.findGreaterOrEqual(lower, nil)
+	}
+	c.checkBounds()
+	return c
+}
+
+func (c *memTableCursor) checkBounds() {
+	if c.node == nil {
+		return
+	}
+	if c.reverse && c.node.key < c.lower || !c.reverse && c.upper != "" && c.node.key >= c.upper {
+		c.node = nil
+	}
+}
+
+func (c *memTableCursor) Valid() bool {
+	return c.node != nil
+}
+
+func (c *memTableCursor) Key() string {
+	return c.node.key
+}
+
+func (c *memTableCursor) Entry() entry {
+	return *c.node.entry.Load()
+}
+
+func (c *memTableCursor) Next() {
+	if c.reverse {
+		// nodes have no backward links, the previous node is found from the top of the skip list
+		if c.node.key == "" {
+			c.node = nil
+			return
+		}
+		c.node = c.memTable.findLessThan(c.node.key)
+	} else {
+		c.node = c.node.next[0].Load()
+	}
+	c.checkBounds()
+}
+
+func (c *memTableCursor) Err() error {
+	return nil
+}
@@ -0,0 +1,464 @@
+package kv
+
+import (
+	"bufio"
+	"encoding/binary"
+	"fmt"
+	"hash/crc32"
+	"hash/fnv"
+	"os"
+	"sort"
+	"sync/atomic"
+)
+
+// A table is an immutable sorted file written when a memtable is flushed or when tables are compacted:
+//
+//	data block ... | index | filter | footer
+//
+// A data block holds the entries of a range of keys, encoded like write-ahead log operations, followed by the
+// crc32 of the entries. The index has the last key, offset and length of every data block, and the filter is
+// a bloom filter of all keys of the table. The footer is:
+//
+//	index offset (uint64) | index length (uint64) | filter length (uint64) | entry count (uint64) |
+//	crc32 of index and filter (uint32) | tableMagic (uint32)
+//
+// Only the index and the filter are kept in memory while the table is open, so the memory used by a table
+// grows with the number of blocks rather than the number of keys. Data blocks are read from the file on
+// demand, the OS page cache keeps the hot ones in memory.
+
+const (
+	tableMagic      uint32 = 0x6b767432 // "kvt2"
+	tableFooterSize        = 40
+
+	// tableBlockSize is the size after which a data block is closed, blocks holding large values are larger.
+	tableBlockSize = 4096
+	blockCRCSize   = 4
+
+	bloomBitsPerKey = 10
+	bloomHashCount  = 7
+)
+
+type (
+	table struct {
+		name  string
+		path  string
+		file  *os.File
+		size  int64
+		count uint64
+
+		index  []blockHandle
+		filter bloomFilter
+
+		// refs counts the versions and compactions using the table. The file is closed when the last one
+		// releases it, and removed if the table was replaced by a compaction.
+		refs     atomic.Int32
+		obsolete atomic.Bool
+	}
+
+	blockHandle struct {
+		lastKey string
+		offset  int64
+		length  int
+	}
+
+	blockEntry struct {
+		key   string
+		entry entry
+	}
+
+	tableWriter struct {
+		file   *os.File
+		writer *bufio.Writer
+		offset int64
+		count  uint64
+
+		block   []byte
+		lastKey string
+		index   []blockHandle
+		keys    []uint64 // hashes of all keys, for the filter
+	}
+
+	tableCursor struct {
+		table   *table
+		reverse bool
+		lower   string
+		upper   string
+
+		block   int
+		entries []blockEntry
+		index   int
+		err     error
+	}
+
+	bloomFilter []byte
+)
+
+func newTableWriter(path string) (*tableWriter, error) {
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0o644)
+	if err != nil {
+		return nil, err
+	}
+	return &tableWriter{file: file, writer: bufio.NewWriter(file)}, nil
+}
+
+// add adds an entry, keys must be added in increasing order.
+func (w *tableWriter) add(key string, e entry) error {
+	w.block = appendOperation(w.block, key, e)
+	w.lastKey = key
+	w.keys = append(w.keys, bloomHash(key))
+	w.count++
+	if len(w.block) >= tableBlockSize {
+		return w.finishBlock()
+	}
+	return nil
+}
+
+func (w *tableWriter) finishBlock() error {
+	if len(w.block) == 0 {
+		return nil
+	}
+	length := len(w.block)
+	w.block = binary.LittleEndian.AppendUint32(w.block, crc32.Checksum(w.block, crcTable))
+	if _, err := w.writer.Write(w.block); err != nil {
+		return err
+	}
+	w.index = append(w.index, blockHandle{lastKey: w.lastKey, offset: w.offset, length: length})
+	w.offset += int64(len(w.block))
+	w.block = w.block[:0]
+	return nil
+}
+
+// finish writes the index, filter and footer and syncs the file. The writer can't be used afterwards.
+func (w *tableWriter) finish() error {
+	defer func() { _ = w.file.Close() }()
+	if err := w.finishBlock(); err != nil {
+		return err
+	}
+
+	var meta []byte
+	for _, h := range w.index {
+		meta = binary.AppendUvarint(meta, uint64(len(h.lastKey)))
+		meta = append(meta, h.lastKey...)
+		meta = binary.AppendUvarint(meta, uint64(h.offset))
+		meta = binary.AppendUvarint(meta, uint64(h.length))
+	}
+	indexLength := len(meta)
+	meta = append(meta, newBloomFilter(w.keys)...)
+
+	footer := make([]byte, 0, tableFooterSize)
+	footer = binary.LittleEndian.AppendUint64(footer, uint64(w.offset))
+	footer = binary.LittleEndian.AppendUint64(footer, uint64(indexLength))
+	footer = binary.LittleEndian.AppendUint64(footer, uint64(len(meta)-indexLength))
+	footer = binary.LittleEndian.AppendUint64(footer, w.count)
+	footer = binary.LittleEndian.AppendUint32(footer, crc32.Checksum(meta, crcTable))
+	footer = binary.LittleEndian.AppendUint32(footer, tableMagic)
+	if _, err := w.writer.Write(meta); err != nil {
+		return err
+	}
+	if _, err := w.writer.Write(footer); err != nil {
+		return err
+	}
+	if err := w.writer.Flush(); err != nil {
+		return err
+	}
+	return w.file.Sync()
+}
+
+// abort discards the table being written.
+func (w *tableWriter) abort() {
+	_ = w.file.Close()
+	_ = os.Remove(w.file.Name())
+}
+
+// writeTable writes all entries of the cursor to a new table file. Tombstones are dropped if dropTombstones
+// is set, which is only safe when the output includes the oldest table of the tree.
+func writeTable(path string, c cursor, dropTombstones bool) error {
+	w, err := newTableWriter(path)
+	if err != nil {
+		return err
+	}
+	for ; c.Valid(); c.Next() {
+		e := c.Entry()
+		if e.deleted && dropTombstones {
+			continue
+		}
+		if err := w.add(c.Key(), e); err != nil {
+			w.abort()
+			return err
+		}
+	}
+	if err := c.Err(); err != nil {
+		w.abort()
+		return err
+	}
+	if err := w.finish(); err != nil {
+		_ = os.Remove(path)
+		return err
+	}
+	return nil
+}
+
+func openTable(path string, name string) (*table, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	t, err := readTableMetadata(file, path, name)
+	if err != nil {
+		_ = file.Close()
+		return nil, err
+	}
+	return t, nil
+}
+
+func readTableMetadata(file *os.File, path string, name string) (*table, error) {
+	info, err := file.Stat()
+	if err != nil {
+		return nil, err
+	}
+	size := info.Size()
+	corrupted := fmt.Errorf("table %v: %w", name, errCorruptedRecord)
+	if size < tableFooterSize {
+		return nil, corrupted
+	}
+	footer := make([]byte, tableFooterSize)
+	if _, err := file.ReadAt(footer, size-tableFooterSize); err != nil {
+		return nil, err
+	}
+	indexOffset := binary.LittleEndian.Uint64(footer[0:8])
+	indexLength := binary.LittleEndian.Uint64(footer[8:16])
+	filterLength := binary.LittleEndian.Uint64(footer[16:24])
+	if binary.LittleEndian.Uint32(footer[36:40]) != tableMagic ||
+		indexOffset+indexLength+filterLength+tableFooterSize != uint64(size) {
+		return nil, corrupted
+	}
+	meta := make([]byte, indexLength+filterLength)
+	if _, err := file.ReadAt(meta, int64(indexOffset)); err != nil {
+		return nil, err
+	}
+	if crc32.Checksum(meta, crcTable) != binary.LittleEndian.Uint32(footer[32:36]) {
+		return nil, corrupted
+	}
+
+	t := &table{
+		name:   name,
+		path:   path,
+		file:   file,
+		size:   size,
+		count:  binary.LittleEndian.Uint64(footer[24:32]),
+		filter: bloomFilter(meta[indexLength:]),
+	}
+	data := meta[:indexLength]
+	for len(data) > 0 {
+		lastKey, rest, err := readLengthPrefixed(data)
+		if err != nil {
+			return nil, corrupted
+		}
+		offset, n := binary.Uvarint(rest)
+		if n <= 0 {
+			return nil, corrupted
+		}
+		rest = rest[n:]
+		length, n := binary.Uvarint(rest)
+		if n <= 0 {
+			return nil, corrupted
+		}
+		data = rest[n:]
+		t.index = append(t.index, blockHandle{lastKey: string(lastKey), offset: int64(offset), length: int(length)})
+	}
+	return t, nil
+}
+
+func (t *table) ref() {
+	t.refs.Add(1)
+}
+
+func (t *table) unref() {
+	if t.refs.Add(-1) > 0 {
+		return
+	}
+	_ = t.file.Close()
+	if t.obsolete.Load() {
+		_ = os.Remove(t.path)
+	}
+}
+
+// readBlock reads and decodes the entries of a data block.
+func (t *table) readBlock(i int) ([]blockEntry, error) {
+	h := t.index[i]
+	data := make([]byte, h.length+blockCRCSize)
+	if _, err := t.file.ReadAt(data, h.offset); err != nil {
+		return nil, fmt.Errorf("table %v: %w", t.name, err)
+	}
+	if crc32.Checksum(data[:h.length], crcTable) != binary.LittleEndian.Uint32(data[h.length:]) {
+		return nil, fmt.Errorf("table %v: %w", t.name, errCorruptedRecord)
+	}
+	var entries []blockEntry
+	data = data[:h.length]
+	for len(data) > 0 {
+		key, e, rest, err := decodeOperation(data)
+		if err != nil {
+			return nil, fmt.Errorf("table %v: %w", t.name, err)
+		}
+		entries = append(entries, blockEntry{key: key, entry: e})
+		data = rest
+	}
+	return entries, nil
+}
+
+// findBlock returns the first block whose last key is greater than or equal to key.
+func (t *table) findBlock(key string) int {
+	return sort.Search(len(t.index), func(i int) bool {
+		return t.index[i].lastKey >= key
+	})
+}
+
+func (t *table) get(key string) (entry, bool, error) {
+	if !t.filter.mayContain(key) {
+		return entry{}, false, nil
+	}
+	i := t.findBlock(key)
+	if i == len(t.index) {
+		return entry{}, false, nil
+	}
+	entries, err := t.readBlock(i)
+	if err != nil {
+		return entry{}, false, err
+	}
+	j := sort.Search(len(entries), func(j int) bool {
+		return entries[j].key >= key
+	})
+	if j == len(entries) || entries[j].key != key {
+		return entry{}, false, nil
+	}
+	return entries[j].entry, true, nil
+}
+
+// cursor returns a cursor over keys in [lower, upper), an empty upper bound means no upper bound.
+func (t *table) cursor(lower string, upper string, reverse bool) cursor {
+	c := &tableCursor{table: t, reverse: reverse, lower: lower, upper: upper}
+	if reverse {
+		c.block = len(t.index) - 1
+		if upper != "" {
+			c.block = min(t.findBlock(upper), len(t.index)-1)
+		}
+	} else {
+		c.block = t.findBlock(lower)
+	}
+	c.loadBlock()
+	if c.entries != nil {
+		if reverse {
+			c.index = sort.Search(len(c.entries), func(j int) bool {
+				return upper != "" && c.entries[j].key >= upper
+			}) - 1
+		} else {
+			c.index = sort.Search(len(c.entries), func(j int) bool {
+				return c.entries[j].key >= lower
+			})
+		}
+	}
+	c.settle()
+	return c
+}
+
+func (c *tableCursor) loadBlock() {
+	c.entries = nil
+	if c.block < 0 || c.block >= len(c.table.index) {
+		return
+	}
+	if c.entries, c.err = c.table.readBlock(c.block); c.err != nil {
+		c.entries = nil
+		return
+	}
+	if c.reverse {
+		c.index = len(c.entries) - 1
+	} else {
+		c.index = 0
+	}
+}
+
+// settle moves to the next block when the cursor is past the current one, and invalidates the cursor when
+// it is out of bounds.
+func (c *tableCursor) settle() {
+	for c.entries != nil && (c.index < 0 || c.index >= len(c.entries)) {
+		if c.reverse {
+			c.block--
+		} else {
+			c.block++
+		}
+		c.loadBlock()
+	}
+	if c.entries == nil {
+		return
+	}
+	key := c.entries[c.index].key
+	if c.reverse && key < c.lower || !c.reverse && c.upper != "" && key >= c.upper {
+		c.entries = nil
+	}
+}
+
+func (c *tableCursor) Valid() bool {
+	return c.entries != nil
+}
+
+func (c *tableCursor) Key() string {
+	return c.entries[c.index].key
+}
+
+func (c *tableCursor) Entry() entry {
+	return c.entries[c.index].entry
+}
+
+func (c *tableCursor) Next() {
+	if c.reverse {
+		c.index--
+	} else {
+		c.index++
+	}
+	c.settle()
+}
+
+func (c *tableCursor) Err() error {
+	return c.err
+}
+
+func bloomHash(key string) uint64 {
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(key))
+	return h.Sum64()
+}
+
+func newBloomFilter(hashes []uint64) bloomFilter {
+	bits := max(64, len(hashes)*bloomBitsPerKey)
+	filter := make(bloomFilter, (bits+7)/8)
+	for _, h := range hashes {
+		filter.add(h)
+	}
+	return filter
+}
+
+// add sets the bits of a key hash, using double hashing to derive the bit positions.
+func (f bloomFilter) add(h uint64) {
+	bits := uint64(len(f) * 8)
+	h1, h2 := h, h>>33|h<<31
+	for i := uint64(0); i < bloomHashCount; i++ {
+		bit := (h1 + i*h2) % bits
+		f[bit/8] |= 1 << (bit % 8)
+	}
+}
+
+func (f bloomFilter) mayContain(key string) bool {
+	if len(f) == 0 {
+		return true
+	}
+	bits := uint64(len(f) * 8)
+	h := bloomHash(key)
+	h1, h2 := h, h>>33|h<<31
+	for i := uint64(0); i < bloomHashCount; i++ {
+		bit := (h1 + i*h2) % bits
+		if f[bit/8]&(1<<(bit%8)) == 0 {
+			return false
+		}
+	}
+	return true
+}
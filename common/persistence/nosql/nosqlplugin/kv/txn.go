@@ -0,0 +1,104 @@
+package kv
+
+import (
+	"bytes"
+	"fmt"
+)
+
+type (
+	// Txn is a transaction on the key ranges it was started with. Reads observe the writes made earlier in
+	// the same transaction, and no other transaction writes to the ranges while the transaction runs, so all
+	// reads of a transaction observe the same state of its ranges. Keys outside of the ranges can't be used.
+	Txn struct {
+		db       *DB
+		ranges   [][]byte
+		writable bool
+		writes   *memTable
+		err      error
+	}
+)
+
+// Get returns the value of the key, or ErrNotFound.
+func (t *Txn) Get(key []byte) ([]byte, error) {
+	if err := t.check(key); err != nil {
+		return nil, err
+	}
+	if e, ok := t.writes.get(string(key)); ok {
+		return liveValue(e)
+	}
+	return t.db.Get(key)
+}
+
+// Scan calls fn for all keys in [lower, upper) in key order, or in reverse key order, until fn returns
+// false. A nil upper bound means no upper bound. fn must not use the database, but may use the transaction.
+func (t *Txn) Scan(lower []byte, upper []byte, reverse bool, fn func(key []byte, value []byte) bool) error {
+	// Collect the entries first, so that fn can write to the transaction.
+	var keys, values [][]byte
+	var rangeErr error
+	err := t.db.scan(t.writes, lower, upper, reverse, func(key []byte, value []byte) bool {
+		if rangeErr = t.check(key); rangeErr != nil {
+			return false
+		}
+		keys = append(keys, key)
+		values = append(values, value)
+		return true
+	})
+	if err != nil {
+		return err
+	}
+	if rangeErr != nil {
+		return rangeErr
+	}
+	for i := range keys {
+		if !fn(keys[i], values[i]) {
+			return nil
+		}
+	}
+	return nil
+}
+
+// Set sets the value of the key.
+func (t *Txn) Set(key []byte, value []byte) {
+	if err := t.checkWrite(key); err != nil {
+		if t.err == nil {
+			t.err = err
+		}
+		return
+	}
+	t.writes.put(string(key), entry{value: bytes.Clone(value)})
+}
+
+// Delete deletes the key.
+func (t *Txn) Delete(key []byte) {
+	if err := t.checkWrite(key); err != nil {
+		if t.err == nil {
+			t.err = err
+		}
+		return
+	}
+	t.writes.put(string(key), entry{deleted: true})
+}
+
+// DeleteRange deletes all keys in [lower, upper).
+func (t *Txn) DeleteRange(lower []byte, upper []byte) error {
+	return t.Scan(lower, upper, false, func(key []byte, _ []byte) bool {
+		t.Delete(key)
+		return true
+	})
+}
+
+func (t *Txn) check(key []byte) error {
+	for _, r := range t.ranges {
+		if bytes.HasPrefix(key, r) {
+			return nil
+		}
+	}
+	return fmt.Errorf("%w: %q", ErrKeyNotInRanges, key)
+}
+
+func (t *Txn) checkWrite(key []byte) error {
+	if !t.writable {
+		return ErrReadOnlyTxn
+	}
+	return t.check(key)
+}
@@ -0,0 +1,183 @@
+package kv
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"hash/crc32"
+	"io"
+	"os"
+)
+
+// The write-ahead log is a sequence of records, one per committed batch:
+//
+//	payload length (uint32) | crc32 of payload (uint32) | payload
+//
+// and the payload is a sequence of operations:
+//
+//	kind (byte) | key length (uvarint) | key | value length (uvarint) | value
+//
+// A torn or corrupted record at the end of the log is the result of a crash in the middle of a write. The
+// batch was never acknowledged, so replay stops there and the log is truncated.
+
+const (
+	opSet    byte = 1
+	opDelete byte = 2
+
+	walRecordHeaderSize = 8
+)
+
+var (
+	errCorruptedRecord = errors.New("corrupted record")
+	crcTable           = crc32.MakeTable(crc32.Castagnoli)
+)
+
+type (
+	writeAheadLog struct {
+		name       string
+		file       *os.File
+		syncWrites bool
+	}
+)
+
+func openWriteAheadLog(path string, name string, syncWrites bool) (*writeAheadLog, error) {
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_RDWR, 0o644)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := file.Seek(0, io.SeekEnd); err != nil {
+		_ = file.Close()
+		return nil, err
+	}
+	return &writeAheadLog{name: name, file: file, syncWrites: syncWrites}, nil
+}
+
+// replayWriteAheadLog applies all intact records of the log to the memtable and truncates the log after the
+// last one.
+func replayWriteAheadLog(path string, m *memTable) error {
+	file, err := os.OpenFile(path, os.O_RDWR, 0o644)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	defer func() { _ = file.Close() }()
+
+	reader := bufio.NewReader(file)
+	var offset int64
+	for {
+		payload, err := readWALRecord(reader)
+		if err != nil {
+			break
+		}
+		// decode the whole batch before applying it, batches are atomic
+		batch := newMemTable()
+		if err := decodeBatch(payload, batch.put); err != nil {
+			break
+		}
+		for c := batch.cursor("", "", false); c.Valid(); c.Next() {
+			m.put(c.Key(), c.Entry())
+		}
+		offset += walRecordHeaderSize + int64(len(payload))
+	}
+	return file.Truncate(offset)
+}
+
+func readWALRecord(reader io.Reader) ([]byte, error) {
+	var header [walRecordHeaderSize]byte
+	if _, err := io.ReadFull(reader, header[:]); err != nil {
+		return nil, err
+	}
+	payload := make([]byte, binary.LittleEndian.Uint32(header[0:4]))
+	if _, err := io.ReadFull(reader, payload); err != nil {
+		return nil, err
+	}
+	if crc32.Checksum(payload, crcTable) != binary.LittleEndian.Uint32(header[4:8]) {
+		return nil, errCorruptedRecord
+	}
+	return payload, nil
+}
+
+func (w *writeAheadLog) append(payload []byte) error {
+	record := make([]byte, walRecordHeaderSize, walRecordHeaderSize+len(payload))
+	binary.LittleEndian.PutUint32(record[0:4], uint32(len(payload)))
+	binary.LittleEndian.PutUint32(record[4:8], crc32.Checksum(payload, crcTable))
+	record = append(record, payload...)
+	if _, err := w.file.Write(record); err != nil {
+		return err
+	}
+	if w.syncWrites {
+		return w.file.Sync()
+	}
+	return nil
+}
+
+func (w *writeAheadLog) close() error {
+	return w.file.Close()
+}
+
+func encodeBatch(m *memTable) []byte {
+	var payload []byte
+	for c := m.cursor("", "", false); c.Valid(); c.Next() {
+		payload = appendOperation(payload, c.Key(), c.Entry())
+	}
+	return payload
+}
+
+func appendOperation(buf []byte, key string, e entry) []byte {
+	if e.deleted {
+		buf = append(buf, opDelete)
+	} else {
+		buf = append(buf, opSet)
+	}
+	buf = binary.AppendUvarint(buf, uint64(len(key)))
+	buf = append(buf, key...)
+	buf = binary.AppendUvarint(buf, uint64(len(e.value)))
+	return append(buf, e.value...)
+}
+
+// decodeOperation decodes the operation at the start of data and returns the rest of data.
+func decodeOperation(data []byte) (string, entry, []byte, error) {
+	if len(data) == 0 {
+		return "", entry{}, nil, errCorruptedRecord
+	}
+	kind := data[0]
+	key, rest, err := readLengthPrefixed(data[1:])
+	if err != nil {
+		return "", entry{}, nil, err
+	}
+	value, rest, err := readLengthPrefixed(rest)
+	if err != nil {
+		return "", entry{}, nil, err
+	}
+	switch kind {
+	case opSet:
+		return string(key), entry{value: value}, rest, nil
+	case opDelete:
+		return string(key), entry{deleted: true}, rest, nil
+	default:
+		return "", entry{}, nil, errCorruptedRecord
+	}
+}
+
+func decodeBatch(payload []byte, fn func(key string, e entry)) error {
+	for len(payload) > 0 {
+		key, e, rest, err := decodeOperation(payload)
+		if err != nil {
+			return err
+		}
+		fn(key, e)
+		payload = rest
+	}
+	return nil
+}
+
+func readLengthPrefixed(data []byte) ([]byte, []byte, error) {
+	length, n := binary.Uvarint(data)
+	if n <= 0 || uint64(len(data)-n) < length {
+		return nil, nil, errCorruptedRecord
+	}
+	data = data[n:]
+	return data[:length:length], data[length:], nil
+}
@@ -69,6 +69,7 @@ type (
 		CreateTasks(ctx context.Context, request *InternalCreateTasksRequest) (*CreateTasksResponse, error)
 		GetTasks(ctx context.Context, request *GetTasksRequest) (*InternalGetTasksResponse, error)
 		CompleteTasksLessThan(ctx context.Context, request *CompleteTasksLessThanRequest) (int, error)
+		CompleteTask(ctx context.Context, request *CompleteTaskRequest) error
 		GetTaskQueueUserData(ctx context.Context, request *GetTaskQueueUserDataRequest) (*InternalGetTaskQueueUserDataResponse, error)
 		UpdateTaskQueueUserData(ctx context.Context, request *InternalUpdateTaskQueueUserDataRequest) error
 		ListTaskQueueUserDataEntries(ctx context.Context, request *ListTaskQueueUserDataEntriesRequest) (*InternalListTaskQueueUserDataEntriesResponse, error)
@@ -124,6 +125,7 @@ type (
 
 		// Scan related methods
 		ListConcreteExecutions(ctx context.Context, request *ListConcreteExecutionsRequest) (*InternalListConcreteExecutionsResponse, error)
+		ListCurrentExecutions(ctx context.Context, request *ListCurrentExecutionsRequest) (*InternalListCurrentExecutionsResponse, error)
 
 		// Tasks related APIs
 
@@ -544,6 +546,12 @@ type (
 		NextPageToken []byte
 	}
 
+	// InternalListCurrentExecutionsResponse is the response to ListCurrentExecutions for Persistence Interface
+	InternalListCurrentExecutionsResponse struct {
+		Executions    []*CurrentWorkflowExecution `json:",omitempty"`
+		NextPageToken []byte
+	}
+
 	InternalGetHistoryTaskResponse struct {
 		InternalHistoryTask
 	}
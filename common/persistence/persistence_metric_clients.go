@@ -330,6 +330,19 @@ func (p *executionPersistenceClient) ListConcreteExecutions(
 	return p.persistence.ListConcreteExecutions(ctx, request)
 }
 
+func (p *executionPersistenceClient) ListCurrentExecutions(
+	ctx context.Context,
+	request *ListCurrentExecutionsRequest,
+) (_ *ListCurrentExecutionsResponse, retErr error) {
+	caller := headers.GetCallerInfo(ctx).CallerName
+	startTime := time.Now().UTC()
+	defer func() {
+		p.healthSignals.Record(request.ShardID, caller, time.Since(startTime), retErr)
+		p.recordRequestMetrics(metrics.PersistenceListCurrentExecutionsScope, caller, time.Since(startTime), retErr)
+	}()
+	return p.persistence.ListCurrentExecutions(ctx, request)
+}
+
 func (p *executionPersistenceClient) AddHistoryTasks(
 	ctx context.Context,
 	request *AddHistoryTasksRequest,
@@ -548,6 +561,19 @@ func (p *taskPersistenceClient) CompleteTasksLessThan(
 	return p.persistence.CompleteTasksLessThan(ctx, request)
 }
 
+func (p *taskPersistenceClient) CompleteTask(
+	ctx context.Context,
+	request *CompleteTaskRequest,
+) (retErr error) {
+	caller := headers.GetCallerInfo(ctx).CallerName
+	startTime := time.Now().UTC()
+	defer func() {
+		p.healthSignals.Record(CallerSegmentMissing, caller, time.Since(startTime), retErr)
+		p.recordRequestMetrics(metrics.PersistenceCompleteTaskScope, caller, time.Since(startTime), retErr)
+	}()
+	return p.persistence.CompleteTask(ctx, request)
+}
+
 func (p *taskPersistenceClient) CreateTaskQueue(
 	ctx context.Context,
 	request *CreateTaskQueueRequest,
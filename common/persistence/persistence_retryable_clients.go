@@ -331,6 +331,21 @@ func (p *executionRetryablePersistenceClient) ListConcreteExecutions(
 	return response, err
 }
 
+func (p *executionRetryablePersistenceClient) ListCurrentExecutions(
+	ctx context.Context,
+	request *ListCurrentExecutionsRequest,
+) (*ListCurrentExecutionsResponse, error) {
+	var response *ListCurrentExecutionsResponse
+	op := func(ctx context.Context) error {
+		var err error
+		response, err = p.persistence.ListCurrentExecutions(ctx, request)
+		return err
+	}
+
+	err := backoff.ThrottleRetryContext(ctx, op, p.policy, p.isRetryable)
+	return response, err
+}
+
 func (p *executionRetryablePersistenceClient) AddHistoryTasks(
 	ctx context.Context,
 	request *AddHistoryTasksRequest,
@@ -650,6 +665,17 @@ func (p *taskRetryablePersistenceClient) CompleteTasksLessThan(
 	return response, err
 }
 
+func (p *taskRetryablePersistenceClient) CompleteTask(
+	ctx context.Context,
+	request *CompleteTaskRequest,
+) error {
+	op := func(ctx context.Context) error {
+		return p.persistence.CompleteTask(ctx, request)
+	}
+
+	return backoff.ThrottleRetryContext(ctx, op, p.policy, p.isRetryable)
+}
+
 func (p *taskRetryablePersistenceClient) CreateTaskQueue(
 	ctx context.Context,
 	request *CreateTaskQueueRequest,
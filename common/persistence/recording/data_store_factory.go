@@ -0,0 +1,172 @@
+package recording
+
+import (
+	"slices"
+
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/persistence"
+)
+
+type (
+	// RecordingDataStoreFactory wraps the stores of a data store factory to record their calls.
+	RecordingDataStoreFactory struct {
+		baseFactory persistence.DataStoreFactory
+		cfg         *config.Recording
+		recorder    *recorder
+
+		taskStore          persistence.TaskStore
+		shardStore         persistence.ShardStore
+		metadataStore      persistence.MetadataStore
+		executionStore     persistence.ExecutionStore
+		queue              persistence.Queue
+		queueV2            persistence.QueueV2
+		clusterMDStore     persistence.ClusterMetadataStore
+		nexusEndpointStore persistence.NexusEndpointStore
+	}
+)
+
+func NewRecordingDataStoreFactory(
+	cfg *config.Recording,
+	baseFactory persistence.DataStoreFactory,
+	logger log.Logger,
+) (*RecordingDataStoreFactory, error) {
+	recorder, err := acquireRecorder(cfg.Path, logger)
+	if err != nil {
+		return nil, err
+	}
+	return &RecordingDataStoreFactory{
+		baseFactory: baseFactory,
+		cfg:         cfg,
+		recorder:    recorder,
+	}, nil
+}
+
+func (d *RecordingDataStoreFactory) Close() {
+	d.baseFactory.Close()
+	d.recorder.release()
+}
+
+func (d *RecordingDataStoreFactory) NewTaskStore() (persistence.TaskStore, error) {
+	if d.taskStore == nil {
+		baseStore, err := d.baseFactory.NewTaskStore()
+		if err != nil {
+			return nil, err
+		}
+		if d.isRecorded(config.TaskStoreName) {
+			d.taskStore = newRecordingTaskStore(baseStore, d.recorder)
+		} else {
+			d.taskStore = baseStore
+		}
+	}
+	return d.taskStore, nil
+}
+
+func (d *RecordingDataStoreFactory) NewShardStore() (persistence.ShardStore, error) {
+	if d.shardStore == nil {
+		baseStore, err := d.baseFactory.NewShardStore()
+		if err != nil {
+			return nil, err
+		}
+		if d.isRecorded(config.ShardStoreName) {
+			d.shardStore = newRecordingShardStore(baseStore, d.recorder)
+		} else {
+			d.shardStore = baseStore
+		}
+	}
+	return d.shardStore, nil
+}
+
+func (d *RecordingDataStoreFactory) NewMetadataStore() (persistence.MetadataStore, error) {
+	if d.metadataStore == nil {
+		baseStore, err := d.baseFactory.NewMetadataStore()
+		if err != nil {
+			return nil, err
+		}
+		if d.isRecorded(config.MetadataStoreName) {
+			d.metadataStore = newRecordingMetadataStore(baseStore, d.recorder)
+		} else {
+			d.metadataStore = baseStore
+		}
+	}
+	return d.metadataStore, nil
+}
+
+func (d *RecordingDataStoreFactory) NewExecutionStore() (persistence.ExecutionStore, error) {
+	if d.executionStore == nil {
+		baseStore, err := d.baseFactory.NewExecutionStore()
+		if err != nil {
+			return nil, err
+		}
+		if d.isRecorded(config.ExecutionStoreName) {
+			d.executionStore = newRecordingExecutionStore(baseStore, d.recorder)
+		} else {
+			d.executionStore = baseStore
+		}
+	}
+	return d.executionStore, nil
+}
+
+func (d *RecordingDataStoreFactory) NewQueue(queueType persistence.QueueType) (persistence.Queue, error) {
+	if d.queue == nil {
+		baseQueue, err := d.baseFactory.NewQueue(queueType)
+		if err != nil {
+			return baseQueue, err
+		}
+		if d.isRecorded(config.QueueName) {
+			d.queue = newRecordingQueue(baseQueue, d.recorder)
+		} else {
+			d.queue = baseQueue
+		}
+	}
+	return d.queue, nil
+}
+
+func (d *RecordingDataStoreFactory) NewQueueV2() (persistence.QueueV2, error) {
+	if d.queueV2 == nil {
+		baseQueue, err := d.baseFactory.NewQueueV2()
+		if err != nil {
+			return baseQueue, err
+		}
+		if d.isRecorded(config.QueueV2Name) {
+			d.queueV2 = newRecordingQueueV2(baseQueue, d.recorder)
+		} else {
+			d.queueV2 = baseQueue
+		}
+	}
+	return d.queueV2, nil
+}
+
+func (d *RecordingDataStoreFactory) NewClusterMetadataStore() (persistence.ClusterMetadataStore, error) {
+	if d.clusterMDStore == nil {
+		baseStore, err := d.baseFactory.NewClusterMetadataStore()
+		if err != nil {
+			return nil, err
+		}
+		if d.isRecorded(config.ClusterMDStoreName) {
+			d.clusterMDStore = newRecordingClusterMetadataStore(baseStore, d.recorder)
+		} else {
+			d.clusterMDStore = baseStore
+		}
+	}
+	return d.clusterMDStore, nil
+}
+
+func (d *RecordingDataStoreFactory) NewNexusEndpointStore() (persistence.NexusEndpointStore, error) {
+	if d.nexusEndpointStore == nil {
+		baseStore, err := d.baseFactory.NewNexusEndpointStore()
+		if err != nil {
+			return nil, err
+		}
+		if d.isRecorded(config.NexusEndpointStoreName) {
+			d.nexusEndpointStore = newRecordingNexusEndpointStore(baseStore, d.recorder)
+		} else {
+			d.nexusEndpointStore = baseStore
+		}
+	}
+	return d.nexusEndpointStore, nil
+}
+
+func (d *RecordingDataStoreFactory) isRecorded(name config.DataStoreName) bool {
+	return len(d.cfg.DataStores) == 0 || slices.Contains(d.cfg.DataStores, name)
+}
@@ -0,0 +1,153 @@
+package recording
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"reflect"
+
+	"go.temporal.io/api/serviceerror"
+	p "go.temporal.io/server/common/persistence"
+	serviceerrors "go.temporal.io/server/common/serviceerror"
+	spb "google.golang.org/genproto/googleapis/rpc/status"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+type (
+	// errorRecord is the serialized form of an error returned by a store. Callers of the stores check errors by
+	// identity, type and gRPC status, so all three are preserved when possible.
+	errorRecord struct {
+		Message string `json:"message"`
+		// Sentinel is the name of a well known error value that the error wraps.
+		Sentinel string `json:"sentinel,omitempty"`
+		// Type is the name of the persistence error type of the error, and Details is its JSON encoding.
+		Type    string          `json:"type,omitempty"`
+		Details json.RawMessage `json:"details,omitempty"`
+		// Status is the encoded gRPC status of a service error.
+		Status []byte `json:"status,omitempty"`
+	}
+
+	// replayedError is a replayed error that wraps a sentinel error, the sentinel might not be the outermost error
+	// so the original message is kept.
+	replayedError struct {
+		msg     string
+		wrapped error
+	}
+
+	sentinelError struct {
+		name string
+		err  error
+	}
+)
+
+var (
+	sentinelErrors = []sentinelError{
+		{name: "context.Canceled", err: context.Canceled},
+		{name: "context.DeadlineExceeded", err: context.DeadlineExceeded},
+		{name: "ErrQueueAlreadyExists", err: p.ErrQueueAlreadyExists},
+		{name: "ErrInvalidMembershipExpiry", err: p.ErrInvalidMembershipExpiry},
+		{name: "ErrIncompleteMembershipUpsert", err: p.ErrIncompleteMembershipUpsert},
+		{name: "ErrNexusTableVersionConflict", err: p.ErrNexusTableVersionConflict},
+		{name: "ErrNexusEndpointVersionConflict", err: p.ErrNexusEndpointVersionConflict},
+		{name: "ErrNegativeListNexusEndpointsPageSize", err: p.ErrNegativeListNexusEndpointsPageSize},
+		{name: "ErrInvalidReadQueueMessagesNextPageToken", err: p.ErrInvalidReadQueueMessagesNextPageToken},
+		{name: "ErrInvalidListQueuesNextPageToken", err: p.ErrInvalidListQueuesNextPageToken},
+		{name: "ErrNonPositiveReadQueueMessagesPageSize", err: p.ErrNonPositiveReadQueueMessagesPageSize},
+		{name: "ErrInvalidQueueRangeDeleteMaxMessageID", err: p.ErrInvalidQueueRangeDeleteMaxMessageID},
+		{name: "ErrNonPositiveListQueuesPageSize", err: p.ErrNonPositiveListQueuesPageSize},
+		{name: "ErrNegativeListQueuesOffset", err: p.ErrNegativeListQueuesOffset},
+	}
+
+	// persistenceErrorTypes are the error types that the persistence layer checks with errors.As.
+	persistenceErrorTypes = map[string]reflect.Type{
+		"InvalidPersistenceRequestError":      reflect.TypeOf(&p.InvalidPersistenceRequestError{}),
+		"AppendHistoryTimeoutError":           reflect.TypeOf(&p.AppendHistoryTimeoutError{}),
+		"CurrentWorkflowConditionFailedError": reflect.TypeOf(&p.CurrentWorkflowConditionFailedError{}),
+		"WorkflowConditionFailedError":        reflect.TypeOf(&p.WorkflowConditionFailedError{}),
+		"ConditionFailedError":                reflect.TypeOf(&p.ConditionFailedError{}),
+		"ShardAlreadyExistError":              reflect.TypeOf(&p.ShardAlreadyExistError{}),
+		"ShardOwnershipLostError":             reflect.TypeOf(&p.ShardOwnershipLostError{}),
+		"TimeoutError":                        reflect.TypeOf(&p.TimeoutError{}),
+		"TransactionSizeLimitError":           reflect.TypeOf(&p.TransactionSizeLimitError{}),
+	}
+)
+
+func (e *replayedError) Error() string {
+	return e.msg
+}
+
+func (e *replayedError) Unwrap() error {
+	return e.wrapped
+}
+
+// encodeError returns the record of err, or nil if err is nil.
+func encodeError(err error) *errorRecord {
+	if err == nil {
+		return nil
+	}
+	record := &errorRecord{Message: err.Error()}
+
+	for _, sentinel := range sentinelErrors {
+		if errors.Is(err, sentinel.err) {
+			record.Sentinel = sentinel.name
+			return record
+		}
+	}
+
+	for name, errType := range persistenceErrorTypes {
+		target := reflect.New(errType)
+		if !errors.As(err, target.Interface()) {
+			continue
+		}
+		details, marshalErr := json.Marshal(target.Elem().Interface())
+		if marshalErr != nil {
+			break
+		}
+		record.Type = name
+		record.Details = details
+		return record
+	}
+
+	if st := serviceerror.ToStatus(err); st.Code() != codes.Unknown {
+		if data, marshalErr := proto.Marshal(st.Proto()); marshalErr == nil {
+			record.Status = data
+		}
+	}
+	return record
+}
+
+// decodeError returns the error of record, or nil if record is nil.
+func decodeError(record *errorRecord) error {
+	if record == nil {
+		return nil
+	}
+
+	if record.Sentinel != "" {
+		for _, sentinel := range sentinelErrors {
+			if sentinel.name != record.Sentinel {
+				continue
+			}
+			if sentinel.err.Error() == record.Message {
+				return sentinel.err
+			}
+			return &replayedError{msg: record.Message, wrapped: sentinel.err}
+		}
+	}
+
+	if errType, ok := persistenceErrorTypes[record.Type]; ok {
+		target := reflect.New(errType.Elem())
+		if err := json.Unmarshal(record.Details, target.Interface()); err == nil {
+			return target.Interface().(error)
+		}
+	}
+
+	if len(record.Status) > 0 {
+		var st spb.Status
+		if err := proto.Unmarshal(record.Status, &st); err == nil {
+			return serviceerrors.FromStatus(status.FromProto(&st))
+		}
+	}
+	return errors.New(record.Message)
+}
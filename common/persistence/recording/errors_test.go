@@ -0,0 +1,67 @@
+package recording
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/api/serviceerror"
+	p "go.temporal.io/server/common/persistence"
+	serviceerrors "go.temporal.io/server/common/serviceerror"
+)
+
+func roundTripError(t *testing.T, err error) error {
+	data, marshalErr := json.Marshal(encodeError(err))
+	require.NoError(t, marshalErr)
+	var record *errorRecord
+	require.NoError(t, json.Unmarshal(data, &record))
+	return decodeError(record)
+}
+
+func TestErrorRoundTrip_Nil(t *testing.T) {
+	require.NoError(t, roundTripError(t, nil))
+}
+
+func TestErrorRoundTrip_Sentinel(t *testing.T) {
+	require.Equal(t, context.DeadlineExceeded, roundTripError(t, context.DeadlineExceeded))
+
+	err := fmt.Errorf("%w: queue type 1 and name test", p.ErrQueueAlreadyExists)
+	replayed := roundTripError(t, err)
+	require.ErrorIs(t, replayed, p.ErrQueueAlreadyExists)
+	require.Equal(t, err.Error(), replayed.Error())
+
+	require.Equal(t, p.ErrNexusTableVersionConflict, roundTripError(t, p.ErrNexusTableVersionConflict))
+}
+
+func TestErrorRoundTrip_PersistenceError(t *testing.T) {
+	replayed := roundTripError(t, &p.WorkflowConditionFailedError{
+		Msg:             "condition failed",
+		NextEventID:     10,
+		DBRecordVersion: 3,
+	})
+	var conditionFailed *p.WorkflowConditionFailedError
+	require.ErrorAs(t, replayed, &conditionFailed)
+	require.Equal(t, "condition failed", conditionFailed.Msg)
+	require.Equal(t, int64(10), conditionFailed.NextEventID)
+	require.Equal(t, int64(3), conditionFailed.DBRecordVersion)
+}
+
+func TestErrorRoundTrip_ServiceError(t *testing.T) {
+	replayed := roundTripError(t, serviceerror.NewNotFound("workflow not found"))
+	var notFound *serviceerror.NotFound
+	require.ErrorAs(t, replayed, &notFound)
+	require.Equal(t, "workflow not found", notFound.Error())
+
+	replayed = roundTripError(t, serviceerrors.NewShardOwnershipLost("owner", "current"))
+	var ownershipLost *serviceerrors.ShardOwnershipLost
+	require.ErrorAs(t, replayed, &ownershipLost)
+	require.Equal(t, "owner", ownershipLost.OwnerHost)
+}
+
+func TestErrorRoundTrip_OtherError(t *testing.T) {
+	replayed := roundTripError(t, errors.New("some error"))
+	require.EqualError(t, replayed, "some error")
+}
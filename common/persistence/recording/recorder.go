@@ -0,0 +1,149 @@
+package recording
+
+import (
+	"encoding/json"
+	"os"
+	"sync"
+
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+)
+
+type (
+	// callRecord is a single store call in a recording. Recordings are files of JSON encoded call records, one
+	// per line, in the order the calls completed.
+	callRecord struct {
+		Store  string `json:"store"`
+		Method string `json:"method"`
+		// Params are the parameters of the call without the context.
+		Params json.RawMessage `json:"params"`
+		// Results are the results of the call without the error.
+		Results json.RawMessage `json:"results"`
+		Error   *errorRecord    `json:"error,omitempty"`
+	}
+
+	// recorder appends the calls of all wrapped stores to a recording file.
+	recorder struct {
+		sync.Mutex
+		path     string
+		file     *os.File
+		refCount int
+		logger   log.Logger
+	}
+)
+
+var (
+	// The services of a single binary deployment each create their own factory, calls are recorded to a single
+	// file so that they can be replayed in order.
+	sharedRecordersLock sync.Mutex
+	sharedRecorders     = make(map[string]*recorder)
+)
+
+func acquireRecorder(
+	path string,
+	logger log.Logger,
+) (*recorder, error) {
+	sharedRecordersLock.Lock()
+	defer sharedRecordersLock.Unlock()
+
+	if r, ok := sharedRecorders[path]; ok {
+		r.refCount++
+		return r, nil
+	}
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+	if err != nil {
+		return nil, err
+	}
+	r := &recorder{
+		path:     path,
+		file:     file,
+		refCount: 1,
+		logger:   logger,
+	}
+	sharedRecorders[path] = r
+	return r, nil
+}
+
+func (r *recorder) release() {
+	sharedRecordersLock.Lock()
+	defer sharedRecordersLock.Unlock()
+
+	r.refCount--
+	if r.refCount > 0 {
+		return
+	}
+	delete(sharedRecorders, r.path)
+	r.Lock()
+	defer r.Unlock()
+	if err := r.file.Close(); err != nil {
+		r.logger.Error("unable to close persistence recording", tag.Error(err))
+	}
+}
+
+// record appends a call to the recording. The first param is the context of the call and the last result is
+// its error, neither is encoded with the others.
+func (r *recorder) record(
+	store string,
+	method string,
+	params []any,
+	results []any,
+) {
+	var err error
+	if e, ok := results[len(results)-1].(error); ok {
+		err = e
+	}
+	call := callRecord{
+		Store:   store,
+		Method:  method,
+		Params:  r.encode(store, method, params[1:]),
+		Results: r.encode(store, method, results[:len(results)-1]),
+		Error:   encodeError(err),
+	}
+	line, marshalErr := json.Marshal(&call)
+	if marshalErr != nil {
+		r.logger.Error("unable to encode persistence call", tag.NewStringTag("store", store), tag.NewStringTag("method", method), tag.Error(marshalErr))
+		return
+	}
+	line = append(line, '\n')
+
+	r.Lock()
+	defer r.Unlock()
+	if _, writeErr := r.file.Write(line); writeErr != nil {
+		r.logger.Error("unable to write persistence recording", tag.Error(writeErr))
+	}
+}
+
+// encode returns the JSON array of values. Values that cannot be encoded are recorded as null, such calls are
+// still replayed, but the replayed values are zero.
+func (r *recorder) encode(
+	store string,
+	method string,
+	values []any,
+) json.RawMessage {
+	data, err := encodeValues(values)
+	if err != nil {
+		r.logger.Warn("unable to encode persistence call value", tag.NewStringTag("store", store), tag.NewStringTag("method", method), tag.Error(err))
+	}
+	return data
+}
+
+// encodeValues returns the JSON array of values, values that cannot be encoded are null.
+func encodeValues(values []any) (json.RawMessage, error) {
+	var firstErr error
+	encoded := make([]json.RawMessage, len(values))
+	for i, value := range values {
+		data, err := json.Marshal(value)
+		if err != nil {
+			if firstErr == nil {
+				firstErr = err
+			}
+			data = []byte("null")
+		}
+		encoded[i] = data
+	}
+	data, err := json.Marshal(encoded)
+	if err != nil {
+		return nil, err
+	}
+	return data, firstErr
+}
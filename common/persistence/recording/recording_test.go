@@ -0,0 +1,93 @@
+package recording
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/log"
+	p "go.temporal.io/server/common/persistence"
+)
+
+type testShardStore struct {
+	p.ShardStore
+	shards map[int32]*commonpb.DataBlob
+}
+
+func (s *testShardStore) GetOrCreateShard(
+	_ context.Context,
+	request *p.InternalGetOrCreateShardRequest,
+) (*p.InternalGetOrCreateShardResponse, error) {
+	shardInfo, ok := s.shards[request.ShardID]
+	if !ok {
+		return nil, serviceerror.NewNotFound("shard not found")
+	}
+	return &p.InternalGetOrCreateShardResponse{ShardInfo: shardInfo}, nil
+}
+
+func (s *testShardStore) UpdateShard(
+	_ context.Context,
+	request *p.InternalUpdateShardRequest,
+) error {
+	return &p.ShardOwnershipLostError{ShardID: request.ShardID, Msg: "range ID mismatch"}
+}
+
+func TestRecordAndReplay(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "recording.jsonl")
+	ctx := context.Background()
+	shardInfo := &commonpb.DataBlob{EncodingType: enumspb.ENCODING_TYPE_PROTO3, Data: []byte("shard info")}
+
+	factory, err := NewRecordingDataStoreFactory(&config.Recording{Path: path}, nil, log.NewNoopLogger())
+	require.NoError(t, err)
+	recordingStore := newRecordingShardStore(&testShardStore{
+		shards: map[int32]*commonpb.DataBlob{1: shardInfo},
+	}, factory.recorder)
+
+	_, err = recordingStore.GetOrCreateShard(ctx, &p.InternalGetOrCreateShardRequest{ShardID: 2})
+	require.Error(t, err)
+	resp, err := recordingStore.GetOrCreateShard(ctx, &p.InternalGetOrCreateShardRequest{ShardID: 1})
+	require.NoError(t, err)
+	require.Equal(t, shardInfo, resp.ShardInfo)
+	err = recordingStore.UpdateShard(ctx, &p.InternalUpdateShardRequest{ShardID: 1, RangeID: 2})
+	require.Error(t, err)
+	factory.recorder.release()
+
+	replayFactory, err := NewReplayDataStoreFactory(config.CustomDatastoreConfig{
+		Name:    ReplayPluginName,
+		Options: map[string]any{"path": path},
+	}, "active", log.NewNoopLogger())
+	require.NoError(t, err)
+	defer replayFactory.Close()
+	replayStore, err := replayFactory.NewShardStore()
+	require.NoError(t, err)
+	require.Equal(t, "active", replayStore.GetClusterName())
+
+	// calls with recorded params are replayed first, regardless of the recorded order
+	resp, err = replayStore.GetOrCreateShard(ctx, &p.InternalGetOrCreateShardRequest{ShardID: 1})
+	require.NoError(t, err)
+	require.Equal(t, shardInfo.Data, resp.ShardInfo.Data)
+	require.Equal(t, shardInfo.EncodingType, resp.ShardInfo.EncodingType)
+
+	_, err = replayStore.GetOrCreateShard(ctx, &p.InternalGetOrCreateShardRequest{ShardID: 2})
+	var notFound *serviceerror.NotFound
+	require.ErrorAs(t, err, &notFound)
+
+	err = replayStore.UpdateShard(ctx, &p.InternalUpdateShardRequest{ShardID: 1, RangeID: 2})
+	var ownershipLost *p.ShardOwnershipLostError
+	require.ErrorAs(t, err, &ownershipLost)
+	require.Equal(t, int32(1), ownershipLost.ShardID)
+
+	err = replayStore.UpdateShard(ctx, &p.InternalUpdateShardRequest{ShardID: 1, RangeID: 2})
+	var unavailable *serviceerror.Unavailable
+	require.ErrorAs(t, err, &unavailable)
+}
+
+func TestReplayRequiresPath(t *testing.T) {
+	_, err := NewReplayDataStoreFactory(config.CustomDatastoreConfig{Name: ReplayPluginName}, "active", log.NewNoopLogger())
+	require.Error(t, err)
+}
@@ -0,0 +1,286 @@
+package recording
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"sync"
+
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/metrics"
+	p "go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/resolver"
+)
+
+const (
+	// ReplayPluginName is the name of the custom datastore that replays a recording.
+	ReplayPluginName = "replay"
+
+	// optionPath is the custom datastore option of the recording file to replay.
+	optionPath = "path"
+)
+
+type (
+	// ReplayDataStoreFactory vends data stores that serve the calls of a recording instead of reading and
+	// writing a database.
+	ReplayDataStoreFactory struct {
+		store replayStore
+	}
+
+	// AbstractReplayDataStoreFactory creates factories for custom datastores named ReplayPluginName. It is used
+	// by default when the server is not given an AbstractDataStoreFactory.
+	AbstractReplayDataStoreFactory struct{}
+
+	// replayStore implements the methods shared by the replay data stores.
+	replayStore struct {
+		player      *player
+		clusterName string
+	}
+
+	callKey struct {
+		store  string
+		method string
+	}
+
+	// player serves the recorded calls of each store method in the order they were recorded. Calls made
+	// concurrently might be recorded in a different order than they are replayed in, so a recorded call with
+	// the same params is served before any other.
+	player struct {
+		sync.Mutex
+		path     string
+		calls    map[callKey][]*callRecord
+		refCount int
+		logger   log.Logger
+	}
+)
+
+var (
+	// Replayed calls are consumed, so all the factories of a single binary deployment share the recording.
+	sharedPlayersLock sync.Mutex
+	sharedPlayers     = make(map[string]*player)
+)
+
+// NewFactory implements client.AbstractDataStoreFactory.
+func (AbstractReplayDataStoreFactory) NewFactory(
+	cfg config.CustomDatastoreConfig,
+	_ resolver.ServiceResolver,
+	clusterName string,
+	logger log.Logger,
+	_ metrics.Handler,
+) p.DataStoreFactory {
+	factory, err := NewReplayDataStoreFactory(cfg, clusterName, logger)
+	if err != nil {
+		logger.Fatal("unable to load persistence recording", tag.Error(err))
+	}
+	return factory
+}
+
+// NewReplayDataStoreFactory returns a factory of data stores that replay the recording at the "path" option.
+func NewReplayDataStoreFactory(
+	cfg config.CustomDatastoreConfig,
+	clusterName string,
+	logger log.Logger,
+) (*ReplayDataStoreFactory, error) {
+	path, ok := cfg.Options[optionPath].(string)
+	if !ok || path == "" {
+		return nil, fmt.Errorf("%v datastore requires the %q option", ReplayPluginName, optionPath)
+	}
+	player, err := acquirePlayer(path, logger)
+	if err != nil {
+		return nil, err
+	}
+	return &ReplayDataStoreFactory{
+		store: replayStore{
+			player:      player,
+			clusterName: clusterName,
+		},
+	}, nil
+}
+
+func (f *ReplayDataStoreFactory) Close() {
+	f.store.player.release()
+}
+
+func (f *ReplayDataStoreFactory) NewTaskStore() (p.TaskStore, error) {
+	return newReplayTaskStore(f.store), nil
+}
+
+func (f *ReplayDataStoreFactory) NewShardStore() (p.ShardStore, error) {
+	return newReplayShardStore(f.store), nil
+}
+
+func (f *ReplayDataStoreFactory) NewMetadataStore() (p.MetadataStore, error) {
+	return newReplayMetadataStore(f.store), nil
+}
+
+func (f *ReplayDataStoreFactory) NewExecutionStore() (p.ExecutionStore, error) {
+	return newReplayExecutionStore(f.store), nil
+}
+
+func (f *ReplayDataStoreFactory) NewQueue(_ p.QueueType) (p.Queue, error) {
+	return newReplayQueue(f.store), nil
+}
+
+func (f *ReplayDataStoreFactory) NewQueueV2() (p.QueueV2, error) {
+	return newReplayQueueV2(f.store), nil
+}
+
+func (f *ReplayDataStoreFactory) NewClusterMetadataStore() (p.ClusterMetadataStore, error) {
+	return newReplayClusterMetadataStore(f.store), nil
+}
+
+func (f *ReplayDataStoreFactory) NewNexusEndpointStore() (p.NexusEndpointStore, error) {
+	return newReplayNexusEndpointStore(f.store), nil
+}
+
+func (s replayStore) GetName() string {
+	return ReplayPluginName
+}
+
+func (s replayStore) GetClusterName() string {
+	return s.clusterName
+}
+
+func (s replayStore) GetHistoryBranchUtil() p.HistoryBranchUtil {
+	return &p.HistoryBranchUtilImpl{}
+}
+
+func (s replayStore) Close() {
+}
+
+func acquirePlayer(
+	path string,
+	logger log.Logger,
+) (*player, error) {
+	sharedPlayersLock.Lock()
+	defer sharedPlayersLock.Unlock()
+
+	if pl, ok := sharedPlayers[path]; ok {
+		pl.refCount++
+		return pl, nil
+	}
+	calls, err := loadRecording(path)
+	if err != nil {
+		return nil, err
+	}
+	pl := &player{
+		path:     path,
+		calls:    calls,
+		refCount: 1,
+		logger:   logger,
+	}
+	sharedPlayers[path] = pl
+	return pl, nil
+}
+
+func loadRecording(path string) (map[callKey][]*callRecord, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = file.Close() }()
+
+	calls := make(map[callKey][]*callRecord)
+	decoder := json.NewDecoder(file)
+	for {
+		var call callRecord
+		if err := decoder.Decode(&call); err != nil {
+			if errors.Is(err, io.EOF) {
+				return calls, nil
+			}
+			return nil, fmt.Errorf("invalid persistence recording %v: %w", path, err)
+		}
+		key := callKey{store: call.Store, method: call.Method}
+		calls[key] = append(calls[key], &call)
+	}
+}
+
+func (pl *player) release() {
+	sharedPlayersLock.Lock()
+	defer sharedPlayersLock.Unlock()
+
+	pl.refCount--
+	if pl.refCount == 0 {
+		delete(sharedPlayers, pl.path)
+	}
+}
+
+// replay sets the results of the next recorded call of the store method. The first param is the context of the
+// call and the last result is a pointer to its error.
+func (pl *player) replay(
+	store string,
+	method string,
+	params []any,
+	results []any,
+) {
+	errPtr := results[len(results)-1].(*error)
+	call := pl.next(store, method, params[1:])
+	if call == nil {
+		*errPtr = serviceerror.NewUnavailable(fmt.Sprintf("no recorded %v.%v call left to replay", store, method))
+		return
+	}
+
+	var values []json.RawMessage
+	if err := json.Unmarshal(call.Results, &values); err != nil || len(values) != len(results)-1 {
+		*errPtr = serviceerror.NewInternal(fmt.Sprintf("recorded %v.%v call has invalid results", store, method))
+		return
+	}
+	for i, value := range values {
+		if err := json.Unmarshal(value, results[i]); err != nil {
+			*errPtr = serviceerror.NewInternal(fmt.Sprintf("unable to decode recorded %v.%v call results: %v", store, method, err))
+			return
+		}
+	}
+	*errPtr = decodeError(call.Error)
+}
+
+// next removes and returns the first recorded call of the store method with the same params, or the first
+// recorded call if none has the same params. It returns nil if there is no recorded call left.
+func (pl *player) next(
+	store string,
+	method string,
+	params []any,
+) *callRecord {
+	encoded, err := encodeValues(params)
+	if err != nil {
+		pl.logger.Warn("unable to encode persistence call value", tag.NewStringTag("store", store), tag.NewStringTag("method", method), tag.Error(err))
+	}
+
+	pl.Lock()
+	defer pl.Unlock()
+
+	key := callKey{store: store, method: method}
+	calls := pl.calls[key]
+	if len(calls) == 0 {
+		return nil
+	}
+	index := 0
+	for i, call := range calls {
+		if bytes.Equal(call.Params, encoded) {
+			index = i
+			break
+		}
+	}
+	if index == 0 && !bytes.Equal(calls[0].Params, encoded) {
+		pl.logger.Warn("replaying persistence call with different params",
+			tag.NewStringTag("store", store),
+			tag.NewStringTag("method", method),
+			tag.NewStringTag("params", string(encoded)),
+			tag.NewStringTag("recorded-params", string(calls[0].Params)),
+		)
+	}
+	call := calls[index]
+	if index == 0 {
+		pl.calls[key] = calls[1:]
+	} else {
+		pl.calls[key] = slices.Delete(calls, index, index+1)
+	}
+	return call
+}
@@ -0,0 +1,26 @@
+package persistence
+
+import (
+	"context"
+)
+
+type replicaReadsContextKey struct{}
+
+// WithReplicaReads returns a context whose reads can be served by a read replica of the database, which might be
+// behind the primary. It should only be used to read data that doesn't change anymore, e.g. the history of a closed
+// workflow, and callers should retry with WithoutReplicaReads if the data is incomplete. Data stores that don't
+// have read replicas ignore it.
+func WithReplicaReads(ctx context.Context) context.Context {
+	return context.WithValue(ctx, replicaReadsContextKey{}, true)
+}
+
+// WithoutReplicaReads returns a context whose reads are served by the primary database.
+func WithoutReplicaReads(ctx context.Context) context.Context {
+	return context.WithValue(ctx, replicaReadsContextKey{}, false)
+}
+
+// ReplicaReadsAllowed returns whether the reads of the context can be served by a read replica.
+func ReplicaReadsAllowed(ctx context.Context) bool {
+	allowed, _ := ctx.Value(replicaReadsContextKey{}).(bool)
+	return allowed
+}
@@ -151,6 +151,11 @@ func decode(
 		return nil
 	}
 
+	blob, err := decompress(blob)
+	if err != nil {
+		return NewDeserializationError(enumspb.ENCODING_TYPE_UNSPECIFIED, err)
+	}
+
 	enc, _ := enumspb.EncodingTypeFromString(encoding)
 	switch enc {
 	case enumspb.ENCODING_TYPE_JSON:
@@ -182,7 +187,11 @@ func Proto3Decode(blob []byte, e enumspb.EncodingType, result proto.Message) err
 	if e != enumspb.ENCODING_TYPE_PROTO3 {
 		return NewUnknownEncodingTypeError(e.String(), enumspb.ENCODING_TYPE_PROTO3)
 	}
-	err := proto.Unmarshal(blob, result)
+	blob, err := decompress(blob)
+	if err != nil {
+		return NewDeserializationError(enumspb.ENCODING_TYPE_PROTO3, err)
+	}
+	err = proto.Unmarshal(blob, result)
 	if err != nil {
 		return NewDeserializationError(enumspb.ENCODING_TYPE_PROTO3, err)
 	}
@@ -0,0 +1,157 @@
+package serialization
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/golang/snappy"
+	"github.com/klauspost/compress/zstd"
+	commonpb "go.temporal.io/api/common/v1"
+)
+
+// Compressed blobs keep their original EncodingType and wrap the encoded payload in a small envelope:
+//
+//	0x00 | compressionMagic | codec ID | compressed payload
+//
+// A leading zero byte can never start a valid proto3 message (field number 0 is reserved) or a JSON
+// document, so blobs written before compression was enabled are always distinguishable from compressed
+// ones and both can be read side by side.
+
+type (
+	// CompressionType is the codec used to compress persisted DataBlobs.
+	CompressionType string
+
+	// UnknownCompressionTypeError is returned for a compression type or codec ID that is not supported.
+	UnknownCompressionTypeError struct {
+		providedType string
+	}
+)
+
+const (
+	CompressionTypeNone   CompressionType = "none"
+	CompressionTypeSnappy CompressionType = "snappy"
+	CompressionTypeZstd   CompressionType = "zstd"
+)
+
+const (
+	compressionMagic    byte = 'C'
+	compressionIDSnappy byte = 1
+	compressionIDZstd   byte = 2
+
+	compressionHeaderSize = 3
+)
+
+var (
+	zstdEncoder = sync.OnceValues(func() (*zstd.Encoder, error) {
+		return zstd.NewWriter(nil, zstd.WithEncoderLevel(zstd.SpeedDefault))
+	})
+	zstdDecoder = sync.OnceValues(func() (*zstd.Decoder, error) {
+		return zstd.NewReader(nil)
+	})
+)
+
+// ParseCompressionType returns the CompressionType for a dynamic config value. An empty string maps to
+// CompressionTypeNone.
+func ParseCompressionType(s string) (CompressionType, error) {
+	switch CompressionType(s) {
+	case "", CompressionTypeNone:
+		return CompressionTypeNone, nil
+	case CompressionTypeSnappy, CompressionTypeZstd:
+		return CompressionType(s), nil
+	default:
+		return CompressionTypeNone, &UnknownCompressionTypeError{providedType: s}
+	}
+}
+
+// CompressBlob returns a copy of the blob with its data compressed using the given codec. The blob is
+// returned unchanged if compression is disabled, the blob is empty or compression doesn't reduce its size.
+func CompressBlob(blob *commonpb.DataBlob, compressionType CompressionType) (*commonpb.DataBlob, error) {
+	if blob == nil || len(blob.Data) == 0 || IsCompressed(blob.Data) {
+		return blob, nil
+	}
+
+	var compressed []byte
+	switch compressionType {
+	case "", CompressionTypeNone:
+		return blob, nil
+	case CompressionTypeSnappy:
+		encoded := snappy.Encode(nil, blob.Data)
+		compressed = append(newCompressionBuffer(compressionIDSnappy, len(encoded)), encoded...)
+	case CompressionTypeZstd:
+		encoder, err := zstdEncoder()
+		if err != nil {
+			return nil, NewSerializationError(blob.EncodingType, err)
+		}
+		compressed = encoder.EncodeAll(blob.Data, newCompressionBuffer(compressionIDZstd, len(blob.Data)))
+	default:
+		return nil, &UnknownCompressionTypeError{providedType: string(compressionType)}
+	}
+
+	if len(compressed) >= len(blob.Data) {
+		return blob, nil
+	}
+	return &commonpb.DataBlob{
+		EncodingType: blob.EncodingType,
+		Data:         compressed,
+	}, nil
+}
+
+// DecompressBlob returns a copy of the blob with its data decompressed. Uncompressed blobs are returned as is.
+func DecompressBlob(blob *commonpb.DataBlob) (*commonpb.DataBlob, error) {
+	if blob == nil || !IsCompressed(blob.Data) {
+		return blob, nil
+	}
+	data, err := decompress(blob.Data)
+	if err != nil {
+		return nil, NewDeserializationError(blob.EncodingType, err)
+	}
+	return &commonpb.DataBlob{
+		EncodingType: blob.EncodingType,
+		Data:         data,
+	}, nil
+}
+
+// IsCompressed returns true if data was written by CompressBlob.
+func IsCompressed(data []byte) bool {
+	return len(data) >= compressionHeaderSize && data[0] == 0 && data[1] == compressionMagic
+}
+
+func decompress(data []byte) ([]byte, error) {
+	if !IsCompressed(data) {
+		return data, nil
+	}
+
+	payload := data[compressionHeaderSize:]
+	switch data[2] {
+	case compressionIDSnappy:
+		return snappy.Decode(nil, payload)
+	case compressionIDZstd:
+		decoder, err := zstdDecoder()
+		if err != nil {
+			return nil, err
+		}
+		return decoder.DecodeAll(payload, nil)
+	default:
+		return nil, &UnknownCompressionTypeError{providedType: fmt.Sprintf("codec ID %d", data[2])}
+	}
+}
+
+func newCompressionBuffer(id byte, capacity int) []byte {
+	buf := make([]byte, compressionHeaderSize, compressionHeaderSize+capacity)
+	buf[0] = 0
+	buf[1] = compressionMagic
+	buf[2] = id
+	return buf
+}
+
+func (e *UnknownCompressionTypeError) Error() string {
+	return fmt.Sprintf("unknown or unsupported compression type %v, supported types: %v,%v,%v",
+		e.providedType,
+		CompressionTypeNone,
+		CompressionTypeSnappy,
+		CompressionTypeZstd,
+	)
+}
+
+// IsTerminalTaskError informs our task processing subsystem that it is impossible to retry this error.
+func (e *UnknownCompressionTypeError) IsTerminalTaskError() bool { return true }
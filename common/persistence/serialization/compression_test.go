@@ -0,0 +1,133 @@
+package serialization
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	historypb "go.temporal.io/api/history/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/payloads"
+)
+
+func TestParseCompressionType(t *testing.T) {
+	for _, tc := range []struct {
+		value    string
+		expected CompressionType
+		err      bool
+	}{
+		{value: "", expected: CompressionTypeNone},
+		{value: "none", expected: CompressionTypeNone},
+		{value: "snappy", expected: CompressionTypeSnappy},
+		{value: "zstd", expected: CompressionTypeZstd},
+		{value: "gzip", expected: CompressionTypeNone, err: true},
+	} {
+		t.Run(tc.value, func(t *testing.T) {
+			compressionType, err := ParseCompressionType(tc.value)
+			require.Equal(t, tc.expected, compressionType)
+			if tc.err {
+				var unknownErr *UnknownCompressionTypeError
+				require.ErrorAs(t, err, &unknownErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestCompressBlob_RoundTrip(t *testing.T) {
+	data := bytes.Repeat([]byte("temporal-history-event-payload"), 128)
+	blob := &commonpb.DataBlob{EncodingType: enumspb.ENCODING_TYPE_PROTO3, Data: data}
+
+	for _, compressionType := range []CompressionType{CompressionTypeSnappy, CompressionTypeZstd} {
+		t.Run(string(compressionType), func(t *testing.T) {
+			compressed, err := CompressBlob(blob, compressionType)
+			require.NoError(t, err)
+			require.True(t, IsCompressed(compressed.Data))
+			require.Less(t, len(compressed.Data), len(data))
+			require.Equal(t, enumspb.ENCODING_TYPE_PROTO3, compressed.EncodingType)
+			// input blob must not be modified
+			require.Equal(t, data, blob.Data)
+
+			decompressed, err := DecompressBlob(compressed)
+			require.NoError(t, err)
+			require.Equal(t, data, decompressed.Data)
+			require.Equal(t, enumspb.ENCODING_TYPE_PROTO3, decompressed.EncodingType)
+		})
+	}
+}
+
+func TestCompressBlob_Passthrough(t *testing.T) {
+	blob := &commonpb.DataBlob{EncodingType: enumspb.ENCODING_TYPE_PROTO3, Data: []byte("x")}
+
+	compressed, err := CompressBlob(blob, CompressionTypeNone)
+	require.NoError(t, err)
+	require.Same(t, blob, compressed)
+
+	// compression is skipped when it doesn't reduce the size
+	compressed, err = CompressBlob(blob, CompressionTypeZstd)
+	require.NoError(t, err)
+	require.Same(t, blob, compressed)
+
+	compressed, err = CompressBlob(nil, CompressionTypeZstd)
+	require.NoError(t, err)
+	require.Nil(t, compressed)
+
+	_, err = CompressBlob(blob, CompressionType("gzip"))
+	require.Error(t, err)
+}
+
+func TestDecompressBlob_UnknownCodec(t *testing.T) {
+	blob := &commonpb.DataBlob{
+		EncodingType: enumspb.ENCODING_TYPE_PROTO3,
+		Data:         []byte{0, compressionMagic, 42, 1, 2, 3},
+	}
+	_, err := DecompressBlob(blob)
+	var deserializationErr *DeserializationError
+	require.ErrorAs(t, err, &deserializationErr)
+}
+
+func TestSerializer_ReadsCompressedAndUncompressedBlobs(t *testing.T) {
+	serializer := NewSerializer()
+
+	events := []*historypb.HistoryEvent{
+		{
+			EventId:   1,
+			EventType: enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_SIGNALED,
+			Attributes: &historypb.HistoryEvent_WorkflowExecutionSignaledEventAttributes{
+				WorkflowExecutionSignaledEventAttributes: &historypb.WorkflowExecutionSignaledEventAttributes{
+					SignalName: "signal",
+					Input:      payloads.EncodeBytes(bytes.Repeat([]byte("signal-input"), 256)),
+				},
+			},
+		},
+	}
+	eventsBlob, err := serializer.SerializeEvents(events, enumspb.ENCODING_TYPE_PROTO3)
+	require.NoError(t, err)
+
+	activityInfo := &persistencespb.ActivityInfo{
+		ActivityId:           "activity-id",
+		LastHeartbeatDetails: payloads.EncodeBytes(bytes.Repeat([]byte("heartbeat"), 256)),
+	}
+	activityBlob, err := serializer.ActivityInfoToBlob(activityInfo, enumspb.ENCODING_TYPE_PROTO3)
+	require.NoError(t, err)
+
+	for _, compressionType := range []CompressionType{CompressionTypeNone, CompressionTypeSnappy, CompressionTypeZstd} {
+		t.Run(string(compressionType), func(t *testing.T) {
+			compressedEvents, err := CompressBlob(eventsBlob, compressionType)
+			require.NoError(t, err)
+			deserializedEvents, err := serializer.DeserializeEvents(compressedEvents)
+			require.NoError(t, err)
+			require.Len(t, deserializedEvents, 1)
+			require.Equal(t, "signal", deserializedEvents[0].GetWorkflowExecutionSignaledEventAttributes().GetSignalName())
+
+			compressedActivity, err := CompressBlob(activityBlob, compressionType)
+			require.NoError(t, err)
+			deserializedActivity, err := serializer.ActivityInfoFromBlob(compressedActivity)
+			require.NoError(t, err)
+			require.Equal(t, activityInfo.ActivityId, deserializedActivity.ActivityId)
+		})
+	}
+}
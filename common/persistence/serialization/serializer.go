@@ -148,8 +148,12 @@ func (t *serializerImpl) DeserializeEvents(data *commonpb.DataBlob) ([]*historyp
 		return nil, nil
 	}
 
+	data, err := DecompressBlob(data)
+	if err != nil {
+		return nil, err
+	}
+
 	events := &historypb.History{}
-	var err error
 	switch data.EncodingType {
 	case enumspb.ENCODING_TYPE_PROTO3:
 		// Client API currently specifies encodingType on requests which span multiple of these objects
@@ -171,8 +175,12 @@ func (t *serializerImpl) DeserializeStrippedEvents(data *commonpb.DataBlob) ([]*
 		return nil, nil
 	}
 
+	data, err := DecompressBlob(data)
+	if err != nil {
+		return nil, err
+	}
+
 	events := &historyspb.StrippedHistoryEvents{}
-	var err error
 	//nolint:exhaustive
 	switch data.EncodingType {
 	case enumspb.ENCODING_TYPE_PROTO3:
@@ -205,8 +213,12 @@ func (t *serializerImpl) DeserializeEvent(data *commonpb.DataBlob) (*historypb.H
 		return nil, nil
 	}
 
+	data, err := DecompressBlob(data)
+	if err != nil {
+		return nil, err
+	}
+
 	event := &historypb.HistoryEvent{}
-	var err error
 	switch data.EncodingType {
 	case enumspb.ENCODING_TYPE_PROTO3:
 		// Client API currently specifies encodingType on requests which span multiple of these objects
@@ -236,8 +248,12 @@ func (t *serializerImpl) DeserializeClusterMetadata(data *commonpb.DataBlob) (*p
 		return nil, nil
 	}
 
+	data, err := DecompressBlob(data)
+	if err != nil {
+		return nil, err
+	}
+
 	cm := &persistencespb.ClusterMetadata{}
-	var err error
 	switch data.EncodingType {
 	case enumspb.ENCODING_TYPE_PROTO3:
 		// Thrift == Proto for this object so that we can maintain test behavior until thrift is gone
@@ -604,6 +620,11 @@ func decodeBlob(data *commonpb.DataBlob, result proto.Message) error {
 		return nil
 	}
 
+	data, err := DecompressBlob(data)
+	if err != nil {
+		return err
+	}
+
 	switch data.EncodingType {
 	case enumspb.ENCODING_TYPE_JSON:
 		return codec.NewJSONPBEncoder().Decode(data.Data, result)
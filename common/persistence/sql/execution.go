@@ -21,6 +21,12 @@ type sqlExecutionStore struct {
 	p.HistoryBranchUtilImpl
 }
 
+type listExecutionsPageToken struct {
+	LastNamespaceID primitives.UUID
+	LastWorkflowID  string
+	LastRunID       primitives.UUID
+}
+
 var _ p.ExecutionStore = (*sqlExecutionStore)(nil)
 
 // NewSQLExecutionStore creates an instance of ExecutionStore
@@ -721,10 +727,101 @@ func (m *sqlExecutionStore) setWorkflowExecutionTx(
 }
 
 func (m *sqlExecutionStore) ListConcreteExecutions(
-	_ context.Context,
-	_ *p.ListConcreteExecutionsRequest,
+	ctx context.Context,
+	request *p.ListConcreteExecutionsRequest,
 ) (*p.InternalListConcreteExecutionsResponse, error) {
-	return nil, serviceerror.NewUnimplemented("ListConcreteExecutions is not implemented")
+	filter := sqlplugin.ExecutionsRangeFilter{
+		ShardID:        request.ShardID,
+		MinNamespaceID: primitives.UUID{},
+		MinRunID:       primitives.UUID{},
+		PageSize:       request.PageSize,
+	}
+	if len(request.PageToken) != 0 {
+		token, err := deserializePageTokenJson[listExecutionsPageToken](request.PageToken)
+		if err != nil {
+			return nil, serviceerror.NewInvalidArgument(fmt.Sprintf("ListConcreteExecutions: invalid page token: %v", err))
+		}
+		filter.MinNamespaceID = token.LastNamespaceID
+		filter.MinWorkflowID = token.LastWorkflowID
+		filter.MinRunID = token.LastRunID
+	}
+
+	rows, err := m.Db.RangeSelectFromExecutions(ctx, filter)
+	if err != nil {
+		return nil, serviceerror.NewUnavailable(fmt.Sprintf("ListConcreteExecutions: failed. Error: %v", err))
+	}
+
+	response := &p.InternalListConcreteExecutionsResponse{
+		States: make([]*p.InternalWorkflowMutableState, len(rows)),
+	}
+	for i, row := range rows {
+		response.States[i] = &p.InternalWorkflowMutableState{
+			ExecutionInfo:   p.NewDataBlob(row.Data, row.DataEncoding),
+			ExecutionState:  p.NewDataBlob(row.State, row.StateEncoding),
+			NextEventID:     row.NextEventID,
+			DBRecordVersion: row.DBRecordVersion,
+		}
+	}
+	if len(rows) == request.PageSize {
+		last := rows[len(rows)-1]
+		response.NextPageToken, err = serializePageTokenJson(&listExecutionsPageToken{
+			LastNamespaceID: last.NamespaceID,
+			LastWorkflowID:  last.WorkflowID,
+			LastRunID:       last.RunID,
+		})
+		if err != nil {
+			return nil, serviceerror.NewInternal(err.Error())
+		}
+	}
+	return response, nil
+}
+
+func (m *sqlExecutionStore) ListCurrentExecutions(
+	ctx context.Context,
+	request *p.ListCurrentExecutionsRequest,
+) (*p.InternalListCurrentExecutionsResponse, error) {
+	filter := sqlplugin.CurrentExecutionsRangeFilter{
+		ShardID:        request.ShardID,
+		MinNamespaceID: primitives.UUID{},
+		PageSize:       request.PageSize,
+	}
+	if len(request.PageToken) != 0 {
+		token, err := deserializePageTokenJson[listExecutionsPageToken](request.PageToken)
+		if err != nil {
+			return nil, serviceerror.NewInvalidArgument(fmt.Sprintf("ListCurrentExecutions: invalid page token: %v", err))
+		}
+		filter.MinNamespaceID = token.LastNamespaceID
+		filter.MinWorkflowID = token.LastWorkflowID
+	}
+
+	rows, err := m.Db.RangeSelectFromCurrentExecutions(ctx, filter)
+	if err != nil {
+		return nil, serviceerror.NewUnavailable(fmt.Sprintf("ListCurrentExecutions: failed. Error: %v", err))
+	}
+
+	response := &p.InternalListCurrentExecutionsResponse{
+		Executions: make([]*p.CurrentWorkflowExecution, len(rows)),
+	}
+	for i, row := range rows {
+		response.Executions[i] = &p.CurrentWorkflowExecution{
+			NamespaceID: row.NamespaceID.String(),
+			WorkflowID:  row.WorkflowID,
+			RunID:       row.RunID.String(),
+			State:       row.State,
+			Status:      row.Status,
+		}
+	}
+	if len(rows) == request.PageSize {
+		last := rows[len(rows)-1]
+		response.NextPageToken, err = serializePageTokenJson(&listExecutionsPageToken{
+			LastNamespaceID: last.NamespaceID,
+			LastWorkflowID:  last.WorkflowID,
+		})
+		if err != nil {
+			return nil, serviceerror.NewInternal(err.Error())
+		}
+	}
+	return response, nil
 }
 
 func getStartTimeFromState(state *persistencespb.WorkflowExecutionState) *time.Time {
@@ -0,0 +1,265 @@
+package sql
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/metrics"
+	p "go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/sql/sqlplugin"
+	"go.temporal.io/server/common/resolver"
+)
+
+const (
+	defaultMaxReplicationLag   = 10 * time.Second
+	defaultReplicationLagCheck = 5 * time.Second
+	replicationLagCheckTimeout = 5 * time.Second
+	readReplicaMetricsTagName  = "read_replica"
+)
+
+type (
+	// readReplicaDB sends the reads that tolerate stale data to the read replicas of the database and everything
+	// else to the primary. Visibility list and count queries and the execution range reads of ListConcreteExecutions
+	// always tolerate stale data, history node reads only if their context was created with
+	// persistence.WithReplicaReads. Reads fall back to the primary if no replica
+	// is healthy or the read fails on the replica.
+	readReplicaDB struct {
+		sqlplugin.DB
+
+		replicas         []*readReplica
+		next             atomic.Uint32
+		maxLag           time.Duration
+		lagCheckInterval time.Duration
+		logger           log.Logger
+		metricsHandler   metrics.Handler
+
+		shutdownCh chan struct{}
+		closeOnce  sync.Once
+		wg         sync.WaitGroup
+	}
+
+	readReplica struct {
+		addr string
+		db   sqlplugin.DB
+		// healthy is whether the replica was reachable and not too far behind the primary at the last check.
+		healthy atomic.Bool
+	}
+)
+
+var _ sqlplugin.DB = (*readReplicaDB)(nil)
+
+// newReadReplicaDBFromConfig connects to the read replicas of the config and returns a DB that routes reads
+// between them and the primary.
+func newReadReplicaDBFromConfig(
+	primary sqlplugin.DB,
+	dbKind sqlplugin.DbKind,
+	cfg *config.SQL,
+	r resolver.ServiceResolver,
+	logger log.Logger,
+	metricsHandler metrics.Handler,
+) (*readReplicaDB, error) {
+	replicas := make([]*readReplica, 0, len(cfg.ReadReplicas.ConnectAddrs))
+	for _, addr := range cfg.ReadReplicas.ConnectAddrs {
+		replicaCfg := *cfg
+		replicaCfg.ConnectAddr = addr
+		replicaCfg.ReadReplicas = nil
+		db, err := createDB[sqlplugin.DB](dbKind, &replicaCfg, r, logger, metricsHandler)
+		if err != nil {
+			for _, replica := range replicas {
+				_ = replica.db.Close()
+			}
+			return nil, err
+		}
+		replicas = append(replicas, &readReplica{addr: addr, db: db})
+	}
+
+	db := newReadReplicaDB(primary, replicas, cfg.ReadReplicas, logger, metricsHandler)
+	db.start()
+	return db, nil
+}
+
+func newReadReplicaDB(
+	primary sqlplugin.DB,
+	replicas []*readReplica,
+	cfg *config.SQLReadReplicas,
+	logger log.Logger,
+	metricsHandler metrics.Handler,
+) *readReplicaDB {
+	db := &readReplicaDB{
+		DB:               primary,
+		replicas:         replicas,
+		maxLag:           cfg.MaxReplicationLag,
+		lagCheckInterval: cfg.LagCheckInterval,
+		logger:           logger,
+		metricsHandler:   metricsHandler,
+		shutdownCh:       make(chan struct{}),
+	}
+	if db.maxLag <= 0 {
+		db.maxLag = defaultMaxReplicationLag
+	}
+	if db.lagCheckInterval <= 0 {
+		db.lagCheckInterval = defaultReplicationLagCheck
+	}
+	return db
+}
+
+// start checks the replication lag of the replicas in the background. Replicas aren't read from until their
+// first check succeeds.
+func (db *readReplicaDB) start() {
+	db.wg.Add(1)
+	go func() {
+		defer db.wg.Done()
+
+		ticker := time.NewTicker(db.lagCheckInterval)
+		defer ticker.Stop()
+		for {
+			db.checkReplicationLag()
+			select {
+			case <-ticker.C:
+			case <-db.shutdownCh:
+				return
+			}
+		}
+	}()
+}
+
+func (db *readReplicaDB) checkReplicationLag() {
+	for _, replica := range db.replicas {
+		healthy := db.checkReplica(replica)
+		if replica.healthy.Swap(healthy) != healthy {
+			db.logger.Info("SQL read replica health changed", tag.Address(replica.addr), tag.NewBoolTag("healthy", healthy))
+		}
+	}
+}
+
+func (db *readReplicaDB) checkReplica(replica *readReplica) bool {
+	lagReader, ok := replica.db.(sqlplugin.ReplicaDB)
+	if !ok {
+		// the plugin can't tell how far behind the replica is, it's only read from when it's reachable
+		return true
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), replicationLagCheckTimeout)
+	defer cancel()
+	lag, err := lagReader.ReplicationLag(ctx)
+	if err != nil {
+		db.logger.Warn("Unable to check the replication lag of SQL read replica", tag.Address(replica.addr), tag.Error(err))
+		return false
+	}
+	metrics.PersistenceReadReplicaLag.With(db.metricsHandler).Record(
+		lag.Seconds(),
+		metrics.StringTag(readReplicaMetricsTagName, replica.addr),
+	)
+	return lag <= db.maxLag
+}
+
+// replica returns the next healthy replica, or nil if none is healthy.
+func (db *readReplicaDB) replica() *readReplica {
+	start := int(db.next.Add(1))
+	for i := range db.replicas {
+		replica := db.replicas[(start+i)%len(db.replicas)]
+		if replica.healthy.Load() {
+			return replica
+		}
+	}
+	return nil
+}
+
+// readFromReplica runs the read on a healthy replica, or on the primary if there is none or the read fails on
+// the replica.
+func readFromReplica[T any](
+	ctx context.Context,
+	db *readReplicaDB,
+	read func(sqlplugin.DB) (T, error),
+) (T, error) {
+	replica := db.replica()
+	if replica == nil {
+		metrics.PersistenceReadReplicaFallbacks.With(db.metricsHandler).Record(1)
+		return read(db.DB)
+	}
+
+	result, err := read(replica.db)
+	if err == nil || ctx.Err() != nil {
+		return result, err
+	}
+	db.logger.Warn("Read failed on SQL read replica, retrying on the primary", tag.Address(replica.addr), tag.Error(err))
+	metrics.PersistenceReadReplicaFallbacks.With(db.metricsHandler).Record(1, metrics.StringTag(readReplicaMetricsTagName, replica.addr))
+	return read(db.DB)
+}
+
+func (db *readReplicaDB) SelectFromVisibility(
+	ctx context.Context,
+	filter sqlplugin.VisibilitySelectFilter,
+) ([]sqlplugin.VisibilityRow, error) {
+	return readFromReplica(ctx, db, func(d sqlplugin.DB) ([]sqlplugin.VisibilityRow, error) {
+		return d.SelectFromVisibility(ctx, filter)
+	})
+}
+
+func (db *readReplicaDB) CountFromVisibility(
+	ctx context.Context,
+	filter sqlplugin.VisibilitySelectFilter,
+) (int64, error) {
+	return readFromReplica(ctx, db, func(d sqlplugin.DB) (int64, error) {
+		return d.CountFromVisibility(ctx, filter)
+	})
+}
+
+func (db *readReplicaDB) CountGroupByFromVisibility(
+	ctx context.Context,
+	filter sqlplugin.VisibilitySelectFilter,
+) ([]sqlplugin.VisibilityCountRow, error) {
+	return readFromReplica(ctx, db, func(d sqlplugin.DB) ([]sqlplugin.VisibilityCountRow, error) {
+		return d.CountGroupByFromVisibility(ctx, filter)
+	})
+}
+
+func (db *readReplicaDB) ExplainVisibilityQuery(
+	ctx context.Context,
+	filter sqlplugin.VisibilitySelectFilter,
+) ([]string, error) {
+	return readFromReplica(ctx, db, func(d sqlplugin.DB) ([]string, error) {
+		return d.ExplainVisibilityQuery(ctx, filter)
+	})
+}
+
+func (db *readReplicaDB) RangeSelectFromHistoryNode(
+	ctx context.Context,
+	filter sqlplugin.HistoryNodeSelectFilter,
+) ([]sqlplugin.HistoryNodeRow, error) {
+	if !p.ReplicaReadsAllowed(ctx) {
+		return db.DB.RangeSelectFromHistoryNode(ctx, filter)
+	}
+	return readFromReplica(ctx, db, func(d sqlplugin.DB) ([]sqlplugin.HistoryNodeRow, error) {
+		return d.RangeSelectFromHistoryNode(ctx, filter)
+	})
+}
+
+func (db *readReplicaDB) RangeSelectFromExecutions(
+	ctx context.Context,
+	filter sqlplugin.ExecutionsRangeFilter,
+) ([]sqlplugin.ExecutionsRow, error) {
+	return readFromReplica(ctx, db, func(d sqlplugin.DB) ([]sqlplugin.ExecutionsRow, error) {
+		return d.RangeSelectFromExecutions(ctx, filter)
+	})
+}
+
+func (db *readReplicaDB) Close() error {
+	db.closeOnce.Do(func() {
+		close(db.shutdownCh)
+	})
+	db.wg.Wait()
+
+	var errs []error
+	for _, replica := range db.replicas {
+		errs = append(errs, replica.db.Close())
+	}
+	errs = append(errs, db.DB.Close())
+	return errors.Join(errs...)
+}
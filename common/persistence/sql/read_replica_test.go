@@ -0,0 +1,196 @@
+package sql
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
+	p "go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/sql/sqlplugin"
+)
+
+type testReplicaDB struct {
+	sqlplugin.DB
+	name    string
+	lag     time.Duration
+	lagErr  error
+	readErr error
+	reads   int
+	closed  bool
+}
+
+func (db *testReplicaDB) SelectFromVisibility(
+	_ context.Context,
+	_ sqlplugin.VisibilitySelectFilter,
+) ([]sqlplugin.VisibilityRow, error) {
+	db.reads++
+	if db.readErr != nil {
+		return nil, db.readErr
+	}
+	return []sqlplugin.VisibilityRow{{WorkflowID: db.name}}, nil
+}
+
+func (db *testReplicaDB) CountFromVisibility(
+	_ context.Context,
+	_ sqlplugin.VisibilitySelectFilter,
+) (int64, error) {
+	db.reads++
+	return 1, db.readErr
+}
+
+func (db *testReplicaDB) RangeSelectFromHistoryNode(
+	_ context.Context,
+	_ sqlplugin.HistoryNodeSelectFilter,
+) ([]sqlplugin.HistoryNodeRow, error) {
+	db.reads++
+	return nil, db.readErr
+}
+
+func (db *testReplicaDB) RangeSelectFromExecutions(
+	_ context.Context,
+	_ sqlplugin.ExecutionsRangeFilter,
+) ([]sqlplugin.ExecutionsRow, error) {
+	db.reads++
+	return nil, db.readErr
+}
+
+func (db *testReplicaDB) ReplicationLag(_ context.Context) (time.Duration, error) {
+	return db.lag, db.lagErr
+}
+
+func (db *testReplicaDB) Close() error {
+	db.closed = true
+	return nil
+}
+
+func newTestReadReplicaDB(primary *testReplicaDB, replicas ...*testReplicaDB) *readReplicaDB {
+	readReplicas := make([]*readReplica, 0, len(replicas))
+	for _, replica := range replicas {
+		readReplicas = append(readReplicas, &readReplica{addr: replica.name, db: replica})
+	}
+	return newReadReplicaDB(primary, readReplicas, &config.SQLReadReplicas{}, log.NewNoopLogger(), metrics.NoopMetricsHandler)
+}
+
+func TestReadReplicaDB_Visibility(t *testing.T) {
+	primary := &testReplicaDB{name: "primary"}
+	replica1 := &testReplicaDB{name: "replica1"}
+	replica2 := &testReplicaDB{name: "replica2"}
+	db := newTestReadReplicaDB(primary, replica1, replica2)
+	ctx := context.Background()
+
+	// replicas aren't read from before their replication lag is checked
+	rows, err := db.SelectFromVisibility(ctx, sqlplugin.VisibilitySelectFilter{})
+	require.NoError(t, err)
+	require.Equal(t, "primary", rows[0].WorkflowID)
+
+	db.checkReplicationLag()
+	for range 4 {
+		rows, err = db.SelectFromVisibility(ctx, sqlplugin.VisibilitySelectFilter{})
+		require.NoError(t, err)
+		require.NotEqual(t, "primary", rows[0].WorkflowID)
+	}
+	require.Equal(t, 2, replica1.reads)
+	require.Equal(t, 2, replica2.reads)
+
+	_, err = db.CountFromVisibility(ctx, sqlplugin.VisibilitySelectFilter{})
+	require.NoError(t, err)
+	require.Equal(t, 1, primary.reads)
+}
+
+func TestReadReplicaDB_ReplicationLag(t *testing.T) {
+	primary := &testReplicaDB{name: "primary"}
+	replica := &testReplicaDB{name: "replica", lag: time.Minute}
+	db := newTestReadReplicaDB(primary, replica)
+	ctx := context.Background()
+
+	db.checkReplicationLag()
+	rows, err := db.SelectFromVisibility(ctx, sqlplugin.VisibilitySelectFilter{})
+	require.NoError(t, err)
+	require.Equal(t, "primary", rows[0].WorkflowID)
+
+	replica.lag = time.Second
+	db.checkReplicationLag()
+	rows, err = db.SelectFromVisibility(ctx, sqlplugin.VisibilitySelectFilter{})
+	require.NoError(t, err)
+	require.Equal(t, "replica", rows[0].WorkflowID)
+
+	replica.lagErr = errors.New("connection refused")
+	db.checkReplicationLag()
+	rows, err = db.SelectFromVisibility(ctx, sqlplugin.VisibilitySelectFilter{})
+	require.NoError(t, err)
+	require.Equal(t, "primary", rows[0].WorkflowID)
+}
+
+func TestReadReplicaDB_FallbackOnReplicaError(t *testing.T) {
+	primary := &testReplicaDB{name: "primary"}
+	replica := &testReplicaDB{name: "replica", readErr: errors.New("connection reset")}
+	db := newTestReadReplicaDB(primary, replica)
+	db.checkReplicationLag()
+
+	rows, err := db.SelectFromVisibility(context.Background(), sqlplugin.VisibilitySelectFilter{})
+	require.NoError(t, err)
+	require.Equal(t, "primary", rows[0].WorkflowID)
+	require.Equal(t, 1, replica.reads)
+
+	primary.readErr = errors.New("primary error")
+	_, err = db.SelectFromVisibility(context.Background(), sqlplugin.VisibilitySelectFilter{})
+	require.ErrorIs(t, err, primary.readErr)
+}
+
+func TestReadReplicaDB_HistoryNode(t *testing.T) {
+	primary := &testReplicaDB{name: "primary"}
+	replica := &testReplicaDB{name: "replica"}
+	db := newTestReadReplicaDB(primary, replica)
+	db.checkReplicationLag()
+	ctx := context.Background()
+
+	_, err := db.RangeSelectFromHistoryNode(ctx, sqlplugin.HistoryNodeSelectFilter{})
+	require.NoError(t, err)
+	require.Equal(t, 1, primary.reads)
+	require.Equal(t, 0, replica.reads)
+
+	_, err = db.RangeSelectFromHistoryNode(p.WithReplicaReads(ctx), sqlplugin.HistoryNodeSelectFilter{})
+	require.NoError(t, err)
+	require.Equal(t, 1, primary.reads)
+	require.Equal(t, 1, replica.reads)
+
+	_, err = db.RangeSelectFromHistoryNode(p.WithoutReplicaReads(p.WithReplicaReads(ctx)), sqlplugin.HistoryNodeSelectFilter{})
+	require.NoError(t, err)
+	require.Equal(t, 2, primary.reads)
+	require.Equal(t, 1, replica.reads)
+}
+
+func TestReadReplicaDB_Executions(t *testing.T) {
+	primary := &testReplicaDB{name: "primary"}
+	replica := &testReplicaDB{name: "replica"}
+	db := newTestReadReplicaDB(primary, replica)
+	db.checkReplicationLag()
+	ctx := context.Background()
+
+	_, err := db.RangeSelectFromExecutions(ctx, sqlplugin.ExecutionsRangeFilter{})
+	require.NoError(t, err)
+	require.Equal(t, 0, primary.reads)
+	require.Equal(t, 1, replica.reads)
+
+	replica.readErr = errors.New("connection reset")
+	_, err = db.RangeSelectFromExecutions(ctx, sqlplugin.ExecutionsRangeFilter{})
+	require.NoError(t, err)
+	require.Equal(t, 1, primary.reads)
+	require.Equal(t, 2, replica.reads)
+}
+
+func TestReadReplicaDB_Close(t *testing.T) {
+	primary := &testReplicaDB{name: "primary"}
+	replica := &testReplicaDB{name: "replica"}
+	db := newTestReadReplicaDB(primary, replica)
+	db.start()
+
+	require.NoError(t, db.Close())
+	require.True(t, primary.closed)
+	require.True(t, replica.closed)
+}
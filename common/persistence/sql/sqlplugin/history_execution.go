@@ -35,6 +35,17 @@ type (
 		RunID       primitives.UUID
 	}
 
+	// ExecutionsRangeFilter contains the column names within executions table that
+	// can be used to page through the executions of a shard
+	ExecutionsRangeFilter struct {
+		ShardID int32
+		// the page starts after the row with these keys, exclusive
+		MinNamespaceID primitives.UUID
+		MinWorkflowID  string
+		MinRunID       primitives.UUID
+		PageSize       int
+	}
+
 	// CurrentExecutionsRow represents a row in current_executions table
 	CurrentExecutionsRow struct {
 		ShardID          int32
@@ -59,6 +70,16 @@ type (
 		RunID       primitives.UUID
 	}
 
+	// CurrentExecutionsRangeFilter contains the column names within current_executions table that
+	// can be used to page through the current executions of a shard
+	CurrentExecutionsRangeFilter struct {
+		ShardID int32
+		// the page starts after the row with these keys, exclusive
+		MinNamespaceID primitives.UUID
+		MinWorkflowID  string
+		PageSize       int
+	}
+
 	// TODO remove this block in 1.12.x
 	ExecutionVersion struct {
 		DBRecordVersion int64
@@ -70,6 +91,9 @@ type (
 		InsertIntoExecutions(ctx context.Context, row *ExecutionsRow) (sql.Result, error)
 		UpdateExecutions(ctx context.Context, row *ExecutionsRow) (sql.Result, error)
 		SelectFromExecutions(ctx context.Context, filter ExecutionsFilter) (*ExecutionsRow, error)
+		// RangeSelectFromExecutions returns the rows of a shard after the keys of the filter, ordered by
+		// namespace ID, workflow ID and run ID
+		RangeSelectFromExecutions(ctx context.Context, filter ExecutionsRangeFilter) ([]ExecutionsRow, error)
 		DeleteFromExecutions(ctx context.Context, filter ExecutionsFilter) (sql.Result, error)
 		ReadLockExecutions(ctx context.Context, filter ExecutionsFilter) (int64, int64, error)
 		WriteLockExecutions(ctx context.Context, filter ExecutionsFilter) (int64, int64, error)
@@ -81,6 +105,9 @@ type (
 		// SelectFromCurrentExecutions returns one or more rows from current_executions table
 		// Required params - {shardID, namespaceID, workflowID}
 		SelectFromCurrentExecutions(ctx context.Context, filter CurrentExecutionsFilter) (*CurrentExecutionsRow, error)
+		// RangeSelectFromCurrentExecutions returns the rows of a shard after the keys of the filter, ordered by
+		// namespace ID and workflow ID
+		RangeSelectFromCurrentExecutions(ctx context.Context, filter CurrentExecutionsRangeFilter) ([]CurrentExecutionsRow, error)
 		// DeleteFromCurrentExecutions deletes a single row that matches the filter criteria
 		// If a row exist, that row will be deleted and this method will return success
 		// If there is no row matching the filter criteria, this method will still return success
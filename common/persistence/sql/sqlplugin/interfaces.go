@@ -3,6 +3,8 @@ package sqlplugin
 import (
 	"context"
 	"database/sql"
+	"errors"
+	"time"
 
 	"github.com/jmoiron/sqlx"
 	"go.temporal.io/server/common/config"
@@ -15,6 +17,9 @@ type (
 	DbKind int
 )
 
+// ErrReplicationStopped is returned by ReplicaDB.ReplicationLag when the replica isn't replicating
+var ErrReplicationStopped = errors.New("replica is not replicating from its primary")
+
 const (
 	DbKindUnknown DbKind = iota
 	DbKindMain
@@ -27,6 +32,24 @@ type VersionedBlob struct {
 	DataEncoding string
 }
 
+type (
+	// SchemaColumnRow is a column of a table of a database, as reported by the database
+	SchemaColumnRow struct {
+		TableName  string
+		ColumnName string
+		ColumnType string
+		Nullable   bool
+	}
+
+	// SchemaIndexRow is an index of a table of a database. Definition lists the indexed columns in order, prefixed
+	// with UNIQUE for unique indexes, e.g. "UNIQUE (shard_id, task_id)".
+	SchemaIndexRow struct {
+		TableName  string
+		IndexName  string
+		Definition string
+	}
+)
+
 type (
 	// Plugin defines the interface for any SQL database that needs to implement
 	Plugin interface {
@@ -79,6 +102,8 @@ type (
 		UpdateSchemaVersion(database string, newVersion string, minCompatibleVersion string) error
 		WriteSchemaUpdateLog(oldVersion string, newVersion string, manifestMD5 string, desc string) error
 		ListTables(database string) ([]string, error)
+		ListColumns(database string) ([]SchemaColumnRow, error)
+		ListIndexes(database string) ([]SchemaIndexRow, error)
 		DropTable(table string) error
 		DropAllTables(database string) error
 		CreateDatabase(database string) error
@@ -115,6 +140,15 @@ type (
 		Close() error
 	}
 
+	// ReplicaDB is implemented by the DB of plugins that can tell how far a read replica is behind its primary
+	ReplicaDB interface {
+		// ReplicationLag returns how long ago the last transaction applied by the database was committed on its
+		// primary, or 0 if the database isn't a replica or has applied every transaction of its primary. It returns
+		// ErrReplicationStopped if the database is a replica that doesn't receive or apply changes, or can't tell
+		// how far behind it is.
+		ReplicationLag(ctx context.Context) (time.Duration, error)
+	}
+
 	// Conn defines the API for a single database connection
 	Conn interface {
 		Rebind(query string) string
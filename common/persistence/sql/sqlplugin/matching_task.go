@@ -20,6 +20,7 @@ type (
 	TasksFilter struct {
 		RangeHash          uint32
 		TaskQueueID        []byte
+		TaskID             *int64
 		InclusiveMinTaskID *int64
 		ExclusiveMaxTaskID *int64
 		Limit              *int
@@ -36,6 +37,7 @@ type (
 		// Required filter params:
 		//    - {namespaceID, taskqueueName, taskType, exclusiveMaxTaskID, limit }
 		//    - this will delete upto limit number of tasks less than the given max task id
+		//    - or {namespaceID, taskqueueName, taskType, taskID} to delete a single task
 		DeleteFromTasks(ctx context.Context, filter TasksFilter) (sql.Result, error)
 	}
 )
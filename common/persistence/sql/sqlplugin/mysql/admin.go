@@ -3,6 +3,8 @@ package mysql
 import (
 	"fmt"
 	"time"
+
+	"go.temporal.io/server/common/persistence/sql/sqlplugin"
 )
 
 const (
@@ -40,6 +42,15 @@ const (
 
 	listTablesQuery = "SHOW TABLES FROM %v"
 
+	listColumnsQuery = `SELECT table_name AS table_name, column_name AS column_name, column_type AS column_type, ` +
+		`is_nullable = 'YES' AS nullable ` +
+		`FROM information_schema.columns WHERE table_schema = ?`
+
+	listIndexesQuery = `SELECT table_name AS table_name, index_name AS index_name, ` +
+		`CONCAT(IF(non_unique = 0, 'UNIQUE ', ''), '(', COALESCE(GROUP_CONCAT(column_name ORDER BY seq_in_index SEPARATOR ', '), ''), ')') AS definition ` +
+		`FROM information_schema.statistics WHERE table_schema = ? ` +
+		`GROUP BY table_name, index_name, non_unique`
+
 	dropTableQuery = "DROP TABLE %v"
 )
 
@@ -94,6 +105,28 @@ func (mdb *db) ListTables(database string) ([]string, error) {
 	return tables, mdb.handle.ConvertError(err)
 }
 
+// ListColumns returns the columns of all tables of the given database
+func (mdb *db) ListColumns(database string) ([]sqlplugin.SchemaColumnRow, error) {
+	var columns []sqlplugin.SchemaColumnRow
+	db, err := mdb.handle.DB()
+	if err != nil {
+		return nil, err
+	}
+	err = db.Select(&columns, listColumnsQuery, database)
+	return columns, mdb.handle.ConvertError(err)
+}
+
+// ListIndexes returns the indexes of all tables of the given database, including their primary keys
+func (mdb *db) ListIndexes(database string) ([]sqlplugin.SchemaIndexRow, error) {
+	var indexes []sqlplugin.SchemaIndexRow
+	db, err := mdb.handle.DB()
+	if err != nil {
+		return nil, err
+	}
+	err = db.Select(&indexes, listIndexesQuery, database)
+	return indexes, mdb.handle.ConvertError(err)
+}
+
 // DropTable drops a given table from the database
 func (mdb *db) DropTable(name string) error {
 	return mdb.Exec(fmt.Sprintf(dropTableQuery, name))
@@ -0,0 +1,49 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"go.temporal.io/server/common/persistence/sql/sqlplugin"
+)
+
+// SHOW REPLICA STATUS returns no row on a primary. Seconds_Behind_Source is NULL while the SQL thread isn't
+// running or the I/O thread isn't connected to the source.
+const replicaStatusQuery = `SHOW REPLICA STATUS`
+
+// replicaStatus holds the columns of SHOW REPLICA STATUS used to tell the replication lag, the statement
+// returns many more.
+type replicaStatus struct {
+	IORunning           string        `db:"Replica_IO_Running"`
+	SQLRunning          string        `db:"Replica_SQL_Running"`
+	SecondsBehindSource sql.NullInt64 `db:"Seconds_Behind_Source"`
+}
+
+var _ sqlplugin.ReplicaDB = (*db)(nil)
+
+// ReplicationLag returns how far the database is behind its source if it's a replica
+func (mdb *db) ReplicationLag(ctx context.Context) (time.Duration, error) {
+	conn, err := mdb.handle.DB()
+	if err != nil {
+		return 0, err
+	}
+	var status replicaStatus
+	// Unsafe ignores the columns that replicaStatus doesn't map
+	err = conn.Unsafe().GetContext(ctx, &status, replicaStatusQuery)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, mdb.handle.ConvertError(err)
+	}
+	return status.lag()
+}
+
+func (s replicaStatus) lag() (time.Duration, error) {
+	if s.IORunning != "Yes" || s.SQLRunning != "Yes" || !s.SecondsBehindSource.Valid {
+		return 0, sqlplugin.ErrReplicationStopped
+	}
+	return time.Duration(s.SecondsBehindSource.Int64) * time.Second, nil
+}
@@ -48,6 +48,14 @@ var (
 		DELETE FROM custom_search_attributes
 		WHERE namespace_id = :namespace_id AND run_id = :run_id`
 
+	templateUpdateSearchAttributes_v8 = `
+		UPDATE executions_visibility SET search_attributes = :search_attributes
+		WHERE namespace_id = :namespace_id AND run_id = :run_id AND _version = :_version`
+
+	templateUpdateCustomSearchAttributes = `
+		UPDATE custom_search_attributes SET search_attributes = :search_attributes
+		WHERE namespace_id = :namespace_id AND run_id = :run_id AND _version = :_version`
+
 	templateGetWorkflowExecution_v8 = fmt.Sprintf(
 		`SELECT %s FROM executions_visibility
 		WHERE namespace_id = :namespace_id AND run_id = :run_id`,
@@ -247,6 +255,19 @@ func (mdb *db) CountFromVisibility(
 	return count, nil
 }
 
+// ExplainVisibilityQuery returns the execution plan of the query in the tree format of MySQL
+func (mdb *db) ExplainVisibilityQuery(
+	ctx context.Context,
+	filter sqlplugin.VisibilitySelectFilter,
+) ([]string, error) {
+	var plan string
+	err := mdb.GetContext(ctx, &plan, "EXPLAIN FORMAT=TREE "+filter.Query, filter.QueryArgs...)
+	if err != nil {
+		return nil, err
+	}
+	return strings.Split(plan, "\n"), nil
+}
+
 func (mdb *db) CountGroupByFromVisibility(
 	ctx context.Context,
 	filter sqlplugin.VisibilitySelectFilter,
@@ -266,6 +287,67 @@ func (mdb *db) CountGroupByFromVisibility(
 	return sqlplugin.ParseCountGroupByRows(rows, filter.GroupBy)
 }
 
+// SelectSearchAttributesFromVisibility reads the search attributes of the rows matching the filter.
+// Custom search attributes columns are only in custom_search_attributes table.
+func (mdb *db) SelectSearchAttributesFromVisibility(
+	ctx context.Context,
+	filter sqlplugin.VisibilitySearchAttributesFilter,
+) ([]sqlplugin.VisibilitySearchAttributesRow, error) {
+	query, args := sqlplugin.GenerateSelectSearchAttributesQuery("custom_search_attributes", filter)
+	var rows []sqlplugin.VisibilitySearchAttributesRow
+	err := mdb.SelectContext(ctx, &rows, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	for i := range rows {
+		err = processSearchAttributesFromDB(rows[i].SearchAttributes)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return rows, nil
+}
+
+// UpdateSearchAttributesInVisibility replaces the search attributes of a row in both executions_visibility and
+// custom_search_attributes tables if its version didn't change
+func (mdb *db) UpdateSearchAttributesInVisibility(
+	ctx context.Context,
+	row *sqlplugin.VisibilitySearchAttributesRow,
+) (result sql.Result, retError error) {
+	defer func() {
+		retError = mdb.handle.ConvertError(retError)
+	}()
+	db, err := mdb.handle.DB()
+	if err != nil {
+		return nil, err
+	}
+	tx, err := db.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		err := tx.Rollback()
+		// If the error is sql.ErrTxDone, it means the transaction already closed, so ignore error.
+		if err != nil && !errors.Is(err, sql.ErrTxDone) {
+			// Transaction rollback error should never happen, unless db connection was lost.
+			retError = fmt.Errorf("transaction rollback failed: %w", retError)
+		}
+	}()
+	result, err = tx.NamedExecContext(ctx, templateUpdateSearchAttributes_v8, row)
+	if err != nil {
+		return nil, fmt.Errorf("unable to update workflow execution: %w", err)
+	}
+	_, err = tx.NamedExecContext(ctx, templateUpdateCustomSearchAttributes, row)
+	if err != nil {
+		return nil, fmt.Errorf("unable to update custom search attributes: %w", err)
+	}
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
 func (mdb *db) prepareRowForDB(row *sqlplugin.VisibilityRow) *sqlplugin.VisibilityRow {
 	if row == nil {
 		return nil
@@ -289,24 +371,29 @@ func (mdb *db) processRowFromDB(row *sqlplugin.VisibilityRow) error {
 		closeTime := mdb.converter.FromMySQLDateTime(*row.CloseTime)
 		row.CloseTime = &closeTime
 	}
-	if row.SearchAttributes != nil {
-		for saName, saValue := range *row.SearchAttributes {
-			switch typedSaValue := saValue.(type) {
-			case []interface{}:
-				// the only valid type is slice of strings
-				strSlice := make([]string, len(typedSaValue))
-				for i, item := range typedSaValue {
-					switch v := item.(type) {
-					case string:
-						strSlice[i] = v
-					default:
-						return fmt.Errorf("%w: %T (expected string)", sqlplugin.ErrInvalidKeywordListDataType, v)
-					}
+	return processSearchAttributesFromDB(row.SearchAttributes)
+}
+
+func processSearchAttributesFromDB(searchAttributes *sqlplugin.VisibilitySearchAttributes) error {
+	if searchAttributes == nil {
+		return nil
+	}
+	for saName, saValue := range *searchAttributes {
+		switch typedSaValue := saValue.(type) {
+		case []interface{}:
+			// the only valid type is slice of strings
+			strSlice := make([]string, len(typedSaValue))
+			for i, item := range typedSaValue {
+				switch v := item.(type) {
+				case string:
+					strSlice[i] = v
+				default:
+					return fmt.Errorf("%w: %T (expected string)", sqlplugin.ErrInvalidKeywordListDataType, v)
 				}
-				(*row.SearchAttributes)[saName] = strSlice
-			default:
-				// no-op
 			}
+			(*searchAttributes)[saName] = strSlice
+		default:
+			// no-op
 		}
 	}
 	return nil
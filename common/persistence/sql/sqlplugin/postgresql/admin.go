@@ -3,6 +3,8 @@ package postgresql
 import (
 	"fmt"
 	"time"
+
+	"go.temporal.io/server/common/persistence/sql/sqlplugin"
 )
 
 const (
@@ -44,6 +46,22 @@ const (
 
 	listTablesQuery = "select table_name from information_schema.tables where table_schema='public'"
 
+	listColumnsQuery = `SELECT table_name, column_name, ` +
+		`CASE WHEN character_maximum_length IS NULL THEN data_type ELSE data_type || '(' || character_maximum_length || ')' END AS column_type, ` +
+		`is_nullable = 'YES' AS nullable ` +
+		`FROM information_schema.columns WHERE table_schema = 'public'`
+
+	listIndexesQuery = `SELECT t.relname AS table_name, i.relname AS index_name, ` +
+		`CASE WHEN ix.indisunique THEN 'UNIQUE ' ELSE '' END || '(' || COALESCE(string_agg(a.attname, ', ' ORDER BY k.n), '') || ')' AS definition ` +
+		`FROM pg_index ix ` +
+		`JOIN pg_class i ON i.oid = ix.indexrelid ` +
+		`JOIN pg_class t ON t.oid = ix.indrelid ` +
+		`JOIN pg_namespace ns ON ns.oid = t.relnamespace ` +
+		`CROSS JOIN LATERAL unnest(ix.indkey) WITH ORDINALITY AS k(attnum, n) ` +
+		`LEFT JOIN pg_attribute a ON a.attrelid = t.oid AND a.attnum = k.attnum ` +
+		`WHERE ns.nspname = 'public' ` +
+		`GROUP BY t.relname, i.relname, ix.indisunique`
+
 	dropTableQuery = "DROP TABLE %v"
 )
 
@@ -95,6 +113,20 @@ func (pdb *db) ListTables(database string) ([]string, error) {
 	return tables, pdb.handle.ConvertError(err)
 }
 
+// ListColumns returns the columns of all tables of the database
+func (pdb *db) ListColumns(database string) ([]sqlplugin.SchemaColumnRow, error) {
+	var columns []sqlplugin.SchemaColumnRow
+	err := pdb.Select(&columns, listColumnsQuery)
+	return columns, pdb.handle.ConvertError(err)
+}
+
+// ListIndexes returns the indexes of all tables of the database, including their primary keys
+func (pdb *db) ListIndexes(database string) ([]sqlplugin.SchemaIndexRow, error) {
+	var indexes []sqlplugin.SchemaIndexRow
+	err := pdb.Select(&indexes, listIndexesQuery)
+	return indexes, pdb.handle.ConvertError(err)
+}
+
 // DropTable drops a given table from the database
 func (pdb *db) DropTable(name string) error {
 	return pdb.Exec(fmt.Sprintf(dropTableQuery, name))
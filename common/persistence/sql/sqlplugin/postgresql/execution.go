@@ -20,6 +20,10 @@ const (
 	getExecutionQuery = `SELECT ` + executionsColumns + ` FROM executions
  WHERE shard_id = $1 AND namespace_id = $2 AND workflow_id = $3 AND run_id = $4`
 
+	rangeSelectExecutionsQuery = `SELECT ` + executionsColumns + ` FROM executions
+ WHERE shard_id = $1 AND (namespace_id, workflow_id, run_id) > ($2, $3, $4)
+ ORDER BY namespace_id, workflow_id, run_id LIMIT $5`
+
 	deleteExecutionQuery = `DELETE FROM executions 
  WHERE shard_id = $1 AND namespace_id = $2 AND workflow_id = $3 AND run_id = $4`
 
@@ -39,6 +43,11 @@ const (
 shard_id, namespace_id, workflow_id, run_id, create_request_id, state, status, start_time, last_write_version, data, data_encoding
 FROM current_executions WHERE shard_id = $1 AND namespace_id = $2 AND workflow_id = $3`
 
+	rangeSelectCurrentExecutionsQuery = `SELECT
+shard_id, namespace_id, workflow_id, run_id, create_request_id, state, status, start_time, last_write_version, data, data_encoding
+FROM current_executions WHERE shard_id = $1 AND (namespace_id, workflow_id) > ($2, $3)
+ORDER BY namespace_id, workflow_id LIMIT $4`
+
 	lockCurrentExecutionJoinExecutionsQuery = `SELECT
 ce.shard_id, ce.namespace_id, ce.workflow_id, ce.run_id, ce.create_request_id, ce.state, ce.status, ce.start_time, e.last_write_version, ce.data, ce.data_encoding
 FROM current_executions ce
@@ -206,6 +215,25 @@ func (pdb *db) SelectFromExecutions(
 	return &row, nil
 }
 
+// RangeSelectFromExecutions reads a page of rows from executions table
+func (pdb *db) RangeSelectFromExecutions(
+	ctx context.Context,
+	filter sqlplugin.ExecutionsRangeFilter,
+) ([]sqlplugin.ExecutionsRow, error) {
+	var rows []sqlplugin.ExecutionsRow
+	if err := pdb.SelectContext(ctx,
+		&rows, rangeSelectExecutionsQuery,
+		filter.ShardID,
+		filter.MinNamespaceID,
+		filter.MinWorkflowID,
+		filter.MinRunID,
+		filter.PageSize,
+	); err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
 // DeleteFromExecutions deletes a single row from executions table
 func (pdb *db) DeleteFromExecutions(
 	ctx context.Context,
@@ -292,6 +320,24 @@ func (pdb *db) SelectFromCurrentExecutions(
 	return &row, err
 }
 
+// RangeSelectFromCurrentExecutions reads a page of rows from current_executions table
+func (pdb *db) RangeSelectFromCurrentExecutions(
+	ctx context.Context,
+	filter sqlplugin.CurrentExecutionsRangeFilter,
+) ([]sqlplugin.CurrentExecutionsRow, error) {
+	var rows []sqlplugin.CurrentExecutionsRow
+	if err := pdb.SelectContext(ctx,
+		&rows, rangeSelectCurrentExecutionsQuery,
+		filter.ShardID,
+		filter.MinNamespaceID,
+		filter.MinWorkflowID,
+		filter.PageSize,
+	); err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
 // DeleteFromCurrentExecutions deletes a single row in current_executions table
 func (pdb *db) DeleteFromCurrentExecutions(
 	ctx context.Context,
@@ -0,0 +1,51 @@
+package postgresql
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"go.temporal.io/server/common/persistence/sql/sqlplugin"
+)
+
+// The replay timestamp is NULL until the standby has replayed a transaction, and the WAL receiver only has a
+// row while it's running. The replay timestamp of an idle standby doesn't change, so a standby that streams
+// from its primary and has replayed all the WAL it received isn't considered behind.
+const replicationStatusQuery = `SELECT
+	pg_is_in_recovery() AS in_recovery,
+	EXISTS (SELECT 1 FROM pg_stat_wal_receiver WHERE status = 'streaming') AS streaming,
+	COALESCE(pg_last_wal_receive_lsn() = pg_last_wal_replay_lsn(), FALSE) AS caught_up,
+	EXTRACT(EPOCH FROM now() - pg_last_xact_replay_timestamp())::float8 AS lag_seconds`
+
+type replicationStatus struct {
+	InRecovery bool            `db:"in_recovery"`
+	Streaming  bool            `db:"streaming"`
+	CaughtUp   bool            `db:"caught_up"`
+	LagSeconds sql.NullFloat64 `db:"lag_seconds"`
+}
+
+var _ sqlplugin.ReplicaDB = (*db)(nil)
+
+// ReplicationLag returns how far the database is behind its primary if it's a hot standby
+func (pdb *db) ReplicationLag(ctx context.Context) (time.Duration, error) {
+	var status replicationStatus
+	if err := pdb.GetContext(ctx, &status, replicationStatusQuery); err != nil {
+		return 0, err
+	}
+	return status.lag()
+}
+
+func (s replicationStatus) lag() (time.Duration, error) {
+	switch {
+	case !s.InRecovery:
+		return 0, nil
+	case !s.Streaming:
+		return 0, sqlplugin.ErrReplicationStopped
+	case s.CaughtUp:
+		return 0, nil
+	case !s.LagSeconds.Valid:
+		return 0, sqlplugin.ErrReplicationStopped
+	default:
+		return time.Duration(s.LagSeconds.Float64 * float64(time.Second)), nil
+	}
+}
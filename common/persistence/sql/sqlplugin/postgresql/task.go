@@ -57,6 +57,9 @@ task_queue_id = :task_queue_id
 		`tasks(range_hash, task_queue_id, task_id, data, data_encoding) ` +
 		`VALUES(:range_hash, :task_queue_id, :task_id, :data, :data_encoding)`
 
+	deleteTaskQry = `DELETE FROM tasks ` +
+		`WHERE range_hash = $1 AND task_queue_id = $2 AND task_id = $3`
+
 	rangeDeleteTaskQry = `DELETE FROM tasks ` +
 		`WHERE range_hash = $1 AND task_queue_id = $2 AND task_id IN (SELECT task_id FROM
 		 tasks WHERE range_hash = $1 AND task_queue_id = $2 AND task_id < $3 ` +
@@ -132,6 +135,14 @@ func (pdb *db) DeleteFromTasks(
 	ctx context.Context,
 	filter sqlplugin.TasksFilter,
 ) (sql.Result, error) {
+	if filter.TaskID != nil {
+		return pdb.ExecContext(ctx,
+			deleteTaskQry,
+			filter.RangeHash,
+			filter.TaskQueueID,
+			*filter.TaskID,
+		)
+	}
 	if filter.ExclusiveMaxTaskID == nil {
 		return nil, serviceerror.NewInternal("missing ExclusiveMaxTaskID parameter")
 	}
@@ -31,6 +31,10 @@ var (
 		DELETE FROM executions_visibility
 		WHERE namespace_id = :namespace_id AND run_id = :run_id`
 
+	templateUpdateSearchAttributes_v12 = `
+		UPDATE executions_visibility SET search_attributes = :search_attributes
+		WHERE namespace_id = :namespace_id AND run_id = :run_id AND _version = :_version`
+
 	templateGetWorkflowExecution_v12 = fmt.Sprintf(
 		`SELECT %s FROM executions_visibility
 		WHERE namespace_id = :namespace_id AND run_id = :run_id`,
@@ -144,6 +148,19 @@ func (pdb *db) CountFromVisibility(
 	return count, nil
 }
 
+// ExplainVisibilityQuery returns the execution plan of the query, one row of the PostgreSQL plan per line
+func (pdb *db) ExplainVisibilityQuery(
+	ctx context.Context,
+	filter sqlplugin.VisibilitySelectFilter,
+) ([]string, error) {
+	var plan []string
+	err := pdb.SelectContext(ctx, &plan, "EXPLAIN "+pdb.Rebind(filter.Query), filter.QueryArgs...)
+	if err != nil {
+		return nil, err
+	}
+	return plan, nil
+}
+
 func (pdb *db) CountGroupByFromVisibility(
 	ctx context.Context,
 	filter sqlplugin.VisibilitySelectFilter,
@@ -157,6 +174,35 @@ func (pdb *db) CountGroupByFromVisibility(
 	return sqlplugin.ParseCountGroupByRows(rows, filter.GroupBy)
 }
 
+// SelectSearchAttributesFromVisibility reads the search attributes of the rows matching the filter
+func (pdb *db) SelectSearchAttributesFromVisibility(
+	ctx context.Context,
+	filter sqlplugin.VisibilitySearchAttributesFilter,
+) ([]sqlplugin.VisibilitySearchAttributesRow, error) {
+	query, args := sqlplugin.GenerateSelectSearchAttributesQuery("executions_visibility", filter)
+	var rows []sqlplugin.VisibilitySearchAttributesRow
+	err := pdb.SelectContext(ctx, &rows, pdb.Rebind(query), args...)
+	if err != nil {
+		return nil, err
+	}
+	for i := range rows {
+		rows[i].RunID = strings.TrimSpace(rows[i].RunID)
+		err = processSearchAttributesFromDB(rows[i].SearchAttributes)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return rows, nil
+}
+
+// UpdateSearchAttributesInVisibility replaces the search attributes of a row if its version didn't change
+func (pdb *db) UpdateSearchAttributesInVisibility(
+	ctx context.Context,
+	row *sqlplugin.VisibilitySearchAttributesRow,
+) (sql.Result, error) {
+	return pdb.NamedExecContext(ctx, templateUpdateSearchAttributes_v12, row)
+}
+
 func (pdb *db) prepareRowForDB(row *sqlplugin.VisibilityRow) *sqlplugin.VisibilityRow {
 	if row == nil {
 		return nil
@@ -180,28 +226,33 @@ func (pdb *db) processRowFromDB(row *sqlplugin.VisibilityRow) error {
 		closeTime := pdb.converter.FromPostgreSQLDateTime(*row.CloseTime)
 		row.CloseTime = &closeTime
 	}
-	if row.SearchAttributes != nil {
-		for saName, saValue := range *row.SearchAttributes {
-			switch typedSaValue := saValue.(type) {
-			case []interface{}:
-				// the only valid type is slice of strings
-				strSlice := make([]string, len(typedSaValue))
-				for i, item := range typedSaValue {
-					switch v := item.(type) {
-					case string:
-						strSlice[i] = v
-					default:
-						return fmt.Errorf("%w: %T (expected string)", sqlplugin.ErrInvalidKeywordListDataType, v)
-					}
+	// need to trim the run ID, or otherwise the returned value will
+	// come with lots of trailing spaces, probably due to the CHAR(64) type
+	row.RunID = strings.TrimSpace(row.RunID)
+	return processSearchAttributesFromDB(row.SearchAttributes)
+}
+
+func processSearchAttributesFromDB(searchAttributes *sqlplugin.VisibilitySearchAttributes) error {
+	if searchAttributes == nil {
+		return nil
+	}
+	for saName, saValue := range *searchAttributes {
+		switch typedSaValue := saValue.(type) {
+		case []interface{}:
+			// the only valid type is slice of strings
+			strSlice := make([]string, len(typedSaValue))
+			for i, item := range typedSaValue {
+				switch v := item.(type) {
+				case string:
+					strSlice[i] = v
+				default:
+					return fmt.Errorf("%w: %T (expected string)", sqlplugin.ErrInvalidKeywordListDataType, v)
 				}
-				(*row.SearchAttributes)[saName] = strSlice
-			default:
-				// no-op
 			}
+			(*searchAttributes)[saName] = strSlice
+		default:
+			// no-op
 		}
 	}
-	// need to trim the run ID, or otherwise the returned value will
-	// come with lots of trailing spaces, probably due to the CHAR(64) type
-	row.RunID = strings.TrimSpace(row.RunID)
 	return nil
 }
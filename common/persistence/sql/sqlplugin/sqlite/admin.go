@@ -3,6 +3,8 @@ package sqlite
 import (
 	"fmt"
 	"time"
+
+	"go.temporal.io/server/common/persistence/sql/sqlplugin"
 )
 
 const (
@@ -32,6 +34,14 @@ const (
 
 	listTablesQuery = "SELECT name FROM sqlite_master WHERE type='table'"
 
+	listColumnsQuery = `SELECT m.name AS table_name, c.name AS column_name, c.type AS column_type, c."notnull" = 0 AS nullable ` +
+		`FROM sqlite_master m JOIN pragma_table_info(m.name) c WHERE m.type = 'table'`
+
+	listIndexesQuery = `SELECT m.name AS table_name, il.name AS index_name, ` +
+		`CASE WHEN il."unique" THEN 'UNIQUE ' ELSE '' END || '(' || ` +
+		`COALESCE((SELECT group_concat(name, ', ') FROM (SELECT ii.name FROM pragma_index_info(il.name) ii ORDER BY ii.seqno)), '') || ')' AS definition ` +
+		`FROM sqlite_master m JOIN pragma_index_list(m.name) il WHERE m.type = 'table'`
+
 	dropTableQuery = "DROP TABLE %v"
 )
 
@@ -74,6 +84,20 @@ func (mdb *db) ListTables(database string) ([]string, error) {
 	return tables, err
 }
 
+// ListColumns returns the columns of all tables of the database
+func (mdb *db) ListColumns(database string) ([]sqlplugin.SchemaColumnRow, error) {
+	var columns []sqlplugin.SchemaColumnRow
+	err := mdb.db.Select(&columns, listColumnsQuery)
+	return columns, err
+}
+
+// ListIndexes returns the indexes of all tables of the database
+func (mdb *db) ListIndexes(database string) ([]sqlplugin.SchemaIndexRow, error) {
+	var indexes []sqlplugin.SchemaIndexRow
+	err := mdb.db.Select(&indexes, listIndexesQuery)
+	return indexes, err
+}
+
 // DropTable drops a given table from the database
 func (mdb *db) DropTable(name string) error {
 	return mdb.Exec(fmt.Sprintf(dropTableQuery, name))
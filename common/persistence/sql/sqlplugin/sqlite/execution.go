@@ -20,6 +20,10 @@ const (
 	getExecutionQuery = `SELECT ` + executionsColumns + ` FROM executions
  WHERE shard_id = ? AND namespace_id = ? AND workflow_id = ? AND run_id = ?`
 
+	rangeSelectExecutionsQuery = `SELECT ` + executionsColumns + ` FROM executions
+ WHERE shard_id = ? AND (namespace_id, workflow_id, run_id) > (?, ?, ?)
+ ORDER BY namespace_id, workflow_id, run_id LIMIT ?`
+
 	deleteExecutionQuery = `DELETE FROM executions 
  WHERE shard_id = ? AND namespace_id = ? AND workflow_id = ? AND run_id = ?`
 
@@ -39,6 +43,11 @@ const (
 shard_id, namespace_id, workflow_id, run_id, create_request_id, state, status, start_time, last_write_version, data, data_encoding
 FROM current_executions WHERE shard_id = ? AND namespace_id = ? AND workflow_id = ?`
 
+	rangeSelectCurrentExecutionsQuery = `SELECT
+shard_id, namespace_id, workflow_id, run_id, create_request_id, state, status, start_time, last_write_version, data, data_encoding
+FROM current_executions WHERE shard_id = ? AND (namespace_id, workflow_id) > (?, ?)
+ORDER BY namespace_id, workflow_id LIMIT ?`
+
 	lockCurrentExecutionJoinExecutionsQuery = `SELECT
 ce.shard_id, ce.namespace_id, ce.workflow_id, ce.run_id, ce.create_request_id, ce.state, ce.status, ce.start_time, e.last_write_version, ce.data, ce.data_encoding
 FROM current_executions ce
@@ -206,6 +215,25 @@ func (mdb *db) SelectFromExecutions(
 	return &row, err
 }
 
+// RangeSelectFromExecutions reads a page of rows from executions table
+func (mdb *db) RangeSelectFromExecutions(
+	ctx context.Context,
+	filter sqlplugin.ExecutionsRangeFilter,
+) ([]sqlplugin.ExecutionsRow, error) {
+	var rows []sqlplugin.ExecutionsRow
+	if err := mdb.SelectContext(ctx,
+		&rows, rangeSelectExecutionsQuery,
+		filter.ShardID,
+		filter.MinNamespaceID,
+		filter.MinWorkflowID,
+		filter.MinRunID,
+		filter.PageSize,
+	); err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
 // DeleteFromExecutions deletes a single row from executions table
 func (mdb *db) DeleteFromExecutions(
 	ctx context.Context,
@@ -292,6 +320,24 @@ func (mdb *db) SelectFromCurrentExecutions(
 	return &row, err
 }
 
+// RangeSelectFromCurrentExecutions reads a page of rows from current_executions table
+func (mdb *db) RangeSelectFromCurrentExecutions(
+	ctx context.Context,
+	filter sqlplugin.CurrentExecutionsRangeFilter,
+) ([]sqlplugin.CurrentExecutionsRow, error) {
+	var rows []sqlplugin.CurrentExecutionsRow
+	if err := mdb.SelectContext(ctx,
+		&rows, rangeSelectCurrentExecutionsQuery,
+		filter.ShardID,
+		filter.MinNamespaceID,
+		filter.MinWorkflowID,
+		filter.PageSize,
+	); err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
 // DeleteFromCurrentExecutions deletes a single row in current_executions table
 func (mdb *db) DeleteFromCurrentExecutions(
 	ctx context.Context,
@@ -136,6 +136,14 @@ func (mdb *db) DeleteFromTasks(
 	ctx context.Context,
 	filter sqlplugin.TasksFilter,
 ) (sql.Result, error) {
+	if filter.TaskID != nil {
+		return mdb.conn.ExecContext(ctx,
+			deleteTaskQry,
+			filter.RangeHash,
+			filter.TaskQueueID,
+			*filter.TaskID,
+		)
+	}
 	if filter.ExclusiveMaxTaskID == nil {
 		return nil, serviceerror.NewInternal("missing ExclusiveMaxTaskID parameter")
 	}
@@ -33,6 +33,10 @@ var (
 		DELETE FROM executions_visibility
 		WHERE namespace_id = :namespace_id AND run_id = :run_id`
 
+	templateUpdateSearchAttributes = `
+		UPDATE executions_visibility SET search_attributes = :search_attributes
+		WHERE namespace_id = :namespace_id AND run_id = :run_id AND _version = :_version`
+
 	templateGetWorkflowExecution = fmt.Sprintf(
 		`SELECT %s FROM executions_visibility
 		WHERE namespace_id = :namespace_id AND run_id = :run_id`,
@@ -139,6 +143,28 @@ func (mdb *db) CountFromVisibility(
 	return count, nil
 }
 
+// ExplainVisibilityQuery returns the query plan of the query, one step of the SQLite plan per line
+func (mdb *db) ExplainVisibilityQuery(
+	ctx context.Context,
+	filter sqlplugin.VisibilitySelectFilter,
+) ([]string, error) {
+	var steps []struct {
+		ID      int64  `db:"id"`
+		Parent  int64  `db:"parent"`
+		NotUsed int64  `db:"notused"`
+		Detail  string `db:"detail"`
+	}
+	err := mdb.conn.SelectContext(ctx, &steps, "EXPLAIN QUERY PLAN "+filter.Query, filter.QueryArgs...)
+	if err != nil {
+		return nil, err
+	}
+	plan := make([]string, len(steps))
+	for i, step := range steps {
+		plan[i] = step.Detail
+	}
+	return plan, nil
+}
+
 func (mdb *db) CountGroupByFromVisibility(
 	ctx context.Context,
 	filter sqlplugin.VisibilitySelectFilter,
@@ -151,6 +177,33 @@ func (mdb *db) CountGroupByFromVisibility(
 	return sqlplugin.ParseCountGroupByRows(rows, filter.GroupBy)
 }
 
+// SelectSearchAttributesFromVisibility reads the search attributes of the rows matching the filter
+func (mdb *db) SelectSearchAttributesFromVisibility(
+	ctx context.Context,
+	filter sqlplugin.VisibilitySearchAttributesFilter,
+) ([]sqlplugin.VisibilitySearchAttributesRow, error) {
+	query, args := sqlplugin.GenerateSelectSearchAttributesQuery("executions_visibility", filter)
+	var rows []sqlplugin.VisibilitySearchAttributesRow
+	err := mdb.conn.SelectContext(ctx, &rows, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	for i := range rows {
+		processSearchAttributesFromDB(rows[i].SearchAttributes)
+	}
+	return rows, nil
+}
+
+// UpdateSearchAttributesInVisibility replaces the search attributes of a row if its version didn't change
+func (mdb *db) UpdateSearchAttributesInVisibility(
+	ctx context.Context,
+	row *sqlplugin.VisibilitySearchAttributesRow,
+) (sql.Result, error) {
+	finalRow := *row
+	finalRow.SearchAttributes = prepareSearchAttributesForDB(row.SearchAttributes)
+	return mdb.conn.NamedExecContext(ctx, templateUpdateSearchAttributes, &finalRow)
+}
+
 func (mdb *db) prepareRowForDB(row *sqlplugin.VisibilityRow) *sqlplugin.VisibilityRow {
 	if row == nil {
 		return nil
@@ -161,19 +214,26 @@ func (mdb *db) prepareRowForDB(row *sqlplugin.VisibilityRow) *sqlplugin.Visibili
 	if finalRow.CloseTime != nil {
 		*finalRow.CloseTime = mdb.converter.ToSQLiteDateTime(*finalRow.CloseTime)
 	}
-	if finalRow.SearchAttributes != nil {
-		finalSearchAttributes := sqlplugin.VisibilitySearchAttributes{}
-		for name, value := range *finalRow.SearchAttributes {
-			switch v := value.(type) {
-			case []string:
-				finalSearchAttributes[name] = strings.Join(v, keywordListSeparator)
-			default:
-				finalSearchAttributes[name] = v
-			}
+	finalRow.SearchAttributes = prepareSearchAttributesForDB(finalRow.SearchAttributes)
+	return &finalRow
+}
+
+func prepareSearchAttributesForDB(
+	searchAttributes *sqlplugin.VisibilitySearchAttributes,
+) *sqlplugin.VisibilitySearchAttributes {
+	if searchAttributes == nil {
+		return nil
+	}
+	finalSearchAttributes := sqlplugin.VisibilitySearchAttributes{}
+	for name, value := range *searchAttributes {
+		switch v := value.(type) {
+		case []string:
+			finalSearchAttributes[name] = strings.Join(v, keywordListSeparator)
+		default:
+			finalSearchAttributes[name] = v
 		}
-		finalRow.SearchAttributes = &finalSearchAttributes
 	}
-	return &finalRow
+	return &finalSearchAttributes
 }
 
 func (mdb *db) processRowFromDB(row *sqlplugin.VisibilityRow) error {
@@ -186,19 +246,24 @@ func (mdb *db) processRowFromDB(row *sqlplugin.VisibilityRow) error {
 		closeTime := mdb.converter.FromSQLiteDateTime(*row.CloseTime)
 		row.CloseTime = &closeTime
 	}
-	if row.SearchAttributes != nil {
-		for saName, saValue := range *row.SearchAttributes {
-			switch typedSaValue := saValue.(type) {
-			case string:
-				if strings.Index(typedSaValue, keywordListSeparator) >= 0 {
-					// If the string contains the keywordListSeparator, then we need to split it
-					// into a list of keywords.
-					(*row.SearchAttributes)[saName] = strings.Split(typedSaValue, keywordListSeparator)
-				}
-			default:
-				// no-op
+	processSearchAttributesFromDB(row.SearchAttributes)
+	return nil
+}
+
+func processSearchAttributesFromDB(searchAttributes *sqlplugin.VisibilitySearchAttributes) {
+	if searchAttributes == nil {
+		return
+	}
+	for saName, saValue := range *searchAttributes {
+		switch typedSaValue := saValue.(type) {
+		case string:
+			if strings.Index(typedSaValue, keywordListSeparator) >= 0 {
+				// If the string contains the keywordListSeparator, then we need to split it
+				// into a list of keywords.
+				(*searchAttributes)[saName] = strings.Split(typedSaValue, keywordListSeparator)
 			}
+		default:
+			// no-op
 		}
 	}
-	return nil
 }
@@ -136,6 +136,40 @@ func (s *historyCurrentExecutionSuite) TestInsertSelect() {
 	s.Equal(&currentExecution, row)
 }
 
+func (s *historyCurrentExecutionSuite) TestInsertRangeSelect() {
+	shardID := rand.Int31()
+	namespaceID := primitives.NewUUID()
+
+	var currentExecutions []sqlplugin.CurrentExecutionsRow
+	for i := 0; i < 3; i++ {
+		workflowID := shuffle.String(testHistoryExecutionWorkflowID)
+		requestID := primitives.NewUUID().String()
+		currentExecution := s.newRandomCurrentExecutionRow(shardID, namespaceID, workflowID, primitives.NewUUID(), requestID, rand.Int63())
+		_, err := s.store.InsertIntoCurrentExecutions(newExecutionContext(), &currentExecution)
+		s.NoError(err)
+		currentExecutions = append(currentExecutions, currentExecution)
+	}
+
+	filter := sqlplugin.CurrentExecutionsRangeFilter{
+		ShardID:        shardID,
+		MinNamespaceID: primitives.UUID{},
+		PageSize:       2,
+	}
+	var rows []sqlplugin.CurrentExecutionsRow
+	for {
+		page, err := s.store.RangeSelectFromCurrentExecutions(newExecutionContext(), filter)
+		s.NoError(err)
+		rows = append(rows, page...)
+		if len(page) < filter.PageSize {
+			break
+		}
+		last := page[len(page)-1]
+		filter.MinNamespaceID = last.NamespaceID
+		filter.MinWorkflowID = last.WorkflowID
+	}
+	s.ElementsMatch(currentExecutions, rows)
+}
+
 func (s *historyCurrentExecutionSuite) TestInsertUpdate_Success() {
 	shardID := rand.Int31()
 	namespaceID := primitives.NewUUID()
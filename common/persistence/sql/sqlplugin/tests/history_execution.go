@@ -120,6 +120,41 @@ func (s *historyExecutionSuite) TestInsertSelect() {
 	s.Equal(&execution, row)
 }
 
+func (s *historyExecutionSuite) TestInsertRangeSelect() {
+	shardID := rand.Int31()
+	namespaceID := primitives.NewUUID()
+	workflowID := shuffle.String(testHistoryExecutionWorkflowID)
+
+	var executions []sqlplugin.ExecutionsRow
+	for i := 0; i < 3; i++ {
+		execution := s.newRandomExecutionRow(shardID, namespaceID, workflowID, primitives.NewUUID(), rand.Int63(), rand.Int63())
+		_, err := s.store.InsertIntoExecutions(newExecutionContext(), &execution)
+		s.NoError(err)
+		executions = append(executions, execution)
+	}
+
+	filter := sqlplugin.ExecutionsRangeFilter{
+		ShardID:        shardID,
+		MinNamespaceID: primitives.UUID{},
+		MinRunID:       primitives.UUID{},
+		PageSize:       2,
+	}
+	var rows []sqlplugin.ExecutionsRow
+	for {
+		page, err := s.store.RangeSelectFromExecutions(newExecutionContext(), filter)
+		s.NoError(err)
+		rows = append(rows, page...)
+		if len(page) < filter.PageSize {
+			break
+		}
+		last := page[len(page)-1]
+		filter.MinNamespaceID = last.NamespaceID
+		filter.MinWorkflowID = last.WorkflowID
+		filter.MinRunID = last.RunID
+	}
+	s.ElementsMatch(executions, rows)
+}
+
 func (s *historyExecutionSuite) TestInsertUpdate_Success() {
 	shardID := rand.Int31()
 	namespaceID := primitives.NewUUID()
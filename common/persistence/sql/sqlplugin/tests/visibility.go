@@ -287,6 +287,77 @@ func (s *visibilitySuite) TestDeleteGet() {
 	s.Error(err) // TODO persistence layer should do proper error translation
 }
 
+func (s *visibilitySuite) TestReplaceSelectUpdateSearchAttributes() {
+	namespaceID := primitives.NewUUID()
+	runID := primitives.NewUUID()
+	startTime := s.now()
+
+	visibility := s.newRandomVisibilityRow(
+		namespaceID,
+		runID,
+		shuffle.String(testVisibilityWorkflowTypeName),
+		shuffle.String(testVisibilityWorkflowID),
+		startTime,
+		startTime,
+		int32(enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING),
+		nil,
+		nil,
+	)
+	visibility.SearchAttributes = &sqlplugin.VisibilitySearchAttributes{"Keyword01": "42"}
+	_, err := s.store.ReplaceIntoVisibility(newVisibilityContext(), &visibility)
+	s.NoError(err)
+
+	selectFilter := sqlplugin.VisibilitySearchAttributesFilter{
+		NamespaceID:  namespaceID.String(),
+		Field:        "Keyword01",
+		MissingField: "Int01",
+		PageSize:     10,
+	}
+	rows, err := s.store.SelectSearchAttributesFromVisibility(newVisibilityContext(), selectFilter)
+	s.NoError(err)
+	s.Len(rows, 1)
+	s.Equal(runID.String(), rows[0].RunID)
+	s.Equal(visibility.Version, rows[0].Version)
+
+	// An outdated version doesn't update the row.
+	outdatedRow := rows[0]
+	outdatedRow.Version--
+	outdatedRow.SearchAttributes = &sqlplugin.VisibilitySearchAttributes{"Keyword01": "42", "Int01": 1}
+	result, err := s.store.UpdateSearchAttributesInVisibility(newVisibilityContext(), &outdatedRow)
+	s.NoError(err)
+	rowsAffected, err := result.RowsAffected()
+	s.NoError(err)
+	s.Equal(0, int(rowsAffected))
+
+	(*rows[0].SearchAttributes)["Int01"] = 42
+	result, err = s.store.UpdateSearchAttributesInVisibility(newVisibilityContext(), &rows[0])
+	s.NoError(err)
+	rowsAffected, err = result.RowsAffected()
+	s.NoError(err)
+	s.Equal(1, int(rowsAffected))
+
+	rows, err = s.store.SelectSearchAttributesFromVisibility(newVisibilityContext(), selectFilter)
+	s.NoError(err)
+	s.Empty(rows)
+
+	row, err := s.store.GetFromVisibility(newVisibilityContext(), sqlplugin.VisibilityGetFilter{
+		NamespaceID: namespaceID.String(),
+		RunID:       runID.String(),
+	})
+	s.NoError(err)
+	s.Equal(visibility.Version, row.Version)
+	s.EqualValues(42, (*row.SearchAttributes)["Int01"])
+}
+
+func (s *visibilitySuite) TestExplainVisibilityQuery() {
+	plan, err := s.store.ExplainVisibilityQuery(newVisibilityContext(), sqlplugin.VisibilitySelectFilter{
+		Query:     "SELECT run_id FROM executions_visibility WHERE namespace_id = ? ORDER BY start_time DESC LIMIT ?",
+		QueryArgs: []any{primitives.NewUUID().String(), 10},
+	})
+	s.NoError(err)
+	s.NotEmpty(plan)
+}
+
 func (s *visibilitySuite) TestInsertDeleteGet() {
 	namespaceID := primitives.NewUUID()
 	runID := primitives.NewUUID()
@@ -88,6 +88,25 @@ type (
 		Count       int64
 	}
 
+	// VisibilitySearchAttributesFilter selects, in run ID order, the rows of a namespace having a value in
+	// the search attribute column Field, and no value in the column MissingField if it's set.
+	// Field and MissingField are interpolated in the query and must be validated by the caller.
+	VisibilitySearchAttributesFilter struct {
+		NamespaceID  string
+		Field        string
+		MissingField string
+		MinRunID     string // exclusive
+		PageSize     int
+	}
+
+	// VisibilitySearchAttributesRow represents the search attributes of a row in executions_visibility table
+	VisibilitySearchAttributesRow struct {
+		NamespaceID      string
+		RunID            string
+		SearchAttributes *VisibilitySearchAttributes
+		Version          int64 `db:"_version"`
+	}
+
 	Visibility interface {
 		// InsertIntoVisibility inserts a row into visibility table. If a row already exist,
 		// no changes will be made by this API
@@ -107,6 +126,14 @@ type (
 		DeleteFromVisibility(ctx context.Context, filter VisibilityDeleteFilter) (sql.Result, error)
 		CountFromVisibility(ctx context.Context, filter VisibilitySelectFilter) (int64, error)
 		CountGroupByFromVisibility(ctx context.Context, filter VisibilitySelectFilter) ([]VisibilityCountRow, error)
+		// ExplainVisibilityQuery returns the execution plan of the query of the filter, one line per element,
+		// without running the query.
+		ExplainVisibilityQuery(ctx context.Context, filter VisibilitySelectFilter) ([]string, error)
+		// SelectSearchAttributesFromVisibility returns the search attributes of the rows matching the filter
+		SelectSearchAttributesFromVisibility(ctx context.Context, filter VisibilitySearchAttributesFilter) ([]VisibilitySearchAttributesRow, error)
+		// UpdateSearchAttributesInVisibility replaces the search attributes of a row, only if its version didn't
+		// change since it was selected. The version itself is left unchanged.
+		UpdateSearchAttributesInVisibility(ctx context.Context, row *VisibilitySearchAttributesRow) (sql.Result, error)
 	}
 )
 
@@ -208,6 +235,30 @@ func getDbFields() []string {
 	return dbFields
 }
 
+// GenerateSelectSearchAttributesQuery generates the SELECT query of SelectSearchAttributesFromVisibility on the
+// given table. It uses ? placeholders.
+func GenerateSelectSearchAttributesQuery(
+	table string,
+	filter VisibilitySearchAttributesFilter,
+) (string, []any) {
+	whereClauses := []string{
+		fmt.Sprintf("%s = ?", searchattribute.GetSqlDbColName(searchattribute.NamespaceID)),
+		fmt.Sprintf("%s > ?", searchattribute.GetSqlDbColName(searchattribute.RunID)),
+		fmt.Sprintf("%s IS NOT NULL", filter.Field),
+	}
+	if filter.MissingField != "" {
+		whereClauses = append(whereClauses, fmt.Sprintf("%s IS NULL", filter.MissingField))
+	}
+	query := fmt.Sprintf(
+		"SELECT namespace_id, run_id, search_attributes, %s FROM %s WHERE %s ORDER BY %s LIMIT ?",
+		VersionColumnName,
+		table,
+		strings.Join(whereClauses, " AND "),
+		searchattribute.GetSqlDbColName(searchattribute.RunID),
+	)
+	return query, []any{filter.NamespaceID, filter.MinRunID, filter.PageSize}
+}
+
 // TODO (rodrigozhou): deprecate with standard visibility code.
 // GenerateSelectQuery generates the SELECT query based on the fields of VisibilitySelectFilter
 // for backward compatibility of any use case using old format (eg: unit test).
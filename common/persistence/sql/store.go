@@ -36,7 +36,16 @@ func NewSQLDB(
 	logger log.Logger,
 	mh metrics.Handler,
 ) (sqlplugin.DB, error) {
-	return createDB[sqlplugin.DB](dbKind, cfg, r, logger, mh)
+	db, err := createDB[sqlplugin.DB](dbKind, cfg, r, logger, mh)
+	if err != nil || cfg.ReadReplicas == nil || len(cfg.ReadReplicas.ConnectAddrs) == 0 {
+		return db, err
+	}
+	replicaDB, err := newReadReplicaDBFromConfig(db, dbKind, cfg, r, logger, mh)
+	if err != nil {
+		_ = db.Close()
+		return nil, err
+	}
+	return replicaDB, nil
 }
 
 // NewSQLAdminDB returns a AdminDB.
@@ -447,6 +447,25 @@ func (m *sqlTaskManager) CompleteTasksLessThan(
 	return int(nRows), nil
 }
 
+func (m *sqlTaskManager) CompleteTask(
+	ctx context.Context,
+	request *persistence.CompleteTaskRequest,
+) error {
+	nidBytes, err := primitives.ParseUUID(request.NamespaceID)
+	if err != nil {
+		return serviceerror.NewUnavailable(err.Error())
+	}
+	tqId, tqHash := m.taskQueueIdAndHash(nidBytes, request.TaskQueueName, request.TaskType, request.Subqueue)
+	if _, err := m.Db.DeleteFromTasks(ctx, sqlplugin.TasksFilter{
+		RangeHash:   tqHash,
+		TaskQueueID: tqId,
+		TaskID:      &request.TaskID,
+	}); err != nil {
+		return serviceerror.NewUnavailable(fmt.Sprintf("CompleteTask operation failed. Error: %v", err))
+	}
+	return nil
+}
+
 func (m *sqlTaskManager) GetTaskQueueUserData(ctx context.Context, request *persistence.GetTaskQueueUserDataRequest) (*persistence.InternalGetTaskQueueUserDataResponse, error) {
 	namespaceID, err := primitives.ParseUUID(request.NamespaceID)
 	if err != nil {
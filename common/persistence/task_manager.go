@@ -226,6 +226,13 @@ func (m *taskManagerImpl) CompleteTasksLessThan(
 	return m.taskStore.CompleteTasksLessThan(ctx, request)
 }
 
+func (m *taskManagerImpl) CompleteTask(
+	ctx context.Context,
+	request *CompleteTaskRequest,
+) error {
+	return m.taskStore.CompleteTask(ctx, request)
+}
+
 // GetTaskQueueUserData implements TaskManager
 func (m *taskManagerImpl) GetTaskQueueUserData(ctx context.Context, request *GetTaskQueueUserDataRequest) (*GetTaskQueueUserDataResponse, error) {
 	response, err := m.taskStore.GetTaskQueueUserData(ctx, request)
@@ -768,6 +768,48 @@ func (d telemetryExecutionStore) ListConcreteExecutions(ctx context.Context, req
 	return
 }
 
+// ListCurrentExecutions wraps ExecutionStore.ListCurrentExecutions.
+func (d telemetryExecutionStore) ListCurrentExecutions(ctx context.Context, request *_sourcePersistence.ListCurrentExecutionsRequest) (ip1 *_sourcePersistence.InternalListCurrentExecutionsResponse, err error) {
+	ctx, span := d.tracer.Start(
+		ctx,
+		"persistence.ExecutionStore/ListCurrentExecutions",
+		trace.WithAttributes(
+			attribute.Key("persistence.store").String("ExecutionStore"),
+			attribute.Key("persistence.method").String("ListCurrentExecutions"),
+		))
+	defer span.End()
+
+	if deadline, ok := ctx.Deadline(); ok {
+		span.SetAttributes(attribute.String("deadline", deadline.Format(time.RFC3339Nano)))
+		span.SetAttributes(attribute.String("timeout", time.Until(deadline).String()))
+	}
+
+	ip1, err = d.ExecutionStore.ListCurrentExecutions(ctx, request)
+	if err != nil {
+		span.RecordError(err)
+	}
+
+	if d.debugMode {
+
+		requestPayload, err := json.MarshalIndent(request, "", "    ")
+		if err != nil {
+			d.logger.Error("failed to serialize *_sourcePersistence.ListCurrentExecutionsRequest for OTEL span", tag.Error(err))
+		} else {
+			span.SetAttributes(attribute.Key("persistence.request.payload").String(string(requestPayload)))
+		}
+
+		responsePayload, err := json.MarshalIndent(ip1, "", "    ")
+		if err != nil {
+			d.logger.Error("failed to serialize *_sourcePersistence.InternalListCurrentExecutionsResponse for OTEL span", tag.Error(err))
+		} else {
+			span.SetAttributes(attribute.Key("persistence.response.payload").String(string(responsePayload)))
+		}
+
+	}
+
+	return
+}
+
 // PutReplicationTaskToDLQ wraps ExecutionStore.PutReplicationTaskToDLQ.
 func (d telemetryExecutionStore) PutReplicationTaskToDLQ(ctx context.Context, request *_sourcePersistence.PutReplicationTaskToDLQRequest) (err error) {
 	ctx, span := d.tracer.Start(
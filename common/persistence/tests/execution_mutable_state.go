@@ -72,6 +72,8 @@ func NewExecutionMutableStateSuite(
 			nil,
 			logger,
 			dynamicconfig.GetIntPropertyFn(4*1024*1024),
+			dynamicconfig.GetStringPropertyFnFilteredByNamespaceID(""),
+			nil,
 		),
 		historyBranchUtil: historyBranchUtil,
 		Logger:            logger,
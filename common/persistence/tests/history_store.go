@@ -2,7 +2,13 @@ package tests
 
 import (
 	"context"
+	cryptorand "crypto/rand"
+	"encoding/base64"
+	"fmt"
 	"math/rand"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 	"time"
 
@@ -13,10 +19,12 @@ import (
 	historypb "go.temporal.io/api/history/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
 	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/debug"
 	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/log"
 	p "go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/encryption"
 	"go.temporal.io/server/common/persistence/serialization"
 	"go.temporal.io/server/common/testing/protorequire"
 	"google.golang.org/protobuf/types/known/timestamppb"
@@ -42,9 +50,10 @@ type (
 
 		ShardID int32
 
-		store      p.ExecutionManager
-		serializer serialization.Serializer
-		logger     log.Logger
+		executionStore p.ExecutionStore
+		store          p.ExecutionManager
+		serializer     serialization.Serializer
+		logger         log.Logger
 
 		Ctx    context.Context
 		Cancel context.CancelFunc
@@ -60,12 +69,15 @@ func NewHistoryEventsSuite(
 	return &HistoryEventsSuite{
 		Assertions:      require.New(t),
 		ProtoAssertions: protorequire.New(t),
+		executionStore:  store,
 		store: p.NewExecutionManager(
 			store,
 			eventSerializer,
 			nil,
 			logger,
 			dynamicconfig.GetIntPropertyFn(4*1024*1024),
+			dynamicconfig.GetStringPropertyFnFilteredByNamespaceID(""),
+			nil,
 		),
 		serializer: eventSerializer,
 		logger:     logger,
@@ -726,6 +738,137 @@ func (s *HistoryEventsSuite) TestForkDeleteBranch_DeleteForkedBranchFirst() {
 	s.Error(err, "Workflow execution history not found.")
 }
 
+func (s *HistoryEventsSuite) TestAppendSelect_CompressedEncrypted() {
+	keyFile := filepath.Join(s.T().TempDir(), "keys.yaml")
+	key := make([]byte, 32)
+	_, err := cryptorand.Read(key)
+	s.NoError(err)
+	s.NoError(os.WriteFile(keyFile, []byte(fmt.Sprintf("activeKeyID: key-1\nkeys:\n  key-1: %s\n", base64.StdEncoding.EncodeToString(key))), 0600))
+	keyProvider, err := encryption.NewLocalKeyProvider(keyFile)
+	s.NoError(err)
+	encryptor := encryption.NewBlobEncryptor(
+		keyProvider,
+		dynamicconfig.GetBoolPropertyFnFilteredByNamespaceID(true),
+		time.Hour,
+		clock.NewRealTimeSource(),
+	)
+	manager := p.NewExecutionManager(
+		s.executionStore,
+		s.serializer,
+		nil,
+		s.logger,
+		dynamicconfig.GetIntPropertyFn(4*1024*1024),
+		dynamicconfig.GetStringPropertyFnFilteredByNamespaceID(string(serialization.CompressionTypeZstd)),
+		encryptor,
+	)
+
+	namespaceID := uuid.New()
+	branchID := uuid.New()
+	branchToken, err := manager.GetHistoryBranchUtil().NewHistoryBranch(
+		namespaceID,
+		uuid.New(),
+		uuid.New(),
+		uuid.New(),
+		&branchID,
+		[]*persistencespb.HistoryBranchRange{},
+		time.Duration(0),
+		time.Duration(0),
+		time.Duration(0),
+	)
+	s.NoError(err)
+
+	// repetitive attributes so that compression reduces the size of the events
+	eventsPacket0 := s.newHistoryEvents([]int64{1, 2, 3}, rand.Int63(), 0)
+	eventsPacket1 := s.newHistoryEvents([]int64{4, 5}, eventsPacket0.transactionID+1, eventsPacket0.transactionID)
+	for _, event := range append(eventsPacket0.events, eventsPacket1.events...) {
+		event.EventType = enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_SIGNALED
+		event.Attributes = &historypb.HistoryEvent_WorkflowExecutionSignaledEventAttributes{
+			WorkflowExecutionSignaledEventAttributes: &historypb.WorkflowExecutionSignaledEventAttributes{
+				SignalName: strings.Repeat("signal-name", 64),
+			},
+		}
+	}
+
+	_, err = manager.AppendHistoryNodes(s.Ctx, &p.AppendHistoryNodesRequest{
+		ShardID:           s.ShardID,
+		NamespaceID:       namespaceID,
+		BranchToken:       branchToken,
+		Events:            eventsPacket0.events,
+		TransactionID:     eventsPacket0.transactionID,
+		PrevTransactionID: eventsPacket0.prevTransactionID,
+		IsNewBranch:       true,
+	})
+	s.NoError(err)
+	blob, err := s.serializer.SerializeEvents(eventsPacket1.events, enumspb.ENCODING_TYPE_PROTO3)
+	s.NoError(err)
+	_, err = manager.AppendRawHistoryNodes(s.Ctx, &p.AppendRawHistoryNodesRequest{
+		ShardID:           s.ShardID,
+		NamespaceID:       namespaceID,
+		BranchToken:       branchToken,
+		NodeID:            eventsPacket1.nodeID,
+		TransactionID:     eventsPacket1.transactionID,
+		PrevTransactionID: eventsPacket1.prevTransactionID,
+		History:           blob,
+	})
+	s.NoError(err)
+
+	// both nodes are stored compressed and encrypted
+	resp, err := s.executionStore.ReadHistoryBranch(s.Ctx, &p.InternalReadHistoryBranchRequest{
+		BranchToken: branchToken,
+		ShardID:     s.ShardID,
+		BranchID:    branchID,
+		MinNodeID:   common.FirstEventID,
+		MaxNodeID:   common.LastEventID,
+		PageSize:    10,
+	})
+	s.NoError(err)
+	s.Len(resp.Nodes, 2)
+	for _, node := range resp.Nodes {
+		s.True(encryption.IsEncrypted(node.Events.Data))
+		decrypted, err := encryptor.Decrypt(node.Events)
+		s.NoError(err)
+		s.True(serialization.IsCompressed(decrypted.Data))
+	}
+
+	// and read back as plain events, or as plain raw blobs
+	allEvents := append(eventsPacket0.events, eventsPacket1.events...)
+	var events []*historypb.HistoryEvent
+	var token []byte
+	for doContinue := true; doContinue; doContinue = len(token) > 0 {
+		resp, err := manager.ReadHistoryBranch(s.Ctx, &p.ReadHistoryBranchRequest{
+			ShardID:       s.ShardID,
+			BranchToken:   branchToken,
+			MinEventID:    common.FirstEventID,
+			MaxEventID:    common.LastEventID,
+			PageSize:      1,
+			NextPageToken: token,
+		})
+		s.NoError(err)
+		token = resp.NextPageToken
+		events = append(events, resp.HistoryEvents...)
+	}
+	protorequire.ProtoSliceEqual(s.T(), allEvents, events)
+
+	rawResp, err := manager.ReadRawHistoryBranch(s.Ctx, &p.ReadHistoryBranchRequest{
+		ShardID:     s.ShardID,
+		BranchToken: branchToken,
+		MinEventID:  common.FirstEventID,
+		MaxEventID:  common.LastEventID,
+		PageSize:    10,
+	})
+	s.NoError(err)
+	s.Len(rawResp.HistoryEventBlobs, 2)
+	events = nil
+	for _, blob := range rawResp.HistoryEventBlobs {
+		s.False(encryption.IsEncrypted(blob.Data))
+		s.False(serialization.IsCompressed(blob.Data))
+		batch, err := s.serializer.DeserializeEvents(blob)
+		s.NoError(err)
+		events = append(events, batch...)
+	}
+	protorequire.ProtoSliceEqual(s.T(), allEvents, events)
+}
+
 func (s *HistoryEventsSuite) appendHistoryEvents(
 	shardID int32,
 	branchToken []byte,
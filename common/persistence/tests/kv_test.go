@@ -0,0 +1,126 @@
+package tests
+
+import (
+	"sync/atomic"
+	"testing"
+
+	"github.com/pborman/uuid"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/kv"
+	"go.temporal.io/server/common/persistence/serialization"
+)
+
+const (
+	testKVClusterName = "temporal_kv_cluster"
+)
+
+// NewKVMemoryConfig returns a new config of an in memory key-value store for test
+func NewKVMemoryConfig() config.CustomDatastoreConfig {
+	return config.CustomDatastoreConfig{
+		Name:    kv.PluginName,
+		Options: map[string]any{"path": "memory:" + uuid.New()},
+	}
+}
+
+func newKVFactory(t *testing.T, logger log.Logger) *kv.Factory {
+	factory, err := kv.NewFactory(NewKVMemoryConfig(), testKVClusterName, logger)
+	require.NoError(t, err)
+	t.Cleanup(factory.Close)
+	return factory
+}
+
+func TestKVExecutionMutableStateStoreSuite(t *testing.T) {
+	logger := log.NewNoopLogger()
+	factory := newKVFactory(t, logger)
+	shardStore, err := factory.NewShardStore()
+	require.NoError(t, err)
+	executionStore, err := factory.NewExecutionStore()
+	require.NoError(t, err)
+
+	s := NewExecutionMutableStateSuite(
+		t,
+		shardStore,
+		executionStore,
+		serialization.NewSerializer(),
+		&persistence.HistoryBranchUtilImpl{},
+		logger,
+	)
+	suite.Run(t, s)
+}
+
+func TestKVExecutionMutableStateTaskStoreSuite(t *testing.T) {
+	logger := log.NewNoopLogger()
+	factory := newKVFactory(t, logger)
+	shardStore, err := factory.NewShardStore()
+	require.NoError(t, err)
+	executionStore, err := factory.NewExecutionStore()
+	require.NoError(t, err)
+
+	s := NewExecutionMutableStateTaskSuite(
+		t,
+		shardStore,
+		executionStore,
+		serialization.NewSerializer(),
+		logger,
+	)
+	suite.Run(t, s)
+}
+
+func TestKVHistoryStoreSuite(t *testing.T) {
+	logger := log.NewNoopLogger()
+	factory := newKVFactory(t, logger)
+	store, err := factory.NewExecutionStore()
+	require.NoError(t, err)
+
+	s := NewHistoryEventsSuite(t, store, logger)
+	suite.Run(t, s)
+}
+
+func TestKVTaskQueueSuite(t *testing.T) {
+	logger := log.NewNoopLogger()
+	factory := newKVFactory(t, logger)
+	taskQueueStore, err := factory.NewTaskStore()
+	require.NoError(t, err)
+
+	s := NewTaskQueueSuite(t, taskQueueStore, logger)
+	suite.Run(t, s)
+}
+
+func TestKVTaskQueueTaskSuite(t *testing.T) {
+	logger := log.NewNoopLogger()
+	factory := newKVFactory(t, logger)
+	taskQueueStore, err := factory.NewTaskStore()
+	require.NoError(t, err)
+
+	s := NewTaskQueueTaskSuite(t, taskQueueStore, logger)
+	suite.Run(t, s)
+}
+
+func TestKVTaskQueueUserDataSuite(t *testing.T) {
+	logger := log.NewNoopLogger()
+	factory := newKVFactory(t, logger)
+	taskQueueStore, err := factory.NewTaskStore()
+	require.NoError(t, err)
+
+	s := NewTaskQueueUserDataSuite(t, taskQueueStore, logger)
+	suite.Run(t, s)
+}
+
+func TestKVQueueV2(t *testing.T) {
+	factory := newKVFactory(t, log.NewNoopLogger())
+	q, err := factory.NewQueueV2()
+	require.NoError(t, err)
+	RunQueueV2TestSuite(t, q)
+}
+
+func TestKVNexusEndpointPersistence(t *testing.T) {
+	factory := newKVFactory(t, log.NewNoopLogger())
+	store, err := factory.NewNexusEndpointStore()
+	require.NoError(t, err)
+	tableVersion := atomic.Int64{}
+	RunNexusEndpointTestSuite(t, store, &tableVersion)
+}
@@ -75,6 +75,7 @@ func (s *VisibilityPersistenceSuite) SetupSuite() {
 		dynamicconfig.GetDurationPropertyFn(time.Second),
 		dynamicconfig.GetBoolPropertyFnFilteredByNamespace(false),
 		dynamicconfig.GetBoolPropertyFn(false),
+		dynamicconfig.GetFloatPropertyFnFilteredByNamespace(0),
 		dynamicconfig.GetStringPropertyFn(visibility.SecondaryVisibilityWritingModeOff),
 		dynamicconfig.GetBoolPropertyFnFilteredByNamespace(false),
 		dynamicconfig.GetBoolPropertyFnFilteredByNamespace(true),
@@ -1050,6 +1051,127 @@ func (s *VisibilityPersistenceSuite) TestCountGroupByWorkflowExecutions() {
 	s.Equal(int64(5), resp.Count)
 }
 
+func (s *VisibilityPersistenceSuite) TestCountGroupByKeyword() {
+	testNamespaceUUID := namespace.ID(uuid.New())
+	startTime := time.Now().UTC()
+
+	for i := 0; i < 5; i++ {
+		s.createOpenWorkflowRecord(
+			testNamespaceUUID,
+			"visibility-workflow-test",
+			fmt.Sprintf("visibility-workflow-%d", i%2),
+			startTime,
+			startTime,
+			"test-queue",
+		)
+	}
+
+	resp, err := s.VisibilityMgr.CountWorkflowExecutions(
+		s.ctx,
+		&manager.CountWorkflowExecutionsRequest{
+			NamespaceID: testNamespaceUUID,
+			Query:       "GROUP BY WorkflowType",
+		},
+	)
+	s.NoError(err)
+	s.Equal(int64(5), resp.Count)
+	type0Payload, _ := searchattribute.EncodeValue("visibility-workflow-0", enumspb.INDEXED_VALUE_TYPE_KEYWORD)
+	type1Payload, _ := searchattribute.EncodeValue("visibility-workflow-1", enumspb.INDEXED_VALUE_TYPE_KEYWORD)
+	s.ElementsMatch(
+		[]*workflowservice.CountWorkflowExecutionsResponse_AggregationGroup{
+			{
+				GroupValues: []*commonpb.Payload{type0Payload},
+				Count:       int64(3),
+			},
+			{
+				GroupValues: []*commonpb.Payload{type1Payload},
+				Count:       int64(2),
+			},
+		},
+		resp.Groups,
+	)
+}
+
+func (s *VisibilityPersistenceSuite) TestCountGroupByTimeBucket() {
+	testNamespaceUUID := namespace.ID(uuid.New())
+	startTime := time.Date(2024, 5, 1, 10, 15, 0, 0, time.UTC)
+
+	var startRequests []*manager.RecordWorkflowExecutionStartedRequest
+	for _, offset := range []time.Duration{0, 10 * time.Minute, 50 * time.Minute} {
+		startRequests = append(
+			startRequests,
+			s.createOpenWorkflowRecord(
+				testNamespaceUUID,
+				"visibility-workflow-test",
+				"visibility-workflow",
+				startTime.Add(offset),
+				startTime.Add(offset),
+				"test-queue",
+			),
+		)
+	}
+	s.createClosedWorkflowRecord(
+		startRequests[0],
+		startTime.Add(2*time.Hour),
+		enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED,
+	)
+
+	resp, err := s.VisibilityMgr.CountWorkflowExecutions(
+		s.ctx,
+		&manager.CountWorkflowExecutionsRequest{
+			NamespaceID: testNamespaceUUID,
+			Query:       "GROUP BY time_bucket(StartTime, '1h')",
+		},
+	)
+	s.NoError(err)
+	s.Equal(int64(3), resp.Count)
+	bucket10Payload, _ := searchattribute.EncodeValue(
+		time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC),
+		enumspb.INDEXED_VALUE_TYPE_DATETIME,
+	)
+	bucket11Payload, _ := searchattribute.EncodeValue(
+		time.Date(2024, 5, 1, 11, 0, 0, 0, time.UTC),
+		enumspb.INDEXED_VALUE_TYPE_DATETIME,
+	)
+	s.ElementsMatch(
+		[]*workflowservice.CountWorkflowExecutionsResponse_AggregationGroup{
+			{
+				GroupValues: []*commonpb.Payload{bucket10Payload},
+				Count:       int64(2),
+			},
+			{
+				GroupValues: []*commonpb.Payload{bucket11Payload},
+				Count:       int64(1),
+			},
+		},
+		resp.Groups,
+	)
+
+	// Running executions don't have a close time and are not counted.
+	resp, err = s.VisibilityMgr.CountWorkflowExecutions(
+		s.ctx,
+		&manager.CountWorkflowExecutionsRequest{
+			NamespaceID: testNamespaceUUID,
+			Query:       "GROUP BY time_bucket(CloseTime, '1h')",
+		},
+	)
+	s.NoError(err)
+	s.Equal(int64(1), resp.Count)
+	bucket12Payload, _ := searchattribute.EncodeValue(
+		time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC),
+		enumspb.INDEXED_VALUE_TYPE_DATETIME,
+	)
+	s.Equal(
+		[]*workflowservice.CountWorkflowExecutionsResponse_AggregationGroup{
+			{
+				GroupValues: []*commonpb.Payload{bucket12Payload},
+				Count:       int64(1),
+			},
+		},
+		resp.Groups,
+	)
+}
+
 func (s *VisibilityPersistenceSuite) listWithPagination(namespaceID namespace.ID, pageSize int) []*workflowpb.WorkflowExecutionInfo {
 	var executions []*workflowpb.WorkflowExecutionInfo
 	resp, err := s.VisibilityMgr.ListWorkflowExecutions(s.ctx, &manager.ListWorkflowExecutionsRequestV2{
@@ -43,6 +43,7 @@ func NewManager(
 	slowQueryThreshold dynamicconfig.DurationPropertyFn,
 	enableReadFromSecondaryVisibility dynamicconfig.BoolPropertyFnWithNamespaceFilter,
 	visibilityEnableShadowReadMode dynamicconfig.BoolPropertyFn,
+	visibilityShadowReadVerificationRate dynamicconfig.FloatPropertyFnWithNamespaceFilter,
 	secondaryVisibilityWritingMode dynamicconfig.StringPropertyFn,
 	visibilityDisableOrderByClause dynamicconfig.BoolPropertyFnWithNamespaceFilter,
 	visibilityEnableManualPagination dynamicconfig.BoolPropertyFnWithNamespaceFilter,
@@ -108,6 +109,9 @@ func NewManager(
 			secondaryVisibilityManager,
 			managerSelector,
 			visibilityEnableShadowReadMode,
+			visibilityShadowReadVerificationRate,
+			metricsHandler,
+			logger,
 		), nil
 	}
 
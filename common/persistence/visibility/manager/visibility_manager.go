@@ -30,12 +30,15 @@ type (
 		RecordWorkflowExecutionClosed(ctx context.Context, request *RecordWorkflowExecutionClosedRequest) error
 		UpsertWorkflowExecution(ctx context.Context, request *UpsertWorkflowExecutionRequest) error
 		DeleteWorkflowExecution(ctx context.Context, request *VisibilityDeleteWorkflowExecutionRequest) error
+		CopySearchAttributeValues(ctx context.Context, request *CopySearchAttributeValuesRequest) (*SearchAttributeValuesResponse, error)
+		ClearSearchAttributeValues(ctx context.Context, request *ClearSearchAttributeValuesRequest) (*SearchAttributeValuesResponse, error)
 
 		// Read APIs.
 		ListWorkflowExecutions(ctx context.Context, request *ListWorkflowExecutionsRequestV2) (*ListWorkflowExecutionsResponse, error)
 		ScanWorkflowExecutions(ctx context.Context, request *ListWorkflowExecutionsRequestV2) (*ListWorkflowExecutionsResponse, error)
 		CountWorkflowExecutions(ctx context.Context, request *CountWorkflowExecutionsRequest) (*CountWorkflowExecutionsResponse, error)
 		GetWorkflowExecution(ctx context.Context, request *GetWorkflowExecutionRequest) (*GetWorkflowExecutionResponse, error)
+		ExplainQuery(ctx context.Context, request *ExplainQueryRequest) (*ExplainQueryResponse, error)
 	}
 
 	VisibilityRequestBase struct {
@@ -142,6 +145,60 @@ type (
 	GetWorkflowExecutionResponse struct {
 		Execution *workflowpb.WorkflowExecutionInfo
 	}
+
+	// ExplainQueryRequest is used to translate a list query into the query of the visibility store
+	ExplainQueryRequest struct {
+		NamespaceID namespace.ID
+		Namespace   namespace.Name // namespace.Name is not persisted.
+		Query       string
+		PageSize    int // Page size of the explained list request
+		// Include the execution plan of the database, only supported by SQL visibility stores.
+		IncludePlan bool
+	}
+
+	// ExplainQueryResponse is the response to ExplainQueryRequest
+	ExplainQueryResponse struct {
+		StoreName string
+		// Query is the SQL statement, or the JSON body of the search request with Elasticsearch.
+		Query string
+		// QueryArgs are the arguments bound to the placeholders of the SQL statement.
+		QueryArgs []any
+		// Plan is the execution plan of the SQL statement, one line per element.
+		Plan []string
+	}
+
+	// CopySearchAttributeValuesRequest is used to copy the values of a custom search attribute field into another
+	// field of a different type. Only executions with a value in SourceField and no value in TargetField are updated.
+	CopySearchAttributeValuesRequest struct {
+		NamespaceID namespace.ID
+		SourceField string
+		SourceType  enumspb.IndexedValueType
+		TargetField string
+		TargetType  enumspb.IndexedValueType
+		// Maximum number of executions updated per page
+		PageSize int
+		// Token to continue copying the next page, pass in empty slice for first page.
+		NextPageToken []byte
+	}
+
+	// ClearSearchAttributeValuesRequest is used to remove the values of a custom search attribute field
+	ClearSearchAttributeValuesRequest struct {
+		NamespaceID namespace.ID
+		Field       string
+		// Maximum number of executions updated per page
+		PageSize int
+		// Token to continue clearing the next page, pass in empty slice for first page.
+		NextPageToken []byte
+	}
+
+	// SearchAttributeValuesResponse is the response to CopySearchAttributeValues and ClearSearchAttributeValues
+	SearchAttributeValuesResponse struct {
+		UpdatedCount int64
+		// Number of executions whose value couldn't be converted to the target type
+		FailedCount int64
+		// Token to process the next page, empty if there are no more executions to update.
+		NextPageToken []byte
+	}
 )
 
 func (r *ListWorkflowExecutionsRequest) OverrideToken(token []byte) {
@@ -41,6 +41,21 @@ func (m *MockVisibilityManager) EXPECT() *MockVisibilityManagerMockRecorder {
 	return m.recorder
 }
 
+// ClearSearchAttributeValues mocks base method.
+func (m *MockVisibilityManager) ClearSearchAttributeValues(ctx context.Context, request *ClearSearchAttributeValuesRequest) (*SearchAttributeValuesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClearSearchAttributeValues", ctx, request)
+	ret0, _ := ret[0].(*SearchAttributeValuesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClearSearchAttributeValues indicates an expected call of ClearSearchAttributeValues.
+func (mr *MockVisibilityManagerMockRecorder) ClearSearchAttributeValues(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClearSearchAttributeValues", reflect.TypeOf((*MockVisibilityManager)(nil).ClearSearchAttributeValues), ctx, request)
+}
+
 // Close mocks base method.
 func (m *MockVisibilityManager) Close() {
 	m.ctrl.T.Helper()
@@ -53,6 +68,21 @@ func (mr *MockVisibilityManagerMockRecorder) Close() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockVisibilityManager)(nil).Close))
 }
 
+// CopySearchAttributeValues mocks base method.
+func (m *MockVisibilityManager) CopySearchAttributeValues(ctx context.Context, request *CopySearchAttributeValuesRequest) (*SearchAttributeValuesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CopySearchAttributeValues", ctx, request)
+	ret0, _ := ret[0].(*SearchAttributeValuesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CopySearchAttributeValues indicates an expected call of CopySearchAttributeValues.
+func (mr *MockVisibilityManagerMockRecorder) CopySearchAttributeValues(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CopySearchAttributeValues", reflect.TypeOf((*MockVisibilityManager)(nil).CopySearchAttributeValues), ctx, request)
+}
+
 // CountWorkflowExecutions mocks base method.
 func (m *MockVisibilityManager) CountWorkflowExecutions(ctx context.Context, request *CountWorkflowExecutionsRequest) (*CountWorkflowExecutionsResponse, error) {
 	m.ctrl.T.Helper()
@@ -82,6 +112,21 @@ func (mr *MockVisibilityManagerMockRecorder) DeleteWorkflowExecution(ctx, reques
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWorkflowExecution", reflect.TypeOf((*MockVisibilityManager)(nil).DeleteWorkflowExecution), ctx, request)
 }
 
+// ExplainQuery mocks base method.
+func (m *MockVisibilityManager) ExplainQuery(ctx context.Context, request *ExplainQueryRequest) (*ExplainQueryResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExplainQuery", ctx, request)
+	ret0, _ := ret[0].(*ExplainQueryResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExplainQuery indicates an expected call of ExplainQuery.
+func (mr *MockVisibilityManagerMockRecorder) ExplainQuery(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExplainQuery", reflect.TypeOf((*MockVisibilityManager)(nil).ExplainQuery), ctx, request)
+}
+
 // GetIndexName mocks base method.
 func (m *MockVisibilityManager) GetIndexName() string {
 	m.ctrl.T.Helper()
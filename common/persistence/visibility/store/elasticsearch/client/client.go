@@ -12,6 +12,7 @@ import (
 
 const (
 	versionTypeExternal                 = "external"
+	versionTypeExternalGTE              = "external_gte"
 	minimumCloseIdleConnectionsInterval = 15 * time.Second
 )
 
@@ -23,6 +24,9 @@ type (
 		Count(ctx context.Context, index string, query elastic.Query) (int64, error)
 		CountGroupBy(ctx context.Context, index string, query elastic.Query, aggName string, agg elastic.Aggregation) (*elastic.SearchResult, error)
 		RunBulkProcessor(ctx context.Context, p *BulkProcessorParameters) (BulkProcessor, error)
+		// ReplaceDocuments re-indexes documents without changing their version. A document which was indexed
+		// with a greater version since it was read fails with a version conflict.
+		ReplaceDocuments(ctx context.Context, index string, docs []*DocumentReplacement) (*elastic.BulkResponse, error)
 
 		// TODO (alex): move this to some admin client (and join with IntegrationTestsClient)
 		PutMapping(ctx context.Context, index string, mapping map[string]enumspb.IndexedValueType) (bool, error)
@@ -65,5 +69,15 @@ type (
 		SearchAfter []interface{}
 		ScrollID    string
 		PointInTime *elastic.PointInTime
+
+		// Version includes the version of the documents in the hits.
+		Version bool
+	}
+
+	// DocumentReplacement is the new source of a document read with its version.
+	DocumentReplacement struct {
+		ID      string
+		Version int64
+		Doc     map[string]any
 	}
 )
@@ -1,35 +1,78 @@
 package client
 
 import (
+	"context"
 	"fmt"
 	"net/http"
+	"time"
 
 	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
 )
 
-func NewClient(config *Config, httpClient *http.Client, logger log.Logger) (Client, error) {
-	switch config.Version {
-	case "v8", "v7", "":
-		return newClient(config, httpClient, logger)
-	default:
-		return nil, fmt.Errorf("not supported Elasticsearch version: %v", config.Version)
+const (
+	versionElasticsearchV7 = "v7"
+	versionElasticsearchV8 = "v8"
+	versionOpenSearchV2    = "opensearch2"
+
+	// detectDistributionTimeout bounds the detection of the distribution of the cluster when the version isn't
+	// configured, to not block server startup if the cluster is down.
+	detectDistributionTimeout = 5 * time.Second
+)
+
+type (
+	// versionedClient is implemented by the clients of all supported versions
+	versionedClient interface {
+		CLIClient
+		IntegrationTestsClient
 	}
+)
+
+func NewClient(config *Config, httpClient *http.Client, logger log.Logger) (Client, error) {
+	return newVersionedClient(config, httpClient, logger)
 }
 
 func NewCLIClient(config *Config, logger log.Logger) (CLIClient, error) {
-	switch config.Version {
-	case "v8", "v7", "":
-		return newClient(config, nil, logger)
-	default:
-		return nil, fmt.Errorf("not supported Elasticsearch version: %v", config.Version)
-	}
+	return newVersionedClient(config, nil, logger)
 }
 
 func NewFunctionalTestsClient(config *Config, logger log.Logger) (IntegrationTestsClient, error) {
+	return newVersionedClient(config, nil, logger)
+}
+
+func newVersionedClient(config *Config, httpClient *http.Client, logger log.Logger) (versionedClient, error) {
 	switch config.Version {
-	case "v8", "v7", "":
-		return newClient(config, nil, logger)
+	case versionElasticsearchV8, versionElasticsearchV7:
+		return newClient(config, httpClient, logger)
+	case versionOpenSearchV2:
+		return newOpenSearchClient(config, httpClient, logger)
+	case "":
+		return newDetectedClient(config, httpClient, logger)
 	default:
 		return nil, fmt.Errorf("not supported Elasticsearch version: %v", config.Version)
 	}
 }
+
+// newDetectedClient creates the client of the distribution returned by the cluster info. The Elasticsearch client is
+// used if the cluster is unavailable: it works with OpenSearch too, except that point in time is not used.
+func newDetectedClient(config *Config, httpClient *http.Client, logger log.Logger) (versionedClient, error) {
+	client, err := newClient(config, httpClient, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), detectDistributionTimeout)
+	defer cancel()
+	info, err := client.getClusterInfo(ctx)
+	if err != nil {
+		logger.Warn("Unable to detect visibility cluster distribution, using Elasticsearch client.", tag.Error(err))
+		return client, nil
+	}
+	if info.isOpenSearch() {
+		logger.Info("Detected OpenSearch visibility cluster.", tag.NewStringTag("version", info.Version.Number))
+		return &openSearchClientImpl{
+			clientImpl: client,
+		}, nil
+	}
+	return client, nil
+}
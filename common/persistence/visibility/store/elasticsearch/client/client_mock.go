@@ -250,6 +250,21 @@ func (mr *MockClientMockRecorder) PutMapping(ctx, index, mapping any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutMapping", reflect.TypeOf((*MockClient)(nil).PutMapping), ctx, index, mapping)
 }
 
+// ReplaceDocuments mocks base method.
+func (m *MockClient) ReplaceDocuments(ctx context.Context, index string, docs []*DocumentReplacement) (*elastic.BulkResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplaceDocuments", ctx, index, docs)
+	ret0, _ := ret[0].(*elastic.BulkResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReplaceDocuments indicates an expected call of ReplaceDocuments.
+func (mr *MockClientMockRecorder) ReplaceDocuments(ctx, index, docs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceDocuments", reflect.TypeOf((*MockClient)(nil).ReplaceDocuments), ctx, index, docs)
+}
+
 // RunBulkProcessor mocks base method.
 func (m *MockClient) RunBulkProcessor(ctx context.Context, p *BulkProcessorParameters) (BulkProcessor, error) {
 	m.ctrl.T.Helper()
@@ -556,6 +571,21 @@ func (mr *MockCLIClientMockRecorder) PutMapping(ctx, index, mapping any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutMapping", reflect.TypeOf((*MockCLIClient)(nil).PutMapping), ctx, index, mapping)
 }
 
+// ReplaceDocuments mocks base method.
+func (m *MockCLIClient) ReplaceDocuments(ctx context.Context, index string, docs []*DocumentReplacement) (*elastic.BulkResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplaceDocuments", ctx, index, docs)
+	ret0, _ := ret[0].(*elastic.BulkResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReplaceDocuments indicates an expected call of ReplaceDocuments.
+func (mr *MockCLIClientMockRecorder) ReplaceDocuments(ctx, index, docs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceDocuments", reflect.TypeOf((*MockCLIClient)(nil).ReplaceDocuments), ctx, index, docs)
+}
+
 // RunBulkProcessor mocks base method.
 func (m *MockCLIClient) RunBulkProcessor(ctx context.Context, p *BulkProcessorParameters) (BulkProcessor, error) {
 	m.ctrl.T.Helper()
@@ -907,6 +937,21 @@ func (mr *MockIntegrationTestsClientMockRecorder) PutMapping(ctx, index, mapping
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutMapping", reflect.TypeOf((*MockIntegrationTestsClient)(nil).PutMapping), ctx, index, mapping)
 }
 
+// ReplaceDocuments mocks base method.
+func (m *MockIntegrationTestsClient) ReplaceDocuments(ctx context.Context, index string, docs []*DocumentReplacement) (*elastic.BulkResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplaceDocuments", ctx, index, docs)
+	ret0, _ := ret[0].(*elastic.BulkResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReplaceDocuments indicates an expected call of ReplaceDocuments.
+func (mr *MockIntegrationTestsClientMockRecorder) ReplaceDocuments(ctx, index, docs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceDocuments", reflect.TypeOf((*MockIntegrationTestsClient)(nil).ReplaceDocuments), ctx, index, docs)
+}
+
 // RunBulkProcessor mocks base method.
 func (m *MockIntegrationTestsClient) RunBulkProcessor(ctx context.Context, p *BulkProcessorParameters) (BulkProcessor, error) {
 	m.ctrl.T.Helper()
@@ -0,0 +1,216 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sync"
+
+	"github.com/blang/semver/v4"
+	"github.com/olivere/elastic/v7"
+	"github.com/olivere/elastic/v7/uritemplates"
+	"go.temporal.io/server/common/log"
+)
+
+type (
+	// openSearchClientImpl implements Client for OpenSearch 2.x. OpenSearch was forked from Elasticsearch 7.10 and
+	// the search, count, bulk, mapping and index APIs used by visibility are wire compatible with it. It diverges in
+	// the point in time API and in the index template API, which are overridden.
+	openSearchClientImpl struct {
+		*clientImpl
+
+		initIsPointInTimeSupported sync.Once
+		isPointInTimeSupported     bool
+	}
+
+	// clusterInfo is the response of the root endpoint of the cluster
+	clusterInfo struct {
+		Version clusterVersion `json:"version"`
+	}
+
+	clusterVersion struct {
+		Number string `json:"number"`
+		// Distribution is only returned by OpenSearch
+		Distribution string `json:"distribution"`
+		BuildFlavor  string `json:"build_flavor"`
+	}
+
+	openPointInTimeResponse struct {
+		PitID string `json:"pit_id"`
+	}
+
+	closePointInTimeResponse struct {
+		Pits []struct {
+			PitID      string `json:"pit_id"`
+			Successful bool   `json:"successful"`
+		} `json:"pits"`
+	}
+
+	// legacyIndexTemplate is the body of the legacy index template API
+	legacyIndexTemplate struct {
+		Order         int            `json:"order"`
+		IndexPatterns []string       `json:"index_patterns"`
+		Settings      map[string]any `json:"settings,omitempty"`
+		Mappings      map[string]any `json:"mappings,omitempty"`
+		Aliases       map[string]any `json:"aliases,omitempty"`
+	}
+)
+
+const (
+	openSearchDistribution = "opensearch"
+)
+
+var (
+	openSearchPointInTimeSupportedIn = semver.MustParseRange(">=2.4.0")
+)
+
+var _ Client = (*openSearchClientImpl)(nil)
+
+// newOpenSearchClient create an OpenSearch client
+func newOpenSearchClient(cfg *Config, httpClient *http.Client, logger log.Logger) (*openSearchClientImpl, error) {
+	client, err := newClient(cfg, httpClient, logger)
+	if err != nil {
+		return nil, err
+	}
+	return &openSearchClientImpl{
+		clientImpl: client,
+	}, nil
+}
+
+// getClusterInfo returns the distribution and the version of the cluster
+func (c *clientImpl) getClusterInfo(ctx context.Context) (*clusterInfo, error) {
+	res, err := c.esClient.PerformRequest(ctx, elastic.PerformRequestOptions{
+		Method:  "GET",
+		Path:    "/",
+		Params:  url.Values{},
+		Headers: http.Header{},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var info clusterInfo
+	if err := json.Unmarshal(res.Body, &info); err != nil {
+		return nil, err
+	}
+	return &info, nil
+}
+
+func (i *clusterInfo) isOpenSearch() bool {
+	return i.Version.Distribution == openSearchDistribution
+}
+
+func (c *openSearchClientImpl) IsPointInTimeSupported(ctx context.Context) bool {
+	c.initIsPointInTimeSupported.Do(func() {
+		c.isPointInTimeSupported = c.queryPointInTimeSupported(ctx)
+	})
+	return c.isPointInTimeSupported
+}
+
+func (c *openSearchClientImpl) queryPointInTimeSupported(ctx context.Context) bool {
+	info, err := c.getClusterInfo(ctx)
+	if err != nil || !info.isOpenSearch() {
+		return false
+	}
+	openSearchVersion, err := semver.ParseTolerant(info.Version.Number)
+	if err != nil {
+		return false
+	}
+	return openSearchPointInTimeSupportedIn(openSearchVersion)
+}
+
+func (c *openSearchClientImpl) OpenPointInTime(ctx context.Context, index string, keepAliveInterval string) (string, error) {
+	path, err := uritemplates.Expand("/{index}/_search/point_in_time", map[string]string{
+		"index": index,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	res, err := c.esClient.PerformRequest(ctx, elastic.PerformRequestOptions{
+		Method:  "POST",
+		Path:    path,
+		Params:  url.Values{"keep_alive": []string{keepAliveInterval}},
+		Headers: http.Header{},
+	})
+	if err != nil {
+		return "", err
+	}
+
+	var resp openPointInTimeResponse
+	if err := json.Unmarshal(res.Body, &resp); err != nil {
+		return "", err
+	}
+	if resp.PitID == "" {
+		return "", errors.New("OpenSearch didn't return point in time id")
+	}
+	return resp.PitID, nil
+}
+
+func (c *openSearchClientImpl) ClosePointInTime(ctx context.Context, id string) (bool, error) {
+	res, err := c.esClient.PerformRequest(ctx, elastic.PerformRequestOptions{
+		Method:  "DELETE",
+		Path:    "/_search/point_in_time",
+		Params:  url.Values{},
+		Body:    map[string]any{"pit_id": []string{id}},
+		Headers: http.Header{},
+	})
+	if err != nil {
+		return false, err
+	}
+
+	var resp closePointInTimeResponse
+	if err := json.Unmarshal(res.Body, &resp); err != nil {
+		return false, err
+	}
+	for _, pit := range resp.Pits {
+		if pit.PitID == id {
+			return pit.Successful, nil
+		}
+	}
+	return false, nil
+}
+
+// IndexPutTemplate creates a composable index template from the body of a legacy index template: legacy index
+// templates are deprecated in OpenSearch 2.x.
+func (c *openSearchClientImpl) IndexPutTemplate(ctx context.Context, templateName string, bodyString string) (bool, error) {
+	body, err := convertLegacyIndexTemplate(bodyString)
+	if err != nil {
+		return false, err
+	}
+	resp, err := c.esClient.IndexPutIndexTemplate(templateName).BodyJson(body).Do(ctx)
+	if err != nil {
+		return false, err
+	}
+	return resp.Acknowledged, nil
+}
+
+// convertLegacyIndexTemplate converts the body of a legacy index template to the body of a composable index template
+func convertLegacyIndexTemplate(bodyString string) (map[string]any, error) {
+	var legacy legacyIndexTemplate
+	if err := json.Unmarshal([]byte(bodyString), &legacy); err != nil {
+		return nil, fmt.Errorf("unable to parse index template: %w", err)
+	}
+	if len(legacy.IndexPatterns) == 0 {
+		return nil, errors.New("index template doesn't have index patterns")
+	}
+
+	template := make(map[string]any)
+	if legacy.Settings != nil {
+		template["settings"] = legacy.Settings
+	}
+	if legacy.Mappings != nil {
+		template["mappings"] = legacy.Mappings
+	}
+	if legacy.Aliases != nil {
+		template["aliases"] = legacy.Aliases
+	}
+	return map[string]any{
+		"index_patterns": legacy.IndexPatterns,
+		"priority":       legacy.Order,
+		"template":       template,
+	}, nil
+}
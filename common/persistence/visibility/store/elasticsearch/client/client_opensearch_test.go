@@ -0,0 +1,287 @@
+package client
+
+import (
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/olivere/elastic/v7"
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/tests/testutils"
+)
+
+type (
+	// fakeCluster records the requests to a cluster and serves the registered responses
+	fakeCluster struct {
+		t        *testing.T
+		server   *httptest.Server
+		handlers map[string]func(r *http.Request, body []byte) any
+
+		mu       sync.Mutex
+		requests []fakeRequest
+	}
+
+	fakeRequest struct {
+		method string
+		path   string
+		query  url.Values
+		body   []byte
+	}
+)
+
+func newFakeCluster(t *testing.T) *fakeCluster {
+	c := &fakeCluster{
+		t:        t,
+		handlers: make(map[string]func(r *http.Request, body []byte) any),
+	}
+	c.server = httptest.NewServer(http.HandlerFunc(c.serveHTTP))
+	t.Cleanup(c.server.Close)
+	return c
+}
+
+func (c *fakeCluster) handle(method string, path string, handler func(r *http.Request, body []byte) any) {
+	c.handlers[method+" "+path] = handler
+}
+
+func (c *fakeCluster) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	reader := io.Reader(r.Body)
+	if r.Header.Get("Content-Encoding") == "gzip" {
+		gzipReader, err := gzip.NewReader(r.Body)
+		require.NoError(c.t, err)
+		reader = gzipReader
+	}
+	body, err := io.ReadAll(reader)
+	require.NoError(c.t, err)
+	c.mu.Lock()
+	c.requests = append(c.requests, fakeRequest{method: r.Method, path: r.URL.Path, query: r.URL.Query(), body: body})
+	c.mu.Unlock()
+
+	handler, ok := c.handlers[r.Method+" "+r.URL.Path]
+	if !ok {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	require.NoError(c.t, json.NewEncoder(w).Encode(handler(r, body)))
+}
+
+func (c *fakeCluster) lastRequest() fakeRequest {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	require.NotEmpty(c.t, c.requests)
+	return c.requests[len(c.requests)-1]
+}
+
+func (c *fakeCluster) config(version string) *Config {
+	u, err := url.Parse(c.server.URL)
+	require.NoError(c.t, err)
+	return &Config{
+		Version: version,
+		URL:     *u,
+	}
+}
+
+func (c *fakeCluster) handleClusterInfo(distribution string, number string) {
+	c.handle("GET", "/", func(*http.Request, []byte) any {
+		return map[string]any{
+			"version": map[string]any{
+				"distribution": distribution,
+				"number":       number,
+			},
+		}
+	})
+}
+
+func TestNewClient_DetectDistribution(t *testing.T) {
+	cluster := newFakeCluster(t)
+	cluster.handleClusterInfo("opensearch", "2.11.1")
+	client, err := NewClient(cluster.config(""), nil, log.NewTestLogger())
+	require.NoError(t, err)
+	require.IsType(t, &openSearchClientImpl{}, client)
+
+	cluster = newFakeCluster(t)
+	cluster.handleClusterInfo("", "7.17.0")
+	client, err = NewClient(cluster.config(""), nil, log.NewTestLogger())
+	require.NoError(t, err)
+	require.IsType(t, &clientImpl{}, client)
+
+	cluster = newFakeCluster(t)
+	cluster.server.Close()
+	client, err = NewClient(cluster.config(""), nil, log.NewTestLogger())
+	require.NoError(t, err)
+	require.IsType(t, &clientImpl{}, client)
+}
+
+func TestNewClient_ConfiguredVersion(t *testing.T) {
+	cluster := newFakeCluster(t)
+	client, err := NewClient(cluster.config(versionOpenSearchV2), nil, log.NewTestLogger())
+	require.NoError(t, err)
+	require.IsType(t, &openSearchClientImpl{}, client)
+
+	client, err = NewClient(cluster.config(versionElasticsearchV7), nil, log.NewTestLogger())
+	require.NoError(t, err)
+	require.IsType(t, &clientImpl{}, client)
+
+	_, err = NewClient(cluster.config("opensearch1"), nil, log.NewTestLogger())
+	require.Error(t, err)
+}
+
+func TestOpenSearchClient_IsPointInTimeSupported(t *testing.T) {
+	tests := []struct {
+		distribution string
+		number       string
+		expected     bool
+	}{
+		{distribution: "opensearch", number: "2.11.1", expected: true},
+		{distribution: "opensearch", number: "2.4.0", expected: true},
+		{distribution: "opensearch", number: "2.3.0", expected: false},
+		{distribution: "opensearch", number: "1.3.14", expected: false},
+		{distribution: "", number: "7.17.0", expected: false},
+	}
+	for _, test := range tests {
+		cluster := newFakeCluster(t)
+		cluster.handleClusterInfo(test.distribution, test.number)
+		client, err := newOpenSearchClient(cluster.config(versionOpenSearchV2), nil, log.NewTestLogger())
+		require.NoError(t, err)
+		require.Equal(t, test.expected, client.IsPointInTimeSupported(context.Background()), test.number)
+	}
+}
+
+func TestOpenSearchClient_PointInTime(t *testing.T) {
+	cluster := newFakeCluster(t)
+	cluster.handle("POST", "/test-index/_search/point_in_time", func(*http.Request, []byte) any {
+		return map[string]any{"pit_id": "test-pit-id", "creation_time": time.Now().UnixMilli()}
+	})
+	cluster.handle("DELETE", "/_search/point_in_time", func(*http.Request, []byte) any {
+		return map[string]any{"pits": []map[string]any{{"pit_id": "test-pit-id", "successful": true}}}
+	})
+	client, err := newOpenSearchClient(cluster.config(versionOpenSearchV2), nil, log.NewTestLogger())
+	require.NoError(t, err)
+
+	pitID, err := client.OpenPointInTime(context.Background(), "test-index", "1m")
+	require.NoError(t, err)
+	require.Equal(t, "test-pit-id", pitID)
+	require.Equal(t, "1m", cluster.lastRequest().query.Get("keep_alive"))
+
+	succeeded, err := client.ClosePointInTime(context.Background(), pitID)
+	require.NoError(t, err)
+	require.True(t, succeeded)
+	require.JSONEq(t, `{"pit_id":["test-pit-id"]}`, string(cluster.lastRequest().body))
+
+	// Search with point in time uses the same request body as Elasticsearch.
+	cluster.handle("POST", "/_search", func(*http.Request, []byte) any {
+		return map[string]any{"pit_id": "test-pit-id", "hits": map[string]any{"hits": []any{}}}
+	})
+	result, err := client.Search(context.Background(), &SearchParameters{
+		Index:       "test-index",
+		Query:       elastic.NewMatchAllQuery(),
+		PointInTime: elastic.NewPointInTimeWithKeepAlive(pitID, "1m"),
+	})
+	require.NoError(t, err)
+	require.Equal(t, "test-pit-id", result.PitId)
+	var body map[string]any
+	require.NoError(t, json.Unmarshal(cluster.lastRequest().body, &body))
+	require.Equal(t, map[string]any{"id": "test-pit-id", "keep_alive": "1m"}, body["pit"])
+}
+
+func TestOpenSearchClient_IndexPutTemplate(t *testing.T) {
+	cluster := newFakeCluster(t)
+	cluster.handle("PUT", "/_index_template/temporal_visibility_v1_template", func(*http.Request, []byte) any {
+		return map[string]any{"acknowledged": true}
+	})
+	client, err := newOpenSearchClient(cluster.config(versionOpenSearchV2), nil, log.NewTestLogger())
+	require.NoError(t, err)
+
+	templateFile := path.Join(testutils.GetRepoRootDirectory(), "schema/elasticsearch/visibility/index_template_v7.json")
+	template, err := os.ReadFile(templateFile)
+	require.NoError(t, err)
+	acknowledged, err := client.IndexPutTemplate(context.Background(), "temporal_visibility_v1_template", string(template))
+	require.NoError(t, err)
+	require.True(t, acknowledged)
+
+	var legacy map[string]any
+	require.NoError(t, json.Unmarshal(template, &legacy))
+	var composable map[string]any
+	require.NoError(t, json.Unmarshal(cluster.lastRequest().body, &composable))
+	require.Equal(t, legacy["index_patterns"], composable["index_patterns"])
+	require.EqualValues(t, legacy["order"], composable["priority"])
+	require.Equal(t, map[string]any{
+		"settings": legacy["settings"],
+		"mappings": legacy["mappings"],
+	}, composable["template"])
+}
+
+func TestConvertLegacyIndexTemplate_Errors(t *testing.T) {
+	_, err := convertLegacyIndexTemplate("{")
+	require.Error(t, err)
+	_, err = convertLegacyIndexTemplate(`{"order": 0}`)
+	require.Error(t, err)
+}
+
+func TestOpenSearchClient_BulkProcessor(t *testing.T) {
+	cluster := newFakeCluster(t)
+	cluster.handle("POST", "/_bulk", func(*http.Request, []byte) any {
+		return map[string]any{
+			"took":   1,
+			"errors": true,
+			"items": []map[string]any{
+				{"index": map[string]any{"_index": "test-index", "_id": "1", "_version": 3, "status": 201}},
+				{"delete": map[string]any{"_index": "test-index", "_id": "2", "status": 409, "error": map[string]any{
+					"type":   "version_conflict_engine_exception",
+					"reason": "current version [5] is higher or equal to the one provided [4]",
+				}}},
+			},
+		}
+	})
+	client, err := newOpenSearchClient(cluster.config(versionOpenSearchV2), nil, log.NewTestLogger())
+	require.NoError(t, err)
+
+	type bulkResult struct {
+		response *elastic.BulkResponse
+		err      error
+	}
+	results := make(chan bulkResult, 1)
+	processor, err := client.RunBulkProcessor(context.Background(), &BulkProcessorParameters{
+		Name:          "test-bulk-processor",
+		NumOfWorkers:  1,
+		BulkActions:   2,
+		FlushInterval: time.Minute,
+		AfterFunc: func(_ int64, _ []elastic.BulkableRequest, response *elastic.BulkResponse, err error) {
+			results <- bulkResult{response: response, err: err}
+		},
+	})
+	require.NoError(t, err)
+	processor.Add(&BulkableRequest{
+		RequestType: BulkableRequestTypeIndex,
+		Index:       "test-index",
+		ID:          "1",
+		Version:     3,
+		Doc:         map[string]any{"WorkflowId": "test-workflow-id"},
+	})
+	processor.Add(&BulkableRequest{
+		RequestType: BulkableRequestTypeDelete,
+		Index:       "test-index",
+		ID:          "2",
+		Version:     4,
+	})
+
+	result := <-results
+	require.NoError(t, result.err)
+	response := result.response
+	require.True(t, response.Errors)
+	require.Equal(t, http.StatusCreated, response.Indexed()[0].Status)
+	require.Equal(t, http.StatusConflict, response.Deleted()[0].Status)
+	require.Equal(t, "version_conflict_engine_exception", response.Deleted()[0].Error.Type)
+	require.Contains(t, string(cluster.lastRequest().body), `"version_type":"external"`)
+	require.NoError(t, processor.Stop())
+}
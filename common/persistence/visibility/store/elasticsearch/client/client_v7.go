@@ -133,6 +133,17 @@ func (c *clientImpl) Get(ctx context.Context, index string, docID string) (*elas
 }
 
 func (c *clientImpl) Search(ctx context.Context, p *SearchParameters) (*elastic.SearchResult, error) {
+	searchService := c.esClient.Search().SearchSource(NewSearchSource(p))
+	// If pit is specified, index must not be used.
+	if p.PointInTime == nil {
+		searchService.Index(p.Index)
+	}
+
+	return searchService.Do(ctx)
+}
+
+// NewSearchSource returns the body of the search request of the search parameters
+func NewSearchSource(p *SearchParameters) *elastic.SearchSource {
 	searchSource := elastic.NewSearchSource().
 		Query(p.Query).
 		SortBy(p.Sorter...).
@@ -150,13 +161,10 @@ func (c *clientImpl) Search(ctx context.Context, p *SearchParameters) (*elastic.
 		searchSource.SearchAfter(p.SearchAfter...)
 	}
 
-	searchService := c.esClient.Search().SearchSource(searchSource)
-	// If pit is specified, index must not be used.
-	if p.PointInTime == nil {
-		searchService.Index(p.Index)
+	if p.Version {
+		searchSource.Version(true)
 	}
-
-	return searchService.Do(ctx)
+	return searchSource
 }
 
 func (c *clientImpl) OpenScroll(
@@ -260,6 +268,22 @@ func (c *clientImpl) RunBulkProcessor(ctx context.Context, p *BulkProcessorParam
 	return newBulkProcessor(esBulkProcessor), err
 }
 
+func (c *clientImpl) ReplaceDocuments(
+	ctx context.Context,
+	index string,
+	docs []*DocumentReplacement,
+) (*elastic.BulkResponse, error) {
+	bulkService := c.esClient.Bulk().Index(index)
+	for _, doc := range docs {
+		bulkService.Add(elastic.NewBulkIndexRequest().
+			Id(doc.ID).
+			VersionType(versionTypeExternalGTE).
+			Version(doc.Version).
+			Doc(doc.Doc))
+	}
+	return bulkService.Do(ctx)
+}
+
 func (c *clientImpl) PutMapping(ctx context.Context, index string, mapping map[string]enumspb.IndexedValueType) (bool, error) {
 	body := buildMappingBody(mapping)
 	resp, err := c.esClient.PutMapping().Index(index).BodyJson(body).Do(ctx)
@@ -19,6 +19,7 @@ const (
 // Config for connecting to Elasticsearch
 type (
 	Config struct {
+		// Version is one of "v7", "v8" or "opensearch2". The distribution is detected from the cluster if it's empty.
 		Version                      string                    `yaml:"version"`
 		URL                          url.URL                   `yaml:"url"`
 		URLs                         []url.URL                 `yaml:"urls"`
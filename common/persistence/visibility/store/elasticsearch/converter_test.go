@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	enumspb "go.temporal.io/api/enums/v1"
@@ -28,6 +29,9 @@ var errorCases = map[string]string{
 	"select * from a where a not like '%a%'": "operator 'not like' not allowed in comparison expression",
 	"invalid query":                          query.MalformedSqlQueryErrMessage,
 	"select * from a where  a= 1 and multi_match(zz=1, query='this is a test', fields=(title,title.origin), type=phrase)": query.NotSupportedErrMessage,
+	"select * from a group by time_bucket(k)":         query.InvalidExpressionErrMessage,
+	"select * from a group by time_bucket(k, 1)":      query.InvalidExpressionErrMessage,
+	"select * from a group by time_bucket(k, '10ms')": query.InvalidExpressionErrMessage,
 }
 
 var supportedWhereCases = map[string]string{
@@ -91,8 +95,9 @@ var supportedWhereOrderCases = map[string]struct {
 }
 
 var supportedWhereGroupByCases = map[string]struct {
-	query   string
-	groupBy []string
+	query    string
+	groupBy  []string
+	interval time.Duration
 }{
 	"group by status": {
 		query:   ``,
@@ -102,6 +107,16 @@ var supportedWhereGroupByCases = map[string]struct {
 		query:   `{"bool":{"filter":{"term":{"id":1}}}}`,
 		groupBy: []string{"status"},
 	},
+	"group by time_bucket(create_time, '1h')": {
+		query:    ``,
+		groupBy:  []string{"create_time"},
+		interval: time.Hour,
+	},
+	"id = 1 group by TIME_BUCKET(create_time, '1d')": {
+		query:    `{"bool":{"filter":{"term":{"id":1}}}}`,
+		groupBy:  []string{"create_time"},
+		interval: 24 * time.Hour,
+	},
 }
 
 var testNameTypeMap = searchattribute.NewNameTypeMapStub(
@@ -192,6 +207,7 @@ func TestSupportedSelectWhereGroupBy(t *testing.T) {
 			assert.Nil(t, queryParams.Query)
 		}
 		assert.Equal(t, expectedJson.groupBy, queryParams.GroupBy)
+		assert.Equal(t, expectedJson.interval, queryParams.GroupByInterval, fmt.Sprintf("sql: %s", sql))
 	}
 }
 
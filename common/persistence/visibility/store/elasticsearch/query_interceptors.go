@@ -95,10 +95,19 @@ func (ni *nameInterceptor) Name(name string, usage query.FieldNameUsage) (string
 			)
 		}
 	case query.FieldNameGroupBy:
-		if fieldName != searchattribute.ExecutionStatus {
+		if fieldType != enumspb.INDEXED_VALUE_TYPE_KEYWORD {
 			return "", query.NewConverterError(
-				"'group by' clause is only supported for %s search attribute",
-				searchattribute.ExecutionStatus,
+				"'group by' clause is only supported for search attributes of %s type",
+				enumspb.INDEXED_VALUE_TYPE_KEYWORD.String(),
+			)
+		}
+	case query.FieldNameGroupByTimeBucket:
+		if !query.IsTimeBucketField(fieldName) {
+			return "", query.NewConverterError(
+				"%s function is only supported for %s and %s search attributes",
+				query.TimeBucketFuncName,
+				searchattribute.StartTime,
+				searchattribute.CloseTime,
 			)
 		}
 	}
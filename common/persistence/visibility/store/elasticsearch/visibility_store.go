@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"io"
 	"math"
+	"net/http"
 	"strconv"
 	"strings"
 	"time"
@@ -37,6 +38,12 @@ const (
 	delimiter                    = "~"
 	scrollKeepAliveInterval      = "1m"
 	pointInTimeKeepAliveInterval = "1m"
+
+	// countGroupByTermsSize is the maximum number of groups per 'group by' field, Elasticsearch only returns the 10
+	// largest groups by default.
+	countGroupByTermsSize = 1000
+	// timeBucketKeyFormat is the format of the keys of the time buckets, it's parsed as RFC3339Nano
+	timeBucketKeyFormat = "strict_date_optional_time_nanos"
 )
 
 type (
@@ -267,6 +274,168 @@ func (s *VisibilityStore) DeleteWorkflowExecution(
 	return s.AddBulkRequestAndWait(ctx, bulkDeleteRequest, docID)
 }
 
+func (s *VisibilityStore) CopySearchAttributeValues(
+	ctx context.Context,
+	request *manager.CopySearchAttributeValuesRequest,
+) (*manager.SearchAttributeValuesResponse, error) {
+	if err := s.validateCustomSearchAttributeField(request.SourceField, request.SourceType); err != nil {
+		return nil, err
+	}
+	if err := s.validateCustomSearchAttributeField(request.TargetField, request.TargetType); err != nil {
+		return nil, err
+	}
+	if !searchattribute.IsTypeConversionSupported(request.SourceType, request.TargetType) {
+		return nil, serviceerror.NewInvalidArgument(fmt.Sprintf(
+			"Unable to convert search attribute values from %s to %s", request.SourceType, request.TargetType))
+	}
+
+	return s.updateSearchAttributeValues(
+		ctx,
+		request.NamespaceID,
+		request.SourceField,
+		request.TargetField,
+		request.PageSize,
+		request.NextPageToken,
+		func(doc map[string]any) error {
+			value, err := searchattribute.ConvertValue(doc[request.SourceField], request.SourceType, request.TargetType)
+			if err != nil {
+				return err
+			}
+			doc[request.TargetField] = value
+			return nil
+		},
+	)
+}
+
+func (s *VisibilityStore) ClearSearchAttributeValues(
+	ctx context.Context,
+	request *manager.ClearSearchAttributeValuesRequest,
+) (*manager.SearchAttributeValuesResponse, error) {
+	if err := s.validateCustomSearchAttributeField(request.Field, enumspb.INDEXED_VALUE_TYPE_UNSPECIFIED); err != nil {
+		return nil, err
+	}
+
+	return s.updateSearchAttributeValues(
+		ctx,
+		request.NamespaceID,
+		request.Field,
+		"",
+		request.PageSize,
+		request.NextPageToken,
+		func(doc map[string]any) error {
+			delete(doc, request.Field)
+			return nil
+		},
+	)
+}
+
+// updateSearchAttributeValues applies update to a page of documents which have a value in field and no value in
+// missingField, and re-indexes them with their current version. Documents indexed by a visibility task since they
+// were read are skipped with a version conflict. Documents which can't be updated are counted as failed.
+func (s *VisibilityStore) updateSearchAttributeValues(
+	ctx context.Context,
+	namespaceID namespace.ID,
+	field string,
+	missingField string,
+	pageSize int,
+	nextPageToken []byte,
+	update func(doc map[string]any) error,
+) (*manager.SearchAttributeValuesResponse, error) {
+	token, err := s.deserializePageToken(nextPageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	boolQuery := elastic.NewBoolQuery().Filter(
+		elastic.NewTermQuery(searchattribute.NamespaceID, namespaceID.String()),
+		elastic.NewExistsQuery(field),
+	)
+	if missingField != "" {
+		boolQuery.MustNot(elastic.NewExistsQuery(missingField))
+	}
+	p := &client.SearchParameters{
+		Index:    s.index,
+		Query:    boolQuery,
+		PageSize: pageSize,
+		Sorter:   []elastic.Sorter{elastic.NewFieldSort(searchattribute.RunID)},
+		Version:  true,
+	}
+	if token != nil {
+		p.SearchAfter = token.SearchAfter
+	}
+	searchResult, err := s.esClient.Search(ctx, p)
+	if err != nil {
+		return nil, ConvertElasticsearchClientError("CopySearchAttributeValues failed", err)
+	}
+
+	response := &manager.SearchAttributeValuesResponse{}
+	if searchResult.Hits == nil || len(searchResult.Hits.Hits) == 0 {
+		return response, nil
+	}
+	hits := searchResult.Hits.Hits
+	docs := make([]*client.DocumentReplacement, 0, len(hits))
+	for _, hit := range hits {
+		var doc map[string]any
+		d := json.NewDecoder(bytes.NewReader(hit.Source))
+		// Very important line. See finishParseJSONValue bellow.
+		d.UseNumber()
+		if err := d.Decode(&doc); err != nil || hit.Version == nil {
+			response.FailedCount++
+			continue
+		}
+		if err := update(doc); err != nil {
+			response.FailedCount++
+			continue
+		}
+		docs = append(docs, &client.DocumentReplacement{
+			ID:      hit.Id,
+			Version: *hit.Version,
+			Doc:     doc,
+		})
+	}
+
+	if len(docs) > 0 {
+		bulkResponse, err := s.esClient.ReplaceDocuments(ctx, s.index, docs)
+		if err != nil {
+			return nil, ConvertElasticsearchClientError("Unable to replace documents", err)
+		}
+		response.UpdatedCount = int64(len(bulkResponse.Succeeded()))
+		for _, item := range bulkResponse.Failed() {
+			// Version conflicts are documents updated by a visibility task since they were read.
+			if item.Status != http.StatusConflict {
+				response.FailedCount++
+			}
+		}
+	}
+
+	if len(hits) == pageSize {
+		response.NextPageToken, err = s.serializePageToken(&visibilityPageToken{
+			SearchAfter: hits[len(hits)-1].Sort,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return response, nil
+}
+
+// validateCustomSearchAttributeField checks that field is a custom search attribute of the index, and that its type
+// is tp unless tp is unspecified.
+func (s *VisibilityStore) validateCustomSearchAttributeField(field string, tp enumspb.IndexedValueType) error {
+	saTypeMap, err := s.searchAttributesProvider.GetSearchAttributes(s.index, false)
+	if err != nil {
+		return serviceerror.NewUnavailable(fmt.Sprintf("Unable to read search attributes types: %v", err))
+	}
+	fieldType, ok := saTypeMap.Custom()[field]
+	if !ok {
+		return serviceerror.NewInvalidArgument(fmt.Sprintf("%s is not a custom search attribute", field))
+	}
+	if tp != enumspb.INDEXED_VALUE_TYPE_UNSPECIFIED && fieldType != tp {
+		return serviceerror.NewInvalidArgument(fmt.Sprintf("%s is of type %s, not %s", field, fieldType, tp))
+	}
+	return nil
+}
+
 func GetDocID(workflowID string, runID string) string {
 	// From Elasticsearch doc: _id is limited to 512 bytes in size and larger values will be rejected.
 	const maxDocIDLength = 512
@@ -481,6 +650,26 @@ func (s *VisibilityStore) countGroupByWorkflowExecutions(
 ) (*manager.CountWorkflowExecutionsResponse, error) {
 	groupByFields := queryParams.GroupBy
 
+	if queryParams.GroupByInterval > 0 {
+		// Time buckets are only supported for a single 'group by' field.
+		dateHistogramAgg := elastic.NewDateHistogramAggregation().
+			Field(groupByFields[0]).
+			FixedInterval(fmt.Sprintf("%ds", int64(queryParams.GroupByInterval/time.Second))).
+			MinDocCount(1).
+			Format(timeBucketKeyFormat)
+		esResponse, err := s.esClient.CountGroupBy(
+			ctx,
+			s.index,
+			queryParams.Query,
+			groupByFields[0],
+			dateHistogramAgg,
+		)
+		if err != nil {
+			return nil, err
+		}
+		return s.parseCountGroupByResponse(esResponse, groupByFields, "key_as_string")
+	}
+
 	// Elasticsearch aggregation is nested. so need to loop backwards to build it.
 	// Example: when grouping by (field1, field2), the object looks like
 	// {
@@ -499,10 +688,13 @@ func (s *VisibilityStore) countGroupByWorkflowExecutions(
 	//     }
 	//   }
 	// }
-	termsAgg := elastic.NewTermsAggregation().Field(groupByFields[len(groupByFields)-1])
+	termsAgg := elastic.NewTermsAggregation().
+		Field(groupByFields[len(groupByFields)-1]).
+		Size(countGroupByTermsSize)
 	for i := len(groupByFields) - 2; i >= 0; i-- {
 		termsAgg = elastic.NewTermsAggregation().
 			Field(groupByFields[i]).
+			Size(countGroupByTermsSize).
 			SubAggregation(groupByFields[i+1], termsAgg)
 	}
 	esResponse, err := s.esClient.CountGroupBy(
@@ -515,7 +707,7 @@ func (s *VisibilityStore) countGroupByWorkflowExecutions(
 	if err != nil {
 		return nil, err
 	}
-	return s.parseCountGroupByResponse(esResponse, groupByFields)
+	return s.parseCountGroupByResponse(esResponse, groupByFields, "key")
 }
 
 func (s *VisibilityStore) GetWorkflowExecution(
@@ -551,6 +743,39 @@ func (s *VisibilityStore) GetWorkflowExecution(
 	}, nil
 }
 
+// ExplainQuery returns the JSON body of the search request of a list request. Elasticsearch can't explain a query
+// without running it, so the execution plan is not supported.
+func (s *VisibilityStore) ExplainQuery(
+	_ context.Context,
+	request *manager.ExplainQueryRequest,
+) (*manager.ExplainQueryResponse, error) {
+	if request.IncludePlan {
+		return nil, serviceerror.NewInvalidArgument("execution plan is only supported by SQL visibility")
+	}
+	p, err := s.BuildSearchParametersV2(&manager.ListWorkflowExecutionsRequestV2{
+		NamespaceID: request.NamespaceID,
+		Namespace:   request.Namespace,
+		PageSize:    request.PageSize,
+		Query:       request.Query,
+	}, s.GetListFieldSorter)
+	if err != nil {
+		return nil, err
+	}
+
+	source, err := client.NewSearchSource(p).Source()
+	if err != nil {
+		return nil, serviceerror.NewInternal(fmt.Sprintf("unable to build search request: %v", err))
+	}
+	body, err := json.Marshal(source)
+	if err != nil {
+		return nil, serviceerror.NewInternal(fmt.Sprintf("unable to encode search request: %v", err))
+	}
+	return &manager.ExplainQueryResponse{
+		StoreName: s.GetName(),
+		Query:     string(body),
+	}, nil
+}
+
 func (s *VisibilityStore) buildSearchParameters(
 	request *manager.ListWorkflowExecutionsRequest,
 	boolQuery *elastic.BoolQuery,
@@ -1089,12 +1314,13 @@ func (s *VisibilityStore) ParseESDoc(
 }
 
 // Elasticsearch aggregation groups are returned as a nested object.
-// This function flattens the response into rows.
+// This function flattens the response into rows. The value of a group is the bucketKey field of its bucket.
 //
 //nolint:revive // cognitive complexity 27 (> max enabled 25)
 func (s *VisibilityStore) parseCountGroupByResponse(
 	searchResult *elastic.SearchResult,
 	groupByFields []string,
+	bucketKey string,
 ) (*manager.CountWorkflowExecutionsResponse, error) {
 	response := &manager.CountWorkflowExecutionsResponse{}
 	typeMap, err := s.searchAttributesProvider.GetSearchAttributes(s.index, false)
@@ -1147,9 +1373,9 @@ func (s *VisibilityStore) parseCountGroupByResponse(
 		buckets := aggs[fieldName].(map[string]any)["buckets"].([]any)
 		for i := range buckets {
 			bucket := buckets[i].(map[string]any)
-			value, err := finishParseJSONValue(bucket["key"], groupByTypes[index])
+			value, err := finishParseJSONValue(bucket[bucketKey], groupByTypes[index])
 			if err != nil {
-				return fmt.Errorf("unable to parse value %v: %w", bucket["key"], err)
+				return fmt.Errorf("unable to parse value %v: %w", bucket[bucketKey], err)
 			}
 			payload, err := searchattribute.EncodeValue(value, groupByTypes[index])
 			if err != nil {
@@ -1231,7 +1231,7 @@ func (s *ESVisibilitySuite) TestCountWorkflowExecutions_GroupBy() {
 				Filter(elastic.NewTermQuery(searchattribute.NamespaceID, testNamespaceID.String())).
 				MustNot(namespaceDivisionExists),
 			searchattribute.ExecutionStatus,
-			elastic.NewTermsAggregation().Field(searchattribute.ExecutionStatus),
+			elastic.NewTermsAggregation().Field(searchattribute.ExecutionStatus).Size(countGroupByTermsSize),
 		).
 		Return(
 			&elastic.SearchResult{
@@ -1277,14 +1277,136 @@ func (s *ESVisibilitySuite) TestCountWorkflowExecutions_GroupBy() {
 	s.Contains(err.Error(), "'group by' clause supports only a single field")
 	s.Nil(resp)
 
-	// test only allowed to group by ExecutionStatus
-	request.Query = "GROUP BY WorkflowType"
+	// test only allowed to group by keyword search attributes
+	request.Query = "GROUP BY StartTime"
 	resp, err = s.visibilityStore.CountWorkflowExecutions(context.Background(), request)
 	s.Error(err)
-	s.Contains(err.Error(), "'group by' clause is only supported for ExecutionStatus search attribute")
+	s.Contains(err.Error(), "'group by' clause is only supported for search attributes of Keyword type")
+	s.Nil(resp)
+
+	// test time buckets only allowed for StartTime and CloseTime
+	request.Query = "GROUP BY time_bucket(WorkflowType, '1h')"
+	resp, err = s.visibilityStore.CountWorkflowExecutions(context.Background(), request)
+	s.Error(err)
+	s.Contains(err.Error(), "time_bucket function is only supported for StartTime and CloseTime search attributes")
+	s.Nil(resp)
+
+	// test time bucket interval must be whole seconds
+	request.Query = "GROUP BY time_bucket(StartTime, '1500ms')"
+	resp, err = s.visibilityStore.CountWorkflowExecutions(context.Background(), request)
+	s.Error(err)
+	s.Contains(err.Error(), "invalid time_bucket interval '1500ms'")
 	s.Nil(resp)
 }
 
+func (s *ESVisibilitySuite) TestCountWorkflowExecutions_GroupByKeyword() {
+	request := &manager.CountWorkflowExecutionsRequest{
+		NamespaceID: testNamespaceID,
+		Namespace:   testNamespace,
+		Query:       "GROUP BY WorkflowType",
+	}
+	s.mockESClient.EXPECT().
+		CountGroupBy(
+			gomock.Any(),
+			testIndex,
+			elastic.NewBoolQuery().
+				Filter(elastic.NewTermQuery(searchattribute.NamespaceID, testNamespaceID.String())).
+				MustNot(namespaceDivisionExists),
+			searchattribute.WorkflowType,
+			elastic.NewTermsAggregation().Field(searchattribute.WorkflowType).Size(countGroupByTermsSize),
+		).
+		Return(
+			&elastic.SearchResult{
+				Aggregations: map[string]json.RawMessage{
+					searchattribute.WorkflowType: json.RawMessage(
+						`{"buckets":[{"key":"wf-type-1","doc_count":7},{"key":"wf-type-2","doc_count":3}]}`,
+					),
+				},
+			},
+			nil,
+		)
+	resp, err := s.visibilityStore.CountWorkflowExecutions(context.Background(), request)
+	s.NoError(err)
+	payload1, _ := searchattribute.EncodeValue("wf-type-1", enumspb.INDEXED_VALUE_TYPE_KEYWORD)
+	payload2, _ := searchattribute.EncodeValue("wf-type-2", enumspb.INDEXED_VALUE_TYPE_KEYWORD)
+	s.True(temporalproto.DeepEqual(
+		&manager.CountWorkflowExecutionsResponse{
+			Count: 10,
+			Groups: []*workflowservice.CountWorkflowExecutionsResponse_AggregationGroup{
+				{
+					GroupValues: []*commonpb.Payload{payload1},
+					Count:       7,
+				},
+				{
+					GroupValues: []*commonpb.Payload{payload2},
+					Count:       3,
+				},
+			},
+		},
+		resp),
+	)
+}
+
+func (s *ESVisibilitySuite) TestCountWorkflowExecutions_GroupByTimeBucket() {
+	request := &manager.CountWorkflowExecutionsRequest{
+		NamespaceID: testNamespaceID,
+		Namespace:   testNamespace,
+		Query:       "GROUP BY time_bucket(CloseTime, '1h')",
+	}
+	s.mockESClient.EXPECT().
+		CountGroupBy(
+			gomock.Any(),
+			testIndex,
+			elastic.NewBoolQuery().
+				Filter(elastic.NewTermQuery(searchattribute.NamespaceID, testNamespaceID.String())).
+				MustNot(namespaceDivisionExists),
+			searchattribute.CloseTime,
+			elastic.NewDateHistogramAggregation().
+				Field(searchattribute.CloseTime).
+				FixedInterval("3600s").
+				MinDocCount(1).
+				Format(timeBucketKeyFormat),
+		).
+		Return(
+			&elastic.SearchResult{
+				Aggregations: map[string]json.RawMessage{
+					searchattribute.CloseTime: json.RawMessage(
+						`{"buckets":[` +
+							`{"key_as_string":"2024-05-01T10:00:00.000Z","key":1714557600000,"doc_count":12},` +
+							`{"key_as_string":"2024-05-01T12:00:00.000Z","key":1714564800000,"doc_count":5}]}`,
+					),
+				},
+			},
+			nil,
+		)
+	resp, err := s.visibilityStore.CountWorkflowExecutions(context.Background(), request)
+	s.NoError(err)
+	payload1, _ := searchattribute.EncodeValue(
+		time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC),
+		enumspb.INDEXED_VALUE_TYPE_DATETIME,
+	)
+	payload2, _ := searchattribute.EncodeValue(
+		time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC),
+		enumspb.INDEXED_VALUE_TYPE_DATETIME,
+	)
+	s.True(temporalproto.DeepEqual(
+		&manager.CountWorkflowExecutionsResponse{
+			Count: 17,
+			Groups: []*workflowservice.CountWorkflowExecutionsResponse_AggregationGroup{
+				{
+					GroupValues: []*commonpb.Payload{payload1},
+					Count:       12,
+				},
+				{
+					GroupValues: []*commonpb.Payload{payload2},
+					Count:       5,
+				},
+			},
+		},
+		resp),
+	)
+}
+
 func (s *ESVisibilitySuite) TestCountGroupByWorkflowExecutions() {
 	statusCompletedPayload, _ := searchattribute.EncodeValue(
 		enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED,
@@ -1314,7 +1436,7 @@ func (s *ESVisibilitySuite) TestCountGroupByWorkflowExecutions() {
 			name:    "group by one field",
 			groupBy: []string{searchattribute.ExecutionStatus},
 			aggName: searchattribute.ExecutionStatus,
-			agg:     elastic.NewTermsAggregation().Field(searchattribute.ExecutionStatus),
+			agg:     elastic.NewTermsAggregation().Field(searchattribute.ExecutionStatus).Size(countGroupByTermsSize),
 			mockResponse: &elastic.SearchResult{
 				Aggregations: map[string]json.RawMessage{
 					searchattribute.ExecutionStatus: json.RawMessage(
@@ -1352,9 +1474,9 @@ func (s *ESVisibilitySuite) TestCountGroupByWorkflowExecutions() {
 			name:    "group by two fields",
 			groupBy: []string{searchattribute.ExecutionStatus, searchattribute.WorkflowType},
 			aggName: searchattribute.ExecutionStatus,
-			agg: elastic.NewTermsAggregation().Field(searchattribute.ExecutionStatus).SubAggregation(
+			agg: elastic.NewTermsAggregation().Field(searchattribute.ExecutionStatus).Size(countGroupByTermsSize).SubAggregation(
 				searchattribute.WorkflowType,
-				elastic.NewTermsAggregation().Field(searchattribute.WorkflowType),
+				elastic.NewTermsAggregation().Field(searchattribute.WorkflowType).Size(countGroupByTermsSize),
 			),
 			mockResponse: &elastic.SearchResult{
 				Aggregations: map[string]json.RawMessage{
@@ -1429,11 +1551,11 @@ func (s *ESVisibilitySuite) TestCountGroupByWorkflowExecutions() {
 				searchattribute.WorkflowID,
 			},
 			aggName: searchattribute.ExecutionStatus,
-			agg: elastic.NewTermsAggregation().Field(searchattribute.ExecutionStatus).SubAggregation(
+			agg: elastic.NewTermsAggregation().Field(searchattribute.ExecutionStatus).Size(countGroupByTermsSize).SubAggregation(
 				searchattribute.WorkflowType,
-				elastic.NewTermsAggregation().Field(searchattribute.WorkflowType).SubAggregation(
+				elastic.NewTermsAggregation().Field(searchattribute.WorkflowType).Size(countGroupByTermsSize).SubAggregation(
 					searchattribute.WorkflowID,
-					elastic.NewTermsAggregation().Field(searchattribute.WorkflowID),
+					elastic.NewTermsAggregation().Field(searchattribute.WorkflowID).Size(countGroupByTermsSize),
 				),
 			),
 			mockResponse: &elastic.SearchResult{
@@ -1611,6 +1733,36 @@ func (s *ESVisibilitySuite) TestGetWorkflowExecution() {
 	s.Contains(err.Error(), "GetWorkflowExecution failed")
 }
 
+func (s *ESVisibilitySuite) TestExplainQuery() {
+	request := &manager.ExplainQueryRequest{
+		NamespaceID: testNamespaceID,
+		Namespace:   testNamespace,
+		Query:       `ExecutionStatus = "Terminated"`,
+		PageSize:    10,
+	}
+	resp, err := s.visibilityStore.ExplainQuery(context.Background(), request)
+	s.NoError(err)
+	s.Equal(s.visibilityStore.GetName(), resp.StoreName)
+	s.Empty(resp.QueryArgs)
+	s.Empty(resp.Plan)
+
+	var body map[string]any
+	s.NoError(json.Unmarshal([]byte(resp.Query), &body))
+	s.EqualValues(10, body["size"])
+	s.Contains(resp.Query, `{"term":{"ExecutionStatus":"Terminated"}}`)
+	s.Contains(resp.Query, `{"term":{"NamespaceId":"`+testNamespaceID.String()+`"}}`)
+
+	request.IncludePlan = true
+	_, err = s.visibilityStore.ExplainQuery(context.Background(), request)
+	var invalidArgErr *serviceerror.InvalidArgument
+	s.ErrorAs(err, &invalidArgErr)
+
+	request.IncludePlan = false
+	request.Query = "invalid query"
+	_, err = s.visibilityStore.ExplainQuery(context.Background(), request)
+	s.ErrorAs(err, &invalidArgErr)
+}
+
 func (s *ESVisibilitySuite) Test_detailedErrorMessage() {
 	err := errors.New("test message")
 	s.Equal("test message", detailedErrorMessage(err))
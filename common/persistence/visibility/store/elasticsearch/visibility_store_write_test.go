@@ -2,14 +2,19 @@ package elasticsearch
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"net/http"
 	"strings"
 	"time"
 	"unicode/utf8"
 
+	"github.com/olivere/elastic/v7"
 	commonpb "go.temporal.io/api/common/v1"
 	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
 	"go.temporal.io/server/common/future"
+	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/payload"
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/persistence/visibility/manager"
@@ -249,6 +254,93 @@ func (s *ESVisibilitySuite) TestDeleteExecution_EmptyRequest() {
 	s.NoError(err)
 }
 
+func (s *ESVisibilitySuite) TestCopySearchAttributeValues() {
+	version := int64(111)
+	hits := []*elastic.SearchHit{
+		{Id: "wid1~rid1", Version: &version, Source: json.RawMessage(`{"RunId":"rid1","CustomKeywordField":"42"}`), Sort: []any{"rid1"}},
+		{Id: "wid2~rid2", Version: &version, Source: json.RawMessage(`{"RunId":"rid2","CustomKeywordField":"qwe"}`), Sort: []any{"rid2"}},
+		{Id: "wid3~rid3", Version: &version, Source: json.RawMessage(`{"RunId":"rid3","CustomKeywordField":"7"}`), Sort: []any{"rid3"}},
+	}
+	s.mockESClient.EXPECT().Search(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, p *client.SearchParameters) (*elastic.SearchResult, error) {
+			s.Equal(testIndex, p.Index)
+			s.Equal(3, p.PageSize)
+			s.True(p.Version)
+			source, err := p.Query.Source()
+			s.NoError(err)
+			queryJSON, err := json.Marshal(source)
+			s.NoError(err)
+			s.JSONEq(`{"bool":{"filter":[{"term":{"NamespaceId":"namespace-id"}},{"exists":{"field":"CustomKeywordField"}}],"must_not":{"exists":{"field":"CustomIntField"}}}}`, string(queryJSON))
+			return &elastic.SearchResult{Hits: &elastic.SearchHits{Hits: hits}}, nil
+		})
+	s.mockESClient.EXPECT().ReplaceDocuments(gomock.Any(), testIndex, gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ string, docs []*client.DocumentReplacement) (*elastic.BulkResponse, error) {
+			s.Len(docs, 2)
+			s.Equal("wid1~rid1", docs[0].ID)
+			s.Equal(version, docs[0].Version)
+			s.Equal(int64(42), docs[0].Doc["CustomIntField"])
+			s.Equal("42", docs[0].Doc["CustomKeywordField"])
+			s.Equal(int64(7), docs[1].Doc["CustomIntField"])
+			return &elastic.BulkResponse{Items: []map[string]*elastic.BulkResponseItem{
+				{"index": {Id: "wid1~rid1", Status: http.StatusOK}},
+				{"index": {Id: "wid3~rid3", Status: http.StatusConflict}},
+			}}, nil
+		})
+
+	resp, err := s.visibilityStore.CopySearchAttributeValues(context.Background(), &manager.CopySearchAttributeValuesRequest{
+		NamespaceID: namespace.ID("namespace-id"),
+		SourceField: "CustomKeywordField",
+		SourceType:  enumspb.INDEXED_VALUE_TYPE_KEYWORD,
+		TargetField: "CustomIntField",
+		TargetType:  enumspb.INDEXED_VALUE_TYPE_INT,
+		PageSize:    3,
+	})
+	s.NoError(err)
+	s.Equal(int64(1), resp.UpdatedCount)
+	s.Equal(int64(1), resp.FailedCount)
+	token, err := s.visibilityStore.deserializePageToken(resp.NextPageToken)
+	s.NoError(err)
+	s.Equal([]any{"rid3"}, token.SearchAfter)
+}
+
+func (s *ESVisibilitySuite) TestCopySearchAttributeValues_InvalidType() {
+	_, err := s.visibilityStore.CopySearchAttributeValues(context.Background(), &manager.CopySearchAttributeValuesRequest{
+		NamespaceID: namespace.ID("namespace-id"),
+		SourceField: "CustomKeywordField",
+		SourceType:  enumspb.INDEXED_VALUE_TYPE_TEXT,
+		TargetField: "CustomIntField",
+		TargetType:  enumspb.INDEXED_VALUE_TYPE_INT,
+		PageSize:    3,
+	})
+	var invalidArgErr *serviceerror.InvalidArgument
+	s.ErrorAs(err, &invalidArgErr)
+}
+
+func (s *ESVisibilitySuite) TestClearSearchAttributeValues() {
+	version := int64(111)
+	s.mockESClient.EXPECT().Search(gomock.Any(), gomock.Any()).Return(&elastic.SearchResult{Hits: &elastic.SearchHits{Hits: []*elastic.SearchHit{
+		{Id: "wid1~rid1", Version: &version, Source: json.RawMessage(`{"RunId":"rid1","CustomKeywordField":"42"}`), Sort: []any{"rid1"}},
+	}}}, nil)
+	s.mockESClient.EXPECT().ReplaceDocuments(gomock.Any(), testIndex, gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ string, docs []*client.DocumentReplacement) (*elastic.BulkResponse, error) {
+			s.Len(docs, 1)
+			s.NotContains(docs[0].Doc, "CustomKeywordField")
+			return &elastic.BulkResponse{Items: []map[string]*elastic.BulkResponseItem{
+				{"index": {Id: "wid1~rid1", Status: http.StatusOK}},
+			}}, nil
+		})
+
+	resp, err := s.visibilityStore.ClearSearchAttributeValues(context.Background(), &manager.ClearSearchAttributeValuesRequest{
+		NamespaceID: namespace.ID("namespace-id"),
+		Field:       "CustomKeywordField",
+		PageSize:    10,
+	})
+	s.NoError(err)
+	s.Equal(int64(1), resp.UpdatedCount)
+	s.Zero(resp.FailedCount)
+	s.Empty(resp.NextPageToken)
+}
+
 func (s *ESVisibilitySuite) Test_GetDocID() {
 	s.Equal("wid~rid", GetDocID("wid", "rid"))
 
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/olivere/elastic/v7"
 	"github.com/temporalio/sqlparser"
@@ -62,6 +63,9 @@ type (
 		Query   elastic.Query
 		Sorter  []elastic.Sorter
 		GroupBy []string
+		// GroupByInterval is the interval of the time buckets of the 'group by' field if it's grouped by
+		// TimeBucketFuncName, and zero otherwise.
+		GroupByInterval time.Duration
 	}
 )
 
@@ -222,7 +226,16 @@ func (c *Converter) convertSelect(sel *sqlparser.Select) (*QueryParams, error) {
 		return nil, NewConverterError("%s: 'group by' clause supports only a single field", NotSupportedErrMessage)
 	}
 	for _, groupByExpr := range sel.GroupBy {
-		_, colName, err := convertColName(c.fnInterceptor, groupByExpr, FieldNameGroupBy)
+		colNameExpr, interval, isTimeBucket, err := ParseTimeBucket(groupByExpr)
+		if err != nil {
+			return nil, wrapConverterError("unable to convert 'group by' expression", err)
+		}
+		usage := FieldNameGroupBy
+		if isTimeBucket {
+			usage = FieldNameGroupByTimeBucket
+			queryParams.GroupByInterval = interval
+		}
+		_, colName, err := convertColName(c.fnInterceptor, colNameExpr, usage)
 		if err != nil {
 			return nil, wrapConverterError("unable to convert 'group by' column name", err)
 		}
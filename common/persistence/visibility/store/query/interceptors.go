@@ -19,6 +19,7 @@ const (
 	FieldNameFilter FieldNameUsage = iota
 	FieldNameSorter
 	FieldNameGroupBy
+	FieldNameGroupByTimeBucket
 )
 
 func (n *NopFieldNameInterceptor) Name(name string, _ FieldNameUsage) (string, error) {
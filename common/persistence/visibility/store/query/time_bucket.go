@@ -0,0 +1,79 @@
+package query
+
+import (
+	"time"
+
+	"github.com/temporalio/sqlparser"
+	"go.temporal.io/server/common/primitives/timestamp"
+	"go.temporal.io/server/common/searchattribute"
+)
+
+const (
+	// TimeBucketFuncName is the function of the 'group by' clause which groups executions by fixed time intervals
+	// of a datetime search attribute, e.g. "GROUP BY time_bucket(StartTime, '1h')". Buckets start at multiples of
+	// the interval since Unix epoch in UTC, and executions without a value in the search attribute are not counted.
+	TimeBucketFuncName = "time_bucket"
+)
+
+var (
+	timeBucketFields = map[string]struct{}{
+		searchattribute.StartTime: {},
+		searchattribute.CloseTime: {},
+	}
+)
+
+// ParseTimeBucket returns the column name and the interval of a time_bucket expression of the 'group by' clause.
+// isTimeBucket is false if the expression is not a time_bucket function call.
+func ParseTimeBucket(expr sqlparser.Expr) (colName sqlparser.Expr, interval time.Duration, isTimeBucket bool, err error) {
+	funcExpr, ok := expr.(*sqlparser.FuncExpr)
+	if !ok || !funcExpr.Name.EqualString(TimeBucketFuncName) {
+		return expr, 0, false, nil
+	}
+	if len(funcExpr.Exprs) != 2 {
+		return nil, 0, false, NewConverterError(
+			"%s: %s function requires a search attribute and an interval",
+			InvalidExpressionErrMessage,
+			TimeBucketFuncName,
+		)
+	}
+	colNameArg, ok := funcExpr.Exprs[0].(*sqlparser.AliasedExpr)
+	if !ok {
+		return nil, 0, false, NewConverterError(
+			"%s: first argument of %s function must be a search attribute",
+			InvalidExpressionErrMessage,
+			TimeBucketFuncName,
+		)
+	}
+	intervalArg, ok := funcExpr.Exprs[1].(*sqlparser.AliasedExpr)
+	if !ok {
+		return nil, 0, false, NewConverterError(
+			"%s: second argument of %s function must be an interval",
+			InvalidExpressionErrMessage,
+			TimeBucketFuncName,
+		)
+	}
+	intervalVal, ok := intervalArg.Expr.(*sqlparser.SQLVal)
+	if !ok || intervalVal.Type != sqlparser.StrVal {
+		return nil, 0, false, NewConverterError(
+			"%s: second argument of %s function must be an interval string, e.g. '1h'",
+			InvalidExpressionErrMessage,
+			TimeBucketFuncName,
+		)
+	}
+	interval, err = timestamp.ParseDuration(string(intervalVal.Val))
+	if err != nil || interval < time.Second || interval%time.Second != 0 {
+		return nil, 0, false, NewConverterError(
+			"%s: invalid %s interval '%s', it must be a whole number of seconds",
+			InvalidExpressionErrMessage,
+			TimeBucketFuncName,
+			intervalVal.Val,
+		)
+	}
+	return colNameArg.Expr, interval, true, nil
+}
+
+// IsTimeBucketField returns true if executions can be grouped by time buckets of the search attribute
+func IsTimeBucketField(fieldName string) bool {
+	_, ok := timeBucketFields[fieldName]
+	return ok
+}
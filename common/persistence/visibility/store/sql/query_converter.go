@@ -32,6 +32,10 @@ type (
 
 		buildCountStmt(namespaceID namespace.ID, queryString string, groupBy []string) (string, []any)
 
+		// buildTimeBucketExpr returns the expression of the start of the time bucket of the datetime column, time
+		// buckets start at multiples of interval since Unix epoch.
+		buildTimeBucketExpr(dbColName string, interval time.Duration) string
+
 		getDatetimeFormat() string
 
 		getCoalesceCloseTimeExpr() sqlparser.Expr
@@ -52,6 +56,8 @@ type (
 		queryString string
 		// List of search attributes to group by (field name, not db name).
 		groupBy []string
+		// Interval of the time buckets of the 'group by' search attribute, zero if it's grouped by value.
+		groupByInterval time.Duration
 	}
 )
 
@@ -163,6 +169,9 @@ func (c *QueryConverter) BuildCountStmt() (*sqlplugin.VisibilitySelectFilter, er
 	groupByDbNames := make([]string, len(qp.groupBy))
 	for i, fieldName := range qp.groupBy {
 		groupByDbNames[i] = searchattribute.GetSqlDbColName(fieldName)
+		if qp.groupByInterval > 0 {
+			groupByDbNames[i] = c.buildTimeBucketExpr(groupByDbNames[i], qp.groupByInterval)
+		}
 	}
 	queryString, queryArgs := c.buildCountStmt(c.namespaceID, qp.queryString, groupByDbNames)
 	return &sqlplugin.VisibilitySelectFilter{
@@ -197,9 +206,14 @@ func (c *QueryConverter) convertWhereString(queryString string) (*queryParams, e
 		res.queryString = sqlparser.String(selectStmt.Where.Expr)
 	}
 	for _, groupByExpr := range selectStmt.GroupBy {
-		// The parser already ensures the type is saColName.
-		colName := groupByExpr.(*saColName)
-		res.groupBy = append(res.groupBy, colName.fieldName)
+		// The parser already ensures the type is saColName or timeBucketColName.
+		switch colName := groupByExpr.(type) {
+		case *timeBucketColName:
+			res.groupBy = append(res.groupBy, colName.fieldName)
+			res.groupByInterval = colName.interval
+		case *saColName:
+			res.groupBy = append(res.groupBy, colName.fieldName)
+		}
 	}
 	return res, nil
 }
@@ -265,17 +279,35 @@ func (c *QueryConverter) convertSelectStmt(sel *sqlparser.Select) error {
 		)
 	}
 	for k := range sel.GroupBy {
-		colName, err := c.convertColName(&sel.GroupBy[k])
+		colNameExpr, interval, isTimeBucket, err := query.ParseTimeBucket(sel.GroupBy[k])
 		if err != nil {
 			return err
 		}
-		if colName.fieldName != searchattribute.ExecutionStatus {
+		colName, err := c.convertColName(&colNameExpr)
+		if err != nil {
+			return err
+		}
+		if isTimeBucket {
+			if !query.IsTimeBucketField(colName.fieldName) {
+				return query.NewConverterError(
+					"%s: %s function is only supported for %s and %s search attributes",
+					query.NotSupportedErrMessage,
+					query.TimeBucketFuncName,
+					searchattribute.StartTime,
+					searchattribute.CloseTime,
+				)
+			}
+			sel.GroupBy[k] = newTimeBucketColName(colName, interval)
+			continue
+		}
+		if colName.valueType != enumspb.INDEXED_VALUE_TYPE_KEYWORD {
 			return query.NewConverterError(
-				"%s: 'group by' clause is only supported for %s search attribute",
+				"%s: 'group by' clause is only supported for search attributes of %s type",
 				query.NotSupportedErrMessage,
-				searchattribute.ExecutionStatus,
+				enumspb.INDEXED_VALUE_TYPE_KEYWORD.String(),
 			)
 		}
+		sel.GroupBy[k] = colNameExpr
 	}
 
 	return nil
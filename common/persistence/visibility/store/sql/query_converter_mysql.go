@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/temporalio/sqlparser"
 	"go.temporal.io/server/common/namespace"
@@ -285,3 +286,16 @@ func (c *mysqlQueryConverter) buildCountStmt(
 		groupByClause,
 	), queryArgs
 }
+
+// mysqlUnixEpoch is a DATETIME literal, so that the time bucket is a DATETIME too
+const mysqlUnixEpoch = "TIMESTAMP '1970-01-01 00:00:00'"
+
+func (c *mysqlQueryConverter) buildTimeBucketExpr(dbColName string, interval time.Duration) string {
+	seconds := int64(interval / time.Second)
+	return fmt.Sprintf(
+		"TIMESTAMPADD(SECOND, FLOOR(TIMESTAMPDIFF(SECOND, %[1]s, %[2]s) / %[3]d) * %[3]d, %[1]s)",
+		mysqlUnixEpoch,
+		dbColName,
+		seconds,
+	)
+}
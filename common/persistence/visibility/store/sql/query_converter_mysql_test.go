@@ -3,6 +3,7 @@ package sql
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/suite"
 	"github.com/temporalio/sqlparser"
@@ -32,6 +33,13 @@ func (s *mysqlQueryConverterSuite) TestGetCoalesceCloseTimeExpr() {
 	)
 }
 
+func (s *mysqlQueryConverterSuite) TestBuildTimeBucketExpr() {
+	s.Equal(
+		"TIMESTAMPADD(SECOND, FLOOR(TIMESTAMPDIFF(SECOND, TIMESTAMP '1970-01-01 00:00:00', start_time) / 3600) * 3600, TIMESTAMP '1970-01-01 00:00:00')",
+		s.queryConverter.buildTimeBucketExpr("start_time", time.Hour),
+	)
+}
+
 func (s *mysqlQueryConverterSuite) TestConvertKeywordListComparisonExpr() {
 	var tests = []testCase{
 		{
@@ -3,6 +3,7 @@ package sql
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/temporalio/sqlparser"
 	"go.temporal.io/server/common/namespace"
@@ -281,3 +282,12 @@ func (c *pgQueryConverter) buildCountStmt(
 		groupByClause,
 	), queryArgs
 }
+
+func (c *pgQueryConverter) buildTimeBucketExpr(dbColName string, interval time.Duration) string {
+	seconds := int64(interval / time.Second)
+	return fmt.Sprintf(
+		"TO_TIMESTAMP(FLOOR(EXTRACT(EPOCH FROM %[1]s) / %[2]d) * %[2]d) AT TIME ZONE 'UTC'",
+		dbColName,
+		seconds,
+	)
+}
@@ -3,6 +3,7 @@ package sql
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/suite"
 	"github.com/temporalio/sqlparser"
@@ -32,6 +33,13 @@ func (s *postgresqlQueryConverterSuite) TestGetCoalesceCloseTimeExpr() {
 	)
 }
 
+func (s *postgresqlQueryConverterSuite) TestBuildTimeBucketExpr() {
+	s.Equal(
+		"TO_TIMESTAMP(FLOOR(EXTRACT(EPOCH FROM start_time) / 3600) * 3600) AT TIME ZONE 'UTC'",
+		s.queryConverter.buildTimeBucketExpr("start_time", time.Hour),
+	)
+}
+
 func (s *postgresqlQueryConverterSuite) TestConvertKeywordListComparisonExpr() {
 	var tests = []testCase{
 		{
@@ -3,6 +3,8 @@ package sql
 import (
 	"fmt"
 	"strings"
+	"time"
+	"unicode"
 
 	"github.com/temporalio/sqlparser"
 	"go.temporal.io/server/common/namespace"
@@ -22,6 +24,12 @@ const (
 	textTypeFtsTableName        = "executions_visibility_fts_text"
 )
 
+var (
+	// sqlStringUnescaper reverts the escaping of string values done when the values are parsed, Text
+	// values are tokenized from their original form.
+	sqlStringUnescaper = newUnescapeReplacer(escapeCharMap)
+)
+
 func newSqliteQueryConverter(
 	namespaceName namespace.Name,
 	namespaceID namespace.ID,
@@ -165,7 +173,7 @@ func (c *sqliteQueryConverter) convertTextComparisonExpr(
 			sqlparser.String(expr.Right),
 		)
 	}
-	tokens := tokenizeTextQueryString(valueExpr.Val)
+	tokens := tokenizeFtsTextQueryString(valueExpr.Val)
 	if len(tokens) == 0 {
 		return nil, query.NewConverterError(
 			"%s: unexpected value for Text type search attribute (no tokens found in %s)",
@@ -263,6 +271,8 @@ func (c *sqliteQueryConverter) buildSelectStmt(
 // buildFtsSelectStmt builds the following statement for querying FTS:
 //
 //	SELECT rowid FROM tableName WHERE tableName = '%s'
+//
+// For FTS tables, `tableName = '%s'` is the same as `tableName MATCH '%s'`.
 func (c *sqliteQueryConverter) buildFtsSelectStmt(
 	tableName string,
 	queryString string,
@@ -322,7 +332,30 @@ func (c *sqliteQueryConverter) buildCountStmt(
 	), queryArgs
 }
 
+// buildTimeBucketExpr returns the start of the time bucket as an RFC3339 string: SQLite doesn't have a datetime type.
+func (c *sqliteQueryConverter) buildTimeBucketExpr(dbColName string, interval time.Duration) string {
+	seconds := int64(interval / time.Second)
+	return fmt.Sprintf(
+		"STRFTIME('%%Y-%%m-%%dT%%H:%%M:%%SZ', (CAST(STRFTIME('%%s', %[1]s) AS INTEGER) / %[2]d) * %[2]d, 'unixepoch')",
+		dbColName,
+		seconds,
+	)
+}
+
 func buildFtsQueryString(colname string, values ...string) string {
 	// FTS query format: 'colname : ("token1" OR "token2" OR ...)'
 	return fmt.Sprintf(`%s : ("%s")`, colname, strings.Join(values, `" OR "`))
 }
+
+// tokenizeFtsTextQueryString splits the value of a Text search attribute into the tokens that the unicode61
+// tokenizer of the FTS table produces for the indexed values: tokens are the runs of letters and numbers, every
+// other character is a separator. Besides matching the indexed tokens, this is also how the standard analyzer
+// of Elasticsearch splits most values, so `Text = 'foo-bar'` matches the same executions as `foo OR bar`.
+// Since separators are dropped, tokens never contain characters that need to be escaped in FTS queries.
+func tokenizeFtsTextQueryString(s string) []string {
+	return strings.FieldsFunc(sqlStringUnescaper.Replace(s), isFtsTextSeparator)
+}
+
+func isFtsTextSeparator(r rune) bool {
+	return !unicode.IsLetter(r) && !unicode.IsNumber(r) && !unicode.Is(unicode.Co, r)
+}
@@ -3,6 +3,7 @@ package sql
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/suite"
 	"github.com/temporalio/sqlparser"
@@ -32,6 +33,13 @@ func (s *sqliteQueryConverterSuite) TestGetCoalesceCloseTimeExpr() {
 	)
 }
 
+func (s *sqliteQueryConverterSuite) TestBuildTimeBucketExpr() {
+	s.Equal(
+		"STRFTIME('%Y-%m-%dT%H:%M:%SZ', (CAST(STRFTIME('%s', start_time) AS INTEGER) / 3600) * 3600, 'unixepoch')",
+		s.queryConverter.buildTimeBucketExpr("start_time", time.Hour),
+	)
+}
+
 func (s *sqliteQueryConverterSuite) TestConvertKeywordListComparisonExpr() {
 	var tests = []testCase{
 		{
@@ -114,6 +122,34 @@ func (s *sqliteQueryConverterSuite) TestConvertTextComparisonExpr() {
 			output: `rowid not in (select rowid from executions_visibility_fts_text where executions_visibility_fts_text = 'Text01 : ("foo" OR "bar")')`,
 			err:    nil,
 		},
+		{
+			name:   "punctuation separates tokens",
+			input:  "AliasForText01 = 'foo-bar, baz.qux'",
+			output: `rowid in (select rowid from executions_visibility_fts_text where executions_visibility_fts_text = 'Text01 : ("foo" OR "bar" OR "baz" OR "qux")')`,
+			err:    nil,
+		},
+		{
+			name:   "quotes are not part of tokens",
+			input:  `AliasForText01 = 'it''s "quoted"'`,
+			output: `rowid in (select rowid from executions_visibility_fts_text where executions_visibility_fts_text = 'Text01 : ("it" OR "s" OR "quoted")')`,
+			err:    nil,
+		},
+		{
+			name:   "unicode tokens",
+			input:  "AliasForText01 = 'café 東京'",
+			output: `rowid in (select rowid from executions_visibility_fts_text where executions_visibility_fts_text = 'Text01 : ("café" OR "東京")')`,
+			err:    nil,
+		},
+		{
+			name:   "no tokens",
+			input:  "AliasForText01 = '-- !'",
+			output: "",
+			err: query.NewConverterError(
+				"%s: unexpected value for Text type search attribute (no tokens found in %s)",
+				query.InvalidExpressionErrMessage,
+				"'-- !'",
+			),
+		},
 	}
 
 	for _, tc := range tests {
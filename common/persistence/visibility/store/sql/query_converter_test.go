@@ -113,13 +113,74 @@ func (s *queryConverterSuite) TestConvertWhereString() {
 			),
 		},
 		{
-			name:   "group by non ExecutionStatus",
-			input:  "GROUP BY WorkflowType",
+			name:  "group by keyword field",
+			input: "GROUP BY WorkflowType",
+			output: &queryParams{
+				queryString: "TemporalNamespaceDivision is null",
+				groupBy:     []string{searchattribute.WorkflowType},
+			},
+			err: nil,
+		},
+		{
+			name:  "group by custom keyword field",
+			input: "AliasForInt01 = 1 GROUP BY AliasForKeyword01",
+			output: &queryParams{
+				queryString: "(Int01 = 1) and TemporalNamespaceDivision is null",
+				groupBy:     []string{"Keyword01"},
+			},
+			err: nil,
+		},
+		{
+			name:   "group by non keyword field",
+			input:  "GROUP BY AliasForInt01",
 			output: nil,
 			err: query.NewConverterError(
-				"%s: 'group by' clause is only supported for %s search attribute",
+				"%s: 'group by' clause is only supported for search attributes of %s type",
 				query.NotSupportedErrMessage,
-				searchattribute.ExecutionStatus,
+				enumspb.INDEXED_VALUE_TYPE_KEYWORD.String(),
+			),
+		},
+		{
+			name:  "group by time bucket",
+			input: "GROUP BY time_bucket(StartTime, '1h')",
+			output: &queryParams{
+				queryString:     "TemporalNamespaceDivision is null",
+				groupBy:         []string{searchattribute.StartTime},
+				groupByInterval: time.Hour,
+			},
+			err: nil,
+		},
+		{
+			name:  "group by close time bucket",
+			input: "GROUP BY time_bucket(CloseTime, '1d')",
+			output: &queryParams{
+				queryString:     "TemporalNamespaceDivision is null",
+				groupBy:         []string{searchattribute.CloseTime},
+				groupByInterval: 24 * time.Hour,
+			},
+			err: nil,
+		},
+		{
+			name:   "group by time bucket of unsupported field",
+			input:  "GROUP BY time_bucket(WorkflowType, '1h')",
+			output: nil,
+			err: query.NewConverterError(
+				"%s: %s function is only supported for %s and %s search attributes",
+				query.NotSupportedErrMessage,
+				query.TimeBucketFuncName,
+				searchattribute.StartTime,
+				searchattribute.CloseTime,
+			),
+		},
+		{
+			name:   "group by time bucket with invalid interval",
+			input:  "GROUP BY time_bucket(StartTime, '1ms')",
+			output: nil,
+			err: query.NewConverterError(
+				"%s: invalid %s interval '%s', it must be a whole number of seconds",
+				query.InvalidExpressionErrMessage,
+				query.TimeBucketFuncName,
+				"1ms",
 			),
 		},
 		{
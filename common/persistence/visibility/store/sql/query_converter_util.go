@@ -30,6 +30,12 @@ type (
 		fieldName string
 		valueType enumspb.IndexedValueType
 	}
+
+	// timeBucketColName is a 'group by' column grouped by time buckets of interval
+	timeBucketColName struct {
+		*saColName
+		interval time.Duration
+	}
 )
 
 const (
@@ -39,6 +45,7 @@ const (
 var _ sqlparser.Expr = (*unsafeSQLString)(nil)
 var _ sqlparser.Expr = (*colName)(nil)
 var _ sqlparser.Expr = (*saColName)(nil)
+var _ sqlparser.Expr = (*timeBucketColName)(nil)
 
 var (
 	maxDatetimeValue = getMaxDatetimeValue()
@@ -85,6 +92,13 @@ func newSAColName(
 	}
 }
 
+func newTimeBucketColName(colName *saColName, interval time.Duration) *timeBucketColName {
+	return &timeBucketColName{
+		saColName: colName,
+		interval:  interval,
+	}
+}
+
 func newFuncExpr(name string, exprs ...sqlparser.Expr) *sqlparser.FuncExpr {
 	args := make([]sqlparser.SelectExpr, len(exprs))
 	for i := range exprs {
@@ -119,8 +133,17 @@ func formatComparisonExprStringForError(expr sqlparser.ComparisonExpr) string {
 	return sqlparser.String(&expr)
 }
 
+// newUnescapeReplacer returns a replacer that reverts the replacements of escapeCharMap.
+func newUnescapeReplacer(escapeCharMap []string) *strings.Replacer {
+	oldnew := make([]string, len(escapeCharMap))
+	for i := 0; i+1 < len(escapeCharMap); i += 2 {
+		oldnew[i], oldnew[i+1] = escapeCharMap[i+1], escapeCharMap[i]
+	}
+	return strings.NewReplacer(oldnew...)
+}
+
 // Simple tokenizer by spaces. It's a temporary solution as it doesn't cover tokenizer used by
-// PostgreSQL or SQLite.
+// PostgreSQL. SQLite uses tokenizeFtsTextQueryString instead.
 func tokenizeTextQueryString(s string) []string {
 	tokens := strings.Split(s, " ")
 	nonEmptyTokens := make([]string, 0, len(tokens))
@@ -157,6 +157,125 @@ func (s *VisibilityStore) DeleteWorkflowExecution(
 	return nil
 }
 
+func (s *VisibilityStore) CopySearchAttributeValues(
+	ctx context.Context,
+	request *manager.CopySearchAttributeValuesRequest,
+) (*manager.SearchAttributeValuesResponse, error) {
+	if err := validateCustomSearchAttributeColumn(request.SourceField, request.SourceType); err != nil {
+		return nil, err
+	}
+	if err := validateCustomSearchAttributeColumn(request.TargetField, request.TargetType); err != nil {
+		return nil, err
+	}
+	if !searchattribute.IsTypeConversionSupported(request.SourceType, request.TargetType) {
+		return nil, serviceerror.NewInvalidArgument(fmt.Sprintf(
+			"Unable to convert search attribute values from %s to %s", request.SourceType, request.TargetType))
+	}
+
+	return s.updateSearchAttributeValues(
+		ctx,
+		request.NamespaceID,
+		request.SourceField,
+		request.TargetField,
+		request.PageSize,
+		request.NextPageToken,
+		func(searchAttributes sqlplugin.VisibilitySearchAttributes) error {
+			value, err := searchattribute.ConvertValue(
+				searchAttributes[request.SourceField],
+				request.SourceType,
+				request.TargetType,
+			)
+			if err != nil {
+				return err
+			}
+			searchAttributes[request.TargetField] = value
+			return nil
+		},
+	)
+}
+
+func (s *VisibilityStore) ClearSearchAttributeValues(
+	ctx context.Context,
+	request *manager.ClearSearchAttributeValuesRequest,
+) (*manager.SearchAttributeValuesResponse, error) {
+	if err := validateCustomSearchAttributeColumn(request.Field, enumspb.INDEXED_VALUE_TYPE_UNSPECIFIED); err != nil {
+		return nil, err
+	}
+
+	return s.updateSearchAttributeValues(
+		ctx,
+		request.NamespaceID,
+		request.Field,
+		"",
+		request.PageSize,
+		request.NextPageToken,
+		func(searchAttributes sqlplugin.VisibilitySearchAttributes) error {
+			delete(searchAttributes, request.Field)
+			return nil
+		},
+	)
+}
+
+// updateSearchAttributeValues applies update to the search attributes of a page of executions which have a value
+// in field and no value in missingField. Executions updated concurrently by a visibility task are skipped, they
+// are selected again by the next call if they still match. Executions which can't be updated are counted as
+// failed and skipped.
+func (s *VisibilityStore) updateSearchAttributeValues(
+	ctx context.Context,
+	namespaceID namespace.ID,
+	field string,
+	missingField string,
+	pageSize int,
+	nextPageToken []byte,
+	update func(searchAttributes sqlplugin.VisibilitySearchAttributes) error,
+) (*manager.SearchAttributeValuesResponse, error) {
+	token, err := deserializePageToken(nextPageToken)
+	if err != nil {
+		return nil, serviceerror.NewInvalidArgument(fmt.Sprintf("Invalid page token: %v", err))
+	}
+	filter := sqlplugin.VisibilitySearchAttributesFilter{
+		NamespaceID:  namespaceID.String(),
+		Field:        field,
+		MissingField: missingField,
+		PageSize:     pageSize,
+	}
+	if token != nil {
+		filter.MinRunID = token.RunID
+	}
+	rows, err := s.sqlStore.Db.SelectSearchAttributesFromVisibility(ctx, filter)
+	if err != nil {
+		return nil, serviceerror.NewUnavailable(
+			fmt.Sprintf("Unable to select search attributes: %v", err))
+	}
+
+	response := &manager.SearchAttributeValuesResponse{}
+	for _, row := range rows {
+		if row.SearchAttributes == nil {
+			continue
+		}
+		if err := update(*row.SearchAttributes); err != nil {
+			response.FailedCount++
+			continue
+		}
+		result, err := s.sqlStore.Db.UpdateSearchAttributesInVisibility(ctx, &row)
+		if err != nil {
+			return nil, serviceerror.NewUnavailable(
+				fmt.Sprintf("Unable to update search attributes: %v", err))
+		}
+		if rowsAffected, err := result.RowsAffected(); err == nil && rowsAffected > 0 {
+			response.UpdatedCount++
+		}
+	}
+
+	if len(rows) == pageSize {
+		response.NextPageToken, err = serializePageToken(&pageToken{RunID: rows[len(rows)-1].RunID})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return response, nil
+}
+
 func (s *VisibilityStore) ListWorkflowExecutions(
 	ctx context.Context,
 	request *manager.ListWorkflowExecutionsRequestV2,
@@ -303,9 +422,19 @@ func (s *VisibilityStore) countGroupByWorkflowExecutions(
 		Count:  0,
 		Groups: make([]*workflowservice.CountWorkflowExecutionsResponse_AggregationGroup, 0, len(rows)),
 	}
+RowsLoop:
 	for _, row := range rows {
 		groupValues := make([]*commonpb.Payload, len(row.GroupValues))
 		for i, val := range row.GroupValues {
+			if val == nil {
+				// Executions without a value in a 'group by' search attribute are not counted, like in
+				// Elasticsearch.
+				continue RowsLoop
+			}
+			val, err = parseGroupValue(val, groupByTypes[i])
+			if err != nil {
+				return nil, err
+			}
 			groupValues[i], err = searchattribute.EncodeValue(val, groupByTypes[i])
 			if err != nil {
 				return nil, err
@@ -323,6 +452,22 @@ func (s *VisibilityStore) countGroupByWorkflowExecutions(
 	return resp, nil
 }
 
+// parseGroupValue converts a group value read from the database to the Go type of the search attribute type: MySQL
+// returns strings as bytes, and SQLite returns time buckets as RFC3339 strings.
+func parseGroupValue(val any, tp enumspb.IndexedValueType) (any, error) {
+	switch typedVal := val.(type) {
+	case []byte:
+		return string(typedVal), nil
+	case time.Time:
+		return typedVal.UTC(), nil
+	case string:
+		if tp == enumspb.INDEXED_VALUE_TYPE_DATETIME {
+			return time.Parse(time.RFC3339Nano, typedVal)
+		}
+	}
+	return val, nil
+}
+
 func (s *VisibilityStore) GetWorkflowExecution(
 	ctx context.Context,
 	request *manager.GetWorkflowExecutionRequest,
@@ -344,6 +489,70 @@ func (s *VisibilityStore) GetWorkflowExecution(
 	}, nil
 }
 
+// validateCustomSearchAttributeColumn checks that field is one of the pre-allocated custom search attribute
+// columns, and that its type is tp unless tp is unspecified. Field is interpolated in SQL queries.
+// ExplainQuery returns the SQL statement of a list request with its arguments, and optionally the execution plan
+// of the database.
+func (s *VisibilityStore) ExplainQuery(
+	ctx context.Context,
+	request *manager.ExplainQueryRequest,
+) (*manager.ExplainQueryResponse, error) {
+	saTypeMap, err := s.searchAttributesProvider.GetSearchAttributes(s.GetIndexName(), false)
+	if err != nil {
+		return nil, err
+	}
+
+	saMapper, err := s.searchAttributesMapperProvider.GetMapper(request.Namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	converter := NewQueryConverter(
+		s.GetName(),
+		request.Namespace,
+		request.NamespaceID,
+		saTypeMap,
+		saMapper,
+		request.Query,
+	)
+	selectFilter, err := converter.BuildSelectStmt(request.PageSize, nil)
+	if err != nil {
+		// Convert ConverterError to InvalidArgument and pass through all other errors (which should be only mapper errors).
+		var converterErr *query.ConverterError
+		if errors.As(err, &converterErr) {
+			return nil, converterErr.ToInvalidArgument()
+		}
+		return nil, err
+	}
+
+	resp := &manager.ExplainQueryResponse{
+		StoreName: s.GetName(),
+		Query:     selectFilter.Query,
+		QueryArgs: selectFilter.QueryArgs,
+	}
+	if request.IncludePlan {
+		resp.Plan, err = s.sqlStore.Db.ExplainVisibilityQuery(ctx, *selectFilter)
+		if err != nil {
+			return nil, serviceerror.NewUnavailable(
+				fmt.Sprintf("ExplainQuery operation failed. Query failed: %v", err))
+		}
+	}
+	return resp, nil
+}
+
+func validateCustomSearchAttributeColumn(field string, tp enumspb.IndexedValueType) error {
+	fieldType, ok := searchattribute.GetSqlDbIndexSearchAttributes().GetCustomSearchAttributes()[field]
+	if !ok {
+		return serviceerror.NewInvalidArgument(
+			fmt.Sprintf("%s is not a custom search attribute column", field))
+	}
+	if tp != enumspb.INDEXED_VALUE_TYPE_UNSPECIFIED && fieldType != tp {
+		return serviceerror.NewInvalidArgument(
+			fmt.Sprintf("%s is of type %s, not %s", field, fieldType, tp))
+	}
+	return nil
+}
+
 func (s *VisibilityStore) generateVisibilityRow(
 	request *store.InternalVisibilityRequestBase,
 ) (*sqlplugin.VisibilityRow, error) {
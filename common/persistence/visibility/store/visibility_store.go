@@ -31,11 +31,19 @@ type (
 		UpsertWorkflowExecution(ctx context.Context, request *InternalUpsertWorkflowExecutionRequest) error
 		DeleteWorkflowExecution(ctx context.Context, request *manager.VisibilityDeleteWorkflowExecutionRequest) error
 
+		// CopySearchAttributeValues and ClearSearchAttributeValues are used to migrate the values of a custom search
+		// attribute to a field of another type. They update at most PageSize executions per call.
+		CopySearchAttributeValues(ctx context.Context, request *manager.CopySearchAttributeValuesRequest) (*manager.SearchAttributeValuesResponse, error)
+		ClearSearchAttributeValues(ctx context.Context, request *manager.ClearSearchAttributeValuesRequest) (*manager.SearchAttributeValuesResponse, error)
+
 		// Read APIs.
 		ListWorkflowExecutions(ctx context.Context, request *manager.ListWorkflowExecutionsRequestV2) (*InternalListWorkflowExecutionsResponse, error)
 		ScanWorkflowExecutions(ctx context.Context, request *manager.ListWorkflowExecutionsRequestV2) (*InternalListWorkflowExecutionsResponse, error)
 		CountWorkflowExecutions(ctx context.Context, request *manager.CountWorkflowExecutionsRequest) (*manager.CountWorkflowExecutionsResponse, error)
 		GetWorkflowExecution(ctx context.Context, request *manager.GetWorkflowExecutionRequest) (*InternalGetWorkflowExecutionResponse, error)
+
+		// ExplainQuery translates the query of a list request into the query of the store without running it.
+		ExplainQuery(ctx context.Context, request *manager.ExplainQueryRequest) (*manager.ExplainQueryResponse, error)
 	}
 
 	// InternalWorkflowExecutionInfo is visibility info for internal response
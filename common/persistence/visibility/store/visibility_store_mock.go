@@ -41,6 +41,21 @@ func (m *MockVisibilityStore) EXPECT() *MockVisibilityStoreMockRecorder {
 	return m.recorder
 }
 
+// ClearSearchAttributeValues mocks base method.
+func (m *MockVisibilityStore) ClearSearchAttributeValues(ctx context.Context, request *manager.ClearSearchAttributeValuesRequest) (*manager.SearchAttributeValuesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClearSearchAttributeValues", ctx, request)
+	ret0, _ := ret[0].(*manager.SearchAttributeValuesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClearSearchAttributeValues indicates an expected call of ClearSearchAttributeValues.
+func (mr *MockVisibilityStoreMockRecorder) ClearSearchAttributeValues(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClearSearchAttributeValues", reflect.TypeOf((*MockVisibilityStore)(nil).ClearSearchAttributeValues), ctx, request)
+}
+
 // Close mocks base method.
 func (m *MockVisibilityStore) Close() {
 	m.ctrl.T.Helper()
@@ -53,6 +68,21 @@ func (mr *MockVisibilityStoreMockRecorder) Close() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockVisibilityStore)(nil).Close))
 }
 
+// CopySearchAttributeValues mocks base method.
+func (m *MockVisibilityStore) CopySearchAttributeValues(ctx context.Context, request *manager.CopySearchAttributeValuesRequest) (*manager.SearchAttributeValuesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CopySearchAttributeValues", ctx, request)
+	ret0, _ := ret[0].(*manager.SearchAttributeValuesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CopySearchAttributeValues indicates an expected call of CopySearchAttributeValues.
+func (mr *MockVisibilityStoreMockRecorder) CopySearchAttributeValues(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CopySearchAttributeValues", reflect.TypeOf((*MockVisibilityStore)(nil).CopySearchAttributeValues), ctx, request)
+}
+
 // CountWorkflowExecutions mocks base method.
 func (m *MockVisibilityStore) CountWorkflowExecutions(ctx context.Context, request *manager.CountWorkflowExecutionsRequest) (*manager.CountWorkflowExecutionsResponse, error) {
 	m.ctrl.T.Helper()
@@ -82,6 +112,21 @@ func (mr *MockVisibilityStoreMockRecorder) DeleteWorkflowExecution(ctx, request
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWorkflowExecution", reflect.TypeOf((*MockVisibilityStore)(nil).DeleteWorkflowExecution), ctx, request)
 }
 
+// ExplainQuery mocks base method.
+func (m *MockVisibilityStore) ExplainQuery(ctx context.Context, request *manager.ExplainQueryRequest) (*manager.ExplainQueryResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExplainQuery", ctx, request)
+	ret0, _ := ret[0].(*manager.ExplainQueryResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExplainQuery indicates an expected call of ExplainQuery.
+func (mr *MockVisibilityStoreMockRecorder) ExplainQuery(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExplainQuery", reflect.TypeOf((*MockVisibilityStore)(nil).ExplainQuery), ctx, request)
+}
+
 // GetIndexName mocks base method.
 func (m *MockVisibilityStore) GetIndexName() string {
 	m.ctrl.T.Helper()
@@ -2,8 +2,18 @@ package visibility
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"strings"
+	"time"
 
+	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/api/serviceerror"
 	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/persistence/visibility/manager"
 )
@@ -14,9 +24,27 @@ type (
 		secondaryVisibilityManager manager.VisibilityManager
 		managerSelector            managerSelector
 		enableShadowReadMode       dynamicconfig.BoolPropertyFn
+		shadowReadVerificationRate dynamicconfig.FloatPropertyFnWithNamespaceFilter
+		metricsHandler             metrics.Handler
+		logger                     log.Logger
+	}
+
+	// dualSearchAttributeValuesPageToken is the page token of CopySearchAttributeValues and
+	// ClearSearchAttributeValues, Token is the page token of the store.
+	dualSearchAttributeValuesPageToken struct {
+		Secondary bool
+		Token     []byte
 	}
 )
 
+const (
+	// shadowReadVerificationTimeout bounds the shadow read of a verified request, which doesn't use the deadline
+	// of the request since it runs after the response was returned.
+	shadowReadVerificationTimeout = 30 * time.Second
+	// maxLoggedShadowReadDifferences is the number of different executions logged for a list mismatch
+	maxLoggedShadowReadDifferences = 10
+)
+
 var _ manager.VisibilityManager = (*VisibilityManagerDual)(nil)
 
 // NewVisibilityManagerDual create a visibility manager that operate on multiple manager
@@ -26,12 +54,18 @@ func NewVisibilityManagerDual(
 	secondaryVisibilityManager manager.VisibilityManager,
 	managerSelector managerSelector,
 	enableShadowReadMode dynamicconfig.BoolPropertyFn,
+	shadowReadVerificationRate dynamicconfig.FloatPropertyFnWithNamespaceFilter,
+	metricsHandler metrics.Handler,
+	logger log.Logger,
 ) *VisibilityManagerDual {
 	return &VisibilityManagerDual{
 		visibilityManager:          visibilityManager,
 		secondaryVisibilityManager: secondaryVisibilityManager,
 		managerSelector:            managerSelector,
 		enableShadowReadMode:       enableShadowReadMode,
+		shadowReadVerificationRate: shadowReadVerificationRate,
+		metricsHandler:             metricsHandler,
+		logger:                     logger,
 	}
 }
 
@@ -153,6 +187,74 @@ func (v *VisibilityManagerDual) DeleteWorkflowExecution(
 	return nil
 }
 
+// CopySearchAttributeValues copies the values of the primary store, then the values of the secondary store. The
+// search attribute fields are the same in both stores since they are mapped by the namespace.
+func (v *VisibilityManagerDual) CopySearchAttributeValues(
+	ctx context.Context,
+	request *manager.CopySearchAttributeValuesRequest,
+) (*manager.SearchAttributeValuesResponse, error) {
+	return v.processSearchAttributeValues(
+		request.NextPageToken,
+		func(m manager.VisibilityManager, nextPageToken []byte) (*manager.SearchAttributeValuesResponse, error) {
+			storeRequest := *request
+			storeRequest.NextPageToken = nextPageToken
+			return m.CopySearchAttributeValues(ctx, &storeRequest)
+		},
+	)
+}
+
+// ClearSearchAttributeValues clears the values of the primary store, then the values of the secondary store.
+func (v *VisibilityManagerDual) ClearSearchAttributeValues(
+	ctx context.Context,
+	request *manager.ClearSearchAttributeValuesRequest,
+) (*manager.SearchAttributeValuesResponse, error) {
+	return v.processSearchAttributeValues(
+		request.NextPageToken,
+		func(m manager.VisibilityManager, nextPageToken []byte) (*manager.SearchAttributeValuesResponse, error) {
+			storeRequest := *request
+			storeRequest.NextPageToken = nextPageToken
+			return m.ClearSearchAttributeValues(ctx, &storeRequest)
+		},
+	)
+}
+
+// processSearchAttributeValues processes one page of the store of the page token and wraps the page token of the store,
+// the page tokens of the two stores are not compatible.
+func (v *VisibilityManagerDual) processSearchAttributeValues(
+	nextPageToken []byte,
+	processPage func(m manager.VisibilityManager, nextPageToken []byte) (*manager.SearchAttributeValuesResponse, error),
+) (*manager.SearchAttributeValuesResponse, error) {
+	var token dualSearchAttributeValuesPageToken
+	if len(nextPageToken) > 0 {
+		if err := json.Unmarshal(nextPageToken, &token); err != nil {
+			return nil, serviceerror.NewInvalidArgument("invalid next page token")
+		}
+	}
+	m := v.visibilityManager
+	if token.Secondary {
+		m = v.secondaryVisibilityManager
+	}
+	resp, err := processPage(m, token.Token)
+	if err != nil {
+		return nil, err
+	}
+
+	result := *resp
+	switch {
+	case len(resp.NextPageToken) > 0:
+		token.Token = resp.NextPageToken
+	case !token.Secondary:
+		token = dualSearchAttributeValuesPageToken{Secondary: true}
+	default:
+		return &result, nil
+	}
+	result.NextPageToken, err = json.Marshal(token)
+	if err != nil {
+		return nil, serviceerror.NewInternal(fmt.Sprintf("unable to serialize page token: %v", err))
+	}
+	return &result, nil
+}
+
 func (v *VisibilityManagerDual) ListWorkflowExecutions(
 	ctx context.Context,
 	request *manager.ListWorkflowExecutionsRequestV2,
@@ -162,6 +264,15 @@ func (v *VisibilityManagerDual) ListWorkflowExecutions(
 		if err != nil {
 			return nil, err
 		}
+		// page tokens are specific to a store, only the first page of both stores can be compared
+		if len(request.NextPageToken) == 0 && v.shouldVerifyShadowRead(request.Namespace) {
+			res, err := ms[0].ListWorkflowExecutions(ctx, request)
+			if err != nil {
+				return nil, err
+			}
+			go v.verifyListWorkflowExecutions(ctx, ms[1], request, res)
+			return res, nil
+		}
 		//nolint:errcheck // ignore error since it's shadow request
 		go ms[1].ListWorkflowExecutions(ctx, request)
 		res, err := ms[0].ListWorkflowExecutions(ctx, request)
@@ -202,6 +313,14 @@ func (v *VisibilityManagerDual) CountWorkflowExecutions(
 		if err != nil {
 			return nil, err
 		}
+		if v.shouldVerifyShadowRead(request.Namespace) {
+			res, err := ms[0].CountWorkflowExecutions(ctx, request)
+			if err != nil {
+				return nil, err
+			}
+			go v.verifyCountWorkflowExecutions(ctx, ms[1], request, res)
+			return res, nil
+		}
 		//nolint:errcheck // ignore error since it's shadow request
 		go ms[1].CountWorkflowExecutions(ctx, request)
 		res, err := ms[0].CountWorkflowExecutions(ctx, request)
@@ -232,3 +351,146 @@ func (v *VisibilityManagerDual) GetWorkflowExecution(
 	}
 	return v.managerSelector.readManager(request.Namespace).GetWorkflowExecution(ctx, request)
 }
+
+// ExplainQuery explains the query of the store serving the reads of the namespace, shadow reads are not explained.
+func (v *VisibilityManagerDual) ExplainQuery(
+	ctx context.Context,
+	request *manager.ExplainQueryRequest,
+) (*manager.ExplainQueryResponse, error) {
+	return v.managerSelector.readManager(request.Namespace).ExplainQuery(ctx, request)
+}
+
+func (v *VisibilityManagerDual) shouldVerifyShadowRead(nsName namespace.Name) bool {
+	return rand.Float64() < v.shadowReadVerificationRate(nsName.String())
+}
+
+// verifyListWorkflowExecutions runs a list request against the shadow store and compares the executions of its first
+// page with the executions returned by the read store.
+func (v *VisibilityManagerDual) verifyListWorkflowExecutions(
+	ctx context.Context,
+	shadow manager.VisibilityManager,
+	request *manager.ListWorkflowExecutionsRequestV2,
+	res *manager.ListWorkflowExecutionsResponse,
+) {
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), shadowReadVerificationTimeout)
+	defer cancel()
+
+	handler := v.shadowReadMetricsHandler(metrics.VisibilityPersistenceListWorkflowExecutionsScope, request.Namespace)
+	shadowRes, err := shadow.ListWorkflowExecutions(ctx, request)
+	if err != nil {
+		v.shadowReadFailed(handler, request.Namespace, request.Query, err)
+		return
+	}
+	metrics.VisibilityShadowReadComparisons.With(handler).Record(1)
+
+	executions := make(map[string]struct{}, len(res.Executions))
+	for _, execution := range res.Executions {
+		executions[executionKey(execution.GetExecution().GetWorkflowId(), execution.GetExecution().GetRunId())] = struct{}{}
+	}
+	var unexpected []string
+	for _, execution := range shadowRes.Executions {
+		key := executionKey(execution.GetExecution().GetWorkflowId(), execution.GetExecution().GetRunId())
+		if _, ok := executions[key]; ok {
+			delete(executions, key)
+			continue
+		}
+		unexpected = append(unexpected, key)
+	}
+	if len(executions) == 0 && len(unexpected) == 0 {
+		return
+	}
+
+	missing := make([]string, 0, len(executions))
+	for key := range executions {
+		missing = append(missing, key)
+	}
+	metrics.VisibilityShadowReadMismatches.With(handler).Record(1)
+	v.logger.Warn("Shadow visibility read returned different executions",
+		tag.WorkflowNamespace(request.Namespace.String()),
+		tag.NewStringTag("query", request.Query),
+		tag.NewInt("executions", len(res.Executions)),
+		tag.NewInt("shadow-executions", len(shadowRes.Executions)),
+		tag.NewStringsTag("missing-in-shadow", truncateDifferences(missing)),
+		tag.NewStringsTag("unexpected-in-shadow", truncateDifferences(unexpected)),
+	)
+}
+
+// verifyCountWorkflowExecutions runs a count request against the shadow store and compares the count and the counts of
+// its groups with the result of the read store.
+func (v *VisibilityManagerDual) verifyCountWorkflowExecutions(
+	ctx context.Context,
+	shadow manager.VisibilityManager,
+	request *manager.CountWorkflowExecutionsRequest,
+	res *manager.CountWorkflowExecutionsResponse,
+) {
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), shadowReadVerificationTimeout)
+	defer cancel()
+
+	handler := v.shadowReadMetricsHandler(metrics.VisibilityPersistenceCountWorkflowExecutionsScope, request.Namespace)
+	shadowRes, err := shadow.CountWorkflowExecutions(ctx, request)
+	if err != nil {
+		v.shadowReadFailed(handler, request.Namespace, request.Query, err)
+		return
+	}
+	metrics.VisibilityShadowReadComparisons.With(handler).Record(1)
+
+	groupsMatch := len(res.Groups) == len(shadowRes.Groups)
+	if groupsMatch {
+		groups := make(map[string]int64, len(res.Groups))
+		for _, group := range res.Groups {
+			groups[groupKey(group.GetGroupValues())] = group.GetCount()
+		}
+		for _, group := range shadowRes.Groups {
+			if count, ok := groups[groupKey(group.GetGroupValues())]; !ok || count != group.GetCount() {
+				groupsMatch = false
+				break
+			}
+		}
+	}
+	if res.Count == shadowRes.Count && groupsMatch {
+		return
+	}
+
+	metrics.VisibilityShadowReadMismatches.With(handler).Record(1)
+	v.logger.Warn("Shadow visibility read returned a different count",
+		tag.WorkflowNamespace(request.Namespace.String()),
+		tag.NewStringTag("query", request.Query),
+		tag.NewInt64("count", res.Count),
+		tag.NewInt64("shadow-count", shadowRes.Count),
+		tag.NewInt("groups", len(res.Groups)),
+		tag.NewInt("shadow-groups", len(shadowRes.Groups)),
+		tag.NewBoolTag("groups-match", groupsMatch),
+	)
+}
+
+func (v *VisibilityManagerDual) shadowReadMetricsHandler(operation string, nsName namespace.Name) metrics.Handler {
+	return v.metricsHandler.WithTags(metrics.OperationTag(operation), metrics.NamespaceTag(nsName.String()))
+}
+
+func (v *VisibilityManagerDual) shadowReadFailed(handler metrics.Handler, nsName namespace.Name, query string, err error) {
+	metrics.VisibilityShadowReadErrors.With(handler).Record(1)
+	v.logger.Warn("Shadow visibility read failed",
+		tag.WorkflowNamespace(nsName.String()),
+		tag.NewStringTag("query", query),
+		tag.Error(err),
+	)
+}
+
+func executionKey(workflowID string, runID string) string {
+	return workflowID + "/" + runID
+}
+
+func groupKey(values []*commonpb.Payload) string {
+	parts := make([]string, len(values))
+	for i, value := range values {
+		parts[i] = string(value.GetData())
+	}
+	return strings.Join(parts, "\x00")
+}
+
+func truncateDifferences(keys []string) []string {
+	if len(keys) > maxLoggedShadowReadDifferences {
+		return keys[:maxLoggedShadowReadDifferences]
+	}
+	return keys
+}
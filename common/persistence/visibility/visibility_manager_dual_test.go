@@ -0,0 +1,237 @@
+package visibility
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	commonpb "go.temporal.io/api/common/v1"
+	workflowpb "go.temporal.io/api/workflow/v1"
+	"go.temporal.io/api/workflowservice/v1"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/metrics/metricstest"
+	"go.temporal.io/server/common/persistence/visibility/manager"
+	"go.uber.org/mock/gomock"
+)
+
+type VisibilityManagerDualSuite struct {
+	*require.Assertions // override suite.Suite.Assertions with require.Assertions; this means that s.NotNil(nil) will stop the test, not merely log an error
+	suite.Suite
+	controller *gomock.Controller
+
+	readManager     *manager.MockVisibilityManager
+	shadowManager   *manager.MockVisibilityManager
+	managerSelector *MockmanagerSelector
+	metricsHandler  *metricstest.CaptureHandler
+	capture         *metricstest.Capture
+}
+
+func TestVisibilityManagerDualSuite(t *testing.T) {
+	suite.Run(t, new(VisibilityManagerDualSuite))
+}
+
+func (s *VisibilityManagerDualSuite) SetupTest() {
+	s.Assertions = require.New(s.T()) // Have to define our overridden assertions in the test setup. If we did it earlier, s.T() will return nil
+
+	s.controller = gomock.NewController(s.T())
+	s.readManager = manager.NewMockVisibilityManager(s.controller)
+	s.shadowManager = manager.NewMockVisibilityManager(s.controller)
+	s.managerSelector = NewMockmanagerSelector(s.controller)
+	s.managerSelector.EXPECT().readManagers(testNamespace).
+		Return([]manager.VisibilityManager{s.readManager, s.shadowManager}, nil).AnyTimes()
+	s.metricsHandler = metricstest.NewCaptureHandler()
+	s.capture = s.metricsHandler.StartCapture()
+}
+
+func (s *VisibilityManagerDualSuite) TearDownTest() {
+	s.metricsHandler.StopCapture(s.capture)
+	s.controller.Finish()
+}
+
+func (s *VisibilityManagerDualSuite) newManager(verificationRate float64) *VisibilityManagerDual {
+	return NewVisibilityManagerDual(
+		s.readManager,
+		s.shadowManager,
+		s.managerSelector,
+		dynamicconfig.GetBoolPropertyFn(true),
+		dynamicconfig.GetFloatPropertyFnFilteredByNamespace(verificationRate),
+		s.metricsHandler,
+		log.NewNoopLogger(),
+	)
+}
+
+// waitForCounter waits until the shadow read verification recorded the counter for the operation
+func (s *VisibilityManagerDualSuite) waitForCounter(metricName string, operation string) {
+	s.Eventually(func() bool {
+		for _, recording := range s.capture.Snapshot()[metricName] {
+			if recording.Tags[metrics.OperationTagName] == operation && recording.Tags["namespace"] == testNamespace.String() {
+				return true
+			}
+		}
+		return false
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+func (s *VisibilityManagerDualSuite) countRecordings(metricName string) int {
+	return len(s.capture.Snapshot()[metricName])
+}
+
+func newExecutionInfo(workflowID string, runID string) *workflowpb.WorkflowExecutionInfo {
+	return &workflowpb.WorkflowExecutionInfo{
+		Execution: &commonpb.WorkflowExecution{WorkflowId: workflowID, RunId: runID},
+	}
+}
+
+func (s *VisibilityManagerDualSuite) TestListWorkflowExecutions_Match() {
+	request := &manager.ListWorkflowExecutionsRequestV2{Namespace: testNamespace, Query: "WorkflowType = 'wf'"}
+	res := &manager.ListWorkflowExecutionsResponse{
+		Executions: []*workflowpb.WorkflowExecutionInfo{newExecutionInfo("wf1", "run1"), newExecutionInfo("wf2", "run1")},
+	}
+	s.readManager.EXPECT().ListWorkflowExecutions(gomock.Any(), request).Return(res, nil)
+	s.shadowManager.EXPECT().ListWorkflowExecutions(gomock.Any(), request).Return(&manager.ListWorkflowExecutionsResponse{
+		Executions: []*workflowpb.WorkflowExecutionInfo{newExecutionInfo("wf2", "run1"), newExecutionInfo("wf1", "run1")},
+	}, nil)
+
+	actual, err := s.newManager(1).ListWorkflowExecutions(context.Background(), request)
+	s.NoError(err)
+	s.Equal(res, actual)
+	s.waitForCounter(metrics.VisibilityShadowReadComparisons.Name(), metrics.VisibilityPersistenceListWorkflowExecutionsScope)
+	s.Zero(s.countRecordings(metrics.VisibilityShadowReadMismatches.Name()))
+}
+
+func (s *VisibilityManagerDualSuite) TestListWorkflowExecutions_Mismatch() {
+	request := &manager.ListWorkflowExecutionsRequestV2{Namespace: testNamespace}
+	res := &manager.ListWorkflowExecutionsResponse{
+		Executions: []*workflowpb.WorkflowExecutionInfo{newExecutionInfo("wf1", "run1"), newExecutionInfo("wf2", "run1")},
+	}
+	s.readManager.EXPECT().ListWorkflowExecutions(gomock.Any(), request).Return(res, nil)
+	s.shadowManager.EXPECT().ListWorkflowExecutions(gomock.Any(), request).Return(&manager.ListWorkflowExecutionsResponse{
+		Executions: []*workflowpb.WorkflowExecutionInfo{newExecutionInfo("wf1", "run1"), newExecutionInfo("wf2", "run2")},
+	}, nil)
+
+	actual, err := s.newManager(1).ListWorkflowExecutions(context.Background(), request)
+	s.NoError(err)
+	s.Equal(res, actual)
+	s.waitForCounter(metrics.VisibilityShadowReadMismatches.Name(), metrics.VisibilityPersistenceListWorkflowExecutionsScope)
+}
+
+func (s *VisibilityManagerDualSuite) TestListWorkflowExecutions_NextPageNotVerified() {
+	request := &manager.ListWorkflowExecutionsRequestV2{Namespace: testNamespace, NextPageToken: []byte("token")}
+	res := &manager.ListWorkflowExecutionsResponse{}
+	s.readManager.EXPECT().ListWorkflowExecutions(gomock.Any(), request).Return(res, nil)
+	shadowDone := make(chan struct{})
+	s.shadowManager.EXPECT().ListWorkflowExecutions(gomock.Any(), request).
+		DoAndReturn(func(context.Context, *manager.ListWorkflowExecutionsRequestV2) (*manager.ListWorkflowExecutionsResponse, error) {
+			close(shadowDone)
+			return nil, errors.New("shadow read failed")
+		})
+
+	actual, err := s.newManager(1).ListWorkflowExecutions(context.Background(), request)
+	s.NoError(err)
+	s.Equal(res, actual)
+	<-shadowDone
+	s.Zero(s.countRecordings(metrics.VisibilityShadowReadComparisons.Name()))
+	s.Zero(s.countRecordings(metrics.VisibilityShadowReadErrors.Name()))
+}
+
+func (s *VisibilityManagerDualSuite) TestListWorkflowExecutions_ShadowError() {
+	request := &manager.ListWorkflowExecutionsRequestV2{Namespace: testNamespace}
+	s.readManager.EXPECT().ListWorkflowExecutions(gomock.Any(), request).Return(&manager.ListWorkflowExecutionsResponse{}, nil)
+	s.shadowManager.EXPECT().ListWorkflowExecutions(gomock.Any(), request).Return(nil, errors.New("shadow read failed"))
+
+	_, err := s.newManager(1).ListWorkflowExecutions(context.Background(), request)
+	s.NoError(err)
+	s.waitForCounter(metrics.VisibilityShadowReadErrors.Name(), metrics.VisibilityPersistenceListWorkflowExecutionsScope)
+	s.Zero(s.countRecordings(metrics.VisibilityShadowReadComparisons.Name()))
+}
+
+func (s *VisibilityManagerDualSuite) TestCountWorkflowExecutions_Match() {
+	request := &manager.CountWorkflowExecutionsRequest{Namespace: testNamespace, Query: "GROUP BY ExecutionStatus"}
+	res := &manager.CountWorkflowExecutionsResponse{
+		Count: 3,
+		Groups: []*workflowservice.CountWorkflowExecutionsResponse_AggregationGroup{
+			{GroupValues: []*commonpb.Payload{{Data: []byte(`"Running"`)}}, Count: 2},
+			{GroupValues: []*commonpb.Payload{{Data: []byte(`"Completed"`)}}, Count: 1},
+		},
+	}
+	s.readManager.EXPECT().CountWorkflowExecutions(gomock.Any(), request).Return(res, nil)
+	s.shadowManager.EXPECT().CountWorkflowExecutions(gomock.Any(), request).Return(&manager.CountWorkflowExecutionsResponse{
+		Count: 3,
+		Groups: []*workflowservice.CountWorkflowExecutionsResponse_AggregationGroup{
+			{GroupValues: []*commonpb.Payload{{Data: []byte(`"Completed"`)}}, Count: 1},
+			{GroupValues: []*commonpb.Payload{{Data: []byte(`"Running"`)}}, Count: 2},
+		},
+	}, nil)
+
+	actual, err := s.newManager(1).CountWorkflowExecutions(context.Background(), request)
+	s.NoError(err)
+	s.Equal(res, actual)
+	s.waitForCounter(metrics.VisibilityShadowReadComparisons.Name(), metrics.VisibilityPersistenceCountWorkflowExecutionsScope)
+	s.Zero(s.countRecordings(metrics.VisibilityShadowReadMismatches.Name()))
+}
+
+func (s *VisibilityManagerDualSuite) TestCountWorkflowExecutions_Mismatch() {
+	request := &manager.CountWorkflowExecutionsRequest{Namespace: testNamespace}
+	s.readManager.EXPECT().CountWorkflowExecutions(gomock.Any(), request).Return(&manager.CountWorkflowExecutionsResponse{Count: 3}, nil)
+	s.shadowManager.EXPECT().CountWorkflowExecutions(gomock.Any(), request).Return(&manager.CountWorkflowExecutionsResponse{Count: 2}, nil)
+
+	_, err := s.newManager(1).CountWorkflowExecutions(context.Background(), request)
+	s.NoError(err)
+	s.waitForCounter(metrics.VisibilityShadowReadMismatches.Name(), metrics.VisibilityPersistenceCountWorkflowExecutionsScope)
+}
+
+func (s *VisibilityManagerDualSuite) TestCountWorkflowExecutions_NotSampled() {
+	request := &manager.CountWorkflowExecutionsRequest{Namespace: testNamespace}
+	res := &manager.CountWorkflowExecutionsResponse{Count: 3}
+	s.readManager.EXPECT().CountWorkflowExecutions(gomock.Any(), request).Return(res, nil)
+	shadowDone := make(chan struct{})
+	s.shadowManager.EXPECT().CountWorkflowExecutions(gomock.Any(), request).
+		DoAndReturn(func(context.Context, *manager.CountWorkflowExecutionsRequest) (*manager.CountWorkflowExecutionsResponse, error) {
+			close(shadowDone)
+			return &manager.CountWorkflowExecutionsResponse{Count: 2}, nil
+		})
+
+	actual, err := s.newManager(0).CountWorkflowExecutions(context.Background(), request)
+	s.NoError(err)
+	s.Equal(res, actual)
+	<-shadowDone
+	s.Zero(s.countRecordings(metrics.VisibilityShadowReadComparisons.Name()))
+	s.Zero(s.countRecordings(metrics.VisibilityShadowReadMismatches.Name()))
+}
+
+func (s *VisibilityManagerDualSuite) TestClearSearchAttributeValues() {
+	request := &manager.ClearSearchAttributeValuesRequest{NamespaceID: "namespace-id", Field: "Keyword01", PageSize: 10}
+	visibilityManager := s.newManager(0)
+
+	// primary store first
+	s.readManager.EXPECT().ClearSearchAttributeValues(gomock.Any(), request).
+		Return(&manager.SearchAttributeValuesResponse{UpdatedCount: 10, NextPageToken: []byte("primary-page")}, nil)
+	resp, err := visibilityManager.ClearSearchAttributeValues(context.Background(), request)
+	s.NoError(err)
+	s.Equal(int64(10), resp.UpdatedCount)
+
+	s.readManager.EXPECT().ClearSearchAttributeValues(gomock.Any(), &manager.ClearSearchAttributeValuesRequest{
+		NamespaceID: "namespace-id", Field: "Keyword01", PageSize: 10, NextPageToken: []byte("primary-page"),
+	}).Return(&manager.SearchAttributeValuesResponse{UpdatedCount: 2}, nil)
+	resp, err = visibilityManager.ClearSearchAttributeValues(context.Background(), &manager.ClearSearchAttributeValuesRequest{
+		NamespaceID: "namespace-id", Field: "Keyword01", PageSize: 10, NextPageToken: resp.NextPageToken,
+	})
+	s.NoError(err)
+	s.Equal(int64(2), resp.UpdatedCount)
+	s.NotEmpty(resp.NextPageToken)
+
+	// then secondary store
+	s.shadowManager.EXPECT().ClearSearchAttributeValues(gomock.Any(), request).
+		Return(&manager.SearchAttributeValuesResponse{UpdatedCount: 3}, nil)
+	resp, err = visibilityManager.ClearSearchAttributeValues(context.Background(), &manager.ClearSearchAttributeValuesRequest{
+		NamespaceID: "namespace-id", Field: "Keyword01", PageSize: 10, NextPageToken: resp.NextPageToken,
+	})
+	s.NoError(err)
+	s.Equal(int64(3), resp.UpdatedCount)
+	s.Empty(resp.NextPageToken)
+}
@@ -128,6 +128,20 @@ func (p *visibilityManagerImpl) DeleteWorkflowExecution(
 	return p.store.DeleteWorkflowExecution(ctx, request)
 }
 
+func (p *visibilityManagerImpl) CopySearchAttributeValues(
+	ctx context.Context,
+	request *manager.CopySearchAttributeValuesRequest,
+) (*manager.SearchAttributeValuesResponse, error) {
+	return p.store.CopySearchAttributeValues(ctx, request)
+}
+
+func (p *visibilityManagerImpl) ClearSearchAttributeValues(
+	ctx context.Context,
+	request *manager.ClearSearchAttributeValuesRequest,
+) (*manager.SearchAttributeValuesResponse, error) {
+	return p.store.ClearSearchAttributeValues(ctx, request)
+}
+
 func (p *visibilityManagerImpl) ListWorkflowExecutions(
 	ctx context.Context,
 	request *manager.ListWorkflowExecutionsRequestV2,
@@ -179,6 +193,13 @@ func (p *visibilityManagerImpl) GetWorkflowExecution(
 	return &manager.GetWorkflowExecutionResponse{Execution: execution}, err
 }
 
+func (p *visibilityManagerImpl) ExplainQuery(
+	ctx context.Context,
+	request *manager.ExplainQueryRequest,
+) (*manager.ExplainQueryResponse, error) {
+	return p.store.ExplainQuery(ctx, request)
+}
+
 func (p *visibilityManagerImpl) newInternalVisibilityRequestBase(
 	request *manager.VisibilityRequestBase,
 ) (*store.InternalVisibilityRequestBase, error) {
@@ -105,6 +105,26 @@ func (m *visibilityManagerRateLimited) DeleteWorkflowExecution(
 	return m.delegate.DeleteWorkflowExecution(ctx, request)
 }
 
+func (m *visibilityManagerRateLimited) CopySearchAttributeValues(
+	ctx context.Context,
+	request *manager.CopySearchAttributeValuesRequest,
+) (*manager.SearchAttributeValuesResponse, error) {
+	if ok := allow(ctx, "CopySearchAttributeValues", m.writeRateLimiter); !ok {
+		return nil, persistence.ErrPersistenceSystemLimitExceeded
+	}
+	return m.delegate.CopySearchAttributeValues(ctx, request)
+}
+
+func (m *visibilityManagerRateLimited) ClearSearchAttributeValues(
+	ctx context.Context,
+	request *manager.ClearSearchAttributeValuesRequest,
+) (*manager.SearchAttributeValuesResponse, error) {
+	if ok := allow(ctx, "ClearSearchAttributeValues", m.writeRateLimiter); !ok {
+		return nil, persistence.ErrPersistenceSystemLimitExceeded
+	}
+	return m.delegate.ClearSearchAttributeValues(ctx, request)
+}
+
 // Below are read APIs.
 func (m *visibilityManagerRateLimited) ListWorkflowExecutions(
 	ctx context.Context,
@@ -146,6 +166,16 @@ func (m *visibilityManagerRateLimited) GetWorkflowExecution(
 	return m.delegate.GetWorkflowExecution(ctx, request)
 }
 
+func (m *visibilityManagerRateLimited) ExplainQuery(
+	ctx context.Context,
+	request *manager.ExplainQueryRequest,
+) (*manager.ExplainQueryResponse, error) {
+	if ok := allow(ctx, "ExplainQuery", m.readRateLimiter); !ok {
+		return nil, persistence.ErrPersistenceSystemLimitExceeded
+	}
+	return m.delegate.ExplainQuery(ctx, request)
+}
+
 func allow(
 	ctx context.Context,
 	api string,
@@ -111,6 +111,26 @@ func (m *visibilityManagerMetrics) DeleteWorkflowExecution(
 	return m.updateErrorMetric(handler, err)
 }
 
+func (m *visibilityManagerMetrics) CopySearchAttributeValues(
+	ctx context.Context,
+	request *manager.CopySearchAttributeValuesRequest,
+) (*manager.SearchAttributeValuesResponse, error) {
+	handler, startTime := m.tagScope(metrics.VisibilityPersistenceCopySearchAttributeValuesScope)
+	response, err := m.delegate.CopySearchAttributeValues(ctx, request)
+	metrics.VisibilityPersistenceLatency.With(handler).Record(time.Since(startTime))
+	return response, m.updateErrorMetric(handler, err)
+}
+
+func (m *visibilityManagerMetrics) ClearSearchAttributeValues(
+	ctx context.Context,
+	request *manager.ClearSearchAttributeValuesRequest,
+) (*manager.SearchAttributeValuesResponse, error) {
+	handler, startTime := m.tagScope(metrics.VisibilityPersistenceClearSearchAttributeValuesScope)
+	response, err := m.delegate.ClearSearchAttributeValues(ctx, request)
+	metrics.VisibilityPersistenceLatency.With(handler).Record(time.Since(startTime))
+	return response, m.updateErrorMetric(handler, err)
+}
+
 func (m *visibilityManagerMetrics) ListWorkflowExecutions(
 	ctx context.Context,
 	request *manager.ListWorkflowExecutionsRequestV2,
@@ -167,6 +187,16 @@ func (m *visibilityManagerMetrics) GetWorkflowExecution(
 	return response, m.updateErrorMetric(handler, err)
 }
 
+func (m *visibilityManagerMetrics) ExplainQuery(
+	ctx context.Context,
+	request *manager.ExplainQueryRequest,
+) (*manager.ExplainQueryResponse, error) {
+	handler, startTime := m.tagScope(metrics.VisibilityPersistenceExplainQueryScope)
+	response, err := m.delegate.ExplainQuery(ctx, request)
+	metrics.VisibilityPersistenceLatency.With(handler).Record(time.Since(startTime))
+	return response, m.updateErrorMetric(handler, err)
+}
+
 func (m *visibilityManagerMetrics) tagScope(operation string) (metrics.Handler, time.Time) {
 	taggedHandler := m.metricHandler.WithTags(metrics.OperationTag(operation), m.visibilityPluginNameMetricsTag, m.visibilityIndexNameMetricsTag)
 	metrics.VisibilityPersistenceRequests.With(taggedHandler).Record(1)
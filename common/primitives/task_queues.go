@@ -5,8 +5,10 @@ const (
 	DefaultWorkerTaskQueue = "default-worker-tq"
 	PerNSWorkerTaskQueue   = "temporal-sys-per-ns-tq"
 
-	MigrationActivityTQ           = "temporal-sys-migration-activity-tq"
-	AddSearchAttributesActivityTQ = "temporal-sys-add-search-attributes-activity-tq"
-	DeleteNamespaceActivityTQ     = "temporal-sys-delete-namespace-activity-tq"
-	DLQActivityTQ                 = "temporal-sys-dlq-activity-tq"
+	MigrationActivityTQ                 = "temporal-sys-migration-activity-tq"
+	AddSearchAttributesActivityTQ       = "temporal-sys-add-search-attributes-activity-tq"
+	DeleteNamespaceActivityTQ           = "temporal-sys-delete-namespace-activity-tq"
+	DLQActivityTQ                       = "temporal-sys-dlq-activity-tq"
+	RebuildVisibilityActivityTQ         = "temporal-sys-rebuild-visibility-activity-tq"
+	ChangeSearchAttributeTypeActivityTQ = "temporal-sys-change-search-attribute-type-activity-tq"
 )
@@ -39,6 +39,7 @@ import (
 	"go.temporal.io/server/common/rpc/encryption"
 	"go.temporal.io/server/common/sdk"
 	"go.temporal.io/server/common/searchattribute"
+	"go.temporal.io/server/common/shardmap"
 	"go.temporal.io/server/common/telemetry"
 	"go.temporal.io/server/common/testing/testhooks"
 	"go.uber.org/fx"
@@ -102,6 +103,7 @@ var Module = fx.Options(
 	fx.Provide(FrontendHTTPClientCacheProvider),
 	fx.Invoke(RegisterBootstrapContainer),
 	fx.Provide(PersistenceConfigProvider),
+	shardmap.Module,
 	fx.Provide(health.NewServer),
 	deadlock.Module,
 	config.Module,
@@ -205,7 +207,7 @@ func ClientFactoryProvider(
 	metricsHandler metrics.Handler,
 	dynamicCollection *dynamicconfig.Collection,
 	testHooks testhooks.TestHooks,
-	persistenceConfig *config.Persistence,
+	shardMapProvider *shardmap.Provider,
 	logger log.SnTaggedLogger,
 	throttledLogger log.ThrottledLogger,
 ) client.Factory {
@@ -215,7 +217,7 @@ func ClientFactoryProvider(
 		metricsHandler,
 		dynamicCollection,
 		testHooks,
-		persistenceConfig.NumHistoryShards,
+		shardMapProvider,
 		logger,
 		throttledLogger,
 	)
@@ -319,6 +321,8 @@ func MatchingClientProvider(matchingRawClient MatchingRawClient) MatchingClient
 
 func PersistenceConfigProvider(persistenceConfig config.Persistence, dc *dynamicconfig.Collection) *config.Persistence {
 	persistenceConfig.TransactionSizeLimit = dynamicconfig.TransactionSizeLimit.Get(dc)
+	persistenceConfig.BlobCompression = dynamicconfig.PersistenceBlobCompression.Get(dc)
+	persistenceConfig.BlobEncryption = dynamicconfig.PersistenceBlobEncryption.Get(dc)
 	return &persistenceConfig
 }
 
@@ -21,10 +21,25 @@ func (wt *WorkflowTags) extractFromAdminServiceServerMessage(message any) []tag.
 		return nil
 	case *adminservice.AddTasksResponse:
 		return nil
+	case *adminservice.AdvanceNamespaceClockRequest:
+		return nil
+	case *adminservice.AdvanceNamespaceClockResponse:
+		return nil
+	case *adminservice.BatchPauseWorkflowExecutionsRequest:
+		return nil
+	case *adminservice.BatchPauseWorkflowExecutionsResponse:
+		return nil
 	case *adminservice.CancelDLQJobRequest:
 		return nil
 	case *adminservice.CancelDLQJobResponse:
 		return nil
+	case *adminservice.ChangeSearchAttributeTypeRequest:
+		return nil
+	case *adminservice.ChangeSearchAttributeTypeResponse:
+		return []tag.Tag{
+			tag.WorkflowID(r.GetWorkflowId()),
+			tag.WorkflowRunID(r.GetRunId()),
+		}
 	case *adminservice.CloseShardRequest:
 		return nil
 	case *adminservice.CloseShardResponse:
@@ -62,14 +77,42 @@ func (wt *WorkflowTags) extractFromAdminServiceServerMessage(message any) []tag.
 		}
 	case *adminservice.DescribeMutableStateResponse:
 		return nil
+	case *adminservice.DescribeSearchAttributeTypeChangeRequest:
+		return nil
+	case *adminservice.DescribeSearchAttributeTypeChangeResponse:
+		return []tag.Tag{
+			tag.WorkflowID(r.GetWorkflowId()),
+			tag.WorkflowRunID(r.GetRunId()),
+		}
 	case *adminservice.DescribeTaskQueuePartitionRequest:
 		return nil
 	case *adminservice.DescribeTaskQueuePartitionResponse:
 		return nil
+	case *adminservice.DescribeVisibilityRebuildRequest:
+		return nil
+	case *adminservice.DescribeVisibilityRebuildResponse:
+		return []tag.Tag{
+			tag.WorkflowID(r.GetWorkflowId()),
+			tag.WorkflowRunID(r.GetRunId()),
+		}
+	case *adminservice.DescribeWorkerDispatchLimitsRequest:
+		return nil
+	case *adminservice.DescribeWorkerDispatchLimitsResponse:
+		return nil
+	case *adminservice.ExplainVisibilityQueryRequest:
+		return nil
+	case *adminservice.ExplainVisibilityQueryResponse:
+		return nil
 	case *adminservice.ForceUnloadTaskQueuePartitionRequest:
 		return nil
 	case *adminservice.ForceUnloadTaskQueuePartitionResponse:
 		return nil
+	case *adminservice.ForkWorkflowExecutionRequest:
+		return []tag.Tag{
+			tag.WorkflowID(r.GetWorkflowId()),
+		}
+	case *adminservice.ForkWorkflowExecutionResponse:
+		return nil
 	case *adminservice.GenerateLastHistoryReplicationTasksRequest:
 		return []tag.Tag{
 			tag.WorkflowID(r.GetExecution().GetWorkflowId()),
@@ -158,6 +201,24 @@ func (wt *WorkflowTags) extractFromAdminServiceServerMessage(message any) []tag.
 		return nil
 	case *adminservice.MergeDLQTasksResponse:
 		return nil
+	case *adminservice.MoveTaskQueueTasksRequest:
+		return nil
+	case *adminservice.MoveTaskQueueTasksResponse:
+		return nil
+	case *adminservice.PauseWorkflowExecutionRequest:
+		return []tag.Tag{
+			tag.WorkflowID(r.GetExecution().GetWorkflowId()),
+			tag.WorkflowRunID(r.GetExecution().GetRunId()),
+		}
+	case *adminservice.PauseWorkflowExecutionResponse:
+		return nil
+	case *adminservice.PreviewResetWorkflowExecutionRequest:
+		return []tag.Tag{
+			tag.WorkflowID(r.GetExecution().GetWorkflowId()),
+			tag.WorkflowRunID(r.GetExecution().GetRunId()),
+		}
+	case *adminservice.PreviewResetWorkflowExecutionResponse:
+		return nil
 	case *adminservice.PurgeDLQMessagesRequest:
 		return nil
 	case *adminservice.PurgeDLQMessagesResponse:
@@ -180,6 +241,13 @@ func (wt *WorkflowTags) extractFromAdminServiceServerMessage(message any) []tag.
 		}
 	case *adminservice.RebuildMutableStateResponse:
 		return nil
+	case *adminservice.RebuildVisibilityRequest:
+		return nil
+	case *adminservice.RebuildVisibilityResponse:
+		return []tag.Tag{
+			tag.WorkflowID(r.GetWorkflowId()),
+			tag.WorkflowRunID(r.GetRunId()),
+		}
 	case *adminservice.RefreshWorkflowTasksRequest:
 		return []tag.Tag{
 			tag.WorkflowID(r.GetExecution().GetWorkflowId()),
@@ -213,6 +281,17 @@ func (wt *WorkflowTags) extractFromAdminServiceServerMessage(message any) []tag.
 		}
 	case *adminservice.SyncWorkflowStateResponse:
 		return nil
+	case *adminservice.UnpauseWorkflowExecutionRequest:
+		return []tag.Tag{
+			tag.WorkflowID(r.GetExecution().GetWorkflowId()),
+			tag.WorkflowRunID(r.GetExecution().GetRunId()),
+		}
+	case *adminservice.UnpauseWorkflowExecutionResponse:
+		return nil
+	case *adminservice.UpdateWorkerDispatchLimitsRequest:
+		return nil
+	case *adminservice.UpdateWorkerDispatchLimitsResponse:
+		return nil
 	default:
 		return nil
 	}
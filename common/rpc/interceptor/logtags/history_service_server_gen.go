@@ -32,6 +32,20 @@ func (wt *WorkflowTags) extractFromHistoryServiceServerMessage(message any) []ta
 		return nil
 	case *historyservice.DeleteDLQTasksResponse:
 		return nil
+	case *historyservice.DeleteOrphanedCurrentExecutionRequest:
+		return []tag.Tag{
+			tag.WorkflowID(r.GetExecution().GetWorkflowId()),
+			tag.WorkflowRunID(r.GetExecution().GetRunId()),
+		}
+	case *historyservice.DeleteOrphanedCurrentExecutionResponse:
+		return nil
+	case *historyservice.DeleteOrphanedHistoryTaskRequest:
+		return []tag.Tag{
+			tag.WorkflowID(r.GetExecution().GetWorkflowId()),
+			tag.WorkflowRunID(r.GetExecution().GetRunId()),
+		}
+	case *historyservice.DeleteOrphanedHistoryTaskResponse:
+		return nil
 	case *historyservice.DeleteWorkflowExecutionRequest:
 		return []tag.Tag{
 			tag.WorkflowID(r.GetWorkflowExecution().GetWorkflowId()),
@@ -80,6 +94,12 @@ func (wt *WorkflowTags) extractFromHistoryServiceServerMessage(message any) []ta
 		}
 	case *historyservice.ForceDeleteWorkflowExecutionResponse:
 		return nil
+	case *historyservice.ForkWorkflowExecutionRequest:
+		return []tag.Tag{
+			tag.WorkflowID(r.GetWorkflowId()),
+		}
+	case *historyservice.ForkWorkflowExecutionResponse:
+		return nil
 	case *historyservice.GenerateLastHistoryReplicationTasksRequest:
 		return []tag.Tag{
 			tag.WorkflowID(r.GetExecution().GetWorkflowId()),
@@ -189,6 +209,13 @@ func (wt *WorkflowTags) extractFromHistoryServiceServerMessage(message any) []ta
 		return nil
 	case *historyservice.MergeDLQMessagesResponse:
 		return nil
+	case *historyservice.MoveSearchAttributeValueRequest:
+		return []tag.Tag{
+			tag.WorkflowID(r.GetExecution().GetWorkflowId()),
+			tag.WorkflowRunID(r.GetExecution().GetRunId()),
+		}
+	case *historyservice.MoveSearchAttributeValueResponse:
+		return nil
 	case *historyservice.PauseActivityRequest:
 		return []tag.Tag{
 			tag.WorkflowID(r.GetFrontendRequest().GetExecution().GetWorkflowId()),
@@ -196,6 +223,13 @@ func (wt *WorkflowTags) extractFromHistoryServiceServerMessage(message any) []ta
 		}
 	case *historyservice.PauseActivityResponse:
 		return nil
+	case *historyservice.PauseWorkflowExecutionRequest:
+		return []tag.Tag{
+			tag.WorkflowID(r.GetExecution().GetWorkflowId()),
+			tag.WorkflowRunID(r.GetExecution().GetRunId()),
+		}
+	case *historyservice.PauseWorkflowExecutionResponse:
+		return nil
 	case *historyservice.PollMutableStateRequest:
 		return []tag.Tag{
 			tag.WorkflowID(r.GetExecution().GetWorkflowId()),
@@ -213,6 +247,13 @@ func (wt *WorkflowTags) extractFromHistoryServiceServerMessage(message any) []ta
 		}
 	case *historyservice.PollWorkflowExecutionUpdateResponse:
 		return nil
+	case *historyservice.PreviewResetWorkflowExecutionRequest:
+		return []tag.Tag{
+			tag.WorkflowID(r.GetResetRequest().GetWorkflowExecution().GetWorkflowId()),
+			tag.WorkflowRunID(r.GetResetRequest().GetWorkflowExecution().GetRunId()),
+		}
+	case *historyservice.PreviewResetWorkflowExecutionResponse:
+		return nil
 	case *historyservice.PurgeDLQMessagesRequest:
 		return nil
 	case *historyservice.PurgeDLQMessagesResponse:
@@ -407,6 +448,13 @@ func (wt *WorkflowTags) extractFromHistoryServiceServerMessage(message any) []ta
 		}
 	case *historyservice.UnpauseActivityResponse:
 		return nil
+	case *historyservice.UnpauseWorkflowExecutionRequest:
+		return []tag.Tag{
+			tag.WorkflowID(r.GetExecution().GetWorkflowId()),
+			tag.WorkflowRunID(r.GetExecution().GetRunId()),
+		}
+	case *historyservice.UnpauseWorkflowExecutionResponse:
+		return nil
 	case *historyservice.UpdateActivityOptionsRequest:
 		return []tag.Tag{
 			tag.WorkflowID(r.GetUpdateRequest().GetExecution().GetWorkflowId()),
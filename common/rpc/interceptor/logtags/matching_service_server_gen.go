@@ -91,6 +91,10 @@ func (wt *WorkflowTags) extractFromMatchingServiceServerMessage(message any) []t
 		return nil
 	case *matchingservice.ListTaskQueuePartitionsResponse:
 		return nil
+	case *matchingservice.MoveTaskQueueTasksRequest:
+		return nil
+	case *matchingservice.MoveTaskQueueTasksResponse:
+		return nil
 	case *matchingservice.PollActivityTaskQueueRequest:
 		return nil
 	case *matchingservice.PollActivityTaskQueueResponse:
@@ -116,6 +120,10 @@ func (wt *WorkflowTags) extractFromMatchingServiceServerMessage(message any) []t
 		}
 	case *matchingservice.QueryWorkflowResponse:
 		return nil
+	case *matchingservice.ReleaseWorkerInFlightTaskRequest:
+		return nil
+	case *matchingservice.ReleaseWorkerInFlightTaskResponse:
+		return nil
 	case *matchingservice.ReplicateTaskQueueUserDataRequest:
 		return nil
 	case *matchingservice.ReplicateTaskQueueUserDataResponse:
@@ -148,6 +156,10 @@ func (wt *WorkflowTags) extractFromMatchingServiceServerMessage(message any) []t
 		return nil
 	case *matchingservice.UpdateWorkerBuildIdCompatibilityResponse:
 		return nil
+	case *matchingservice.UpdateWorkerDispatchLimitsRequest:
+		return nil
+	case *matchingservice.UpdateWorkerDispatchLimitsResponse:
+		return nil
 	case *matchingservice.UpdateWorkerVersioningRulesRequest:
 		return nil
 	case *matchingservice.UpdateWorkerVersioningRulesResponse:
@@ -0,0 +1,201 @@
+package searchattribute
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+)
+
+var (
+	ErrUnsupportedTypeConversion = errors.New("unsupported search attribute type conversion")
+	ErrInvalidValueConversion    = errors.New("search attribute value can't be converted")
+)
+
+// IsTypeConversionSupported returns true if ConvertValue can convert values of type from into values of type to.
+func IsTypeConversionSupported(from enumspb.IndexedValueType, to enumspb.IndexedValueType) bool {
+	if from == to {
+		return false
+	}
+	switch from {
+	case enumspb.INDEXED_VALUE_TYPE_KEYWORD, enumspb.INDEXED_VALUE_TYPE_TEXT:
+		return to != enumspb.INDEXED_VALUE_TYPE_UNSPECIFIED
+	case enumspb.INDEXED_VALUE_TYPE_KEYWORD_LIST:
+		return isStringType(to)
+	case enumspb.INDEXED_VALUE_TYPE_INT:
+		return to == enumspb.INDEXED_VALUE_TYPE_DOUBLE || isStringType(to)
+	case enumspb.INDEXED_VALUE_TYPE_DOUBLE:
+		return to == enumspb.INDEXED_VALUE_TYPE_INT || isStringType(to)
+	case enumspb.INDEXED_VALUE_TYPE_BOOL, enumspb.INDEXED_VALUE_TYPE_DATETIME:
+		return isStringType(to)
+	default:
+		return false
+	}
+}
+
+// ConvertValue converts a search attribute value, as decoded from the JSON document of a visibility store, from
+// type from to type to. The returned value can be encoded back into the document: datetime values are returned as
+// RFC3339 strings. It returns ErrInvalidValueConversion if the value can't be represented in the new type,
+// e.g. a Keyword that isn't a number converted to Int or a Double with a fractional part converted to Int.
+func ConvertValue(value any, from enumspb.IndexedValueType, to enumspb.IndexedValueType) (any, error) {
+	if !IsTypeConversionSupported(from, to) {
+		return nil, fmt.Errorf("%w: from %s to %s", ErrUnsupportedTypeConversion, from, to)
+	}
+
+	switch from {
+	case enumspb.INDEXED_VALUE_TYPE_KEYWORD, enumspb.INDEXED_VALUE_TYPE_TEXT, enumspb.INDEXED_VALUE_TYPE_DATETIME:
+		s, ok := value.(string)
+		if !ok {
+			return nil, invalidValueConversionError(value, from, to)
+		}
+		return convertString(s, from, to)
+	case enumspb.INDEXED_VALUE_TYPE_KEYWORD_LIST:
+		switch v := value.(type) {
+		case string:
+			return v, nil
+		case []string:
+			if len(v) == 1 {
+				return v[0], nil
+			}
+		case []any:
+			if len(v) == 1 {
+				if s, ok := v[0].(string); ok {
+					return s, nil
+				}
+			}
+		}
+		return nil, invalidValueConversionError(value, from, to)
+	case enumspb.INDEXED_VALUE_TYPE_INT, enumspb.INDEXED_VALUE_TYPE_DOUBLE:
+		if n, ok := value.(json.Number); ok && isStringType(to) {
+			// Keep the precision of big int64 values.
+			return n.String(), nil
+		}
+		f, ok := toFloat64(value)
+		if !ok {
+			return nil, invalidValueConversionError(value, from, to)
+		}
+		switch to {
+		case enumspb.INDEXED_VALUE_TYPE_DOUBLE:
+			return f, nil
+		case enumspb.INDEXED_VALUE_TYPE_INT:
+			if f != math.Trunc(f) || f > math.MaxInt64 || f < math.MinInt64 {
+				return nil, invalidValueConversionError(value, from, to)
+			}
+			return int64(f), nil
+		default:
+			if from == enumspb.INDEXED_VALUE_TYPE_INT {
+				return strconv.FormatInt(int64(f), 10), nil
+			}
+			return strconv.FormatFloat(f, 'f', -1, 64), nil
+		}
+	case enumspb.INDEXED_VALUE_TYPE_BOOL:
+		b, ok := value.(bool)
+		if !ok {
+			return nil, invalidValueConversionError(value, from, to)
+		}
+		return strconv.FormatBool(b), nil
+	default:
+		return nil, fmt.Errorf("%w: from %s to %s", ErrUnsupportedTypeConversion, from, to)
+	}
+}
+
+// ConvertPayload converts a search attribute value encoded in a payload, e.g. in the mutable state of a workflow
+// execution, from type from to type to. See ConvertValue.
+func ConvertPayload(value *commonpb.Payload, from enumspb.IndexedValueType, to enumspb.IndexedValueType) (*commonpb.Payload, error) {
+	decoded, err := DecodeValue(value, from, true)
+	if err != nil {
+		return nil, err
+	}
+	switch v := decoded.(type) {
+	case time.Time:
+		decoded = v.UTC().Format(time.RFC3339Nano)
+	case []time.Time:
+		if len(v) != 1 {
+			return nil, invalidValueConversionError(decoded, from, to)
+		}
+		decoded = v[0].UTC().Format(time.RFC3339Nano)
+	case []int64:
+		if len(v) != 1 {
+			return nil, invalidValueConversionError(decoded, from, to)
+		}
+		decoded = v[0]
+	case []float64:
+		if len(v) != 1 {
+			return nil, invalidValueConversionError(decoded, from, to)
+		}
+		decoded = v[0]
+	case []bool:
+		if len(v) != 1 {
+			return nil, invalidValueConversionError(decoded, from, to)
+		}
+		decoded = v[0]
+	case []string:
+		if from != enumspb.INDEXED_VALUE_TYPE_KEYWORD_LIST {
+			if len(v) != 1 {
+				return nil, invalidValueConversionError(decoded, from, to)
+			}
+			decoded = v[0]
+		}
+	}
+	converted, err := ConvertValue(decoded, from, to)
+	if err != nil {
+		return nil, err
+	}
+	return EncodeValue(converted, to)
+}
+
+func convertString(s string, from enumspb.IndexedValueType, to enumspb.IndexedValueType) (any, error) {
+	switch to {
+	case enumspb.INDEXED_VALUE_TYPE_KEYWORD, enumspb.INDEXED_VALUE_TYPE_TEXT:
+		return s, nil
+	case enumspb.INDEXED_VALUE_TYPE_KEYWORD_LIST:
+		return []string{s}, nil
+	case enumspb.INDEXED_VALUE_TYPE_INT:
+		if i, err := strconv.ParseInt(strings.TrimSpace(s), 10, 64); err == nil {
+			return i, nil
+		}
+	case enumspb.INDEXED_VALUE_TYPE_DOUBLE:
+		if f, err := strconv.ParseFloat(strings.TrimSpace(s), 64); err == nil && !math.IsNaN(f) && !math.IsInf(f, 0) {
+			return f, nil
+		}
+	case enumspb.INDEXED_VALUE_TYPE_BOOL:
+		if b, err := strconv.ParseBool(strings.TrimSpace(s)); err == nil {
+			return b, nil
+		}
+	case enumspb.INDEXED_VALUE_TYPE_DATETIME:
+		if t, err := time.Parse(time.RFC3339Nano, strings.TrimSpace(s)); err == nil {
+			return t.UTC().Format(time.RFC3339Nano), nil
+		}
+	}
+	return nil, invalidValueConversionError(s, from, to)
+}
+
+func toFloat64(value any) (float64, bool) {
+	switch v := value.(type) {
+	case float64:
+		return v, true
+	case int64:
+		return float64(v), true
+	case int:
+		return float64(v), true
+	case json.Number:
+		f, err := v.Float64()
+		return f, err == nil
+	default:
+		return 0, false
+	}
+}
+
+func isStringType(t enumspb.IndexedValueType) bool {
+	return t == enumspb.INDEXED_VALUE_TYPE_KEYWORD || t == enumspb.INDEXED_VALUE_TYPE_TEXT
+}
+
+func invalidValueConversionError(value any, from enumspb.IndexedValueType, to enumspb.IndexedValueType) error {
+	return fmt.Errorf("%w: %v from %s to %s", ErrInvalidValueConversion, value, from, to)
+}
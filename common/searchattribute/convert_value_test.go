@@ -0,0 +1,100 @@
+package searchattribute
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	enumspb "go.temporal.io/api/enums/v1"
+)
+
+func Test_ConvertValue_Success(t *testing.T) {
+	s := assert.New(t)
+
+	testCases := []struct {
+		value    any
+		from     enumspb.IndexedValueType
+		to       enumspb.IndexedValueType
+		expected any
+	}{
+		{"qwe", enumspb.INDEXED_VALUE_TYPE_KEYWORD, enumspb.INDEXED_VALUE_TYPE_TEXT, "qwe"},
+		{"qwe", enumspb.INDEXED_VALUE_TYPE_TEXT, enumspb.INDEXED_VALUE_TYPE_KEYWORD, "qwe"},
+		{"qwe", enumspb.INDEXED_VALUE_TYPE_KEYWORD, enumspb.INDEXED_VALUE_TYPE_KEYWORD_LIST, []string{"qwe"}},
+		{" 42 ", enumspb.INDEXED_VALUE_TYPE_KEYWORD, enumspb.INDEXED_VALUE_TYPE_INT, int64(42)},
+		{"4.2", enumspb.INDEXED_VALUE_TYPE_KEYWORD, enumspb.INDEXED_VALUE_TYPE_DOUBLE, 4.2},
+		{"true", enumspb.INDEXED_VALUE_TYPE_TEXT, enumspb.INDEXED_VALUE_TYPE_BOOL, true},
+		{"2024-01-02T03:04:05+01:00", enumspb.INDEXED_VALUE_TYPE_KEYWORD, enumspb.INDEXED_VALUE_TYPE_DATETIME, "2024-01-02T02:04:05Z"},
+		{[]string{"qwe"}, enumspb.INDEXED_VALUE_TYPE_KEYWORD_LIST, enumspb.INDEXED_VALUE_TYPE_KEYWORD, "qwe"},
+		{[]any{"qwe"}, enumspb.INDEXED_VALUE_TYPE_KEYWORD_LIST, enumspb.INDEXED_VALUE_TYPE_KEYWORD, "qwe"},
+		{"qwe", enumspb.INDEXED_VALUE_TYPE_KEYWORD_LIST, enumspb.INDEXED_VALUE_TYPE_KEYWORD, "qwe"},
+		{float64(42), enumspb.INDEXED_VALUE_TYPE_INT, enumspb.INDEXED_VALUE_TYPE_DOUBLE, float64(42)},
+		{float64(42), enumspb.INDEXED_VALUE_TYPE_INT, enumspb.INDEXED_VALUE_TYPE_KEYWORD, "42"},
+		{json.Number("42"), enumspb.INDEXED_VALUE_TYPE_DOUBLE, enumspb.INDEXED_VALUE_TYPE_INT, int64(42)},
+		{4.5, enumspb.INDEXED_VALUE_TYPE_DOUBLE, enumspb.INDEXED_VALUE_TYPE_TEXT, "4.5"},
+		{true, enumspb.INDEXED_VALUE_TYPE_BOOL, enumspb.INDEXED_VALUE_TYPE_KEYWORD, "true"},
+		{"2024-01-02T03:04:05Z", enumspb.INDEXED_VALUE_TYPE_DATETIME, enumspb.INDEXED_VALUE_TYPE_KEYWORD, "2024-01-02T03:04:05Z"},
+	}
+	for _, tc := range testCases {
+		converted, err := ConvertValue(tc.value, tc.from, tc.to)
+		s.NoError(err)
+		s.Equal(tc.expected, converted)
+	}
+}
+
+func Test_ConvertValue_InvalidValue(t *testing.T) {
+	s := assert.New(t)
+
+	testCases := []struct {
+		value any
+		from  enumspb.IndexedValueType
+		to    enumspb.IndexedValueType
+	}{
+		{"qwe", enumspb.INDEXED_VALUE_TYPE_KEYWORD, enumspb.INDEXED_VALUE_TYPE_INT},
+		{"qwe", enumspb.INDEXED_VALUE_TYPE_KEYWORD, enumspb.INDEXED_VALUE_TYPE_DATETIME},
+		{4.5, enumspb.INDEXED_VALUE_TYPE_DOUBLE, enumspb.INDEXED_VALUE_TYPE_INT},
+		{[]string{"qwe", "asd"}, enumspb.INDEXED_VALUE_TYPE_KEYWORD_LIST, enumspb.INDEXED_VALUE_TYPE_KEYWORD},
+		{"qwe", enumspb.INDEXED_VALUE_TYPE_INT, enumspb.INDEXED_VALUE_TYPE_DOUBLE},
+	}
+	for _, tc := range testCases {
+		_, err := ConvertValue(tc.value, tc.from, tc.to)
+		s.ErrorIs(err, ErrInvalidValueConversion)
+	}
+}
+
+func Test_ConvertValue_UnsupportedConversion(t *testing.T) {
+	s := assert.New(t)
+
+	_, err := ConvertValue(true, enumspb.INDEXED_VALUE_TYPE_BOOL, enumspb.INDEXED_VALUE_TYPE_INT)
+	s.ErrorIs(err, ErrUnsupportedTypeConversion)
+	_, err = ConvertValue("qwe", enumspb.INDEXED_VALUE_TYPE_KEYWORD, enumspb.INDEXED_VALUE_TYPE_KEYWORD)
+	s.ErrorIs(err, ErrUnsupportedTypeConversion)
+	s.False(IsTypeConversionSupported(enumspb.INDEXED_VALUE_TYPE_DATETIME, enumspb.INDEXED_VALUE_TYPE_INT))
+	s.True(IsTypeConversionSupported(enumspb.INDEXED_VALUE_TYPE_INT, enumspb.INDEXED_VALUE_TYPE_DOUBLE))
+}
+
+func Test_ConvertPayload(t *testing.T) {
+	s := assert.New(t)
+
+	value, err := EncodeValue("42", enumspb.INDEXED_VALUE_TYPE_KEYWORD)
+	s.NoError(err)
+	converted, err := ConvertPayload(value, enumspb.INDEXED_VALUE_TYPE_KEYWORD, enumspb.INDEXED_VALUE_TYPE_INT)
+	s.NoError(err)
+	decoded, err := DecodeValue(converted, enumspb.INDEXED_VALUE_TYPE_INT, false)
+	s.NoError(err)
+	s.Equal(int64(42), decoded)
+	s.Equal(enumspb.INDEXED_VALUE_TYPE_INT.String(), string(converted.GetMetadata()[MetadataType]))
+
+	value, err = EncodeValue(time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC), enumspb.INDEXED_VALUE_TYPE_DATETIME)
+	s.NoError(err)
+	converted, err = ConvertPayload(value, enumspb.INDEXED_VALUE_TYPE_DATETIME, enumspb.INDEXED_VALUE_TYPE_KEYWORD)
+	s.NoError(err)
+	decoded, err = DecodeValue(converted, enumspb.INDEXED_VALUE_TYPE_KEYWORD, false)
+	s.NoError(err)
+	s.Equal("2024-01-02T03:04:05Z", decoded)
+
+	value, err = EncodeValue("qwe", enumspb.INDEXED_VALUE_TYPE_KEYWORD)
+	s.NoError(err)
+	_, err = ConvertPayload(value, enumspb.INDEXED_VALUE_TYPE_KEYWORD, enumspb.INDEXED_VALUE_TYPE_INT)
+	s.ErrorIs(err, ErrInvalidValueConversion)
+}
@@ -3,6 +3,8 @@
 package searchattribute
 
 import (
+	"fmt"
+
 	commonpb "go.temporal.io/api/common/v1"
 	"go.temporal.io/api/serviceerror"
 	"go.temporal.io/server/common/namespace"
@@ -28,6 +30,13 @@ type (
 		emptyStringNameTypeMap NameTypeMap
 	}
 
+	// overrideMapper is used when custom search attributes aren't aliased per namespace, i.e. with Elasticsearch:
+	// names are field names, except the aliases the namespace defines, e.g. while the type of a search attribute is
+	// changed. An alias then refers to its field, and the field itself isn't visible under its own name.
+	overrideMapper struct {
+		mapper namespace.CustomSearchAttributesMapper
+	}
+
 	MapperProvider interface {
 		GetMapper(nsName namespace.Name) (Mapper, error)
 	}
@@ -42,6 +51,7 @@ type (
 
 var _ Mapper = (*noopMapper)(nil)
 var _ Mapper = (*backCompMapper_v1_20)(nil)
+var _ Mapper = (*overrideMapper)(nil)
 var _ Mapper = (*namespace.CustomSearchAttributesMapper)(nil)
 var _ MapperProvider = (*mapperProviderImpl)(nil)
 
@@ -79,6 +89,30 @@ func (m *backCompMapper_v1_20) GetFieldName(alias string, namespaceName string)
 	return fieldName, nil
 }
 
+func (m *overrideMapper) GetAlias(fieldName string, namespaceName string) (string, error) {
+	if alias, err := m.mapper.GetAlias(fieldName, namespaceName); err == nil {
+		return alias, nil
+	}
+	if _, err := m.mapper.GetFieldName(fieldName, namespaceName); err == nil {
+		return "", serviceerror.NewInvalidArgument(
+			fmt.Sprintf("Namespace %s uses %s as alias of another field", namespaceName, fieldName),
+		)
+	}
+	return fieldName, nil
+}
+
+func (m *overrideMapper) GetFieldName(alias string, namespaceName string) (string, error) {
+	if fieldName, err := m.mapper.GetFieldName(alias, namespaceName); err == nil {
+		return fieldName, nil
+	}
+	if _, err := m.mapper.GetAlias(alias, namespaceName); err == nil {
+		return "", serviceerror.NewInvalidArgument(
+			fmt.Sprintf("Namespace %s has no mapping defined for search attribute %s", namespaceName, alias),
+		)
+	}
+	return alias, nil
+}
+
 func NewMapperProvider(
 	customMapper Mapper,
 	namespaceRegistry namespace.Registry,
@@ -97,13 +131,19 @@ func (m *mapperProviderImpl) GetMapper(nsName namespace.Name) (Mapper, error) {
 	if m.customMapper != nil {
 		return m.customMapper, nil
 	}
-	if !m.enableMapperFromNamespace {
+	if !m.enableMapperFromNamespace && m.namespaceRegistry == nil {
 		return &noopMapper{}, nil
 	}
 	saMapper, err := m.namespaceRegistry.GetCustomSearchAttributesMapper(nsName)
 	if err != nil {
 		return nil, err
 	}
+	if !m.enableMapperFromNamespace {
+		if len(saMapper.FieldToAliasMap()) == 0 {
+			return &noopMapper{}, nil
+		}
+		return &overrideMapper{mapper: saMapper}, nil
+	}
 	// if there's an error, it returns an empty object, which is expected here
 	emptyStringNameTypeMap, _ := m.searchAttributesProvider.GetSearchAttributes("", false)
 	return &backCompMapper_v1_20{
@@ -6,6 +6,9 @@ import (
 	"github.com/stretchr/testify/assert"
 	commonpb "go.temporal.io/api/common/v1"
 	"go.temporal.io/api/serviceerror"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/namespace"
+	"go.uber.org/mock/gomock"
 )
 
 func Test_AliasFields(t *testing.T) {
@@ -138,3 +141,48 @@ func Test_UnaliasFields(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, sb, sb, "when there is nothin to unalias should return received attributes")
 }
+
+func Test_MapperProvider_Override(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	registry := namespace.NewMockRegistry(ctrl)
+	mapperProvider := NewMapperProvider(nil, registry, NewTestProvider(), false)
+
+	ns := namespace.FromPersistentState(&persistencespb.NamespaceDetail{
+		Info: &persistencespb.NamespaceInfo{Id: "test-namespace-id", Name: "test-namespace"},
+		Config: &persistencespb.NamespaceConfig{
+			CustomSearchAttributeAliases: map[string]string{"CustomerIdInt": "CustomerId"},
+		},
+		ReplicationConfig: &persistencespb.NamespaceReplicationConfig{},
+	})
+	registry.EXPECT().GetCustomSearchAttributesMapper(namespace.Name("test-namespace")).Return(ns.CustomSearchAttributesMapper(), nil)
+	mapper, err := mapperProvider.GetMapper("test-namespace")
+	assert.NoError(t, err)
+
+	fieldName, err := mapper.GetFieldName("CustomerId", "test-namespace")
+	assert.NoError(t, err)
+	assert.Equal(t, "CustomerIdInt", fieldName)
+	alias, err := mapper.GetAlias("CustomerIdInt", "test-namespace")
+	assert.NoError(t, err)
+	assert.Equal(t, "CustomerId", alias)
+
+	// The old field is hidden by the alias.
+	_, err = mapper.GetAlias("CustomerId", "test-namespace")
+	var invalidArgumentErr *serviceerror.InvalidArgument
+	assert.ErrorAs(t, err, &invalidArgumentErr)
+	_, err = mapper.GetFieldName("CustomerIdInt", "test-namespace")
+	assert.ErrorAs(t, err, &invalidArgumentErr)
+
+	// Other fields aren't mapped.
+	fieldName, err = mapper.GetFieldName("OrderId", "test-namespace")
+	assert.NoError(t, err)
+	assert.Equal(t, "OrderId", fieldName)
+	alias, err = mapper.GetAlias("OrderId", "test-namespace")
+	assert.NoError(t, err)
+	assert.Equal(t, "OrderId", alias)
+
+	// Without aliases names are field names.
+	registry.EXPECT().GetCustomSearchAttributesMapper(namespace.Name("other-namespace")).Return(namespace.CustomSearchAttributesMapper{}, nil)
+	mapper, err = mapperProvider.GetMapper("other-namespace")
+	assert.NoError(t, err)
+	assert.Equal(t, &noopMapper{}, mapper)
+}
@@ -0,0 +1,43 @@
+package shardmap
+
+import (
+	"context"
+
+	"go.temporal.io/server/common/cluster"
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/log"
+	"go.uber.org/fx"
+)
+
+var Module = fx.Options(
+	fx.Provide(ProviderProvider),
+	fx.Invoke(ProviderLifetimeHooks),
+)
+
+func ProviderProvider(persistenceConfig *config.Persistence) *Provider {
+	return NewProvider(persistenceConfig.NumHistoryShards)
+}
+
+// ProviderLifetimeHooks keeps the Provider up to date with the shard map of the cluster metadata.
+func ProviderLifetimeHooks(
+	lc fx.Lifecycle,
+	provider *Provider,
+	clusterMetadata cluster.Metadata,
+	logger log.SnTaggedLogger,
+) {
+	lc.Append(
+		fx.Hook{
+			OnStart: func(context.Context) error {
+				clusterMetadata.RegisterMetadataChangeCallback(
+					provider,
+					provider.onClusterMetadataChange(clusterMetadata, logger),
+				)
+				return nil
+			},
+			OnStop: func(context.Context) error {
+				clusterMetadata.UnRegisterMetadataChangeCallback(provider)
+				return nil
+			},
+		},
+	)
+}
@@ -0,0 +1,121 @@
+package shardmap
+
+import (
+	"context"
+	"sync/atomic"
+
+	"go.temporal.io/server/common/cluster"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/persistence"
+)
+
+type (
+	// Provider provides the current shard map of the cluster. It's updated from the cluster metadata, which all hosts
+	// refresh periodically, and by the shard splits completed by this host. All the components of a host which route
+	// workflows to history shards must use the same Provider.
+	Provider struct {
+		shardMap atomic.Pointer[ShardMap]
+	}
+)
+
+// NewProvider returns a Provider of the shard map of a cluster which was never split, until it's updated.
+func NewProvider(initialShardCount int32) *Provider {
+	p := &Provider{}
+	p.shardMap.Store(NewShardMap(initialShardCount))
+	return p
+}
+
+// ShardMap returns the current shard map.
+func (p *Provider) ShardMap() *ShardMap {
+	return p.shardMap.Load()
+}
+
+// WorkflowIDToHistoryShard returns the shard ID of the workflow in the current shard map.
+func (p *Provider) WorkflowIDToHistoryShard(namespaceID string, workflowID string) int32 {
+	return p.ShardMap().WorkflowIDToHistoryShard(namespaceID, workflowID)
+}
+
+// TotalShardCount returns the number of shards to be owned by history hosts in the current shard map.
+func (p *Provider) TotalShardCount() int32 {
+	return p.ShardMap().TotalShardCount()
+}
+
+// RingShard returns the shard whose owner in the membership ring owns the shard in the current shard map.
+func (p *Provider) RingShard(shardID int32) int32 {
+	return p.ShardMap().RingShard(shardID)
+}
+
+// Update replaces the current shard map if the shard map is newer, and returns the current shard map.
+func (p *Provider) Update(shardMap *ShardMap) *ShardMap {
+	for {
+		current := p.shardMap.Load()
+		if shardMap.Version <= current.Version {
+			return current
+		}
+		if p.shardMap.CompareAndSwap(current, shardMap) {
+			return shardMap
+		}
+	}
+}
+
+// Refresh loads the shard map from the cluster metadata store, without waiting for the next cluster metadata refresh.
+func (p *Provider) Refresh(
+	ctx context.Context,
+	clusterMetadataManager persistence.ClusterMetadataManager,
+) (*ShardMap, error) {
+	shardMap, _, err := Load(ctx, clusterMetadataManager)
+	if err != nil {
+		return nil, err
+	}
+	return p.Update(shardMap), nil
+}
+
+func (p *Provider) onClusterMetadataChange(
+	clusterMetadata cluster.Metadata,
+	logger log.Logger,
+) cluster.CallbackFn {
+	return func(_ map[string]*cluster.ClusterInformation, newClusterMetadata map[string]*cluster.ClusterInformation) {
+		clusterInfo, ok := newClusterMetadata[clusterMetadata.GetCurrentClusterName()]
+		if !ok || clusterInfo == nil {
+			return
+		}
+		data, ok := clusterInfo.Tags[ClusterMetadataTagKey]
+		if !ok {
+			return
+		}
+		shardMap, err := Decode(data)
+		if err != nil {
+			logger.Error("Ignoring invalid shard map in cluster metadata.", tag.Error(err))
+			return
+		}
+		p.Update(shardMap)
+	}
+}
+
+// ClusterShardCount returns the number of history shards of a cluster of the cluster metadata, which routes
+// replication between clusters. The shard map of the current cluster is the current shard map, and the shard map of a
+// remote cluster is the one in its cluster metadata tags, which are copied when the remote cluster is added or updated.
+// A cluster which was never split has the shard count of its cluster metadata.
+func (p *Provider) ClusterShardCount(clusterMetadata cluster.Metadata, clusterName string) int32 {
+	clusterInfo := clusterMetadata.GetAllClusterInfo()[clusterName]
+	shardMap := ClusterShardMap(clusterInfo.ShardCount, clusterInfo.Tags)
+	if clusterName == clusterMetadata.GetCurrentClusterName() {
+		shardMap = p.ShardMap()
+	}
+	if shardMap.Version == 0 {
+		return clusterInfo.ShardCount
+	}
+	return shardMap.TotalShardCount()
+}
+
+// ClusterShardMap returns the shard map in the tags of the metadata of a cluster, or the shard map of a cluster which
+// was never split if there is none.
+func ClusterShardMap(shardCount int32, tags map[string]string) *ShardMap {
+	if data, ok := tags[ClusterMetadataTagKey]; ok {
+		if shardMap, err := Decode(data); err == nil {
+			return shardMap
+		}
+	}
+	return NewShardMap(shardCount)
+}
@@ -0,0 +1,35 @@
+package shardmap
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/server/common/cluster"
+	"go.uber.org/mock/gomock"
+)
+
+func TestProvider_ClusterShardCount(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	remoteShardMap, err := NewShardMap(8).StartSplit(2)
+	require.NoError(t, err)
+	encoded, err := remoteShardMap.Encode()
+	require.NoError(t, err)
+
+	clusterMetadata := cluster.NewMockMetadata(ctrl)
+	clusterMetadata.EXPECT().GetCurrentClusterName().Return("active").AnyTimes()
+	clusterMetadata.EXPECT().GetAllClusterInfo().Return(map[string]cluster.ClusterInformation{
+		"active":  {ShardCount: 4},
+		"standby": {ShardCount: 8, Tags: map[string]string{ClusterMetadataTagKey: encoded}},
+		"other":   {ShardCount: 2},
+	}).AnyTimes()
+
+	p := NewProvider(4)
+	require.Equal(t, int32(4), p.ClusterShardCount(clusterMetadata, "active"))
+	require.Equal(t, int32(16), p.ClusterShardCount(clusterMetadata, "standby"))
+	require.Equal(t, int32(2), p.ClusterShardCount(clusterMetadata, "other"))
+
+	shardMap, err := NewShardMap(4).StartSplit(4)
+	require.NoError(t, err)
+	p.Update(shardMap)
+	require.Equal(t, int32(16), p.ClusterShardCount(clusterMetadata, "active"))
+}
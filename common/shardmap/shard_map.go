@@ -0,0 +1,204 @@
+// Package shardmap contains the versioned map from workflows to history shards. It allows the number of history
+// shards of a cluster to grow online, by splitting each shard into the same number of child shards.
+//
+// Shard IDs are derived from the hash of the sharding key modulo the shard count, so when the shard count is
+// multiplied by a split factor, all the keys of a child shard belong to the same parent shard:
+//
+//	parent = (child - 1) % shardCount + 1
+//
+// While a split is in progress, the keys of a parent shard are routed to the parent shard until its executions and
+// tasks are moved to its child shards and the split of the shard is completed, and to the child shards afterward.
+// Once all the shards are split, the shard count of the map becomes the new shard count.
+package shardmap
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+
+	"go.temporal.io/server/common"
+)
+
+const (
+	// ClusterMetadataTagKey is the key of the cluster metadata tag which stores the shard map. The shard count of the
+	// cluster metadata is the initial shard count of the cluster and is never changed.
+	ClusterMetadataTagKey = "temporal.shardMap"
+
+	maxShardCount = 1 << 24
+)
+
+type (
+	// ShardMap maps workflows to history shards. A ShardMap is immutable, changes return a new ShardMap with a greater
+	// version.
+	ShardMap struct {
+		Version    int64 `json:"version"`
+		ShardCount int32 `json:"shardCount"`
+		// SplitFactor is the number of child shards of each shard while a split is in progress, 0 otherwise.
+		SplitFactor int32 `json:"splitFactor,omitempty"`
+		// SplitShards are the shards whose executions and tasks were moved to their child shards, in ascending order.
+		SplitShards []int32 `json:"splitShards,omitempty"`
+	}
+)
+
+// NewShardMap returns the shard map of a cluster which was never split.
+func NewShardMap(shardCount int32) *ShardMap {
+	return &ShardMap{
+		ShardCount: shardCount,
+	}
+}
+
+// Decode returns the shard map encoded by Encode.
+func Decode(data string) (*ShardMap, error) {
+	var m ShardMap
+	if err := json.Unmarshal([]byte(data), &m); err != nil {
+		return nil, fmt.Errorf("unable to decode shard map: %w", err)
+	}
+	if err := m.validate(); err != nil {
+		return nil, err
+	}
+	return &m, nil
+}
+
+// Encode returns the representation of the shard map stored in the cluster metadata.
+func (m *ShardMap) Encode() (string, error) {
+	data, err := json.Marshal(m)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+// IsSplitting returns true if a split is in progress.
+func (m *ShardMap) IsSplitting() bool {
+	return m.SplitFactor > 1
+}
+
+// TotalShardCount returns the number of shards to be owned by history hosts. It includes the child shards of the
+// shards which are not split yet.
+func (m *ShardMap) TotalShardCount() int32 {
+	if !m.IsSplitting() {
+		return m.ShardCount
+	}
+	return m.ShardCount * m.SplitFactor
+}
+
+// WorkflowIDToHistoryShard returns the shard ID of the workflow.
+func (m *ShardMap) WorkflowIDToHistoryShard(namespaceID string, workflowID string) int32 {
+	return m.ShardingKeyToShard(namespaceID + "_" + workflowID)
+}
+
+// ShardingKeyToShard returns the shard ID of the sharding key.
+func (m *ShardMap) ShardingKeyToShard(shardingKey string) int32 {
+	shardID := common.ShardingKeyToShard(shardingKey, m.ShardCount)
+	if !m.IsShardSplit(shardID) {
+		return shardID
+	}
+	return common.ShardingKeyToShard(shardingKey, m.TotalShardCount())
+}
+
+// WorkflowIDToChildShard returns the shard ID of the workflow once the split of its shard is completed. It's the shard
+// ID returned by WorkflowIDToHistoryShard if no split is in progress.
+func (m *ShardMap) WorkflowIDToChildShard(namespaceID string, workflowID string) int32 {
+	return common.WorkflowIDToHistoryShard(namespaceID, workflowID, m.TotalShardCount())
+}
+
+// IsShardSplit returns true if the executions and tasks of the shard were moved to its child shards.
+func (m *ShardMap) IsShardSplit(shardID int32) bool {
+	if !m.IsSplitting() {
+		return false
+	}
+	_, found := slices.BinarySearch(m.SplitShards, shardID)
+	return found
+}
+
+// ParentShard returns the shard which is split into the shard, or the shard itself if it isn't a child shard.
+func (m *ShardMap) ParentShard(shardID int32) int32 {
+	return (shardID-1)%m.ShardCount + 1
+}
+
+// RingShard returns the shard whose owner in the membership ring owns the shard. While a split is in progress, the
+// child shards are owned by the owner of their parent shard: executions are moved between shards of the same host, and
+// requests routed with an outdated shard map still reach the owner of the shard.
+func (m *ShardMap) RingShard(shardID int32) int32 {
+	if !m.IsSplitting() {
+		return shardID
+	}
+	return m.ParentShard(shardID)
+}
+
+// ChildShards returns the shards the shard is split into. The first child shard is the shard itself: it keeps the
+// executions and tasks which are routed to it after the split.
+func (m *ShardMap) ChildShards(shardID int32) []int32 {
+	if !m.IsSplitting() {
+		return []int32{shardID}
+	}
+	childShards := make([]int32, 0, m.SplitFactor)
+	for i := range m.SplitFactor {
+		childShards = append(childShards, shardID+i*m.ShardCount)
+	}
+	return childShards
+}
+
+// StartSplit returns the shard map which splits each shard into splitFactor child shards.
+func (m *ShardMap) StartSplit(splitFactor int32) (*ShardMap, error) {
+	if m.IsSplitting() {
+		return nil, fmt.Errorf("split into %d shards is already in progress", m.TotalShardCount())
+	}
+	if splitFactor < 2 {
+		return nil, fmt.Errorf("split factor must be at least 2, got %d", splitFactor)
+	}
+	if int64(m.ShardCount)*int64(splitFactor) > maxShardCount {
+		return nil, fmt.Errorf("shard count can't exceed %d", maxShardCount)
+	}
+	return &ShardMap{
+		Version:     m.Version + 1,
+		ShardCount:  m.ShardCount,
+		SplitFactor: splitFactor,
+	}, nil
+}
+
+// CompleteShardSplit returns the shard map which routes the workflows of the shard to its child shards. When the split
+// of the last shard is completed, the split is finished and the shard count becomes the total shard count.
+func (m *ShardMap) CompleteShardSplit(shardID int32) (*ShardMap, error) {
+	if !m.IsSplitting() {
+		return nil, fmt.Errorf("no split is in progress")
+	}
+	if shardID < 1 || shardID > m.ShardCount {
+		return nil, fmt.Errorf("invalid shard ID %d, it must be between 1 and %d", shardID, m.ShardCount)
+	}
+	if m.IsShardSplit(shardID) {
+		return nil, fmt.Errorf("shard %d is already split", shardID)
+	}
+	if int32(len(m.SplitShards))+1 == m.ShardCount {
+		return &ShardMap{
+			Version:    m.Version + 1,
+			ShardCount: m.TotalShardCount(),
+		}, nil
+	}
+	splitShards := append(slices.Clone(m.SplitShards), shardID)
+	slices.Sort(splitShards)
+	return &ShardMap{
+		Version:     m.Version + 1,
+		ShardCount:  m.ShardCount,
+		SplitFactor: m.SplitFactor,
+		SplitShards: splitShards,
+	}, nil
+}
+
+func (m *ShardMap) validate() error {
+	if m.ShardCount < 1 || m.ShardCount > maxShardCount {
+		return fmt.Errorf("invalid shard map: invalid shard count %d", m.ShardCount)
+	}
+	if m.SplitFactor < 0 || m.SplitFactor == 1 || int64(m.ShardCount)*int64(m.SplitFactor) > maxShardCount {
+		return fmt.Errorf("invalid shard map: invalid split factor %d", m.SplitFactor)
+	}
+	if !m.IsSplitting() && len(m.SplitShards) > 0 {
+		return fmt.Errorf("invalid shard map: split shards without split in progress")
+	}
+	for i, shardID := range m.SplitShards {
+		if shardID < 1 || shardID > m.ShardCount || (i > 0 && shardID <= m.SplitShards[i-1]) {
+			return fmt.Errorf("invalid shard map: invalid split shards %v", m.SplitShards)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,109 @@
+package shardmap
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/server/common"
+)
+
+func TestShardMap_NotSplit(t *testing.T) {
+	shardMap := NewShardMap(16)
+	require.False(t, shardMap.IsSplitting())
+	require.Equal(t, int32(16), shardMap.TotalShardCount())
+	require.Equal(t, []int32{3}, shardMap.ChildShards(3))
+	for i := range 100 {
+		workflowID := fmt.Sprintf("workflow-%d", i)
+		require.Equal(t,
+			common.WorkflowIDToHistoryShard("namespace-id", workflowID, 16),
+			shardMap.WorkflowIDToHistoryShard("namespace-id", workflowID),
+		)
+	}
+}
+
+func TestShardMap_Split(t *testing.T) {
+	shardMap, err := NewShardMap(4).StartSplit(3)
+	require.NoError(t, err)
+	require.True(t, shardMap.IsSplitting())
+	require.Equal(t, int64(1), shardMap.Version)
+	require.Equal(t, int32(12), shardMap.TotalShardCount())
+	require.Equal(t, []int32{2, 6, 10}, shardMap.ChildShards(2))
+	for _, childShardID := range shardMap.ChildShards(2) {
+		require.Equal(t, int32(2), shardMap.ParentShard(childShardID))
+	}
+
+	// Workflows are routed to the child shards of split shards only.
+	shardMap, err = shardMap.CompleteShardSplit(2)
+	require.NoError(t, err)
+	require.Equal(t, int64(2), shardMap.Version)
+	require.True(t, shardMap.IsShardSplit(2))
+	require.False(t, shardMap.IsShardSplit(1))
+	for i := range 100 {
+		workflowID := fmt.Sprintf("workflow-%d", i)
+		parentShardID := common.WorkflowIDToHistoryShard("namespace-id", workflowID, 4)
+		shardID := shardMap.WorkflowIDToHistoryShard("namespace-id", workflowID)
+		if parentShardID == 2 {
+			require.Contains(t, shardMap.ChildShards(2), shardID)
+			require.Equal(t, common.WorkflowIDToHistoryShard("namespace-id", workflowID, 12), shardID)
+		} else {
+			require.Equal(t, parentShardID, shardID)
+		}
+	}
+
+	_, err = shardMap.CompleteShardSplit(2)
+	require.Error(t, err)
+	_, err = shardMap.CompleteShardSplit(5)
+	require.Error(t, err)
+	_, err = shardMap.StartSplit(2)
+	require.Error(t, err)
+
+	// The split is finished with the last shard.
+	for _, shardID := range []int32{4, 1, 3} {
+		shardMap, err = shardMap.CompleteShardSplit(shardID)
+		require.NoError(t, err)
+	}
+	require.Equal(t, &ShardMap{Version: 5, ShardCount: 12}, shardMap)
+	for i := range 100 {
+		workflowID := fmt.Sprintf("workflow-%d", i)
+		require.Equal(t,
+			common.WorkflowIDToHistoryShard("namespace-id", workflowID, 12),
+			shardMap.WorkflowIDToHistoryShard("namespace-id", workflowID),
+		)
+	}
+}
+
+func TestShardMap_StartSplit_InvalidFactor(t *testing.T) {
+	_, err := NewShardMap(4).StartSplit(1)
+	require.Error(t, err)
+	_, err = NewShardMap(maxShardCount).StartSplit(2)
+	require.Error(t, err)
+	_, err = NewShardMap(4).CompleteShardSplit(1)
+	require.Error(t, err)
+}
+
+func TestShardMap_EncodeDecode(t *testing.T) {
+	shardMap, err := NewShardMap(4).StartSplit(2)
+	require.NoError(t, err)
+	shardMap, err = shardMap.CompleteShardSplit(3)
+	require.NoError(t, err)
+
+	data, err := shardMap.Encode()
+	require.NoError(t, err)
+	require.JSONEq(t, `{"version":2,"shardCount":4,"splitFactor":2,"splitShards":[3]}`, data)
+	decoded, err := Decode(data)
+	require.NoError(t, err)
+	require.Equal(t, shardMap, decoded)
+
+	for _, invalid := range []string{
+		`{`,
+		`{"version":1,"shardCount":0}`,
+		`{"version":1,"shardCount":4,"splitFactor":1}`,
+		`{"version":1,"shardCount":4,"splitShards":[1]}`,
+		`{"version":1,"shardCount":4,"splitFactor":2,"splitShards":[5]}`,
+		`{"version":1,"shardCount":4,"splitFactor":2,"splitShards":[2,1]}`,
+	} {
+		_, err := Decode(invalid)
+		require.Error(t, err, invalid)
+	}
+}
@@ -0,0 +1,76 @@
+package shardmap
+
+import (
+	"context"
+	"errors"
+	"maps"
+
+	"go.temporal.io/server/common/persistence"
+)
+
+var (
+	// ErrConcurrentUpdate is returned when the cluster metadata was updated since the shard map was loaded.
+	ErrConcurrentUpdate = errors.New("cluster metadata was updated concurrently")
+)
+
+// Load returns the shard map of the current cluster, and the version of the cluster metadata record to pass to Save.
+func Load(
+	ctx context.Context,
+	clusterMetadataManager persistence.ClusterMetadataManager,
+) (*ShardMap, int64, error) {
+	resp, err := clusterMetadataManager.GetCurrentClusterMetadata(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+	data, ok := resp.GetTags()[ClusterMetadataTagKey]
+	if !ok {
+		return NewShardMap(resp.GetHistoryShardCount()), resp.Version, nil
+	}
+	shardMap, err := Decode(data)
+	if err != nil {
+		return nil, 0, err
+	}
+	return shardMap, resp.Version, nil
+}
+
+// Save stores the shard map in the metadata of the current cluster. recordVersion is the version returned by Load, and
+// ErrConcurrentUpdate is returned if the cluster metadata was updated since.
+func Save(
+	ctx context.Context,
+	clusterMetadataManager persistence.ClusterMetadataManager,
+	shardMap *ShardMap,
+	recordVersion int64,
+) error {
+	if err := shardMap.validate(); err != nil {
+		return err
+	}
+	data, err := shardMap.Encode()
+	if err != nil {
+		return err
+	}
+	resp, err := clusterMetadataManager.GetCurrentClusterMetadata(ctx)
+	if err != nil {
+		return err
+	}
+	if resp.Version != recordVersion {
+		return ErrConcurrentUpdate
+	}
+	tags := maps.Clone(resp.GetTags())
+	if tags == nil {
+		tags = make(map[string]string, 1)
+	}
+	tags[ClusterMetadataTagKey] = data
+	resp.Tags = tags
+
+	applied, err := clusterMetadataManager.SaveClusterMetadata(ctx, &persistence.SaveClusterMetadataRequest{
+		ClusterMetadata: resp.ClusterMetadata,
+		Version:         resp.Version,
+	})
+	if err != nil {
+		return err
+	}
+	if !applied {
+		return ErrConcurrentUpdate
+	}
+	return nil
+}
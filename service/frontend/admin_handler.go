@@ -35,6 +35,8 @@ import (
 	"go.temporal.io/server/client/frontend"
 	"go.temporal.io/server/client/history"
 	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/archiver"
+	"go.temporal.io/server/common/archiver/provider"
 	"go.temporal.io/server/common/channel"
 	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/cluster"
@@ -57,13 +59,18 @@ import (
 	"go.temporal.io/server/common/sdk"
 	"go.temporal.io/server/common/searchattribute"
 	serviceerrors "go.temporal.io/server/common/serviceerror"
+	"go.temporal.io/server/common/shardmap"
 	"go.temporal.io/server/common/util"
 	"go.temporal.io/server/common/xdc"
 	"go.temporal.io/server/service/history/tasks"
 	"go.temporal.io/server/service/worker/addsearchattributes"
+	"go.temporal.io/server/service/worker/batcher"
+	"go.temporal.io/server/service/worker/changesearchattributetype"
 	"go.temporal.io/server/service/worker/dlq"
+	"go.temporal.io/server/service/worker/rebuildvisibility"
 	"google.golang.org/grpc/health"
 	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -81,10 +88,11 @@ type (
 		status int32
 
 		logger                     log.Logger
-		numberOfHistoryShards      int32
+		shardMapProvider           *shardmap.Provider
 		ESClient                   esclient.Client
 		config                     *Config
 		namespaceDLQHandler        nsreplication.DLQMessageHandler
+		namespaceHandler           *namespaceHandler
 		eventSerializer            serialization.Serializer
 		visibilityMgr              manager.VisibilityManager
 		persistenceExecutionName   string
@@ -141,6 +149,9 @@ type (
 		HealthServer                        *health.Server
 		EventSerializer                     serialization.Serializer
 		TimeSource                          clock.TimeSource
+		ShardMapProvider                    *shardmap.Provider
+		ArchivalMetadata                    archiver.ArchivalMetadata
+		ArchiverProvider                    provider.ArchiverProvider
 
 		// DEPRECATED: only history service on server side is supposed to
 		// use the following components.
@@ -181,15 +192,25 @@ func NewAdminHandler(
 	)
 
 	return &AdminHandler{
-		logger:                args.Logger,
-		status:                common.DaemonStatusInitialized,
-		numberOfHistoryShards: args.PersistenceConfig.NumHistoryShards,
-		config:                args.Config,
+		logger:           args.Logger,
+		status:           common.DaemonStatusInitialized,
+		shardMapProvider: args.ShardMapProvider,
+		config:           args.Config,
 		namespaceDLQHandler: nsreplication.NewDLQMessageHandler(
 			namespaceReplicationTaskExecutor,
 			args.NamespaceReplicationQueue,
 			args.Logger,
 		),
+		namespaceHandler: newNamespaceHandler(
+			args.Logger,
+			args.PersistenceMetadataManager,
+			args.ClusterMetadata,
+			nsreplication.NewReplicator(args.ReplicatorNamespaceReplicationQueue, args.Logger),
+			args.ArchivalMetadata,
+			args.ArchiverProvider,
+			args.TimeSource,
+			args.Config,
+		),
 		eventSerializer:            args.EventSerializer,
 		visibilityMgr:              args.visibilityMgr,
 		ESClient:                   args.EsClient,
@@ -794,14 +815,15 @@ func (adh *AdminHandler) DescribeMutableState(ctx context.Context, request *admi
 		return nil, err
 	}
 
-	shardID := common.WorkflowIDToHistoryShard(namespaceID.String(), request.Execution.WorkflowId, adh.numberOfHistoryShards)
+	shardMap := adh.shardMapProvider.ShardMap()
+	shardID := shardMap.WorkflowIDToHistoryShard(namespaceID.String(), request.Execution.WorkflowId)
 	shardIDStr := convert.Int32ToString(shardID)
 
 	resolver, err := adh.membershipMonitor.GetResolver(primitives.HistoryService)
 	if err != nil {
 		return nil, err
 	}
-	historyHost, err := resolver.Lookup(shardIDStr)
+	historyHost, err := resolver.Lookup(convert.Int32ToString(shardMap.RingShard(shardID)))
 	if err != nil {
 		return nil, err
 	}
@@ -1733,6 +1755,625 @@ func (adh *AdminHandler) ForceUnloadTaskQueuePartition(
 	}, nil
 }
 
+// MoveTaskQueueTasks moves, or copies, one page of the backlog tasks of a task queue partition selected by the
+// filters to another task queue
+func (adh *AdminHandler) MoveTaskQueueTasks(
+	ctx context.Context,
+	request *adminservice.MoveTaskQueueTasksRequest,
+) (_ *adminservice.MoveTaskQueueTasksResponse, err error) {
+	defer log.CapturePanic(adh.logger, &err)
+
+	// validate request
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if len(request.Namespace) == 0 {
+		return nil, errNamespaceNotSet
+	}
+	if request.GetSourcePartition() == nil {
+		return nil, serviceerror.NewInvalidArgument("source partition is not set")
+	}
+
+	namespaceID, err := adh.namespaceRegistry.GetNamespaceID(namespace.Name(request.GetNamespace()))
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := adh.matchingClient.MoveTaskQueueTasks(ctx, &matchingservice.MoveTaskQueueTasksRequest{
+		NamespaceId:             namespaceID.String(),
+		SourcePartition:         request.GetSourcePartition(),
+		DestinationTaskQueue:    request.GetDestinationTaskQueue(),
+		Subqueue:                request.GetSubqueue(),
+		WorkflowIdPrefix:        request.GetWorkflowIdPrefix(),
+		MinTaskAge:              request.GetMinTaskAge(),
+		BuildId:                 request.GetBuildId(),
+		Copy:                    request.GetCopy(),
+		DryRun:                  request.GetDryRun(),
+		BatchSize:               request.GetBatchSize(),
+		NextPageToken:           request.GetNextPageToken(),
+		SourceBuildId:           request.GetSourceBuildId(),
+		SourceDeploymentVersion: request.GetSourceDeploymentVersion(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &adminservice.MoveTaskQueueTasksResponse{
+		ScannedCount:  resp.GetScannedCount(),
+		SelectedCount: resp.GetSelectedCount(),
+		MovedCount:    resp.GetMovedCount(),
+		NextPageToken: resp.GetNextPageToken(),
+	}, nil
+}
+
+// UpdateWorkerDispatchLimits replaces the per-worker dispatch limits of a task queue type, stored in the task queue
+// user data
+func (adh *AdminHandler) UpdateWorkerDispatchLimits(
+	ctx context.Context,
+	request *adminservice.UpdateWorkerDispatchLimitsRequest,
+) (_ *adminservice.UpdateWorkerDispatchLimitsResponse, err error) {
+	defer log.CapturePanic(adh.logger, &err)
+
+	// validate request
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if len(request.Namespace) == 0 {
+		return nil, errNamespaceNotSet
+	}
+	if len(request.TaskQueue) == 0 {
+		return nil, errTaskQueueNotSet
+	}
+
+	namespaceID, err := adh.namespaceRegistry.GetNamespaceID(namespace.Name(request.GetNamespace()))
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = adh.matchingClient.UpdateWorkerDispatchLimits(ctx, &matchingservice.UpdateWorkerDispatchLimitsRequest{
+		NamespaceId:   namespaceID.String(),
+		TaskQueue:     request.GetTaskQueue(),
+		TaskQueueType: request.GetTaskQueueType(),
+		Limits:        request.GetLimits(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &adminservice.UpdateWorkerDispatchLimitsResponse{}, nil
+}
+
+// DescribeWorkerDispatchLimits returns the per-worker dispatch limits of a task queue type stored in the task queue
+// user data. Limits set in dynamic config are not included.
+func (adh *AdminHandler) DescribeWorkerDispatchLimits(
+	ctx context.Context,
+	request *adminservice.DescribeWorkerDispatchLimitsRequest,
+) (_ *adminservice.DescribeWorkerDispatchLimitsResponse, err error) {
+	defer log.CapturePanic(adh.logger, &err)
+
+	// validate request
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if len(request.Namespace) == 0 {
+		return nil, errNamespaceNotSet
+	}
+	if len(request.TaskQueue) == 0 {
+		return nil, errTaskQueueNotSet
+	}
+
+	namespaceID, err := adh.namespaceRegistry.GetNamespaceID(namespace.Name(request.GetNamespace()))
+	if err != nil {
+		return nil, err
+	}
+
+	// the user data of all types is stored with the workflow task queue
+	resp, err := adh.matchingClient.GetTaskQueueUserData(ctx, &matchingservice.GetTaskQueueUserDataRequest{
+		NamespaceId:   namespaceID.String(),
+		TaskQueue:     request.GetTaskQueue(),
+		TaskQueueType: enumspb.TASK_QUEUE_TYPE_WORKFLOW,
+	})
+	if err != nil {
+		return nil, err
+	}
+	perType := resp.GetUserData().GetData().GetPerType()[int32(request.GetTaskQueueType())]
+	return &adminservice.DescribeWorkerDispatchLimitsResponse{
+		Limits: perType.GetWorkerDispatchLimits(),
+	}, nil
+}
+
+// ChangeSearchAttributeType starts the system workflow changing the type of a custom search attribute of a namespace.
+// It belongs to the operator service, whose API is defined in go.temporal.io/api.
+func (adh *AdminHandler) ChangeSearchAttributeType(
+	ctx context.Context,
+	request *adminservice.ChangeSearchAttributeTypeRequest,
+) (_ *adminservice.ChangeSearchAttributeTypeResponse, err error) {
+	defer log.CapturePanic(adh.logger, &err)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if len(request.GetNamespace()) == 0 {
+		return nil, errNamespaceNotSet
+	}
+	if len(request.GetSearchAttribute()) == 0 {
+		return nil, errSearchAttributesNotSet
+	}
+	if searchattribute.IsReserved(request.GetSearchAttribute()) {
+		return nil, serviceerror.NewInvalidArgument(fmt.Sprintf(errSearchAttributeIsReservedMessage, request.GetSearchAttribute()))
+	}
+	if _, ok := enumspb.IndexedValueType_name[int32(request.GetNewType())]; !ok || request.GetNewType() == enumspb.INDEXED_VALUE_TYPE_UNSPECIFIED {
+		return nil, serviceerror.NewInvalidArgument(fmt.Sprintf(errUnknownSearchAttributeTypeMessage, request.GetNewType()))
+	}
+	if _, err := adh.namespaceRegistry.GetNamespace(namespace.Name(request.GetNamespace())); err != nil {
+		return nil, err
+	}
+
+	workflowID := changesearchattributetype.WorkflowIDPrefix + request.GetNamespace()
+	run, err := adh.sdkClientFactory.GetSystemClient().ExecuteWorkflow(
+		ctx,
+		sdkclient.StartWorkflowOptions{
+			ID:                    workflowID,
+			TaskQueue:             primitives.DefaultWorkerTaskQueue,
+			WorkflowIDReusePolicy: enumspb.WORKFLOW_ID_REUSE_POLICY_ALLOW_DUPLICATE,
+			// one type change per namespace can run at a time
+			WorkflowExecutionErrorWhenAlreadyStarted: true,
+		},
+		changesearchattributetype.WorkflowName,
+		changesearchattributetype.ChangeSearchAttributeTypeParams{
+			Namespace:       request.GetNamespace(),
+			SearchAttribute: request.GetSearchAttribute(),
+			NewType:         request.GetNewType(),
+			NewFieldName:    request.GetNewFieldName(),
+			PageSize:        int(request.GetPageSize()),
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &adminservice.ChangeSearchAttributeTypeResponse{
+		WorkflowId: workflowID,
+		RunId:      run.GetRunID(),
+	}, nil
+}
+
+// DescribeSearchAttributeTypeChange returns the progress of the last search attribute type change of a namespace
+func (adh *AdminHandler) DescribeSearchAttributeTypeChange(
+	ctx context.Context,
+	request *adminservice.DescribeSearchAttributeTypeChangeRequest,
+) (_ *adminservice.DescribeSearchAttributeTypeChangeResponse, err error) {
+	defer log.CapturePanic(adh.logger, &err)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if len(request.GetNamespace()) == 0 {
+		return nil, errNamespaceNotSet
+	}
+
+	workflowID := changesearchattributetype.WorkflowIDPrefix + request.GetNamespace()
+	client := adh.sdkClientFactory.GetSystemClient()
+	execution, err := client.DescribeWorkflowExecution(ctx, workflowID, "")
+	if err != nil {
+		return nil, err
+	}
+	runID := execution.GetWorkflowExecutionInfo().GetExecution().GetRunId()
+	queryResponse, err := client.QueryWorkflow(ctx, workflowID, runID, changesearchattributetype.ProgressQueryType)
+	if err != nil {
+		return nil, err
+	}
+	var progress changesearchattributetype.ChangeSearchAttributeTypeProgress
+	if err := queryResponse.Get(&progress); err != nil {
+		return nil, err
+	}
+	return &adminservice.DescribeSearchAttributeTypeChangeResponse{
+		WorkflowId:      workflowID,
+		RunId:           runID,
+		Status:          execution.GetWorkflowExecutionInfo().GetStatus(),
+		Phase:           progress.Phase,
+		SearchAttribute: progress.SearchAttribute,
+		OldField:        progress.OldField,
+		OldType:         progress.OldType,
+		NewField:        progress.NewField,
+		NewType:         progress.NewType,
+		CopiedValues:    progress.CopiedValues,
+		RewrittenValues: progress.RewrittenValues,
+		FailedValues:    progress.FailedValues,
+		ClearedValues:   progress.ClearedValues,
+		StartTime:       timestamppb.New(progress.StartTime),
+	}, nil
+}
+
+// RebuildVisibility starts rebuilding the visibility records of a namespace from the mutable state of its executions
+func (adh *AdminHandler) RebuildVisibility(
+	ctx context.Context,
+	request *adminservice.RebuildVisibilityRequest,
+) (_ *adminservice.RebuildVisibilityResponse, err error) {
+	defer log.CapturePanic(adh.logger, &err)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if len(request.GetNamespace()) == 0 {
+		return nil, errNamespaceNotSet
+	}
+	if _, err := adh.namespaceRegistry.GetNamespace(namespace.Name(request.GetNamespace())); err != nil {
+		return nil, err
+	}
+
+	workflowID := rebuildvisibility.WorkflowIDPrefix + request.GetNamespace()
+	run, err := adh.sdkClientFactory.GetSystemClient().ExecuteWorkflow(
+		ctx,
+		sdkclient.StartWorkflowOptions{
+			ID:                    workflowID,
+			TaskQueue:             primitives.DefaultWorkerTaskQueue,
+			WorkflowIDReusePolicy: enumspb.WORKFLOW_ID_REUSE_POLICY_ALLOW_DUPLICATE,
+			// one rebuild per namespace can run at a time
+			WorkflowExecutionErrorWhenAlreadyStarted: true,
+		},
+		rebuildvisibility.WorkflowName,
+		rebuildvisibility.RebuildVisibilityParams{
+			Namespace: request.GetNamespace(),
+			RPS:       request.GetRps(),
+			PageSize:  int(request.GetPageSize()),
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &adminservice.RebuildVisibilityResponse{
+		WorkflowId: workflowID,
+		RunId:      run.GetRunID(),
+	}, nil
+}
+
+// DescribeVisibilityRebuild returns the progress of the last visibility rebuild of a namespace
+func (adh *AdminHandler) DescribeVisibilityRebuild(
+	ctx context.Context,
+	request *adminservice.DescribeVisibilityRebuildRequest,
+) (_ *adminservice.DescribeVisibilityRebuildResponse, err error) {
+	defer log.CapturePanic(adh.logger, &err)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if len(request.GetNamespace()) == 0 {
+		return nil, errNamespaceNotSet
+	}
+
+	workflowID := rebuildvisibility.WorkflowIDPrefix + request.GetNamespace()
+	client := adh.sdkClientFactory.GetSystemClient()
+	execution, err := client.DescribeWorkflowExecution(ctx, workflowID, "")
+	if err != nil {
+		return nil, err
+	}
+	runID := execution.GetWorkflowExecutionInfo().GetExecution().GetRunId()
+	queryResponse, err := client.QueryWorkflow(ctx, workflowID, runID, rebuildvisibility.ProgressQueryType)
+	if err != nil {
+		return nil, err
+	}
+	var progress rebuildvisibility.RebuildVisibilityProgress
+	if err := queryResponse.Get(&progress); err != nil {
+		return nil, err
+	}
+	return &adminservice.DescribeVisibilityRebuildResponse{
+		WorkflowId:        workflowID,
+		RunId:             runID,
+		Status:            execution.GetWorkflowExecutionInfo().GetStatus(),
+		TotalShards:       progress.TotalShards,
+		CompletedShards:   progress.CompletedShards,
+		ScannedExecutions: progress.ScannedExecutions,
+		RebuiltExecutions: progress.RebuiltExecutions,
+		FailedExecutions:  progress.FailedExecutions,
+		StartTime:         timestamppb.New(progress.StartTime),
+	}, nil
+}
+
+// ExplainVisibilityQuery returns the query of the visibility store for a list filter of a namespace
+func (adh *AdminHandler) ExplainVisibilityQuery(
+	ctx context.Context,
+	request *adminservice.ExplainVisibilityQueryRequest,
+) (_ *adminservice.ExplainVisibilityQueryResponse, err error) {
+	defer log.CapturePanic(adh.logger, &err)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if len(request.GetNamespace()) == 0 {
+		return nil, errNamespaceNotSet
+	}
+	nsEntry, err := adh.namespaceRegistry.GetNamespace(namespace.Name(request.GetNamespace()))
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := adh.visibilityMgr.ExplainQuery(ctx, &manager.ExplainQueryRequest{
+		NamespaceID: nsEntry.ID(),
+		Namespace:   nsEntry.Name(),
+		Query:       request.GetQuery(),
+		PageSize:    int(request.GetPageSize()),
+		IncludePlan: request.GetIncludePlan(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	queryArgs := make([]string, 0, len(resp.QueryArgs))
+	for _, arg := range resp.QueryArgs {
+		queryArgs = append(queryArgs, fmt.Sprint(arg))
+	}
+	return &adminservice.ExplainVisibilityQueryResponse{
+		StoreName: resp.StoreName,
+		Query:     resp.Query,
+		QueryArgs: queryArgs,
+		Plan:      resp.Plan,
+	}, nil
+}
+
+// AdvanceNamespaceClock moves the virtual clock of a test namespace forward
+func (adh *AdminHandler) AdvanceNamespaceClock(
+	ctx context.Context,
+	request *adminservice.AdvanceNamespaceClockRequest,
+) (_ *adminservice.AdvanceNamespaceClockResponse, err error) {
+	defer log.CapturePanic(adh.logger, &err)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if len(request.GetNamespace()) == 0 {
+		return nil, errNamespaceNotSet
+	}
+	if request.GetDuration().AsDuration() <= 0 {
+		return nil, errInvalidClockDuration
+	}
+	if !adh.config.EnableVirtualClock(request.GetNamespace()) {
+		return nil, errVirtualClockDisabled
+	}
+
+	getResponse, err := adh.persistenceMetadataManager.GetNamespace(ctx, &persistence.GetNamespaceRequest{
+		Name: request.GetNamespace(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// The namespace is updated like by UpdateNamespace, so that the new offset is replicated to the other clusters
+	// of a global namespace. The history service refreshes the tasks of the running workflows of the namespace once
+	// it loads the new offset.
+	offset := namespace.FromPersistentState(getResponse.Namespace).VirtualClockOffset() + request.GetDuration().AsDuration()
+	if _, err := adh.namespaceHandler.UpdateNamespace(ctx, &workflowservice.UpdateNamespaceRequest{
+		Namespace: request.GetNamespace(),
+		UpdateInfo: &namespacepb.UpdateNamespaceInfo{
+			Data: map[string]string{namespace.VirtualClockOffsetDataKey: offset.String()},
+		},
+	}); err != nil {
+		return nil, err
+	}
+
+	adh.logger.Info("Advanced namespace virtual clock",
+		tag.WorkflowNamespace(request.GetNamespace()),
+		tag.NewDurationTag("offset", offset),
+	)
+	return &adminservice.AdvanceNamespaceClockResponse{
+		Offset: durationpb.New(offset),
+	}, nil
+}
+
+// PauseWorkflowExecution pauses a running workflow execution.
+// The workflow service API, which would also record the pause in the history, is defined in go.temporal.io/api.
+func (adh *AdminHandler) PauseWorkflowExecution(
+	ctx context.Context,
+	request *adminservice.PauseWorkflowExecutionRequest,
+) (_ *adminservice.PauseWorkflowExecutionResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if err := validateExecution(request.GetExecution()); err != nil {
+		return nil, err
+	}
+
+	namespaceID, err := adh.namespaceRegistry.GetNamespaceID(namespace.Name(request.GetNamespace()))
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := adh.historyClient.PauseWorkflowExecution(ctx, &historyservice.PauseWorkflowExecutionRequest{
+		NamespaceId: namespaceID.String(),
+		Execution:   request.GetExecution(),
+	}); err != nil {
+		return nil, err
+	}
+	return &adminservice.PauseWorkflowExecutionResponse{}, nil
+}
+
+// UnpauseWorkflowExecution resumes a paused workflow execution.
+func (adh *AdminHandler) UnpauseWorkflowExecution(
+	ctx context.Context,
+	request *adminservice.UnpauseWorkflowExecutionRequest,
+) (_ *adminservice.UnpauseWorkflowExecutionResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if err := validateExecution(request.GetExecution()); err != nil {
+		return nil, err
+	}
+
+	namespaceID, err := adh.namespaceRegistry.GetNamespaceID(namespace.Name(request.GetNamespace()))
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := adh.historyClient.UnpauseWorkflowExecution(ctx, &historyservice.UnpauseWorkflowExecutionRequest{
+		NamespaceId: namespaceID.String(),
+		Execution:   request.GetExecution(),
+	}); err != nil {
+		return nil, err
+	}
+	return &adminservice.UnpauseWorkflowExecutionResponse{}, nil
+}
+
+// BatchPauseWorkflowExecutions starts a batch operation pausing, or unpausing, workflow executions.
+// The batch operation types of the workflow service are defined in go.temporal.io/api.
+func (adh *AdminHandler) BatchPauseWorkflowExecutions(
+	ctx context.Context,
+	request *adminservice.BatchPauseWorkflowExecutionsRequest,
+) (_ *adminservice.BatchPauseWorkflowExecutionsResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if len(request.GetJobId()) == 0 {
+		return nil, errBatchJobIDNotSet
+	}
+	if len(request.GetNamespace()) == 0 {
+		return nil, errNamespaceNotSet
+	}
+	if len(request.GetVisibilityQuery()) == 0 && len(request.GetExecutions()) == 0 {
+		return nil, errBatchOpsWorkflowFilterNotSet
+	}
+	if len(request.GetVisibilityQuery()) != 0 && len(request.GetExecutions()) != 0 {
+		return nil, errBatchOpsWorkflowFiltersNotAllowed
+	}
+	if len(request.GetExecutions()) > adh.config.MaxExecutionCountBatchOperation(request.GetNamespace()) {
+		return nil, errBatchOpsMaxWorkflowExecutionCount
+	}
+	if len(request.GetReason()) == 0 {
+		return nil, errReasonNotSet
+	}
+	if !adh.config.EnableBatcher(request.GetNamespace()) {
+		return nil, errBatchAPINotAllowed
+	}
+
+	namespaceID, err := adh.namespaceRegistry.GetNamespaceID(namespace.Name(request.GetNamespace()))
+	if err != nil {
+		return nil, err
+	}
+
+	batchType := batcher.BatchTypePauseWorkflow
+	if request.GetUnpause() {
+		batchType = batcher.BatchTypeUnpauseWorkflow
+	}
+	startReq, err := newBatchOperationStartRequest(request.GetNamespace(), request.GetJobId(), request.GetIdentity(), &batcher.BatchParams{
+		Namespace:  request.GetNamespace(),
+		Query:      request.GetVisibilityQuery(),
+		Executions: request.GetExecutions(),
+		Reason:     request.GetReason(),
+		BatchType:  batchType,
+		RPS:        float64(request.GetMaxOperationsPerSecond()),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if _, err := adh.historyClient.StartWorkflowExecution(
+		ctx,
+		common.CreateHistoryStartWorkflowRequest(
+			namespaceID.String(),
+			startReq,
+			nil,
+			nil,
+			time.Now().UTC(),
+		),
+	); err != nil {
+		return nil, err
+	}
+	return &adminservice.BatchPauseWorkflowExecutionsResponse{}, nil
+}
+
+// ForkWorkflowExecution creates a new workflow from the history of a workflow up to a given event
+func (adh *AdminHandler) ForkWorkflowExecution(
+	ctx context.Context,
+	request *adminservice.ForkWorkflowExecutionRequest,
+) (_ *adminservice.ForkWorkflowExecutionResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if len(request.GetNamespace()) == 0 {
+		return nil, errNamespaceNotSet
+	}
+	if len(request.GetWorkflowId()) == 0 {
+		return nil, errWorkflowIDNotSet
+	}
+	if err := validateExecution(request.GetSourceExecution()); err != nil {
+		return nil, err
+	}
+
+	namespaceID, err := adh.namespaceRegistry.GetNamespaceID(namespace.Name(request.GetNamespace()))
+	if err != nil {
+		return nil, err
+	}
+	sourceNamespaceID := namespaceID
+	if len(request.GetSourceNamespace()) != 0 {
+		sourceNamespaceID, err = adh.namespaceRegistry.GetNamespaceID(namespace.Name(request.GetSourceNamespace()))
+		if err != nil {
+			return nil, err
+		}
+	}
+	requestID := request.GetRequestId()
+	if len(requestID) == 0 {
+		requestID = uuid.New()
+	}
+
+	resp, err := adh.historyClient.ForkWorkflowExecution(ctx, &historyservice.ForkWorkflowExecutionRequest{
+		NamespaceId:       namespaceID.String(),
+		WorkflowId:        request.GetWorkflowId(),
+		SourceNamespaceId: sourceNamespaceID.String(),
+		SourceExecution:   request.GetSourceExecution(),
+		ForkEventId:       request.GetForkEventId(),
+		TaskQueue:         request.GetTaskQueue(),
+		RequestId:         requestID,
+		Reason:            request.GetReason(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &adminservice.ForkWorkflowExecutionResponse{RunId: resp.GetRunId()}, nil
+}
+
+// PreviewResetWorkflowExecution returns what resetting a workflow would do, without changing the workflow
+func (adh *AdminHandler) PreviewResetWorkflowExecution(
+	ctx context.Context,
+	request *adminservice.PreviewResetWorkflowExecutionRequest,
+) (_ *adminservice.PreviewResetWorkflowExecutionResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if err := validateExecution(request.GetExecution()); err != nil {
+		return nil, err
+	}
+
+	namespaceID, err := adh.namespaceRegistry.GetNamespaceID(namespace.Name(request.GetNamespace()))
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := adh.historyClient.PreviewResetWorkflowExecution(ctx, &historyservice.PreviewResetWorkflowExecutionRequest{
+		NamespaceId: namespaceID.String(),
+		ResetRequest: &workflowservice.ResetWorkflowExecutionRequest{
+			Namespace:                 request.GetNamespace(),
+			WorkflowExecution:         request.GetExecution(),
+			WorkflowTaskFinishEventId: request.GetWorkflowTaskFinishEventId(),
+			ResetReapplyExcludeTypes:  request.GetResetReapplyExcludeTypes(),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &adminservice.PreviewResetWorkflowExecutionResponse{
+		ResetMutableState:   resp.GetResetMutableState(),
+		ReappliedEvents:     resp.GetReappliedEvents(),
+		DroppedEvents:       resp.GetDroppedEvents(),
+		AbandonedActivities: resp.GetAbandonedActivities(),
+		AbandonedChildren:   resp.GetAbandonedChildren(),
+	}, nil
+}
+
 func (adh *AdminHandler) DeleteWorkflowExecution(
 	ctx context.Context,
 	request *adminservice.DeleteWorkflowExecutionRequest,
@@ -1774,10 +2415,11 @@ func (adh *AdminHandler) validateRemoteClusterMetadata(metadata *adminservice.De
 		// failover version increment is mismatch with current cluster config
 		return serviceerror.NewInvalidArgument("Cannot add remote cluster due to failover version increment mismatch")
 	}
-	if metadata.GetHistoryShardCount() != adh.config.NumHistoryShards {
-		remoteShardCount := metadata.GetHistoryShardCount()
+	// The shard counts change when the shards of a cluster are split.
+	remoteShardCount := shardmap.ClusterShardMap(metadata.GetHistoryShardCount(), metadata.GetTags()).TotalShardCount()
+	if localShardCount := adh.shardMapProvider.TotalShardCount(); remoteShardCount != localShardCount {
 		large := remoteShardCount
-		small := adh.config.NumHistoryShards
+		small := localShardCount
 		if large < small {
 			small, large = large, small
 		}
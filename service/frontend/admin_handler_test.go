@@ -8,6 +8,7 @@ import (
 	"strings"
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/pborman/uuid"
 	"github.com/stretchr/testify/assert"
@@ -21,6 +22,7 @@ import (
 	taskqueuepb "go.temporal.io/api/taskqueue/v1"
 	workflowpb "go.temporal.io/api/workflow/v1"
 	"go.temporal.io/api/workflowservice/v1"
+	sdkclient "go.temporal.io/sdk/client"
 	"go.temporal.io/server/api/adminservice/v1"
 	"go.temporal.io/server/api/adminservicemock/v1"
 	commonspb "go.temporal.io/server/api/common/v1"
@@ -30,6 +32,7 @@ import (
 	"go.temporal.io/server/api/matchingservice/v1"
 	"go.temporal.io/server/api/matchingservicemock/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
+	replicationspb "go.temporal.io/server/api/replication/v1"
 	taskqueuespb "go.temporal.io/server/api/taskqueue/v1"
 	clientmocks "go.temporal.io/server/client"
 	historyclient "go.temporal.io/server/client/history"
@@ -46,20 +49,27 @@ import (
 	"go.temporal.io/server/common/primitives"
 	"go.temporal.io/server/common/primitives/timestamp"
 	"go.temporal.io/server/common/resourcetest"
+	"go.temporal.io/server/common/sdk"
 	"go.temporal.io/server/common/searchattribute"
 	serviceerror2 "go.temporal.io/server/common/serviceerror"
+	"go.temporal.io/server/common/shardmap"
 	test "go.temporal.io/server/common/testing"
 	"go.temporal.io/server/common/testing/historyrequire"
 	"go.temporal.io/server/common/testing/mocksdk"
 	"go.temporal.io/server/common/testing/protorequire"
 	"go.temporal.io/server/common/testing/testvars"
 	"go.temporal.io/server/service/history/tasks"
+	"go.temporal.io/server/service/worker/batcher"
+	"go.temporal.io/server/service/worker/changesearchattributetype"
 	"go.temporal.io/server/service/worker/dlq"
+	"go.temporal.io/server/service/worker/rebuildvisibility"
 	"go.uber.org/mock/gomock"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/health"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type (
@@ -144,6 +154,9 @@ func (s *adminHandlerSuite) SetupTest() {
 		SearchAttributesTotalSizeLimit:        dynamicconfig.GetIntPropertyFnFilteredByNamespace(10),
 		VisibilityAllowList:                   dynamicconfig.GetBoolPropertyFnFilteredByNamespace(false),
 		SuppressErrorSetSystemSearchAttribute: dynamicconfig.GetBoolPropertyFnFilteredByNamespace(false),
+		EnableBatcher:                         dynamicconfig.GetBoolPropertyFnFilteredByNamespace(true),
+		MaxExecutionCountBatchOperation:       dynamicconfig.GetIntPropertyFnFilteredByNamespace(10),
+		EnableVirtualClock:                    dynamicconfig.GetBoolPropertyFnFilteredByNamespace(true),
 	}
 	args := NewAdminHandlerArgs{
 		persistenceConfig,
@@ -172,6 +185,9 @@ func (s *adminHandlerSuite) SetupTest() {
 		health.NewServer(),
 		serialization.NewSerializer(),
 		clock.NewRealTimeSource(),
+		shardmap.NewProvider(cfg.NumHistoryShards),
+		s.mockResource.GetArchivalMetadata(),
+		s.mockResource.GetArchiverProvider(),
 		tasks.NewDefaultTaskCategoryRegistry(),
 		s.mockResource.GetMatchingClient(),
 	}
@@ -1721,6 +1737,515 @@ func (s *adminHandlerSuite) TestForceUnloadTaskQueuePartition() {
 	s.True(resp.WasLoaded)
 }
 
+func (s *adminHandlerSuite) TestMoveTaskQueueTasks() {
+	handler := s.handler
+	ctx := context.Background()
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(gomock.Any()).Return(s.namespaceID, nil).AnyTimes()
+
+	type test struct {
+		Name     string
+		Request  *adminservice.MoveTaskQueueTasksRequest
+		Expected error
+	}
+	// request validation tests
+	errorCases := []test{
+		{
+			Name:     "nil request",
+			Request:  nil,
+			Expected: &serviceerror.InvalidArgument{Message: "Request is nil."},
+		},
+		{
+			Name:     "empty request",
+			Request:  &adminservice.MoveTaskQueueTasksRequest{},
+			Expected: &serviceerror.InvalidArgument{Message: "Namespace is not set on request."},
+		},
+		{
+			Name:     "no source partition",
+			Request:  &adminservice.MoveTaskQueueTasksRequest{Namespace: s.namespace.String()},
+			Expected: &serviceerror.InvalidArgument{Message: "source partition is not set"},
+		},
+	}
+	for _, test := range errorCases {
+		s.T().Run(test.Name, func(t *testing.T) {
+			resp, err := handler.MoveTaskQueueTasks(ctx, test.Request)
+			s.Equal(test.Expected, err)
+			s.Nil(resp)
+		})
+	}
+
+	// valid request
+	sourcePartition := &taskqueuespb.TaskQueuePartition{
+		TaskQueue:     "misconfigured",
+		TaskQueueType: enumspb.TASK_QUEUE_TYPE_ACTIVITY,
+		PartitionId:   &taskqueuespb.TaskQueuePartition_NormalPartitionId{NormalPartitionId: 1},
+	}
+	s.mockMatchingClient.EXPECT().MoveTaskQueueTasks(ctx, &matchingservice.MoveTaskQueueTasksRequest{
+		NamespaceId:          s.namespaceID.String(),
+		SourcePartition:      sourcePartition,
+		DestinationTaskQueue: "hello-world",
+		WorkflowIdPrefix:     "orders-",
+		BuildId:              "v1",
+		BatchSize:            10,
+		NextPageToken:        []byte("token"),
+	}).Return(&matchingservice.MoveTaskQueueTasksResponse{
+		ScannedCount:  10,
+		SelectedCount: 4,
+		MovedCount:    4,
+		NextPageToken: []byte("next"),
+	}, nil).Times(1)
+
+	resp, err := handler.MoveTaskQueueTasks(ctx, &adminservice.MoveTaskQueueTasksRequest{
+		Namespace:            s.namespace.String(),
+		SourcePartition:      sourcePartition,
+		DestinationTaskQueue: "hello-world",
+		WorkflowIdPrefix:     "orders-",
+		BuildId:              "v1",
+		BatchSize:            10,
+		NextPageToken:        []byte("token"),
+	})
+	s.NoError(err)
+	s.Equal(int64(10), resp.ScannedCount)
+	s.Equal(int64(4), resp.SelectedCount)
+	s.Equal(int64(4), resp.MovedCount)
+	s.Equal([]byte("next"), resp.NextPageToken)
+}
+
+func (s *adminHandlerSuite) TestUpdateWorkerDispatchLimits() {
+	ctx := context.Background()
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(gomock.Any()).Return(s.namespaceID, nil).AnyTimes()
+
+	_, err := s.handler.UpdateWorkerDispatchLimits(ctx, &adminservice.UpdateWorkerDispatchLimitsRequest{Namespace: s.namespace.String()})
+	s.Equal(&serviceerror.InvalidArgument{Message: "TaskQueue is not set on request."}, err)
+
+	limits := &persistencespb.WorkerDispatchLimits{
+		ByIdentity: map[string]*persistencespb.WorkerDispatchLimit{"worker-1": {MaxTasksPerSecond: 10, MaxInFlight: 5}},
+	}
+	s.mockMatchingClient.EXPECT().UpdateWorkerDispatchLimits(ctx, &matchingservice.UpdateWorkerDispatchLimitsRequest{
+		NamespaceId:   s.namespaceID.String(),
+		TaskQueue:     "orders",
+		TaskQueueType: enumspb.TASK_QUEUE_TYPE_ACTIVITY,
+		Limits:        limits,
+	}).Return(&matchingservice.UpdateWorkerDispatchLimitsResponse{}, nil)
+
+	_, err = s.handler.UpdateWorkerDispatchLimits(ctx, &adminservice.UpdateWorkerDispatchLimitsRequest{
+		Namespace:     s.namespace.String(),
+		TaskQueue:     "orders",
+		TaskQueueType: enumspb.TASK_QUEUE_TYPE_ACTIVITY,
+		Limits:        limits,
+	})
+	s.NoError(err)
+}
+
+func (s *adminHandlerSuite) TestDescribeWorkerDispatchLimits() {
+	ctx := context.Background()
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(gomock.Any()).Return(s.namespaceID, nil).AnyTimes()
+
+	limits := &persistencespb.WorkerDispatchLimits{
+		ByBuildId: map[string]*persistencespb.WorkerDispatchLimit{"v1": {MaxInFlight: 5}},
+	}
+	s.mockMatchingClient.EXPECT().GetTaskQueueUserData(ctx, &matchingservice.GetTaskQueueUserDataRequest{
+		NamespaceId:   s.namespaceID.String(),
+		TaskQueue:     "orders",
+		TaskQueueType: enumspb.TASK_QUEUE_TYPE_WORKFLOW,
+	}).Return(&matchingservice.GetTaskQueueUserDataResponse{
+		UserData: &persistencespb.VersionedTaskQueueUserData{
+			Data: &persistencespb.TaskQueueUserData{
+				PerType: map[int32]*persistencespb.TaskQueueTypeUserData{
+					int32(enumspb.TASK_QUEUE_TYPE_ACTIVITY): {WorkerDispatchLimits: limits},
+				},
+			},
+		},
+	}, nil).Times(2)
+
+	resp, err := s.handler.DescribeWorkerDispatchLimits(ctx, &adminservice.DescribeWorkerDispatchLimitsRequest{
+		Namespace:     s.namespace.String(),
+		TaskQueue:     "orders",
+		TaskQueueType: enumspb.TASK_QUEUE_TYPE_ACTIVITY,
+	})
+	s.NoError(err)
+	s.Equal(limits, resp.GetLimits())
+
+	// the workflow task queue has no limits
+	resp, err = s.handler.DescribeWorkerDispatchLimits(ctx, &adminservice.DescribeWorkerDispatchLimitsRequest{
+		Namespace:     s.namespace.String(),
+		TaskQueue:     "orders",
+		TaskQueueType: enumspb.TASK_QUEUE_TYPE_WORKFLOW,
+	})
+	s.NoError(err)
+	s.Nil(resp.GetLimits())
+}
+
+func (s *adminHandlerSuite) TestChangeSearchAttributeType() {
+	_, err := s.handler.ChangeSearchAttributeType(context.Background(), &adminservice.ChangeSearchAttributeTypeRequest{
+		Namespace:       s.namespace.String(),
+		SearchAttribute: "TemporalNewFieldOfCustomField",
+		NewType:         enumspb.INDEXED_VALUE_TYPE_INT,
+	})
+	var invalidArgument *serviceerror.InvalidArgument
+	s.ErrorAs(err, &invalidArgument)
+
+	s.mockNamespaceCache.EXPECT().GetNamespace(s.namespace).Return(s.namespaceEntry, nil)
+	mockSdkClient := mocksdk.NewMockClient(s.controller)
+	s.mockResource.SDKClientFactory.EXPECT().GetSystemClient().Return(mockSdkClient)
+	mockRun := mocksdk.NewMockWorkflowRun(s.controller)
+	mockRun.EXPECT().GetRunID().Return("test-run-id")
+	workflowID := changesearchattributetype.WorkflowIDPrefix + s.namespace.String()
+	mockSdkClient.EXPECT().ExecuteWorkflow(
+		gomock.Any(),
+		gomock.Any(),
+		changesearchattributetype.WorkflowName,
+		changesearchattributetype.ChangeSearchAttributeTypeParams{
+			Namespace:       s.namespace.String(),
+			SearchAttribute: "CustomField",
+			NewType:         enumspb.INDEXED_VALUE_TYPE_INT,
+			PageSize:        50,
+		},
+	).DoAndReturn(func(_ context.Context, options sdkclient.StartWorkflowOptions, _ interface{}, _ ...interface{}) (sdkclient.WorkflowRun, error) {
+		s.Equal(workflowID, options.ID)
+		s.True(options.WorkflowExecutionErrorWhenAlreadyStarted)
+		return mockRun, nil
+	})
+
+	resp, err := s.handler.ChangeSearchAttributeType(context.Background(), &adminservice.ChangeSearchAttributeTypeRequest{
+		Namespace:       s.namespace.String(),
+		SearchAttribute: "CustomField",
+		NewType:         enumspb.INDEXED_VALUE_TYPE_INT,
+		PageSize:        50,
+	})
+	s.NoError(err)
+	s.Equal(&adminservice.ChangeSearchAttributeTypeResponse{WorkflowId: workflowID, RunId: "test-run-id"}, resp)
+}
+
+func (s *adminHandlerSuite) TestDescribeSearchAttributeTypeChange() {
+	workflowID := changesearchattributetype.WorkflowIDPrefix + s.namespace.String()
+	startTime := time.Now().UTC()
+	mockSdkClient := mocksdk.NewMockClient(s.controller)
+	s.mockResource.SDKClientFactory.EXPECT().GetSystemClient().Return(mockSdkClient)
+	mockSdkClient.EXPECT().DescribeWorkflowExecution(gomock.Any(), workflowID, "").Return(&workflowservice.DescribeWorkflowExecutionResponse{
+		WorkflowExecutionInfo: &workflowpb.WorkflowExecutionInfo{
+			Execution: &commonpb.WorkflowExecution{WorkflowId: workflowID, RunId: "test-run-id"},
+			Status:    enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING,
+		},
+	}, nil)
+	mockValue := mocksdk.NewMockEncodedValue(s.controller)
+	mockValue.EXPECT().Get(gomock.Any()).Do(func(result interface{}) {
+		*(result.(*changesearchattributetype.ChangeSearchAttributeTypeProgress)) = changesearchattributetype.ChangeSearchAttributeTypeProgress{
+			Phase:           changesearchattributetype.PhaseRewrite,
+			SearchAttribute: "CustomField",
+			OldField:        "Keyword01",
+			OldType:         enumspb.INDEXED_VALUE_TYPE_KEYWORD,
+			NewField:        "Int01",
+			NewType:         enumspb.INDEXED_VALUE_TYPE_INT,
+			CopiedValues:    10,
+			RewrittenValues: 3,
+			FailedValues:    1,
+			StartTime:       startTime,
+		}
+	})
+	mockSdkClient.EXPECT().QueryWorkflow(gomock.Any(), workflowID, "test-run-id", changesearchattributetype.ProgressQueryType).Return(mockValue, nil)
+
+	resp, err := s.handler.DescribeSearchAttributeTypeChange(context.Background(), &adminservice.DescribeSearchAttributeTypeChangeRequest{
+		Namespace: s.namespace.String(),
+	})
+	s.NoError(err)
+	s.ProtoEqual(&adminservice.DescribeSearchAttributeTypeChangeResponse{
+		WorkflowId:      workflowID,
+		RunId:           "test-run-id",
+		Status:          enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING,
+		Phase:           changesearchattributetype.PhaseRewrite,
+		SearchAttribute: "CustomField",
+		OldField:        "Keyword01",
+		OldType:         enumspb.INDEXED_VALUE_TYPE_KEYWORD,
+		NewField:        "Int01",
+		NewType:         enumspb.INDEXED_VALUE_TYPE_INT,
+		CopiedValues:    10,
+		RewrittenValues: 3,
+		FailedValues:    1,
+		StartTime:       timestamppb.New(startTime),
+	}, resp)
+}
+
+func (s *adminHandlerSuite) TestRebuildVisibility() {
+	s.mockNamespaceCache.EXPECT().GetNamespace(s.namespace).Return(s.namespaceEntry, nil)
+	mockSdkClient := mocksdk.NewMockClient(s.controller)
+	s.mockResource.SDKClientFactory.EXPECT().GetSystemClient().Return(mockSdkClient)
+	mockRun := mocksdk.NewMockWorkflowRun(s.controller)
+	mockRun.EXPECT().GetRunID().Return("test-run-id")
+	workflowID := rebuildvisibility.WorkflowIDPrefix + s.namespace.String()
+	mockSdkClient.EXPECT().ExecuteWorkflow(
+		gomock.Any(),
+		gomock.Any(),
+		rebuildvisibility.WorkflowName,
+		rebuildvisibility.RebuildVisibilityParams{
+			Namespace: s.namespace.String(),
+			RPS:       20,
+			PageSize:  50,
+		},
+	).DoAndReturn(func(_ context.Context, options sdkclient.StartWorkflowOptions, _ interface{}, _ ...interface{}) (sdkclient.WorkflowRun, error) {
+		s.Equal(workflowID, options.ID)
+		s.True(options.WorkflowExecutionErrorWhenAlreadyStarted)
+		return mockRun, nil
+	})
+
+	resp, err := s.handler.RebuildVisibility(context.Background(), &adminservice.RebuildVisibilityRequest{
+		Namespace: s.namespace.String(),
+		Rps:       20,
+		PageSize:  50,
+	})
+	s.NoError(err)
+	s.Equal(&adminservice.RebuildVisibilityResponse{WorkflowId: workflowID, RunId: "test-run-id"}, resp)
+}
+
+func (s *adminHandlerSuite) TestDescribeVisibilityRebuild() {
+	workflowID := rebuildvisibility.WorkflowIDPrefix + s.namespace.String()
+	startTime := time.Now().UTC()
+	mockSdkClient := mocksdk.NewMockClient(s.controller)
+	s.mockResource.SDKClientFactory.EXPECT().GetSystemClient().Return(mockSdkClient)
+	mockSdkClient.EXPECT().DescribeWorkflowExecution(gomock.Any(), workflowID, "").Return(&workflowservice.DescribeWorkflowExecutionResponse{
+		WorkflowExecutionInfo: &workflowpb.WorkflowExecutionInfo{
+			Execution: &commonpb.WorkflowExecution{WorkflowId: workflowID, RunId: "test-run-id"},
+			Status:    enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING,
+		},
+	}, nil)
+	mockValue := mocksdk.NewMockEncodedValue(s.controller)
+	mockValue.EXPECT().Get(gomock.Any()).Do(func(result interface{}) {
+		*(result.(*rebuildvisibility.RebuildVisibilityProgress)) = rebuildvisibility.RebuildVisibilityProgress{
+			TotalShards:       4,
+			CompletedShards:   2,
+			ScannedExecutions: 10,
+			RebuiltExecutions: 9,
+			FailedExecutions:  1,
+			StartTime:         startTime,
+		}
+	})
+	mockSdkClient.EXPECT().QueryWorkflow(gomock.Any(), workflowID, "test-run-id", rebuildvisibility.ProgressQueryType).Return(mockValue, nil)
+
+	resp, err := s.handler.DescribeVisibilityRebuild(context.Background(), &adminservice.DescribeVisibilityRebuildRequest{
+		Namespace: s.namespace.String(),
+	})
+	s.NoError(err)
+	s.ProtoEqual(&adminservice.DescribeVisibilityRebuildResponse{
+		WorkflowId:        workflowID,
+		RunId:             "test-run-id",
+		Status:            enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING,
+		TotalShards:       4,
+		CompletedShards:   2,
+		ScannedExecutions: 10,
+		RebuiltExecutions: 9,
+		FailedExecutions:  1,
+		StartTime:         timestamppb.New(startTime),
+	}, resp)
+}
+
+func (s *adminHandlerSuite) TestExplainVisibilityQuery() {
+	s.mockNamespaceCache.EXPECT().GetNamespace(s.namespace).Return(s.namespaceEntry, nil)
+	s.mockVisibilityMgr.EXPECT().ExplainQuery(gomock.Any(), &manager.ExplainQueryRequest{
+		NamespaceID: s.namespaceEntry.ID(),
+		Namespace:   s.namespaceEntry.Name(),
+		Query:       "WorkflowType = 'test-type'",
+		PageSize:    10,
+		IncludePlan: true,
+	}).Return(&manager.ExplainQueryResponse{
+		StoreName: "mysql",
+		Query:     "SELECT * FROM executions_visibility WHERE namespace_id = ? AND workflow_type_name = ?",
+		QueryArgs: []any{s.namespaceEntry.ID().String(), "test-type"},
+		Plan:      []string{"-> Index lookup on executions_visibility"},
+	}, nil)
+
+	resp, err := s.handler.ExplainVisibilityQuery(context.Background(), &adminservice.ExplainVisibilityQueryRequest{
+		Namespace:   s.namespace.String(),
+		Query:       "WorkflowType = 'test-type'",
+		PageSize:    10,
+		IncludePlan: true,
+	})
+	s.NoError(err)
+	s.ProtoEqual(&adminservice.ExplainVisibilityQueryResponse{
+		StoreName: "mysql",
+		Query:     "SELECT * FROM executions_visibility WHERE namespace_id = ? AND workflow_type_name = ?",
+		QueryArgs: []string{s.namespaceEntry.ID().String(), "test-type"},
+		Plan:      []string{"-> Index lookup on executions_visibility"},
+	}, resp)
+}
+
+func (s *adminHandlerSuite) TestAdvanceNamespaceClock() {
+	_, err := s.handler.AdvanceNamespaceClock(context.Background(), &adminservice.AdvanceNamespaceClockRequest{
+		Namespace: s.namespace.String(),
+		Duration:  durationpb.New(-time.Hour),
+	})
+	var invalidArgument *serviceerror.InvalidArgument
+	s.ErrorAs(err, &invalidArgument)
+
+	s.mockMetadata.EXPECT().IsGlobalNamespaceEnabled().Return(true).AnyTimes()
+	s.mockMetadata.EXPECT().GetAllClusterInfo().Return(map[string]cluster.ClusterInformation{
+		"active":  {Enabled: true},
+		"standby": {Enabled: true},
+	}).AnyTimes()
+	s.mockResource.MetadataMgr.EXPECT().GetMetadata(gomock.Any()).Return(&persistence.GetMetadataResponse{NotificationVersion: 7}, nil)
+	// The namespace is read to compute the new offset, and again by the namespace update.
+	s.mockResource.MetadataMgr.EXPECT().GetNamespace(gomock.Any(), &persistence.GetNamespaceRequest{Name: s.namespace.String()}).DoAndReturn(
+		func(_ context.Context, _ *persistence.GetNamespaceRequest) (*persistence.GetNamespaceResponse, error) {
+			return &persistence.GetNamespaceResponse{
+				Namespace: &persistencespb.NamespaceDetail{
+					Info: &persistencespb.NamespaceInfo{
+						Id:   s.namespaceID.String(),
+						Name: s.namespace.String(),
+						Data: map[string]string{namespace.VirtualClockOffsetDataKey: "1h", "owner": "test"},
+					},
+					Config: &persistencespb.NamespaceConfig{},
+					ReplicationConfig: &persistencespb.NamespaceReplicationConfig{
+						ActiveClusterName: "active",
+						Clusters:          []string{"active", "standby"},
+					},
+					ConfigVersion: 3,
+				},
+				IsGlobalNamespace: true,
+			}, nil
+		}).Times(2)
+	s.mockResource.MetadataMgr.EXPECT().UpdateNamespace(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *persistence.UpdateNamespaceRequest) error {
+			s.EqualValues(7, request.NotificationVersion)
+			s.EqualValues(4, request.Namespace.ConfigVersion)
+			s.Equal(map[string]string{namespace.VirtualClockOffsetDataKey: "25h0m0s", "owner": "test"}, request.Namespace.Info.Data)
+			return nil
+		})
+	s.mockProducer.EXPECT().Publish(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, task *replicationspb.ReplicationTask) error {
+			s.Equal("25h0m0s", task.GetNamespaceTaskAttributes().GetInfo().GetData()[namespace.VirtualClockOffsetDataKey])
+			return nil
+		})
+
+	resp, err := s.handler.AdvanceNamespaceClock(context.Background(), &adminservice.AdvanceNamespaceClockRequest{
+		Namespace: s.namespace.String(),
+		Duration:  durationpb.New(24 * time.Hour),
+	})
+	s.NoError(err)
+	s.Equal(25*time.Hour, resp.GetOffset().AsDuration())
+}
+
+func (s *adminHandlerSuite) TestPauseWorkflowExecution() {
+	execution := &commonpb.WorkflowExecution{WorkflowId: "test-workflow-id", RunId: uuid.New()}
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(s.namespace).Return(s.namespaceID, nil).Times(2)
+	s.mockHistoryClient.EXPECT().PauseWorkflowExecution(gomock.Any(), &historyservice.PauseWorkflowExecutionRequest{
+		NamespaceId: s.namespaceID.String(),
+		Execution:   execution,
+	}).Return(&historyservice.PauseWorkflowExecutionResponse{}, nil)
+	s.mockHistoryClient.EXPECT().UnpauseWorkflowExecution(gomock.Any(), &historyservice.UnpauseWorkflowExecutionRequest{
+		NamespaceId: s.namespaceID.String(),
+		Execution:   execution,
+	}).Return(&historyservice.UnpauseWorkflowExecutionResponse{}, nil)
+
+	_, err := s.handler.PauseWorkflowExecution(context.Background(), &adminservice.PauseWorkflowExecutionRequest{
+		Namespace: s.namespace.String(),
+		Execution: execution,
+	})
+	s.NoError(err)
+	_, err = s.handler.UnpauseWorkflowExecution(context.Background(), &adminservice.UnpauseWorkflowExecutionRequest{
+		Namespace: s.namespace.String(),
+		Execution: execution,
+	})
+	s.NoError(err)
+
+	_, err = s.handler.PauseWorkflowExecution(context.Background(), &adminservice.PauseWorkflowExecutionRequest{
+		Namespace: s.namespace.String(),
+	})
+	s.ErrorIs(err, errExecutionNotSet)
+}
+
+func (s *adminHandlerSuite) TestBatchPauseWorkflowExecutions() {
+	_, err := s.handler.BatchPauseWorkflowExecutions(context.Background(), &adminservice.BatchPauseWorkflowExecutionsRequest{
+		Namespace:       s.namespace.String(),
+		JobId:           "test-job-id",
+		VisibilityQuery: "WorkflowType='test'",
+	})
+	s.ErrorIs(err, errReasonNotSet)
+
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(s.namespace).Return(s.namespaceID, nil)
+	s.mockHistoryClient.EXPECT().StartWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *historyservice.StartWorkflowExecutionRequest, _ ...grpc.CallOption) (*historyservice.StartWorkflowExecutionResponse, error) {
+			s.Equal(s.namespaceID.String(), request.GetNamespaceId())
+			startRequest := request.GetStartRequest()
+			s.Equal("test-job-id", startRequest.GetWorkflowId())
+			s.Equal(batcher.BatchWFTypeName, startRequest.GetWorkflowType().GetName())
+			var params batcher.BatchParams
+			s.NoError(sdk.PreferProtoDataConverter.FromPayloads(startRequest.GetInput(), &params))
+			s.Equal(batcher.BatchTypeUnpauseWorkflow, params.BatchType)
+			s.Equal("WorkflowType='test'", params.Query)
+			s.Equal("test-reason", params.Reason)
+			return &historyservice.StartWorkflowExecutionResponse{}, nil
+		})
+
+	_, err = s.handler.BatchPauseWorkflowExecutions(context.Background(), &adminservice.BatchPauseWorkflowExecutionsRequest{
+		Namespace:       s.namespace.String(),
+		JobId:           "test-job-id",
+		VisibilityQuery: "WorkflowType='test'",
+		Reason:          "test-reason",
+		Unpause:         true,
+	})
+	s.NoError(err)
+}
+
+func (s *adminHandlerSuite) TestForkWorkflowExecution() {
+	sourceExecution := &commonpb.WorkflowExecution{WorkflowId: "source-workflow-id", RunId: uuid.New()}
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(s.namespace).Return(s.namespaceID, nil)
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(namespace.Name("source-namespace")).Return(namespace.ID("source-namespace-id"), nil)
+	s.mockHistoryClient.EXPECT().ForkWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *historyservice.ForkWorkflowExecutionRequest, _ ...grpc.CallOption) (*historyservice.ForkWorkflowExecutionResponse, error) {
+			s.Equal(s.namespaceID.String(), request.GetNamespaceId())
+			s.Equal("fork-workflow-id", request.GetWorkflowId())
+			s.Equal("source-namespace-id", request.GetSourceNamespaceId())
+			s.ProtoEqual(sourceExecution, request.GetSourceExecution())
+			s.EqualValues(5, request.GetForkEventId())
+			s.Equal("fork-task-queue", request.GetTaskQueue())
+			s.NotEmpty(request.GetRequestId())
+			return &historyservice.ForkWorkflowExecutionResponse{RunId: "fork-run-id"}, nil
+		})
+
+	resp, err := s.handler.ForkWorkflowExecution(context.Background(), &adminservice.ForkWorkflowExecutionRequest{
+		Namespace:       s.namespace.String(),
+		WorkflowId:      "fork-workflow-id",
+		SourceNamespace: "source-namespace",
+		SourceExecution: sourceExecution,
+		ForkEventId:     5,
+		TaskQueue:       "fork-task-queue",
+	})
+	s.NoError(err)
+	s.Equal("fork-run-id", resp.GetRunId())
+
+	_, err = s.handler.ForkWorkflowExecution(context.Background(), &adminservice.ForkWorkflowExecutionRequest{
+		Namespace:       s.namespace.String(),
+		SourceExecution: sourceExecution,
+	})
+	s.ErrorIs(err, errWorkflowIDNotSet)
+}
+
+func (s *adminHandlerSuite) TestPreviewResetWorkflowExecution() {
+	execution := &commonpb.WorkflowExecution{WorkflowId: "test-workflow-id", RunId: uuid.New()}
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(s.namespace).Return(s.namespaceID, nil)
+	s.mockHistoryClient.EXPECT().PreviewResetWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *historyservice.PreviewResetWorkflowExecutionRequest, _ ...grpc.CallOption) (*historyservice.PreviewResetWorkflowExecutionResponse, error) {
+			s.Equal(s.namespaceID.String(), request.GetNamespaceId())
+			s.ProtoEqual(execution, request.GetResetRequest().GetWorkflowExecution())
+			s.EqualValues(4, request.GetResetRequest().GetWorkflowTaskFinishEventId())
+			s.Equal([]enumspb.ResetReapplyExcludeType{enumspb.RESET_REAPPLY_EXCLUDE_TYPE_SIGNAL}, request.GetResetRequest().GetResetReapplyExcludeTypes())
+			return &historyservice.PreviewResetWorkflowExecutionResponse{
+				DroppedEvents:       []*historypb.HistoryEvent{{EventId: 5, EventType: enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_SIGNALED}},
+				AbandonedActivities: []*persistencespb.ActivityInfo{{ScheduledEventId: 3}},
+			}, nil
+		})
+
+	resp, err := s.handler.PreviewResetWorkflowExecution(context.Background(), &adminservice.PreviewResetWorkflowExecutionRequest{
+		Namespace:                 s.namespace.String(),
+		Execution:                 execution,
+		WorkflowTaskFinishEventId: 4,
+		ResetReapplyExcludeTypes:  []enumspb.ResetReapplyExcludeType{enumspb.RESET_REAPPLY_EXCLUDE_TYPE_SIGNAL},
+	})
+	s.NoError(err)
+	s.Len(resp.GetDroppedEvents(), 1)
+	s.EqualValues(5, resp.GetDroppedEvents()[0].GetEventId())
+	s.Len(resp.GetAbandonedActivities(), 1)
+	s.Empty(resp.GetReappliedEvents())
+}
+
 func (s *adminHandlerSuite) TestDescribeTaskQueuePartition() {
 	handler := s.handler
 	ctx := context.Background()
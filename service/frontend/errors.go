@@ -86,6 +86,8 @@ var (
 	errSourceClusterNotSet    = serviceerror.NewInvalidArgument("SourceCluster is not set on request.")
 	errTargetClusterNotSet    = serviceerror.NewInvalidArgument("TargetCluster is not set on request.")
 	errInvalidDLQJobToken     = serviceerror.NewInvalidArgument("Invalid DLQ job token.")
+	errInvalidClockDuration   = serviceerror.NewInvalidArgument("Duration must be positive.")
+	errVirtualClockDisabled   = serviceerror.NewFailedPrecondition("Virtual clock is not enabled for the namespace.")
 
 	errPageSizeTooBigMessage = "PageSize is larger than allowed %d."
 
@@ -29,6 +29,7 @@ import (
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/primitives"
 	"go.temporal.io/server/common/primitives/timestamp"
+	"go.temporal.io/server/common/searchattribute"
 	"go.temporal.io/server/common/util"
 	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
@@ -923,15 +924,19 @@ func (d *namespaceHandler) mergeNamespaceData(
 	return old
 }
 
+// upsertCustomSearchAttributesAliases allocates the fields of upsert which are not allocated yet and frees the fields
+// with an empty alias. A field can be re-allocated only if its current or new alias is reserved: reserved aliases are
+// set by system workflows, e.g. to hold a field while the type of a search attribute is changed.
 func (d *namespaceHandler) upsertCustomSearchAttributesAliases(
 	current map[string]string,
 	upsert map[string]string,
 ) (map[string]string, error) {
 	result := util.CloneMapNonNil(current)
 	for key, value := range upsert {
+		currentValue, ok := current[key]
 		if value == "" {
 			delete(result, key)
-		} else if _, ok := current[key]; !ok {
+		} else if !ok || searchattribute.IsReserved(currentValue) || searchattribute.IsReserved(value) {
 			result[key] = value
 		} else {
 			return nil, errCustomSearchAttributeFieldAlreadyAllocated
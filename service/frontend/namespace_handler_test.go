@@ -163,6 +163,30 @@ func (s *namespaceHandlerCommonSuite) TestMergeNamespaceData_Nil() {
 	}, out)
 }
 
+func (s *namespaceHandlerCommonSuite) TestUpsertCustomSearchAttributesAliases() {
+	current := map[string]string{
+		"Keyword01": "CustomKeyword",
+		"Int01":     "CustomInt",
+		"Text01":    "TemporalNewFieldOfCustomKeyword",
+	}
+
+	_, err := s.handler.upsertCustomSearchAttributesAliases(current, map[string]string{"Keyword01": "OtherKeyword"})
+	s.ErrorIs(err, errCustomSearchAttributeFieldAlreadyAllocated)
+
+	out, err := s.handler.upsertCustomSearchAttributesAliases(current, map[string]string{
+		"Text01":    "CustomKeyword",
+		"Keyword01": "TemporalOldFieldOfCustomKeyword",
+		"Int01":     "",
+		"Double01":  "CustomDouble",
+	})
+	s.NoError(err)
+	s.Equal(map[string]string{
+		"Keyword01": "TemporalOldFieldOfCustomKeyword",
+		"Text01":    "CustomKeyword",
+		"Double01":  "CustomDouble",
+	}, out)
+}
+
 // test merging bad binaries
 func (s *namespaceHandlerCommonSuite) TestMergeBadBinaries_Overriding() {
 	out := s.handler.mergeBadBinaries(
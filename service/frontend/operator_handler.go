@@ -34,6 +34,7 @@ import (
 	"go.temporal.io/server/common/resource"
 	"go.temporal.io/server/common/sdk"
 	"go.temporal.io/server/common/searchattribute"
+	"go.temporal.io/server/common/shardmap"
 	"go.temporal.io/server/common/util"
 	"go.temporal.io/server/service/worker/addsearchattributes"
 	"go.temporal.io/server/service/worker/deletenamespace"
@@ -68,6 +69,7 @@ type (
 		clientFactory          svc.Factory
 		namespaceRegistry      namespace.Registry
 		nexusEndpointClient    *NexusEndpointClient
+		shardMapProvider       *shardmap.Provider
 	}
 
 	NewOperatorHandlerImplArgs struct {
@@ -85,6 +87,7 @@ type (
 		clientFactory          svc.Factory
 		namespaceRegistry      namespace.Registry
 		nexusEndpointClient    *NexusEndpointClient
+		shardMapProvider       *shardmap.Provider
 	}
 )
 
@@ -115,6 +118,7 @@ func NewOperatorHandlerImpl(
 		clientFactory:          args.clientFactory,
 		namespaceRegistry:      args.namespaceRegistry,
 		nexusEndpointClient:    args.nexusEndpointClient,
+		shardMapProvider:       args.shardMapProvider,
 	}
 
 	return handler
@@ -775,10 +779,11 @@ func (h *OperatorHandlerImpl) validateRemoteClusterMetadata(metadata *adminservi
 		// failover version increment is mismatch with current cluster config
 		return serviceerror.NewInvalidArgument("Cannot add remote cluster due to failover version increment mismatch")
 	}
-	if metadata.GetHistoryShardCount() != h.config.NumHistoryShards {
-		remoteShardCount := metadata.GetHistoryShardCount()
+	// The shard counts change when the shards of a cluster are split.
+	remoteShardCount := shardmap.ClusterShardMap(metadata.GetHistoryShardCount(), metadata.GetTags()).TotalShardCount()
+	if localShardCount := h.shardMapProvider.TotalShardCount(); remoteShardCount != localShardCount {
 		large := remoteShardCount
-		small := h.config.NumHistoryShards
+		small := localShardCount
 		if large < small {
 			small, large = large, small
 		}
@@ -20,6 +20,8 @@ import (
 	persistencespb "go.temporal.io/server/api/persistence/v1"
 	"go.temporal.io/server/common/cluster"
 	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/persistence/sql/sqlplugin/mysql"
 	"go.temporal.io/server/common/persistence/visibility"
@@ -28,6 +30,7 @@ import (
 	"go.temporal.io/server/common/primitives"
 	"go.temporal.io/server/common/resourcetest"
 	"go.temporal.io/server/common/searchattribute"
+	"go.temporal.io/server/common/shardmap"
 	"go.temporal.io/server/common/testing/mocksdk"
 	"go.temporal.io/server/service/worker/addsearchattributes"
 	"go.temporal.io/server/service/worker/deletenamespace"
@@ -91,6 +94,7 @@ func (s *operatorHandlerSuite) SetupTest() {
 		s.mockResource.GetClientFactory(),
 		s.mockResource.NamespaceCache,
 		endpointClient,
+		shardmap.NewProvider(4),
 	}
 	s.handler = NewOperatorHandlerImpl(args)
 	s.handler.Start()
@@ -219,6 +223,9 @@ func (s *operatorHandlerSuite) Test_AddSearchAttributes_DualVisibility() {
 		mockVisManager2,
 		mockManagerSelector,
 		dynamicconfig.GetBoolPropertyFn(false),
+		dynamicconfig.GetFloatPropertyFnFilteredByNamespace(0),
+		metrics.NoopMetricsHandler,
+		log.NewNoopLogger(),
 	)
 	s.handler.visibilityMgr = mockDualVisManager
 
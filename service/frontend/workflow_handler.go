@@ -29,6 +29,7 @@ import (
 	"go.temporal.io/server/api/matchingservice/v1"
 	schedulespb "go.temporal.io/server/api/schedule/v1"
 	taskqueuespb "go.temporal.io/server/api/taskqueue/v1"
+	tokenspb "go.temporal.io/server/api/token/v1"
 	"go.temporal.io/server/client/frontend"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/archiver"
@@ -957,6 +958,16 @@ func (wh *WorkflowHandler) RespondWorkflowTaskCompleted(
 
 	wh.overrides.DisableEagerActivityDispatchForBuggyClients(ctx, request)
 
+	// history validates the token, here it's only needed to release the task
+	if taskToken, err := wh.tokenSerializer.Deserialize(request.TaskToken); err == nil {
+		defer func() {
+			// the worker retries the completion on a transient error, the task is still in flight until then
+			if retError == nil || !common.IsServiceClientTransientError(retError) {
+				wh.releaseWorkerInFlightTask(ctx, taskToken, enumspb.TASK_QUEUE_TYPE_WORKFLOW)
+			}
+		}()
+	}
+
 	namespaceID, err := wh.namespaceRegistry.GetNamespaceID(namespace.Name(request.GetNamespace()))
 	if err != nil {
 		return nil, err
@@ -998,6 +1009,7 @@ func (wh *WorkflowHandler) RespondWorkflowTaskFailed(
 	if err != nil {
 		return nil, errDeserializingToken
 	}
+	defer wh.releaseWorkerInFlightTask(ctx, taskToken, enumspb.TASK_QUEUE_TYPE_WORKFLOW)
 	namespaceId := namespace.ID(taskToken.GetNamespaceId())
 	namespaceEntry, err := wh.namespaceRegistry.GetNamespaceByID(namespaceId)
 	if err != nil {
@@ -1339,6 +1351,7 @@ func (wh *WorkflowHandler) RespondActivityTaskCompleted(
 	if err != nil {
 		return nil, errDeserializingToken
 	}
+	defer wh.releaseWorkerInFlightTask(ctx, taskToken, enumspb.TASK_QUEUE_TYPE_ACTIVITY)
 	namespaceId := namespace.ID(taskToken.GetNamespaceId())
 	namespaceEntry, err := wh.namespaceRegistry.GetNamespaceByID(namespaceId)
 	if err != nil {
@@ -1461,13 +1474,14 @@ func (wh *WorkflowHandler) RespondActivityTaskCompletedById(ctx context.Context,
 			Failure:   failure.NewServerFailure(common.FailureReasonCompleteResultExceedsLimit, true),
 			Identity:  request.Identity,
 		}
-		_, err = wh.historyClient.RespondActivityTaskFailed(ctx, &historyservice.RespondActivityTaskFailedRequest{
+		historyResp, err := wh.historyClient.RespondActivityTaskFailed(ctx, &historyservice.RespondActivityTaskFailedRequest{
 			NamespaceId:   namespaceID.String(),
 			FailedRequest: failRequest,
 		})
 		if err != nil {
 			return nil, err
 		}
+		wh.releaseActivityInFlightTaskByID(ctx, taskToken, historyResp)
 	} else {
 		req := &workflowservice.RespondActivityTaskCompletedRequest{
 			TaskToken: token,
@@ -1475,13 +1489,14 @@ func (wh *WorkflowHandler) RespondActivityTaskCompletedById(ctx context.Context,
 			Identity:  request.Identity,
 		}
 
-		_, err = wh.historyClient.RespondActivityTaskCompleted(ctx, &historyservice.RespondActivityTaskCompletedRequest{
+		historyResp, err := wh.historyClient.RespondActivityTaskCompleted(ctx, &historyservice.RespondActivityTaskCompletedRequest{
 			NamespaceId:     namespaceID.String(),
 			CompleteRequest: req,
 		})
 		if err != nil {
 			return nil, err
 		}
+		wh.releaseActivityInFlightTaskByID(ctx, taskToken, historyResp)
 	}
 
 	return &workflowservice.RespondActivityTaskCompletedByIdResponse{}, nil
@@ -1507,6 +1522,7 @@ func (wh *WorkflowHandler) RespondActivityTaskFailed(
 	if err != nil {
 		return nil, errDeserializingToken
 	}
+	defer wh.releaseWorkerInFlightTask(ctx, taskToken, enumspb.TASK_QUEUE_TYPE_ACTIVITY)
 	namespaceID := namespace.ID(taskToken.GetNamespaceId())
 	namespaceEntry, err := wh.namespaceRegistry.GetNamespaceByID(namespaceID)
 	if err != nil {
@@ -1674,13 +1690,14 @@ func (wh *WorkflowHandler) RespondActivityTaskFailedById(ctx context.Context, re
 		Identity:  request.Identity,
 	}
 
-	_, err = wh.historyClient.RespondActivityTaskFailed(ctx, &historyservice.RespondActivityTaskFailedRequest{
+	historyResp, err := wh.historyClient.RespondActivityTaskFailed(ctx, &historyservice.RespondActivityTaskFailedRequest{
 		NamespaceId:   namespaceID.String(),
 		FailedRequest: req,
 	})
 	if err != nil {
 		return nil, err
 	}
+	wh.releaseActivityInFlightTaskByID(ctx, taskToken, historyResp)
 	return &response, nil
 }
 
@@ -1700,6 +1717,7 @@ func (wh *WorkflowHandler) RespondActivityTaskCanceled(ctx context.Context, requ
 	if err != nil {
 		return nil, errDeserializingToken
 	}
+	defer wh.releaseWorkerInFlightTask(ctx, taskToken, enumspb.TASK_QUEUE_TYPE_ACTIVITY)
 	namespaceID := namespace.ID(taskToken.GetNamespaceId())
 	namespaceEntry, err := wh.namespaceRegistry.GetNamespaceByID(namespaceID)
 	if err != nil {
@@ -1821,13 +1839,14 @@ func (wh *WorkflowHandler) RespondActivityTaskCanceledById(ctx context.Context,
 			Failure:   failure.NewServerFailure(common.FailureReasonCancelDetailsExceedsLimit, true),
 			Identity:  request.Identity,
 		}
-		_, err = wh.historyClient.RespondActivityTaskFailed(ctx, &historyservice.RespondActivityTaskFailedRequest{
+		historyResp, err := wh.historyClient.RespondActivityTaskFailed(ctx, &historyservice.RespondActivityTaskFailedRequest{
 			NamespaceId:   namespaceID.String(),
 			FailedRequest: failRequest,
 		})
 		if err != nil {
 			return nil, err
 		}
+		wh.releaseActivityInFlightTaskByID(ctx, taskToken, historyResp)
 	} else {
 		req := &workflowservice.RespondActivityTaskCanceledRequest{
 			TaskToken: token,
@@ -1835,13 +1854,14 @@ func (wh *WorkflowHandler) RespondActivityTaskCanceledById(ctx context.Context,
 			Identity:  request.Identity,
 		}
 
-		_, err = wh.historyClient.RespondActivityTaskCanceled(ctx, &historyservice.RespondActivityTaskCanceledRequest{
+		historyResp, err := wh.historyClient.RespondActivityTaskCanceled(ctx, &historyservice.RespondActivityTaskCanceledRequest{
 			NamespaceId:   namespaceID.String(),
 			CancelRequest: req,
 		})
 		if err != nil {
 			return nil, err
 		}
+		wh.releaseActivityInFlightTaskByID(ctx, taskToken, historyResp)
 	}
 
 	return &workflowservice.RespondActivityTaskCanceledByIdResponse{}, nil
@@ -4613,6 +4633,33 @@ func (wh *WorkflowHandler) StartBatchOperation(
 		UpdateOptionsParams:     updateOptionsParams,
 		UnpauseActivitiesParams: unpauseActivitiesParams,
 	}
+	startReq, err := newBatchOperationStartRequest(request.GetNamespace(), request.GetJobId(), identity, input)
+	if err != nil {
+		return nil, err
+	}
+	_, err = wh.historyClient.StartWorkflowExecution(
+		ctx,
+		common.CreateHistoryStartWorkflowRequest(
+			namespaceID.String(),
+			startReq,
+			nil,
+			nil,
+			time.Now().UTC(),
+		),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &workflowservice.StartBatchOperationResponse{}, nil
+}
+
+// newBatchOperationStartRequest returns the request starting the batcher workflow running a batch operation.
+func newBatchOperationStartRequest(
+	namespaceName string,
+	jobID string,
+	identity string,
+	input *batcher.BatchParams,
+) (*workflowservice.StartWorkflowExecutionRequest, error) {
 	inputPayload, err := sdk.PreferProtoDataConverter.ToPayloads(input)
 	if err != nil {
 		return nil, err
@@ -4620,8 +4667,8 @@ func (wh *WorkflowHandler) StartBatchOperation(
 
 	memo := &commonpb.Memo{
 		Fields: map[string]*commonpb.Payload{
-			batcher.BatchOperationTypeMemo: payload.EncodeString(operationType),
-			batcher.BatchReasonMemo:        payload.EncodeString(request.GetReason()),
+			batcher.BatchOperationTypeMemo: payload.EncodeString(input.BatchType),
+			batcher.BatchReasonMemo:        payload.EncodeString(input.Reason),
 		},
 	}
 
@@ -4630,9 +4677,9 @@ func (wh *WorkflowHandler) StartBatchOperation(
 	searchattribute.AddSearchAttribute(&searchAttributes, searchattribute.BatcherUser, payload.EncodeString(identity))
 	searchattribute.AddSearchAttribute(&searchAttributes, searchattribute.TemporalNamespaceDivision, payload.EncodeString(batcher.NamespaceDivision))
 
-	startReq := &workflowservice.StartWorkflowExecutionRequest{
-		Namespace:                request.Namespace,
-		WorkflowId:               request.GetJobId(),
+	return &workflowservice.StartWorkflowExecutionRequest{
+		Namespace:                namespaceName,
+		WorkflowId:               jobID,
 		WorkflowType:             &commonpb.WorkflowType{Name: batcher.BatchWFTypeName},
 		TaskQueue:                &taskqueuepb.TaskQueue{Name: primitives.PerNSWorkerTaskQueue},
 		Input:                    inputPayload,
@@ -4643,22 +4690,7 @@ func (wh *WorkflowHandler) StartBatchOperation(
 		Memo:                     memo,
 		SearchAttributes:         searchAttributes,
 		Priority:                 &commonpb.Priority{}, // ie default priority
-	}
-
-	_, err = wh.historyClient.StartWorkflowExecution(
-		ctx,
-		common.CreateHistoryStartWorkflowRequest(
-			namespaceID.String(),
-			startReq,
-			nil,
-			nil,
-			time.Now().UTC(),
-		),
-	)
-	if err != nil {
-		return nil, err
-	}
-	return &workflowservice.StartBatchOperationResponse{}, nil
+	}, nil
 }
 
 func (wh *WorkflowHandler) StopBatchOperation(
@@ -5447,6 +5479,53 @@ func (wh *WorkflowHandler) cancelOutstandingPoll(
 	return true
 }
 
+// releaseWorkerInFlightTask tells matching that the worker responded to a task counted against
+// the max in-flight of its identity or build ID. Matching expires the tasks it doesn't hear
+// about, so this is best effort.
+func (wh *WorkflowHandler) releaseWorkerInFlightTask(
+	ctx context.Context,
+	taskToken *tokenspb.Task,
+	taskQueueType enumspb.TaskQueueType,
+) {
+	if taskToken.GetInFlightId() == "" {
+		return
+	}
+	_, err := wh.matchingClient.ReleaseWorkerInFlightTask(
+		rpc.CopyContextValues(context.TODO(), ctx),
+		&matchingservice.ReleaseWorkerInFlightTaskRequest{
+			NamespaceId:   taskToken.GetNamespaceId(),
+			TaskQueue:     taskToken.GetInFlightTaskQueue(),
+			TaskQueueType: taskQueueType,
+			InFlightId:    taskToken.GetInFlightId(),
+		},
+	)
+	if err != nil {
+		wh.throttledLogger.Warn("Failed to release worker in-flight task.",
+			tag.WorkflowTaskQueueName(taskToken.GetInFlightTaskQueue().GetName()), tag.Error(err))
+	}
+}
+
+// releaseActivityInFlightTaskByID releases the in-flight task of an activity completed by ID. The task token built
+// from the IDs doesn't carry the in-flight task, history returns the one recorded when the activity started.
+func (wh *WorkflowHandler) releaseActivityInFlightTaskByID(
+	ctx context.Context,
+	taskToken *tokenspb.Task,
+	historyResp interface {
+		GetInFlightTaskQueue() string
+		GetInFlightId() string
+	},
+) {
+	if historyResp.GetInFlightId() == "" {
+		return
+	}
+	taskToken.InFlightTaskQueue = &taskqueuepb.TaskQueue{
+		Name: historyResp.GetInFlightTaskQueue(),
+		Kind: enumspb.TASK_QUEUE_KIND_NORMAL,
+	}
+	taskToken.InFlightId = historyResp.GetInFlightId()
+	wh.releaseWorkerInFlightTask(ctx, taskToken, enumspb.TASK_QUEUE_TYPE_ACTIVITY)
+}
+
 func (wh *WorkflowHandler) registerOutstandingPollContext(
 	ctx context.Context,
 	pollerID string,
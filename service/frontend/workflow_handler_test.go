@@ -3522,6 +3522,110 @@ func (s *WorkflowHandlerSuite) TestExecuteMultiOperation() {
 	})
 }
 
+func (s *WorkflowHandlerSuite) TestRespondActivityTaskCompleted_ReleasesWorkerInFlightTask() {
+	wh := s.getWorkflowHandler(s.newConfig())
+	ctx := context.Background()
+
+	taskQueue := &taskqueuepb.TaskQueue{Name: "tq", Kind: enumspb.TASK_QUEUE_KIND_NORMAL}
+	taskToken := tasktoken.NewActivityTaskToken(
+		s.testNamespaceID.String(), "wf", "run", 5, "activity", "type", 1, nil, 0,
+	)
+	taskToken.InFlightTaskQueue = taskQueue
+	taskToken.InFlightId = "in-flight-id"
+	serializedToken, err := s.tokenSerializer.Serialize(taskToken)
+	s.NoError(err)
+
+	namespaceEntry := namespace.NewLocalNamespaceForTest(
+		&persistencespb.NamespaceInfo{Id: s.testNamespaceID.String(), Name: s.testNamespace.String()},
+		&persistencespb.NamespaceConfig{},
+		"",
+	)
+	s.mockNamespaceCache.EXPECT().GetNamespaceByID(s.testNamespaceID).Return(namespaceEntry, nil).AnyTimes()
+	s.mockHistoryClient.EXPECT().RespondActivityTaskCompleted(gomock.Any(), gomock.Any()).Return(&historyservice.RespondActivityTaskCompletedResponse{}, nil)
+	s.mockMatchingClient.EXPECT().ReleaseWorkerInFlightTask(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *matchingservice.ReleaseWorkerInFlightTaskRequest, _ ...grpc.CallOption) (*matchingservice.ReleaseWorkerInFlightTaskResponse, error) {
+			s.Equal(s.testNamespaceID.String(), request.GetNamespaceId())
+			s.Equal("tq", request.GetTaskQueue().GetName())
+			s.Equal(enumspb.TASK_QUEUE_TYPE_ACTIVITY, request.GetTaskQueueType())
+			s.Equal("in-flight-id", request.GetInFlightId())
+			return &matchingservice.ReleaseWorkerInFlightTaskResponse{}, nil
+		})
+
+	_, err = wh.RespondActivityTaskCompleted(ctx, &workflowservice.RespondActivityTaskCompletedRequest{
+		Namespace: s.testNamespace.String(),
+		TaskToken: serializedToken,
+		Identity:  "worker",
+	})
+	s.NoError(err)
+}
+
+func (s *WorkflowHandlerSuite) TestRespondActivityTaskCompletedById_ReleasesWorkerInFlightTask() {
+	wh := s.getWorkflowHandler(s.newConfig())
+	ctx := context.Background()
+
+	namespaceEntry := namespace.NewLocalNamespaceForTest(
+		&persistencespb.NamespaceInfo{Id: s.testNamespaceID.String(), Name: s.testNamespace.String()},
+		&persistencespb.NamespaceConfig{},
+		"",
+	)
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(s.testNamespace).Return(s.testNamespaceID, nil)
+	s.mockNamespaceCache.EXPECT().GetNamespaceByID(s.testNamespaceID).Return(namespaceEntry, nil).AnyTimes()
+	s.mockHistoryClient.EXPECT().RespondActivityTaskCompleted(gomock.Any(), gomock.Any()).Return(&historyservice.RespondActivityTaskCompletedResponse{
+		InFlightTaskQueue: "/_sys/tq/1",
+		InFlightId:        "in-flight-id",
+	}, nil)
+	s.mockMatchingClient.EXPECT().ReleaseWorkerInFlightTask(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *matchingservice.ReleaseWorkerInFlightTaskRequest, _ ...grpc.CallOption) (*matchingservice.ReleaseWorkerInFlightTaskResponse, error) {
+			s.Equal(s.testNamespaceID.String(), request.GetNamespaceId())
+			s.Equal("/_sys/tq/1", request.GetTaskQueue().GetName())
+			s.Equal(enumspb.TASK_QUEUE_TYPE_ACTIVITY, request.GetTaskQueueType())
+			s.Equal("in-flight-id", request.GetInFlightId())
+			return &matchingservice.ReleaseWorkerInFlightTaskResponse{}, nil
+		})
+
+	_, err := wh.RespondActivityTaskCompletedById(ctx, &workflowservice.RespondActivityTaskCompletedByIdRequest{
+		Namespace:  s.testNamespace.String(),
+		WorkflowId: "wf",
+		ActivityId: "activity",
+		Identity:   "worker",
+	})
+	s.NoError(err)
+}
+
+func (s *WorkflowHandlerSuite) TestRespondWorkflowTaskCompleted_KeepsWorkerInFlightTaskOnTransientError() {
+	wh := s.getWorkflowHandler(s.newConfig())
+	ctx := context.Background()
+
+	taskToken := tasktoken.NewWorkflowTaskToken(
+		s.testNamespaceID.String(), "wf", "run", 5, 6, nil, 1, nil, 0,
+	)
+	taskToken.InFlightTaskQueue = &taskqueuepb.TaskQueue{Name: "tq", Kind: enumspb.TASK_QUEUE_KIND_NORMAL}
+	taskToken.InFlightId = "in-flight-id"
+	serializedToken, err := s.tokenSerializer.Serialize(taskToken)
+	s.NoError(err)
+	request := &workflowservice.RespondWorkflowTaskCompletedRequest{
+		Namespace: s.testNamespace.String(),
+		TaskToken: serializedToken,
+		Identity:  "worker",
+	}
+
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(s.testNamespace).Return(s.testNamespaceID, nil).Times(2)
+	// the worker retries the completion, the task stays in flight
+	s.mockHistoryClient.EXPECT().RespondWorkflowTaskCompleted(gomock.Any(), gomock.Any()).Return(nil, serviceerror.NewUnavailable("unavailable"))
+	_, err = wh.RespondWorkflowTaskCompleted(ctx, request)
+	s.Error(err)
+
+	s.mockHistoryClient.EXPECT().RespondWorkflowTaskCompleted(gomock.Any(), gomock.Any()).Return(nil, serviceerror.NewNotFound("workflow task not found"))
+	s.mockMatchingClient.EXPECT().ReleaseWorkerInFlightTask(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *matchingservice.ReleaseWorkerInFlightTaskRequest, _ ...grpc.CallOption) (*matchingservice.ReleaseWorkerInFlightTaskResponse, error) {
+			s.Equal(enumspb.TASK_QUEUE_TYPE_WORKFLOW, request.GetTaskQueueType())
+			s.Equal("in-flight-id", request.GetInFlightId())
+			return &matchingservice.ReleaseWorkerInFlightTaskResponse{}, nil
+		})
+	_, err = wh.RespondWorkflowTaskCompleted(ctx, request)
+	s.Error(err)
+}
+
 func (s *WorkflowHandlerSuite) TestShutdownWorker() {
 	config := s.newConfig()
 	wh := s.getWorkflowHandler(config)
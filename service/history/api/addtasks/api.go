@@ -9,6 +9,7 @@ import (
 	"go.temporal.io/server/api/historyservice/v1"
 	"go.temporal.io/server/common/definition"
 	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/shardmap"
 	"go.temporal.io/server/service/history/api"
 	historyi "go.temporal.io/server/service/history/interfaces"
 	"go.temporal.io/server/service/history/tasks"
@@ -37,7 +38,7 @@ func Invoke(
 	ctx context.Context,
 	shardContext historyi.ShardContext,
 	deserializer TaskDeserializer,
-	numShards int,
+	shardMap *shardmap.ShardMap,
 	req *historyservice.AddTasksRequest,
 	taskRegistry tasks.TaskCategoryRegistry,
 ) (*historyservice.AddTasksResponse, error) {
@@ -77,8 +78,8 @@ func Invoke(
 			return nil, err
 		}
 
-		shardID := tasks.GetShardIDForTask(deserializedTask, numShards)
-		if shardID != int(req.ShardId) {
+		shardID := shardMap.WorkflowIDToHistoryShard(deserializedTask.GetNamespaceID(), deserializedTask.GetWorkflowID())
+		if shardID != req.ShardId {
 			return nil, serviceerror.NewInvalidArgument(fmt.Sprintf(
 				"Task is for wrong shard: index = %d, task shard = %d, request shard = %d",
 				i, shardID, req.ShardId,
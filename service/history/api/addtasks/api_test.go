@@ -14,6 +14,7 @@ import (
 	"go.temporal.io/server/common/definition"
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/persistence/serialization"
+	"go.temporal.io/server/common/shardmap"
 	"go.temporal.io/server/service/history/api/addtasks"
 	historyi "go.temporal.io/server/service/history/interfaces"
 	"go.temporal.io/server/service/history/tasks"
@@ -26,7 +27,7 @@ type (
 	testParams struct {
 		shardContext historyi.ShardContext
 		deserializer addtasks.TaskDeserializer
-		numShards    int
+		shardMap     *shardmap.ShardMap
 		req          *historyservice.AddTasksRequest
 		// expectation is invoked with the result of addtasks.Invoke.
 		expectation func(*historyservice.AddTasksResponse, error)
@@ -194,7 +195,7 @@ func TestInvoke(t *testing.T) {
 			name: "wrong shard",
 			configure: func(t *testing.T, params *testParams) {
 				params.req.ShardId = 1
-				params.numShards = 2
+				params.shardMap = shardmap.NewShardMap(2)
 				params.expectation = func(resp *historyservice.AddTasksResponse, err error) {
 					require.ErrorAs(t, err, new(*serviceerror.InvalidArgument))
 					assert.ErrorContains(t, err, "Task is for wrong shard")
@@ -224,7 +225,7 @@ func TestInvoke(t *testing.T) {
 				context.Background(),
 				params.shardContext,
 				params.deserializer,
-				params.numShards,
+				params.shardMap,
 				params.req,
 				tasks.NewDefaultTaskCategoryRegistry(),
 			)
@@ -246,7 +247,7 @@ func getDefaultTestParams(t *testing.T) *testParams {
 	shardContext.EXPECT().GetRangeID().Return(int64(1)).AnyTimes()
 	params := &testParams{
 		shardContext: shardContext,
-		numShards:    1,
+		shardMap:     shardmap.NewShardMap(1),
 		deserializer: serializer,
 		req: &historyservice.AddTasksRequest{
 			ShardId: 1,
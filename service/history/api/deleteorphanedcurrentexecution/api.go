@@ -0,0 +1,87 @@
+package deleteorphanedcurrentexecution
+
+import (
+	"context"
+
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/locks"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/service/history/api"
+	historyi "go.temporal.io/server/service/history/interfaces"
+)
+
+// Invoke deletes the current execution record of a workflow ID if it points to the run of the request and the run
+// doesn't exist. The current workflow lock is held while the record is checked and deleted, so a new run can't be
+// started in between, and the shard ownership is asserted before the record is deleted.
+func Invoke(
+	ctx context.Context,
+	request *historyservice.DeleteOrphanedCurrentExecutionRequest,
+	shardContext historyi.ShardContext,
+	workflowConsistencyChecker api.WorkflowConsistencyChecker,
+) (_ *historyservice.DeleteOrphanedCurrentExecutionResponse, retError error) {
+	namespaceID := namespace.ID(request.GetNamespaceId())
+	if err := api.ValidateNamespaceUUID(namespaceID); err != nil {
+		return nil, err
+	}
+	workflowID := request.GetExecution().GetWorkflowId()
+	runID := request.GetExecution().GetRunId()
+	if workflowID == "" || runID == "" {
+		return nil, serviceerror.NewInvalidArgument("workflow ID and run ID must be set")
+	}
+
+	currentRelease, err := workflowConsistencyChecker.GetWorkflowCache().GetOrCreateCurrentWorkflowExecution(
+		ctx,
+		shardContext,
+		namespaceID,
+		workflowID,
+		locks.PriorityLow,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { currentRelease(retError) }()
+
+	current, err := shardContext.GetCurrentExecution(ctx, &persistence.GetCurrentExecutionRequest{
+		ShardID:     shardContext.GetShardID(),
+		NamespaceID: namespaceID.String(),
+		WorkflowID:  workflowID,
+	})
+	if common.IsNotFoundError(err) {
+		return &historyservice.DeleteOrphanedCurrentExecutionResponse{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if current.RunID != runID {
+		return &historyservice.DeleteOrphanedCurrentExecutionResponse{}, nil
+	}
+
+	_, err = shardContext.GetWorkflowExecution(ctx, &persistence.GetWorkflowExecutionRequest{
+		ShardID:     shardContext.GetShardID(),
+		NamespaceID: namespaceID.String(),
+		WorkflowID:  workflowID,
+		RunID:       runID,
+	})
+	if err == nil {
+		return &historyservice.DeleteOrphanedCurrentExecutionResponse{}, nil
+	}
+	if !common.IsNotFoundError(err) {
+		return nil, err
+	}
+
+	if err := shardContext.AssertOwnership(ctx); err != nil {
+		return nil, err
+	}
+	if err := shardContext.GetExecutionManager().DeleteCurrentWorkflowExecution(ctx, &persistence.DeleteCurrentWorkflowExecutionRequest{
+		ShardID:     shardContext.GetShardID(),
+		NamespaceID: namespaceID.String(),
+		WorkflowID:  workflowID,
+		RunID:       runID,
+	}); err != nil {
+		return nil, err
+	}
+	return &historyservice.DeleteOrphanedCurrentExecutionResponse{Deleted: true}, nil
+}
@@ -0,0 +1,84 @@
+package deleteorphanedhistorytask
+
+import (
+	"context"
+	"fmt"
+
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/locks"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/primitives/timestamp"
+	"go.temporal.io/server/service/history/api"
+	historyi "go.temporal.io/server/service/history/interfaces"
+	"go.temporal.io/server/service/history/tasks"
+)
+
+// Invoke deletes a history task of the shard if the workflow run of the task doesn't exist. The lock of the run is
+// held while the run is checked and the task is deleted, and the shard ownership is asserted before the task is
+// deleted.
+func Invoke(
+	ctx context.Context,
+	request *historyservice.DeleteOrphanedHistoryTaskRequest,
+	shardContext historyi.ShardContext,
+	workflowConsistencyChecker api.WorkflowConsistencyChecker,
+	taskCategoryRegistry tasks.TaskCategoryRegistry,
+) (_ *historyservice.DeleteOrphanedHistoryTaskResponse, retError error) {
+	namespaceID := namespace.ID(request.GetNamespaceId())
+	if err := api.ValidateNamespaceUUID(namespaceID); err != nil {
+		return nil, err
+	}
+	if request.GetExecution().GetWorkflowId() == "" || request.GetExecution().GetRunId() == "" {
+		return nil, serviceerror.NewInvalidArgument("workflow ID and run ID must be set")
+	}
+	category, ok := taskCategoryRegistry.GetCategoryByID(int(request.GetCategory()))
+	if !ok {
+		return nil, serviceerror.NewInvalidArgument(fmt.Sprintf("Invalid task category ID: %v", request.GetCategory()))
+	}
+	key := tasks.NewKey(
+		timestamp.TimeValue(request.GetVisibilityTime()),
+		request.GetTaskId(),
+	)
+	if err := tasks.ValidateKey(key); err != nil {
+		return nil, serviceerror.NewInvalidArgument(fmt.Sprintf("Invalid task key: %v", err.Error()))
+	}
+
+	_, release, err := workflowConsistencyChecker.GetWorkflowCache().GetOrCreateWorkflowExecution(
+		ctx,
+		shardContext,
+		namespaceID,
+		request.GetExecution(),
+		locks.PriorityLow,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { release(retError) }()
+
+	_, err = shardContext.GetWorkflowExecution(ctx, &persistence.GetWorkflowExecutionRequest{
+		ShardID:     shardContext.GetShardID(),
+		NamespaceID: namespaceID.String(),
+		WorkflowID:  request.GetExecution().GetWorkflowId(),
+		RunID:       request.GetExecution().GetRunId(),
+	})
+	if err == nil {
+		return &historyservice.DeleteOrphanedHistoryTaskResponse{}, nil
+	}
+	if !common.IsNotFoundError(err) {
+		return nil, err
+	}
+
+	if err := shardContext.AssertOwnership(ctx); err != nil {
+		return nil, err
+	}
+	if err := shardContext.GetExecutionManager().CompleteHistoryTask(ctx, &persistence.CompleteHistoryTaskRequest{
+		ShardID:      shardContext.GetShardID(),
+		TaskCategory: category,
+		TaskKey:      key,
+	}); err != nil {
+		return nil, err
+	}
+	return &historyservice.DeleteOrphanedHistoryTaskResponse{Deleted: true}, nil
+}
@@ -0,0 +1,220 @@
+// Package forkworkflow contains the logic to implement the [historyservice.HistoryServiceServer.ForkWorkflowExecution] API.
+package forkworkflow
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	enumspb "go.temporal.io/api/enums/v1"
+	historypb "go.temporal.io/api/history/v1"
+	"go.temporal.io/api/serviceerror"
+	taskqueuepb "go.temporal.io/api/taskqueue/v1"
+	"go.temporal.io/server/api/adminservice/v1"
+	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/service/history/api"
+	historyi "go.temporal.io/server/service/history/interfaces"
+	"go.temporal.io/server/service/history/ndc"
+)
+
+const (
+	readHistoryPageSize = 1000
+)
+
+// Invoke creates a new workflow from the history of the source workflow up to the fork event. The source workflow is
+// not changed.
+func Invoke(
+	ctx context.Context,
+	request *historyservice.ForkWorkflowExecutionRequest,
+	shardContext historyi.ShardContext,
+	workflowConsistencyChecker api.WorkflowConsistencyChecker,
+) (*historyservice.ForkWorkflowExecutionResponse, error) {
+	sourceNamespaceID := namespace.ID(request.GetSourceNamespaceId())
+	namespaceID := namespace.ID(request.GetNamespaceId())
+	if err := api.ValidateNamespaceUUID(sourceNamespaceID); err != nil {
+		return nil, err
+	}
+	namespaceEntry, err := api.GetActiveNamespace(shardContext, namespaceID)
+	if err != nil {
+		return nil, err
+	}
+	if request.GetWorkflowId() == "" {
+		return nil, serviceerror.NewInvalidArgument("WorkflowId is not set on request.")
+	}
+	if request.GetRequestId() == "" {
+		return nil, serviceerror.NewInvalidArgument("RequestId is not set on request.")
+	}
+
+	// The source workflow may belong to another shard, its history is read through the history service.
+	historyClient := shardContext.GetHistoryClient()
+	mutableStateResp, err := historyClient.GetMutableState(ctx, &historyservice.GetMutableStateRequest{
+		NamespaceId: sourceNamespaceID.String(),
+		Execution:   request.GetSourceExecution(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if request.GetForkEventId() <= common.FirstEventID || request.GetForkEventId() >= mutableStateResp.GetNextEventId() {
+		return nil, serviceerror.NewInvalidArgument("Fork event ID must be > 1 && <= workflow last event ID.")
+	}
+	sourceExecution := mutableStateResp.GetExecution()
+
+	historyBatches, err := readHistoryBatches(ctx, shardContext, request, sourceExecution.GetRunId())
+	if err != nil {
+		return nil, err
+	}
+	if err := ndc.ValidateForkPoint(historyBatches); err != nil {
+		return nil, err
+	}
+
+	forkRunID := uuid.NewString()
+	forkHistoryBatches(
+		historyBatches,
+		namespaceEntry,
+		namespaceID != sourceNamespaceID,
+		forkRunID,
+		mutableStateResp.GetTaskQueue().GetName(),
+		request.GetTaskQueue(),
+	)
+
+	err = ndc.NewWorkflowResetter(
+		shardContext,
+		workflowConsistencyChecker.GetWorkflowCache(),
+		shardContext.GetLogger(),
+	).ForkWorkflow(
+		ctx,
+		namespaceID,
+		request.GetWorkflowId(),
+		forkRunID,
+		request.GetRequestId(),
+		sourceExecution.GetRunId(),
+		historyBatches,
+		request.GetReason(),
+	)
+	var currentWorkflowConditionFailed *persistence.CurrentWorkflowConditionFailedError
+	if errors.As(err, &currentWorkflowConditionFailed) {
+		if _, ok := currentWorkflowConditionFailed.RequestIDs[request.GetRequestId()]; ok {
+			// The workflow was already forked by a previous attempt of the request.
+			return &historyservice.ForkWorkflowExecutionResponse{RunId: currentWorkflowConditionFailed.RunID}, nil
+		}
+		return nil, serviceerror.NewWorkflowExecutionAlreadyStarted(
+			fmt.Sprintf("Workflow execution is already running. WorkflowId: %v, RunId: %v.", request.GetWorkflowId(), currentWorkflowConditionFailed.RunID),
+			request.GetRequestId(),
+			currentWorkflowConditionFailed.RunID,
+		)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &historyservice.ForkWorkflowExecutionResponse{RunId: forkRunID}, nil
+}
+
+// readHistoryBatches returns the batches of events of the source workflow up to the fork event
+func readHistoryBatches(
+	ctx context.Context,
+	shardContext historyi.ShardContext,
+	request *historyservice.ForkWorkflowExecutionRequest,
+	sourceRunID string,
+) ([][]*historypb.HistoryEvent, error) {
+	var historyBatches [][]*historypb.HistoryEvent
+	var nextPageToken []byte
+	for {
+		resp, err := shardContext.GetHistoryClient().GetWorkflowExecutionRawHistoryV2(ctx, &historyservice.GetWorkflowExecutionRawHistoryV2Request{
+			NamespaceId: request.GetSourceNamespaceId(),
+			Request: &adminservice.GetWorkflowExecutionRawHistoryV2Request{
+				NamespaceId:     request.GetSourceNamespaceId(),
+				Execution:       request.GetSourceExecution(),
+				MaximumPageSize: readHistoryPageSize,
+				NextPageToken:   nextPageToken,
+			},
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, blob := range resp.GetResponse().GetHistoryBatches() {
+			events, err := shardContext.GetPayloadSerializer().DeserializeEvents(blob)
+			if err != nil {
+				return nil, err
+			}
+			if len(events) == 0 {
+				continue
+			}
+			lastEventID := events[len(events)-1].GetEventId()
+			if events[0].GetEventId() > request.GetForkEventId() {
+				break
+			}
+			if lastEventID > request.GetForkEventId() {
+				return nil, serviceerror.NewInvalidArgument(fmt.Sprintf(
+					"Fork event ID %v is not the last event of a batch of events of workflow run %v.",
+					request.GetForkEventId(),
+					sourceRunID,
+				))
+			}
+			historyBatches = append(historyBatches, events)
+			if lastEventID == request.GetForkEventId() {
+				return historyBatches, nil
+			}
+		}
+		nextPageToken = resp.GetResponse().GetNextPageToken()
+		if len(nextPageToken) == 0 {
+			return nil, serviceerror.NewInvalidArgument(fmt.Sprintf(
+				"Fork event ID %v is not found in the history of workflow run %v.",
+				request.GetForkEventId(),
+				sourceRunID,
+			))
+		}
+	}
+}
+
+// forkHistoryBatches rewrites the copied events for the new workflow: the new workflow is a top level workflow of
+// its own chain of runs, its events have the failover version of its namespace and, if a new task queue is set, the
+// workflow and the activities scheduled on the task queue of the workflow are moved to it.
+func forkHistoryBatches(
+	historyBatches [][]*historypb.HistoryEvent,
+	namespaceEntry *namespace.Namespace,
+	crossNamespace bool,
+	forkRunID string,
+	sourceTaskQueue string,
+	taskQueue string,
+) {
+	for _, batch := range historyBatches {
+		for _, event := range batch {
+			event.Version = namespaceEntry.FailoverVersion()
+
+			switch event.GetEventType() { // nolint:exhaustive
+			case enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_STARTED:
+				attributes := event.GetWorkflowExecutionStartedEventAttributes()
+				attributes.ParentWorkflowNamespace = ""
+				attributes.ParentWorkflowNamespaceId = ""
+				attributes.ParentWorkflowExecution = nil
+				attributes.ParentInitiatedEventId = 0
+				attributes.ParentInitiatedEventVersion = 0
+				attributes.RootWorkflowExecution = nil
+				attributes.ContinuedExecutionRunId = ""
+				attributes.OriginalExecutionRunId = forkRunID
+				attributes.FirstExecutionRunId = forkRunID
+				if crossNamespace {
+					// Search attributes are stored under the field names of the source namespace.
+					attributes.SearchAttributes = nil
+				}
+				if taskQueue != "" {
+					attributes.TaskQueue = &taskqueuepb.TaskQueue{Name: taskQueue, Kind: enumspb.TASK_QUEUE_KIND_NORMAL}
+				}
+			case enumspb.EVENT_TYPE_WORKFLOW_TASK_SCHEDULED:
+				if taskQueue != "" {
+					attributes := event.GetWorkflowTaskScheduledEventAttributes()
+					attributes.TaskQueue = &taskqueuepb.TaskQueue{Name: taskQueue, Kind: enumspb.TASK_QUEUE_KIND_NORMAL}
+				}
+			case enumspb.EVENT_TYPE_ACTIVITY_TASK_SCHEDULED:
+				attributes := event.GetActivityTaskScheduledEventAttributes()
+				if taskQueue != "" && attributes.GetTaskQueue().GetName() == sourceTaskQueue {
+					attributes.TaskQueue = &taskqueuepb.TaskQueue{Name: taskQueue, Kind: enumspb.TASK_QUEUE_KIND_NORMAL}
+				}
+			}
+		}
+	}
+}
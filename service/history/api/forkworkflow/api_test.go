@@ -0,0 +1,135 @@
+package forkworkflow
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	historypb "go.temporal.io/api/history/v1"
+	taskqueuepb "go.temporal.io/api/taskqueue/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/namespace"
+)
+
+type (
+	forkWorkflowSuite struct {
+		suite.Suite
+	}
+)
+
+func TestForkWorkflowSuite(t *testing.T) {
+	s := new(forkWorkflowSuite)
+	suite.Run(t, s)
+}
+
+func (s *forkWorkflowSuite) TestForkHistoryBatches() {
+	namespaceEntry := namespace.NewGlobalNamespaceForTest(
+		&persistencespb.NamespaceInfo{Id: "fork-namespace-id", Name: "fork-namespace"},
+		nil,
+		&persistencespb.NamespaceReplicationConfig{},
+		123,
+	)
+	historyBatches := [][]*historypb.HistoryEvent{
+		{
+			{
+				EventId:   1,
+				EventType: enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_STARTED,
+				Version:   1,
+				Attributes: &historypb.HistoryEvent_WorkflowExecutionStartedEventAttributes{
+					WorkflowExecutionStartedEventAttributes: &historypb.WorkflowExecutionStartedEventAttributes{
+						TaskQueue:               &taskqueuepb.TaskQueue{Name: "source-tq"},
+						ParentWorkflowNamespace: "parent-namespace",
+						ParentWorkflowExecution: &commonpb.WorkflowExecution{WorkflowId: "parent-workflow-id"},
+						ParentInitiatedEventId:  5,
+						ContinuedExecutionRunId: "previous-run-id",
+						OriginalExecutionRunId:  "source-run-id",
+						FirstExecutionRunId:     "first-run-id",
+						SearchAttributes:        &commonpb.SearchAttributes{},
+					},
+				},
+			},
+			{
+				EventId:   2,
+				EventType: enumspb.EVENT_TYPE_WORKFLOW_TASK_SCHEDULED,
+				Version:   1,
+				Attributes: &historypb.HistoryEvent_WorkflowTaskScheduledEventAttributes{
+					WorkflowTaskScheduledEventAttributes: &historypb.WorkflowTaskScheduledEventAttributes{
+						TaskQueue: &taskqueuepb.TaskQueue{Name: "source-tq"},
+					},
+				},
+			},
+		},
+		{
+			{
+				EventId:   5,
+				EventType: enumspb.EVENT_TYPE_ACTIVITY_TASK_SCHEDULED,
+				Version:   1,
+				Attributes: &historypb.HistoryEvent_ActivityTaskScheduledEventAttributes{
+					ActivityTaskScheduledEventAttributes: &historypb.ActivityTaskScheduledEventAttributes{
+						TaskQueue: &taskqueuepb.TaskQueue{Name: "source-tq"},
+					},
+				},
+			},
+			{
+				EventId:   6,
+				EventType: enumspb.EVENT_TYPE_ACTIVITY_TASK_SCHEDULED,
+				Version:   1,
+				Attributes: &historypb.HistoryEvent_ActivityTaskScheduledEventAttributes{
+					ActivityTaskScheduledEventAttributes: &historypb.ActivityTaskScheduledEventAttributes{
+						TaskQueue: &taskqueuepb.TaskQueue{Name: "other-tq"},
+					},
+				},
+			},
+		},
+	}
+
+	forkHistoryBatches(historyBatches, namespaceEntry, true, "fork-run-id", "source-tq", "fork-tq")
+
+	for _, batch := range historyBatches {
+		for _, event := range batch {
+			s.Equal(int64(123), event.GetVersion())
+		}
+	}
+	startedAttributes := historyBatches[0][0].GetWorkflowExecutionStartedEventAttributes()
+	s.Equal("fork-tq", startedAttributes.GetTaskQueue().GetName())
+	s.Empty(startedAttributes.GetParentWorkflowNamespace())
+	s.Nil(startedAttributes.GetParentWorkflowExecution())
+	s.Zero(startedAttributes.GetParentInitiatedEventId())
+	s.Empty(startedAttributes.GetContinuedExecutionRunId())
+	s.Equal("fork-run-id", startedAttributes.GetOriginalExecutionRunId())
+	s.Equal("fork-run-id", startedAttributes.GetFirstExecutionRunId())
+	s.Nil(startedAttributes.GetSearchAttributes())
+	s.Equal("fork-tq", historyBatches[0][1].GetWorkflowTaskScheduledEventAttributes().GetTaskQueue().GetName())
+	s.Equal("fork-tq", historyBatches[1][0].GetActivityTaskScheduledEventAttributes().GetTaskQueue().GetName())
+	s.Equal("other-tq", historyBatches[1][1].GetActivityTaskScheduledEventAttributes().GetTaskQueue().GetName())
+}
+
+func (s *forkWorkflowSuite) TestForkHistoryBatches_SameTaskQueue() {
+	namespaceEntry := namespace.NewLocalNamespaceForTest(
+		&persistencespb.NamespaceInfo{Id: "fork-namespace-id", Name: "fork-namespace"},
+		nil,
+		"active",
+	)
+	searchAttributes := &commonpb.SearchAttributes{}
+	historyBatches := [][]*historypb.HistoryEvent{
+		{
+			{
+				EventId:   1,
+				EventType: enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_STARTED,
+				Attributes: &historypb.HistoryEvent_WorkflowExecutionStartedEventAttributes{
+					WorkflowExecutionStartedEventAttributes: &historypb.WorkflowExecutionStartedEventAttributes{
+						TaskQueue:        &taskqueuepb.TaskQueue{Name: "source-tq"},
+						SearchAttributes: searchAttributes,
+					},
+				},
+			},
+		},
+	}
+
+	forkHistoryBatches(historyBatches, namespaceEntry, false, "fork-run-id", "source-tq", "")
+
+	startedAttributes := historyBatches[0][0].GetWorkflowExecutionStartedEventAttributes()
+	s.Equal("source-tq", startedAttributes.GetTaskQueue().GetName())
+	s.Same(searchAttributes, startedAttributes.GetSearchAttributes())
+}
@@ -10,7 +10,6 @@ import (
 	"go.temporal.io/api/serviceerror"
 	historyspb "go.temporal.io/server/api/history/v1"
 	"go.temporal.io/server/api/historyservice/v1"
-	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/failure"
 	"go.temporal.io/server/common/headers"
 	"go.temporal.io/server/common/log/tag"
@@ -37,7 +36,30 @@ func GetRawHistory(
 	transientWorkflowTaskInfo *historyspb.TransientWorkflowTaskInfo,
 	branchToken []byte,
 ) ([]*commonpb.DataBlob, []byte, error) {
-	shardID := common.WorkflowIDToHistoryShard(namespaceID.String(), execution.GetWorkflowId(), shardContext.GetConfig().NumberOfShards)
+	if persistence.ReplicaReadsAllowed(ctx) {
+		rawHistory, nextToken, err := getRawHistory(ctx, shardContext, namespaceID, execution, firstEventID, nextEventID, pageSize, token, transientWorkflowTaskInfo, branchToken)
+		if err == nil || ctx.Err() != nil {
+			return rawHistory, nextToken, err
+		}
+		// the read replica might not have all the events yet
+		ctx = persistence.WithoutReplicaReads(ctx)
+	}
+	return getRawHistory(ctx, shardContext, namespaceID, execution, firstEventID, nextEventID, pageSize, token, transientWorkflowTaskInfo, branchToken)
+}
+
+func getRawHistory(
+	ctx context.Context,
+	shardContext historyi.ShardContext,
+	namespaceID namespace.ID,
+	execution *commonpb.WorkflowExecution,
+	firstEventID int64,
+	nextEventID int64,
+	pageSize int32,
+	token []byte,
+	transientWorkflowTaskInfo *historyspb.TransientWorkflowTaskInfo,
+	branchToken []byte,
+) ([]*commonpb.DataBlob, []byte, error) {
+	shardID := shardContext.GetConfig().GetShardID(namespaceID, execution.GetWorkflowId())
 	logger := shardContext.GetLogger()
 	rawHistory, size, nextToken, err := persistence.ReadFullPageRawEvents(
 		ctx, shardContext.GetExecutionManager(),
@@ -84,6 +106,9 @@ func GetRawHistory(
 		len(nextToken) == 0,
 		int(pageSize),
 	); err != nil {
+		if persistence.ReplicaReadsAllowed(ctx) {
+			return nil, nil, err
+		}
 		metricsHandler := interceptor.GetMetricsHandlerFromContext(ctx, logger).WithTags(metrics.OperationTag(metrics.HistoryGetHistoryScope))
 		metrics.ServiceErrIncompleteHistoryCounter.With(metricsHandler).Record(1)
 		logger.Error("getHistory: incomplete history",
@@ -131,10 +156,34 @@ func GetHistory(
 	branchToken []byte,
 	persistenceVisibilityMgr manager.VisibilityManager,
 ) (*historypb.History, []byte, error) {
+	if persistence.ReplicaReadsAllowed(ctx) {
+		history, nextToken, err := getHistory(ctx, shardContext, namespaceID, execution, firstEventID, nextEventID, pageSize, nextPageToken, transientWorkflowTaskInfo, branchToken, persistenceVisibilityMgr)
+		if err == nil || ctx.Err() != nil {
+			return history, nextToken, err
+		}
+		// the read replica might not have all the events yet
+		ctx = persistence.WithoutReplicaReads(ctx)
+	}
+	return getHistory(ctx, shardContext, namespaceID, execution, firstEventID, nextEventID, pageSize, nextPageToken, transientWorkflowTaskInfo, branchToken, persistenceVisibilityMgr)
+}
+
+func getHistory(
+	ctx context.Context,
+	shardContext historyi.ShardContext,
+	namespaceID namespace.ID,
+	execution *commonpb.WorkflowExecution,
+	firstEventID int64,
+	nextEventID int64,
+	pageSize int32,
+	nextPageToken []byte,
+	transientWorkflowTaskInfo *historyspb.TransientWorkflowTaskInfo,
+	branchToken []byte,
+	persistenceVisibilityMgr manager.VisibilityManager,
+) (*historypb.History, []byte, error) {
 
 	var size int
 	isFirstPage := len(nextPageToken) == 0
-	shardID := common.WorkflowIDToHistoryShard(namespaceID.String(), execution.GetWorkflowId(), shardContext.GetConfig().NumberOfShards)
+	shardID := shardContext.GetConfig().GetShardID(namespaceID, execution.GetWorkflowId())
 	var err error
 	var historyEvents []*historypb.HistoryEvent
 	historyEvents, size, nextPageToken, err = persistence.ReadFullPageEvents(ctx, shardContext.GetExecutionManager(), &persistence.ReadHistoryBranchRequest{
@@ -184,6 +233,9 @@ func GetHistory(
 		isFirstPage,
 		isLastPage,
 		int(pageSize)); err != nil {
+		if persistence.ReplicaReadsAllowed(ctx) {
+			return nil, nil, err
+		}
 		metrics.ServiceErrIncompleteHistoryCounter.With(metricsHandler).Record(1)
 		logger.Error("getHistory: incomplete history",
 			tag.WorkflowNamespaceID(namespaceID.String()),
@@ -236,7 +288,7 @@ func GetHistoryReverse(
 	persistenceVisibilityMgr manager.VisibilityManager,
 ) (*historypb.History, []byte, int64, error) {
 	var size int
-	shardID := common.WorkflowIDToHistoryShard(namespaceID.String(), execution.GetWorkflowId(), shardContext.GetConfig().NumberOfShards)
+	shardID := shardContext.GetConfig().GetShardID(namespaceID, execution.GetWorkflowId())
 	var err error
 	var historyEvents []*historypb.HistoryEvent
 
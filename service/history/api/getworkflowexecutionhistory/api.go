@@ -17,6 +17,7 @@ import (
 	"go.temporal.io/server/common/headers"
 	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/persistence/transitionhistory"
 	"go.temporal.io/server/common/persistence/versionhistory"
 	"go.temporal.io/server/common/persistence/visibility/manager"
@@ -197,6 +198,13 @@ func Invoke(
 		}
 	}()
 
+	// The history of a closed workflow doesn't change anymore, so it can be read from a read replica of the
+	// database. The history reads fall back to the primary if the replica doesn't have all the events yet.
+	readCtx := ctx
+	if !continuationToken.IsWorkflowRunning {
+		readCtx = persistence.WithReplicaReads(ctx)
+	}
+
 	history := &historypb.History{}
 	history.Events = []*historypb.HistoryEvent{}
 	var historyBlob []*commonpb.DataBlob
@@ -207,7 +215,7 @@ func Invoke(
 		if !isWorkflowRunning {
 			if sendRawWorkflowHistoryForNamespace || sendRawHistoryBetweenInternalServices {
 				historyBlob, _, err = api.GetRawHistory(
-					ctx,
+					readCtx,
 					shardContext,
 					namespaceID,
 					execution,
@@ -225,7 +233,7 @@ func Invoke(
 				historyBlob = historyBlob[len(historyBlob)-1:]
 			} else {
 				history, _, err = api.GetHistory(
-					ctx,
+					readCtx,
 					shardContext,
 					namespaceID,
 					execution,
@@ -270,7 +278,7 @@ func Invoke(
 		} else {
 			if sendRawWorkflowHistoryForNamespace || sendRawHistoryBetweenInternalServices {
 				historyBlob, continuationToken.PersistenceToken, err = api.GetRawHistory(
-					ctx,
+					readCtx,
 					shardContext,
 					namespaceID,
 					execution,
@@ -283,7 +291,7 @@ func Invoke(
 				)
 			} else {
 				history, continuationToken.PersistenceToken, err = api.GetHistory(
-					ctx,
+					readCtx,
 					shardContext,
 					namespaceID,
 					execution,
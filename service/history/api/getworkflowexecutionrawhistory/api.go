@@ -91,10 +91,9 @@ func Invoke(
 	}
 
 	pageSize := int(req.GetMaximumPageSize())
-	shardID := common.WorkflowIDToHistoryShard(
-		ns.ID().String(),
+	shardID := shardContext.GetConfig().GetShardID(
+		ns.ID(),
 		execution.GetWorkflowId(),
-		shardContext.GetConfig().NumberOfShards,
 	)
 	rawHistoryResponse, err := shardContext.GetExecutionManager().ReadRawHistoryBranch(ctx, &persistence.ReadHistoryBranchRequest{
 		BranchToken: targetVersionHistory.GetBranchToken(),
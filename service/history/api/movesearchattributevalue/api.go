@@ -0,0 +1,81 @@
+package movesearchattributevalue
+
+import (
+	"context"
+	"fmt"
+
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/common/definition"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/searchattribute"
+	"go.temporal.io/server/service/history/api"
+	historyi "go.temporal.io/server/service/history/interfaces"
+)
+
+// Invoke moves the value of a custom search attribute field of a running workflow execution into another field,
+// converting it to the type of the target field. It's used when the type of a search attribute is changed, so open
+// workflow executions stop referencing the old field before it's released. A value which can't be converted is
+// dropped, and an existing value of the target field is kept.
+func Invoke(
+	ctx context.Context,
+	request *historyservice.MoveSearchAttributeValueRequest,
+	shardContext historyi.ShardContext,
+	workflowConsistencyChecker api.WorkflowConsistencyChecker,
+) (*historyservice.MoveSearchAttributeValueResponse, error) {
+	if err := api.ValidateNamespaceUUID(namespace.ID(request.GetNamespaceId())); err != nil {
+		return nil, err
+	}
+	if request.GetSourceField() == "" || request.GetTargetField() == "" || request.GetSourceField() == request.GetTargetField() {
+		return nil, serviceerror.NewInvalidArgument("source and target fields must be set and different")
+	}
+	if !searchattribute.IsTypeConversionSupported(request.GetSourceType(), request.GetTargetType()) {
+		return nil, serviceerror.NewInvalidArgument(fmt.Sprintf(
+			"unsupported search attribute type conversion from %s to %s", request.GetSourceType(), request.GetTargetType()))
+	}
+
+	resp := &historyservice.MoveSearchAttributeValueResponse{}
+	err := api.GetAndUpdateWorkflowWithNew(
+		ctx,
+		nil,
+		definition.NewWorkflowKey(
+			request.GetNamespaceId(),
+			request.GetExecution().GetWorkflowId(),
+			request.GetExecution().GetRunId(),
+		),
+		func(workflowLease api.WorkflowLease) (*api.UpdateWorkflowAction, error) {
+			mutableState := workflowLease.GetMutableState()
+			if !mutableState.IsWorkflowExecutionRunning() {
+				return &api.UpdateWorkflowAction{Noop: true}, nil
+			}
+			searchAttributes := mutableState.GetExecutionInfo().GetSearchAttributes()
+			source, ok := searchAttributes[request.GetSourceField()]
+			if !ok {
+				return &api.UpdateWorkflowAction{Noop: true}, nil
+			}
+
+			value, err := searchattribute.ConvertPayload(source, request.GetSourceType(), request.GetTargetType())
+			if err != nil {
+				// the conversion is supported, so the value itself can't be represented in the target type
+				resp.ConversionFailed = true
+				value = nil
+			}
+			if _, ok := searchAttributes[request.GetTargetField()]; ok {
+				// upserted by the workflow after the type change, it's newer than the old value
+				value = nil
+			}
+			if err := mutableState.MoveSearchAttributeValue(request.GetSourceField(), request.GetTargetField(), value); err != nil {
+				return nil, err
+			}
+			resp.Moved = value != nil
+			return &api.UpdateWorkflowAction{Noop: false}, nil
+		},
+		nil,
+		shardContext,
+		workflowConsistencyChecker,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
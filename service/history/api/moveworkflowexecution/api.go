@@ -0,0 +1,358 @@
+package moveworkflowexecution
+
+import (
+	"context"
+	"strings"
+
+	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/api/serviceerror"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/definition"
+	"go.temporal.io/server/common/locks"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/versionhistory"
+	"go.temporal.io/server/service/history/api"
+	historyi "go.temporal.io/server/service/history/interfaces"
+	"go.temporal.io/server/service/history/workflow"
+)
+
+const (
+	historyPageSize = 100
+)
+
+var (
+	errBufferedEvents = serviceerror.NewUnavailable("workflow has buffered events, it will be moved once they are flushed")
+)
+
+// Invoke moves a workflow execution of the shard to the target shard, which is one of the child shards of the shard
+// after a shard split. The history of the execution is copied with its original transaction IDs, the execution is
+// created in the target shard and its tasks are regenerated there, and the execution and its history are deleted from
+// the shard. Every step is idempotent, so a move which failed half way can be retried.
+func Invoke(
+	ctx context.Context,
+	workflowKey definition.WorkflowKey,
+	shardContext historyi.ShardContext,
+	workflowConsistencyChecker api.WorkflowConsistencyChecker,
+	targetShardContext historyi.ShardContext,
+) (retError error) {
+	if err := api.ValidateNamespaceUUID(namespace.ID(workflowKey.NamespaceID)); err != nil {
+		return err
+	}
+
+	workflowLease, err := workflowConsistencyChecker.GetWorkflowLease(
+		ctx,
+		nil,
+		workflowKey,
+		locks.PriorityLow,
+	)
+	if err != nil {
+		return err
+	}
+	defer func() { workflowLease.GetReleaseFn()(retError) }()
+
+	// Buffered events aren't part of a workflow snapshot.
+	if workflowLease.GetMutableState().HasBufferedEvents() {
+		return errBufferedEvents
+	}
+
+	resp, err := shardContext.GetWorkflowExecution(ctx, &persistence.GetWorkflowExecutionRequest{
+		ShardID:     shardContext.GetShardID(),
+		NamespaceID: workflowKey.NamespaceID,
+		WorkflowID:  workflowKey.WorkflowID,
+		RunID:       workflowKey.RunID,
+	})
+	if err != nil {
+		return err
+	}
+	isCurrent, err := isCurrentRun(ctx, shardContext, workflowKey)
+	if err != nil {
+		return err
+	}
+
+	_, err = targetShardContext.GetWorkflowExecution(ctx, &persistence.GetWorkflowExecutionRequest{
+		ShardID:     targetShardContext.GetShardID(),
+		NamespaceID: workflowKey.NamespaceID,
+		WorkflowID:  workflowKey.WorkflowID,
+		RunID:       workflowKey.RunID,
+	})
+	switch err.(type) {
+	case nil:
+		// already created by a previous attempt
+	case *serviceerror.NotFound:
+		if err := copyHistory(ctx, shardContext, targetShardContext, workflowKey, resp.State); err != nil {
+			return err
+		}
+		if err := createWorkflowExecution(ctx, targetShardContext, resp, isCurrent); err != nil {
+			return err
+		}
+	default:
+		return err
+	}
+
+	targetEngine, err := targetShardContext.GetEngine(ctx)
+	if err != nil {
+		return err
+	}
+	if err := targetEngine.RefreshWorkflowTasks(
+		ctx,
+		namespace.ID(workflowKey.NamespaceID),
+		&commonpb.WorkflowExecution{WorkflowId: workflowKey.WorkflowID, RunId: workflowKey.RunID},
+	); err != nil {
+		return err
+	}
+
+	// The deletes aren't fenced by the range ID of the shard, so make sure the shard is still owned.
+	if err := shardContext.AssertOwnership(ctx); err != nil {
+		return err
+	}
+	executionManager := shardContext.GetExecutionManager()
+	// The history is deleted before the execution which refers to it, so a retry deletes it if the move fails here.
+	if err := deleteHistory(ctx, shardContext, resp.State); err != nil {
+		return err
+	}
+	if isCurrent {
+		if err := executionManager.DeleteCurrentWorkflowExecution(ctx, &persistence.DeleteCurrentWorkflowExecutionRequest{
+			ShardID:     shardContext.GetShardID(),
+			NamespaceID: workflowKey.NamespaceID,
+			WorkflowID:  workflowKey.WorkflowID,
+			RunID:       workflowKey.RunID,
+		}); err != nil {
+			return err
+		}
+	}
+	if err := executionManager.DeleteWorkflowExecution(ctx, &persistence.DeleteWorkflowExecutionRequest{
+		ShardID:     shardContext.GetShardID(),
+		NamespaceID: workflowKey.NamespaceID,
+		WorkflowID:  workflowKey.WorkflowID,
+		RunID:       workflowKey.RunID,
+	}); err != nil {
+		return err
+	}
+	workflowLease.GetContext().Clear()
+	return nil
+}
+
+func isCurrentRun(
+	ctx context.Context,
+	shardContext historyi.ShardContext,
+	workflowKey definition.WorkflowKey,
+) (bool, error) {
+	resp, err := shardContext.GetCurrentExecution(ctx, &persistence.GetCurrentExecutionRequest{
+		ShardID:     shardContext.GetShardID(),
+		NamespaceID: workflowKey.NamespaceID,
+		WorkflowID:  workflowKey.WorkflowID,
+	})
+	switch err.(type) {
+	case nil:
+		return resp.RunID == workflowKey.RunID, nil
+	case *serviceerror.NotFound:
+		return false, nil
+	default:
+		return false, err
+	}
+}
+
+// copyHistory copies the history branches of the execution to the target shard. The nodes keep their transaction IDs,
+// so the copy is consistent with the branches shared with other executions, whether they were already copied or not.
+// Stores which don't partition the history by shard just overwrite the nodes with the same values.
+func copyHistory(
+	ctx context.Context,
+	shardContext historyi.ShardContext,
+	targetShardContext historyi.ShardContext,
+	workflowKey definition.WorkflowKey,
+	state *persistencespb.WorkflowMutableState,
+) error {
+	for _, versionHistory := range state.GetExecutionInfo().GetVersionHistories().GetHistories() {
+		if versionhistory.IsEmptyVersionHistory(versionHistory) {
+			continue
+		}
+		lastItem, err := versionhistory.GetLastVersionHistoryItem(versionHistory)
+		if err != nil {
+			return err
+		}
+		if err := copyHistoryBranch(
+			ctx,
+			shardContext,
+			targetShardContext,
+			workflowKey,
+			versionHistory.GetBranchToken(),
+			lastItem.GetEventId()+1,
+		); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func copyHistoryBranch(
+	ctx context.Context,
+	shardContext historyi.ShardContext,
+	targetShardContext historyi.ShardContext,
+	workflowKey definition.WorkflowKey,
+	branchToken []byte,
+	nextEventID int64,
+) error {
+	executionManager := shardContext.GetExecutionManager()
+	branchUtil := executionManager.GetHistoryBranchUtil()
+	branch, err := branchUtil.ParseHistoryBranchInfo(branchToken)
+	if err != nil {
+		return err
+	}
+
+	// Nodes are appended to the branch of their token, so each ancestor range needs its own token.
+	rangeTokens := make([][]byte, len(branch.GetAncestors()))
+	for i, ancestor := range branch.GetAncestors() {
+		rangeTokens[i], err = branchUtil.UpdateHistoryBranchInfo(
+			branchToken,
+			&persistencespb.HistoryBranch{
+				TreeId:    branch.GetTreeId(),
+				BranchId:  ancestor.GetBranchId(),
+				Ancestors: branch.GetAncestors()[:i],
+			},
+			workflowKey.RunID,
+		)
+		if err != nil {
+			return err
+		}
+	}
+	tokenOfNode := func(nodeID int64) []byte {
+		for i, ancestor := range branch.GetAncestors() {
+			if nodeID < ancestor.GetEndNodeId() {
+				return rangeTokens[i]
+			}
+		}
+		return branchToken
+	}
+
+	beginNodeID := persistence.GetBeginNodeID(branch)
+	prevTransactionID := common.EmptyEventTaskID
+	var pageToken []byte
+	for {
+		resp, err := executionManager.ReadRawHistoryBranch(ctx, &persistence.ReadHistoryBranchRequest{
+			ShardID:       shardContext.GetShardID(),
+			BranchToken:   branchToken,
+			MinEventID:    common.FirstEventID,
+			MaxEventID:    nextEventID,
+			PageSize:      historyPageSize,
+			NextPageToken: pageToken,
+		})
+		if err != nil {
+			return err
+		}
+		for i, blob := range resp.HistoryEventBlobs {
+			nodeID := resp.NodeIDs[i]
+			if _, err := executionManager.AppendRawHistoryNodes(ctx, &persistence.AppendRawHistoryNodesRequest{
+				ShardID:     targetShardContext.GetShardID(),
+				NamespaceID: workflowKey.NamespaceID,
+				// The first node of the branch creates its tree record, which records its ancestors.
+				IsNewBranch: nodeID == beginNodeID,
+				Info: persistence.BuildHistoryGarbageCleanupInfo(
+					workflowKey.NamespaceID,
+					workflowKey.WorkflowID,
+					workflowKey.RunID,
+				),
+				BranchToken:       tokenOfNode(nodeID),
+				History:           blob,
+				PrevTransactionID: prevTransactionID,
+				TransactionID:     resp.TransactionIDs[i],
+				NodeID:            nodeID,
+			}); err != nil {
+				return err
+			}
+			prevTransactionID = resp.TransactionIDs[i]
+		}
+		pageToken = resp.NextPageToken
+		if len(pageToken) == 0 {
+			return nil
+		}
+	}
+}
+
+// deleteHistory deletes the history branches of the execution from the shard, once they're copied to the target shard.
+// The parts of the branches which are shared with the executions left in the shard are kept. Stores which don't
+// partition the history by shard have a single copy of the history, which is now used by the target shard.
+func deleteHistory(
+	ctx context.Context,
+	shardContext historyi.ShardContext,
+	state *persistencespb.WorkflowMutableState,
+) error {
+	executionManager := shardContext.GetExecutionManager()
+	if strings.Contains(executionManager.GetName(), "cassandra") {
+		return nil
+	}
+	for _, versionHistory := range state.GetExecutionInfo().GetVersionHistories().GetHistories() {
+		if versionhistory.IsEmptyVersionHistory(versionHistory) {
+			continue
+		}
+		err := executionManager.DeleteHistoryBranch(ctx, &persistence.DeleteHistoryBranchRequest{
+			ShardID:     shardContext.GetShardID(),
+			BranchToken: versionHistory.GetBranchToken(),
+		})
+		switch err.(type) {
+		case nil, *serviceerror.NotFound:
+			// already deleted by a previous attempt
+		default:
+			return err
+		}
+	}
+	return nil
+}
+
+func createWorkflowExecution(
+	ctx context.Context,
+	targetShardContext historyi.ShardContext,
+	resp *persistence.GetWorkflowExecutionResponse,
+	isCurrent bool,
+) error {
+	state := resp.State
+	executionInfo := common.CloneProto(state.GetExecutionInfo())
+	// Timers and close task IDs refer to the tasks of the shard, the tasks are regenerated in the target shard.
+	executionInfo.WorkflowExecutionTimerTaskStatus = workflow.TimerTaskStatusNone
+	executionInfo.CloseTransferTaskId = common.EmptyEventTaskID
+	executionInfo.CloseVisibilityTaskId = common.EmptyEventTaskID
+	executionInfo.TaskGenerationShardClockTimestamp = common.EmptyEventTaskID
+
+	signalRequestedIDs := make(map[string]struct{}, len(state.GetSignalRequestedIds()))
+	for _, requestID := range state.GetSignalRequestedIds() {
+		signalRequestedIDs[requestID] = struct{}{}
+	}
+	request := &persistence.CreateWorkflowExecutionRequest{
+		ShardID: targetShardContext.GetShardID(),
+		Mode:    persistence.CreateWorkflowModeBypassCurrent,
+		NewWorkflowSnapshot: persistence.WorkflowSnapshot{
+			ExecutionInfo:       executionInfo,
+			ExecutionState:      state.GetExecutionState(),
+			NextEventID:         state.GetNextEventId(),
+			ActivityInfos:       state.GetActivityInfos(),
+			TimerInfos:          state.GetTimerInfos(),
+			ChildExecutionInfos: state.GetChildExecutionInfos(),
+			RequestCancelInfos:  state.GetRequestCancelInfos(),
+			SignalInfos:         state.GetSignalInfos(),
+			SignalRequestedIDs:  signalRequestedIDs,
+			ChasmNodes:          state.GetChasmNodes(),
+			Condition:           state.GetNextEventId(),
+			DBRecordVersion:     resp.DBRecordVersion,
+			Checksum:            state.GetChecksum(),
+		},
+	}
+	if !isCurrent {
+		_, err := targetShardContext.CreateWorkflowExecution(ctx, request)
+		return err
+	}
+
+	request.Mode = persistence.CreateWorkflowModeBrandNew
+	_, err := targetShardContext.CreateWorkflowExecution(ctx, request)
+	currentErr, ok := err.(*persistence.CurrentWorkflowConditionFailedError)
+	if !ok {
+		return err
+	}
+	// A previous run of the workflow was moved while it was the current run, and this run was started in the shard
+	// before it was deleted from the shard.
+	request.Mode = persistence.CreateWorkflowModeUpdateCurrent
+	request.PreviousRunID = currentErr.RunID
+	request.PreviousLastWriteVersion = currentErr.LastWriteVersion
+	_, err = targetShardContext.CreateWorkflowExecution(ctx, request)
+	return err
+}
@@ -0,0 +1,68 @@
+// Package pauseworkflow contains the logic to implement the [historyservice.HistoryServiceServer.PauseWorkflowExecution] API.
+//
+// Pausing is exposed through the admin service, tdbg and the batcher. The following parts depend on protos defined
+// in go.temporal.io/api, and are missing until they are added there:
+//   - the PauseWorkflowExecution and UnpauseWorkflowExecution APIs of the workflow service;
+//   - the WorkflowExecutionPaused and WorkflowExecutionUnpaused history events, the pause and the unpause are
+//     recorded in WorkflowPropertiesModifiedExternally events;
+//   - the pause and unpause operations of StartBatchOperation;
+//   - a paused workflow execution status.
+package pauseworkflow
+
+import (
+	"context"
+
+	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/common/definition"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/service/history/api"
+	historyi "go.temporal.io/server/service/history/interfaces"
+)
+
+func Invoke(
+	ctx context.Context,
+	request *historyservice.PauseWorkflowExecutionRequest,
+	shardContext historyi.ShardContext,
+	workflowConsistencyChecker api.WorkflowConsistencyChecker,
+) (*historyservice.PauseWorkflowExecutionResponse, error) {
+	namespaceID := namespace.ID(request.GetNamespaceId())
+	if err := api.ValidateNamespaceUUID(namespaceID); err != nil {
+		return nil, err
+	}
+
+	err := api.GetAndUpdateWorkflowWithNew(
+		ctx,
+		nil,
+		definition.NewWorkflowKey(
+			namespaceID.String(),
+			request.GetExecution().GetWorkflowId(),
+			request.GetExecution().GetRunId(),
+		),
+		func(workflowLease api.WorkflowLease) (*api.UpdateWorkflowAction, error) {
+			mutableState := workflowLease.GetMutableState()
+			if mutableState.IsWorkflowExecutionPaused() {
+				return &api.UpdateWorkflowAction{
+					Noop:               true,
+					CreateWorkflowTask: false,
+				}, nil
+			}
+
+			// Tasks which are already created are dropped by the task executors and matching while the workflow
+			// is paused, and are regenerated when the workflow is unpaused.
+			if _, err := mutableState.AddWorkflowExecutionPausedEvent(); err != nil {
+				return nil, err
+			}
+			return &api.UpdateWorkflowAction{
+				Noop:               false,
+				CreateWorkflowTask: false,
+			}, nil
+		},
+		nil,
+		shardContext,
+		workflowConsistencyChecker,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &historyservice.PauseWorkflowExecutionResponse{}, nil
+}
@@ -60,6 +60,10 @@ func Invoke(
 			if !mutableState.IsWorkflowExecutionRunning() {
 				return nil, consts.ErrWorkflowCompleted
 			}
+			if mutableState.IsWorkflowExecutionPaused() {
+				// Matching can drop the task, it will be regenerated when the workflow is unpaused.
+				return nil, serviceerrors.NewObsoleteMatchingTask("workflow is paused")
+			}
 
 			response, rejectCode, err = recordActivityTaskStarted(
 				ctx, shardContext, mutableState, request, matchingClient,
@@ -223,6 +227,10 @@ func recordActivityTaskStarted(
 		}
 	}
 
+	// The in-flight task of the attempt is released in matching when the activity is completed by ID.
+	ai.InFlightTaskQueue = request.GetInFlightTaskQueue()
+	ai.InFlightId = request.GetInFlightId()
+
 	versioningStamp := worker_versioning.StampFromCapabilities(request.PollRequest.WorkerVersionCapabilities)
 	if _, err := mutableState.AddActivityTaskStartedEvent(
 		ai, scheduledEventID, requestID, request.PollRequest.GetIdentity(),
@@ -329,6 +337,8 @@ func processActivityWorkflowRules(
 		activityInfo.StartedEventId = common.EmptyEventID
 		activityInfo.StartedTime = nil
 		activityInfo.RequestId = ""
+		activityInfo.InFlightTaskQueue = ""
+		activityInfo.InFlightId = ""
 		return nil
 	}); err != nil {
 		return rejectCodeUndefined, err
@@ -28,6 +28,7 @@ import (
 	historyi "go.temporal.io/server/service/history/interfaces"
 	"go.temporal.io/server/service/history/workflow"
 	"go.temporal.io/server/service/history/workflow/update"
+	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -107,6 +108,11 @@ func Invoke(
 				return nil, serviceerrors.NewTaskAlreadyStarted("Workflow")
 			}
 
+			if mutableState.IsWorkflowExecutionPaused() {
+				// Matching can drop the task, it will be regenerated when the workflow is unpaused.
+				return nil, serviceerrors.NewObsoleteMatchingTask("workflow is paused")
+			}
+
 			// Assuming a workflow is running on a sticky task queue by a workerA.
 			// After workerA is dead for more than 10s, matching will return StickyWorkerUnavailable error when history
 			// tries to push a new workflow task. When history sees that error, it will fall back to push the task to
@@ -372,6 +378,7 @@ func CreateRecordWorkflowTaskStartedResponse(
 		BranchToken:                rawResp.BranchToken,
 		ScheduledTime:              rawResp.ScheduledTime,
 		StartedTime:                rawResp.StartedTime,
+		WorkflowTaskTimeout:        rawResp.WorkflowTaskTimeout,
 		Queries:                    rawResp.Queries,
 		Clock:                      rawResp.Clock,
 		Messages:                   rawResp.Messages,
@@ -409,6 +416,7 @@ func CreateRecordWorkflowTaskStartedResponseWithRawHistory(
 	}
 	response.ScheduledTime = timestamppb.New(workflowTask.ScheduledTime)
 	response.StartedTime = timestamppb.New(workflowTask.StartedTime)
+	response.WorkflowTaskTimeout = durationpb.New(workflowTask.WorkflowTaskTimeout)
 	response.Version = workflowTask.Version
 
 	// TODO (alex-update): Transient needs to be renamed to "TransientOrSpeculative"
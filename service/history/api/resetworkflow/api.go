@@ -159,6 +159,129 @@ func Invoke(
 	}, nil
 }
 
+// Preview returns what resetting the workflow would do, without mutating the workflow: the events which would be
+// reapplied or dropped, the pending activities and child workflows of the current run which would be abandoned, and
+// the mutable state the new run would be created with.
+func Preview(
+	ctx context.Context,
+	resetRequest *historyservice.PreviewResetWorkflowExecutionRequest,
+	shardContext historyi.ShardContext,
+	workflowConsistencyChecker api.WorkflowConsistencyChecker,
+) (_ *historyservice.PreviewResetWorkflowExecutionResponse, retError error) {
+	namespaceID := namespace.ID(resetRequest.GetNamespaceId())
+	err := api.ValidateNamespaceUUID(namespaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	request := resetRequest.ResetRequest
+	workflowID := request.WorkflowExecution.GetWorkflowId()
+	baseRunID := request.WorkflowExecution.GetRunId()
+
+	baseWorkflowLease, err := workflowConsistencyChecker.GetWorkflowLease(
+		ctx,
+		nil,
+		definition.NewWorkflowKey(
+			namespaceID.String(),
+			workflowID,
+			baseRunID,
+		),
+		locks.PriorityHigh,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { baseWorkflowLease.GetReleaseFn()(retError) }()
+
+	baseMutableState := baseWorkflowLease.GetMutableState()
+	if request.GetWorkflowTaskFinishEventId() <= common.FirstEventID ||
+		request.GetWorkflowTaskFinishEventId() >= baseMutableState.GetNextEventID() {
+		return nil, serviceerror.NewInvalidArgument("Workflow task finish ID must be > 1 && <= workflow last event ID.")
+	}
+
+	currentRunID, err := workflowConsistencyChecker.GetCurrentRunID(
+		ctx,
+		namespaceID.String(),
+		workflowID,
+		locks.PriorityHigh,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if baseRunID == "" {
+		baseRunID = currentRunID
+	}
+
+	currentWorkflowLease := baseWorkflowLease
+	if currentRunID != baseRunID {
+		currentWorkflowLease, err = workflowConsistencyChecker.GetWorkflowLease(
+			ctx,
+			nil,
+			definition.NewWorkflowKey(
+				namespaceID.String(),
+				workflowID,
+				currentRunID,
+			),
+			locks.PriorityHigh,
+		)
+		if err != nil {
+			return nil, err
+		}
+		defer func() { currentWorkflowLease.GetReleaseFn()(retError) }()
+	}
+
+	baseRebuildLastEventID := request.GetWorkflowTaskFinishEventId() - 1
+	baseCurrentVersionHistory, err := versionhistory.GetCurrentVersionHistory(
+		baseMutableState.GetExecutionInfo().GetVersionHistories(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	baseRebuildLastEventVersion, err := versionhistory.GetVersionHistoryEventVersion(baseCurrentVersionHistory, baseRebuildLastEventID)
+	if err != nil {
+		return nil, err
+	}
+
+	namespaceEntry, err := api.GetActiveNamespace(shardContext, namespaceID)
+	if err != nil {
+		return nil, err
+	}
+	allowResetWithPendingChildren := shardContext.GetConfig().AllowResetWithPendingChildren(namespaceEntry.Name().String())
+	preview, err := ndc.NewWorkflowResetter(
+		shardContext,
+		workflowConsistencyChecker.GetWorkflowCache(),
+		shardContext.GetLogger(),
+	).PreviewResetWorkflow(
+		ctx,
+		namespaceID,
+		workflowID,
+		baseRunID,
+		baseCurrentVersionHistory.GetBranchToken(),
+		baseRebuildLastEventID,
+		baseRebuildLastEventVersion,
+		baseMutableState.GetNextEventID(),
+		ndc.NewWorkflow(
+			shardContext.GetClusterMetadata(),
+			currentWorkflowLease.GetContext(),
+			currentWorkflowLease.GetMutableState(),
+			currentWorkflowLease.GetReleaseFn(),
+		),
+		request.GetReason(),
+		GetResetReapplyExcludeTypes(request.GetResetReapplyExcludeTypes(), request.GetResetReapplyType()),
+		allowResetWithPendingChildren,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &historyservice.PreviewResetWorkflowExecutionResponse{
+		ResetMutableState:   preview.ResetMutableState,
+		ReappliedEvents:     preview.ReappliedEvents,
+		DroppedEvents:       preview.DroppedEvents,
+		AbandonedActivities: preview.AbandonedActivities,
+		AbandonedChildren:   preview.AbandonedChildren,
+	}, nil
+}
+
 // GetResetReapplyExcludeTypes computes the set of requested exclude types. It
 // uses the reset_reapply_exclude_types request field (a set of event types to
 // exclude from reapply), as well as the deprecated reset_reapply_type request
@@ -41,6 +41,8 @@ func Invoke(
 	var activityStartedTime time.Time
 	var taskQueue string
 	var workflowTypeName string
+	var inFlightTaskQueue string
+	var inFlightID string
 	err = api.GetAndUpdateWorkflowWithNew(
 		ctx,
 		token.Clock,
@@ -80,6 +82,8 @@ func Invoke(
 				(token.GetVersion() != common.EmptyVersion && token.Version != ai.Version) {
 				return nil, consts.ErrActivityTaskNotFound
 			}
+			inFlightTaskQueue = ai.InFlightTaskQueue
+			inFlightID = ai.InFlightId
 
 			// sanity check if activity is requested to be cancelled
 			if !ai.CancelRequested {
@@ -118,5 +122,8 @@ func Invoke(
 			),
 		).Record(time.Since(activityStartedTime))
 	}
-	return &historyservice.RespondActivityTaskCanceledResponse{}, err
+	return &historyservice.RespondActivityTaskCanceledResponse{
+		InFlightTaskQueue: inFlightTaskQueue,
+		InFlightId:        inFlightID,
+	}, err
 }
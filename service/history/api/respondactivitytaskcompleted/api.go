@@ -41,6 +41,8 @@ func Invoke(
 	var activityStartedTime time.Time
 	var taskQueue string
 	var workflowTypeName string
+	var inFlightTaskQueue string
+	var inFlightID string
 	var fabricateStartedEvent bool
 	err = api.GetAndUpdateWorkflowWithNew(
 		ctx,
@@ -83,6 +85,8 @@ func Invoke(
 				(token.GetVersion() != common.EmptyVersion && token.Version != ai.Version) {
 				return nil, consts.ErrActivityTaskNotFound
 			}
+			inFlightTaskQueue = ai.InFlightTaskQueue
+			inFlightID = ai.InFlightId
 
 			// We fabricate a started event only when the activity is not started yet and
 			// we need to force complete an activity
@@ -131,5 +135,8 @@ func Invoke(
 			),
 		).Record(time.Since(activityStartedTime))
 	}
-	return &historyservice.RespondActivityTaskCompletedResponse{}, err
+	return &historyservice.RespondActivityTaskCompletedResponse{
+		InFlightTaskQueue: inFlightTaskQueue,
+		InFlightId:        inFlightID,
+	}, err
 }
@@ -43,6 +43,8 @@ func Invoke(
 	var activityStartedTime time.Time
 	var taskQueue string
 	var workflowTypeName string
+	var inFlightTaskQueue string
+	var inFlightID string
 	err = api.GetAndUpdateWorkflowWithNew(
 		ctx,
 		token.Clock,
@@ -82,6 +84,8 @@ func Invoke(
 				(token.GetVersion() != common.EmptyVersion && token.Version != ai.Version) {
 				return nil, consts.ErrActivityTaskNotFound
 			}
+			inFlightTaskQueue = ai.InFlightTaskQueue
+			inFlightID = ai.InFlightId
 
 			if request.GetLastHeartbeatDetails() != nil {
 				// Save heartbeat details as progress
@@ -129,5 +133,8 @@ func Invoke(
 			),
 		).Record(time.Since(activityStartedTime))
 	}
-	return &historyservice.RespondActivityTaskFailedResponse{}, err
+	return &historyservice.RespondActivityTaskFailedResponse{
+		InFlightTaskQueue: inFlightTaskQueue,
+		InFlightId:        inFlightID,
+	}, err
 }
@@ -489,7 +489,9 @@ func (handler *WorkflowTaskCompletedHandler) Invoke(
 		}
 	}
 
-	bypassTaskGeneration := request.GetReturnNewWorkflowTask() && wtFailedCause == nil
+	// New workflow task of a paused workflow is not returned to the worker, and its transfer task is dropped until
+	// the workflow is unpaused.
+	bypassTaskGeneration := request.GetReturnNewWorkflowTask() && wtFailedCause == nil && !ms.IsWorkflowExecutionPaused()
 	// TODO (alex-update): All current SDKs always set ReturnNewWorkflowTask to true
 	//  which means that server always bypass task generation if WFT didn't fail.
 	//  ReturnNewWorkflowTask flag needs to be removed.
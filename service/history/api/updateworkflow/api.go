@@ -50,6 +50,7 @@ type Updater struct {
 	// to avoid data races when used outside the workflow lease.
 	taskQueue              *taskqueuepb.TaskQueue
 	priority               *commonpb.Priority
+	fairnessKey            string
 	normalTaskQueueName    string
 	scheduledEventID       int64
 	scheduleToStartTimeout time.Duration
@@ -169,6 +170,15 @@ func (u *Updater) ApplyRequest(
 		}, nil
 	}
 
+	// If workflow is paused, normal WT is created instead of speculative WT, which bypasses transfer tasks and
+	// would be added to matching directly. Its transfer task is dropped until the workflow is unpaused.
+	if ms.IsWorkflowExecutionPaused() {
+		return &api.UpdateWorkflowAction{
+			Noop:               false,
+			CreateWorkflowTask: true,
+		}, nil
+	}
+
 	// This will try not to add an event but will create speculative WT in mutable state.
 	newWorkflowTask, err := ms.AddWorkflowTaskScheduledEvent(false, enumsspb.WORKFLOW_TASK_TYPE_SPECULATIVE)
 	if err != nil {
@@ -188,6 +198,7 @@ func (u *Updater) ApplyRequest(
 
 	u.taskQueue = common.CloneProto(newWorkflowTask.TaskQueue)
 	u.priority = common.CloneProto(ms.GetExecutionInfo().Priority)
+	u.fairnessKey = workflow.FairnessKey(u.shardCtx, ms)
 	u.normalTaskQueueName = ms.GetExecutionInfo().TaskQueue
 	u.directive = worker_versioning.MakeDirectiveForWorkflowTask(
 		ms.GetInheritedBuildId(),
@@ -276,6 +287,7 @@ func (u *Updater) addWorkflowTaskToMatching(ctx context.Context) error {
 		Clock:                  clock,
 		VersionDirective:       u.directive,
 		Priority:               u.priority,
+		FairnessKey:            u.fairnessKey,
 	})
 	if err != nil {
 		return err
@@ -158,6 +158,7 @@ func (f *archivalQueueFactory) newScheduledQueue(shard historyi.ShardContext, ex
 		shard.GetTimeSource(),
 		shard.GetNamespaceRegistry(),
 		shard.GetClusterMetadata(),
+		f.Config.ShardMapProvider,
 		logger,
 		metricsHandler,
 		f.Tracer,
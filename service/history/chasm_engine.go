@@ -10,6 +10,7 @@ import (
 	"go.temporal.io/server/common/definition"
 	"go.temporal.io/server/common/headers"
 	"go.temporal.io/server/common/locks"
+	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/service/history/api"
 	"go.temporal.io/server/service/history/configs"
 	"go.temporal.io/server/service/history/consts"
@@ -131,16 +132,30 @@ func (e *ChasmEngine) ReadComponent(
 	return readFn(chasmContext, component)
 }
 
-func (e *ChasmEngine) getExecutionLease(
-	ctx context.Context,
+// getShardContext returns the shard of the run that contains the referenced component. Entities which are sharded like
+// workflows are routed like workflows, to the shard they are moved to during a shard split.
+func (e *ChasmEngine) getShardContext(
 	ref chasm.ComponentRef,
-) (historyi.ShardContext, api.WorkflowLease, error) {
-	shardID, err := ref.ShardID(e.registry, e.config.NumberOfShards)
+) (historyi.ShardContext, error) {
+	shardingKey, err := ref.ShardingKey(e.registry)
 	if err != nil {
-		return nil, nil, err
+		return nil, err
+	}
+	if shardingKey == ref.NamespaceID+"_"+ref.BusinessID {
+		return e.shardController.GetShardByNamespaceWorkflow(namespace.ID(ref.NamespaceID), ref.BusinessID)
+	}
+	shardID, err := ref.ShardID(e.registry, e.config.ShardMapProvider.ShardMap())
+	if err != nil {
+		return nil, err
 	}
+	return e.shardController.GetShardByID(shardID)
+}
 
-	shardContext, err := e.shardController.GetShardByID(shardID)
+func (e *ChasmEngine) getExecutionLease(
+	ctx context.Context,
+	ref chasm.ComponentRef,
+) (historyi.ShardContext, api.WorkflowLease, error) {
+	shardContext, err := e.getShardContext(ref)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -1,15 +1,17 @@
 package configs
 
 import (
-	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/retrypolicy"
+	"go.temporal.io/server/common/shardmap"
 )
 
 // Config represents configuration for history service
 type Config struct {
 	NumberOfShards int32
+	// ShardMapProvider routes workflows to shards, which may be more than NumberOfShards after a shard split.
+	ShardMapProvider *shardmap.Provider
 
 	EnableReplicationStream dynamicconfig.BoolPropertyFn
 	HistoryReplicationDLQV2 dynamicconfig.BoolPropertyFn
@@ -30,6 +32,7 @@ type Config struct {
 	VisibilityPersistenceSlowQueryThreshold dynamicconfig.DurationPropertyFn
 	EnableReadFromSecondaryVisibility       dynamicconfig.BoolPropertyFnWithNamespaceFilter
 	VisibilityEnableShadowReadMode          dynamicconfig.BoolPropertyFn
+	VisibilityShadowReadVerificationRate    dynamicconfig.FloatPropertyFnWithNamespaceFilter
 	SecondaryVisibilityWritingMode          dynamicconfig.StringPropertyFn
 	VisibilityDisableOrderByClause          dynamicconfig.BoolPropertyFnWithNamespaceFilter
 	VisibilityEnableManualPagination        dynamicconfig.BoolPropertyFnWithNamespaceFilter
@@ -47,6 +50,12 @@ type Config struct {
 	AllowResetWithPendingChildren dynamicconfig.BoolPropertyFnWithNamespaceFilter
 	MaxAutoResetPoints            dynamicconfig.IntPropertyFnWithNamespaceFilter
 
+	// EnableVirtualClock marks test namespaces whose workflow timers follow a virtual clock
+	EnableVirtualClock dynamicconfig.BoolPropertyFnWithNamespaceFilter
+
+	// FairnessKeySearchAttribute is the search attribute holding the fairness key of the tasks of a workflow
+	FairnessKeySearchAttribute dynamicconfig.StringPropertyFnWithNamespaceFilter
+
 	// HistoryCache settings
 	// Change of these configs require shard restart
 	HistoryCacheLimitSizeBased            bool
@@ -84,6 +93,18 @@ type Config struct {
 	ShardLingerTimeLimit         dynamicconfig.DurationPropertyFn
 	ShardFinalizerTimeout        dynamicconfig.DurationPropertyFn
 
+	// ShardRebalancer settings
+	ShardLoadReportInterval           dynamicconfig.DurationPropertyFn
+	ShardRebalancerEnabled            dynamicconfig.BoolPropertyFn
+	ShardRebalancerLoadThreshold      dynamicconfig.FloatPropertyFn
+	ShardRebalancerSustainedIntervals dynamicconfig.IntPropertyFn
+	ShardRebalancerMinHoldTime        dynamicconfig.DurationPropertyFn
+	ShardRebalancerMaxTakenOverShards dynamicconfig.IntPropertyFn
+
+	// ShardSplit settings
+	ShardSplitTargetShardCount dynamicconfig.IntPropertyFn
+	ShardSplitInterval         dynamicconfig.DurationPropertyFn
+
 	HistoryClientOwnershipCachingEnabled dynamicconfig.BoolPropertyFn
 
 	// the artificial delay added to standby cluster's view of active cluster's time
@@ -371,7 +392,8 @@ func NewConfig(
 	numberOfShards int32,
 ) *Config {
 	cfg := &Config{
-		NumberOfShards: numberOfShards,
+		NumberOfShards:   numberOfShards,
+		ShardMapProvider: shardmap.NewProvider(numberOfShards),
 
 		EnableReplicationStream: dynamicconfig.EnableReplicationStream.Get(dc),
 		HistoryReplicationDLQV2: dynamicconfig.EnableHistoryReplicationDLQV2.Get(dc),
@@ -390,6 +412,8 @@ func NewConfig(
 		ShutdownDrainDuration:                dynamicconfig.HistoryShutdownDrainDuration.Get(dc),
 		StartupMembershipJoinDelay:           dynamicconfig.HistoryStartupMembershipJoinDelay.Get(dc),
 		AllowResetWithPendingChildren:        dynamicconfig.AllowResetWithPendingChildren.Get(dc),
+		EnableVirtualClock:                   dynamicconfig.EnableVirtualClock.Get(dc),
+		FairnessKeySearchAttribute:           dynamicconfig.FairnessKeySearchAttribute.Get(dc),
 		MaxAutoResetPoints:                   dynamicconfig.HistoryMaxAutoResetPoints.Get(dc),
 		DefaultWorkflowTaskTimeout:           dynamicconfig.DefaultWorkflowTaskTimeout.Get(dc),
 
@@ -398,6 +422,7 @@ func NewConfig(
 		VisibilityPersistenceSlowQueryThreshold: dynamicconfig.VisibilityPersistenceSlowQueryThreshold.Get(dc),
 		EnableReadFromSecondaryVisibility:       dynamicconfig.EnableReadFromSecondaryVisibility.Get(dc),
 		VisibilityEnableShadowReadMode:          dynamicconfig.VisibilityEnableShadowReadMode.Get(dc),
+		VisibilityShadowReadVerificationRate:    dynamicconfig.VisibilityShadowReadVerificationRate.Get(dc),
 		SecondaryVisibilityWritingMode:          dynamicconfig.SecondaryVisibilityWritingMode.Get(dc),
 		VisibilityDisableOrderByClause:          dynamicconfig.VisibilityDisableOrderByClause.Get(dc),
 		VisibilityEnableManualPagination:        dynamicconfig.VisibilityEnableManualPagination.Get(dc),
@@ -438,6 +463,16 @@ func NewConfig(
 		ShardLingerTimeLimit:         dynamicconfig.ShardLingerTimeLimit.Get(dc),
 		ShardFinalizerTimeout:        dynamicconfig.ShardFinalizerTimeout.Get(dc),
 
+		ShardLoadReportInterval:           dynamicconfig.ShardLoadReportInterval.Get(dc),
+		ShardRebalancerEnabled:            dynamicconfig.ShardRebalancerEnabled.Get(dc),
+		ShardRebalancerLoadThreshold:      dynamicconfig.ShardRebalancerLoadThreshold.Get(dc),
+		ShardRebalancerSustainedIntervals: dynamicconfig.ShardRebalancerSustainedIntervals.Get(dc),
+		ShardRebalancerMinHoldTime:        dynamicconfig.ShardRebalancerMinHoldTime.Get(dc),
+		ShardRebalancerMaxTakenOverShards: dynamicconfig.ShardRebalancerMaxTakenOverShards.Get(dc),
+
+		ShardSplitTargetShardCount: dynamicconfig.ShardSplitTargetShardCount.Get(dc),
+		ShardSplitInterval:         dynamicconfig.ShardSplitInterval.Get(dc),
+
 		HistoryClientOwnershipCachingEnabled: dynamicconfig.HistoryClientOwnershipCachingEnabled.Get(dc),
 
 		StandbyClusterDelay:                  dynamicconfig.StandbyClusterDelay.Get(dc),
@@ -690,5 +725,5 @@ func NewConfig(
 
 // GetShardID return the corresponding shard ID for a given namespaceID and workflowID pair
 func (config *Config) GetShardID(namespaceID namespace.ID, workflowID string) int32 {
-	return common.WorkflowIDToHistoryShard(namespaceID.String(), workflowID, config.NumberOfShards)
+	return config.ShardMapProvider.WorkflowIDToHistoryShard(namespaceID.String(), workflowID)
 }
@@ -23,6 +23,7 @@ import (
 	"go.temporal.io/server/common/resource"
 	"go.temporal.io/server/common/rpc/interceptor"
 	"go.temporal.io/server/common/searchattribute"
+	"go.temporal.io/server/common/shardmap"
 	"go.temporal.io/server/common/tasktoken"
 	"go.temporal.io/server/components/callbacks"
 	"go.temporal.io/server/components/nexusoperations"
@@ -100,7 +101,7 @@ func HandlerProvider(args NewHandlerArgs) *Handler {
 		persistenceVisibilityManager: args.PersistenceVisibilityManager,
 		persistenceHealthSignal:      args.PersistenceHealthSignal,
 		healthServer:                 args.HealthServer,
-		historyServiceResolver:       args.HistoryServiceResolver,
+		shardOwners:                  args.ShardOwners,
 		metricsHandler:               args.MetricsHandler,
 		payloadSerializer:            args.PayloadSerializer,
 		timeSource:                   args.TimeSource,
@@ -115,6 +116,13 @@ func HandlerProvider(args NewHandlerArgs) *Handler {
 		taskQueueManager:             args.TaskQueueManager,
 		taskCategoryRegistry:         args.TaskCategoryRegistry,
 		dlqMetricsEmitter:            args.DLQMetricsEmitter,
+		virtualClockRefresher: newVirtualClockRefresher(
+			args.Config,
+			args.NamespaceRegistry,
+			args.PersistenceVisibilityManager,
+			args.ShardController,
+			args.Logger,
+		),
 
 		replicationTaskFetcherFactory:    args.ReplicationTaskFetcherFactory,
 		replicationTaskConverterProvider: args.ReplicationTaskConverterFactory,
@@ -138,11 +146,14 @@ func ConfigProvider(
 	dc *dynamicconfig.Collection,
 	persistenceConfig config.Persistence,
 	esConfig *esclient.Config,
+	shardMapProvider *shardmap.Provider,
 ) *configs.Config {
-	return configs.NewConfig(
+	config := configs.NewConfig(
 		dc,
 		persistenceConfig.NumHistoryShards,
 	)
+	config.ShardMapProvider = shardMapProvider
+	return config
 }
 
 func ThrottledLoggerRpsFnProvider(serviceConfig *configs.Config) resource.ThrottledLoggerRpsFn {
@@ -259,6 +270,7 @@ func VisibilityManagerProvider(
 		serviceConfig.VisibilityPersistenceSlowQueryThreshold,
 		serviceConfig.EnableReadFromSecondaryVisibility,
 		serviceConfig.VisibilityEnableShadowReadMode,
+		serviceConfig.VisibilityShadowReadVerificationRate,
 		serviceConfig.SecondaryVisibilityWritingMode,
 		serviceConfig.VisibilityDisableOrderByClause,
 		serviceConfig.VisibilityEnableManualPagination,
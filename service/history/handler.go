@@ -24,7 +24,6 @@ import (
 	"go.temporal.io/server/common/archiver"
 	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/cluster"
-	"go.temporal.io/server/common/convert"
 	"go.temporal.io/server/common/definition"
 	"go.temporal.io/server/common/headers"
 	"go.temporal.io/server/common/log"
@@ -77,7 +76,7 @@ type (
 		persistenceVisibilityManager manager.VisibilityManager
 		persistenceHealthSignal      persistence.HealthSignalAggregator
 		healthServer                 *health.Server
-		historyServiceResolver       membership.ServiceResolver
+		shardOwners                  *membership.ShardOwners
 		metricsHandler               metrics.Handler
 		payloadSerializer            serialization.Serializer
 		timeSource                   clock.TimeSource
@@ -91,6 +90,7 @@ type (
 		taskQueueManager             persistence.HistoryTaskQueueManager
 		taskCategoryRegistry         tasks.TaskCategoryRegistry
 		dlqMetricsEmitter            *persistence.DLQMetricsEmitter
+		virtualClockRefresher        *virtualClockRefresher
 
 		replicationTaskFetcherFactory    replication.TaskFetcherFactory
 		replicationTaskConverterProvider replication.SourceTaskConverterProvider
@@ -108,7 +108,7 @@ type (
 		PersistenceHealthSignal      persistence.HealthSignalAggregator
 		HealthServer                 *health.Server
 		PersistenceVisibilityManager manager.VisibilityManager
-		HistoryServiceResolver       membership.ServiceResolver
+		ShardOwners                  *membership.ShardOwners
 		MetricsHandler               metrics.Handler
 		PayloadSerializer            serialization.Serializer
 		TimeSource                   clock.TimeSource
@@ -165,6 +165,7 @@ func (h *Handler) Start() {
 	h.eventNotifier.Start()
 	h.controller.Start()
 	h.dlqMetricsEmitter.Start()
+	h.virtualClockRefresher.Start()
 
 	h.startWG.Done()
 }
@@ -181,6 +182,7 @@ func (h *Handler) Stop() {
 
 	h.streamReceiverMonitor.Stop()
 	h.replicationTaskFetcherFactory.Stop()
+	h.virtualClockRefresher.Stop()
 	h.controller.Stop()
 	h.eventNotifier.Stop()
 	h.dlqMetricsEmitter.Stop()
@@ -675,7 +677,7 @@ func (h *Handler) DescribeHistoryHost(_ context.Context, req *historyservice.Des
 	// if option 2/3 is provided, we want to check on the shard ownership to return the correct host address.
 	shardID := req.GetShardId()
 	if len(req.GetNamespaceId()) != 0 && req.GetWorkflowExecution() != nil {
-		shardID = common.WorkflowIDToHistoryShard(req.GetNamespaceId(), req.GetWorkflowExecution().GetWorkflowId(), h.config.NumberOfShards)
+		shardID = h.config.GetShardID(namespace.ID(req.GetNamespaceId()), req.GetWorkflowExecution().GetWorkflowId())
 	}
 	if shardID > 0 {
 		_, err := h.controller.GetShardByID(shardID)
@@ -2103,15 +2105,13 @@ func (h *Handler) StreamWorkflowReplicationMessages(
 	if err != nil {
 		return h.convertError(err)
 	}
-	allClusterInfo := shardContext.GetClusterMetadata().GetAllClusterInfo()
-	clientClusterName, clientShardCount, err := replication.ClusterIDToClusterNameShardCount(allClusterInfo, clientClusterShardID.ClusterID)
-	if err != nil {
-		return h.convertError(err)
-	}
-	_, serverShardCount, err := replication.ClusterIDToClusterNameShardCount(allClusterInfo, int32(shardContext.GetClusterMetadata().GetClusterID()))
+	clusterMetadata := shardContext.GetClusterMetadata()
+	clientClusterName, _, err := replication.ClusterIDToClusterNameShardCount(clusterMetadata.GetAllClusterInfo(), clientClusterShardID.ClusterID)
 	if err != nil {
 		return h.convertError(err)
 	}
+	clientShardCount := h.config.ShardMapProvider.ClusterShardCount(clusterMetadata, clientClusterName)
+	serverShardCount := h.config.ShardMapProvider.ClusterShardCount(clusterMetadata, clusterMetadata.GetCurrentClusterName())
 	err = common.VerifyShardIDMapping(clientShardCount, serverShardCount, clientClusterShardID.ShardID, serverClusterShardID.ShardID)
 	if err != nil {
 		return h.convertError(err)
@@ -2256,10 +2256,9 @@ func (h *Handler) ForceDeleteWorkflowExecution(
 	if err != nil {
 		return nil, err
 	}
-	shardID := common.WorkflowIDToHistoryShard(
-		namespaceID.String(),
+	shardID := h.config.GetShardID(
+		namespaceID,
 		request.Request.Execution.WorkflowId,
-		h.config.NumberOfShards,
 	)
 
 	workflowExecution := request.GetRequest().GetExecution()
@@ -2399,7 +2398,7 @@ func (h *Handler) convertError(err error) error {
 	switch err := err.(type) {
 	case *persistence.ShardOwnershipLostError:
 		hostInfo := h.hostInfoProvider.HostInfo()
-		if ownerInfo, err := h.historyServiceResolver.Lookup(convert.Int32ToString(err.ShardID)); err == nil {
+		if ownerInfo, err := h.shardOwners.Lookup(err.ShardID); err == nil {
 			return serviceerrors.NewShardOwnershipLost(ownerInfo.GetAddress(), hostInfo.GetAddress())
 		}
 		return serviceerrors.NewShardOwnershipLost("", hostInfo.GetAddress())
@@ -2600,3 +2599,200 @@ func (h *Handler) ResetActivity(
 	}
 	return response, nil
 }
+
+func (h *Handler) MoveSearchAttributeValue(
+	ctx context.Context, request *historyservice.MoveSearchAttributeValueRequest,
+) (response *historyservice.MoveSearchAttributeValueResponse, retError error) {
+	defer metrics.CapturePanic(h.logger, h.metricsHandler, &retError)
+	h.startWG.Wait()
+
+	namespaceID := namespace.ID(request.GetNamespaceId())
+	workflowID := request.GetExecution().GetWorkflowId()
+	if request.GetNamespaceId() == "" {
+		return nil, h.convertError(errNamespaceNotSet)
+	}
+
+	shardContext, err := h.controller.GetShardByNamespaceWorkflow(namespaceID, workflowID)
+	if err != nil {
+		return nil, h.convertError(err)
+	}
+	engine, err := shardContext.GetEngine(ctx)
+	if err != nil {
+		return nil, h.convertError(err)
+	}
+
+	response, err = engine.MoveSearchAttributeValue(ctx, request)
+	if err != nil {
+		return nil, h.convertError(err)
+	}
+	return response, nil
+}
+
+func (h *Handler) DeleteOrphanedCurrentExecution(
+	ctx context.Context, request *historyservice.DeleteOrphanedCurrentExecutionRequest,
+) (response *historyservice.DeleteOrphanedCurrentExecutionResponse, retError error) {
+	defer metrics.CapturePanic(h.logger, h.metricsHandler, &retError)
+	h.startWG.Wait()
+
+	namespaceID := namespace.ID(request.GetNamespaceId())
+	workflowID := request.GetExecution().GetWorkflowId()
+	if request.GetNamespaceId() == "" {
+		return nil, h.convertError(errNamespaceNotSet)
+	}
+
+	shardContext, err := h.controller.GetShardByNamespaceWorkflow(namespaceID, workflowID)
+	if err != nil {
+		return nil, h.convertError(err)
+	}
+	engine, err := shardContext.GetEngine(ctx)
+	if err != nil {
+		return nil, h.convertError(err)
+	}
+
+	response, err = engine.DeleteOrphanedCurrentExecution(ctx, request)
+	if err != nil {
+		return nil, h.convertError(err)
+	}
+	return response, nil
+}
+
+func (h *Handler) DeleteOrphanedHistoryTask(
+	ctx context.Context, request *historyservice.DeleteOrphanedHistoryTaskRequest,
+) (response *historyservice.DeleteOrphanedHistoryTaskResponse, retError error) {
+	defer metrics.CapturePanic(h.logger, h.metricsHandler, &retError)
+	h.startWG.Wait()
+
+	if request.GetShardId() == 0 {
+		return nil, h.convertError(errShardIDNotSet)
+	}
+	if request.GetNamespaceId() == "" {
+		return nil, h.convertError(errNamespaceNotSet)
+	}
+
+	shardContext, err := h.controller.GetShardByID(request.GetShardId())
+	if err != nil {
+		return nil, h.convertError(err)
+	}
+	engine, err := shardContext.GetEngine(ctx)
+	if err != nil {
+		return nil, h.convertError(err)
+	}
+
+	response, err = engine.DeleteOrphanedHistoryTask(ctx, request)
+	if err != nil {
+		return nil, h.convertError(err)
+	}
+	return response, nil
+}
+
+func (h *Handler) PauseWorkflowExecution(
+	ctx context.Context, request *historyservice.PauseWorkflowExecutionRequest,
+) (response *historyservice.PauseWorkflowExecutionResponse, retError error) {
+	defer metrics.CapturePanic(h.logger, h.metricsHandler, &retError)
+	h.startWG.Wait()
+
+	namespaceID := namespace.ID(request.GetNamespaceId())
+	workflowID := request.GetExecution().GetWorkflowId()
+	if request.GetNamespaceId() == "" {
+		return nil, h.convertError(errNamespaceNotSet)
+	}
+
+	shardContext, err := h.controller.GetShardByNamespaceWorkflow(namespaceID, workflowID)
+	if err != nil {
+		return nil, h.convertError(err)
+	}
+	engine, err := shardContext.GetEngine(ctx)
+	if err != nil {
+		return nil, h.convertError(err)
+	}
+
+	response, err = engine.PauseWorkflowExecution(ctx, request)
+	if err != nil {
+		return nil, h.convertError(err)
+	}
+	return response, nil
+}
+
+func (h *Handler) UnpauseWorkflowExecution(
+	ctx context.Context, request *historyservice.UnpauseWorkflowExecutionRequest,
+) (response *historyservice.UnpauseWorkflowExecutionResponse, retError error) {
+	defer metrics.CapturePanic(h.logger, h.metricsHandler, &retError)
+	h.startWG.Wait()
+
+	namespaceID := namespace.ID(request.GetNamespaceId())
+	workflowID := request.GetExecution().GetWorkflowId()
+	if request.GetNamespaceId() == "" {
+		return nil, h.convertError(errNamespaceNotSet)
+	}
+
+	shardContext, err := h.controller.GetShardByNamespaceWorkflow(namespaceID, workflowID)
+	if err != nil {
+		return nil, h.convertError(err)
+	}
+	engine, err := shardContext.GetEngine(ctx)
+	if err != nil {
+		return nil, h.convertError(err)
+	}
+
+	response, err = engine.UnpauseWorkflowExecution(ctx, request)
+	if err != nil {
+		return nil, h.convertError(err)
+	}
+	return response, nil
+}
+
+func (h *Handler) ForkWorkflowExecution(
+	ctx context.Context, request *historyservice.ForkWorkflowExecutionRequest,
+) (response *historyservice.ForkWorkflowExecutionResponse, retError error) {
+	defer metrics.CapturePanic(h.logger, h.metricsHandler, &retError)
+	h.startWG.Wait()
+
+	namespaceID := namespace.ID(request.GetNamespaceId())
+	if request.GetNamespaceId() == "" || request.GetSourceNamespaceId() == "" {
+		return nil, h.convertError(errNamespaceNotSet)
+	}
+
+	// The new workflow is created by its own shard, the source workflow is read through the history client.
+	shardContext, err := h.controller.GetShardByNamespaceWorkflow(namespaceID, request.GetWorkflowId())
+	if err != nil {
+		return nil, h.convertError(err)
+	}
+	engine, err := shardContext.GetEngine(ctx)
+	if err != nil {
+		return nil, h.convertError(err)
+	}
+
+	response, err = engine.ForkWorkflowExecution(ctx, request)
+	if err != nil {
+		return nil, h.convertError(err)
+	}
+	return response, nil
+}
+
+func (h *Handler) PreviewResetWorkflowExecution(
+	ctx context.Context, request *historyservice.PreviewResetWorkflowExecutionRequest,
+) (response *historyservice.PreviewResetWorkflowExecutionResponse, retError error) {
+	defer metrics.CapturePanic(h.logger, h.metricsHandler, &retError)
+	h.startWG.Wait()
+
+	namespaceID := namespace.ID(request.GetNamespaceId())
+	if namespaceID == "" {
+		return nil, h.convertError(errNamespaceNotSet)
+	}
+
+	workflowID := request.GetResetRequest().GetWorkflowExecution().GetWorkflowId()
+	shardContext, err := h.controller.GetShardByNamespaceWorkflow(namespaceID, workflowID)
+	if err != nil {
+		return nil, h.convertError(err)
+	}
+	engine, err := shardContext.GetEngine(ctx)
+	if err != nil {
+		return nil, h.convertError(err)
+	}
+
+	response, err = engine.PreviewResetWorkflowExecution(ctx, request)
+	if err != nil {
+		return nil, h.convertError(err)
+	}
+	return response, nil
+}
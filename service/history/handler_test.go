@@ -13,6 +13,7 @@ import (
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/serviceerror"
+	"go.temporal.io/server/common/shardmap"
 	"go.temporal.io/server/service/history/configs"
 	"go.temporal.io/server/service/history/shard"
 	"go.temporal.io/server/service/history/tests"
@@ -27,7 +28,8 @@ func TestDescribeHistoryHost(t *testing.T) {
 	hostInfoProvider := membership.NewMockHostInfoProvider(ctrl)
 	h := Handler{
 		config: &configs.Config{
-			NumberOfShards: 10,
+			NumberOfShards:   10,
+			ShardMapProvider: shardmap.NewProvider(10),
 		},
 		metricsHandler:    metrics.NoopMetricsHandler,
 		logger:            log.NewNoopLogger(),
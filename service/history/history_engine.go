@@ -35,9 +35,12 @@ import (
 	"go.temporal.io/server/common/testing/testhooks"
 	"go.temporal.io/server/service/history/api"
 	"go.temporal.io/server/service/history/api/addtasks"
+	"go.temporal.io/server/service/history/api/deleteorphanedcurrentexecution"
+	"go.temporal.io/server/service/history/api/deleteorphanedhistorytask"
 	"go.temporal.io/server/service/history/api/deleteworkflow"
 	"go.temporal.io/server/service/history/api/describemutablestate"
 	"go.temporal.io/server/service/history/api/describeworkflow"
+	"go.temporal.io/server/service/history/api/forkworkflow"
 	"go.temporal.io/server/service/history/api/getworkflowexecutionhistory"
 	"go.temporal.io/server/service/history/api/getworkflowexecutionhistoryreverse"
 	"go.temporal.io/server/service/history/api/getworkflowexecutionrawhistory"
@@ -45,8 +48,11 @@ import (
 	"go.temporal.io/server/service/history/api/isactivitytaskvalid"
 	"go.temporal.io/server/service/history/api/isworkflowtaskvalid"
 	"go.temporal.io/server/service/history/api/listtasks"
+	"go.temporal.io/server/service/history/api/movesearchattributevalue"
+	"go.temporal.io/server/service/history/api/moveworkflowexecution"
 	"go.temporal.io/server/service/history/api/multioperation"
 	"go.temporal.io/server/service/history/api/pauseactivity"
+	"go.temporal.io/server/service/history/api/pauseworkflow"
 	"go.temporal.io/server/service/history/api/pollupdate"
 	"go.temporal.io/server/service/history/api/queryworkflow"
 	"go.temporal.io/server/service/history/api/reapplyevents"
@@ -73,6 +79,7 @@ import (
 	"go.temporal.io/server/service/history/api/startworkflow"
 	"go.temporal.io/server/service/history/api/terminateworkflow"
 	"go.temporal.io/server/service/history/api/unpauseactivity"
+	"go.temporal.io/server/service/history/api/unpauseworkflow"
 	"go.temporal.io/server/service/history/api/updateactivityoptions"
 	"go.temporal.io/server/service/history/api/updateworkflow"
 	"go.temporal.io/server/service/history/api/updateworkflowoptions"
@@ -821,6 +828,24 @@ func (e *historyEngineImpl) ResetWorkflowExecution(
 	return resetworkflow.Invoke(ctx, req, e.shardContext, e.workflowConsistencyChecker)
 }
 
+// PreviewResetWorkflowExecution returns what ResetWorkflowExecution would do with the request, without mutating the
+// workflow.
+func (e *historyEngineImpl) PreviewResetWorkflowExecution(
+	ctx context.Context,
+	req *historyservice.PreviewResetWorkflowExecutionRequest,
+) (*historyservice.PreviewResetWorkflowExecutionResponse, error) {
+	return resetworkflow.Preview(ctx, req, e.shardContext, e.workflowConsistencyChecker)
+}
+
+// ForkWorkflowExecution creates a new workflow from the history of a workflow up to a given event. The source workflow
+// is not changed.
+func (e *historyEngineImpl) ForkWorkflowExecution(
+	ctx context.Context,
+	request *historyservice.ForkWorkflowExecutionRequest,
+) (*historyservice.ForkWorkflowExecutionResponse, error) {
+	return forkworkflow.Invoke(ctx, request, e.shardContext, e.workflowConsistencyChecker)
+}
+
 // UpdateWorkflowExecutionOptions updates the options of a specific workflow execution.
 // Can be used to set and unset versioning behavior override.
 func (e *historyEngineImpl) UpdateWorkflowExecutionOptions(
@@ -1019,7 +1044,7 @@ func (e *historyEngineImpl) AddTasks(
 		ctx,
 		e.shardContext,
 		e.eventSerializer,
-		int(e.config.NumberOfShards),
+		e.config.ShardMapProvider.ShardMap(),
 		request,
 		e.taskCategoryRegistry,
 	)
@@ -1067,6 +1092,34 @@ func (e *historyEngineImpl) PauseActivity(
 	return pauseactivity.Invoke(ctx, request, e.shardContext, e.workflowConsistencyChecker)
 }
 
+func (e *historyEngineImpl) PauseWorkflowExecution(
+	ctx context.Context,
+	request *historyservice.PauseWorkflowExecutionRequest,
+) (*historyservice.PauseWorkflowExecutionResponse, error) {
+	return pauseworkflow.Invoke(ctx, request, e.shardContext, e.workflowConsistencyChecker)
+}
+
+func (e *historyEngineImpl) MoveWorkflowExecution(
+	ctx context.Context,
+	workflowKey definition.WorkflowKey,
+	targetShardContext historyi.ShardContext,
+) error {
+	return moveworkflowexecution.Invoke(
+		ctx,
+		workflowKey,
+		e.shardContext,
+		e.workflowConsistencyChecker,
+		targetShardContext,
+	)
+}
+
+func (e *historyEngineImpl) UnpauseWorkflowExecution(
+	ctx context.Context,
+	request *historyservice.UnpauseWorkflowExecutionRequest,
+) (*historyservice.UnpauseWorkflowExecutionResponse, error) {
+	return unpauseworkflow.Invoke(ctx, request, e.shardContext, e.workflowConsistencyChecker)
+}
+
 func (e *historyEngineImpl) UnpauseActivity(
 	ctx context.Context,
 	request *historyservice.UnpauseActivityRequest,
@@ -1080,3 +1133,24 @@ func (e *historyEngineImpl) ResetActivity(
 ) (*historyservice.ResetActivityResponse, error) {
 	return resetactivity.Invoke(ctx, request, e.shardContext, e.workflowConsistencyChecker)
 }
+
+func (e *historyEngineImpl) MoveSearchAttributeValue(
+	ctx context.Context,
+	request *historyservice.MoveSearchAttributeValueRequest,
+) (*historyservice.MoveSearchAttributeValueResponse, error) {
+	return movesearchattributevalue.Invoke(ctx, request, e.shardContext, e.workflowConsistencyChecker)
+}
+
+func (e *historyEngineImpl) DeleteOrphanedCurrentExecution(
+	ctx context.Context,
+	request *historyservice.DeleteOrphanedCurrentExecutionRequest,
+) (*historyservice.DeleteOrphanedCurrentExecutionResponse, error) {
+	return deleteorphanedcurrentexecution.Invoke(ctx, request, e.shardContext, e.workflowConsistencyChecker)
+}
+
+func (e *historyEngineImpl) DeleteOrphanedHistoryTask(
+	ctx context.Context,
+	request *historyservice.DeleteOrphanedHistoryTaskRequest,
+) (*historyservice.DeleteOrphanedHistoryTaskResponse, error) {
+	return deleteorphanedhistorytask.Invoke(ctx, request, e.shardContext, e.workflowConsistencyChecker, e.taskCategoryRegistry)
+}
@@ -400,6 +400,19 @@ func (b *EventFactory) CreateWorkflowExecutionOptionsUpdatedEvent(
 	return event
 }
 
+func (b *EventFactory) CreateWorkflowPropertiesModifiedExternallyEvent(
+	upsertedMemo *commonpb.Memo,
+) *historypb.HistoryEvent {
+	event := b.createHistoryEvent(enumspb.EVENT_TYPE_WORKFLOW_PROPERTIES_MODIFIED_EXTERNALLY, b.timeSource.Now())
+	event.Attributes = &historypb.HistoryEvent_WorkflowPropertiesModifiedExternallyEventAttributes{
+		WorkflowPropertiesModifiedExternallyEventAttributes: &historypb.WorkflowPropertiesModifiedExternallyEventAttributes{
+			UpsertedMemo: upsertedMemo,
+		},
+	}
+	event.WorkerMayIgnore = true
+	return event
+}
+
 func (b *EventFactory) CreateWorkflowExecutionUpdateAcceptedEvent(
 	protocolInstanceID string,
 	acceptedRequestMessageId string,
@@ -412,6 +412,14 @@ func (b *HistoryBuilder) AddWorkflowExecutionOptionsUpdatedEvent(
 	return event
 }
 
+func (b *HistoryBuilder) AddWorkflowPropertiesModifiedExternallyEvent(
+	upsertedMemo *commonpb.Memo,
+) *historypb.HistoryEvent {
+	event := b.EventFactory.CreateWorkflowPropertiesModifiedExternallyEvent(upsertedMemo)
+	event, _ = b.EventStore.add(event)
+	return event
+}
+
 func (b *HistoryBuilder) AddWorkflowExecutionUpdateAcceptedEvent(
 	protocolInstanceID string,
 	acceptedRequestMessageId string,
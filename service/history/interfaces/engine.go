@@ -46,6 +46,8 @@ type (
 		TerminateWorkflowExecution(ctx context.Context, request *historyservice.TerminateWorkflowExecutionRequest) (*historyservice.TerminateWorkflowExecutionResponse, error)
 		DeleteWorkflowExecution(ctx context.Context, deleteRequest *historyservice.DeleteWorkflowExecutionRequest) (*historyservice.DeleteWorkflowExecutionResponse, error)
 		ResetWorkflowExecution(ctx context.Context, request *historyservice.ResetWorkflowExecutionRequest) (*historyservice.ResetWorkflowExecutionResponse, error)
+		PreviewResetWorkflowExecution(ctx context.Context, request *historyservice.PreviewResetWorkflowExecutionRequest) (*historyservice.PreviewResetWorkflowExecutionResponse, error)
+		ForkWorkflowExecution(ctx context.Context, request *historyservice.ForkWorkflowExecutionRequest) (*historyservice.ForkWorkflowExecutionResponse, error)
 		UpdateWorkflowExecutionOptions(ctx context.Context, request *historyservice.UpdateWorkflowExecutionOptionsRequest) (*historyservice.UpdateWorkflowExecutionOptionsResponse, error)
 		ScheduleWorkflowTask(ctx context.Context, request *historyservice.ScheduleWorkflowTaskRequest) error
 		IsActivityTaskValid(ctx context.Context, request *historyservice.IsActivityTaskValidRequest) (*historyservice.IsActivityTaskValidResponse, error)
@@ -95,6 +97,13 @@ type (
 		PauseActivity(ctx context.Context, request *historyservice.PauseActivityRequest) (*historyservice.PauseActivityResponse, error)
 		UnpauseActivity(ctx context.Context, request *historyservice.UnpauseActivityRequest) (*historyservice.UnpauseActivityResponse, error)
 		ResetActivity(ctx context.Context, request *historyservice.ResetActivityRequest) (*historyservice.ResetActivityResponse, error)
+		MoveSearchAttributeValue(ctx context.Context, request *historyservice.MoveSearchAttributeValueRequest) (*historyservice.MoveSearchAttributeValueResponse, error)
+		DeleteOrphanedCurrentExecution(ctx context.Context, request *historyservice.DeleteOrphanedCurrentExecutionRequest) (*historyservice.DeleteOrphanedCurrentExecutionResponse, error)
+		DeleteOrphanedHistoryTask(ctx context.Context, request *historyservice.DeleteOrphanedHistoryTaskRequest) (*historyservice.DeleteOrphanedHistoryTaskResponse, error)
+		PauseWorkflowExecution(ctx context.Context, request *historyservice.PauseWorkflowExecutionRequest) (*historyservice.PauseWorkflowExecutionResponse, error)
+		UnpauseWorkflowExecution(ctx context.Context, request *historyservice.UnpauseWorkflowExecutionRequest) (*historyservice.UnpauseWorkflowExecutionResponse, error)
+		// MoveWorkflowExecution moves a workflow execution of the shard to one of its child shards after a shard split.
+		MoveWorkflowExecution(ctx context.Context, workflowKey definition.WorkflowKey, targetShardContext ShardContext) error
 
 		NotifyNewHistoryEvent(event *events.Notification)
 		NotifyNewTasks(tasks map[tasks.Category][]tasks.Task)
@@ -127,4 +136,18 @@ type (
 		NewEvents []*historypb.HistoryEvent
 		NewRunID  string
 	}
+
+	// ResetWorkflowPreview is the outcome of a workflow reset, computed without applying it
+	ResetWorkflowPreview struct {
+		// ResetMutableState is the mutable state the new run would be created with
+		ResetMutableState *persistencespb.WorkflowMutableState
+		// ReappliedEvents are the events after the reset point which would be reapplied to the new run
+		ReappliedEvents []*historypb.HistoryEvent
+		// DroppedEvents are the events after the reset point which would not be reapplied to the new run
+		DroppedEvents []*historypb.HistoryEvent
+		// AbandonedActivities are the pending activities of the current run, abandoned when it's terminated
+		AbandonedActivities []*persistencespb.ActivityInfo
+		// AbandonedChildren are the pending child workflows of the current run, abandoned when it's terminated
+		AbandonedChildren []*persistencespb.ChildExecutionInfo
+	}
 )
@@ -98,6 +98,36 @@ func (mr *MockEngineMockRecorder) ConvertReplicationTask(ctx, task, clusterID an
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConvertReplicationTask", reflect.TypeOf((*MockEngine)(nil).ConvertReplicationTask), ctx, task, clusterID)
 }
 
+// DeleteOrphanedCurrentExecution mocks base method.
+func (m *MockEngine) DeleteOrphanedCurrentExecution(ctx context.Context, request *historyservice.DeleteOrphanedCurrentExecutionRequest) (*historyservice.DeleteOrphanedCurrentExecutionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteOrphanedCurrentExecution", ctx, request)
+	ret0, _ := ret[0].(*historyservice.DeleteOrphanedCurrentExecutionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteOrphanedCurrentExecution indicates an expected call of DeleteOrphanedCurrentExecution.
+func (mr *MockEngineMockRecorder) DeleteOrphanedCurrentExecution(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteOrphanedCurrentExecution", reflect.TypeOf((*MockEngine)(nil).DeleteOrphanedCurrentExecution), ctx, request)
+}
+
+// DeleteOrphanedHistoryTask mocks base method.
+func (m *MockEngine) DeleteOrphanedHistoryTask(ctx context.Context, request *historyservice.DeleteOrphanedHistoryTaskRequest) (*historyservice.DeleteOrphanedHistoryTaskResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteOrphanedHistoryTask", ctx, request)
+	ret0, _ := ret[0].(*historyservice.DeleteOrphanedHistoryTaskResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteOrphanedHistoryTask indicates an expected call of DeleteOrphanedHistoryTask.
+func (mr *MockEngineMockRecorder) DeleteOrphanedHistoryTask(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteOrphanedHistoryTask", reflect.TypeOf((*MockEngine)(nil).DeleteOrphanedHistoryTask), ctx, request)
+}
+
 // DeleteWorkflowExecution mocks base method.
 func (m *MockEngine) DeleteWorkflowExecution(ctx context.Context, deleteRequest *historyservice.DeleteWorkflowExecutionRequest) (*historyservice.DeleteWorkflowExecutionResponse, error) {
 	m.ctrl.T.Helper()
@@ -158,6 +188,21 @@ func (mr *MockEngineMockRecorder) ExecuteMultiOperation(ctx, request any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExecuteMultiOperation", reflect.TypeOf((*MockEngine)(nil).ExecuteMultiOperation), ctx, request)
 }
 
+// ForkWorkflowExecution mocks base method.
+func (m *MockEngine) ForkWorkflowExecution(ctx context.Context, request *historyservice.ForkWorkflowExecutionRequest) (*historyservice.ForkWorkflowExecutionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ForkWorkflowExecution", ctx, request)
+	ret0, _ := ret[0].(*historyservice.ForkWorkflowExecutionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ForkWorkflowExecution indicates an expected call of ForkWorkflowExecution.
+func (mr *MockEngineMockRecorder) ForkWorkflowExecution(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ForkWorkflowExecution", reflect.TypeOf((*MockEngine)(nil).ForkWorkflowExecution), ctx, request)
+}
+
 // GenerateLastHistoryReplicationTasks mocks base method.
 func (m *MockEngine) GenerateLastHistoryReplicationTasks(ctx context.Context, request *historyservice.GenerateLastHistoryReplicationTasksRequest) (*historyservice.GenerateLastHistoryReplicationTasksResponse, error) {
 	m.ctrl.T.Helper()
@@ -413,6 +458,35 @@ func (mr *MockEngineMockRecorder) MergeDLQMessages(ctx, messagesRequest any) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MergeDLQMessages", reflect.TypeOf((*MockEngine)(nil).MergeDLQMessages), ctx, messagesRequest)
 }
 
+// MoveSearchAttributeValue mocks base method.
+func (m *MockEngine) MoveSearchAttributeValue(ctx context.Context, request *historyservice.MoveSearchAttributeValueRequest) (*historyservice.MoveSearchAttributeValueResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MoveSearchAttributeValue", ctx, request)
+	ret0, _ := ret[0].(*historyservice.MoveSearchAttributeValueResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MoveSearchAttributeValue indicates an expected call of MoveSearchAttributeValue.
+func (mr *MockEngineMockRecorder) MoveSearchAttributeValue(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveSearchAttributeValue", reflect.TypeOf((*MockEngine)(nil).MoveSearchAttributeValue), ctx, request)
+}
+
+// MoveWorkflowExecution mocks base method.
+func (m *MockEngine) MoveWorkflowExecution(ctx context.Context, workflowKey definition.WorkflowKey, targetShardContext ShardContext) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MoveWorkflowExecution", ctx, workflowKey, targetShardContext)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MoveWorkflowExecution indicates an expected call of MoveWorkflowExecution.
+func (mr *MockEngineMockRecorder) MoveWorkflowExecution(ctx, workflowKey, targetShardContext any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveWorkflowExecution", reflect.TypeOf((*MockEngine)(nil).MoveWorkflowExecution), ctx, workflowKey, targetShardContext)
+}
+
 // NotifyNewHistoryEvent mocks base method.
 func (m *MockEngine) NotifyNewHistoryEvent(event *events.Notification) {
 	m.ctrl.T.Helper()
@@ -452,6 +526,21 @@ func (mr *MockEngineMockRecorder) PauseActivity(ctx, request any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PauseActivity", reflect.TypeOf((*MockEngine)(nil).PauseActivity), ctx, request)
 }
 
+// PauseWorkflowExecution mocks base method.
+func (m *MockEngine) PauseWorkflowExecution(ctx context.Context, request *historyservice.PauseWorkflowExecutionRequest) (*historyservice.PauseWorkflowExecutionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PauseWorkflowExecution", ctx, request)
+	ret0, _ := ret[0].(*historyservice.PauseWorkflowExecutionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PauseWorkflowExecution indicates an expected call of PauseWorkflowExecution.
+func (mr *MockEngineMockRecorder) PauseWorkflowExecution(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PauseWorkflowExecution", reflect.TypeOf((*MockEngine)(nil).PauseWorkflowExecution), ctx, request)
+}
+
 // PollMutableState mocks base method.
 func (m *MockEngine) PollMutableState(ctx context.Context, request *historyservice.PollMutableStateRequest) (*historyservice.PollMutableStateResponse, error) {
 	m.ctrl.T.Helper()
@@ -482,6 +571,21 @@ func (mr *MockEngineMockRecorder) PollWorkflowExecutionUpdate(ctx, request any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PollWorkflowExecutionUpdate", reflect.TypeOf((*MockEngine)(nil).PollWorkflowExecutionUpdate), ctx, request)
 }
 
+// PreviewResetWorkflowExecution mocks base method.
+func (m *MockEngine) PreviewResetWorkflowExecution(ctx context.Context, request *historyservice.PreviewResetWorkflowExecutionRequest) (*historyservice.PreviewResetWorkflowExecutionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PreviewResetWorkflowExecution", ctx, request)
+	ret0, _ := ret[0].(*historyservice.PreviewResetWorkflowExecutionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PreviewResetWorkflowExecution indicates an expected call of PreviewResetWorkflowExecution.
+func (mr *MockEngineMockRecorder) PreviewResetWorkflowExecution(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PreviewResetWorkflowExecution", reflect.TypeOf((*MockEngine)(nil).PreviewResetWorkflowExecution), ctx, request)
+}
+
 // PurgeDLQMessages mocks base method.
 func (m *MockEngine) PurgeDLQMessages(ctx context.Context, messagesRequest *historyservice.PurgeDLQMessagesRequest) (*historyservice.PurgeDLQMessagesResponse, error) {
 	m.ctrl.T.Helper()
@@ -1032,6 +1136,21 @@ func (mr *MockEngineMockRecorder) UnpauseActivity(ctx, request any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnpauseActivity", reflect.TypeOf((*MockEngine)(nil).UnpauseActivity), ctx, request)
 }
 
+// UnpauseWorkflowExecution mocks base method.
+func (m *MockEngine) UnpauseWorkflowExecution(ctx context.Context, request *historyservice.UnpauseWorkflowExecutionRequest) (*historyservice.UnpauseWorkflowExecutionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnpauseWorkflowExecution", ctx, request)
+	ret0, _ := ret[0].(*historyservice.UnpauseWorkflowExecutionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UnpauseWorkflowExecution indicates an expected call of UnpauseWorkflowExecution.
+func (mr *MockEngineMockRecorder) UnpauseWorkflowExecution(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnpauseWorkflowExecution", reflect.TypeOf((*MockEngine)(nil).UnpauseWorkflowExecution), ctx, request)
+}
+
 // UnsubscribeReplicationNotification mocks base method.
 func (m *MockEngine) UnsubscribeReplicationNotification(arg0 string) {
 	m.ctrl.T.Helper()
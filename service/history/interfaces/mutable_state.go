@@ -210,6 +210,13 @@ type (
 		TaskQueueScheduleToStartTimeout(name string) (*taskqueuepb.TaskQueue, *durationpb.Duration)
 
 		IsWorkflowExecutionRunning() bool
+		IsWorkflowExecutionPaused() bool
+		AddWorkflowExecutionPausedEvent() (*historypb.HistoryEvent, error)
+		AddWorkflowExecutionUnpausedEvent() (*historypb.HistoryEvent, error)
+		// MoveSearchAttributeValue replaces the value of the custom search attribute field sourceField by value in the
+		// field targetField, and records the move in a WorkflowPropertiesModifiedExternally event. A nil value only
+		// removes the value of sourceField.
+		MoveSearchAttributeValue(sourceField string, targetField string, value *commonpb.Payload) error
 		IsResourceDuplicated(resourceDedupKey definition.DeduplicationID) bool
 		IsWorkflowPendingOnWorkflowTaskBackoff() bool
 		UpdateDuplicatedResource(resourceDedupKey definition.DeduplicationID)
@@ -257,6 +264,7 @@ type (
 		ApplyWorkflowExecutionStartedEvent(*clockspb.VectorClock, *commonpb.WorkflowExecution, string, *historypb.HistoryEvent) error
 		ApplyWorkflowExecutionTerminatedEvent(int64, *historypb.HistoryEvent) error
 		ApplyWorkflowExecutionOptionsUpdatedEvent(event *historypb.HistoryEvent) error
+		ApplyWorkflowPropertiesModifiedExternallyEvent(event *historypb.HistoryEvent) error
 		ApplyWorkflowExecutionTimedoutEvent(int64, *historypb.HistoryEvent) error
 		ApplyWorkflowExecutionUpdateAcceptedEvent(*historypb.HistoryEvent) error
 		ApplyWorkflowExecutionUpdateCompletedEvent(event *historypb.HistoryEvent, batchID int64) error
@@ -3,11 +3,14 @@
 package ndc
 
 import (
+	"cmp"
 	"context"
 	"errors"
 	"fmt"
+	"slices"
 	"time"
 
+	"github.com/google/uuid"
 	commonpb "go.temporal.io/api/common/v1"
 	enumspb "go.temporal.io/api/enums/v1"
 	historypb "go.temporal.io/api/history/v1"
@@ -65,6 +68,30 @@ type (
 			resetReapplyExcludeTypes map[enumspb.ResetReapplyExcludeType]struct{},
 			allowResetWithPendingChildren bool,
 		) error
+		PreviewResetWorkflow(
+			ctx context.Context,
+			namespaceID namespace.ID,
+			workflowID string,
+			baseRunID string,
+			baseBranchToken []byte,
+			baseRebuildLastEventID int64,
+			baseRebuildLastEventVersion int64,
+			baseNextEventID int64,
+			currentWorkflow Workflow,
+			resetReason string,
+			resetReapplyExcludeTypes map[enumspb.ResetReapplyExcludeType]struct{},
+			allowResetWithPendingChildren bool,
+		) (*historyi.ResetWorkflowPreview, error)
+		ForkWorkflow(
+			ctx context.Context,
+			namespaceID namespace.ID,
+			workflowID string,
+			forkRunID string,
+			forkRequestID string,
+			baseRunID string,
+			historyBatches [][]*historypb.HistoryEvent,
+			forkReason string,
+		) error
 	}
 
 	workflowResetterImpl struct {
@@ -245,6 +272,275 @@ func (r *workflowResetterImpl) ResetWorkflow(
 	return nil
 }
 
+// PreviewResetWorkflow returns what ResetWorkflow would do with the same arguments, without mutating anything: the
+// reset run is rebuilt in memory from the base branch instead of a forked branch, the current run is not terminated
+// and nothing is persisted.
+func (r *workflowResetterImpl) PreviewResetWorkflow(
+	ctx context.Context,
+	namespaceID namespace.ID,
+	workflowID string,
+	baseRunID string,
+	baseBranchToken []byte,
+	baseRebuildLastEventID int64,
+	baseRebuildLastEventVersion int64,
+	baseNextEventID int64,
+	currentWorkflow Workflow,
+	resetReason string,
+	resetReapplyExcludeTypes map[enumspb.ResetReapplyExcludeType]struct{},
+	allowResetWithPendingChildren bool,
+) (*historyi.ResetWorkflowPreview, error) {
+
+	namespaceEntry, err := r.namespaceRegistry.GetNamespaceByID(namespaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	preview := &historyi.ResetWorkflowPreview{}
+	resetWorkflowVersion := namespaceEntry.FailoverVersion()
+	currentMutableState := currentWorkflow.GetMutableState()
+	if currentMutableState.IsWorkflowExecutionRunning() {
+		resetWorkflowVersion = currentMutableState.GetCurrentVersion()
+		for _, ai := range currentMutableState.GetPendingActivityInfos() {
+			preview.AbandonedActivities = append(preview.AbandonedActivities, common.CloneProto(ai))
+		}
+		slices.SortFunc(preview.AbandonedActivities, func(a, b *persistencespb.ActivityInfo) int {
+			return cmp.Compare(a.ScheduledEventId, b.ScheduledEventId)
+		})
+		for _, ci := range currentMutableState.GetPendingChildExecutionInfos() {
+			preview.AbandonedChildren = append(preview.AbandonedChildren, common.CloneProto(ci))
+		}
+		slices.SortFunc(preview.AbandonedChildren, func(a, b *persistencespb.ChildExecutionInfo) int {
+			return cmp.Compare(a.InitiatedEventId, b.InitiatedEventId)
+		})
+	}
+
+	// The run ID of the reset run is only known once it's created, the preview uses a placeholder.
+	resetRunID := uuid.NewString()
+	resetMutableState, err := r.rebuildResetMutableState(
+		ctx,
+		namespaceID,
+		workflowID,
+		baseRunID,
+		baseBranchToken,
+		baseRebuildLastEventID,
+		baseRebuildLastEventVersion,
+		resetRunID,
+		baseBranchToken,
+		resetRunID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if err := r.prepareResetMutableState(
+		ctx,
+		resetMutableState,
+		baseRunID,
+		baseRebuildLastEventID,
+		baseRebuildLastEventVersion,
+		resetRunID,
+		resetWorkflowVersion,
+		resetReason,
+		allowResetWithPendingChildren,
+	); err != nil {
+		return nil, err
+	}
+
+	if err := r.previewReapplyEvents(
+		ctx,
+		resetMutableState,
+		currentWorkflow,
+		namespaceID,
+		workflowID,
+		baseBranchToken,
+		baseRebuildLastEventID+1,
+		baseNextEventID,
+		resetReapplyExcludeTypes,
+		preview,
+	); err != nil {
+		return nil, err
+	}
+	if err := workflow.ScheduleWorkflowTask(resetMutableState); err != nil {
+		return nil, err
+	}
+
+	preview.ResetMutableState = resetMutableState.CloneToProto()
+	return preview, nil
+}
+
+// ForkWorkflow creates a new workflow from a copy of the history of a base run. The history batches, which must
+// already be rewritten for the new workflow, are persisted in a new history tree: history branches are scoped to a
+// shard and can't be shared with a workflow of another workflow ID. The new workflow is then prepared like a reset
+// run: the pending workflow task at the end of the batches is failed and a new one is scheduled.
+func (r *workflowResetterImpl) ForkWorkflow(
+	ctx context.Context,
+	namespaceID namespace.ID,
+	workflowID string,
+	forkRunID string,
+	forkRequestID string,
+	baseRunID string,
+	historyBatches [][]*historypb.HistoryEvent,
+	forkReason string,
+) error {
+
+	namespaceEntry, err := r.namespaceRegistry.GetNamespaceByID(namespaceID)
+	if err != nil {
+		return err
+	}
+	// The fork point is checked before anything is persisted, prepareResetMutableState only runs on the
+	// rebuilt mutable state, after the history of the new workflow is written.
+	if err := ValidateForkPoint(historyBatches); err != nil {
+		return err
+	}
+	startedAttributes := historyBatches[0][0].GetWorkflowExecutionStartedEventAttributes()
+	lastBatch := historyBatches[len(historyBatches)-1]
+	lastEvent := lastBatch[len(lastBatch)-1]
+
+	forkBranchToken, err := r.executionMgr.GetHistoryBranchUtil().NewHistoryBranch(
+		namespaceID.String(),
+		workflowID,
+		forkRunID,
+		forkRunID,
+		nil,
+		[]*persistencespb.HistoryBranchRange{},
+		startedAttributes.GetWorkflowRunTimeout().AsDuration(),
+		startedAttributes.GetWorkflowExecutionTimeout().AsDuration(),
+		namespaceEntry.Retention(),
+	)
+	if err != nil {
+		return err
+	}
+	prevTxnID := common.EmptyEventTaskID
+	for _, batch := range historyBatches {
+		txnID, err := r.shardContext.GenerateTaskID()
+		if err != nil {
+			return err
+		}
+		if _, err := workflow.PersistWorkflowEvents(ctx, r.shardContext, &persistence.WorkflowEvents{
+			NamespaceID: namespaceID.String(),
+			WorkflowID:  workflowID,
+			RunID:       forkRunID,
+			BranchToken: forkBranchToken,
+			PrevTxnID:   prevTxnID,
+			TxnID:       txnID,
+			Events:      batch,
+		}); err != nil {
+			return err
+		}
+		prevTxnID = txnID
+	}
+
+	forkWorkflowKey := definition.NewWorkflowKey(namespaceID.String(), workflowID, forkRunID)
+	forkMutableState, forkHistorySize, err := r.stateRebuilder.Rebuild(
+		ctx,
+		r.shardContext.GetTimeSource().Now(),
+		forkWorkflowKey,
+		forkBranchToken,
+		lastEvent.GetEventId(),
+		util.Ptr(lastEvent.GetVersion()),
+		forkWorkflowKey,
+		forkBranchToken,
+		forkRequestID,
+	)
+	if err != nil {
+		return err
+	}
+	forkMutableState.AddHistorySize(forkHistorySize)
+
+	// The forked workflow doesn't own the child workflows of the base run.
+	if err := r.prepareResetMutableState(
+		ctx,
+		forkMutableState,
+		baseRunID,
+		lastEvent.GetEventId(),
+		lastEvent.GetVersion(),
+		forkRunID,
+		namespaceEntry.FailoverVersion(),
+		forkReason,
+		false,
+	); err != nil {
+		return err
+	}
+	if err := workflow.ScheduleWorkflowTask(forkMutableState); err != nil {
+		return err
+	}
+
+	forkSnapshot, forkEventsSeq, err := forkMutableState.CloseTransactionAsSnapshot(historyi.TransactionPolicyActive)
+	if err != nil {
+		return err
+	}
+	forkContext := workflow.NewContext(
+		r.shardContext.GetConfig(),
+		forkWorkflowKey,
+		r.logger,
+		r.shardContext.GetThrottledLogger(),
+		r.shardContext.GetMetricsHandler(),
+	)
+	return forkContext.CreateWorkflowExecution(
+		ctx,
+		r.shardContext,
+		persistence.CreateWorkflowModeBrandNew,
+		"",
+		common.EmptyVersion,
+		forkMutableState,
+		forkSnapshot,
+		forkEventsSeq,
+	)
+}
+
+// ValidateForkPoint checks that a workflow can be forked from the history batches: the history starts with the
+// workflow started event, its last event is inside the range of a scheduled or started workflow task, which is failed
+// when the new workflow is prepared, and no child workflow is pending at the last event.
+func ValidateForkPoint(historyBatches [][]*historypb.HistoryEvent) error {
+	if len(historyBatches) == 0 || len(historyBatches[0]) == 0 {
+		return serviceerror.NewInvalidArgument("WorkflowResetter encountered empty history to fork.")
+	}
+	if historyBatches[0][0].GetWorkflowExecutionStartedEventAttributes() == nil {
+		return serviceerror.NewInvalidArgument("WorkflowResetter encountered history not starting with the workflow started event.")
+	}
+
+	pendingWorkflowTask := false
+	pendingChildren := make(map[int64]struct{})
+	var lastEventID int64
+	for _, batch := range historyBatches {
+		for _, event := range batch {
+			lastEventID = event.GetEventId()
+			switch event.GetEventType() { // nolint:exhaustive
+			case enumspb.EVENT_TYPE_WORKFLOW_TASK_SCHEDULED:
+				pendingWorkflowTask = true
+			case enumspb.EVENT_TYPE_WORKFLOW_TASK_COMPLETED,
+				enumspb.EVENT_TYPE_WORKFLOW_TASK_FAILED,
+				enumspb.EVENT_TYPE_WORKFLOW_TASK_TIMED_OUT:
+				pendingWorkflowTask = false
+			case enumspb.EVENT_TYPE_START_CHILD_WORKFLOW_EXECUTION_INITIATED:
+				pendingChildren[event.GetEventId()] = struct{}{}
+			case enumspb.EVENT_TYPE_START_CHILD_WORKFLOW_EXECUTION_FAILED:
+				delete(pendingChildren, event.GetStartChildWorkflowExecutionFailedEventAttributes().GetInitiatedEventId())
+			case enumspb.EVENT_TYPE_CHILD_WORKFLOW_EXECUTION_COMPLETED:
+				delete(pendingChildren, event.GetChildWorkflowExecutionCompletedEventAttributes().GetInitiatedEventId())
+			case enumspb.EVENT_TYPE_CHILD_WORKFLOW_EXECUTION_FAILED:
+				delete(pendingChildren, event.GetChildWorkflowExecutionFailedEventAttributes().GetInitiatedEventId())
+			case enumspb.EVENT_TYPE_CHILD_WORKFLOW_EXECUTION_CANCELED:
+				delete(pendingChildren, event.GetChildWorkflowExecutionCanceledEventAttributes().GetInitiatedEventId())
+			case enumspb.EVENT_TYPE_CHILD_WORKFLOW_EXECUTION_TIMED_OUT:
+				delete(pendingChildren, event.GetChildWorkflowExecutionTimedOutEventAttributes().GetInitiatedEventId())
+			case enumspb.EVENT_TYPE_CHILD_WORKFLOW_EXECUTION_TERMINATED:
+				delete(pendingChildren, event.GetChildWorkflowExecutionTerminatedEventAttributes().GetInitiatedEventId())
+			}
+		}
+	}
+
+	if !pendingWorkflowTask {
+		return serviceerror.NewInvalidArgument(fmt.Sprintf(
+			"Can only fork workflow at event ID in range [WorkflowTaskScheduled, WorkflowTaskStarted]: %v",
+			lastEventID,
+		))
+	}
+	if len(pendingChildren) > 0 {
+		return serviceerror.NewInvalidArgument("WorkflowResetter encountered pending child workflows.")
+	}
+	return nil
+}
+
 func (r *workflowResetterImpl) prepareResetWorkflow(
 	ctx context.Context,
 	namespaceID namespace.ID,
@@ -275,29 +571,55 @@ func (r *workflowResetterImpl) prepareResetWorkflow(
 		return nil, err
 	}
 
-	// Reset expiration time
-	resetMutableState := resetWorkflow.GetMutableState()
+	if err := r.prepareResetMutableState(
+		ctx,
+		resetWorkflow.GetMutableState(),
+		baseRunID,
+		baseRebuildLastEventID,
+		baseRebuildLastEventVersion,
+		resetRunID,
+		resetWorkflowVersion,
+		resetReason,
+		allowResetWithPendingChildren,
+	); err != nil {
+		return nil, err
+	}
+	return resetWorkflow, nil
+}
 
+func (r *workflowResetterImpl) prepareResetMutableState(
+	ctx context.Context,
+	resetMutableState historyi.MutableState,
+	baseRunID string,
+	baseRebuildLastEventID int64,
+	baseRebuildLastEventVersion int64,
+	resetRunID string,
+	resetWorkflowVersion int64,
+	resetReason string,
+	allowResetWithPendingChildren bool,
+) error {
+
+	// Reset expiration time
 	// if workflow was reset after it was expired - at this point expiration task will
 	// already be fired since it is (re)created from the event, and event has old expiration time
 	// generate workflow execution task. again. this time with proper expiration time
 
 	if err := resetMutableState.RefreshExpirationTimeoutTask(ctx); err != nil {
-		return nil, err
+		return err
 	}
 
 	if resetMutableState.GetCurrentVersion() > resetWorkflowVersion {
-		return nil, serviceerror.NewInternal("WorkflowResetter encountered version mismatch.")
+		return serviceerror.NewInternal("WorkflowResetter encountered version mismatch.")
 	}
 	if err := resetMutableState.UpdateCurrentVersion(
 		resetWorkflowVersion,
 		false,
 	); err != nil {
-		return nil, err
+		return err
 	}
 
 	if !allowResetWithPendingChildren && len(resetMutableState.GetPendingChildExecutionInfos()) > 0 {
-		return nil, serviceerror.NewInvalidArgument("WorkflowResetter encountered pending child workflows.")
+		return serviceerror.NewInvalidArgument("WorkflowResetter encountered pending child workflows.")
 	}
 
 	if err := r.failWorkflowTask(
@@ -308,18 +630,14 @@ func (r *workflowResetterImpl) prepareResetWorkflow(
 		resetRunID,
 		resetReason,
 	); err != nil {
-		return nil, err
+		return err
 	}
 
-	if err := r.failInflightActivity(
+	return r.failInflightActivity(
 		resetMutableState.GetExecutionState().StartTime.AsTime(),
 		resetMutableState,
 		resetReason,
-	); err != nil {
-		return nil, err
-	}
-
-	return resetWorkflow, nil
+	)
 }
 
 func (r *workflowResetterImpl) persistToDB(
@@ -444,6 +762,42 @@ func (r *workflowResetterImpl) replayResetWorkflow(
 		r.shardContext.GetMetricsHandler(),
 	)
 
+	resetMutableState, err := r.rebuildResetMutableState(
+		ctx,
+		namespaceID,
+		workflowID,
+		baseRunID,
+		baseBranchToken,
+		baseRebuildLastEventID,
+		baseRebuildLastEventVersion,
+		resetRunID,
+		resetBranchToken,
+		resetRequestID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return NewWorkflow(
+		r.clusterMetadata,
+		resetContext,
+		resetMutableState,
+		wcache.NoopReleaseFn,
+	), nil
+}
+
+func (r *workflowResetterImpl) rebuildResetMutableState(
+	ctx context.Context,
+	namespaceID namespace.ID,
+	workflowID string,
+	baseRunID string,
+	baseBranchToken []byte,
+	baseRebuildLastEventID int64,
+	baseRebuildLastEventVersion int64,
+	resetRunID string,
+	resetBranchToken []byte,
+	resetRequestID string,
+) (historyi.MutableState, error) {
+
 	resetMutableState, resetHistorySize, err := r.stateRebuilder.Rebuild(
 		ctx,
 		r.shardContext.GetTimeSource().Now(),
@@ -473,12 +827,7 @@ func (r *workflowResetterImpl) replayResetWorkflow(
 		baseRebuildLastEventVersion,
 	)
 	resetMutableState.AddHistorySize(resetHistorySize)
-	return NewWorkflow(
-		r.clusterMetadata,
-		resetContext,
-		resetMutableState,
-		wcache.NoopReleaseFn,
-	), nil
+	return resetMutableState, nil
 }
 
 func (r *workflowResetterImpl) failWorkflowTask(
@@ -663,48 +1012,16 @@ func (r *workflowResetterImpl) reapplyContinueAsNewWorkflowEvents(
 		return "", err
 	}
 
-	getNextEventIDBranchToken := func(runID string) (nextEventID int64, branchToken []byte, retError error) {
-		var wfCtx historyi.WorkflowContext
-		var err error
-
-		if runID == currentWorkflow.GetMutableState().GetWorkflowKey().RunID {
-			wfCtx = currentWorkflow.GetContext()
-		} else {
-			var release historyi.ReleaseWorkflowContextFunc
-			wfCtx, release, err = r.workflowCache.GetOrCreateWorkflowExecution(
-				ctx,
-				r.shardContext,
-				namespaceID,
-				&commonpb.WorkflowExecution{
-					WorkflowId: workflowID,
-					RunId:      runID,
-				},
-				locks.PriorityHigh,
-			)
-			if err != nil {
-				return 0, nil, err
-			}
-			defer func() { release(retError) }()
-		}
-
-		mutableState, err := wfCtx.LoadMutableState(ctx, r.shardContext)
-		if err != nil {
-			// no matter what error happen, we need to retry
-			return 0, nil, err
-		}
-
-		nextEventID = mutableState.GetNextEventID()
-		branchToken, err = mutableState.GetCurrentBranchToken()
-		if err != nil {
-			return 0, nil, err
-		}
-		return nextEventID, branchToken, nil
-	}
-
 	// Second, for remaining continue as new workflow, reapply eligible events
 	for len(nextRunID) != 0 {
 		lastVisitedRunID = nextRunID
-		nextWorkflowNextEventID, nextWorkflowBranchToken, err := getNextEventIDBranchToken(nextRunID)
+		nextWorkflowNextEventID, nextWorkflowBranchToken, err := r.getNextEventIDBranchToken(
+			ctx,
+			currentWorkflow,
+			namespaceID,
+			workflowID,
+			nextRunID,
+		)
 		if err != nil {
 			return "", err
 		}
@@ -736,6 +1053,50 @@ func (r *workflowResetterImpl) reapplyContinueAsNewWorkflowEvents(
 	return lastVisitedRunID, nil
 }
 
+func (r *workflowResetterImpl) getNextEventIDBranchToken(
+	ctx context.Context,
+	currentWorkflow Workflow,
+	namespaceID namespace.ID,
+	workflowID string,
+	runID string,
+) (nextEventID int64, branchToken []byte, retError error) {
+	var wfCtx historyi.WorkflowContext
+	var err error
+
+	if runID == currentWorkflow.GetMutableState().GetWorkflowKey().RunID {
+		wfCtx = currentWorkflow.GetContext()
+	} else {
+		var release historyi.ReleaseWorkflowContextFunc
+		wfCtx, release, err = r.workflowCache.GetOrCreateWorkflowExecution(
+			ctx,
+			r.shardContext,
+			namespaceID,
+			&commonpb.WorkflowExecution{
+				WorkflowId: workflowID,
+				RunId:      runID,
+			},
+			locks.PriorityHigh,
+		)
+		if err != nil {
+			return 0, nil, err
+		}
+		defer func() { release(retError) }()
+	}
+
+	mutableState, err := wfCtx.LoadMutableState(ctx, r.shardContext)
+	if err != nil {
+		// no matter what error happen, we need to retry
+		return 0, nil, err
+	}
+
+	nextEventID = mutableState.GetNextEventID()
+	branchToken, err = mutableState.GetCurrentBranchToken()
+	if err != nil {
+		return 0, nil, err
+	}
+	return nextEventID, branchToken, nil
+}
+
 func (r *workflowResetterImpl) reapplyEventsFromBranch(
 	ctx context.Context,
 	mutableState historyi.MutableState,
@@ -799,6 +1160,78 @@ func (r *workflowResetterImpl) reapplyEventsFromBranch(
 	return nextRunID, nil
 }
 
+// previewReapplyEvents reapplies the events after the reset point of the base run and of the runs it continued as new
+// to, the same way reapplyContinueAsNewWorkflowEvents does, and records which of them are reapplied or dropped.
+func (r *workflowResetterImpl) previewReapplyEvents(
+	ctx context.Context,
+	resetMutableState historyi.MutableState,
+	currentWorkflow Workflow,
+	namespaceID namespace.ID,
+	workflowID string,
+	baseBranchToken []byte,
+	baseRebuildNextEventID int64,
+	baseNextEventID int64,
+	resetReapplyExcludeTypes map[enumspb.ResetReapplyExcludeType]struct{},
+	preview *historyi.ResetWorkflowPreview,
+) error {
+
+	excludeAll := r.shouldExcludeAllReapplyEvents(resetReapplyExcludeTypes)
+	firstEventID := baseRebuildNextEventID
+	nextEventID := baseNextEventID
+	branchToken := baseBranchToken
+	for {
+		var nextRunID string
+		iter := collection.NewPagingIterator(r.getPaginationFn(
+			ctx,
+			firstEventID,
+			nextEventID,
+			branchToken,
+		))
+		for iter.HasNext() {
+			batch, err := iter.Next()
+			if err != nil {
+				return err
+			}
+			for _, event := range batch.Events {
+				if event.GetEventType() == enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_CONTINUED_AS_NEW {
+					nextRunID = event.GetWorkflowExecutionContinuedAsNewEventAttributes().GetNewExecutionRunId()
+				}
+				if excludeAll {
+					preview.DroppedEvents = append(preview.DroppedEvents, event)
+					continue
+				}
+				// Every reapplied event adds an event to the reset run: no workflow task is in flight after the
+				// workflow task at the reset point is failed, so the events are not buffered.
+				resetNextEventID := resetMutableState.GetNextEventID()
+				if _, err := r.reapplyEvents(ctx, resetMutableState, []*historypb.HistoryEvent{event}, resetReapplyExcludeTypes); err != nil {
+					return err
+				}
+				if resetMutableState.GetNextEventID() > resetNextEventID {
+					preview.ReappliedEvents = append(preview.ReappliedEvents, event)
+				} else {
+					preview.DroppedEvents = append(preview.DroppedEvents, event)
+				}
+			}
+		}
+		if len(nextRunID) == 0 {
+			return nil
+		}
+
+		var err error
+		firstEventID = common.FirstEventID
+		nextEventID, branchToken, err = r.getNextEventIDBranchToken(
+			ctx,
+			currentWorkflow,
+			namespaceID,
+			workflowID,
+			nextRunID,
+		)
+		if err != nil {
+			return err
+		}
+	}
+}
+
 func (r *workflowResetterImpl) reapplyEvents(
 	ctx context.Context,
 	mutableState historyi.MutableState,
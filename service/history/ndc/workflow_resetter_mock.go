@@ -16,6 +16,7 @@ import (
 	enums "go.temporal.io/api/enums/v1"
 	history "go.temporal.io/api/history/v1"
 	namespace "go.temporal.io/server/common/namespace"
+	interfaces "go.temporal.io/server/service/history/interfaces"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -43,6 +44,35 @@ func (m *MockWorkflowResetter) EXPECT() *MockWorkflowResetterMockRecorder {
 	return m.recorder
 }
 
+// ForkWorkflow mocks base method.
+func (m *MockWorkflowResetter) ForkWorkflow(ctx context.Context, namespaceID namespace.ID, workflowID, forkRunID, forkRequestID, baseRunID string, historyBatches [][]*history.HistoryEvent, forkReason string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ForkWorkflow", ctx, namespaceID, workflowID, forkRunID, forkRequestID, baseRunID, historyBatches, forkReason)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ForkWorkflow indicates an expected call of ForkWorkflow.
+func (mr *MockWorkflowResetterMockRecorder) ForkWorkflow(ctx, namespaceID, workflowID, forkRunID, forkRequestID, baseRunID, historyBatches, forkReason any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ForkWorkflow", reflect.TypeOf((*MockWorkflowResetter)(nil).ForkWorkflow), ctx, namespaceID, workflowID, forkRunID, forkRequestID, baseRunID, historyBatches, forkReason)
+}
+
+// PreviewResetWorkflow mocks base method.
+func (m *MockWorkflowResetter) PreviewResetWorkflow(ctx context.Context, namespaceID namespace.ID, workflowID, baseRunID string, baseBranchToken []byte, baseRebuildLastEventID, baseRebuildLastEventVersion, baseNextEventID int64, currentWorkflow Workflow, resetReason string, resetReapplyExcludeTypes map[enums.ResetReapplyExcludeType]struct{}, allowResetWithPendingChildren bool) (*interfaces.ResetWorkflowPreview, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PreviewResetWorkflow", ctx, namespaceID, workflowID, baseRunID, baseBranchToken, baseRebuildLastEventID, baseRebuildLastEventVersion, baseNextEventID, currentWorkflow, resetReason, resetReapplyExcludeTypes, allowResetWithPendingChildren)
+	ret0, _ := ret[0].(*interfaces.ResetWorkflowPreview)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PreviewResetWorkflow indicates an expected call of PreviewResetWorkflow.
+func (mr *MockWorkflowResetterMockRecorder) PreviewResetWorkflow(ctx, namespaceID, workflowID, baseRunID, baseBranchToken, baseRebuildLastEventID, baseRebuildLastEventVersion, baseNextEventID, currentWorkflow, resetReason, resetReapplyExcludeTypes, allowResetWithPendingChildren any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PreviewResetWorkflow", reflect.TypeOf((*MockWorkflowResetter)(nil).PreviewResetWorkflow), ctx, namespaceID, workflowID, baseRunID, baseBranchToken, baseRebuildLastEventID, baseRebuildLastEventVersion, baseNextEventID, currentWorkflow, resetReason, resetReapplyExcludeTypes, allowResetWithPendingChildren)
+}
+
 // ResetWorkflow mocks base method.
 func (m *MockWorkflowResetter) ResetWorkflow(ctx context.Context, namespaceID namespace.ID, workflowID, baseRunID string, baseBranchToken []byte, baseRebuildLastEventID, baseRebuildLastEventVersion, baseNextEventID int64, resetRunID, resetRequestID string, baseWorkflow, currentWorkflow Workflow, resetReason string, additionalReapplyEvents []*history.HistoryEvent, resetReapplyExcludeTypes map[enums.ResetReapplyExcludeType]struct{}, allowResetWithPendingChildren bool) error {
 	m.ctrl.T.Helper()
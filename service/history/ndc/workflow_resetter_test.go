@@ -13,6 +13,7 @@ import (
 	enumspb "go.temporal.io/api/enums/v1"
 	failurepb "go.temporal.io/api/failure/v1"
 	historypb "go.temporal.io/api/history/v1"
+	"go.temporal.io/api/serviceerror"
 	taskqueuepb "go.temporal.io/api/taskqueue/v1"
 	updatepb "go.temporal.io/api/update/v1"
 	"go.temporal.io/api/workflowservice/v1"
@@ -326,6 +327,100 @@ func (s *workflowResetterSuite) TestReplayResetWorkflow() {
 	s.Equal(resetMutableState, resetWorkflow.GetMutableState())
 }
 
+func (s *workflowResetterSuite) TestValidateForkPoint() {
+	startedEvent := &historypb.HistoryEvent{
+		EventId:   1,
+		EventType: enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_STARTED,
+		Attributes: &historypb.HistoryEvent_WorkflowExecutionStartedEventAttributes{
+			WorkflowExecutionStartedEventAttributes: &historypb.WorkflowExecutionStartedEventAttributes{},
+		},
+	}
+	scheduledEvent := func(eventID int64) *historypb.HistoryEvent {
+		return &historypb.HistoryEvent{EventId: eventID, EventType: enumspb.EVENT_TYPE_WORKFLOW_TASK_SCHEDULED}
+	}
+	startedWorkflowTaskEvent := func(eventID int64) *historypb.HistoryEvent {
+		return &historypb.HistoryEvent{EventId: eventID, EventType: enumspb.EVENT_TYPE_WORKFLOW_TASK_STARTED}
+	}
+	completedEvent := func(eventID int64) *historypb.HistoryEvent {
+		return &historypb.HistoryEvent{EventId: eventID, EventType: enumspb.EVENT_TYPE_WORKFLOW_TASK_COMPLETED}
+	}
+	childInitiatedEvent := &historypb.HistoryEvent{
+		EventId:   5,
+		EventType: enumspb.EVENT_TYPE_START_CHILD_WORKFLOW_EXECUTION_INITIATED,
+	}
+	childCompletedEvent := &historypb.HistoryEvent{
+		EventId:   7,
+		EventType: enumspb.EVENT_TYPE_CHILD_WORKFLOW_EXECUTION_COMPLETED,
+		Attributes: &historypb.HistoryEvent_ChildWorkflowExecutionCompletedEventAttributes{
+			ChildWorkflowExecutionCompletedEventAttributes: &historypb.ChildWorkflowExecutionCompletedEventAttributes{
+				InitiatedEventId: 5,
+			},
+		},
+	}
+
+	testCases := []struct {
+		name           string
+		historyBatches [][]*historypb.HistoryEvent
+		valid          bool
+	}{
+		{
+			name:           "empty history",
+			historyBatches: nil,
+		},
+		{
+			name:           "not starting with the started event",
+			historyBatches: [][]*historypb.HistoryEvent{{scheduledEvent(2)}},
+		},
+		{
+			name:           "scheduled workflow task",
+			historyBatches: [][]*historypb.HistoryEvent{{startedEvent, scheduledEvent(2)}},
+			valid:          true,
+		},
+		{
+			name:           "started workflow task",
+			historyBatches: [][]*historypb.HistoryEvent{{startedEvent, scheduledEvent(2)}, {startedWorkflowTaskEvent(3)}},
+			valid:          true,
+		},
+		{
+			name: "completed workflow task",
+			historyBatches: [][]*historypb.HistoryEvent{
+				{startedEvent, scheduledEvent(2)},
+				{startedWorkflowTaskEvent(3)},
+				{completedEvent(4)},
+			},
+		},
+		{
+			name: "pending child workflow",
+			historyBatches: [][]*historypb.HistoryEvent{
+				{startedEvent, scheduledEvent(2)},
+				{startedWorkflowTaskEvent(3)},
+				{completedEvent(4), childInitiatedEvent},
+				{scheduledEvent(6)},
+			},
+		},
+		{
+			name: "closed child workflow",
+			historyBatches: [][]*historypb.HistoryEvent{
+				{startedEvent, scheduledEvent(2)},
+				{startedWorkflowTaskEvent(3)},
+				{completedEvent(4), childInitiatedEvent},
+				{childCompletedEvent, scheduledEvent(8)},
+			},
+			valid: true,
+		},
+	}
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			err := ValidateForkPoint(tc.historyBatches)
+			if tc.valid {
+				s.NoError(err)
+				return
+			}
+			s.IsType(&serviceerror.InvalidArgument{}, err)
+		})
+	}
+}
+
 func (s *workflowResetterSuite) TestFailWorkflowTask_NoWorkflowTask() {
 	baseRunID := uuid.New()
 	baseRebuildLastEventID := int64(1234)
@@ -812,6 +907,81 @@ func (s *workflowResetterSuite) TestReapplyWorkflowEvents() {
 	s.Equal(newRunID, nextRunID)
 }
 
+func (s *workflowResetterSuite) TestPreviewReapplyEvents() {
+	firstEventID := int64(10)
+	nextEventID := int64(13)
+	branchToken := []byte{1, 2, 3}
+
+	signalEvent := &historypb.HistoryEvent{
+		EventId:   10,
+		EventType: enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_SIGNALED,
+		Attributes: &historypb.HistoryEvent_WorkflowExecutionSignaledEventAttributes{WorkflowExecutionSignaledEventAttributes: &historypb.WorkflowExecutionSignaledEventAttributes{
+			SignalName: "signal-name",
+			Input:      payloads.EncodeString("signal-input"),
+			Identity:   "signal-identity",
+		}},
+	}
+	workflowTaskEvent := &historypb.HistoryEvent{
+		EventId:    11,
+		EventType:  enumspb.EVENT_TYPE_WORKFLOW_TASK_SCHEDULED,
+		Attributes: &historypb.HistoryEvent_WorkflowTaskScheduledEventAttributes{WorkflowTaskScheduledEventAttributes: &historypb.WorkflowTaskScheduledEventAttributes{}},
+	}
+	updateEvent := &historypb.HistoryEvent{
+		EventId:   12,
+		EventType: enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_UPDATE_ADMITTED,
+		Attributes: &historypb.HistoryEvent_WorkflowExecutionUpdateAdmittedEventAttributes{
+			WorkflowExecutionUpdateAdmittedEventAttributes: &historypb.WorkflowExecutionUpdateAdmittedEventAttributes{
+				Request: &updatepb.Request{Input: &updatepb.Input{Args: payloads.EncodeString("update-request-payload")}},
+			},
+		},
+	}
+	s.mockExecutionMgr.EXPECT().ReadHistoryBranchByBatch(gomock.Any(), &persistence.ReadHistoryBranchRequest{
+		BranchToken:   branchToken,
+		MinEventID:    firstEventID,
+		MaxEventID:    nextEventID,
+		PageSize:      defaultPageSize,
+		NextPageToken: nil,
+		ShardID:       s.mockShard.GetShardID(),
+	}).Return(&persistence.ReadHistoryBranchByBatchResponse{
+		History:       []*historypb.History{{Events: []*historypb.HistoryEvent{signalEvent, workflowTaskEvent, updateEvent}}},
+		NextPageToken: nil,
+	}, nil)
+
+	mutableState := historyi.NewMockMutableState(s.controller)
+	smReg := hsm.NewRegistry()
+	s.NoError(workflow.RegisterStateMachine(smReg))
+	root, err := hsm.NewRoot(smReg, workflow.StateMachineType, nil, make(map[string]*persistencespb.StateMachineMap), nil)
+	s.NoError(err)
+	mutableState.EXPECT().HSM().Return(root).AnyTimes()
+	// Only the signal adds an event to the reset run.
+	mutableState.EXPECT().GetNextEventID().Return(int64(20)).Times(1)
+	mutableState.EXPECT().GetNextEventID().Return(int64(21)).AnyTimes()
+	mutableState.EXPECT().AddWorkflowExecutionSignaled(
+		"signal-name",
+		signalEvent.GetWorkflowExecutionSignaledEventAttributes().GetInput(),
+		"signal-identity",
+		gomock.Any(),
+		gomock.Any(),
+	).Return(&historypb.HistoryEvent{}, nil)
+
+	preview := &historyi.ResetWorkflowPreview{}
+	err = s.workflowResetter.previewReapplyEvents(
+		context.Background(),
+		mutableState,
+		nil, // currentWorkflow is only used to load the runs continued as new to
+		namespace.ID(uuid.New()),
+		"some random workflow ID",
+		branchToken,
+		firstEventID,
+		nextEventID,
+		map[enumspb.ResetReapplyExcludeType]struct{}{enumspb.RESET_REAPPLY_EXCLUDE_TYPE_UPDATE: {}},
+		preview,
+	)
+	s.NoError(err)
+	s.Equal([]*historypb.HistoryEvent{signalEvent}, preview.ReappliedEvents)
+	s.Equal([]*historypb.HistoryEvent{workflowTaskEvent, updateEvent}, preview.DroppedEvents)
+}
+
 // TestReapplyEvents_WithPendingChildren tests applying events related to child workflow.
 // It asserts that reapplyEvents() function checks mutableState.GetChildExecutionInfo() before applying the event.
 func (s *workflowResetterSuite) TestReapplyEvents_WithPendingChildren() {
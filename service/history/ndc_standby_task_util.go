@@ -20,6 +20,7 @@ import (
 	"go.temporal.io/server/service/history/consts"
 	historyi "go.temporal.io/server/service/history/interfaces"
 	"go.temporal.io/server/service/history/tasks"
+	"go.temporal.io/server/service/history/workflow"
 )
 
 type (
@@ -133,6 +134,7 @@ type (
 		activityTaskScheduleToStartTimeout time.Duration
 		versionDirective                   *taskqueuespb.TaskVersionDirective
 		priority                           *commonpb.Priority
+		fairnessKey                        string
 	}
 
 	verifyCompletionRecordedPostActionInfo struct {
@@ -144,6 +146,7 @@ type (
 		taskqueue                          *taskqueuepb.TaskQueue
 		versionDirective                   *taskqueuespb.TaskVersionDirective
 		priority                           *commonpb.Priority
+		fairnessKey                        string
 	}
 )
 
@@ -156,6 +159,7 @@ func newExecutionTimerPostActionInfo(
 }
 
 func newActivityTaskPostActionInfo(
+	shardContext historyi.ShardContext,
 	mutableState historyi.MutableState,
 	activityInfo *persistencespb.ActivityInfo,
 ) (*activityTaskPostActionInfo, error) {
@@ -166,10 +170,12 @@ func newActivityTaskPostActionInfo(
 		activityTaskScheduleToStartTimeout: activityInfo.ScheduleToStartTimeout.AsDuration(),
 		versionDirective:                   directive,
 		priority:                           priority,
+		fairnessKey:                        workflow.FairnessKey(shardContext, mutableState),
 	}, nil
 }
 
 func newActivityRetryTimePostActionInfo(
+	shardContext historyi.ShardContext,
 	mutableState historyi.MutableState,
 	taskQueue string,
 	activityScheduleToStartTimeout time.Duration,
@@ -183,10 +189,12 @@ func newActivityRetryTimePostActionInfo(
 		activityTaskScheduleToStartTimeout: activityScheduleToStartTimeout,
 		versionDirective:                   directive,
 		priority:                           priority,
+		fairnessKey:                        workflow.FairnessKey(shardContext, mutableState),
 	}, nil
 }
 
 func newWorkflowTaskPostActionInfo(
+	shardContext historyi.ShardContext,
 	mutableState historyi.MutableState,
 	workflowTaskScheduleToStartTimeout time.Duration,
 	taskqueue *taskqueuepb.TaskQueue,
@@ -199,6 +207,7 @@ func newWorkflowTaskPostActionInfo(
 		taskqueue:                          taskqueue,
 		versionDirective:                   directive,
 		priority:                           priority,
+		fairnessKey:                        workflow.FairnessKey(shardContext, mutableState),
 	}, nil
 }
 
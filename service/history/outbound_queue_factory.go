@@ -242,6 +242,7 @@ func (f *outboundQueueFactory) CreateQueue(
 		shardContext.GetTimeSource(),
 		shardContext.GetNamespaceRegistry(),
 		shardContext.GetClusterMetadata(),
+		f.Config.ShardMapProvider,
 		logger,
 		metricsHandler,
 		f.TracerProvider.Tracer(telemetry.ComponentQueueOutbound),
@@ -28,6 +28,7 @@ import (
 	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/shardmap"
 	ctasks "go.temporal.io/server/common/tasks"
 	"go.temporal.io/server/common/telemetry"
 	"go.temporal.io/server/common/util"
@@ -144,6 +145,7 @@ type (
 		metricsHandler    metrics.Handler
 		tracer            trace.Tracer
 		dlqWriter         *DLQWriter
+		shardMapProvider  *shardmap.Provider
 
 		readerID                   int64
 		loadTime                   time.Time
@@ -166,6 +168,9 @@ type (
 		MaxUnexpectedErrorAttempts dynamicconfig.IntPropertyFn
 		DLQInternalErrors          dynamicconfig.BoolPropertyFn
 		DLQErrorPattern            dynamicconfig.StringPropertyFn
+		// ShardMapProvider routes the tasks written to the DLQ to the shard of their workflow. The shard count of the
+		// cluster metadata is used if it's nil.
+		ShardMapProvider *shardmap.Provider
 	}
 	ExecutableOption func(*ExecutableParams)
 )
@@ -229,6 +234,7 @@ func NewExecutable(
 		maxUnexpectedErrorAttempts: params.MaxUnexpectedErrorAttempts,
 		dlqInternalErrors:          params.DLQInternalErrors,
 		dlqErrorPattern:            params.DLQErrorPattern,
+		shardMapProvider:           params.ShardMapProvider,
 	}
 	executable.updatePriority()
 	return executable
@@ -364,14 +370,17 @@ func (e *executableImpl) Execute() (retErr error) {
 func (e *executableImpl) writeToDLQ(ctx context.Context) error {
 
 	currentClusterName := e.clusterMetadata.GetCurrentClusterName()
-	numShards := e.clusterMetadata.GetAllClusterInfo()[currentClusterName].ShardCount
+	shardID := tasks.GetShardIDForTask(e.Task, int(e.clusterMetadata.GetAllClusterInfo()[currentClusterName].ShardCount))
+	if e.shardMapProvider != nil {
+		shardID = int(e.shardMapProvider.WorkflowIDToHistoryShard(e.GetNamespaceID(), e.GetWorkflowID()))
+	}
 
 	start := e.timeSource.Now()
 	err := e.dlqWriter.WriteTaskToDLQ(
 		ctx,
 		currentClusterName,
 		currentClusterName,
-		tasks.GetShardIDForTask(e.Task, int(numShards)),
+		shardID,
 		e.GetTask(),
 	)
 	if err != nil {
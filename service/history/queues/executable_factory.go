@@ -8,6 +8,7 @@ import (
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/shardmap"
 	"go.temporal.io/server/service/history/tasks"
 )
 
@@ -27,6 +28,7 @@ type (
 		timeSource                 clock.TimeSource
 		namespaceRegistry          namespace.Registry
 		clusterMetadata            cluster.Metadata
+		shardMapProvider           *shardmap.Provider
 		logger                     log.Logger
 		metricsHandler             metrics.Handler
 		tracer                     trace.Tracer
@@ -50,6 +52,7 @@ func NewExecutableFactory(
 	timeSource clock.TimeSource,
 	namespaceRegistry namespace.Registry,
 	clusterMetadata cluster.Metadata,
+	shardMapProvider *shardmap.Provider,
 	logger log.Logger,
 	metricsHandler metrics.Handler,
 	tracer trace.Tracer,
@@ -67,6 +70,7 @@ func NewExecutableFactory(
 		timeSource:                 timeSource,
 		namespaceRegistry:          namespaceRegistry,
 		clusterMetadata:            clusterMetadata,
+		shardMapProvider:           shardMapProvider,
 		logger:                     logger,
 		metricsHandler:             metricsHandler.WithTags(defaultExecutableMetricsTags...),
 		tracer:                     tracer,
@@ -98,6 +102,7 @@ func (f *executableFactoryImpl) NewExecutable(task tasks.Task, readerID int64) E
 			params.MaxUnexpectedErrorAttempts = f.attemptsBeforeSendingToDlq
 			params.DLQInternalErrors = f.dlqInternalErrors
 			params.DLQErrorPattern = f.dlqErrorPattern
+			params.ShardMapProvider = f.shardMapProvider
 		},
 	)
 }
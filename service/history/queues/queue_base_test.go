@@ -542,6 +542,7 @@ func (s *queueBaseSuite) newQueueBase(
 		mockShard.GetTimeSource(),
 		mockShard.GetNamespaceRegistry(),
 		mockShard.GetClusterMetadata(),
+		nil,
 		s.logger,
 		s.metricsHandler,
 		telemetry.NoopTracer,
@@ -114,6 +114,7 @@ func (s *scheduledQueueSuite) SetupTest() {
 		s.mockShard.GetTimeSource(),
 		s.mockShard.GetNamespaceRegistry(),
 		s.mockShard.GetClusterMetadata(),
+		nil,
 		logger,
 		metrics.NoopMetricsHandler,
 		telemetry.NoopTracer,
@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"go.temporal.io/server/common/cluster"
+	"go.temporal.io/server/common/shardmap"
 )
 
 type (
@@ -13,8 +14,9 @@ type (
 	}
 
 	pollerManagerImpl struct {
-		currentShardId  int32
-		clusterMetadata cluster.Metadata
+		currentShardId   int32
+		clusterMetadata  cluster.Metadata
+		shardMapProvider *shardmap.Provider
 	}
 )
 
@@ -23,34 +25,36 @@ var _ pollerManager = (*pollerManagerImpl)(nil)
 func newPollerManager(
 	currentShardId int32,
 	clusterMetadata cluster.Metadata,
+	shardMapProvider *shardmap.Provider,
 ) *pollerManagerImpl {
 	return &pollerManagerImpl{
-		currentShardId:  currentShardId,
-		clusterMetadata: clusterMetadata,
+		currentShardId:   currentShardId,
+		clusterMetadata:  clusterMetadata,
+		shardMapProvider: shardMapProvider,
 	}
 }
 
 func (p pollerManagerImpl) getSourceClusterShardIDs(sourceClusterName string) ([]int32, error) {
 	currentCluster := p.clusterMetadata.GetCurrentClusterName()
 	allClusters := p.clusterMetadata.GetAllClusterInfo()
-	currentClusterInfo, ok := allClusters[currentCluster]
-	if !ok {
+	if _, ok := allClusters[currentCluster]; !ok {
 		return nil, errors.New("cannot get current cluster info from cluster metadata cache")
 	}
-	remoteClusterInfo, ok := allClusters[sourceClusterName]
-	if !ok {
+	if _, ok := allClusters[sourceClusterName]; !ok {
 		return nil, errors.New(fmt.Sprintf("cannot get source cluster %s info from cluster metadata cache", sourceClusterName))
 	}
+	currentShardCount := p.shardMapProvider.ClusterShardCount(p.clusterMetadata, currentCluster)
+	remoteShardCount := p.shardMapProvider.ClusterShardCount(p.clusterMetadata, sourceClusterName)
 
 	// The remote shard count and local shard count must be multiples.
-	large, small := remoteClusterInfo.ShardCount, currentClusterInfo.ShardCount
+	large, small := remoteShardCount, currentShardCount
 	if small > large {
 		large, small = small, large
 	}
 	if large%small != 0 {
-		return nil, errors.New(fmt.Sprintf("remote shard count %d and local shard count %d are not multiples.", remoteClusterInfo.ShardCount, currentClusterInfo.ShardCount))
+		return nil, errors.New(fmt.Sprintf("remote shard count %d and local shard count %d are not multiples.", remoteShardCount, currentShardCount))
 	}
-	return generateShardIDs(p.currentShardId, currentClusterInfo.ShardCount, remoteClusterInfo.ShardCount), nil
+	return generateShardIDs(p.currentShardId, currentShardCount, remoteShardCount), nil
 }
 
 // NOTE generateShardIDs is different than common.MapShardID
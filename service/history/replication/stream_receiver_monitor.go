@@ -170,8 +170,8 @@ func (m *StreamReceiverMonitorImpl) generateInboundStreamKeys() map[ClusterShard
 	clientClusterIDs := make(map[int32]struct{})
 	serverClusterID := int32(m.ClusterMetadata.GetClusterID())
 	clusterIDToShardCount := make(map[int32]int32)
-	for _, clusterInfo := range allClusterInfo {
-		clusterIDToShardCount[int32(clusterInfo.InitialFailoverVersion)] = clusterInfo.ShardCount
+	for clusterName, clusterInfo := range allClusterInfo {
+		clusterIDToShardCount[int32(clusterInfo.InitialFailoverVersion)] = m.Config.ShardMapProvider.ClusterShardCount(m.ClusterMetadata, clusterName)
 
 		if !clusterInfo.Enabled || int32(clusterInfo.InitialFailoverVersion) == serverClusterID {
 			continue
@@ -207,8 +207,8 @@ func (m *StreamReceiverMonitorImpl) generateOutboundStreamKeys() map[ClusterShar
 	clientClusterID := int32(m.ClusterMetadata.GetClusterID())
 	serverClusterIDs := make(map[int32]struct{})
 	clusterIDToShardCount := make(map[int32]int32)
-	for _, clusterInfo := range allClusterInfo {
-		clusterIDToShardCount[int32(clusterInfo.InitialFailoverVersion)] = clusterInfo.ShardCount
+	for clusterName, clusterInfo := range allClusterInfo {
+		clusterIDToShardCount[int32(clusterInfo.InitialFailoverVersion)] = m.Config.ShardMapProvider.ClusterShardCount(m.ClusterMetadata, clusterName)
 
 		if !clusterInfo.Enabled || int32(clusterInfo.InitialFailoverVersion) == clientClusterID {
 			continue
@@ -22,6 +22,7 @@ import (
 	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/persistence/serialization"
+	"go.temporal.io/server/common/shardmap"
 	"go.temporal.io/server/common/xdc"
 	"go.temporal.io/server/service/history/configs"
 	"go.temporal.io/server/service/history/consts"
@@ -126,7 +127,7 @@ func NewTaskProcessorManager(
 		enableFetcher:        !config.EnableReplicationStream(),
 		taskProcessors:       make(map[string][]TaskProcessor),
 		taskExecutorProvider: taskExecutorProvider,
-		taskPollerManager:    newPollerManager(shardContext.GetShardID(), shardContext.GetClusterMetadata()),
+		taskPollerManager:    newPollerManager(shardContext.GetShardID(), shardContext.GetClusterMetadata(), config.ShardMapProvider),
 		minTxAckedTaskID:     persistence.EmptyQueueMessageID,
 		shutdownChan:         make(chan struct{}),
 	}
@@ -286,7 +287,6 @@ func (r *taskProcessorManagerImpl) checkReplicationDLQEmptyLoop() {
 
 func (r *taskProcessorManagerImpl) cleanupReplicationTasks() error {
 	clusterMetadata := r.shard.GetClusterMetadata()
-	allClusterInfo := clusterMetadata.GetAllClusterInfo()
 	currentClusterName := clusterMetadata.GetCurrentClusterName()
 
 	minAckedTaskID := r.shard.GetQueueExclusiveHighReadWatermark(tasks.CategoryReplication).TaskID - 1
@@ -298,9 +298,9 @@ func (r *taskProcessorManagerImpl) cleanupReplicationTasks() error {
 		}
 	}
 	for _, readerID := range targetReaderIDs(
-		currentClusterName,
+		clusterMetadata,
+		r.config.ShardMapProvider,
 		r.shard.GetShardID(),
-		allClusterInfo,
 	) {
 		readerState, ok := queueStates.ReaderStates[readerID]
 		if !ok {
@@ -373,19 +373,20 @@ func (r *taskProcessorManagerImpl) checkReplicationDLQSize() {
 }
 
 func targetReaderIDs(
-	currentClusterName string,
+	clusterMetadata cluster.Metadata,
+	shardMapProvider *shardmap.Provider,
 	currentShardID int32,
-	allClusterInfo map[string]cluster.ClusterInformation,
 ) []int64 {
-	currentShardCount := allClusterInfo[currentClusterName].ShardCount
+	currentClusterName := clusterMetadata.GetCurrentClusterName()
+	currentShardCount := shardMapProvider.ClusterShardCount(clusterMetadata, currentClusterName)
 	var readerIDs []int64
-	for clusterName, clusterInfo := range allClusterInfo {
+	for clusterName, clusterInfo := range clusterMetadata.GetAllClusterInfo() {
 		if clusterName == currentClusterName || !clusterInfo.Enabled {
 			continue
 		}
 
-		targetClusterID := allClusterInfo[clusterName].InitialFailoverVersion
-		targetShardCount := allClusterInfo[clusterName].ShardCount
+		targetClusterID := clusterInfo.InitialFailoverVersion
+		targetShardCount := shardMapProvider.ClusterShardCount(clusterMetadata, clusterName)
 		for _, targetShardID := range common.MapShardID(
 			currentShardCount,
 			targetShardCount,
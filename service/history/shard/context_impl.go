@@ -149,6 +149,8 @@ type (
 		stateMachineRegistry *hsm.Registry
 
 		chasmRegistry *chasm.Registry
+
+		load *shardLoadCounters
 	}
 
 	remoteClusterInfo struct {
@@ -180,6 +182,7 @@ const (
 )
 
 var _ historyi.ShardContext = (*ContextImpl)(nil)
+var _ loadReporter = (*ContextImpl)(nil)
 
 var (
 	// ErrShardStatusUnknown means we're not sure if we have the shard lock or not. This may be returned
@@ -405,11 +408,10 @@ func (s *ContextImpl) UpdateRemoteClusterInfo(
 	s.wLock()
 	defer s.wUnlock()
 
-	clusterInfo := s.clusterMetadata.GetAllClusterInfo()
 	remoteClusterInfo := s.getOrUpdateRemoteClusterInfoLocked(clusterName)
 	for _, remoteShardID := range common.MapShardID(
-		clusterInfo[s.clusterMetadata.GetCurrentClusterName()].ShardCount,
-		clusterInfo[clusterName].ShardCount,
+		s.config.ShardMapProvider.ClusterShardCount(s.clusterMetadata, s.clusterMetadata.GetCurrentClusterName()),
+		s.config.ShardMapProvider.ClusterShardCount(s.clusterMetadata, clusterName),
 		s.shardID,
 	) {
 		remoteClusterInfo.AckedReplicationTaskIDs[remoteShardID] = ackTaskID
@@ -616,7 +618,9 @@ func (s *ContextImpl) CreateWorkflowExecution(
 	request.RangeID = currentRangeID
 
 	s.wUnlock()
+	startTime := time.Now()
 	resp, err := s.executionManager.CreateWorkflowExecution(ctx, request)
+	s.load.recordPersistenceRequest(time.Since(startTime))
 	requestCompletionFn(err)
 
 	if err = s.handleWriteError(request.RangeID, err); err != nil {
@@ -679,7 +683,9 @@ func (s *ContextImpl) UpdateWorkflowExecution(
 	request.RangeID = s.getRangeIDLocked()
 	s.wUnlock()
 
+	startTime := time.Now()
 	resp, err := s.executionManager.UpdateWorkflowExecution(ctx, request)
+	s.load.recordPersistenceRequest(time.Since(startTime))
 	requestCompletionFn(err)
 	if err = s.handleWriteError(request.RangeID, err); err != nil {
 		return nil, err
@@ -756,7 +762,9 @@ func (s *ContextImpl) ConflictResolveWorkflowExecution(
 	request.RangeID = s.getRangeIDLocked()
 	s.wUnlock()
 
+	startTime := time.Now()
 	resp, err := s.executionManager.ConflictResolveWorkflowExecution(ctx, request)
+	s.load.recordPersistenceRequest(time.Since(startTime))
 	requestCompletionFn(err)
 	if err = s.handleWriteError(request.RangeID, err); err != nil {
 		return nil, err
@@ -811,7 +819,9 @@ func (s *ContextImpl) SetWorkflowExecution(
 	request.RangeID = s.getRangeIDLocked()
 	s.wUnlock()
 
+	startTime := time.Now()
 	resp, err := s.executionManager.SetWorkflowExecution(ctx, request)
+	s.load.recordPersistenceRequest(time.Since(startTime))
 	snapShotRequestCompletionFn(err)
 	if err = s.handleWriteError(request.RangeID, err); err != nil {
 		return nil, err
@@ -827,7 +837,9 @@ func (s *ContextImpl) GetCurrentExecution(
 		return nil, err
 	}
 
+	startTime := time.Now()
 	resp, err := s.executionManager.GetCurrentExecution(ctx, request)
+	s.load.recordPersistenceRequest(time.Since(startTime))
 	if err = s.handleReadError(err); err != nil {
 		// also return resp, for RebuildMutableState API
 		return resp, err
@@ -843,7 +855,9 @@ func (s *ContextImpl) GetWorkflowExecution(
 		return nil, err
 	}
 
+	startTime := time.Now()
 	resp, err := s.executionManager.GetWorkflowExecution(ctx, request)
+	s.load.recordPersistenceRequest(time.Since(startTime))
 	if err = s.handleReadError(err); err != nil {
 		// also return resp, for RebuildMutableState API
 		return resp, err
@@ -893,7 +907,9 @@ func (s *ContextImpl) addTasksSemaphoreAcquired(
 	request.RangeID = s.getRangeIDLocked()
 	s.wUnlock()
 
+	startTime := time.Now()
 	err = s.executionManager.AddHistoryTasks(ctx, request)
+	s.load.recordPersistenceRequest(time.Since(startTime))
 	requestCompletionFn(err)
 	return s.handleWriteError(request.RangeID, err)
 }
@@ -909,6 +925,7 @@ func (s *ContextImpl) AppendHistoryEvents(
 	}
 
 	request.ShardID = s.shardID
+	request.NamespaceID = namespaceID.String()
 
 	size := 0
 	defer func() {
@@ -930,7 +947,9 @@ func (s *ContextImpl) AppendHistoryEvents(
 				tag.WorkflowHistorySizeBytes(size))
 		}
 	}()
+	startTime := time.Now()
 	resp, err0 := s.GetExecutionManager().AppendHistoryNodes(ctx, request)
+	s.load.recordPersistenceRequest(time.Since(startTime))
 	if resp != nil {
 		size = resp.Size
 	}
@@ -1294,6 +1313,11 @@ func (s *ContextImpl) emitShardInfoMetricsLogs() {
 
 	metricsHandler := s.GetMetricsHandler().WithTags(metrics.OperationTag(metrics.ShardInfoScope))
 
+	var taskBacklog int64
+	defer func() {
+		s.load.setTaskBacklog(taskBacklog)
+	}()
+
 Loop:
 	for categoryID, queueState := range queueStates {
 		category, ok := s.taskCategoryRegistry.GetCategoryByID(int(categoryID))
@@ -1308,6 +1332,7 @@ Loop:
 				continue Loop
 			}
 			lag := s.taskKeyManager.getExclusiveReaderHighWatermark(category).TaskID - minTaskKey.TaskID
+			taskBacklog += lag
 			if emitShardLagLog && lag > logWarnImmediateTaskLag {
 				s.contextTaggedLogger.Warn(
 					"Shard queue lag exceeds warn threshold.",
@@ -1338,6 +1363,14 @@ Loop:
 	}
 }
 
+func (s *ContextImpl) recordRequest() {
+	s.load.recordRequest()
+}
+
+func (s *ContextImpl) reportLoad(now time.Time) ShardLoad {
+	return s.load.report(s.shardID, now)
+}
+
 func (s *ContextImpl) SetCurrentTime(cluster string, currentTime time.Time) {
 	s.wLock()
 	defer s.wUnlock()
@@ -1877,8 +1910,8 @@ func (s *ContextImpl) GetReplicationStatus(clusterNames []string) (map[string]*h
 		}
 
 		for _, remoteShardID := range common.MapShardID(
-			clusterInfo[s.clusterMetadata.GetCurrentClusterName()].ShardCount,
-			clusterInfo[clusterName].ShardCount,
+			s.config.ShardMapProvider.ClusterShardCount(s.clusterMetadata, s.clusterMetadata.GetCurrentClusterName()),
+			s.config.ShardMapProvider.ClusterShardCount(s.clusterMetadata, clusterName),
 			s.shardID,
 		) {
 			ackTaskID := v.AckedReplicationTaskIDs[remoteShardID] // default to 0
@@ -2118,6 +2151,7 @@ func newContext(
 		ioSemaphore:             locks.NewPrioritySemaphore(ioConcurrency),
 		stateMachineRegistry:    stateMachineRegistry,
 		chasmRegistry:           chasmRegistry,
+		load:                    newShardLoadCounters(timeSource.Now()),
 	}
 	shardContext.taskKeyManager = newTaskKeyManager(
 		shardContext.taskCategoryRegistry,
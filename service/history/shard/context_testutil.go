@@ -160,6 +160,7 @@ func newTestContext(t *resourcetest.Test, eventsCache events.Cache, config Conte
 		hostInfoProvider:        hostInfoProvider,
 		taskCategoryRegistry:    taskCategoryRegistry,
 		ioSemaphore:             locks.NewPrioritySemaphore(1),
+		load:                    newShardLoadCounters(t.TimeSource.Now()),
 	}
 	ctx.taskKeyManager = newTaskKeyManager(
 		ctx.taskCategoryRegistry,
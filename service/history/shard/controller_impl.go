@@ -33,12 +33,18 @@ const (
 var (
 	invalidShardIdLowerBound = serviceerror.NewInvalidArgument("shard Id cannot be equal or lower than zero")
 	invalidShardIdUpperBound = serviceerror.NewInvalidArgument("shard Id cannot be larger than max shard count")
+	errShardSplitting        = serviceerror.NewUnavailable("shard is being split, its moved workflows aren't loaded yet")
+	errWorkflowMoving        = serviceerror.NewUnavailable("workflow is being moved to another shard")
 )
 
 type (
 	ControllerImpl struct {
 		sync.RWMutex
 		historyShards map[int32]historyi.ControllableContext
+		// shardSplits are the splits in progress of the shards owned by this host, by parent shard.
+		shardSplits map[int32]*shardSplit
+		// shardSplitLoads is signaled when a request is routed to a shard whose split isn't loaded yet.
+		shardSplitLoads chan struct{}
 
 		lingerState struct {
 			sync.Mutex
@@ -58,6 +64,17 @@ type (
 		initialShardsAcquired   *future.FutureImpl[struct{}]
 		shardReadinessCancel    atomic.Value // context.CancelFunc
 	}
+	// shardSplit tracks the workflows of a shard which are moved to its child shards. Only the workflow being
+	// moved is rejected, the moved workflows are routed to their child shard until the split of the shard is
+	// completed in the shard map, and the other workflows are still routed to the shard.
+	shardSplit struct {
+		// workflows are the workflows moved to a child shard, mapped to true, or being moved, mapped to false.
+		workflows map[workflowIdentifier]bool
+	}
+	workflowIdentifier struct {
+		namespaceID string
+		workflowID  string
+	}
 	// shardCountSubscription is a subscription to shard count updates.
 	shardCountSubscription struct {
 		controller *ControllerImpl
@@ -71,6 +88,7 @@ func ControllerProvider(
 	config *configs.Config,
 	logger log.Logger,
 	historyServiceResolver membership.ServiceResolver,
+	shardOwners *membership.ShardOwners,
 	metricsHandler metrics.Handler,
 	hostInfoProvider membership.HostInfoProvider,
 	contextFactory ContextFactory,
@@ -83,6 +101,7 @@ func ControllerProvider(
 		config,
 		historyServiceResolver,
 		hostInfoProvider,
+		shardOwners,
 		contextTaggedLogger,
 		taggedMetricsHandler,
 	)
@@ -92,6 +111,8 @@ func ControllerProvider(
 		contextFactory:          contextFactory,
 		contextTaggedLogger:     contextTaggedLogger,
 		historyShards:           make(map[int32]historyi.ControllableContext),
+		shardSplits:             make(map[int32]*shardSplit),
+		shardSplitLoads:         make(chan struct{}, 1),
 		hostInfoProvider:        hostInfoProvider,
 		ownership:               ownership,
 		taggedMetricsHandler:    taggedMetricsHandler,
@@ -168,10 +189,99 @@ func (c *ControllerImpl) GetShardByNamespaceWorkflow(
 	namespaceID namespace.ID,
 	workflowID string,
 ) (historyi.ShardContext, error) {
-	shardID := c.config.GetShardID(namespaceID, workflowID)
+	shardMap := c.config.ShardMapProvider.ShardMap()
+	shardID := shardMap.WorkflowIDToHistoryShard(namespaceID.String(), workflowID)
+	if shardMap.IsSplitting() {
+		if childShardID := shardMap.WorkflowIDToChildShard(namespaceID.String(), workflowID); childShardID != shardID {
+			moved, err := c.isWorkflowMoved(shardID, workflowIdentifier{
+				namespaceID: namespaceID.String(),
+				workflowID:  workflowID,
+			})
+			if err != nil {
+				return nil, err
+			}
+			if moved {
+				shardID = childShardID
+			}
+		}
+	}
 	return c.GetShardByID(shardID)
 }
 
+// isWorkflowMoved returns true if the workflow was moved from the shard to its child shard, and an error if the
+// workflow is being moved, or if the workflows already moved, e.g. by the previous owner of the shard, aren't loaded.
+func (c *ControllerImpl) isWorkflowMoved(shardID int32, workflow workflowIdentifier) (bool, error) {
+	c.RLock()
+	defer c.RUnlock()
+	split, ok := c.shardSplits[shardID]
+	if !ok {
+		select {
+		case c.shardSplitLoads <- struct{}{}:
+		default:
+		}
+		return false, errShardSplitting
+	}
+	moved, ok := split.workflows[workflow]
+	if ok && !moved {
+		return false, errWorkflowMoving
+	}
+	return moved, nil
+}
+
+func (c *ControllerImpl) isShardSplitLoaded(shardID int32) bool {
+	c.RLock()
+	defer c.RUnlock()
+	_, ok := c.shardSplits[shardID]
+	return ok
+}
+
+// loadShardSplit starts routing the workflows which were already moved from the shard to their child shard.
+func (c *ControllerImpl) loadShardSplit(shardID int32, movedWorkflows []workflowIdentifier) {
+	split := &shardSplit{workflows: make(map[workflowIdentifier]bool, len(movedWorkflows))}
+	for _, workflow := range movedWorkflows {
+		split.workflows[workflow] = true
+	}
+	c.Lock()
+	defer c.Unlock()
+	c.shardSplits[shardID] = split
+}
+
+// beginWorkflowMove rejects the requests for the workflow until endWorkflowMove is called, and returns true if the
+// workflow was already moved, i.e. only runs started since it was moved are left in the shard.
+func (c *ControllerImpl) beginWorkflowMove(shardID int32, workflow workflowIdentifier) bool {
+	c.Lock()
+	defer c.Unlock()
+	split, ok := c.shardSplits[shardID]
+	if !ok {
+		return false
+	}
+	moved := split.workflows[workflow]
+	split.workflows[workflow] = false
+	return moved
+}
+
+// endWorkflowMove routes the requests for the workflow to its child shard if it was moved, or to the shard otherwise.
+func (c *ControllerImpl) endWorkflowMove(shardID int32, workflow workflowIdentifier, moved bool) {
+	c.Lock()
+	defer c.Unlock()
+	split, ok := c.shardSplits[shardID]
+	if !ok {
+		return
+	}
+	if moved {
+		split.workflows[workflow] = true
+	} else {
+		delete(split.workflows, workflow)
+	}
+}
+
+// endShardSplit forgets the moved workflows of the shard, once the shard map routes them to their child shard.
+func (c *ControllerImpl) endShardSplit(shardID int32) {
+	c.Lock()
+	defer c.Unlock()
+	delete(c.shardSplits, shardID)
+}
+
 // GetShardByID returns a shard context for the given shard id.
 // The shard context may not have acquired a rangeid lease yet.
 // Callers can use GetEngine on the shard to block on rangeid lease acquisition.
@@ -183,7 +293,14 @@ func (c *ControllerImpl) GetShardByID(
 		metrics.GetEngineForShardLatency.With(c.taggedMetricsHandler).Record(time.Since(startTime))
 	}()
 
-	return c.getOrCreateShardContext(shardID)
+	shard, err := c.getOrCreateShardContext(shardID)
+	if err != nil {
+		return nil, err
+	}
+	if reporter, ok := shard.(loadReporter); ok {
+		reporter.recordRequest()
+	}
+	return shard, nil
 }
 
 func (c *ControllerImpl) CloseShardByID(shardID int32) {
@@ -211,6 +328,24 @@ func (c *ControllerImpl) ShardIDs() []int32 {
 	return ids
 }
 
+// reportShardLoads returns the load of the shards owned by this host since the previous call.
+func (c *ControllerImpl) reportShardLoads(now time.Time) []ShardLoad {
+	c.RLock()
+	reporters := make([]loadReporter, 0, len(c.historyShards))
+	for _, shard := range c.historyShards {
+		if reporter, ok := shard.(loadReporter); ok && shard.IsValid() {
+			reporters = append(reporters, reporter)
+		}
+	}
+	c.RUnlock()
+
+	loads := make([]ShardLoad, 0, len(reporters))
+	for _, reporter := range reporters {
+		loads = append(loads, reporter.reportLoad(now))
+	}
+	return loads
+}
+
 func (c *ControllerImpl) shardRemoveAndStop(shard historyi.ControllableContext) {
 	startTime := time.Now().UTC()
 	defer func() {
@@ -293,6 +428,8 @@ func (c *ControllerImpl) removeShardLocked(shardID int32, expected historyi.Cont
 	}
 
 	delete(c.historyShards, shardID)
+	// The next owner of the shard may move more workflows, they're loaded again if the shard is acquired again.
+	delete(c.shardSplits, c.config.ShardMapProvider.ShardMap().ParentShard(shardID))
 	c.contextTaggedLogger.Info("", numShardsTag(len(c.historyShards)))
 	metrics.ShardContextRemovedCounter.With(c.taggedMetricsHandler).Record(1)
 
@@ -442,7 +579,7 @@ func (c *ControllerImpl) acquireShards(ctx context.Context) {
 
 	concurrency := int64(max(c.config.AcquireShardConcurrency(), 1))
 	sem := semaphore.NewWeighted(concurrency)
-	numShards := c.config.NumberOfShards
+	numShards := c.config.ShardMapProvider.TotalShardCount()
 	randomStartOffset := rand.Int31n(numShards)
 	for index := range numShards {
 		shardID := (index+randomStartOffset)%numShards + 1
@@ -547,7 +684,7 @@ func (c *ControllerImpl) validateShardId(shardID int32) error {
 	if shardID <= 0 {
 		return invalidShardIdLowerBound
 	}
-	if shardID > c.config.NumberOfShards {
+	if shardID > c.config.ShardMapProvider.TotalShardCount() {
 		return invalidShardIdUpperBound
 	}
 	return nil
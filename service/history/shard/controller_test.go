@@ -31,6 +31,7 @@ import (
 	"go.temporal.io/server/common/primitives"
 	"go.temporal.io/server/common/primitives/timestamp"
 	"go.temporal.io/server/common/resourcetest"
+	"go.temporal.io/server/common/shardmap"
 	"go.temporal.io/server/service/history/configs"
 	historyi "go.temporal.io/server/service/history/interfaces"
 	"go.temporal.io/server/service/history/tasks"
@@ -96,6 +97,7 @@ func NewTestController(
 		config,
 		resource.GetLogger(),
 		resource.GetHistoryServiceResolver(),
+		ShardOwnersProvider(config, resource.GetHistoryServiceResolver()),
 		metricsTestHandler,
 		resource.GetHostInfoProvider(),
 		contextFactory,
@@ -149,6 +151,7 @@ func (s *controllerSuite) TearDownTest() {
 func (s *controllerSuite) TestAcquireShardSuccess() {
 	numShards := int32(8)
 	s.config.NumberOfShards = numShards
+	s.config.ShardMapProvider = shardmap.NewProvider(s.config.NumberOfShards)
 
 	var myShards []int32
 	historyEngines := make(map[int32]*historyi.MockEngine)
@@ -182,6 +185,7 @@ func (s *controllerSuite) TestAcquireShardSuccess() {
 func (s *controllerSuite) TestAcquireShardsConcurrently() {
 	numShards := int32(10)
 	s.config.NumberOfShards = numShards
+	s.config.ShardMapProvider = shardmap.NewProvider(s.config.NumberOfShards)
 	s.config.AcquireShardConcurrency = func() int {
 		return 10
 	}
@@ -220,6 +224,7 @@ func (s *controllerSuite) TestAcquireShardsConcurrently() {
 func (s *controllerSuite) TestAcquireShardLookupFailure() {
 	numShards := int32(2)
 	s.config.NumberOfShards = numShards
+	s.config.ShardMapProvider = shardmap.NewProvider(s.config.NumberOfShards)
 	for shardID := int32(1); shardID <= numShards; shardID++ {
 		s.mockServiceResolver.EXPECT().Lookup(convert.Int32ToString(shardID)).Return(nil, errors.New("ring failure"))
 	}
@@ -236,6 +241,7 @@ func (s *controllerSuite) TestAcquireShardLookupFailure() {
 func (s *controllerSuite) TestAcquireShardRenewSuccess() {
 	numShards := int32(2)
 	s.config.NumberOfShards = numShards
+	s.config.ShardMapProvider = shardmap.NewProvider(s.config.NumberOfShards)
 
 	historyEngines := make(map[int32]*historyi.MockEngine)
 	for shardID := int32(1); shardID <= numShards; shardID++ {
@@ -266,6 +272,7 @@ func (s *controllerSuite) TestAcquireShardRenewSuccess() {
 func (s *controllerSuite) TestAcquireShardRenewLookupFailed() {
 	numShards := int32(2)
 	s.config.NumberOfShards = numShards
+	s.config.ShardMapProvider = shardmap.NewProvider(s.config.NumberOfShards)
 
 	historyEngines := make(map[int32]*historyi.MockEngine)
 	for shardID := int32(1); shardID <= numShards; shardID++ {
@@ -296,6 +303,7 @@ func (s *controllerSuite) TestAcquireShardRenewLookupFailed() {
 func (s *controllerSuite) TestHistoryEngineClosed() {
 	numShards := int32(4)
 	s.config.NumberOfShards = numShards
+	s.config.ShardMapProvider = shardmap.NewProvider(s.config.NumberOfShards)
 	s.shardController = NewTestController(
 		s.mockEngineFactory,
 		s.config,
@@ -397,6 +405,7 @@ func (s *controllerSuite) TestHistoryEngineClosed() {
 func (s *controllerSuite) TestShardControllerClosed() {
 	numShards := int32(4)
 	s.config.NumberOfShards = numShards
+	s.config.ShardMapProvider = shardmap.NewProvider(s.config.NumberOfShards)
 	s.shardController = NewTestController(
 		s.mockEngineFactory,
 		s.config,
@@ -449,6 +458,7 @@ func (s *controllerSuite) TestShardControllerClosed() {
 
 func (s *controllerSuite) TestShardExplicitUnload() {
 	s.config.NumberOfShards = 1
+	s.config.ShardMapProvider = shardmap.NewProvider(s.config.NumberOfShards)
 
 	mockEngine := historyi.NewMockEngine(s.controller)
 	mockEngine.EXPECT().Stop().AnyTimes()
@@ -470,6 +480,7 @@ func (s *controllerSuite) TestShardExplicitUnload() {
 
 func (s *controllerSuite) TestShardExplicitUnloadCancelGetOrCreate() {
 	s.config.NumberOfShards = 1
+	s.config.ShardMapProvider = shardmap.NewProvider(s.config.NumberOfShards)
 
 	mockEngine := historyi.NewMockEngine(s.controller)
 	mockEngine.EXPECT().Stop().AnyTimes()
@@ -511,6 +522,7 @@ func (s *controllerSuite) TestShardExplicitUnloadCancelGetOrCreate() {
 
 func (s *controllerSuite) TestShardExplicitUnloadCancelAcquire() {
 	s.config.NumberOfShards = 1
+	s.config.ShardMapProvider = shardmap.NewProvider(s.config.NumberOfShards)
 
 	mockEngine := historyi.NewMockEngine(s.controller)
 	mockEngine.EXPECT().Stop().AnyTimes()
@@ -565,6 +577,7 @@ func (s *controllerSuite) TestShardExplicitUnloadCancelAcquire() {
 // that were not covered by specific tests.
 func (s *controllerSuite) TestShardControllerFuzz() {
 	s.config.NumberOfShards = 10
+	s.config.ShardMapProvider = shardmap.NewProvider(s.config.NumberOfShards)
 
 	s.mockServiceResolver.EXPECT().AddListener(shardControllerMembershipUpdateListenerName, gomock.Any()).Return(nil).AnyTimes()
 	s.mockServiceResolver.EXPECT().RemoveListener(shardControllerMembershipUpdateListenerName).Return(nil).AnyTimes()
@@ -701,6 +714,7 @@ func (s *controllerSuite) TestShardControllerFuzz() {
 func (s *controllerSuite) Test_GetOrCreateShard_InvalidShardID() {
 	numShards := int32(2)
 	s.config.NumberOfShards = numShards
+	s.config.ShardMapProvider = shardmap.NewProvider(s.config.NumberOfShards)
 
 	_, err := s.shardController.getOrCreateShardContext(0)
 	s.ErrorIs(err, invalidShardIdLowerBound)
@@ -709,9 +723,68 @@ func (s *controllerSuite) Test_GetOrCreateShard_InvalidShardID() {
 	s.ErrorIs(err, invalidShardIdUpperBound)
 }
 
+func (s *controllerSuite) TestGetShardByNamespaceWorkflow_ShardSplitting() {
+	s.config.NumberOfShards = 2
+	shardMap, err := shardmap.NewShardMap(s.config.NumberOfShards).StartSplit(2)
+	s.NoError(err)
+	s.config.ShardMapProvider = shardmap.NewProvider(s.config.NumberOfShards)
+	s.config.ShardMapProvider.Update(shardMap)
+
+	// child shards are valid shard IDs during the split
+	_, err = s.shardController.getOrCreateShardContext(5)
+	s.ErrorIs(err, invalidShardIdUpperBound)
+
+	namespaceID := tests.NamespaceID
+	var workflowID string
+	for i := 0; workflowID == ""; i++ {
+		candidate := fmt.Sprintf("workflow-%d", i)
+		if shardMap.WorkflowIDToChildShard(namespaceID.String(), candidate) != shardMap.WorkflowIDToHistoryShard(namespaceID.String(), candidate) {
+			workflowID = candidate
+		}
+	}
+	shardID := shardMap.WorkflowIDToHistoryShard(namespaceID.String(), workflowID)
+	workflow := workflowIdentifier{namespaceID: namespaceID.String(), workflowID: workflowID}
+
+	// the workflows already moved aren't loaded
+	_, err = s.shardController.isWorkflowMoved(shardID, workflow)
+	s.ErrorIs(err, errShardSplitting)
+	select {
+	case <-s.shardController.shardSplitLoads:
+	default:
+		s.Fail("expected a request to load the shard split")
+	}
+
+	s.shardController.loadShardSplit(shardID, nil)
+	moved, err := s.shardController.isWorkflowMoved(shardID, workflow)
+	s.NoError(err)
+	s.False(moved)
+
+	s.False(s.shardController.beginWorkflowMove(shardID, workflow))
+	_, err = s.shardController.GetShardByNamespaceWorkflow(namespaceID, workflowID)
+	s.ErrorIs(err, errWorkflowMoving)
+
+	// a failed move routes the workflow to the shard again
+	s.shardController.endWorkflowMove(shardID, workflow, false)
+	moved, err = s.shardController.isWorkflowMoved(shardID, workflow)
+	s.NoError(err)
+	s.False(moved)
+
+	s.shardController.beginWorkflowMove(shardID, workflow)
+	s.shardController.endWorkflowMove(shardID, workflow, true)
+	moved, err = s.shardController.isWorkflowMoved(shardID, workflow)
+	s.NoError(err)
+	s.True(moved)
+	s.True(s.shardController.beginWorkflowMove(shardID, workflow))
+	s.shardController.endWorkflowMove(shardID, workflow, true)
+
+	s.shardController.endShardSplit(shardID)
+	s.False(s.shardController.isShardSplitLoaded(shardID))
+}
+
 func (s *controllerSuite) TestShardLingerTimeout() {
 	shardID := int32(1)
 	s.config.NumberOfShards = 1
+	s.config.ShardMapProvider = shardmap.NewProvider(s.config.NumberOfShards)
 	timeLimit := 1 * time.Second
 	s.config.ShardLingerTimeLimit = dynamicconfig.GetDurationPropertyFn(timeLimit)
 
@@ -752,6 +825,7 @@ func (s *controllerSuite) TestShardLingerTimeout() {
 func (s *controllerSuite) TestShardLingerSuccess() {
 	shardID := int32(1)
 	s.config.NumberOfShards = 1
+	s.config.ShardMapProvider = shardmap.NewProvider(s.config.NumberOfShards)
 	timeLimit := 1 * time.Second
 	s.config.ShardLingerTimeLimit = dynamicconfig.GetDurationPropertyFn(timeLimit)
 
@@ -830,6 +904,7 @@ func (s *controllerSuite) TestShardLingerSuccess() {
 func (s *controllerSuite) TestShardCounter() {
 	const totalShards = 5
 	s.config.NumberOfShards = totalShards
+	s.config.ShardMapProvider = shardmap.NewProvider(s.config.NumberOfShards)
 
 	var ownedShards atomic.Int32
 	s.mockServiceResolver.EXPECT().Lookup(gomock.Any()).DoAndReturn(func(key string) (membership.HostInfo, error) {
@@ -889,6 +964,7 @@ func (s *controllerSuite) setupMocksForReadiness() *readinessMockState {
 	state.ownership.Store(5, true)
 
 	s.config.NumberOfShards = 5
+	s.config.ShardMapProvider = shardmap.NewProvider(s.config.NumberOfShards)
 
 	s.mockServiceResolver.EXPECT().Lookup(gomock.Any()).DoAndReturn(func(key string) (membership.HostInfo, error) {
 		if i, err := strconv.Atoi(key); err != nil {
@@ -6,6 +6,7 @@ import (
 
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/membership"
 	"go.temporal.io/server/common/pingable"
 	"go.temporal.io/server/common/primitives"
 	"go.temporal.io/server/service/history/configs"
@@ -14,6 +15,7 @@ import (
 
 var Module = fx.Options(
 	fx.Provide(
+		ShardOwnersProvider,
 		ControllerProvider,
 		func(impl *ControllerImpl) Controller { return impl },
 		ContextFactoryProvider,
@@ -23,6 +25,36 @@ var Module = fx.Options(
 		),
 	),
 	ownershipBasedQuotaScalerModule,
+	shardRebalancerModule,
+	shardSplitMoverModule,
+)
+
+// ShardOwnersProvider returns the lookup of shard owners shared by the shard controller and the history handler.
+func ShardOwnersProvider(
+	config *configs.Config,
+	historyServiceResolver membership.ServiceResolver,
+) *membership.ShardOwners {
+	return membership.NewShardOwners(historyServiceResolver, config.ShardRebalancerEnabled, config.ShardMapProvider.RingShard)
+}
+
+var shardRebalancerModule = fx.Options(
+	fx.Provide(ShardRebalancerProvider),
+	fx.Invoke(func(
+		lc fx.Lifecycle,
+		rebalancer *ShardRebalancer,
+	) {
+		lc.Append(fx.StartStopHook(rebalancer.Start, rebalancer.Stop))
+	}),
+)
+
+var shardSplitMoverModule = fx.Options(
+	fx.Provide(ShardSplitMoverProvider),
+	fx.Invoke(func(
+		lc fx.Lifecycle,
+		mover *ShardSplitMover,
+	) {
+		lc.Append(fx.StartStopHook(mover.Start, mover.Stop))
+	}),
 )
 
 var ownershipBasedQuotaScalerModule = fx.Options(
@@ -32,7 +64,7 @@ var ownershipBasedQuotaScalerModule = fx.Options(
 	) (*OwnershipBasedQuotaScalerImpl, error) {
 		return NewOwnershipBasedQuotaScaler(
 			impl,
-			int(cfg.NumberOfShards),
+			func() int { return int(cfg.ShardMapProvider.TotalShardCount()) },
 			nil,
 		)
 	}),
@@ -0,0 +1,85 @@
+package shard
+
+import (
+	"sync/atomic"
+	"time"
+)
+
+const (
+	// taskBacklogPerRequest is the size of the task backlog which weighs as much as one request per second in the
+	// load score of a shard.
+	taskBacklogPerRequest = 100
+)
+
+type (
+	// ShardLoad is the load of a shard over a load report interval.
+	ShardLoad struct {
+		ShardID int32
+		// RequestsPerSecond is the rate of requests routed to the shard by the shard controller.
+		RequestsPerSecond float64
+		// PersistenceLatency is the average latency of the persistence requests of the shard.
+		PersistenceLatency time.Duration
+		// TaskBacklog is the number of task IDs between the ack level and the read level of the immediate queues
+		// of the shard, when the shard info metrics were last emitted.
+		TaskBacklog int64
+	}
+
+	// shardLoadCounters accumulates the load of a shard between two load reports. It's safe for concurrent use.
+	shardLoadCounters struct {
+		requests                atomic.Int64
+		persistenceRequests     atomic.Int64
+		persistenceLatencyNanos atomic.Int64
+		taskBacklog             atomic.Int64
+		lastReportTime          atomic.Int64
+	}
+
+	// loadReporter is implemented by the shard contexts which account their load.
+	loadReporter interface {
+		recordRequest()
+		reportLoad(now time.Time) ShardLoad
+	}
+)
+
+// Score returns the load of the shard as a single number, used to compare the load of shards and hosts.
+func (l ShardLoad) Score() float64 {
+	return l.RequestsPerSecond + float64(l.TaskBacklog)/taskBacklogPerRequest
+}
+
+func newShardLoadCounters(now time.Time) *shardLoadCounters {
+	c := &shardLoadCounters{}
+	c.lastReportTime.Store(now.UnixNano())
+	return c
+}
+
+func (c *shardLoadCounters) recordRequest() {
+	c.requests.Add(1)
+}
+
+func (c *shardLoadCounters) recordPersistenceRequest(latency time.Duration) {
+	c.persistenceRequests.Add(1)
+	c.persistenceLatencyNanos.Add(latency.Nanoseconds())
+}
+
+func (c *shardLoadCounters) setTaskBacklog(taskBacklog int64) {
+	c.taskBacklog.Store(taskBacklog)
+}
+
+// report returns the load since the previous report, and resets the counters.
+func (c *shardLoadCounters) report(shardID int32, now time.Time) ShardLoad {
+	interval := time.Duration(now.UnixNano() - c.lastReportTime.Swap(now.UnixNano()))
+	requests := c.requests.Swap(0)
+	persistenceRequests := c.persistenceRequests.Swap(0)
+	persistenceLatency := time.Duration(c.persistenceLatencyNanos.Swap(0))
+
+	load := ShardLoad{
+		ShardID:     shardID,
+		TaskBacklog: c.taskBacklog.Load(),
+	}
+	if interval > 0 {
+		load.RequestsPerSecond = float64(requests) / interval.Seconds()
+	}
+	if persistenceRequests > 0 {
+		load.PersistenceLatency = persistenceLatency / time.Duration(persistenceRequests)
+	}
+	return load
+}
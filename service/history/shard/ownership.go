@@ -4,7 +4,6 @@ import (
 	"context"
 	"time"
 
-	"go.temporal.io/server/common/convert"
 	"go.temporal.io/server/common/goro"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
@@ -34,6 +33,7 @@ type (
 		logger                 log.Logger
 		membershipUpdateCh     chan *membership.ChangedEvent
 		metricsHandler         metrics.Handler
+		shardOwners            *membership.ShardOwners
 	}
 )
 
@@ -41,6 +41,7 @@ func newOwnership(
 	config *configs.Config,
 	historyServiceResolver membership.ServiceResolver,
 	hostInfoProvider membership.HostInfoProvider,
+	shardOwners *membership.ShardOwners,
 	logger log.Logger,
 	metricsHandler metrics.Handler,
 ) *ownership {
@@ -54,10 +55,13 @@ func newOwnership(
 		logger:                 logger,
 		membershipUpdateCh:     make(chan *membership.ChangedEvent, 1),
 		metricsHandler:         metricsHandler,
+		shardOwners:            shardOwners,
 	}
 }
 
 func (o *ownership) start(controller *ControllerImpl) {
+	o.shardOwners.Refresh()
+
 	o.goros.Go(func(ctx context.Context) error {
 		o.eventLoop(ctx)
 		return nil
@@ -85,9 +89,11 @@ func (o *ownership) eventLoop(ctx context.Context) {
 		case <-ctx.Done():
 			return
 		case <-acquireTicker.C:
+			o.shardOwners.Refresh()
 			o.scheduleAcquire()
 		case changedEvent := <-o.membershipUpdateCh:
 			metrics.MembershipChangedCounter.With(o.metricsHandler).Record(1)
+			o.shardOwners.Refresh()
 
 			o.logger.Info("", tag.ValueRingMembershipChangedEvent,
 				tag.NumberProcessed(len(changedEvent.HostsAdded)),
@@ -133,7 +139,7 @@ func (o *ownership) stop() {
 // controller. If membership lists another host as the owner, it returns a
 // ShardOwnershipLost error with the correct owner.
 func (o *ownership) verifyOwnership(shardID int32) error {
-	ownerInfo, err := o.historyServiceResolver.Lookup(convert.Int32ToString(shardID))
+	ownerInfo, err := o.lookupOwner(shardID)
 	if err != nil {
 		return err
 	}
@@ -145,3 +151,9 @@ func (o *ownership) verifyOwnership(shardID int32) error {
 
 	return nil
 }
+
+// lookupOwner returns the host which took over the shard if any, otherwise the owner of the shard in the membership
+// ring.
+func (o *ownership) lookupOwner(shardID int32) (membership.HostInfo, error) {
+	return o.shardOwners.Lookup(shardID)
+}
@@ -17,7 +17,7 @@ type (
 	// can be obtained from the fx Module within this package.
 	OwnershipBasedQuotaScalerImpl struct {
 		shardCounter          ShardCounter
-		totalNumShards        int
+		totalNumShards        func() int
 		updateAppliedCallback chan struct{}
 
 		shardCount   atomic.Int64
@@ -55,15 +55,15 @@ var (
 
 // NewOwnershipBasedQuotaScaler returns an OwnershipBasedQuotaScaler. The updateAppliedCallback field is a channel which
 // is sent to in a blocking fashion when the shard count updates are applied. This is useful for testing. In production,
-// you should pass in nil, which will cause the callback to be ignored. If totalNumShards is non-positive, then an error
-// is returned.
+// you should pass in nil, which will cause the callback to be ignored. The total number of shards is read on every call
+// to ScaleFactor because it grows when shards are split. If it's initially non-positive, then an error is returned.
 func NewOwnershipBasedQuotaScaler(
 	shardCounter ShardCounter,
-	totalNumShards int,
+	totalNumShards func() int,
 	updateAppliedCallback chan struct{},
 ) (*OwnershipBasedQuotaScalerImpl, error) {
-	if totalNumShards <= 0 {
-		return nil, fmt.Errorf("%w: %d", ErrNonPositiveTotalNumShards, totalNumShards)
+	if n := totalNumShards(); n <= 0 {
+		return nil, fmt.Errorf("%w: %d", ErrNonPositiveTotalNumShards, n)
 	}
 
 	scaler := &OwnershipBasedQuotaScalerImpl{
@@ -95,7 +95,7 @@ func (s *OwnershipBasedQuotaScalerImpl) ScaleFactor() (float64, bool) {
 		return 0, false
 	}
 
-	return float64(shardCount) / float64(s.totalNumShards), true
+	return float64(shardCount) / float64(s.totalNumShards()), true
 }
 
 func (s *OwnershipBasedQuotaScalerImpl) Close() {
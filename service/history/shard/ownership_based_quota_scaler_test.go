@@ -35,7 +35,7 @@ func TestOwnershipBasedQuotaScaler_NonPositiveTotalNumShards(t *testing.T) {
 		closed: false,
 	}
 	totalNumShards := 0
-	_, err := shard.NewOwnershipBasedQuotaScaler(sco, totalNumShards, nil)
+	_, err := shard.NewOwnershipBasedQuotaScaler(sco, func() int { return totalNumShards }, nil)
 	assert.ErrorIs(t, err, shard.ErrNonPositiveTotalNumShards)
 }
 
@@ -48,7 +48,7 @@ func TestOwnershipBasedQuotaScaler(t *testing.T) {
 	}
 	totalNumShards := 10
 	updateAppliedCallback := make(chan struct{})
-	scaler, err := shard.NewOwnershipBasedQuotaScaler(sc, totalNumShards, updateAppliedCallback)
+	scaler, err := shard.NewOwnershipBasedQuotaScaler(sc, func() int { return totalNumShards }, updateAppliedCallback)
 	require.NoError(t, err)
 	_, ok := scaler.ScaleFactor()
 	assert.False(t, ok, "ScaleFactor should return false before any shard count updates")
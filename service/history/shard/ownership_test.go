@@ -11,6 +11,7 @@ import (
 	"go.temporal.io/server/common/primitives"
 	"go.temporal.io/server/common/resourcetest"
 	serviceerrors "go.temporal.io/server/common/serviceerror"
+	"go.temporal.io/server/common/shardmap"
 	"go.temporal.io/server/service/history/configs"
 	historyi "go.temporal.io/server/service/history/interfaces"
 	"go.temporal.io/server/service/history/tests"
@@ -51,6 +52,7 @@ func (s *ownershipSuite) newController(contextFactory ContextFactory) *Controlle
 		s.config,
 		s.resource.GetLogger(),
 		s.resource.GetHistoryServiceResolver(),
+		ShardOwnersProvider(s.config, s.resource.GetHistoryServiceResolver()),
 		s.resource.GetMetricsHandler(),
 		s.resource.GetHostInfoProvider(),
 		contextFactory,
@@ -59,6 +61,7 @@ func (s *ownershipSuite) newController(contextFactory ContextFactory) *Controlle
 
 func (s *ownershipSuite) TestAcquireViaMembershipUpdate() {
 	s.config.NumberOfShards = 1
+	s.config.ShardMapProvider = shardmap.NewProvider(s.config.NumberOfShards)
 	shardID := int32(1)
 
 	shard := historyi.NewMockControllableContext(s.controller)
@@ -102,6 +105,7 @@ func (s *ownershipSuite) TestAcquireViaMembershipUpdate() {
 
 func (s *ownershipSuite) TestAcquireOnDemand() {
 	s.config.NumberOfShards = 1
+	s.config.ShardMapProvider = shardmap.NewProvider(s.config.NumberOfShards)
 	shardID := int32(1)
 
 	shard := historyi.NewMockControllableContext(s.controller)
@@ -132,6 +136,7 @@ func (s *ownershipSuite) TestAcquireOnDemand() {
 
 func (s *ownershipSuite) TestAcquireViaTicker() {
 	s.config.NumberOfShards = 1
+	s.config.ShardMapProvider = shardmap.NewProvider(s.config.NumberOfShards)
 	s.config.AcquireShardInterval = func() time.Duration {
 		return 100 * time.Millisecond
 	}
@@ -172,6 +177,7 @@ func (s *ownershipSuite) TestAcquireViaTicker() {
 
 func (s *ownershipSuite) TestAttemptAcquireUnowned() {
 	s.config.NumberOfShards = 1
+	s.config.ShardMapProvider = shardmap.NewProvider(s.config.NumberOfShards)
 	shardID := int32(1)
 
 	otherHost := "otherHost"
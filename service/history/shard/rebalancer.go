@@ -0,0 +1,349 @@
+package shard
+
+import (
+	"context"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/convert"
+	"go.temporal.io/server/common/goro"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/membership"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/service/history/configs"
+)
+
+const (
+	// shardLoadLabelKey is the membership label of the load score of a host.
+	shardLoadLabelKey = "shardLoad"
+	// hotShardLabelKey is the membership label of the shard with the highest load score among the shards a host
+	// owns in the membership ring, as "<shard ID>:<load score>".
+	hotShardLabelKey = "hotShard"
+
+	// maxTakenOverShards bounds the number of shards a host can take over, so that its label fits in the size
+	// limit of membership labels.
+	maxTakenOverShards = 16
+	// loadLabelChangeThreshold is the relative change of the load of a host below which its label isn't updated:
+	// each label update is a membership change which makes all the hosts check the ownership of their shards.
+	loadLabelChangeThreshold = 0.1
+)
+
+type (
+	// ShardRebalancer measures and publishes the load of the shards owned by this host. When enabled, it moves
+	// shards from overloaded hosts to idle hosts on top of the membership ring: an idle host takes over the hottest
+	// shard of an overloaded host by listing it in its membership label, and the ownership of all hosts follows the
+	// label. The shard is then handed over like on a membership change, with lingering and shard ownership
+	// assertion. A host must be idle or overloaded for several load report intervals, and keeps a shard for a
+	// minimum time before releasing it, so that shards don't flap between hosts.
+	ShardRebalancer struct {
+		status int32
+
+		config           *configs.Config
+		controller       *ControllerImpl
+		monitor          membership.Monitor
+		resolver         membership.ServiceResolver
+		hostInfoProvider membership.HostInfoProvider
+		logger           log.Logger
+		metricsHandler   metrics.Handler
+		goros            goro.Group
+
+		// fields below are only accessed by the rebalance loop
+		labels              map[string]string
+		publishedLoad       float64
+		idleIntervals       int
+		overloadedIntervals int
+		// takenOverShards are the shards taken over by this host, with the time they were taken over
+		takenOverShards map[int32]time.Time
+	}
+
+	hostLoad struct {
+		identity     string
+		load         float64
+		hotShardID   int32
+		hotShardLoad float64
+	}
+)
+
+func ShardRebalancerProvider(
+	config *configs.Config,
+	controller *ControllerImpl,
+	monitor membership.Monitor,
+	historyServiceResolver membership.ServiceResolver,
+	hostInfoProvider membership.HostInfoProvider,
+	logger log.Logger,
+	metricsHandler metrics.Handler,
+) *ShardRebalancer {
+	return &ShardRebalancer{
+		config:           config,
+		controller:       controller,
+		monitor:          monitor,
+		resolver:         historyServiceResolver,
+		hostInfoProvider: hostInfoProvider,
+		logger:           log.With(logger, tag.ComponentShardController),
+		metricsHandler:   metricsHandler.WithTags(metrics.OperationTag(metrics.HistoryShardControllerScope)),
+		labels:           make(map[string]string),
+		takenOverShards:  make(map[int32]time.Time),
+	}
+}
+
+func (r *ShardRebalancer) Start() {
+	if !atomic.CompareAndSwapInt32(&r.status, common.DaemonStatusInitialized, common.DaemonStatusStarted) {
+		return
+	}
+	r.goros.Go(func(ctx context.Context) error {
+		r.rebalanceLoop(ctx)
+		return nil
+	})
+}
+
+func (r *ShardRebalancer) Stop() {
+	if !atomic.CompareAndSwapInt32(&r.status, common.DaemonStatusStarted, common.DaemonStatusStopped) {
+		return
+	}
+	r.goros.Cancel()
+	r.goros.Wait()
+}
+
+func (r *ShardRebalancer) rebalanceLoop(ctx context.Context) {
+	timer := time.NewTimer(r.config.ShardLoadReportInterval())
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-timer.C:
+			r.rebalance(time.Now())
+			timer.Reset(r.config.ShardLoadReportInterval())
+		}
+	}
+}
+
+func (r *ShardRebalancer) rebalance(now time.Time) {
+	self := r.reportLoad(now)
+
+	if !r.config.ShardRebalancerEnabled() {
+		r.takenOverShards = make(map[int32]time.Time)
+		r.setLabel(membership.TakenOverShardsLabelKey, "")
+		r.setLabel(shardLoadLabelKey, "")
+		r.setLabel(hotShardLabelKey, "")
+		return
+	}
+
+	r.publishLoad(self)
+	r.dropLostShards()
+
+	var hosts []hostLoad
+	for _, hostInfo := range r.resolver.AvailableMembers() {
+		if hostInfo.Identity() == self.identity {
+			continue
+		}
+		if load, ok := getHostLoad(hostInfo); ok {
+			hosts = append(hosts, load)
+		}
+	}
+
+	takeOverShardID, releaseShardID := r.decide(self, hosts, now)
+	if releaseShardID != 0 {
+		delete(r.takenOverShards, releaseShardID)
+		metrics.ShardRebalancerReleases.With(r.metricsHandler).Record(1)
+		r.logger.Info("Releasing shard taken over from an overloaded host.",
+			tag.ShardID(releaseShardID),
+			tag.NewFloat64("host-load", self.load),
+		)
+	}
+	if takeOverShardID != 0 {
+		r.takenOverShards[takeOverShardID] = now
+		metrics.ShardRebalancerTakeOvers.With(r.metricsHandler).Record(1)
+		r.logger.Info("Taking over the hottest shard of an overloaded host.",
+			tag.ShardID(takeOverShardID),
+			tag.NewFloat64("host-load", self.load),
+		)
+	}
+	r.setLabel(membership.TakenOverShardsLabelKey, membership.EncodeTakenOverShards(r.sortedTakenOverShards()))
+}
+
+// reportLoad emits the metrics of the load of the shards owned by this host, and returns the load of this host.
+func (r *ShardRebalancer) reportLoad(now time.Time) hostLoad {
+	self := hostLoad{
+		identity: r.hostInfoProvider.HostInfo().Identity(),
+	}
+	for _, load := range r.controller.reportShardLoads(now) {
+		metrics.ShardLoadRequestsPerSecond.With(r.metricsHandler).Record(int64(math.Round(load.RequestsPerSecond)))
+		metrics.ShardLoadPersistenceLatency.With(r.metricsHandler).Record(load.PersistenceLatency)
+		metrics.ShardLoadTaskBacklog.With(r.metricsHandler).Record(load.TaskBacklog)
+
+		score := load.Score()
+		self.load += score
+		if _, ok := r.takenOverShards[load.ShardID]; ok {
+			// Shards taken over from another host are not moved again to a third host.
+			continue
+		}
+		if score > self.hotShardLoad {
+			self.hotShardID = load.ShardID
+			self.hotShardLoad = score
+		}
+	}
+	metrics.HostShardLoadGauge.With(r.metricsHandler).Record(self.load)
+	return self
+}
+
+// publishLoad updates the load labels of this host when the load changed significantly.
+func (r *ShardRebalancer) publishLoad(self hostLoad) {
+	if math.Abs(self.load-r.publishedLoad) <= r.publishedLoad*loadLabelChangeThreshold && r.labels[shardLoadLabelKey] != "" {
+		return
+	}
+	r.publishedLoad = self.load
+	r.setLabel(shardLoadLabelKey, strconv.FormatInt(int64(math.Round(self.load)), 10))
+	hotShard := ""
+	if self.hotShardID != 0 {
+		hotShard = strconv.FormatInt(int64(self.hotShardID), 10) + ":" + strconv.FormatInt(int64(math.Round(self.hotShardLoad)), 10)
+	}
+	r.setLabel(hotShardLabelKey, hotShard)
+}
+
+// dropLostShards forgets the shards taken over by this host which are owned by another host, because another host
+// took over the same shard concurrently or because the membership ring now assigns the shard to this host.
+func (r *ShardRebalancer) dropLostShards() {
+	selfIdentity := r.hostInfoProvider.HostInfo().Identity()
+	takenOverShards := r.controller.ownership.shardOwners.TakenOverShards()
+	for shardID := range r.takenOverShards {
+		ringOwner, err := r.resolver.Lookup(convert.Int32ToString(shardID))
+		if err == nil && ringOwner.Identity() == selfIdentity {
+			delete(r.takenOverShards, shardID)
+			continue
+		}
+		if owner, ok := takenOverShards[shardID]; ok && owner.Identity() != selfIdentity {
+			delete(r.takenOverShards, shardID)
+		}
+	}
+}
+
+// decide returns the shard to take over from an overloaded host and the shard to release, 0 for none. A host takes
+// over a shard once it was idle for the sustained number of intervals and releases a shard once it was overloaded for
+// the sustained number of intervals.
+func (r *ShardRebalancer) decide(self hostLoad, hosts []hostLoad, now time.Time) (takeOverShardID int32, releaseShardID int32) {
+	totalLoad := self.load
+	for _, host := range hosts {
+		totalLoad += host.load
+	}
+	averageLoad := totalLoad / float64(len(hosts)+1)
+	if averageLoad <= 0 {
+		r.idleIntervals = 0
+		r.overloadedIntervals = 0
+		return 0, 0
+	}
+	threshold := r.config.ShardRebalancerLoadThreshold()
+	sustainedIntervals := r.config.ShardRebalancerSustainedIntervals()
+
+	if self.load > averageLoad*(1+threshold) {
+		r.overloadedIntervals++
+	} else {
+		r.overloadedIntervals = 0
+	}
+	if self.load < averageLoad*(1-threshold) {
+		r.idleIntervals++
+	} else {
+		r.idleIntervals = 0
+	}
+
+	maxTakenOver := min(r.config.ShardRebalancerMaxTakenOverShards(), maxTakenOverShards)
+	if r.overloadedIntervals >= sustainedIntervals || len(r.takenOverShards) > maxTakenOver {
+		// Release the shard held for the longest time, once held for the minimum time.
+		var oldestTakeOverTime time.Time
+		for shardID, takeOverTime := range r.takenOverShards {
+			if now.Sub(takeOverTime) < r.config.ShardRebalancerMinHoldTime() {
+				continue
+			}
+			if releaseShardID == 0 || takeOverTime.Before(oldestTakeOverTime) {
+				releaseShardID = shardID
+				oldestTakeOverTime = takeOverTime
+			}
+		}
+		if releaseShardID != 0 {
+			r.overloadedIntervals = 0
+		}
+		return 0, releaseShardID
+	}
+
+	if r.idleIntervals < sustainedIntervals || len(r.takenOverShards) >= maxTakenOver {
+		return 0, 0
+	}
+	var source *hostLoad
+	for i, host := range hosts {
+		if host.load <= averageLoad*(1+threshold) || host.hotShardID == 0 {
+			continue
+		}
+		if _, ok := r.takenOverShards[host.hotShardID]; ok {
+			continue
+		}
+		// Moving the shard must not make this host more loaded than the source host.
+		if self.load+host.hotShardLoad >= host.load-host.hotShardLoad {
+			continue
+		}
+		if source == nil || host.load > source.load {
+			source = &hosts[i]
+		}
+	}
+	if source == nil {
+		return 0, 0
+	}
+	r.idleIntervals = 0
+	return source.hotShardID, 0
+}
+
+func (r *ShardRebalancer) sortedTakenOverShards() []int32 {
+	shardIDs := make([]int32, 0, len(r.takenOverShards))
+	for shardID := range r.takenOverShards {
+		shardIDs = append(shardIDs, shardID)
+	}
+	slices.Sort(shardIDs)
+	return shardIDs
+}
+
+func (r *ShardRebalancer) setLabel(key string, value string) {
+	if r.labels[key] == value {
+		return
+	}
+	if err := r.monitor.SetLabel(key, value); err != nil {
+		r.logger.Warn("Unable to set membership label.", tag.Key(key), tag.Error(err))
+		return
+	}
+	r.labels[key] = value
+}
+
+// getHostLoad returns the load of a host from its membership labels.
+func getHostLoad(hostInfo membership.HostInfo) (hostLoad, bool) {
+	labeledHostInfo, ok := hostInfo.(membership.LabeledHostInfo)
+	if !ok {
+		return hostLoad{}, false
+	}
+	loadLabel, ok := labeledHostInfo.Label(shardLoadLabelKey)
+	if !ok {
+		return hostLoad{}, false
+	}
+	load, err := strconv.ParseFloat(loadLabel, 64)
+	if err != nil {
+		return hostLoad{}, false
+	}
+	host := hostLoad{
+		identity: hostInfo.Identity(),
+		load:     load,
+	}
+	if hotShardLabel, ok := labeledHostInfo.Label(hotShardLabelKey); ok {
+		shardIDField, loadField, found := strings.Cut(hotShardLabel, ":")
+		shardID, shardIDErr := strconv.ParseInt(shardIDField, 10, 32)
+		shardLoad, loadErr := strconv.ParseFloat(loadField, 64)
+		if found && shardIDErr == nil && loadErr == nil && shardID > 0 {
+			host.hotShardID = int32(shardID)
+			host.hotShardLoad = shardLoad
+		}
+	}
+	return host, true
+}
@@ -0,0 +1,192 @@
+package shard
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"go.temporal.io/server/common/membership"
+	"go.temporal.io/server/service/history/configs"
+	"go.temporal.io/server/service/history/tests"
+)
+
+type (
+	rebalancerSuite struct {
+		suite.Suite
+		*require.Assertions
+
+		config     *configs.Config
+		rebalancer *ShardRebalancer
+	}
+
+	labeledHost struct {
+		identity string
+		labels   map[string]string
+	}
+)
+
+func (h labeledHost) Identity() string {
+	return h.identity
+}
+
+func (h labeledHost) GetAddress() string {
+	return h.identity
+}
+
+func (h labeledHost) Label(key string) (string, bool) {
+	value, ok := h.labels[key]
+	return value, ok
+}
+
+func TestRebalancerSuite(t *testing.T) {
+	s := new(rebalancerSuite)
+	suite.Run(t, s)
+}
+
+func (s *rebalancerSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.config = tests.NewDynamicConfig()
+	s.config.ShardRebalancerLoadThreshold = func() float64 { return 0.25 }
+	s.config.ShardRebalancerSustainedIntervals = func() int { return 2 }
+	s.config.ShardRebalancerMinHoldTime = func() time.Duration { return 10 * time.Minute }
+	s.config.ShardRebalancerMaxTakenOverShards = func() int { return 2 }
+	s.rebalancer = &ShardRebalancer{
+		config:          s.config,
+		labels:          make(map[string]string),
+		takenOverShards: make(map[int32]time.Time),
+	}
+}
+
+func (s *rebalancerSuite) TestDecide_TakeOverAfterSustainedIdle() {
+	now := time.Now()
+	self := hostLoad{identity: "idle", load: 10}
+	hosts := []hostLoad{
+		{identity: "busy", load: 250, hotShardID: 7, hotShardLoad: 50},
+		{identity: "busier", load: 300, hotShardID: 9, hotShardLoad: 80},
+		{identity: "average", load: 150, hotShardID: 3, hotShardLoad: 20},
+	}
+
+	takeOver, release := s.rebalancer.decide(self, hosts, now)
+	s.Zero(takeOver)
+	s.Zero(release)
+
+	// The shard of the most loaded host is taken over once the host is idle for the sustained intervals.
+	takeOver, release = s.rebalancer.decide(self, hosts, now)
+	s.Equal(int32(9), takeOver)
+	s.Zero(release)
+
+	// The idle intervals are counted again before taking over another shard.
+	s.rebalancer.takenOverShards[9] = now
+	takeOver, _ = s.rebalancer.decide(self, hosts, now)
+	s.Zero(takeOver)
+	takeOver, _ = s.rebalancer.decide(self, hosts, now)
+	s.Equal(int32(7), takeOver)
+
+	// No more than the max number of shards are taken over.
+	s.rebalancer.takenOverShards[7] = now
+	for range 3 {
+		takeOver, _ = s.rebalancer.decide(self, hosts, now)
+		s.Zero(takeOver)
+	}
+}
+
+func (s *rebalancerSuite) TestDecide_NoTakeOverWhenMoveDoesNotHelp() {
+	now := time.Now()
+	self := hostLoad{identity: "idle", load: 60}
+	// Moving the only shard of the overloaded host would just move the overload.
+	hosts := []hostLoad{
+		{identity: "busy", load: 200, hotShardID: 7, hotShardLoad: 200},
+		{identity: "average", load: 100, hotShardID: 3, hotShardLoad: 20},
+	}
+	for range 3 {
+		takeOver, release := s.rebalancer.decide(self, hosts, now)
+		s.Zero(takeOver)
+		s.Zero(release)
+	}
+}
+
+func (s *rebalancerSuite) TestDecide_ReleaseAfterSustainedOverloadAndHoldTime() {
+	now := time.Now()
+	s.rebalancer.takenOverShards[5] = now.Add(-time.Minute)
+	s.rebalancer.takenOverShards[6] = now.Add(-time.Hour)
+	self := hostLoad{identity: "overloaded", load: 300}
+	hosts := []hostLoad{
+		{identity: "other", load: 100},
+		{identity: "another", load: 100},
+	}
+
+	_, release := s.rebalancer.decide(self, hosts, now)
+	s.Zero(release)
+	_, release = s.rebalancer.decide(self, hosts, now)
+	s.Equal(int32(6), release)
+
+	// The other shard is held for less than the min hold time.
+	delete(s.rebalancer.takenOverShards, 6)
+	for range 3 {
+		_, release = s.rebalancer.decide(self, hosts, now)
+		s.Zero(release)
+	}
+	_, release = s.rebalancer.decide(self, hosts, now.Add(10*time.Minute))
+	s.Equal(int32(5), release)
+}
+
+func (s *rebalancerSuite) TestGetHostLoad() {
+	load, ok := getHostLoad(labeledHost{
+		identity: "host",
+		labels: map[string]string{
+			shardLoadLabelKey: "120",
+			hotShardLabelKey:  "17:45",
+		},
+	})
+	s.True(ok)
+	s.Equal(hostLoad{identity: "host", load: 120, hotShardID: 17, hotShardLoad: 45}, load)
+
+	load, ok = getHostLoad(labeledHost{
+		identity: "host",
+		labels: map[string]string{
+			shardLoadLabelKey: "120",
+			hotShardLabelKey:  "invalid",
+		},
+	})
+	s.True(ok)
+	s.Equal(hostLoad{identity: "host", load: 120}, load)
+
+	_, ok = getHostLoad(labeledHost{identity: "host"})
+	s.False(ok)
+	_, ok = getHostLoad(membership.NewHostInfoFromAddress("host"))
+	s.False(ok)
+}
+
+func (s *rebalancerSuite) TestTakenOverShardsLabel() {
+	// The label of the max number of shards fits in the size limit of membership labels.
+	shardIDs := make([]int32, maxTakenOverShards)
+	for i := range shardIDs {
+		shardIDs[i] = 1<<20 + int32(i)
+	}
+	s.LessOrEqual(len(membership.EncodeTakenOverShards(shardIDs)), 128)
+}
+
+func TestShardLoadCounters(t *testing.T) {
+	now := time.Now()
+	counters := newShardLoadCounters(now)
+	for range 20 {
+		counters.recordRequest()
+	}
+	counters.recordPersistenceRequest(10 * time.Millisecond)
+	counters.recordPersistenceRequest(30 * time.Millisecond)
+	counters.setTaskBacklog(500)
+
+	load := counters.report(3, now.Add(10*time.Second))
+	require.Equal(t, ShardLoad{
+		ShardID:            3,
+		RequestsPerSecond:  2,
+		PersistenceLatency: 20 * time.Millisecond,
+		TaskBacklog:        500,
+	}, load)
+	require.Equal(t, float64(7), load.Score())
+
+	// Counters are reset by the report, except the backlog which is a level.
+	load = counters.report(3, now.Add(20*time.Second))
+	require.Equal(t, ShardLoad{ShardID: 3, TaskBacklog: 500}, load)
+}
@@ -0,0 +1,387 @@
+package shard
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"time"
+
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/backoff"
+	"go.temporal.io/server/common/definition"
+	"go.temporal.io/server/common/goro"
+	"go.temporal.io/server/common/headers"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/shardmap"
+	"go.temporal.io/server/service/history/configs"
+	historyi "go.temporal.io/server/service/history/interfaces"
+)
+
+const (
+	splitMoverListPageSize = 100
+)
+
+var (
+	completeShardSplitRetryPolicy = backoff.NewExponentialRetryPolicy(100 * time.Millisecond).
+		WithMaximumInterval(5 * time.Second).
+		WithMaximumAttempts(10)
+)
+
+type (
+	// ShardSplitMover splits the history shards owned by this host. It starts a split when the target shard count of
+	// the dynamic config is a multiple of the shard count, then moves the executions of each shard it owns to the
+	// child shards they are routed to after the split, and completes the split of the shard in the shard map. The
+	// child shards of a shard are owned by the same host until the split is finished, so the executions are moved
+	// between shards of this host. The runs of a workflow are moved together, the requests for the workflow are
+	// rejected while its runs are moved, and are routed to its child shard once they are moved.
+	ShardSplitMover struct {
+		status int32
+
+		config                 *configs.Config
+		controller             *ControllerImpl
+		clusterMetadataManager persistence.ClusterMetadataManager
+		logger                 log.Logger
+		goros                  goro.Group
+	}
+)
+
+func ShardSplitMoverProvider(
+	config *configs.Config,
+	controller *ControllerImpl,
+	clusterMetadataManager persistence.ClusterMetadataManager,
+	logger log.Logger,
+) *ShardSplitMover {
+	return &ShardSplitMover{
+		config:                 config,
+		controller:             controller,
+		clusterMetadataManager: clusterMetadataManager,
+		logger:                 log.With(logger, tag.ComponentShardController),
+	}
+}
+
+func (m *ShardSplitMover) Start() {
+	if !atomic.CompareAndSwapInt32(&m.status, common.DaemonStatusInitialized, common.DaemonStatusStarted) {
+		return
+	}
+	m.goros.Go(func(ctx context.Context) error {
+		m.splitLoop(headers.SetCallerInfo(ctx, headers.SystemBackgroundCallerInfo))
+		return nil
+	})
+}
+
+func (m *ShardSplitMover) Stop() {
+	if !atomic.CompareAndSwapInt32(&m.status, common.DaemonStatusStarted, common.DaemonStatusStopped) {
+		return
+	}
+	m.goros.Cancel()
+	m.goros.Wait()
+}
+
+func (m *ShardSplitMover) splitLoop(ctx context.Context) {
+	timer := time.NewTimer(m.config.ShardSplitInterval())
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-timer.C:
+			m.split(ctx)
+			timer.Reset(m.config.ShardSplitInterval())
+		case <-m.controller.shardSplitLoads:
+			m.loadShardSplits(ctx, m.config.ShardMapProvider.ShardMap())
+		}
+	}
+}
+
+func (m *ShardSplitMover) split(ctx context.Context) {
+	shardMap, err := m.config.ShardMapProvider.Refresh(ctx, m.clusterMetadataManager)
+	if err != nil {
+		m.logger.Warn("Unable to load shard map.", tag.Error(err))
+		return
+	}
+	if !shardMap.IsSplitting() {
+		if shardMap, err = m.startSplit(ctx, shardMap); err != nil {
+			m.logger.Warn("Unable to start shard split.", tag.Error(err))
+			return
+		}
+		if !shardMap.IsSplitting() {
+			return
+		}
+	}
+
+	m.loadShardSplits(ctx, shardMap)
+	for _, shardID := range m.ownedShardsToSplit(shardMap) {
+		if ctx.Err() != nil {
+			return
+		}
+		if !m.controller.isShardSplitLoaded(shardID) {
+			continue
+		}
+		if err := m.splitShard(ctx, shardMap, shardID); err != nil {
+			m.logger.Warn("Unable to split shard, will retry.", tag.ShardID(shardID), tag.Error(err))
+		}
+	}
+}
+
+// ownedShardsToSplit returns the shards owned by this host whose split isn't completed.
+func (m *ShardSplitMover) ownedShardsToSplit(shardMap *shardmap.ShardMap) []int32 {
+	if !shardMap.IsSplitting() {
+		return nil
+	}
+	var shardIDs []int32
+	for shardID := int32(1); shardID <= shardMap.ShardCount; shardID++ {
+		if m.config.ShardMapProvider.ShardMap().IsShardSplit(shardID) {
+			continue
+		}
+		if m.controller.ownership.verifyOwnership(shardID) != nil {
+			continue
+		}
+		shardIDs = append(shardIDs, shardID)
+	}
+	return shardIDs
+}
+
+// loadShardSplits loads the workflows already moved from the shards owned by this host, so that the requests for the
+// workflows of the shards are routed to the shard which has their executions.
+func (m *ShardSplitMover) loadShardSplits(ctx context.Context, shardMap *shardmap.ShardMap) {
+	for _, shardID := range m.ownedShardsToSplit(shardMap) {
+		if ctx.Err() != nil {
+			return
+		}
+		if m.controller.isShardSplitLoaded(shardID) {
+			continue
+		}
+		if err := m.loadShardSplit(ctx, shardMap, shardID); err != nil {
+			m.logger.Warn("Unable to load shard split, will retry.", tag.ShardID(shardID), tag.Error(err))
+		}
+	}
+}
+
+// loadShardSplit lists the workflows of the child shards of the shard, which were moved by a previous split attempt.
+func (m *ShardSplitMover) loadShardSplit(ctx context.Context, shardMap *shardmap.ShardMap, shardID int32) error {
+	var movedWorkflows []workflowIdentifier
+	// The first child shard is the shard itself.
+	for _, childShardID := range shardMap.ChildShards(shardID)[1:] {
+		childShardContext, err := m.controller.GetShardByID(childShardID)
+		if err != nil {
+			return err
+		}
+		var pageToken []byte
+		for {
+			resp, err := childShardContext.GetExecutionManager().ListConcreteExecutions(ctx, &persistence.ListConcreteExecutionsRequest{
+				ShardID:   childShardID,
+				PageSize:  splitMoverListPageSize,
+				PageToken: pageToken,
+			})
+			if err != nil {
+				return err
+			}
+			for _, state := range resp.States {
+				movedWorkflows = append(movedWorkflows, workflowIdentifier{
+					namespaceID: state.GetExecutionInfo().GetNamespaceId(),
+					workflowID:  state.GetExecutionInfo().GetWorkflowId(),
+				})
+			}
+			pageToken = resp.PageToken
+			if len(pageToken) == 0 {
+				break
+			}
+		}
+	}
+	m.controller.loadShardSplit(shardID, movedWorkflows)
+	return nil
+}
+
+// startSplit starts the split into the target shard count of the dynamic config, and returns the new shard map. The
+// shard map is returned unchanged if there is no split to start.
+func (m *ShardSplitMover) startSplit(ctx context.Context, shardMap *shardmap.ShardMap) (*shardmap.ShardMap, error) {
+	targetShardCount := int32(m.config.ShardSplitTargetShardCount())
+	if targetShardCount <= shardMap.ShardCount {
+		return shardMap, nil
+	}
+	if targetShardCount%shardMap.ShardCount != 0 {
+		m.logger.Warn("Ignoring shard split target shard count which isn't a multiple of the shard count.",
+			tag.NewInt32("target-shard-count", targetShardCount),
+			tag.NewInt32("shard-count", shardMap.ShardCount),
+		)
+		return shardMap, nil
+	}
+
+	// Hosts start the split concurrently, only one of them saves the shard map.
+	shardMap, recordVersion, err := shardmap.Load(ctx, m.clusterMetadataManager)
+	if err != nil {
+		return nil, err
+	}
+	if shardMap.IsSplitting() || targetShardCount <= shardMap.ShardCount {
+		return m.config.ShardMapProvider.Update(shardMap), nil
+	}
+	newShardMap, err := shardMap.StartSplit(targetShardCount / shardMap.ShardCount)
+	if err != nil {
+		return nil, err
+	}
+	if err := shardmap.Save(ctx, m.clusterMetadataManager, newShardMap, recordVersion); err != nil {
+		return nil, err
+	}
+	m.logger.Info("Started shard split.",
+		tag.NewInt32("shard-count", newShardMap.ShardCount),
+		tag.NewInt32("target-shard-count", newShardMap.TotalShardCount()),
+	)
+	newShardMap = m.config.ShardMapProvider.Update(newShardMap)
+	// acquire the child shards without waiting for the next acquire interval
+	m.controller.ownership.scheduleAcquire()
+	return newShardMap, nil
+}
+
+// splitShard moves the executions of the shard to its child shards, and completes the split of the shard.
+func (m *ShardSplitMover) splitShard(ctx context.Context, shardMap *shardmap.ShardMap, shardID int32) error {
+	shardContext, err := m.controller.GetShardByID(shardID)
+	if err != nil {
+		return err
+	}
+	engine, err := shardContext.GetEngine(ctx)
+	if err != nil {
+		return err
+	}
+
+	// Runs started by requests which got the shard before their workflow was moved are moved by the next pass.
+	for {
+		moved, err := m.moveExecutions(ctx, shardMap, shardContext, engine)
+		if err != nil {
+			return err
+		}
+		if moved == 0 {
+			break
+		}
+		m.logger.Info("Moved executions to child shards.", tag.ShardID(shardID), tag.Counter(moved))
+	}
+
+	return backoff.ThrottleRetryContext(
+		ctx,
+		func(ctx context.Context) error {
+			shardMap, recordVersion, err := shardmap.Load(ctx, m.clusterMetadataManager)
+			if err != nil {
+				return err
+			}
+			if !shardMap.IsSplitting() || shardMap.IsShardSplit(shardID) {
+				m.config.ShardMapProvider.Update(shardMap)
+				m.controller.endShardSplit(shardID)
+				return nil
+			}
+			newShardMap, err := shardMap.CompleteShardSplit(shardID)
+			if err != nil {
+				return err
+			}
+			if err := shardmap.Save(ctx, m.clusterMetadataManager, newShardMap, recordVersion); err != nil {
+				return err
+			}
+			m.logger.Info("Completed shard split.", tag.ShardID(shardID))
+			m.config.ShardMapProvider.Update(newShardMap)
+			m.controller.endShardSplit(shardID)
+			return nil
+		},
+		completeShardSplitRetryPolicy,
+		func(err error) bool { return errors.Is(err, shardmap.ErrConcurrentUpdate) },
+	)
+}
+
+// moveExecutions moves the executions of the shard which are routed to another child shard after the split, and
+// returns the number of moved executions. Executions are listed in the order of their primary key, so the runs of a
+// workflow are listed together, and they're moved together, possibly across pages.
+func (m *ShardSplitMover) moveExecutions(
+	ctx context.Context,
+	shardMap *shardmap.ShardMap,
+	shardContext historyi.ShardContext,
+	engine historyi.Engine,
+) (int, error) {
+	shardID := shardContext.GetShardID()
+	moved := 0
+	var runs []definition.WorkflowKey
+	moveRuns := func() error {
+		if len(runs) == 0 {
+			return nil
+		}
+		err := m.moveWorkflow(ctx, shardMap, shardContext, engine, runs)
+		if err == nil {
+			moved += len(runs)
+		}
+		runs = runs[:0]
+		return err
+	}
+
+	var pageToken []byte
+	for {
+		resp, err := shardContext.GetExecutionManager().ListConcreteExecutions(ctx, &persistence.ListConcreteExecutionsRequest{
+			ShardID:   shardID,
+			PageSize:  splitMoverListPageSize,
+			PageToken: pageToken,
+		})
+		if err != nil {
+			return moved, err
+		}
+		for _, state := range resp.States {
+			namespaceID := state.GetExecutionInfo().GetNamespaceId()
+			workflowID := state.GetExecutionInfo().GetWorkflowId()
+			if shardMap.WorkflowIDToChildShard(namespaceID, workflowID) == shardID {
+				continue
+			}
+			if len(runs) > 0 && (runs[0].NamespaceID != namespaceID || runs[0].WorkflowID != workflowID) {
+				if err := moveRuns(); err != nil {
+					return moved, err
+				}
+			}
+			runs = append(runs, definition.NewWorkflowKey(namespaceID, workflowID, state.GetExecutionState().GetRunId()))
+		}
+		pageToken = resp.PageToken
+		if len(pageToken) == 0 {
+			return moved, moveRuns()
+		}
+	}
+}
+
+// moveWorkflow moves the runs of a workflow to its child shard. The requests for the workflow are rejected while its
+// runs are moved, and are routed to the child shard once all of them are moved.
+func (m *ShardSplitMover) moveWorkflow(
+	ctx context.Context,
+	shardMap *shardmap.ShardMap,
+	shardContext historyi.ShardContext,
+	engine historyi.Engine,
+	runs []definition.WorkflowKey,
+) (retError error) {
+	shardID := shardContext.GetShardID()
+	workflow := workflowIdentifier{namespaceID: runs[0].NamespaceID, workflowID: runs[0].WorkflowID}
+	childShardContext, err := m.controller.GetShardByID(shardMap.WorkflowIDToChildShard(workflow.namespaceID, workflow.workflowID))
+	if err != nil {
+		return err
+	}
+
+	wasMoved := m.controller.beginWorkflowMove(shardID, workflow)
+	movedRuns := 0
+	defer func() {
+		switch {
+		case retError == nil:
+			m.controller.endWorkflowMove(shardID, workflow, true)
+		case movedRuns == 0:
+			// e.g. the workflow has buffered events, which are flushed by the requests for the workflow
+			m.controller.endWorkflowMove(shardID, workflow, wasMoved)
+		default:
+			// The runs of the workflow are in both shards, it's rejected until the next attempt moves the other runs.
+		}
+	}()
+
+	for _, run := range runs {
+		err := engine.MoveWorkflowExecution(ctx, run, childShardContext)
+		switch err.(type) {
+		case nil:
+			movedRuns++
+		case *serviceerror.NotFound:
+			// deleted since it was listed
+		default:
+			return err
+		}
+	}
+	return nil
+}
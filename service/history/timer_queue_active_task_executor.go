@@ -150,8 +150,14 @@ func (t *timerQueueActiveTaskExecutor) executeUserTimerTimeoutTask(
 		return consts.ErrWorkflowExecutionNotFound
 	}
 
+	if mutableState.IsWorkflowExecutionPaused() {
+		// User timer task will be regenerated when the workflow is unpaused.
+		release(nil) // release(nil) so mutable state is not unloaded from cache
+		return errNoTimerFired
+	}
+
 	timerSequence := t.getTimerSequence(mutableState)
-	referenceTime := t.Now()
+	referenceTime := t.namespaceNow(mutableState)
 	timerFired := false
 Loop:
 	for _, timerSequenceID := range timerSequence.LoadAndSortUserTimers() {
@@ -209,6 +215,12 @@ func (t *timerQueueActiveTaskExecutor) executeActivityTimeoutTask(
 		return nil
 	}
 
+	if mutableState.IsWorkflowExecutionPaused() {
+		// Activity timer task will be regenerated when the workflow is unpaused.
+		release(nil) // release(nil) so mutable state is not unloaded from cache
+		return nil
+	}
+
 	timerSequence := t.getTimerSequence(mutableState)
 	referenceTime := t.Now()
 	updateMutableState := false
@@ -465,6 +477,12 @@ func (t *timerQueueActiveTaskExecutor) executeWorkflowBackoffTimerTask(
 		return errNoTimerFired
 	}
 
+	if mutableState.IsWorkflowExecutionPaused() {
+		// Workflow backoff timer task will be regenerated when the workflow is unpaused.
+		release(nil)
+		return errNoTimerFired
+	}
+
 	// schedule first workflow task
 	return t.updateWorkflowExecution(ctx, weContext, mutableState, true)
 }
@@ -528,6 +546,12 @@ func (t *timerQueueActiveTaskExecutor) executeActivityRetryTimerTask(
 		return consts.ErrWorkflowCompleted
 	}
 
+	if mutableState.IsWorkflowExecutionPaused() {
+		// Activity task will be regenerated when the workflow is unpaused.
+		release(nil) // release(nil) so mutable state is not unloaded from cache
+		return consts.ErrActivityTaskNotFound
+	}
+
 	err = t.processActivityWorkflowRules(ctx, weContext, mutableState, activityInfo)
 	if err != nil {
 		return err
@@ -548,6 +572,7 @@ func (t *timerQueueActiveTaskExecutor) executeActivityRetryTimerTask(
 	directive := MakeDirectiveForActivityTask(mutableState, activityInfo)
 	useWfBuildId := activityInfo.GetUseWorkflowBuildIdInfo() != nil
 	priority := priorities.Merge(mutableState.GetExecutionInfo().Priority, activityInfo.Priority)
+	fairnessKey := workflow.FairnessKey(t.shardContext, mutableState)
 
 	// NOTE: do not access anything related mutable state after this lock release
 	release(nil) // release earlier as we don't need the lock anymore
@@ -565,6 +590,7 @@ func (t *timerQueueActiveTaskExecutor) executeActivityRetryTimerTask(
 		VersionDirective:       directive,
 		Stamp:                  task.Stamp,
 		Priority:               priority,
+		FairnessKey:            fairnessKey,
 	})
 	if err != nil {
 		return err
@@ -627,7 +653,7 @@ func (t *timerQueueActiveTaskExecutor) executeWorkflowRunTimeoutTask(
 	initiator := enumspb.CONTINUE_AS_NEW_INITIATOR_UNSPECIFIED
 
 	wfExpTime := mutableState.GetExecutionInfo().WorkflowExecutionExpirationTime
-	if wfExpTime == nil || wfExpTime.AsTime().IsZero() || wfExpTime.AsTime().After(t.Now()) {
+	if wfExpTime == nil || wfExpTime.AsTime().IsZero() || wfExpTime.AsTime().After(t.namespaceNow(mutableState)) {
 		backoffInterval, retryState = mutableState.GetRetryBackoffDuration(timeoutFailure)
 		if backoffInterval != backoff.NoBackoff {
 			// We have a retry policy and we should retry.
@@ -897,6 +923,8 @@ func (t *timerQueueActiveTaskExecutor) processActivityWorkflowRules(
 			activityInfo.StartedEventId = common.EmptyEventID
 			activityInfo.StartedTime = nil
 			activityInfo.RequestId = ""
+			activityInfo.InFlightTaskQueue = ""
+			activityInfo.InFlightId = ""
 			return nil
 		}); err != nil {
 			return err
@@ -152,6 +152,7 @@ func (f *timerQueueFactory) CreateQueue(
 		shardContext.GetTimeSource(),
 		shardContext.GetNamespaceRegistry(),
 		shardContext.GetClusterMetadata(),
+		f.Config.ShardMapProvider,
 		logger,
 		metricsHandler,
 		f.Tracer,
@@ -26,6 +26,7 @@ import (
 	historyi "go.temporal.io/server/service/history/interfaces"
 	"go.temporal.io/server/service/history/queues"
 	"go.temporal.io/server/service/history/tasks"
+	"go.temporal.io/server/service/history/workflow"
 	wcache "go.temporal.io/server/service/history/workflow/cache"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
@@ -157,13 +158,21 @@ func (t *timerQueueTaskExecutorBase) isValidExpirationTime(
 		return false
 	}
 
-	now := t.Now()
+	now := t.namespaceNow(mutableState)
 	taskShouldTriggerAt := expirationTime.AsTime()
 	expired := queues.IsTimeExpired(task, now, taskShouldTriggerAt)
 	return expired
 
 }
 
+// namespaceNow returns the current time of the namespace of the mutable state, which is ahead of the shard time for
+// test namespaces with a virtual clock.
+func (t *timerQueueTaskExecutorBase) namespaceNow(
+	mutableState historyi.MutableState,
+) time.Time {
+	return t.Now().Add(workflow.VirtualClockOffset(t.config, mutableState.GetNamespaceEntry()))
+}
+
 func (t *timerQueueTaskExecutorBase) isValidWorkflowRunTimeoutTask(
 	mutableState historyi.MutableState,
 	task *tasks.WorkflowRunTimeoutTask,
@@ -191,16 +191,23 @@ func (t *transferQueueActiveTaskExecutor) processActivityTask(
 		return consts.ErrWorkflowCompleted
 	}
 
+	if mutableState.IsWorkflowExecutionPaused() {
+		// Activity task will be regenerated when the workflow is unpaused.
+		release(nil) // release(nil) so that the mutable state is not unloaded from cache
+		return nil
+	}
+
 	timeout := timestamp.DurationValue(ai.ScheduleToStartTimeout)
 	directive := MakeDirectiveForActivityTask(mutableState, ai)
 	priority := priorities.Merge(mutableState.GetExecutionInfo().Priority, ai.Priority)
+	fairnessKey := workflow.FairnessKey(t.shardContext, mutableState)
 
 	// NOTE: do not access anything related mutable state after this lock release
 	// release the context lock since we no longer need mutable state and
 	// the rest of logic is making RPC call, which takes time.
 	release(nil)
 
-	return t.pushActivity(ctx, task, timeout, directive, priority, historyi.TransactionPolicyActive)
+	return t.pushActivity(ctx, task, timeout, directive, priority, fairnessKey, historyi.TransactionPolicyActive)
 }
 
 func (t *transferQueueActiveTaskExecutor) processWorkflowTask(
@@ -233,6 +240,11 @@ func (t *transferQueueActiveTaskExecutor) processWorkflowTask(
 		return err
 	}
 
+	if mutableState.IsWorkflowExecutionPaused() {
+		// Workflow task will be regenerated when the workflow is unpaused.
+		return nil
+	}
+
 	// Task queue from transfer task (not current one from mutable state) must be used here.
 	// If current task queue becomes sticky since this transfer task was created,
 	// it can't be used here, because timeout timer was not created for it,
@@ -243,6 +255,7 @@ func (t *transferQueueActiveTaskExecutor) processWorkflowTask(
 
 	directive := MakeDirectiveForWorkflowTask(mutableState)
 	priority := mutableState.GetExecutionInfo().Priority
+	fairnessKey := workflow.FairnessKey(t.shardContext, mutableState)
 
 	// NOTE: Do not access mutableState after this lock is released.
 	// It is important to release the workflow lock here, because pushWorkflowTask will call matching,
@@ -256,6 +269,7 @@ func (t *transferQueueActiveTaskExecutor) processWorkflowTask(
 		scheduleToStartTimeout.AsDuration(),
 		directive,
 		priority,
+		fairnessKey,
 		historyi.TransactionPolicyActive,
 	)
 
@@ -279,6 +293,7 @@ func (t *transferQueueActiveTaskExecutor) processWorkflowTask(
 			scheduleToStartTimeout.AsDuration(),
 			directive,
 			priority,
+			fairnessKey,
 			historyi.TransactionPolicyActive,
 		)
 	}
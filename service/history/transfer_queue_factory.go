@@ -146,6 +146,7 @@ func (f *transferQueueFactory) CreateQueue(
 		shardContext.GetTimeSource(),
 		shardContext.GetNamespaceRegistry(),
 		shardContext.GetClusterMetadata(),
+		f.Config.ShardMapProvider,
 		logger,
 		metricsHandler,
 		f.Tracer,
@@ -132,7 +132,7 @@ func (t *transferQueueStandbyTaskExecutor) processActivityTask(
 		}
 
 		if activityInfo.StartedEventId == common.EmptyEventID {
-			return newActivityTaskPostActionInfo(mutableState, activityInfo)
+			return newActivityTaskPostActionInfo(t.shardContext, mutableState, activityInfo)
 		}
 
 		return nil, nil
@@ -180,6 +180,7 @@ func (t *transferQueueStandbyTaskExecutor) processWorkflowTask(
 
 		if wtInfo.StartedEventID == common.EmptyEventID {
 			return newWorkflowTaskPostActionInfo(
+				t.shardContext,
 				mutableState,
 				scheduleToStartTimeout.AsDuration(),
 				taskQueue,
@@ -506,6 +507,7 @@ func (t *transferQueueStandbyTaskExecutor) pushActivity(
 		pushActivityInfo.activityTaskScheduleToStartTimeout,
 		pushActivityInfo.versionDirective,
 		pushActivityInfo.priority,
+		pushActivityInfo.fairnessKey,
 		historyi.TransactionPolicyPassive,
 	)
 }
@@ -528,6 +530,7 @@ func (t *transferQueueStandbyTaskExecutor) pushWorkflowTask(
 		pushwtInfo.workflowTaskScheduleToStartTimeout,
 		pushwtInfo.versionDirective,
 		pushwtInfo.priority,
+		pushwtInfo.fairnessKey,
 		historyi.TransactionPolicyPassive,
 	)
 }
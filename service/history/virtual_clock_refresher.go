@@ -0,0 +1,156 @@
+package history
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence/visibility/manager"
+	"go.temporal.io/server/common/searchattribute"
+	"go.temporal.io/server/service/history/configs"
+	"go.temporal.io/server/service/history/shard"
+)
+
+const (
+	virtualClockRefreshPageSize = 100
+)
+
+type (
+	// virtualClockRefresher refreshes the tasks of the running workflows of a test namespace when its virtual clock
+	// is advanced. Timer tasks are created with the offset of the virtual clock at the time the timer is started, the
+	// refresh regenerates them with the new offset so that they fire by the new virtual time. Every host refreshes
+	// the workflows of the shards it owns, once its namespace registry has loaded the new offset.
+	virtualClockRefresher struct {
+		config            *configs.Config
+		namespaceRegistry namespace.Registry
+		visibilityManager manager.VisibilityManager
+		controller        shard.Controller
+		logger            log.Logger
+
+		ctx    context.Context
+		cancel context.CancelFunc
+
+		sync.Mutex
+		offsets map[namespace.ID]time.Duration
+	}
+)
+
+func newVirtualClockRefresher(
+	config *configs.Config,
+	namespaceRegistry namespace.Registry,
+	visibilityManager manager.VisibilityManager,
+	controller shard.Controller,
+	logger log.Logger,
+) *virtualClockRefresher {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &virtualClockRefresher{
+		config:            config,
+		namespaceRegistry: namespaceRegistry,
+		visibilityManager: visibilityManager,
+		controller:        controller,
+		logger:            logger,
+		ctx:               ctx,
+		cancel:            cancel,
+		offsets:           make(map[namespace.ID]time.Duration),
+	}
+}
+
+func (r *virtualClockRefresher) Start() {
+	r.namespaceRegistry.RegisterStateChangeCallback(r, func(ns *namespace.Namespace, deletedFromDb bool) {
+		if r.offsetChanged(ns, deletedFromDb) {
+			go r.refreshNamespace(ns)
+		}
+	})
+}
+
+func (r *virtualClockRefresher) Stop() {
+	r.namespaceRegistry.UnregisterStateChangeCallback(r)
+	r.cancel()
+}
+
+// offsetChanged records the offset of the virtual clock of the namespace and returns true if it changed. The
+// callback is first called for all namespaces when it's registered, which only records their offsets.
+func (r *virtualClockRefresher) offsetChanged(ns *namespace.Namespace, deletedFromDb bool) bool {
+	r.Lock()
+	defer r.Unlock()
+
+	if deletedFromDb {
+		delete(r.offsets, ns.ID())
+		return false
+	}
+	offset := ns.VirtualClockOffset()
+	previousOffset, ok := r.offsets[ns.ID()]
+	r.offsets[ns.ID()] = offset
+	return ok && offset != previousOffset && r.config.EnableVirtualClock(ns.Name().String())
+}
+
+func (r *virtualClockRefresher) refreshNamespace(ns *namespace.Namespace) {
+	logger := log.With(r.logger, tag.WorkflowNamespace(ns.Name().String()))
+	query := fmt.Sprintf("%s = '%s'", searchattribute.ExecutionStatus, enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING.String())
+
+	refreshed := 0
+	var nextPageToken []byte
+	for {
+		resp, err := r.visibilityManager.ListWorkflowExecutions(r.ctx, &manager.ListWorkflowExecutionsRequestV2{
+			NamespaceID:   ns.ID(),
+			Namespace:     ns.Name(),
+			PageSize:      virtualClockRefreshPageSize,
+			NextPageToken: nextPageToken,
+			Query:         query,
+		})
+		if err != nil {
+			logger.Error("Unable to list running workflows to refresh their tasks after the virtual clock advanced", tag.Error(err))
+			return
+		}
+
+		for _, execution := range resp.Executions {
+			err := r.refreshWorkflow(ns.ID(), execution.GetExecution())
+			if shard.IsShardOwnershipLostError(err) {
+				// The workflow is refreshed by the host owning its shard.
+				continue
+			}
+			if err != nil {
+				if r.ctx.Err() != nil {
+					return
+				}
+				logger.Warn("Unable to refresh workflow tasks after the virtual clock advanced",
+					tag.WorkflowID(execution.GetExecution().GetWorkflowId()),
+					tag.WorkflowRunID(execution.GetExecution().GetRunId()),
+					tag.Error(err),
+				)
+				continue
+			}
+			refreshed++
+		}
+
+		nextPageToken = resp.NextPageToken
+		if len(nextPageToken) == 0 {
+			break
+		}
+	}
+	logger.Info("Refreshed workflow tasks after the virtual clock advanced",
+		tag.NewDurationTag("offset", ns.VirtualClockOffset()),
+		tag.Counter(refreshed),
+	)
+}
+
+func (r *virtualClockRefresher) refreshWorkflow(
+	namespaceID namespace.ID,
+	execution *commonpb.WorkflowExecution,
+) error {
+	shardContext, err := r.controller.GetShardByNamespaceWorkflow(namespaceID, execution.GetWorkflowId())
+	if err != nil {
+		return err
+	}
+	engine, err := shardContext.GetEngine(r.ctx)
+	if err != nil {
+		return err
+	}
+	return engine.RefreshWorkflowTasks(r.ctx, namespaceID, execution)
+}
@@ -0,0 +1,71 @@
+package history
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	commonpb "go.temporal.io/api/common/v1"
+	workflowpb "go.temporal.io/api/workflow/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence/visibility/manager"
+	serviceerrors "go.temporal.io/server/common/serviceerror"
+	"go.temporal.io/server/service/history/configs"
+	historyi "go.temporal.io/server/service/history/interfaces"
+	"go.temporal.io/server/service/history/shard"
+	"go.uber.org/mock/gomock"
+)
+
+func TestVirtualClockRefresher(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	visibilityManager := manager.NewMockVisibilityManager(ctrl)
+	controller := shard.NewMockController(ctrl)
+	refresher := newVirtualClockRefresher(
+		&configs.Config{EnableVirtualClock: func(string) bool { return true }},
+		namespace.NewMockRegistry(ctrl),
+		visibilityManager,
+		controller,
+		log.NewNoopLogger(),
+	)
+	newNamespace := func(offset string) *namespace.Namespace {
+		return namespace.NewLocalNamespaceForTest(
+			&persistencespb.NamespaceInfo{
+				Id:   "test-namespace-id",
+				Name: "test-namespace",
+				Data: map[string]string{namespace.VirtualClockOffsetDataKey: offset},
+			},
+			nil,
+			"active",
+		)
+	}
+
+	// The first callback only records the offset.
+	require.False(t, refresher.offsetChanged(newNamespace("1h"), false))
+	require.False(t, refresher.offsetChanged(newNamespace("1h"), false))
+	ns := newNamespace("2h")
+	require.True(t, refresher.offsetChanged(ns, false))
+
+	owned := &commonpb.WorkflowExecution{WorkflowId: "owned-workflow-id", RunId: "owned-run-id"}
+	notOwned := &commonpb.WorkflowExecution{WorkflowId: "not-owned-workflow-id", RunId: "not-owned-run-id"}
+	visibilityManager.EXPECT().ListWorkflowExecutions(gomock.Any(), &manager.ListWorkflowExecutionsRequestV2{
+		NamespaceID: ns.ID(),
+		Namespace:   ns.Name(),
+		PageSize:    virtualClockRefreshPageSize,
+		Query:       "ExecutionStatus = 'Running'",
+	}).Return(&manager.ListWorkflowExecutionsResponse{
+		Executions: []*workflowpb.WorkflowExecutionInfo{{Execution: notOwned}, {Execution: owned}},
+	}, nil)
+	controller.EXPECT().GetShardByNamespaceWorkflow(ns.ID(), notOwned.GetWorkflowId()).
+		Return(nil, serviceerrors.NewShardOwnershipLost("owner", "current"))
+	shardContext := historyi.NewMockShardContext(ctrl)
+	engine := historyi.NewMockEngine(ctrl)
+	controller.EXPECT().GetShardByNamespaceWorkflow(ns.ID(), owned.GetWorkflowId()).Return(shardContext, nil)
+	shardContext.EXPECT().GetEngine(gomock.Any()).Return(engine, nil)
+	engine.EXPECT().RefreshWorkflowTasks(gomock.Any(), ns.ID(), owned).Return(nil)
+
+	refresher.refreshNamespace(ns)
+
+	require.False(t, refresher.offsetChanged(ns, true))
+	require.False(t, refresher.offsetChanged(newNamespace("3h"), false))
+}
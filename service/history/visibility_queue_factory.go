@@ -116,6 +116,7 @@ func (f *visibilityQueueFactory) CreateQueue(
 		shard.GetTimeSource(),
 		shard.GetNamespaceRegistry(),
 		shard.GetClusterMetadata(),
+		f.Config.ShardMapProvider,
 		logger,
 		metricsHandler,
 		f.Tracer,
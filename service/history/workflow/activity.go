@@ -78,6 +78,8 @@ func UpdateActivityInfoForRetries(
 	ai.ScheduledTime = nextScheduledTime
 	ai.StartedEventId = common.EmptyEventID
 	ai.RequestId = ""
+	ai.InFlightTaskQueue = ""
+	ai.InFlightId = ""
 	ai.StartedTime = nil
 	ai.TimerTaskStatus = TimerTaskStatusNone
 	ai.RetryLastWorkerIdentity = ai.StartedIdentity
@@ -11,11 +11,13 @@ import (
 	commonpb "go.temporal.io/api/common/v1"
 	enumspb "go.temporal.io/api/enums/v1"
 	historypb "go.temporal.io/api/history/v1"
+	"go.temporal.io/api/serviceerror"
 	taskqueuepb "go.temporal.io/api/taskqueue/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/cluster"
 	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/searchattribute"
 	"go.temporal.io/server/service/history/hsm"
 	historyi "go.temporal.io/server/service/history/interfaces"
 	"go.temporal.io/server/service/history/shard"
@@ -451,3 +453,57 @@ func (s *activitySuite) TestUnpauseActivityWithResetAcceptance() {
 	s.Nil(ai.LastHeartbeatUpdateTime)
 	s.Nil(ai.LastHeartbeatDetails)
 }
+
+func (s *activitySuite) TestPauseWorkflowExecution() {
+	ai := s.AddActivityInfo()
+	s.False(s.mutableState.IsWorkflowExecutionPaused())
+
+	event, err := s.mutableState.AddWorkflowExecutionPausedEvent()
+	s.NoError(err)
+	s.Equal(enumspb.EVENT_TYPE_WORKFLOW_PROPERTIES_MODIFIED_EXTERNALLY, event.GetEventType())
+	s.True(event.GetWorkerMayIgnore())
+	s.True(s.mutableState.IsWorkflowExecutionPaused())
+	s.Equal(event.GetEventTime(), s.mutableState.GetExecutionInfo().GetPauseInfo().GetPauseTime())
+	s.ElementsMatch(
+		[]string{"Workflow:" + tests.WorkflowID, "Reason:ManualWorkflowPause"},
+		s.decodePauseInfo(),
+	)
+
+	// pausing an activity must keep the workflow paused
+	s.NoError(PauseActivity(s.mutableState, ai.ActivityId, nil))
+	s.True(s.mutableState.IsWorkflowExecutionPaused())
+	s.ElementsMatch(
+		[]string{"Workflow:" + tests.WorkflowID, "Reason:ManualWorkflowPause", "property:activityType=activity-type"},
+		s.decodePauseInfo(),
+	)
+
+	_, err = s.mutableState.AddWorkflowExecutionPausedEvent()
+	var failedPrecondition *serviceerror.FailedPrecondition
+	s.ErrorAs(err, &failedPrecondition)
+
+	event, err = s.mutableState.AddWorkflowExecutionUnpausedEvent()
+	s.NoError(err)
+	s.Equal(enumspb.EVENT_TYPE_WORKFLOW_PROPERTIES_MODIFIED_EXTERNALLY, event.GetEventType())
+	s.False(s.mutableState.IsWorkflowExecutionPaused())
+	s.Nil(s.mutableState.GetExecutionInfo().GetPauseInfo())
+	s.ElementsMatch([]string{"property:activityType=activity-type"}, s.decodePauseInfo())
+	s.True(ai.Paused, "unpausing the workflow must not unpause the activity")
+
+	_, err = s.mutableState.AddWorkflowExecutionUnpausedEvent()
+	s.ErrorAs(err, &failedPrecondition)
+
+	// the pause is restored from the event when the mutable state is rebuilt
+	pausedEvent, err := s.mutableState.AddWorkflowExecutionPausedEvent()
+	s.NoError(err)
+	s.mutableState.GetExecutionInfo().PauseInfo = nil
+	s.NoError(s.mutableState.ApplyWorkflowPropertiesModifiedExternallyEvent(pausedEvent))
+	s.True(s.mutableState.IsWorkflowExecutionPaused())
+}
+
+func (s *activitySuite) decodePauseInfo() []string {
+	pauseInfoPayload := s.mutableState.GetExecutionInfo().SearchAttributes[searchattribute.TemporalPauseInfo]
+	s.NotNil(pauseInfoPayload)
+	pauseInfo, err := searchattribute.DecodeValue(pauseInfoPayload, enumspb.INDEXED_VALUE_TYPE_KEYWORD_LIST, false)
+	s.NoError(err)
+	return pauseInfo.([]string)
+}
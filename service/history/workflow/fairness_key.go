@@ -0,0 +1,43 @@
+package workflow
+
+import (
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/server/common/searchattribute"
+	historyi "go.temporal.io/server/service/history/interfaces"
+)
+
+// FairnessKey returns the fairness key of the workflow and activity tasks of a workflow: the value of the Keyword
+// search attribute configured with history.fairnessKeySearchAttribute for the namespace. It is empty when no search
+// attribute is configured, or the workflow doesn't have it set.
+func FairnessKey(
+	shardContext historyi.ShardContext,
+	mutableState historyi.MutableState,
+) string {
+	nsName := mutableState.GetNamespaceEntry().Name().String()
+	saName := shardContext.GetConfig().FairnessKeySearchAttribute(nsName)
+	searchAttributes := mutableState.GetExecutionInfo().GetSearchAttributes()
+	if saName == "" || len(searchAttributes) == 0 {
+		return ""
+	}
+
+	// custom search attributes are stored by their field name in some visibility stores
+	aliased, err := searchattribute.AliasFields(
+		shardContext.GetSearchAttributesMapperProvider(),
+		&commonpb.SearchAttributes{IndexedFields: searchAttributes},
+		nsName,
+	)
+	if err != nil {
+		return ""
+	}
+	payload, ok := aliased.GetIndexedFields()[saName]
+	if !ok {
+		return ""
+	}
+	value, err := searchattribute.DecodeValue(payload, enumspb.INDEXED_VALUE_TYPE_KEYWORD, false)
+	if err != nil {
+		return ""
+	}
+	key, _ := value.(string)
+	return key
+}
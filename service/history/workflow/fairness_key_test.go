@@ -0,0 +1,47 @@
+package workflow
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/searchattribute"
+	historyi "go.temporal.io/server/service/history/interfaces"
+	"go.temporal.io/server/service/history/tests"
+	"go.uber.org/mock/gomock"
+)
+
+func TestFairnessKey(t *testing.T) {
+	controller := gomock.NewController(t)
+	nsName := tests.LocalNamespaceEntry.Name().String()
+
+	keyPayload, err := searchattribute.EncodeValue("tenant-a", enumspb.INDEXED_VALUE_TYPE_KEYWORD)
+	require.NoError(t, err)
+
+	config := tests.NewDynamicConfig()
+	shardContext := historyi.NewMockShardContext(controller)
+	shardContext.EXPECT().GetConfig().Return(config).AnyTimes()
+	shardContext.EXPECT().GetSearchAttributesMapperProvider().
+		Return(searchattribute.NewTestMapperProvider(&searchattribute.TestMapper{Namespace: nsName})).AnyTimes()
+
+	newMutableState := func(searchAttributes map[string]*commonpb.Payload) historyi.MutableState {
+		mutableState := historyi.NewMockMutableState(controller)
+		mutableState.EXPECT().GetNamespaceEntry().Return(tests.LocalNamespaceEntry).AnyTimes()
+		mutableState.EXPECT().GetExecutionInfo().Return(&persistencespb.WorkflowExecutionInfo{
+			SearchAttributes: searchAttributes,
+		}).AnyTimes()
+		return mutableState
+	}
+	withKey := newMutableState(map[string]*commonpb.Payload{"Keyword01": keyPayload})
+
+	// no search attribute configured
+	require.Empty(t, FairnessKey(shardContext, withKey))
+
+	config.FairnessKeySearchAttribute = dynamicconfig.GetStringPropertyFnFilteredByNamespace("AliasForKeyword01")
+	require.Equal(t, "tenant-a", FairnessKey(shardContext, withKey))
+	require.Empty(t, FairnessKey(shardContext, newMutableState(nil)))
+	require.Empty(t, FairnessKey(shardContext, newMutableState(map[string]*commonpb.Payload{"Keyword02": keyPayload})))
+}
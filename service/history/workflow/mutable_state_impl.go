@@ -78,6 +78,18 @@ const (
 	mutableStateInvalidHistoryActionMsgTemplate = mutableStateInvalidHistoryActionMsg + ": %v, %v"
 
 	int64SizeBytes = 8
+
+	// workflowManualPauseReason is recorded in the TemporalPauseInfo search attribute of manually paused workflows
+	workflowManualPauseReason = "Reason:ManualWorkflowPause"
+
+	// WorkflowPausedMemoKey is the upserted memo field of the WorkflowPropertiesModifiedExternally events recording
+	// a pause or an unpause of the workflow execution. Its value is true for a pause and false for an unpause.
+	WorkflowPausedMemoKey = "__temporal_workflow_paused"
+
+	// SearchAttributesMovedMemoKey is the upserted memo field of the WorkflowPropertiesModifiedExternally events
+	// recording a move of a custom search attribute value to another field. Its value is the encoded search
+	// attributes upserted by the move: the source field with a nil value and the target field with the moved value.
+	SearchAttributesMovedMemoKey = "__temporal_search_attributes_moved"
 )
 
 // Scheduled tasks with timestamp after this will not be created.
@@ -1875,7 +1887,7 @@ func (ms *MutableStateImpl) UpdateActivityInfo(
 	}
 
 	if oldPaused != ai.Paused {
-		err = ms.updatePauseInfoSearchAttribute()
+		err = ms.updatePauseInfoSearchAttribute(ms.IsWorkflowExecutionPaused())
 	}
 
 	return err
@@ -5566,6 +5578,8 @@ func (ms *MutableStateImpl) RetryActivity(
 			activityInfo.StartedEventId = common.EmptyEventID
 			activityInfo.StartedTime = nil
 			activityInfo.RequestId = ""
+			activityInfo.InFlightTaskQueue = ""
+			activityInfo.InFlightId = ""
 			activityInfo.RetryLastFailure = ms.truncateRetryableActivityFailure(activityFailure)
 			return nil
 		}); err != nil {
@@ -5680,7 +5694,7 @@ func (ms *MutableStateImpl) UpdateActivity(scheduledEventId int64, updater histo
 	}
 
 	if prevPause != ai.Paused {
-		err := ms.updatePauseInfoSearchAttribute()
+		err := ms.updatePauseInfoSearchAttribute(ms.IsWorkflowExecutionPaused())
 		if err != nil {
 			return err
 		}
@@ -5704,7 +5718,133 @@ func (ms *MutableStateImpl) UpdateActivity(scheduledEventId int64, updater histo
 	return nil
 }
 
-func (ms *MutableStateImpl) updatePauseInfoSearchAttribute() error {
+// IsWorkflowExecutionPaused returns true if the workflow execution is paused.
+func (ms *MutableStateImpl) IsWorkflowExecutionPaused() bool {
+	return ms.executionInfo.GetPauseInfo() != nil
+}
+
+// AddWorkflowExecutionPausedEvent pauses the workflow execution. While the workflow is paused, workflow tasks,
+// activity tasks and timers are not dispatched, and new signals and updates are buffered in the pending workflow
+// task.
+func (ms *MutableStateImpl) AddWorkflowExecutionPausedEvent() (*historypb.HistoryEvent, error) {
+	if ms.IsWorkflowExecutionPaused() {
+		return nil, serviceerror.NewFailedPrecondition("Workflow execution is already paused.")
+	}
+	return ms.addWorkflowPausedPropertyEvent(true)
+}
+
+// AddWorkflowExecutionUnpausedEvent clears the pause of the workflow execution. The caller is responsible for
+// regenerating the tasks which were dropped while the workflow was paused.
+func (ms *MutableStateImpl) AddWorkflowExecutionUnpausedEvent() (*historypb.HistoryEvent, error) {
+	if !ms.IsWorkflowExecutionPaused() {
+		return nil, serviceerror.NewFailedPrecondition("Workflow execution is not paused.")
+	}
+	return ms.addWorkflowPausedPropertyEvent(false)
+}
+
+// addWorkflowPausedPropertyEvent records a pause or an unpause in a WorkflowPropertiesModifiedExternally event,
+// there are no dedicated pause events in the public API yet. The event carries the pause state in the reserved
+// WorkflowPausedMemoKey field of its upserted memo, which isn't applied to the memo of the execution.
+func (ms *MutableStateImpl) addWorkflowPausedPropertyEvent(paused bool) (*historypb.HistoryEvent, error) {
+	if err := ms.checkMutability(tag.WorkflowActionWorkflowPropertiesModifiedExternally); err != nil {
+		return nil, err
+	}
+	pausedPayload, err := payload.Encode(paused)
+	if err != nil {
+		return nil, err
+	}
+	event := ms.hBuilder.AddWorkflowPropertiesModifiedExternallyEvent(&commonpb.Memo{
+		Fields: map[string]*commonpb.Payload{WorkflowPausedMemoKey: pausedPayload},
+	})
+	if err := ms.ApplyWorkflowPropertiesModifiedExternallyEvent(event); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
+func (ms *MutableStateImpl) ApplyWorkflowPropertiesModifiedExternallyEvent(event *historypb.HistoryEvent) error {
+	attributes := event.GetWorkflowPropertiesModifiedExternallyEventAttributes()
+	fields := attributes.GetUpsertedMemo().GetFields()
+	if len(fields) != 1 || attributes.GetNewTaskQueue() != "" ||
+		attributes.GetNewWorkflowTaskTimeout() != nil || attributes.GetNewWorkflowRunTimeout() != nil ||
+		attributes.GetNewWorkflowExecutionTimeout() != nil {
+		return serviceerror.NewUnimplemented("Workflow property modification not implemented")
+	}
+	if movedPayload, ok := fields[SearchAttributesMovedMemoKey]; ok {
+		return ms.applySearchAttributesMoved(movedPayload)
+	}
+	pausedPayload, ok := fields[WorkflowPausedMemoKey]
+	if !ok {
+		return serviceerror.NewUnimplemented("Workflow property modification not implemented")
+	}
+	var paused bool
+	if err := payload.Decode(pausedPayload, &paused); err != nil {
+		return err
+	}
+
+	if paused {
+		ms.executionInfo.PauseInfo = &persistencespb.WorkflowPauseInfo{
+			PauseTime: event.GetEventTime(),
+		}
+	} else {
+		ms.executionInfo.PauseInfo = nil
+	}
+	// The search attribute makes paused executions visible to visibility queries.
+	return ms.updatePauseInfoSearchAttribute(paused)
+}
+
+func (ms *MutableStateImpl) MoveSearchAttributeValue(
+	sourceField string,
+	targetField string,
+	value *commonpb.Payload,
+) error {
+	if !ms.IsWorkflowExecutionRunning() {
+		return consts.ErrWorkflowCompleted
+	}
+	if _, ok := ms.executionInfo.SearchAttributes[sourceField]; !ok {
+		return nil
+	}
+	if err := ms.checkMutability(tag.WorkflowActionWorkflowPropertiesModifiedExternally); err != nil {
+		return err
+	}
+
+	// The move is recorded in an event so that it's replicated and reapplied when the mutable state is rebuilt.
+	// An UpsertWorkflowSearchAttributes event is the result of a command of the workflow, which SDKs would fail to
+	// match on replay.
+	nilValue, err := payload.Encode(nil)
+	if err != nil {
+		return err
+	}
+	upserted := map[string]*commonpb.Payload{sourceField: nilValue}
+	if value != nil {
+		upserted[targetField] = value
+	}
+	movedPayload, err := payload.Encode(&commonpb.SearchAttributes{IndexedFields: upserted})
+	if err != nil {
+		return err
+	}
+	event := ms.hBuilder.AddWorkflowPropertiesModifiedExternallyEvent(&commonpb.Memo{
+		Fields: map[string]*commonpb.Payload{SearchAttributesMovedMemoKey: movedPayload},
+	})
+	return ms.ApplyWorkflowPropertiesModifiedExternallyEvent(event)
+}
+
+func (ms *MutableStateImpl) applySearchAttributesMoved(movedPayload *commonpb.Payload) error {
+	var moved commonpb.SearchAttributes
+	if err := payload.Decode(movedPayload, &moved); err != nil {
+		return err
+	}
+	ms.approximateSize -= ms.executionInfo.Size()
+	ms.updateSearchAttributes(moved.GetIndexedFields())
+	ms.approximateSize += ms.executionInfo.Size()
+	return ms.taskGenerator.GenerateUpsertVisibilityTask()
+}
+
+func (ms *MutableStateImpl) workflowPauseInfo() string {
+	return fmt.Sprintf("Workflow:%s", ms.executionInfo.WorkflowId)
+}
+
+func (ms *MutableStateImpl) updatePauseInfoSearchAttribute(workflowPaused bool) error {
 	pausedInfoMap := make(map[string]struct{})
 
 	for _, ai := range ms.GetPendingActivityInfos() {
@@ -5713,7 +5853,10 @@ func (ms *MutableStateImpl) updatePauseInfoSearchAttribute() error {
 		}
 		pausedInfoMap[ai.ActivityType.Name] = struct{}{}
 	}
-	pausedInfo := make([]string, 0, len(pausedInfoMap))
+	pausedInfo := make([]string, 0, len(pausedInfoMap)+2)
+	if workflowPaused {
+		pausedInfo = append(pausedInfo, ms.workflowPauseInfo(), workflowManualPauseReason)
+	}
 	for activityType := range pausedInfoMap {
 		pausedInfo = append(pausedInfo, fmt.Sprintf("property:activityType=%s", activityType))
 	}
@@ -5813,7 +5956,12 @@ func (ms *MutableStateImpl) AddTasks(
 	newTasks ...tasks.Task,
 ) {
 	now := ms.timeSource.Now()
+	virtualClockOffset := VirtualClockOffset(ms.config, ms.namespaceEntry)
 	for _, task := range newTasks {
+		if virtualClockOffset > 0 && followsVirtualClock(task) {
+			// Fire the task when the virtual clock of the namespace reaches its visibility time.
+			task.SetVisibilityTime(task.GetVisibilityTime().Add(-virtualClockOffset))
+		}
 		category := task.GetCategory()
 		if category.Type() == tasks.CategoryTypeScheduled &&
 			task.GetVisibilityTime().Sub(now) > maxScheduledTaskDuration {
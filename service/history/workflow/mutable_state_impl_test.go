@@ -2219,6 +2219,39 @@ func (s *mutableStateSuite) TestupdateBuildIdsAndDeploymentSearchAttributes() {
 	}
 }
 
+func (s *mutableStateSuite) TestMoveSearchAttributeValue() {
+	oldValue, err := searchattribute.EncodeValue("123", enumspb.INDEXED_VALUE_TYPE_KEYWORD)
+	s.NoError(err)
+	newValue, err := searchattribute.EncodeValue(int64(123), enumspb.INDEXED_VALUE_TYPE_INT)
+	s.NoError(err)
+	s.mutableState.executionInfo.SearchAttributes = map[string]*commonpb.Payload{"Keyword01": oldValue}
+
+	// missing source is a noop
+	s.NoError(s.mutableState.MoveSearchAttributeValue("Keyword02", "Int01", newValue))
+	s.Len(s.mutableState.executionInfo.SearchAttributes, 1)
+	s.False(s.mutableState.visibilityUpdated)
+
+	s.NoError(s.mutableState.MoveSearchAttributeValue("Keyword01", "Int01", newValue))
+	s.Equal(map[string]*commonpb.Payload{"Int01": newValue}, s.mutableState.executionInfo.SearchAttributes)
+	s.True(s.mutableState.visibilityUpdated)
+
+	// nil value only clears the source
+	s.NoError(s.mutableState.MoveSearchAttributeValue("Int01", "Keyword01", nil))
+	s.Empty(s.mutableState.executionInfo.SearchAttributes)
+
+	// the moves are recorded in events, applying them again rebuilds the search attributes
+	mutation, err := s.mutableState.hBuilder.Finish(true)
+	s.NoError(err)
+	s.Equal(1, len(mutation.DBEventsBatches))
+	s.Equal(2, len(mutation.DBEventsBatches[0]))
+	s.mutableState.executionInfo.SearchAttributes = map[string]*commonpb.Payload{"Keyword01": oldValue}
+	s.NoError(s.mutableState.ApplyWorkflowPropertiesModifiedExternallyEvent(mutation.DBEventsBatches[0][0]))
+	s.Len(s.mutableState.executionInfo.SearchAttributes, 1)
+	s.True(proto.Equal(newValue, s.mutableState.executionInfo.SearchAttributes["Int01"]))
+	s.NoError(s.mutableState.ApplyWorkflowPropertiesModifiedExternallyEvent(mutation.DBEventsBatches[0][1]))
+	s.Empty(s.mutableState.executionInfo.SearchAttributes)
+}
+
 func (s *mutableStateSuite) TestAddResetPointFromCompletion() {
 	dbState := s.buildWorkflowMutableState()
 	var err error
@@ -3236,6 +3269,22 @@ func (s *mutableStateSuite) TestCollapseVisibilityTasks() {
 	}
 }
 
+func (s *mutableStateSuite) TestAddTasks_VirtualClock() {
+	s.mockConfig.EnableVirtualClock = dynamicconfig.GetBoolPropertyFnFilteredByNamespace(true)
+	namespaceEntry := s.namespaceEntry.Clone(namespace.WithData(namespace.VirtualClockOffsetDataKey, "1h"))
+	ms := NewMutableState(s.mockShard, s.mockEventsCache, s.logger, namespaceEntry, tests.WorkflowID, tests.RunID, time.Now().UTC())
+
+	fireTime := time.Now().UTC().Add(2 * time.Hour)
+	ms.AddTasks(
+		&tasks.UserTimerTask{VisibilityTimestamp: fireTime},
+		&tasks.ActivityTimeoutTask{VisibilityTimestamp: fireTime},
+	)
+	timerTasks := ms.InsertTasks[tasks.CategoryTimer]
+	s.Len(timerTasks, 2)
+	s.Equal(fireTime.Add(-time.Hour), timerTasks[0].GetVisibilityTime())
+	s.Equal(fireTime, timerTasks[1].GetVisibilityTime())
+}
+
 func (s *mutableStateSuite) TestGetCloseVersion() {
 	s.mockEventsCache.EXPECT().PutEvent(gomock.Any(), gomock.Any()).AnyTimes()
 
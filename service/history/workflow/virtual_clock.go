@@ -0,0 +1,36 @@
+package workflow
+
+import (
+	"time"
+
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/service/history/configs"
+	"go.temporal.io/server/service/history/tasks"
+)
+
+// VirtualClockOffset returns how far the virtual clock of a test namespace is ahead of the real clock.
+// It is 0 for namespaces which don't have the virtual clock enabled.
+func VirtualClockOffset(
+	config *configs.Config,
+	namespaceEntry *namespace.Namespace,
+) time.Duration {
+	if !config.EnableVirtualClock(namespaceEntry.Name().String()) {
+		return 0
+	}
+	return namespaceEntry.VirtualClockOffset()
+}
+
+// followsVirtualClock returns true for the timer tasks which fire by the virtual clock of a test namespace. Timeouts
+// of workflow tasks and activities detect unresponsive workers and keep following the real clock.
+func followsVirtualClock(task tasks.Task) bool {
+	switch task.(type) {
+	case *tasks.UserTimerTask,
+		*tasks.ActivityRetryTimerTask,
+		*tasks.WorkflowBackoffTimerTask,
+		*tasks.WorkflowRunTimeoutTask,
+		*tasks.WorkflowExecutionTimeoutTask:
+		return true
+	default:
+		return false
+	}
+}
@@ -303,3 +303,38 @@ func (s *BacklogManagerTestSuite) TestApproximateBacklogCount_NotIncrementedBySp
 	s.Equal(int64(0), s.blm.TotalApproximateBacklogCount(),
 		"backlog count should not be incremented")
 }
+
+func (s *BacklogManagerTestSuite) TestFairnessSubqueues() {
+	if !s.newMatcher {
+		s.T().Skip("fairness subqueues are only in the new backlog manager")
+	}
+	blm := s.blm.(*priBacklogManagerImpl)
+	blm.config.EnableFairness = func() bool { return true }
+	blm.config.MaxFairnessSubqueues = func() int { return 2 }
+
+	s.blm.Start()
+	defer s.blm.Stop()
+	s.NoError(s.blm.WaitUntilInitialized(context.Background()))
+
+	subqueueFor := func(fairnessKey string) int {
+		return blm.getSubqueueForTask(&persistencespb.TaskInfo{FairnessKey: fairnessKey})
+	}
+	unkeyed := subqueueFor("")
+	a := subqueueFor("a")
+	b := subqueueFor("b")
+	s.NotEqual(unkeyed, a)
+	s.NotEqual(unkeyed, b)
+	s.NotEqual(a, b)
+	s.Equal(a, subqueueFor("a"), "a key keeps its subqueue")
+	s.Equal(unkeyed, subqueueFor("c"), "keys over the limit share the subqueue of their priority level")
+
+	// the key is persisted with the subqueue
+	blm.db.Lock()
+	subqueues := blm.db.cloneSubqueues()
+	blm.db.Unlock()
+	s.Equal("a", subqueues[a].Key.GetFairnessKey())
+	s.Equal("b", subqueues[b].Key.GetFairnessKey())
+
+	blm.config.EnableFairness = func() bool { return false }
+	s.Equal(unkeyed, subqueueFor("a"), "keys are ignored with fairness disabled")
+}
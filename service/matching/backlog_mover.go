@@ -0,0 +1,251 @@
+package matching
+
+import (
+	"context"
+	"encoding/binary"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/emirpasic/gods/maps/treemap"
+	godsutils "github.com/emirpasic/gods/utils"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+	taskqueuepb "go.temporal.io/api/taskqueue/v1"
+	"go.temporal.io/server/api/matchingservice/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/worker_versioning"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+const (
+	defaultMoveBacklogTasksBatchSize = 100
+)
+
+type (
+	// backlogTaskFilter selects backlog tasks by workflow ID prefix, age and build ID. Empty fields select all tasks.
+	backlogTaskFilter struct {
+		workflowIDPrefix string
+		minAge           time.Duration
+		buildID          string
+		now              time.Time
+	}
+
+	// movedBacklogTasks holds the IDs of the tasks moved from the backlog of a physical queue to another task queue.
+	// Tasks which were read into memory before they were moved are dropped instead of dispatched, so they aren't
+	// dispatched from both task queues.
+	movedBacklogTasks struct {
+		sync.Mutex
+		// taskIDs holds the IDs of the moved tasks of each subqueue, sorted to forget them once they're acked.
+		taskIDs map[int]*treemap.Map
+	}
+
+	// movedTaskValidator drops the moved backlog tasks before validating tasks with the wrapped validator.
+	movedTaskValidator struct {
+		taskValidator
+		moved *movedBacklogTasks
+	}
+)
+
+// moveBacklogTasks moves one page of the backlog tasks of the queue which are selected by the filters of the request
+// to the destination task queue. A task is added to the destination before it's deleted from the backlog, so a task
+// may be added twice if a move is retried, or if it was dispatched by the queue while it was moved, but it's never
+// lost. A duplicate task is dropped when it's dispatched, since history only starts a task once.
+func moveBacklogTasks(
+	ctx context.Context,
+	db *taskQueueDB,
+	moved *movedBacklogTasks,
+	matchingClient matchingservice.MatchingServiceClient,
+	request *matchingservice.MoveTaskQueueTasksRequest,
+) (*matchingservice.MoveTaskQueueTasksResponse, error) {
+	subqueue := int(request.GetSubqueue())
+	inclusiveMinTaskID, exclusiveMaxTaskID, err := db.backlogRange(subqueue)
+	if err != nil {
+		return nil, err
+	}
+	// the tasks below the ack level were dispatched or dropped already
+	moved.prune(subqueue, inclusiveMinTaskID)
+	if len(request.GetNextPageToken()) > 0 {
+		if len(request.GetNextPageToken()) != 8 {
+			return nil, serviceerror.NewInvalidArgument("invalid next page token")
+		}
+		inclusiveMinTaskID = max(inclusiveMinTaskID, int64(binary.BigEndian.Uint64(request.GetNextPageToken())))
+	}
+	batchSize := int(request.GetBatchSize())
+	if batchSize <= 0 {
+		batchSize = defaultMoveBacklogTasksBatchSize
+	}
+	resp := &matchingservice.MoveTaskQueueTasksResponse{}
+	if inclusiveMinTaskID >= exclusiveMaxTaskID {
+		return resp, nil
+	}
+
+	tasks, err := db.GetTasks(ctx, subqueue, inclusiveMinTaskID, exclusiveMaxTaskID, batchSize)
+	if err != nil {
+		return nil, err
+	}
+	filter := backlogTaskFilter{
+		workflowIDPrefix: request.GetWorkflowIdPrefix(),
+		minAge:           request.GetMinTaskAge().AsDuration(),
+		buildID:          request.GetBuildId(),
+		now:              time.Now(),
+	}
+	for _, task := range tasks.Tasks {
+		resp.ScannedCount++
+		if !filter.matches(task.GetData()) {
+			continue
+		}
+		resp.SelectedCount++
+		if request.GetDryRun() {
+			continue
+		}
+		if err := addBacklogTask(ctx, matchingClient, db.queue.TaskType(), request.GetDestinationTaskQueue(), task); err != nil {
+			return nil, err
+		}
+		if !request.GetCopy() {
+			// the queue drops the task instead of dispatching it if it was read into memory already
+			moved.add(subqueue, task.GetTaskId())
+			if err := db.CompleteTask(ctx, subqueue, task.GetTaskId()); err != nil {
+				return nil, err
+			}
+		}
+		resp.MovedCount++
+	}
+	if len(tasks.NextPageToken) > 0 && len(tasks.Tasks) > 0 {
+		resp.NextPageToken = binary.BigEndian.AppendUint64(nil, uint64(tasks.Tasks[len(tasks.Tasks)-1].GetTaskId()+1))
+	}
+	return resp, nil
+}
+
+// addBacklogTask adds a backlog task to the task queue like a new task, so it's spread over the partitions of the task
+// queue and its versioning rules apply to it.
+func addBacklogTask(
+	ctx context.Context,
+	matchingClient matchingservice.MatchingServiceClient,
+	taskType enumspb.TaskQueueType,
+	taskQueue string,
+	task *persistencespb.AllocatedTaskInfo,
+) error {
+	data := task.GetData()
+	var scheduleToStartTimeout *durationpb.Duration
+	if data.GetExpiryTime() != nil {
+		remaining := time.Until(data.GetExpiryTime().AsTime())
+		if remaining <= 0 {
+			// expired, it would be dropped by the destination anyway
+			return nil
+		}
+		scheduleToStartTimeout = durationpb.New(remaining)
+	}
+
+	execution := &commonpb.WorkflowExecution{WorkflowId: data.GetWorkflowId(), RunId: data.GetRunId()}
+	switch taskType {
+	case enumspb.TASK_QUEUE_TYPE_WORKFLOW:
+		_, err := matchingClient.AddWorkflowTask(ctx, &matchingservice.AddWorkflowTaskRequest{
+			NamespaceId: data.GetNamespaceId(),
+			Execution:   execution,
+			TaskQueue: &taskqueuepb.TaskQueue{
+				Name: taskQueue,
+				Kind: enumspb.TASK_QUEUE_KIND_NORMAL,
+			},
+			ScheduledEventId:       data.GetScheduledEventId(),
+			Clock:                  data.GetClock(),
+			ScheduleToStartTimeout: scheduleToStartTimeout,
+			VersionDirective:       data.GetVersionDirective(),
+			Priority:               data.GetPriority(),
+			FairnessKey:            data.GetFairnessKey(),
+		})
+		return err
+	case enumspb.TASK_QUEUE_TYPE_ACTIVITY:
+		_, err := matchingClient.AddActivityTask(ctx, &matchingservice.AddActivityTaskRequest{
+			NamespaceId: data.GetNamespaceId(),
+			Execution:   execution,
+			TaskQueue: &taskqueuepb.TaskQueue{
+				Name: taskQueue,
+				Kind: enumspb.TASK_QUEUE_KIND_NORMAL,
+			},
+			ScheduledEventId:       data.GetScheduledEventId(),
+			Clock:                  data.GetClock(),
+			ScheduleToStartTimeout: scheduleToStartTimeout,
+			VersionDirective:       data.GetVersionDirective(),
+			Stamp:                  data.GetStamp(),
+			Priority:               data.GetPriority(),
+			FairnessKey:            data.GetFairnessKey(),
+		})
+		return err
+	default:
+		return errInvalidTaskQueueType
+	}
+}
+
+func newMovedBacklogTasks() *movedBacklogTasks {
+	return &movedBacklogTasks{taskIDs: make(map[int]*treemap.Map)}
+}
+
+func (m *movedBacklogTasks) add(subqueue int, taskID int64) {
+	m.Lock()
+	defer m.Unlock()
+	taskIDs, ok := m.taskIDs[subqueue]
+	if !ok {
+		taskIDs = treemap.NewWith(godsutils.Int64Comparator)
+		m.taskIDs[subqueue] = taskIDs
+	}
+	taskIDs.Put(taskID, struct{}{})
+}
+
+// take returns whether the task was moved, and forgets it since a task is read into memory at most once.
+func (m *movedBacklogTasks) take(taskID int64) bool {
+	m.Lock()
+	defer m.Unlock()
+	for _, taskIDs := range m.taskIDs {
+		if _, ok := taskIDs.Get(taskID); ok {
+			taskIDs.Remove(taskID)
+			return true
+		}
+	}
+	return false
+}
+
+// prune forgets the moved tasks of the subqueue below its ack level, they won't be read again.
+func (m *movedBacklogTasks) prune(subqueue int, inclusiveMinTaskID int64) {
+	m.Lock()
+	defer m.Unlock()
+	taskIDs, ok := m.taskIDs[subqueue]
+	if !ok {
+		return
+	}
+	for minTaskID, _ := taskIDs.Min(); minTaskID != nil && minTaskID.(int64) < inclusiveMinTaskID; minTaskID, _ = taskIDs.Min() {
+		taskIDs.Remove(minTaskID)
+	}
+}
+
+func (v *movedTaskValidator) maybeValidate(
+	task *persistencespb.AllocatedTaskInfo,
+	taskType enumspb.TaskQueueType,
+) bool {
+	if v.moved.take(task.GetTaskId()) {
+		return false
+	}
+	return v.taskValidator.maybeValidate(task, taskType)
+}
+
+func (f backlogTaskFilter) matches(data *persistencespb.TaskInfo) bool {
+	if !strings.HasPrefix(data.GetWorkflowId(), f.workflowIDPrefix) {
+		return false
+	}
+	if f.minAge > 0 && f.now.Sub(data.GetCreateTime().AsTime()) < f.minAge {
+		return false
+	}
+	if f.buildID != "" && backlogTaskBuildID(data) != f.buildID {
+		return false
+	}
+	return true
+}
+
+func backlogTaskBuildID(data *persistencespb.TaskInfo) string {
+	directive := data.GetVersionDirective()
+	if buildID := directive.GetAssignedBuildId(); buildID != "" {
+		return buildID
+	}
+	return worker_versioning.DirectiveDeployment(directive).GetBuildId()
+}
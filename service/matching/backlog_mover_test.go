@@ -0,0 +1,33 @@
+package matching
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	enumspb "go.temporal.io/api/enums/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+)
+
+type validTaskValidator struct{}
+
+func (validTaskValidator) maybeValidate(*persistencespb.AllocatedTaskInfo, enumspb.TaskQueueType) bool {
+	return true
+}
+
+func TestMovedTaskValidator(t *testing.T) {
+	moved := newMovedBacklogTasks()
+	validator := &movedTaskValidator{taskValidator: validTaskValidator{}, moved: moved}
+	moved.add(0, 10)
+	moved.add(0, 11)
+	moved.add(1, 12)
+
+	require.False(t, validator.maybeValidate(&persistencespb.AllocatedTaskInfo{TaskId: 10}, enumspb.TASK_QUEUE_TYPE_WORKFLOW))
+	// a moved task is only dropped once
+	require.True(t, validator.maybeValidate(&persistencespb.AllocatedTaskInfo{TaskId: 10}, enumspb.TASK_QUEUE_TYPE_WORKFLOW))
+	require.True(t, validator.maybeValidate(&persistencespb.AllocatedTaskInfo{TaskId: 13}, enumspb.TASK_QUEUE_TYPE_WORKFLOW))
+
+	// the acked tasks of subqueue 0 are forgotten, the ones of subqueue 1 are kept
+	moved.prune(0, 13)
+	require.False(t, moved.take(11))
+	require.True(t, moved.take(12))
+}
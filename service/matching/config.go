@@ -71,6 +71,14 @@ type (
 		MembershipUnloadDelay                    dynamicconfig.DurationPropertyFn
 		TaskQueueInfoByBuildIdTTL                dynamicconfig.DurationPropertyFnWithTaskQueueFilter
 		PriorityLevels                           dynamicconfig.IntPropertyFnWithTaskQueueFilter
+		EnableFairness                           dynamicconfig.BoolPropertyFnWithTaskQueueFilter
+		MaxFairnessSubqueues                     dynamicconfig.IntPropertyFnWithTaskQueueFilter
+		FairnessKeyWeights                       dynamicconfig.TypedPropertyFnWithTaskQueueFilter[map[string]float64]
+		WorkerIdentityDispatchRate               dynamicconfig.TypedPropertyFnWithTaskQueueFilter[map[string]float64]
+		WorkerBuildIDDispatchRate                dynamicconfig.TypedPropertyFnWithTaskQueueFilter[map[string]float64]
+		WorkerIdentityMaxInFlight                dynamicconfig.TypedPropertyFnWithTaskQueueFilter[map[string]int]
+		WorkerBuildIDMaxInFlight                 dynamicconfig.TypedPropertyFnWithTaskQueueFilter[map[string]int]
+		WorkerInFlightTimeout                    dynamicconfig.DurationPropertyFnWithTaskQueueFilter
 
 		// Time to hold a poll request before returning an empty response if there are no tasks
 		LongPollExpirationInterval dynamicconfig.DurationPropertyFnWithTaskQueueFilter
@@ -95,6 +103,7 @@ type (
 		VisibilityPersistenceSlowQueryThreshold dynamicconfig.DurationPropertyFn
 		EnableReadFromSecondaryVisibility       dynamicconfig.BoolPropertyFnWithNamespaceFilter
 		VisibilityEnableShadowReadMode          dynamicconfig.BoolPropertyFn
+		VisibilityShadowReadVerificationRate    dynamicconfig.FloatPropertyFnWithNamespaceFilter
 		VisibilityDisableOrderByClause          dynamicconfig.BoolPropertyFnWithNamespaceFilter
 		VisibilityEnableManualPagination        dynamicconfig.BoolPropertyFnWithNamespaceFilter
 
@@ -134,6 +143,14 @@ type (
 		MaxTaskDeleteBatchSize     func() int
 		TaskDeleteInterval         func() time.Duration
 		PriorityLevels             func() int32
+		EnableFairness             func() bool
+		MaxFairnessSubqueues       func() int
+		FairnessKeyWeights         func() map[string]float64
+		WorkerIdentityDispatchRate func() map[string]float64
+		WorkerBuildIDDispatchRate  func() map[string]float64
+		WorkerIdentityMaxInFlight  func() map[string]int
+		WorkerBuildIDMaxInFlight   func() map[string]int
+		WorkerInFlightTimeout      func() time.Duration
 
 		GetUserDataLongPollTimeout dynamicconfig.DurationPropertyFn
 		GetUserDataMinWaitTime     time.Duration
@@ -267,6 +284,14 @@ func NewConfig(
 		MembershipUnloadDelay:                    dynamicconfig.MatchingMembershipUnloadDelay.Get(dc),
 		TaskQueueInfoByBuildIdTTL:                dynamicconfig.TaskQueueInfoByBuildIdTTL.Get(dc),
 		PriorityLevels:                           dynamicconfig.MatchingPriorityLevels.Get(dc),
+		EnableFairness:                           dynamicconfig.MatchingEnableFairness.Get(dc),
+		MaxFairnessSubqueues:                     dynamicconfig.MatchingMaxFairnessSubqueues.Get(dc),
+		FairnessKeyWeights:                       dynamicconfig.MatchingFairnessKeyWeights.Get(dc),
+		WorkerIdentityDispatchRate:               dynamicconfig.MatchingWorkerIdentityDispatchRate.Get(dc),
+		WorkerBuildIDDispatchRate:                dynamicconfig.MatchingWorkerBuildIDDispatchRate.Get(dc),
+		WorkerIdentityMaxInFlight:                dynamicconfig.MatchingWorkerIdentityMaxInFlight.Get(dc),
+		WorkerBuildIDMaxInFlight:                 dynamicconfig.MatchingWorkerBuildIDMaxInFlight.Get(dc),
+		WorkerInFlightTimeout:                    dynamicconfig.MatchingWorkerInFlightTimeout.Get(dc),
 		MatchingDropNonRetryableTasks:            dynamicconfig.MatchingDropNonRetryableTasks.Get(dc),
 		MaxIDLengthLimit:                         dynamicconfig.MaxIDLengthLimit.Get(dc),
 
@@ -280,6 +305,7 @@ func NewConfig(
 		VisibilityPersistenceSlowQueryThreshold: dynamicconfig.VisibilityPersistenceSlowQueryThreshold.Get(dc),
 		EnableReadFromSecondaryVisibility:       dynamicconfig.EnableReadFromSecondaryVisibility.Get(dc),
 		VisibilityEnableShadowReadMode:          dynamicconfig.VisibilityEnableShadowReadMode.Get(dc),
+		VisibilityShadowReadVerificationRate:    dynamicconfig.VisibilityShadowReadVerificationRate.Get(dc),
 		VisibilityDisableOrderByClause:          dynamicconfig.VisibilityDisableOrderByClause.Get(dc),
 		VisibilityEnableManualPagination:        dynamicconfig.VisibilityEnableManualPagination.Get(dc),
 
@@ -343,6 +369,30 @@ func newTaskQueueConfig(tq *tqid.TaskQueue, config *Config, ns namespace.Name) *
 		PriorityLevels: func() int32 {
 			return int32(config.PriorityLevels(ns.String(), taskQueueName, taskType))
 		},
+		EnableFairness: func() bool {
+			return config.EnableFairness(ns.String(), taskQueueName, taskType)
+		},
+		MaxFairnessSubqueues: func() int {
+			return config.MaxFairnessSubqueues(ns.String(), taskQueueName, taskType)
+		},
+		FairnessKeyWeights: func() map[string]float64 {
+			return config.FairnessKeyWeights(ns.String(), taskQueueName, taskType)
+		},
+		WorkerIdentityDispatchRate: func() map[string]float64 {
+			return config.WorkerIdentityDispatchRate(ns.String(), taskQueueName, taskType)
+		},
+		WorkerBuildIDDispatchRate: func() map[string]float64 {
+			return config.WorkerBuildIDDispatchRate(ns.String(), taskQueueName, taskType)
+		},
+		WorkerIdentityMaxInFlight: func() map[string]int {
+			return config.WorkerIdentityMaxInFlight(ns.String(), taskQueueName, taskType)
+		},
+		WorkerBuildIDMaxInFlight: func() map[string]int {
+			return config.WorkerBuildIDMaxInFlight(ns.String(), taskQueueName, taskType)
+		},
+		WorkerInFlightTimeout: func() time.Duration {
+			return config.WorkerInFlightTimeout(ns.String(), taskQueueName, taskType)
+		},
 		GetUserDataLongPollTimeout: config.GetUserDataLongPollTimeout,
 		GetUserDataMinWaitTime:     1 * time.Second,
 		GetUserDataReturnBudget:    returnEmptyTaskTimeBudget,
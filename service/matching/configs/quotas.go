@@ -30,6 +30,7 @@ var (
 		"/temporal.server.api.matchingservice.v1.MatchingService/ForceUnloadTaskQueuePartition":          1,
 		"/temporal.server.api.matchingservice.v1.MatchingService/ForceUnloadTaskQueue":                   1,
 		"/temporal.server.api.matchingservice.v1.MatchingService/ForceLoadTaskQueuePartition":            1,
+		"/temporal.server.api.matchingservice.v1.MatchingService/MoveTaskQueueTasks":                     1,
 		"/temporal.server.api.matchingservice.v1.MatchingService/UpdateTaskQueueUserData":                1,
 		"/temporal.server.api.matchingservice.v1.MatchingService/ReplicateTaskQueueUserData":             1,
 		"/temporal.server.api.matchingservice.v1.MatchingService/CheckTaskQueueUserDataPropagation":      1,
@@ -45,6 +46,8 @@ var (
 		"/temporal.server.api.matchingservice.v1.MatchingService/GetWorkerVersioningRules":               1,
 		"/temporal.server.api.matchingservice.v1.MatchingService/DescribeTaskQueuePartition":             1,
 		"/temporal.server.api.matchingservice.v1.MatchingService/SyncDeploymentUserData":                 1,
+		"/temporal.server.api.matchingservice.v1.MatchingService/ReleaseWorkerInFlightTask":              1,
+		"/temporal.server.api.matchingservice.v1.MatchingService/UpdateWorkerDispatchLimits":             1,
 	}
 
 	APIPrioritiesOrdered = []int{0, 1}
@@ -436,6 +436,48 @@ func (db *taskQueueDB) CompleteTasksLessThan(
 	return n, err
 }
 
+// CompleteTask deletes a single task of the backlog which wasn't dispatched, e.g. because it was moved to another
+// task queue, and decrements the backlog count.
+func (db *taskQueueDB) CompleteTask(
+	ctx context.Context,
+	subqueue int,
+	taskID int64,
+) error {
+	err := db.store.CompleteTask(ctx, &persistence.CompleteTaskRequest{
+		NamespaceID:   db.queue.NamespaceId(),
+		TaskQueueName: db.queue.PersistenceName(),
+		TaskType:      db.queue.TaskType(),
+		Subqueue:      subqueue,
+		TaskID:        taskID,
+	})
+	if err != nil {
+		db.logger.Error("Persistent store operation failure",
+			tag.StoreOperationCompleteTask,
+			tag.Error(err),
+			tag.TaskID(taskID),
+			tag.WorkflowTaskQueueType(db.queue.TaskType()),
+			tag.WorkflowTaskQueueName(db.queue.PersistenceName()),
+		)
+		return err
+	}
+
+	db.Lock()
+	defer db.Unlock()
+	db.lastChange = time.Now()
+	db.updateBacklogStatsLocked(subqueue, -1, db.subqueues[subqueue].oldestTime)
+	return nil
+}
+
+// backlogRange returns the range of the IDs of the tasks of the subqueue which weren't acked yet.
+func (db *taskQueueDB) backlogRange(subqueue int) (inclusiveMinTaskID int64, exclusiveMaxTaskID int64, err error) {
+	db.Lock()
+	defer db.Unlock()
+	if subqueue < 0 || subqueue >= len(db.subqueues) {
+		return 0, 0, serviceerror.NewInvalidArgument(fmt.Sprintf("subqueue %d doesn't exist", subqueue))
+	}
+	return db.subqueues[subqueue].AckLevel + 1, db.subqueues[subqueue].maxReadLevel + 1, nil
+}
+
 func (db *taskQueueDB) AllocateSubqueue(
 	ctx context.Context,
 	key *persistencespb.SubqueueKey,
@@ -489,9 +531,9 @@ func (db *taskQueueDB) cachedQueueInfo() *persistencespb.TaskQueueInfo {
 	}
 }
 
-// emitBacklogGaugesLocked emits the approximate_backlog_count, approximate_backlog_age_seconds, and the legacy
-// task_lag_per_tl gauges. For these gauges to be emitted, BreakdownMetricsByTaskQueue and BreakdownMetricsByPartition
-// should be enabled. Additionally, for versioned queues, BreakdownMetricsByBuildID should also be enabled.
+// emitBacklogGaugesLocked emits the approximate_backlog_count, approximate_backlog_age_seconds,
+// fairness_key_approximate_backlog_count, and the legacy task_lag_per_tl gauges. For these gauges to be emitted,
+// BreakdownMetricsByTaskQueue and BreakdownMetricsByPartition should be enabled. Additionally, for versioned queues, BreakdownMetricsByBuildID should also be enabled.
 func (db *taskQueueDB) emitBacklogGaugesLocked() {
 	if !db.config.BreakdownMetricsByTaskQueue() ||
 		!db.config.BreakdownMetricsByPartition() ||
@@ -516,6 +558,20 @@ func (db *taskQueueDB) emitBacklogGaugesLocked() {
 		metrics.ApproximateBacklogAgeSeconds.With(db.metricsHandler).Record(time.Since(oldestTime).Seconds())
 	}
 	metrics.TaskLagPerTaskQueueGauge.With(db.metricsHandler).Record(float64(totalLag))
+
+	// backlog of the fairness subqueues, only for the keys with a configured weight to bound the cardinality
+	weights := db.config.FairnessKeyWeights()
+	fairnessBacklogCounts := make(map[string]int64)
+	for _, s := range db.subqueues {
+		if key := s.Key.GetFairnessKey(); key != "" {
+			if _, ok := weights[key]; ok {
+				fairnessBacklogCounts[key] += s.ApproximateBacklogCount
+			}
+		}
+	}
+	for key, count := range fairnessBacklogCounts {
+		metrics.FairnessKeyApproximateBacklogCount.With(db.metricsHandler).Record(float64(count), metrics.FairnessKeyTag(key))
+	}
 }
 
 func (db *taskQueueDB) ensureDefaultSubqueuesLocked(
@@ -0,0 +1,141 @@
+package matching
+
+import (
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	"go.temporal.io/server/common/metrics"
+)
+
+const defaultFairnessWeight = 1.0
+
+type (
+	// fairnessScheduler arranges the tasks of each priority level fairly by fairness key, using
+	// stride scheduling: each task is assigned a pass, which advances by 1/weight for each task
+	// of the same key, and tasks are matched in order of pass. This results in weighted
+	// round-robin across the keys that have waiting tasks. A key with no waiting tasks doesn't
+	// accumulate credit: its next task starts at the pass of the last matched task of the level.
+	//
+	// fairnessScheduler is not safe for concurrent use, it's under matcherData.lock.
+	fairnessScheduler struct {
+		config         *taskQueueConfig
+		metricsHandler metrics.Handler
+
+		levels map[int32]*fairnessLevel
+		// number of local backlog tasks per key, across priority levels, for metrics
+		backlog map[string]int
+	}
+
+	fairnessLevel struct {
+		pass float64 // pass of the last matched task of the level
+		keys map[string]*fairnessKeyState
+	}
+
+	fairnessKeyState struct {
+		pass  float64 // pass of the last added task of the key
+		tasks int     // number of waiting tasks of the key
+	}
+)
+
+func newFairnessScheduler(config *taskQueueConfig, metricsHandler metrics.Handler) fairnessScheduler {
+	return fairnessScheduler{
+		config:         config,
+		metricsHandler: metricsHandler,
+		levels:         make(map[int32]*fairnessLevel),
+		backlog:        make(map[string]int),
+	}
+}
+
+// add assigns the fairness key and pass of a task entering the matcher.
+func (f *fairnessScheduler) add(task *internalTask) {
+	if task.isPollForwarder {
+		return
+	}
+	if !f.config.EnableFairness() {
+		// fairness is disabled: all tasks have the same pass, so they're ordered by task id
+		task.fairnessKey, task.fairnessPass = "", 0
+		return
+	}
+	key := task.getFairnessKey()
+
+	priority := task.getPriority().GetPriorityKey()
+	level, ok := f.levels[priority]
+	if !ok {
+		level = &fairnessLevel{keys: make(map[string]*fairnessKeyState)}
+		f.levels[priority] = level
+	}
+	state, ok := level.keys[key]
+	if !ok {
+		state = &fairnessKeyState{}
+		level.keys[key] = state
+	}
+	state.pass = max(state.pass, level.pass) + 1/f.weight(key)
+	state.tasks++
+
+	task.fairnessKey, task.fairnessPass = key, state.pass
+	task.fairnessTracked = true
+
+	if isLocalBacklogTask(task) {
+		f.backlog[key]++
+		f.emitBacklogGauge(key)
+	}
+}
+
+// remove releases the state of a task leaving the matcher, whether it was matched or not.
+func (f *fairnessScheduler) remove(task *internalTask) {
+	if !task.fairnessTracked {
+		return
+	}
+	task.fairnessTracked = false
+
+	priority := task.getPriority().GetPriorityKey()
+	if level, ok := f.levels[priority]; ok {
+		if state, ok := level.keys[task.fairnessKey]; ok {
+			if state.tasks--; state.tasks <= 0 {
+				delete(level.keys, task.fairnessKey)
+			}
+		}
+		if len(level.keys) == 0 {
+			delete(f.levels, priority)
+		}
+	}
+
+	if isLocalBacklogTask(task) {
+		f.backlog[task.fairnessKey]--
+		f.emitBacklogGauge(task.fairnessKey)
+		if f.backlog[task.fairnessKey] <= 0 {
+			delete(f.backlog, task.fairnessKey)
+		}
+	}
+}
+
+// matched advances the pass of the priority level of a matched task. Call after remove.
+func (f *fairnessScheduler) matched(task *internalTask) {
+	if level, ok := f.levels[task.getPriority().GetPriorityKey()]; ok {
+		level.pass = max(level.pass, task.fairnessPass)
+	}
+}
+
+func (f *fairnessScheduler) weight(key string) float64 {
+	if weight, ok := f.config.FairnessKeyWeights()[key]; ok && weight > 0 {
+		return weight
+	}
+	return defaultFairnessWeight
+}
+
+// emitBacklogGauge emits the backlog of the keys with a configured weight only, to bound the
+// cardinality of the metric.
+func (f *fairnessScheduler) emitBacklogGauge(key string) {
+	if !f.config.BreakdownMetricsByTaskQueue() {
+		return
+	}
+	if _, ok := f.config.FairnessKeyWeights()[key]; !ok {
+		return
+	}
+	metrics.FairnessKeyBacklogCount.With(f.metricsHandler).Record(
+		float64(f.backlog[key]),
+		metrics.FairnessKeyTag(key),
+	)
+}
+
+func isLocalBacklogTask(task *internalTask) bool {
+	return task.source == enumsspb.TASK_SOURCE_DB_BACKLOG && task.forwardInfo == nil
+}
@@ -428,6 +428,30 @@ func (h *Handler) ForceUnloadTaskQueuePartition(
 	return h.engine.ForceUnloadTaskQueuePartition(ctx, request)
 }
 
+func (h *Handler) MoveTaskQueueTasks(
+	ctx context.Context,
+	request *matchingservice.MoveTaskQueueTasksRequest,
+) (_ *matchingservice.MoveTaskQueueTasksResponse, retError error) {
+	defer log.CapturePanic(h.logger, &retError)
+	return h.engine.MoveTaskQueueTasks(ctx, request)
+}
+
+func (h *Handler) ReleaseWorkerInFlightTask(
+	ctx context.Context,
+	request *matchingservice.ReleaseWorkerInFlightTaskRequest,
+) (_ *matchingservice.ReleaseWorkerInFlightTaskResponse, retError error) {
+	defer log.CapturePanic(h.logger, &retError)
+	return h.engine.ReleaseWorkerInFlightTask(ctx, request)
+}
+
+func (h *Handler) UpdateWorkerDispatchLimits(
+	ctx context.Context,
+	request *matchingservice.UpdateWorkerDispatchLimitsRequest,
+) (_ *matchingservice.UpdateWorkerDispatchLimitsResponse, retError error) {
+	defer log.CapturePanic(h.logger, &retError)
+	return h.engine.UpdateWorkerDispatchLimits(ctx, request)
+}
+
 func (h *Handler) ForceLoadTaskQueuePartition(
 	ctx context.Context,
 	request *matchingservice.ForceLoadTaskQueuePartitionRequest,
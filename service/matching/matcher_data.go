@@ -8,8 +8,10 @@ import (
 	"time"
 
 	enumsspb "go.temporal.io/server/api/enums/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
 	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/softassert"
 	"go.temporal.io/server/common/util"
 )
@@ -77,6 +79,9 @@ type taskPQ struct {
 
 	// rate limiter for overall queue
 	wholeQueueLimiter simpleLimiter
+
+	// fairness across keys within each priority level
+	fairness fairnessScheduler
 }
 
 func (t *taskPQ) Add(task *internalTask) {
@@ -119,7 +124,7 @@ func (t *taskPQ) Less(i int, j int) bool {
 	// - ready time: to sort all ready tasks ahead of others, or else find the earliest ready task
 	// - isPollForwarder: forwarding polls should happen only if there are no other tasks
 	// - priority key: to sort tasks by priority
-	// - fairness key pass: to arrange tasks fairly by key
+	// - fairness key pass: to arrange tasks fairly by key (see fairnessScheduler)
 	// - ordering key: to sort tasks by ordering key
 	// - task id: last resort comparison
 
@@ -151,6 +156,13 @@ func (t *taskPQ) Less(i int, j int) bool {
 		return false
 	}
 
+	// try fairness key pass
+	if a.fairnessPass < b.fairnessPass {
+		return true
+	} else if a.fairnessPass > b.fairnessPass {
+		return false
+	}
+
 	// Note: sync match tasks have a fixed negative id.
 	// Query tasks will get 0 here.
 	var aid, bid int64
@@ -175,6 +187,7 @@ func (t *taskPQ) Push(x any) {
 	task := x.(*internalTask) // nolint:revive
 	task.matchHeapIndex = len(t.heap)
 	t.heap = append(t.heap, task)
+	t.fairness.add(task)
 
 	if task.source == enumsspb.TASK_SOURCE_DB_BACKLOG && task.forwardInfo == nil {
 		t.ages.record(task.event.Data.CreateTime, 1)
@@ -187,6 +200,7 @@ func (t *taskPQ) Pop() any {
 	task := t.heap[last]
 	t.heap = t.heap[:last]
 	task.matchHeapIndex = invalidHeapIndex
+	t.fairness.remove(task)
 
 	if task.source == enumsspb.TASK_SOURCE_DB_BACKLOG && task.forwardInfo == nil {
 		t.ages.record(task.event.Data.CreateTime, -1)
@@ -204,6 +218,7 @@ func (t *taskPQ) ForEachTask(pred func(*internalTask) bool, post func(*internalT
 			return false
 		}
 		task.matchHeapIndex = invalidHeapIndex - 1 // maintain heap/index invariant
+		t.fairness.remove(task)
 		if task.source == enumsspb.TASK_SOURCE_DB_BACKLOG && task.forwardInfo == nil {
 			t.ages.record(task.event.Data.CreateTime, -1)
 		}
@@ -233,18 +248,29 @@ type matcherData struct {
 	pollers pollerPQ
 	tasks   taskPQ
 
+	// rate and in-flight limits per worker identity and build ID
+	workerLimits workerRateLimits
+
 	lastPoller time.Time // most recent poll start time
 }
 
-func newMatcherData(config *taskQueueConfig, logger log.Logger, timeSource clock.TimeSource, canForward bool) matcherData {
+func newMatcherData(
+	config *taskQueueConfig,
+	logger log.Logger,
+	metricsHandler metrics.Handler,
+	timeSource clock.TimeSource,
+	canForward bool,
+) matcherData {
 	return matcherData{
 		config:     config,
 		logger:     logger,
 		timeSource: timeSource,
 		canForward: canForward,
 		tasks: taskPQ{
-			ages: newBacklogAgeTracker(),
+			ages:     newBacklogAgeTracker(),
+			fairness: newFairnessScheduler(config, metricsHandler),
 		},
+		workerLimits: newWorkerRateLimits(config),
 	}
 }
 
@@ -255,6 +281,26 @@ func (d *matcherData) UpdateRateLimit(rate float64, burstDuration time.Duration)
 	d.tasks.wholeQueueLimiter.set(rate, burstDuration)
 }
 
+// UpdateWorkerDispatchLimits sets the worker limits stored in the task queue user data.
+func (d *matcherData) UpdateWorkerDispatchLimits(limits *persistencespb.WorkerDispatchLimits) {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	d.workerLimits.setUserDataLimits(limits)
+	d.findAndWakeMatches() // limits may be higher now
+}
+
+// ReleaseWorkerInFlightTask is called when the worker responded to a task, so that the task
+// doesn't count against the max in-flight of the worker anymore.
+func (d *matcherData) ReleaseWorkerInFlightTask(inFlightID string) {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	if d.workerLimits.releaseInFlight(inFlightID) {
+		d.findAndWakeMatches() // a poller of the worker may be able to match now
+	}
+}
+
 func (d *matcherData) EnqueueTaskNoWait(task *internalTask) {
 	d.lock.Lock()
 	defer d.lock.Unlock()
@@ -385,10 +431,12 @@ func (d *matcherData) ReprocessTasks(pred func(*internalTask) bool) []*internalT
 }
 
 // findMatch should return the highest priority task+poller match even if the per-task rate
-// limit doesn't allow the task to be matched yet.
+// limit doesn't allow the task to be matched yet. Pollers whose worker is rate limited are
+// skipped, and if there's no match, workerReady is the earliest time one of them can match.
 // call with lock held
 // nolint:revive // will improve later
-func (d *matcherData) findMatch(allowForwarding bool) (*internalTask, *waitingPoller) {
+func (d *matcherData) findMatch(allowForwarding bool, now int64) (*internalTask, *waitingPoller, int64) {
+	var workerReady int64
 	// TODO(pri): optimize so it's not O(d*n) worst case
 	// TODO(pri): this iterates over heap as slice, which isn't quite correct, but okay for now
 	for _, task := range d.tasks.heap {
@@ -406,12 +454,17 @@ func (d *matcherData) findMatch(allowForwarding bool) (*internalTask, *waitingPo
 				continue
 			} else if poller.isTaskValidator && task.forwardCtx != nil {
 				continue
+			} else if ready := d.workerLimits.readyTimeForPoller(poller); ready > now {
+				if workerReady == 0 || ready < workerReady {
+					workerReady = ready
+				}
+				continue
 			}
 
-			return task, poller
+			return task, poller, 0
 		}
 	}
-	return nil, nil
+	return nil, nil, workerReady
 }
 
 // call with lock held
@@ -453,13 +506,19 @@ func (d *matcherData) findAndWakeMatches() {
 
 	now := d.timeSource.Now().UnixNano()
 	// TODO(pri): for task-specific ready time, we need to do a full/partial re-heapify here
+	d.workerLimits.refreshLimits(now)
 
 	for {
 		// search for highest priority match
-		task, poller := d.findMatch(allowForwarding)
+		task, poller, workerReady := d.findMatch(allowForwarding, now)
 		if task == nil || poller == nil {
-			// no more current matches, stop rate limit timer if was running
-			d.rateLimitTimer.unset()
+			// no more current matches, stop rate limit timer if was running, unless there are
+			// pollers waiting for their worker rate limit
+			if workerReady > 0 {
+				d.rateLimitTimer.set(d.timeSource, d.rematchAfterTimer, time.Duration(workerReady-now))
+			} else {
+				d.rateLimitTimer.unset()
+			}
 			return
 		}
 
@@ -472,11 +531,19 @@ func (d *matcherData) findAndWakeMatches() {
 
 		// ready to signal match
 		d.tasks.Remove(task)
+		d.tasks.fairness.matched(task)
 		d.pollers.Remove(poller)
 
 		// TODO(pri): maybe we can allow tasks to have costs other than 1
 		d.tasks.consumeTokens(now, task, 1)
+		if !task.isPollForwarder {
+			d.workerLimits.consumeTokens(now, poller, 1)
+		}
+		if task.event != nil && !task.isPollForwarder {
+			task.inFlightID = d.workerLimits.addInFlight(now, poller)
+		}
 		task.recycleToken = d.recycleToken
+		task.setInFlightTimeout = d.setInFlightTimeout
 
 		res := &matchResult{task: task, poller: poller}
 		task.wake(d.logger, res)
@@ -497,9 +564,25 @@ func (d *matcherData) recycleToken(task *internalTask) {
 
 	now := d.timeSource.Now().UnixNano()
 	d.tasks.consumeTokens(now, task, -1)
+	if task.inFlightID != "" {
+		// the task didn't reach the worker
+		d.workerLimits.releaseInFlight(task.inFlightID)
+		task.inFlightID = ""
+	}
 	d.findAndWakeMatches() // another task may be ready to match now
 }
 
+// setInFlightTimeout expires the in-flight task of a started task after the timeout of the task
+// instead of the default in-flight timeout.
+func (d *matcherData) setInFlightTimeout(task *internalTask, timeout time.Duration) {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	if d.workerLimits.setInFlightExpiration(task.inFlightID, d.timeSource.Now().UnixNano()+timeout.Nanoseconds()) {
+		d.findAndWakeMatches() // pollers waiting for an in-flight task to expire may be ready sooner
+	}
+}
+
 // called from timer
 func (d *matcherData) rematchAfterTimer() {
 	d.lock.Lock()
@@ -3,6 +3,7 @@ package matching
 import (
 	"context"
 	"errors"
+	"fmt"
 	"math/rand"
 	"runtime"
 	"sync/atomic"
@@ -18,6 +19,7 @@ import (
 	taskqueuespb "go.temporal.io/server/api/taskqueue/v1"
 	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/payloads"
 	"go.temporal.io/server/common/softassert"
 	"go.temporal.io/server/common/testing/testlogger"
@@ -45,7 +47,7 @@ func (s *MatcherDataSuite) SetupTest() {
 	logger := testlogger.NewTestLogger(s.T(), testlogger.FailOnAnyUnexpectedError)
 	s.ts = clock.NewEventTimeSource().Update(time.Now())
 	s.ts.UseAsyncTimers(true)
-	s.md = newMatcherData(cfg, logger, s.ts, true)
+	s.md = newMatcherData(cfg, logger, metrics.NoopMetricsHandler, s.ts, true)
 }
 
 func (s *MatcherDataSuite) now() time.Time {
@@ -365,6 +367,185 @@ func (s *MatcherDataSuite) TestOrder() {
 	// poll forwarder is last to match, but it does a half-match so we won't see it here
 }
 
+func (s *MatcherDataSuite) newBacklogTaskWithFairnessKey(id int64, fairnessKey string, n int, pri *commonpb.Priority) *internalTask {
+	t := s.newBacklogTaskWithPriority(id, 0, nil, pri)
+	t.event.Data.WorkflowId = fmt.Sprintf("%s-%d", fairnessKey, n)
+	t.event.Data.FairnessKey = fairnessKey
+	return t
+}
+
+func (s *MatcherDataSuite) TestFairness() {
+	s.md.config.EnableFairness = func() bool { return true }
+
+	// key a has a large backlog with lower task ids, key b gets its turn anyway
+	pri := &commonpb.Priority{PriorityKey: 3}
+	for i := range 4 {
+		s.md.EnqueueTaskNoWait(s.newBacklogTaskWithFairnessKey(int64(1+i), "a", i, pri))
+	}
+	for i := range 2 {
+		s.md.EnqueueTaskNoWait(s.newBacklogTaskWithFairnessKey(int64(10+i), "b", i, pri))
+	}
+	// a higher priority task is still first
+	s.md.EnqueueTaskNoWait(s.newBacklogTaskWithFairnessKey(20, "c", 0, &commonpb.Priority{PriorityKey: 1}))
+
+	var order []string
+	for range 7 {
+		order = append(order, s.pollFakeTime(time.Second).task.workflowExecution().GetWorkflowId())
+	}
+	s.Equal([]string{"c-0", "a-0", "b-0", "a-1", "b-1", "a-2", "a-3"}, order)
+
+	s.md.lock.Lock()
+	s.Empty(s.md.tasks.fairness.levels)
+	s.Empty(s.md.tasks.fairness.backlog)
+	s.md.lock.Unlock()
+}
+
+func (s *MatcherDataSuite) TestFairnessWeights() {
+	s.md.config.EnableFairness = func() bool { return true }
+	s.md.config.FairnessKeyWeights = func() map[string]float64 { return map[string]float64{"b": 3} }
+
+	for i := range 6 {
+		s.md.EnqueueTaskNoWait(s.newBacklogTaskWithFairnessKey(int64(1+i), "a", i, nil))
+		s.md.EnqueueTaskNoWait(s.newBacklogTaskWithFairnessKey(int64(10+i), "b", i, nil))
+	}
+
+	counts := make(map[string]int)
+	for range 8 {
+		counts[s.pollFakeTime(time.Second).task.getFairnessKey()]++
+	}
+	s.Equal(map[string]int{"a": 2, "b": 6}, counts)
+}
+
+func (s *MatcherDataSuite) TestFairnessIdleKeyDoesNotAccumulateCredit() {
+	s.md.config.EnableFairness = func() bool { return true }
+
+	for i := range 4 {
+		s.md.EnqueueTaskNoWait(s.newBacklogTaskWithFairnessKey(int64(1+i), "a", i, nil))
+	}
+	s.Equal("a-0", s.pollFakeTime(time.Second).task.workflowExecution().GetWorkflowId())
+	s.Equal("a-1", s.pollFakeTime(time.Second).task.workflowExecution().GetWorkflowId())
+
+	// b was idle while a was matched, so it alternates with a instead of catching up
+	for i := range 2 {
+		s.md.EnqueueTaskNoWait(s.newBacklogTaskWithFairnessKey(int64(10+i), "b", i, nil))
+	}
+	var order []string
+	for range 4 {
+		order = append(order, s.pollFakeTime(time.Second).task.workflowExecution().GetWorkflowId())
+	}
+	s.Equal([]string{"a-2", "b-0", "a-3", "b-1"}, order)
+}
+
+func (s *MatcherDataSuite) TestWorkerRateLimit() {
+	s.md.config.NumReadPartitions = func() int { return 1 }
+	s.md.config.WorkerIdentityDispatchRate = func() map[string]float64 { return map[string]float64{"slow": 1} }
+
+	for i := range 10 {
+		s.md.EnqueueTaskNoWait(s.newBacklogTask(int64(1+i), 0, nil))
+	}
+
+	pollAs := func(identity string, timeout time.Duration) *matchResult {
+		ctx, cancel := clock.ContextWithTimeout(context.Background(), timeout, s.ts)
+		defer cancel()
+		return s.md.EnqueuePollerAndWait([]context.Context{ctx}, &waitingPoller{startTime: s.now(), identity: identity})
+	}
+
+	// the slow worker gets its burst, then it's throttled
+	s.NoError(pollAs("slow", time.Second).ctxErr)
+	s.NoError(pollAs("slow", time.Second).ctxErr)
+	resC := make(chan *matchResult, 1)
+	go func() { resC <- pollAs("slow", 5*time.Second) }()
+	s.waitForPollers(1)
+
+	// other workers are not throttled
+	s.NoError(pollAs("fast", time.Second).ctxErr)
+	s.NoError(pollAs("", time.Second).ctxErr)
+
+	// the slow worker gets a task once its rate allows
+	s.ts.Advance(time.Second)
+	s.NoError((<-resC).ctxErr)
+}
+
+func (s *MatcherDataSuite) TestWorkerMaxInFlight() {
+	s.md.config.NumReadPartitions = func() int { return 1 }
+	s.md.config.WorkerBuildIDMaxInFlight = func() map[string]int { return map[string]int{"v1": 2} }
+	s.md.config.WorkerInFlightTimeout = func() time.Duration { return time.Minute }
+
+	for i := range 10 {
+		s.md.EnqueueTaskNoWait(s.newBacklogTask(int64(1+i), 0, nil))
+	}
+
+	pollAs := func(buildID string, timeout time.Duration) *matchResult {
+		ctx, cancel := clock.ContextWithTimeout(context.Background(), timeout, s.ts)
+		defer cancel()
+		return s.md.EnqueuePollerAndWait([]context.Context{ctx}, &waitingPoller{startTime: s.now(), buildID: buildID})
+	}
+
+	// the v1 workers get two tasks, then they wait for one of them to be released
+	res1 := pollAs("v1", time.Second)
+	s.NoError(res1.ctxErr)
+	s.NotEmpty(res1.task.inFlightID)
+	res2 := pollAs("v1", time.Second)
+	s.NoError(res2.ctxErr)
+	resC := make(chan *matchResult, 1)
+	go func() { resC <- pollAs("v1", 5*time.Minute) }()
+	s.waitForPollers(1)
+
+	// other workers are not limited and their tasks are not tracked
+	res := pollAs("v2", time.Second)
+	s.NoError(res.ctxErr)
+	s.Empty(res.task.inFlightID)
+
+	// releasing a task lets the waiting poller match
+	s.md.ReleaseWorkerInFlightTask(res1.task.inFlightID)
+	res3 := <-resC
+	s.NoError(res3.ctxErr)
+
+	// a task that doesn't reach the worker is released too
+	go func() { resC <- pollAs("v1", 5*time.Minute) }()
+	s.waitForPollers(1)
+	res3.task.finish(nil, false)
+	s.NoError((<-resC).ctxErr)
+
+	// and tasks the worker doesn't respond to expire
+	go func() { resC <- pollAs("v1", 5*time.Minute) }()
+	s.waitForPollers(1)
+	s.ts.Advance(time.Minute)
+	s.NoError((<-resC).ctxErr)
+}
+
+func (s *MatcherDataSuite) TestWorkerDispatchLimitsFromUserData() {
+	s.md.config.NumReadPartitions = func() int { return 1 }
+	s.md.config.WorkerIdentityMaxInFlight = func() map[string]int { return map[string]int{workerRateLimitDefaultKey: 1} }
+	s.md.UpdateWorkerDispatchLimits(&persistencespb.WorkerDispatchLimits{
+		ByIdentity: map[string]*persistencespb.WorkerDispatchLimit{"w1": {MaxInFlight: 1}},
+	})
+
+	for i := range 10 {
+		s.md.EnqueueTaskNoWait(s.newBacklogTask(int64(1+i), 0, nil))
+	}
+
+	pollAs := func(identity string, timeout time.Duration) *matchResult {
+		ctx, cancel := clock.ContextWithTimeout(context.Background(), timeout, s.ts)
+		defer cancel()
+		return s.md.EnqueuePollerAndWait([]context.Context{ctx}, &waitingPoller{startTime: s.now(), identity: identity})
+	}
+
+	// the user data limits replace the dynamic config ones
+	s.NoError(pollAs("w1", time.Second).ctxErr)
+	s.NoError(pollAs("w2", time.Second).ctxErr)
+	s.NoError(pollAs("w2", time.Second).ctxErr)
+
+	// raising them wakes up the waiting pollers
+	resC := make(chan *matchResult, 1)
+	go func() { resC <- pollAs("w1", 5*time.Second) }()
+	s.waitForPollers(1)
+	s.md.UpdateWorkerDispatchLimits(&persistencespb.WorkerDispatchLimits{
+		ByIdentity: map[string]*persistencespb.WorkerDispatchLimit{"w1": {MaxInFlight: 2}},
+	})
+	s.NoError((<-resC).ctxErr)
+}
+
 func (s *MatcherDataSuite) TestPollForwardSuccess() {
 	t1 := s.newBacklogTask(1, 0, nil)
 	t2 := s.newBacklogTask(2, 0, nil)
@@ -561,7 +742,7 @@ func FuzzMatcherData(f *testing.F) {
 		ts := clock.NewEventTimeSource()
 		ts.UseAsyncTimers(true)
 		logger := testlogger.NewTestLogger(f, testlogger.FailOnAnyUnexpectedError)
-		md := newMatcherData(cfg, logger, ts, true)
+		md := newMatcherData(cfg, logger, metrics.NoopMetricsHandler, ts, true)
 
 		next := func() int {
 			if len(tape) == 0 {
@@ -57,6 +57,7 @@ import (
 	"go.temporal.io/server/service/history/api"
 	"go.temporal.io/server/service/worker/deployment"
 	"go.temporal.io/server/service/worker/workerdeployment"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
@@ -529,6 +530,7 @@ func (e *matchingEngineImpl) AddWorkflowTask(
 		CreateTime:       timestamppb.New(now),
 		VersionDirective: addRequest.VersionDirective,
 		Priority:         addRequest.Priority,
+		FairnessKey:      addRequest.FairnessKey,
 	}
 
 	return pm.AddTask(ctx, addTaskParams{
@@ -568,6 +570,7 @@ func (e *matchingEngineImpl) AddActivityTask(
 		VersionDirective: addRequest.VersionDirective,
 		Stamp:            addRequest.Stamp,
 		Priority:         addRequest.Priority,
+		FairnessKey:      addRequest.FairnessKey,
 	}
 
 	return pm.AddTask(ctx, addTaskParams{
@@ -671,7 +674,7 @@ pollLoop:
 				NextPageToken:              nextPageToken,
 			}
 
-			return e.createPollWorkflowTaskQueueResponse(task, resp, opMetrics), nil
+			return e.createPollWorkflowTaskQueueResponse(task, request.TaskQueue, resp, opMetrics), nil
 		}
 
 		requestClone := request
@@ -758,7 +761,8 @@ pollLoop:
 		}
 
 		task.finish(nil, true)
-		return e.createPollWorkflowTaskQueueResponse(task, resp, opMetrics), nil
+		setInFlightTimeout(task, resp.GetWorkflowTaskTimeout().AsDuration())
+		return e.createPollWorkflowTaskQueueResponse(task, request.TaskQueue, resp, opMetrics), nil
 	}
 }
 
@@ -991,7 +995,13 @@ pollLoop:
 			continue pollLoop
 		}
 		task.finish(nil, true)
-		return e.createPollActivityTaskQueueResponse(task, resp, opMetrics), nil
+		activityAttributes := resp.GetScheduledEvent().GetActivityTaskScheduledEventAttributes()
+		activityTimeout := activityAttributes.GetStartToCloseTimeout().AsDuration()
+		if activityTimeout <= 0 {
+			activityTimeout = activityAttributes.GetHeartbeatTimeout().AsDuration()
+		}
+		setInFlightTimeout(task, activityTimeout)
+		return e.createPollActivityTaskQueueResponse(task, request.TaskQueue, resp, opMetrics), nil
 	}
 }
 
@@ -1920,6 +1930,105 @@ func (e *matchingEngineImpl) ForceUnloadTaskQueuePartition(
 	return &matchingservice.ForceUnloadTaskQueuePartitionResponse{WasLoaded: wasLoaded}, nil
 }
 
+func (e *matchingEngineImpl) MoveTaskQueueTasks(
+	ctx context.Context,
+	req *matchingservice.MoveTaskQueueTasksRequest,
+) (*matchingservice.MoveTaskQueueTasksResponse, error) {
+	partition := tqid.PartitionFromPartitionProto(req.GetSourcePartition(), req.GetNamespaceId())
+	switch {
+	case req.GetDestinationTaskQueue() == "":
+		return nil, serviceerror.NewInvalidArgument("destination task queue is not set")
+	case partition.Kind() == enumspb.TASK_QUEUE_KIND_STICKY:
+		return nil, serviceerror.NewInvalidArgument("tasks can't be moved from a sticky task queue")
+	case req.GetSourceBuildId() != "" && req.GetSourceDeploymentVersion() != "":
+		return nil, serviceerror.NewInvalidArgument("only one of source build ID and source deployment version can be set")
+	}
+	destination, err := tqid.NewTaskQueueFamily(req.GetNamespaceId(), req.GetDestinationTaskQueue())
+	if err != nil {
+		// the destination is the name of a partition, the tasks would all be added to that partition
+		return nil, err
+	}
+	if destination.Name() == partition.TaskQueue().Name() {
+		// the moved tasks would be read again from the backlog of the partition they were moved from
+		return nil, serviceerror.NewInvalidArgument("destination task queue must be different from the source task queue")
+	}
+	// Loading the partition takes over its backlog, so the moved tasks can be deleted safely.
+	pm, _, err := e.getTaskQueuePartitionManager(ctx, partition, true, loadCauseForce)
+	if err != nil {
+		return nil, err
+	}
+	return pm.MoveBacklogTasks(ctx, req)
+}
+
+func (e *matchingEngineImpl) ReleaseWorkerInFlightTask(
+	ctx context.Context,
+	req *matchingservice.ReleaseWorkerInFlightTaskRequest,
+) (*matchingservice.ReleaseWorkerInFlightTaskResponse, error) {
+	partition, err := tqid.PartitionFromProto(req.GetTaskQueue(), req.GetNamespaceId(), req.GetTaskQueueType())
+	if err != nil {
+		return nil, err
+	}
+	// If the partition was unloaded, its in-flight tasks are gone too.
+	pm, _, err := e.getTaskQueuePartitionManager(ctx, partition, false, loadCauseOtherWrite)
+	if err != nil || pm == nil {
+		return &matchingservice.ReleaseWorkerInFlightTaskResponse{}, err
+	}
+	pm.ReleaseWorkerInFlightTask(req.GetInFlightId())
+	return &matchingservice.ReleaseWorkerInFlightTaskResponse{}, nil
+}
+
+func (e *matchingEngineImpl) UpdateWorkerDispatchLimits(
+	ctx context.Context,
+	req *matchingservice.UpdateWorkerDispatchLimitsRequest,
+) (*matchingservice.UpdateWorkerDispatchLimitsResponse, error) {
+	taskQueueType := req.GetTaskQueueType()
+	if taskQueueType != enumspb.TASK_QUEUE_TYPE_WORKFLOW && taskQueueType != enumspb.TASK_QUEUE_TYPE_ACTIVITY {
+		return nil, serviceerror.NewInvalidArgument("worker dispatch limits can only be set on workflow and activity task queues")
+	}
+	if err := validateWorkerDispatchLimits(req.GetLimits()); err != nil {
+		return nil, err
+	}
+	taskQueueFamily, err := tqid.NewTaskQueueFamily(req.GetNamespaceId(), req.GetTaskQueue())
+	if err != nil {
+		return nil, err
+	}
+	tqMgr, _, err := e.getTaskQueuePartitionManager(ctx, taskQueueFamily.TaskQueue(enumspb.TASK_QUEUE_TYPE_WORKFLOW).RootPartition(), true, loadCauseOtherWrite)
+	if err != nil {
+		return nil, err
+	}
+
+	updateOptions := UserDataUpdateOptions{Source: "UpdateWorkerDispatchLimits"}
+	_, err = tqMgr.GetUserDataManager().UpdateUserData(ctx, updateOptions, func(data *persistencespb.TaskQueueUserData) (*persistencespb.TaskQueueUserData, bool, error) {
+		clk := data.GetClock()
+		if clk == nil {
+			clk = hlc.Zero(e.clusterMeta.GetClusterID())
+		}
+		now := hlc.Next(clk, e.timeSource)
+		// clone the whole thing so we can just mutate
+		data = common.CloneProto(data)
+		if data == nil {
+			data = &persistencespb.TaskQueueUserData{}
+		}
+		if data.PerType == nil {
+			data.PerType = make(map[int32]*persistencespb.TaskQueueTypeUserData)
+		}
+		if data.PerType[int32(taskQueueType)] == nil {
+			data.PerType[int32(taskQueueType)] = &persistencespb.TaskQueueTypeUserData{}
+		}
+		perType := data.PerType[int32(taskQueueType)]
+		if proto.Equal(perType.GetWorkerDispatchLimits(), req.GetLimits()) {
+			return nil, false, errUserDataUnmodified
+		}
+		perType.WorkerDispatchLimits = req.GetLimits()
+		data.Clock = now
+		return data, true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &matchingservice.UpdateWorkerDispatchLimitsResponse{}, nil
+}
+
 func (e *matchingEngineImpl) UpdateTaskQueueUserData(ctx context.Context, request *matchingservice.UpdateTaskQueueUserDataRequest) (*matchingservice.UpdateTaskQueueUserDataResponse, error) {
 	namespaceId := namespace.ID(request.NamespaceId)
 	var applied, conflicting bool
@@ -2499,6 +2608,7 @@ func (e *matchingEngineImpl) updateTaskQueuePartitionGauge(
 // Populate the workflow task response based on context and scheduled/started events.
 func (e *matchingEngineImpl) createPollWorkflowTaskQueueResponse(
 	task *internalTask,
+	taskQueue *taskqueuepb.TaskQueue,
 	recordStartResp *historyservice.RecordWorkflowTaskStartedResponse,
 	metricsHandler metrics.Handler,
 ) *matchingservice.PollWorkflowTaskQueueResponse {
@@ -2525,6 +2635,7 @@ func (e *matchingEngineImpl) createPollWorkflowTaskQueueResponse(
 			recordStartResp.GetClock(),
 			recordStartResp.GetVersion(),
 		)
+		setInFlightTask(taskToken, task, taskQueue)
 		serializedToken, _ = e.tokenSerializer.Serialize(taskToken)
 		if task.responseC == nil {
 			ct := timestamp.TimeValue(task.event.Data.CreateTime)
@@ -2547,9 +2658,29 @@ func (e *matchingEngineImpl) createPollWorkflowTaskQueueResponse(
 	return response
 }
 
+// setInFlightTask adds the in-flight ID of a task counted against the max in-flight of its
+// worker to its task token, so that the frontend can release it when the worker responds.
+func setInFlightTask(taskToken *tokenspb.Task, task *internalTask, taskQueue *taskqueuepb.TaskQueue) {
+	if task.inFlightID == "" {
+		return
+	}
+	taskToken.InFlightTaskQueue = taskQueue
+	taskToken.InFlightId = task.inFlightID
+}
+
+// setInFlightTimeout expires the in-flight task of a started task after the timeout of the task,
+// the default in-flight timeout is kept if the task has none.
+func setInFlightTimeout(task *internalTask, timeout time.Duration) {
+	if task.inFlightID == "" || task.setInFlightTimeout == nil || timeout <= 0 {
+		return
+	}
+	task.setInFlightTimeout(task, timeout)
+}
+
 // Populate the activity task response based on context and scheduled/started events.
 func (e *matchingEngineImpl) createPollActivityTaskQueueResponse(
 	task *internalTask,
+	taskQueue *taskqueuepb.TaskQueue,
 	historyResponse *historyservice.RecordActivityTaskStartedResponse,
 	metricsHandler metrics.Handler,
 ) *matchingservice.PollActivityTaskQueueResponse {
@@ -2578,6 +2709,7 @@ func (e *matchingEngineImpl) createPollActivityTaskQueueResponse(
 		historyResponse.GetClock(),
 		historyResponse.GetVersion(),
 	)
+	setInFlightTask(taskToken, task, taskQueue)
 	serializedToken, _ := e.tokenSerializer.Serialize(taskToken)
 
 	// This is here to ensure that this field is never nil as expected by the TS SDK.
@@ -2707,6 +2839,12 @@ func (e *matchingEngineImpl) recordActivityTaskStarted(
 		ScheduledDeployment: worker_versioning.DirectiveDeployment(task.event.Data.VersionDirective),
 		VersionDirective:    task.event.Data.VersionDirective,
 	}
+	if task.inFlightID != "" {
+		// history returns them when the activity is completed by ID, so that the frontend can
+		// release the task
+		recordStartedRequest.InFlightTaskQueue = pollReq.GetTaskQueue().GetName()
+		recordStartedRequest.InFlightId = task.inFlightID
+	}
 
 	return e.historyClient.RecordActivityTaskStarted(ctx, recordStartedRequest)
 }
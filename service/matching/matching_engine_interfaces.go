@@ -31,6 +31,9 @@ type (
 		ForceUnloadTaskQueuePartition(ctx context.Context, request *matchingservice.ForceUnloadTaskQueuePartitionRequest) (*matchingservice.ForceUnloadTaskQueuePartitionResponse, error)
 		ForceUnloadTaskQueue(ctx context.Context, request *matchingservice.ForceUnloadTaskQueueRequest) (*matchingservice.ForceUnloadTaskQueueResponse, error)
 		ForceLoadTaskQueuePartition(ctx context.Context, request *matchingservice.ForceLoadTaskQueuePartitionRequest) (*matchingservice.ForceLoadTaskQueuePartitionResponse, error)
+		MoveTaskQueueTasks(ctx context.Context, request *matchingservice.MoveTaskQueueTasksRequest) (*matchingservice.MoveTaskQueueTasksResponse, error)
+		ReleaseWorkerInFlightTask(ctx context.Context, request *matchingservice.ReleaseWorkerInFlightTaskRequest) (*matchingservice.ReleaseWorkerInFlightTaskResponse, error)
+		UpdateWorkerDispatchLimits(ctx context.Context, request *matchingservice.UpdateWorkerDispatchLimitsRequest) (*matchingservice.UpdateWorkerDispatchLimitsResponse, error)
 		UpdateTaskQueueUserData(ctx context.Context, request *matchingservice.UpdateTaskQueueUserDataRequest) (*matchingservice.UpdateTaskQueueUserDataResponse, error)
 		ReplicateTaskQueueUserData(ctx context.Context, request *matchingservice.ReplicateTaskQueueUserDataRequest) (*matchingservice.ReplicateTaskQueueUserDataResponse, error)
 		CheckTaskQueueUserDataPropagation(ctx context.Context, request *matchingservice.CheckTaskQueueUserDataPropagationRequest) (*matchingservice.CheckTaskQueueUserDataPropagationResponse, error)
@@ -1549,6 +1549,79 @@ func (s *matchingEngineSuite) TestForceUnloadTaskQueue() {
 	s.ErrorAs(err, new(*serviceerrors.StickyWorkerUnavailable))
 }
 
+func (s *matchingEngineSuite) TestMoveTaskQueueTasks() {
+	ctx := context.Background()
+	namespaceId := uuid.New()
+	tl := "move-tasks-tl"
+	dbq := newUnversionedRootQueueKey(namespaceId, tl, enumspb.TASK_QUEUE_TYPE_ACTIVITY)
+
+	for i, workflowID := range []string{"orders-1", "payments-1", "orders-2", "orders-3"} {
+		_, _, err := s.matchingEngine.AddActivityTask(ctx, &matchingservice.AddActivityTaskRequest{
+			NamespaceId:            namespaceId,
+			Execution:              &commonpb.WorkflowExecution{WorkflowId: workflowID, RunId: uuid.New()},
+			ScheduledEventId:       int64(i),
+			TaskQueue:              &taskqueuepb.TaskQueue{Name: tl, Kind: enumspb.TASK_QUEUE_KIND_NORMAL},
+			ScheduleToStartTimeout: timestamp.DurationFromSeconds(100),
+		})
+		s.NoError(err)
+	}
+	s.EqualValues(4, s.taskManager.getTaskCount(dbq))
+
+	request := &matchingservice.MoveTaskQueueTasksRequest{
+		NamespaceId: namespaceId,
+		SourcePartition: &taskqueuespb.TaskQueuePartition{
+			TaskQueue:     tl,
+			TaskQueueType: enumspb.TASK_QUEUE_TYPE_ACTIVITY,
+		},
+		DestinationTaskQueue: "move-tasks-destination",
+		WorkflowIdPrefix:     "orders-",
+		DryRun:               true,
+	}
+	resp, err := s.matchingEngine.MoveTaskQueueTasks(ctx, request)
+	s.NoError(err)
+	s.EqualValues(4, resp.ScannedCount)
+	s.EqualValues(3, resp.SelectedCount)
+	s.EqualValues(0, resp.MovedCount)
+	s.Empty(resp.NextPageToken)
+	s.EqualValues(4, s.taskManager.getTaskCount(dbq))
+
+	var moved []string
+	s.mockMatchingClient.EXPECT().AddActivityTask(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *matchingservice.AddActivityTaskRequest, _ ...interface{}) (*matchingservice.AddActivityTaskResponse, error) {
+			s.Equal("move-tasks-destination", request.GetTaskQueue().GetName())
+			moved = append(moved, request.GetExecution().GetWorkflowId())
+			return &matchingservice.AddActivityTaskResponse{}, nil
+		}).Times(3)
+	request.DryRun = false
+	resp, err = s.matchingEngine.MoveTaskQueueTasks(ctx, request)
+	s.NoError(err)
+	s.EqualValues(4, resp.ScannedCount)
+	s.EqualValues(3, resp.SelectedCount)
+	s.EqualValues(3, resp.MovedCount)
+	s.Equal([]string{"orders-1", "orders-2", "orders-3"}, moved)
+	s.EqualValues(1, s.taskManager.getTaskCount(dbq))
+
+	request.DestinationTaskQueue = tl
+	_, err = s.matchingEngine.MoveTaskQueueTasks(ctx, request)
+	s.ErrorAs(err, new(*serviceerror.InvalidArgument))
+
+	// tasks can't be moved to a single partition of the destination
+	request.DestinationTaskQueue = "/_sys/move-tasks-destination/1"
+	_, err = s.matchingEngine.MoveTaskQueueTasks(ctx, request)
+	s.ErrorAs(err, new(*serviceerror.InvalidArgument))
+
+	request.DestinationTaskQueue = "move-tasks-destination"
+	request.SourceBuildId = "build-id"
+	request.SourceDeploymentVersion = "deployment.build-id"
+	_, err = s.matchingEngine.MoveTaskQueueTasks(ctx, request)
+	s.ErrorAs(err, new(*serviceerror.InvalidArgument))
+
+	request.SourceBuildId = ""
+	request.SourceDeploymentVersion = "invalid-version"
+	_, err = s.matchingEngine.MoveTaskQueueTasks(ctx, request)
+	s.ErrorAs(err, new(*serviceerror.InvalidArgument))
+}
+
 func (s *matchingEngineSuite) TestMultipleEnginesActivitiesRangeStealing() {
 	if s.newMatcher {
 		s.T().Skip("test is flaky with new matcher")
@@ -3605,6 +3678,17 @@ func (m *testTaskManager) CompleteTasksLessThan(
 	return persistence.UnknownNumRowsAffected, nil
 }
 
+func (m *testTaskManager) CompleteTask(
+	_ context.Context,
+	request *persistence.CompleteTaskRequest,
+) error {
+	tlm := m.getQueueManager(request.TaskQueueName, request.NamespaceID, request.TaskType)
+	tlm.Lock()
+	defer tlm.Unlock()
+	tlm.tasks.Remove(request.TaskID)
+	return nil
+}
+
 func (m *testTaskManager) ListTaskQueue(
 	_ context.Context,
 	_ *persistence.ListTaskQueueRequest,
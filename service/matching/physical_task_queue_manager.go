@@ -14,6 +14,7 @@ import (
 	"go.temporal.io/api/serviceerror"
 	taskqueuepb "go.temporal.io/api/taskqueue/v1"
 	"go.temporal.io/api/workflowservice/v1"
+	enumsspb "go.temporal.io/server/api/enums/v1"
 	"go.temporal.io/server/api/matchingservice/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
 	taskqueuespb "go.temporal.io/server/api/taskqueue/v1"
@@ -82,6 +83,7 @@ type (
 		pollerHistory              *pollerHistory
 		currentPolls               atomic.Int64
 		taskValidator              taskValidator
+		movedTasks                 *movedBacklogTasks
 		tasksAddedInIntervals      *taskTracker
 		tasksDispatchedInIntervals *taskTracker
 		// deploymentWorkflowStarted keeps track if we have already registered the task queue worker
@@ -162,12 +164,16 @@ func newPhysicalTaskQueueManager(
 		func() { pqMgr.UnloadFromPartitionManager(unloadCauseIdle) },
 	)
 
-	pqMgr.taskValidator = newTaskValidator(
-		tqCtx,
-		pqMgr.clusterMeta,
-		pqMgr.namespaceRegistry,
-		pqMgr.partitionMgr.engine.historyClient,
-	)
+	pqMgr.movedTasks = newMovedBacklogTasks()
+	pqMgr.taskValidator = &movedTaskValidator{
+		taskValidator: newTaskValidator(
+			tqCtx,
+			pqMgr.clusterMeta,
+			pqMgr.namespaceRegistry,
+			pqMgr.partitionMgr.engine.historyClient,
+		),
+		moved: pqMgr.movedTasks,
+	}
 
 	newMatcher, cancelSub := config.NewMatcher(func(bool) {
 		// unload on change to NewMatcher so that we can reload with the new setting:
@@ -241,6 +247,7 @@ func (c *physicalTaskQueueManagerImpl) Start() {
 	}
 	c.liveness.Start()
 	c.backlogMgr.Start()
+	c.updateWorkerDispatchLimits()
 	c.matcher.Start()
 	c.logger.Info("Started physicalTaskQueueManager", tag.LifeCycleStarted, tag.Cause(c.config.loadCause.String()))
 	c.metricsHandler.Counter(metrics.TaskQueueStartedCounter.Name()).Record(1)
@@ -339,6 +346,11 @@ func (c *physicalTaskQueueManagerImpl) PollTask(
 			task.finish(nil, false)
 			continue
 		}
+		// The task could also have been moved to another task queue while it was in the matcher.
+		if task.source == enumsspb.TASK_SOURCE_DB_BACKLOG && !task.isForwarded() && c.movedTasks.take(task.event.GetTaskId()) {
+			task.finish(nil, false)
+			continue
+		}
 
 		task.namespace = c.partitionMgr.ns.Name()
 		task.backlogCountHint = c.backlogCountHint
@@ -394,9 +406,31 @@ func (c *physicalTaskQueueManagerImpl) AddSpooledTaskToMatcher(task *internalTas
 }
 
 func (c *physicalTaskQueueManagerImpl) UserDataChanged() {
+	c.updateWorkerDispatchLimits()
 	c.matcher.ReprocessAllTasks()
 }
 
+func (c *physicalTaskQueueManagerImpl) ReleaseWorkerInFlightTask(inFlightID string) {
+	// TODO(pri): old matcher cleanup
+	if c.priMatcher != nil {
+		c.priMatcher.ReleaseWorkerInFlightTask(inFlightID)
+	}
+}
+
+// updateWorkerDispatchLimits passes the per-worker limits of the task queue user data to the
+// matcher. Only the new matcher supports per-worker limits.
+func (c *physicalTaskQueueManagerImpl) updateWorkerDispatchLimits() {
+	if c.priMatcher == nil {
+		return
+	}
+	perTypeUserData, _, err := c.partitionMgr.getPerTypeUserData()
+	if err != nil {
+		// user data is not loaded yet, we'll get called again when it is
+		return
+	}
+	c.priMatcher.UpdateWorkerDispatchLimits(perTypeUserData.GetWorkerDispatchLimits())
+}
+
 // DispatchQueryTask will dispatch query to local or remote poller. If forwarded then result or error is returned,
 // if dispatched to local poller then nil and nil is returned.
 func (c *physicalTaskQueueManagerImpl) DispatchQueryTask(
@@ -491,6 +525,16 @@ func (c *physicalTaskQueueManagerImpl) GetInternalTaskQueueStatus() []*taskqueue
 	return c.backlogMgr.InternalStatus()
 }
 
+func (c *physicalTaskQueueManagerImpl) MoveBacklogTasks(
+	ctx context.Context,
+	request *matchingservice.MoveTaskQueueTasksRequest,
+) (*matchingservice.MoveTaskQueueTasksResponse, error) {
+	if err := c.WaitUntilInitialized(ctx); err != nil {
+		return nil, err
+	}
+	return moveBacklogTasks(ctx, c.backlogMgr.getDB(), c.movedTasks, c.matchingClient, request)
+}
+
 func (c *physicalTaskQueueManagerImpl) TrySyncMatch(ctx context.Context, task *internalTask) (bool, error) {
 	if !task.isForwarded() {
 		// request sent by history service
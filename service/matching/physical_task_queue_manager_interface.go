@@ -36,6 +36,9 @@ type (
 		AddSpooledTask(task *internalTask) error
 		AddSpooledTaskToMatcher(task *internalTask)
 		UserDataChanged()
+		// ReleaseWorkerInFlightTask is called when a worker responded to a task dispatched from this queue,
+		// so that the task doesn't count against the max in-flight of the worker anymore.
+		ReleaseWorkerInFlightTask(inFlightID string)
 		// DispatchQueryTask will dispatch query to local or remote poller. If forwarded then result or error is returned,
 		// if dispatched to local poller then nil and nil is returned.
 		DispatchQueryTask(ctx context.Context, taskId string, request *matchingservice.QueryWorkflowRequest) (*matchingservice.QueryWorkflowResponse, error)
@@ -49,6 +52,9 @@ type (
 		LegacyDescribeTaskQueue(includeTaskQueueStatus bool) *matchingservice.DescribeTaskQueueResponse
 		GetStats() *taskqueuepb.TaskQueueStats
 		GetInternalTaskQueueStatus() []*taskqueuespb.InternalTaskQueueStatus
+		// MoveBacklogTasks moves one page of the backlog tasks selected by the filters of the request to another task
+		// queue.
+		MoveBacklogTasks(ctx context.Context, request *matchingservice.MoveTaskQueueTasksRequest) (*matchingservice.MoveTaskQueueTasksResponse, error)
 		UnloadFromPartitionManager(unloadCause)
 		QueueKey() *PhysicalTaskQueueKey
 		// MakePollerScalingDecision makes a decision on whether to scale pollers up or down based on the current state
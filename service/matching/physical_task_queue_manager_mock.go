@@ -211,6 +211,21 @@ func (mr *MockphysicalTaskQueueManagerMockRecorder) MarkAlive() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkAlive", reflect.TypeOf((*MockphysicalTaskQueueManager)(nil).MarkAlive))
 }
 
+// MoveBacklogTasks mocks base method.
+func (m *MockphysicalTaskQueueManager) MoveBacklogTasks(ctx context.Context, request *matchingservice.MoveTaskQueueTasksRequest) (*matchingservice.MoveTaskQueueTasksResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MoveBacklogTasks", ctx, request)
+	ret0, _ := ret[0].(*matchingservice.MoveTaskQueueTasksResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MoveBacklogTasks indicates an expected call of MoveBacklogTasks.
+func (mr *MockphysicalTaskQueueManagerMockRecorder) MoveBacklogTasks(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveBacklogTasks", reflect.TypeOf((*MockphysicalTaskQueueManager)(nil).MoveBacklogTasks), ctx, request)
+}
+
 // PollTask mocks base method.
 func (m *MockphysicalTaskQueueManager) PollTask(ctx context.Context, pollMetadata *pollMetadata) (*internalTask, error) {
 	m.ctrl.T.Helper()
@@ -254,6 +269,18 @@ func (mr *MockphysicalTaskQueueManagerMockRecorder) QueueKey() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueueKey", reflect.TypeOf((*MockphysicalTaskQueueManager)(nil).QueueKey))
 }
 
+// ReleaseWorkerInFlightTask mocks base method.
+func (m *MockphysicalTaskQueueManager) ReleaseWorkerInFlightTask(inFlightID string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "ReleaseWorkerInFlightTask", inFlightID)
+}
+
+// ReleaseWorkerInFlightTask indicates an expected call of ReleaseWorkerInFlightTask.
+func (mr *MockphysicalTaskQueueManagerMockRecorder) ReleaseWorkerInFlightTask(inFlightID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseWorkerInFlightTask", reflect.TypeOf((*MockphysicalTaskQueueManager)(nil).ReleaseWorkerInFlightTask), inFlightID)
+}
+
 // SpoolTask mocks base method.
 func (m *MockphysicalTaskQueueManager) SpoolTask(taskInfo *persistence.TaskInfo) error {
 	m.ctrl.T.Helper()
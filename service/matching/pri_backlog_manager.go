@@ -42,6 +42,12 @@ type (
 	// 	BacklogHeadAge() time.Duration
 	// }
 
+	// subqueueKey identifies the subqueue of a priority level and fairness key
+	subqueueKey struct {
+		priority    int32
+		fairnessKey string
+	}
+
 	priBacklogManagerImpl struct {
 		pqMgr      physicalTaskQueueManager
 		config     *taskQueueConfig
@@ -49,9 +55,11 @@ type (
 		db         *taskQueueDB
 		taskWriter *priTaskWriter
 
-		subqueueLock        sync.Mutex
-		subqueues           []*priTaskReader
-		subqueuesByPriority map[int32]int
+		subqueueLock   sync.Mutex
+		subqueues      []*priTaskReader
+		subqueuesByKey map[subqueueKey]int
+		// number of subqueues with a fairness key
+		fairnessSubqueues int
 
 		logger           log.Logger
 		throttledLogger  log.ThrottledLogger
@@ -77,15 +85,15 @@ func newPriBacklogManager(
 	metricsHandler metrics.Handler,
 ) *priBacklogManagerImpl {
 	bmg := &priBacklogManagerImpl{
-		pqMgr:               pqMgr,
-		config:              config,
-		tqCtx:               tqCtx,
-		subqueuesByPriority: make(map[int32]int),
-		matchingClient:      matchingClient,
-		metricsHandler:      metricsHandler,
-		logger:              logger,
-		throttledLogger:     throttledLogger,
-		initializedError:    future.NewFuture[struct{}](),
+		pqMgr:            pqMgr,
+		config:           config,
+		tqCtx:            tqCtx,
+		subqueuesByKey:   make(map[subqueueKey]int),
+		matchingClient:   matchingClient,
+		metricsHandler:   metricsHandler,
+		logger:           logger,
+		throttledLogger:  throttledLogger,
+		initializedError: future.NewFuture[struct{}](),
 	}
 	bmg.db = newTaskQueueDB(config, taskManager, pqMgr.QueueKey(), logger, metricsHandler)
 	bmg.taskWriter = newPriTaskWriter(bmg)
@@ -158,11 +166,19 @@ func (c *priBacklogManagerImpl) loadSubqueuesLocked(subqueues []persistencespb.S
 			r.Start()
 			c.subqueues = append(c.subqueues, r)
 		}
-		c.subqueuesByPriority[subqueues[i].Key.Priority] = i
+		key := subqueueKey{priority: subqueues[i].Key.Priority, fairnessKey: subqueues[i].Key.FairnessKey}
+		if _, ok := c.subqueuesByKey[key]; !ok && key.fairnessKey != "" {
+			c.fairnessSubqueues++
+		}
+		c.subqueuesByKey[key] = i
 	}
 }
 
-func (c *priBacklogManagerImpl) getSubqueueForPriority(priority int32) int {
+// getSubqueueForTask returns the subqueue for the priority level and fairness key of a task.
+// With fairness enabled, each fairness key gets its own subqueue, up to MaxFairnessSubqueues,
+// so that the backlog of each key is read separately.
+func (c *priBacklogManagerImpl) getSubqueueForTask(taskInfo *persistencespb.TaskInfo) int {
+	priority := taskInfo.GetPriority().GetPriorityKey()
 	levels := c.config.PriorityLevels()
 	if priority == 0 {
 		priority = defaultPriorityLevel(levels)
@@ -177,28 +193,44 @@ func (c *priBacklogManagerImpl) getSubqueueForPriority(priority int32) int {
 	c.subqueueLock.Lock()
 	defer c.subqueueLock.Unlock()
 
-	if i, ok := c.subqueuesByPriority[priority]; ok {
+	if fairnessKey := taskInfo.GetFairnessKey(); fairnessKey != "" && c.config.EnableFairness() {
+		key := subqueueKey{priority: priority, fairnessKey: fairnessKey}
+		if i, ok := c.subqueuesByKey[key]; ok {
+			return i
+		}
+		if c.fairnessSubqueues < c.config.MaxFairnessSubqueues() {
+			return c.allocateSubqueueLocked(key)
+		}
+		// over the limit, the task goes in the subqueue of its priority level
+	}
+
+	key := subqueueKey{priority: priority}
+	if i, ok := c.subqueuesByKey[key]; ok {
 		return i
 	}
+	return c.allocateSubqueueLocked(key)
+}
 
+func (c *priBacklogManagerImpl) allocateSubqueueLocked(key subqueueKey) int {
 	// We need to allocate a new subqueue. Note this is doing io under backlogLock,
 	// but we want to serialize these updates.
 	// TODO(pri): maybe we can improve that
 	subqueues, err := c.db.AllocateSubqueue(c.tqCtx, &persistencespb.SubqueueKey{
-		Priority: priority,
+		Priority:    key.priority,
+		FairnessKey: key.fairnessKey,
 	})
 	if err != nil {
 		c.signalIfFatal(err)
 		// If we failed to write the metadata update, just use 0. If err was a fatal error
 		// (most likely case), the subsequent call to SpoolTask will fail.
-		return 0
+		return subqueueZero
 	}
 
 	c.loadSubqueuesLocked(subqueues)
 
-	// After AllocateSubqueue added a subqueue for this priority, and we merged the result into
+	// After AllocateSubqueue added a subqueue for this key, and we merged the result into
 	// our state with loadSubqueuesLocked, this lookup should now find a subqueue.
-	if i, ok := c.subqueuesByPriority[priority]; ok {
+	if i, ok := c.subqueuesByKey[key]; ok {
 		return i
 	}
 
@@ -221,7 +253,7 @@ func (c *priBacklogManagerImpl) periodicSync() {
 }
 
 func (c *priBacklogManagerImpl) SpoolTask(taskInfo *persistencespb.TaskInfo) error {
-	subqueue := c.getSubqueueForPriority(taskInfo.Priority.GetPriorityKey())
+	subqueue := c.getSubqueueForTask(taskInfo)
 	err := c.taskWriter.appendTask(subqueue, taskInfo)
 	c.signalIfFatal(err)
 	return err
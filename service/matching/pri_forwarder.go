@@ -89,6 +89,7 @@ func (f *priForwarder) ForwardTask(ctx context.Context, task *internalTask) erro
 				ForwardInfo:            f.getForwardInfo(task),
 				VersionDirective:       task.event.Data.GetVersionDirective(),
 				Priority:               task.event.Data.GetPriority(),
+				FairnessKey:            task.event.Data.GetFairnessKey(),
 			},
 		)
 	case enumspb.TASK_QUEUE_TYPE_ACTIVITY:
@@ -107,6 +108,7 @@ func (f *priForwarder) ForwardTask(ctx context.Context, task *internalTask) erro
 				Stamp:                  task.event.Data.GetStamp(),
 				VersionDirective:       task.event.Data.GetVersionDirective(),
 				Priority:               task.event.Data.GetPriority(),
+				FairnessKey:            task.event.Data.GetFairnessKey(),
 			},
 		)
 	default:
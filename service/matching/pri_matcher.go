@@ -9,6 +9,7 @@ import (
 	"go.temporal.io/api/serviceerror"
 	enumsspb "go.temporal.io/server/api/enums/v1"
 	"go.temporal.io/server/api/matchingservice/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/backoff"
 	"go.temporal.io/server/common/clock"
@@ -56,6 +57,9 @@ type waitingPoller struct {
 	queryOnly       bool            // if true, poller can be given only query task, otherwise any task
 	isTaskForwarder bool
 	isTaskValidator bool
+	// worker identity and build ID, for per-worker rate limits
+	identity string
+	buildID  string
 }
 
 type matchResult struct {
@@ -100,7 +104,7 @@ func newPriTaskMatcher(
 ) *priTaskMatcher {
 	tm := &priTaskMatcher{
 		config:         config,
-		data:           newMatcherData(config, logger, clock.NewRealTimeSource(), fwdr != nil),
+		data:           newMatcherData(config, logger, metricsHandler, clock.NewRealTimeSource(), fwdr != nil),
 		tqCtx:          tqCtx,
 		logger:         logger,
 		metricsHandler: metricsHandler,
@@ -488,6 +492,16 @@ func (tm *priTaskMatcher) UpdateRatelimit(rps float64) {
 	tm.setLimitLocked()
 }
 
+// UpdateWorkerDispatchLimits updates the per-worker limits set in the task queue user data
+func (tm *priTaskMatcher) UpdateWorkerDispatchLimits(limits *persistencespb.WorkerDispatchLimits) {
+	tm.data.UpdateWorkerDispatchLimits(limits)
+}
+
+// ReleaseWorkerInFlightTask releases a task counted against the max in-flight of its worker
+func (tm *priTaskMatcher) ReleaseWorkerInFlightTask(inFlightID string) {
+	tm.data.ReleaseWorkerInFlightTask(inFlightID)
+}
+
 func (tm *priTaskMatcher) setAdminNsRate(rps float64) {
 	tm.limiterLock.Lock()
 	defer tm.limiterLock.Unlock()
@@ -546,11 +560,14 @@ func (tm *priTaskMatcher) poll(
 	}()
 
 	ctxs := []context.Context{ctx, tm.tqCtx}
+	identity, _ := ctx.Value(identityKey).(string) // nolint:revive
 	poller := &waitingPoller{
 		startTime:    start,
 		queryOnly:    queryOnly,
 		forwardCtx:   ctx,
 		pollMetadata: pollMetadata,
+		identity:     identity,
+		buildID:      workerBuildID(pollMetadata),
 	}
 	res := tm.data.EnqueuePollerAndWait(ctxs, poller)
 
@@ -61,11 +61,16 @@ type (
 		// it should adjust its poller count
 		pollerScalingDecision *taskqueuepb.PollerScalingDecision
 		recycleToken          func(*internalTask)
+		setInFlightTimeout    func(*internalTask, time.Duration)
 
 		// These fields are for use by matcherData:
 		waitableMatchResult
 		forwardCtx      context.Context // non-nil for sync match task only
 		isPollForwarder bool
+		fairnessKey     string
+		fairnessPass    float64 // order of the task within its priority level, see fairnessScheduler
+		fairnessTracked bool    // true if the task is accounted in fairnessScheduler
+		inFlightID      string  // non-empty if the task counts against the max in-flight of its worker
 	}
 
 	// taskResponse is used to report the result of either a match with a local poller,
@@ -256,6 +261,14 @@ func (task *internalTask) getPriority() *commonpb.Priority {
 	return nil
 }
 
+func (task *internalTask) getFairnessKey() string {
+	if task.event != nil {
+		return task.event.AllocatedTaskInfo.GetData().GetFairnessKey()
+	}
+	// query and nexus tasks don't have fairness keys
+	return ""
+}
+
 // finish marks a task as finished. Should be called after a poller picks up a task
 // and marks it as started. If the task is unable to marked as started, then this
 // method should be called with a non-nil error argument.
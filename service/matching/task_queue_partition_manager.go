@@ -3,6 +3,7 @@ package matching
 import (
 	"context"
 	"errors"
+	"fmt"
 	"sync"
 	"time"
 
@@ -650,6 +651,42 @@ func (pm *taskQueuePartitionManagerImpl) Describe(
 	}, nil
 }
 
+func (pm *taskQueuePartitionManagerImpl) MoveBacklogTasks(
+	ctx context.Context,
+	request *matchingservice.MoveTaskQueueTasksRequest,
+) (*matchingservice.MoveTaskQueueTasksResponse, error) {
+	physicalQueue := pm.defaultQueue
+	if buildID := request.GetSourceBuildId(); buildID != "" {
+		var err error
+		if physicalQueue, err = pm.getVersionedQueue(ctx, "", buildID, nil, true); err != nil {
+			return nil, err
+		}
+	} else if v := request.GetSourceDeploymentVersion(); v != "" {
+		version, err := worker_versioning.WorkerDeploymentVersionFromString(v)
+		if err != nil {
+			return nil, serviceerror.NewInvalidArgument(fmt.Sprintf("invalid source deployment version: %v", err))
+		}
+		if deployment := worker_versioning.DeploymentFromDeploymentVersion(version); deployment != nil {
+			if physicalQueue, err = pm.getVersionedQueue(ctx, "", deployment.GetBuildId(), deployment, true); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return physicalQueue.MoveBacklogTasks(ctx, request)
+}
+
+func (pm *taskQueuePartitionManagerImpl) ReleaseWorkerInFlightTask(inFlightID string) {
+	// The in-flight ID doesn't say which physical queue dispatched the task, IDs are unique so
+	// we release it in all of them.
+	pm.versionedQueuesLock.RLock()
+	for _, vq := range pm.versionedQueues {
+		vq.ReleaseWorkerInFlightTask(inFlightID)
+	}
+	pm.versionedQueuesLock.RUnlock()
+
+	pm.defaultQueue.ReleaseWorkerInFlightTask(inFlightID)
+}
+
 func (pm *taskQueuePartitionManagerImpl) Partition() tqid.Partition {
 	return pm.partition
 }
@@ -59,6 +59,13 @@ type (
 		// LegacyDescribeTaskQueue returns information about all pollers of this partition and the status of its unversioned physical queue
 		LegacyDescribeTaskQueue(includeTaskQueueStatus bool) (*matchingservice.DescribeTaskQueueResponse, error)
 		Describe(ctx context.Context, buildIds map[string]bool, includeAllActive, reportStats, reportPollers, internalTaskQueueStatus bool) (*matchingservice.DescribeTaskQueuePartitionResponse, error)
+		// MoveBacklogTasks moves one page of the backlog tasks of the unversioned queue, or of the versioned queue of the
+		// source build ID or deployment version of the request, selected by the filters of the request to another task
+		// queue.
+		MoveBacklogTasks(ctx context.Context, request *matchingservice.MoveTaskQueueTasksRequest) (*matchingservice.MoveTaskQueueTasksResponse, error)
+		// ReleaseWorkerInFlightTask releases a task counted against the max in-flight of its worker in any of the
+		// physical queues of this partition.
+		ReleaseWorkerInFlightTask(inFlightID string)
 		Partition() tqid.Partition
 		LongPollExpirationInterval() time.Duration
 		// TimeSinceLastFanOut returns the time since the last DescribeTaskQueuePartition fan out
@@ -233,6 +233,21 @@ func (mr *MocktaskQueuePartitionManagerMockRecorder) MarkAlive() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkAlive", reflect.TypeOf((*MocktaskQueuePartitionManager)(nil).MarkAlive))
 }
 
+// MoveBacklogTasks mocks base method.
+func (m *MocktaskQueuePartitionManager) MoveBacklogTasks(ctx context.Context, request *matchingservice.MoveTaskQueueTasksRequest) (*matchingservice.MoveTaskQueueTasksResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MoveBacklogTasks", ctx, request)
+	ret0, _ := ret[0].(*matchingservice.MoveTaskQueueTasksResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MoveBacklogTasks indicates an expected call of MoveBacklogTasks.
+func (mr *MocktaskQueuePartitionManagerMockRecorder) MoveBacklogTasks(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveBacklogTasks", reflect.TypeOf((*MocktaskQueuePartitionManager)(nil).MoveBacklogTasks), ctx, request)
+}
+
 // Namespace mocks base method.
 func (m *MocktaskQueuePartitionManager) Namespace() *namespace.Namespace {
 	m.ctrl.T.Helper()
@@ -291,6 +306,18 @@ func (mr *MocktaskQueuePartitionManagerMockRecorder) ProcessSpooledTask(ctx, tas
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProcessSpooledTask", reflect.TypeOf((*MocktaskQueuePartitionManager)(nil).ProcessSpooledTask), ctx, task, backlogQueue)
 }
 
+// ReleaseWorkerInFlightTask mocks base method.
+func (m *MocktaskQueuePartitionManager) ReleaseWorkerInFlightTask(inFlightID string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "ReleaseWorkerInFlightTask", inFlightID)
+}
+
+// ReleaseWorkerInFlightTask indicates an expected call of ReleaseWorkerInFlightTask.
+func (mr *MocktaskQueuePartitionManagerMockRecorder) ReleaseWorkerInFlightTask(inFlightID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseWorkerInFlightTask", reflect.TypeOf((*MocktaskQueuePartitionManager)(nil).ReleaseWorkerInFlightTask), inFlightID)
+}
+
 // Start mocks base method.
 func (m *MocktaskQueuePartitionManager) Start() {
 	m.ctrl.T.Helper()
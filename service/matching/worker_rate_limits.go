@@ -0,0 +1,327 @@
+package matching
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/google/uuid"
+	"go.temporal.io/api/serviceerror"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+)
+
+const (
+	// workerRateLimitDefaultKey is the key of the default limits of the workers that are not
+	// listed in the worker limit settings.
+	workerRateLimitDefaultKey = "*"
+
+	workerRateLimitGCInterval = time.Minute
+
+	// maxWorkerDispatchLimitKeys caps the number of identities and build IDs with limits in the
+	// task queue user data, to keep its size reasonable.
+	maxWorkerDispatchLimitKeys = 100
+)
+
+type (
+	// workerRateLimits limits the rate of tasks dispatched to the pollers of each worker
+	// identity and worker build ID, and the number of tasks dispatched to them that they
+	// haven't responded to yet (in-flight), so that a single worker or worker fleet can't take
+	// all the tasks of a task queue, and a misbehaving one can be throttled without affecting
+	// others. Like the whole queue limit, the configured limits are divided across the read
+	// partitions. In-flight tasks are counted per physical queue.
+	//
+	// The limits come from the task queue user data if set there, otherwise from dynamic config.
+	//
+	// workerRateLimits is not safe for concurrent use, it's under matcherData.lock.
+	workerRateLimits struct {
+		config *taskQueueConfig
+
+		// limits set in the task queue user data, they replace the dynamic config limits of
+		// the same kind (identity or build ID) when present
+		userData workerLimitSettings
+
+		// configured limits, refreshed before each round of matching
+		current workerLimitSettings
+
+		identities map[string]*simpleLimiter
+		buildIDs   map[string]*simpleLimiter
+		lastGC     int64 // unix nanos
+
+		// tasks dispatched to workers that haven't responded yet, by in-flight ID
+		inFlight         map[string]inFlightTask
+		identityInFlight map[string]int
+		buildIDInFlight  map[string]int
+		nextExpiration   int64 // unix nanos, earliest expiration of an in-flight task, or 0
+	}
+
+	workerLimitSettings struct {
+		identityRates       map[string]float64
+		buildIDRates        map[string]float64
+		identityMaxInFlight map[string]int
+		buildIDMaxInFlight  map[string]int
+	}
+
+	inFlightTask struct {
+		identity   string
+		buildID    string
+		expiration int64 // unix nanos
+	}
+)
+
+func newWorkerRateLimits(config *taskQueueConfig) workerRateLimits {
+	return workerRateLimits{
+		config:           config,
+		identities:       make(map[string]*simpleLimiter),
+		buildIDs:         make(map[string]*simpleLimiter),
+		inFlight:         make(map[string]inFlightTask),
+		identityInFlight: make(map[string]int),
+		buildIDInFlight:  make(map[string]int),
+	}
+}
+
+// workerBuildID returns the build ID a poller declares, whether it uses versioning or not.
+func workerBuildID(pollMetadata *pollMetadata) string {
+	if buildID := pollMetadata.deploymentOptions.GetBuildId(); buildID != "" {
+		return buildID
+	}
+	return pollMetadata.workerVersionCapabilities.GetBuildId()
+}
+
+// validateWorkerDispatchLimits validates limits before they're stored in the task queue user data.
+func validateWorkerDispatchLimits(limits *persistencespb.WorkerDispatchLimits) error {
+	for kind, byKey := range map[string]map[string]*persistencespb.WorkerDispatchLimit{
+		"identity": limits.GetByIdentity(),
+		"build ID": limits.GetByBuildId(),
+	} {
+		if len(byKey) > maxWorkerDispatchLimitKeys {
+			return serviceerror.NewInvalidArgument(fmt.Sprintf("too many worker %s limits: %d, max: %d", kind, len(byKey), maxWorkerDispatchLimitKeys))
+		}
+		for key, limit := range byKey {
+			rate := limit.GetMaxTasksPerSecond()
+			switch {
+			case key == "":
+				return serviceerror.NewInvalidArgument(fmt.Sprintf("worker %s limit with empty %s", kind, kind))
+			case math.IsNaN(rate) || math.IsInf(rate, 0) || rate < 0:
+				return serviceerror.NewInvalidArgument(fmt.Sprintf("invalid max tasks per second of worker %s %q: %v", kind, key, rate))
+			case limit.GetMaxInFlight() < 0:
+				return serviceerror.NewInvalidArgument(fmt.Sprintf("invalid max in-flight of worker %s %q: %d", kind, key, limit.GetMaxInFlight()))
+			}
+		}
+	}
+	return nil
+}
+
+// setUserDataLimits sets the limits stored in the task queue user data, nil clears them.
+func (w *workerRateLimits) setUserDataLimits(limits *persistencespb.WorkerDispatchLimits) {
+	w.userData = workerLimitSettings{}
+	if byIdentity := limits.GetByIdentity(); len(byIdentity) > 0 {
+		w.userData.identityRates, w.userData.identityMaxInFlight = splitWorkerDispatchLimits(byIdentity)
+	}
+	if byBuildID := limits.GetByBuildId(); len(byBuildID) > 0 {
+		w.userData.buildIDRates, w.userData.buildIDMaxInFlight = splitWorkerDispatchLimits(byBuildID)
+	}
+}
+
+func splitWorkerDispatchLimits(
+	limits map[string]*persistencespb.WorkerDispatchLimit,
+) (map[string]float64, map[string]int) {
+	rates := make(map[string]float64, len(limits))
+	maxInFlight := make(map[string]int, len(limits))
+	for key, limit := range limits {
+		rates[key] = limit.GetMaxTasksPerSecond()
+		maxInFlight[key] = int(limit.GetMaxInFlight())
+	}
+	return rates, maxInFlight
+}
+
+// refreshLimits reloads the configured limits and expires the in-flight tasks that workers
+// didn't respond to in time.
+func (w *workerRateLimits) refreshLimits(now int64) {
+	if w.userData.identityRates != nil {
+		w.current.identityRates = w.userData.identityRates
+		w.current.identityMaxInFlight = w.userData.identityMaxInFlight
+	} else {
+		w.current.identityRates = w.config.WorkerIdentityDispatchRate()
+		w.current.identityMaxInFlight = w.config.WorkerIdentityMaxInFlight()
+	}
+	if w.userData.buildIDRates != nil {
+		w.current.buildIDRates = w.userData.buildIDRates
+		w.current.buildIDMaxInFlight = w.userData.buildIDMaxInFlight
+	} else {
+		w.current.buildIDRates = w.config.WorkerBuildIDDispatchRate()
+		w.current.buildIDMaxInFlight = w.config.WorkerBuildIDMaxInFlight()
+	}
+	w.expireInFlight(now)
+}
+
+// readyTimeForPoller returns the time at which the poller can get a task, or 0 if the poller
+// is not limited. Pollers of a worker at its max in-flight are ready when the next in-flight
+// task expires, unless a worker responds to a task before that.
+func (w *workerRateLimits) readyTimeForPoller(poller *waitingPoller) int64 {
+	var ready int64
+	if _, ok := w.rate(w.current.identityRates, poller.identity); ok {
+		if lim, ok := w.identities[poller.identity]; ok {
+			ready = max(ready, lim.ready)
+		}
+	}
+	if _, ok := w.rate(w.current.buildIDRates, poller.buildID); ok {
+		if lim, ok := w.buildIDs[poller.buildID]; ok {
+			ready = max(ready, lim.ready)
+		}
+	}
+	if w.inFlightFull(w.current.identityMaxInFlight, w.identityInFlight, poller.identity) ||
+		w.inFlightFull(w.current.buildIDMaxInFlight, w.buildIDInFlight, poller.buildID) {
+		ready = max(ready, w.nextExpiration)
+	}
+	return ready
+}
+
+func (w *workerRateLimits) consumeTokens(now int64, poller *waitingPoller, tokens int64) {
+	w.consume(w.identities, w.current.identityRates, poller.identity, now, tokens)
+	w.consume(w.buildIDs, w.current.buildIDRates, poller.buildID, now, tokens)
+
+	if now-w.lastGC > workerRateLimitGCInterval.Nanoseconds() {
+		w.lastGC = now
+		w.gc(now)
+	}
+}
+
+func (w *workerRateLimits) consume(
+	limiters map[string]*simpleLimiter,
+	rates map[string]float64,
+	key string,
+	now int64,
+	tokens int64,
+) {
+	rate, ok := w.rate(rates, key)
+	if !ok {
+		return
+	}
+	lim, ok := limiters[key]
+	if !ok {
+		lim = &simpleLimiter{}
+		limiters[key] = lim
+	}
+	lim.set(rate/float64(max(1, w.config.NumReadPartitions())), burstDuration)
+	lim.consume(now, tokens)
+}
+
+// rate returns the configured rate of a worker identity or build ID. Pollers that don't set
+// the identity or build ID, and non-positive rates, are not limited.
+func (w *workerRateLimits) rate(rates map[string]float64, key string) (float64, bool) {
+	return workerLimit(rates, key)
+}
+
+// maxInFlight returns the configured max in-flight tasks of a worker identity or build ID in
+// this partition. Like rates, non-positive limits are not enforced, but a worker with a
+// positive limit can always have at least one task in-flight in each partition.
+func (w *workerRateLimits) maxInFlight(limits map[string]int, key string) (int, bool) {
+	limit, ok := workerLimit(limits, key)
+	if !ok {
+		return 0, false
+	}
+	return max(1, limit/max(1, w.config.NumReadPartitions())), true
+}
+
+func workerLimit[T int | float64](limits map[string]T, key string) (T, bool) {
+	if key == "" {
+		return 0, false
+	}
+	limit, ok := limits[key]
+	if !ok {
+		limit, ok = limits[workerRateLimitDefaultKey]
+	}
+	return limit, ok && limit > 0
+}
+
+func (w *workerRateLimits) inFlightFull(limits map[string]int, counts map[string]int, key string) bool {
+	limit, ok := w.maxInFlight(limits, key)
+	return ok && counts[key] >= limit
+}
+
+// addInFlight records a task dispatched to the poller and returns its in-flight ID, or an
+// empty string if the worker of the poller has no max in-flight.
+func (w *workerRateLimits) addInFlight(now int64, poller *waitingPoller) string {
+	_, identityLimited := w.maxInFlight(w.current.identityMaxInFlight, poller.identity)
+	_, buildIDLimited := w.maxInFlight(w.current.buildIDMaxInFlight, poller.buildID)
+	if !identityLimited && !buildIDLimited {
+		return ""
+	}
+
+	id := uuid.NewString()
+	expiration := now + w.config.WorkerInFlightTimeout().Nanoseconds()
+	w.inFlight[id] = inFlightTask{identity: poller.identity, buildID: poller.buildID, expiration: expiration}
+	w.identityInFlight[poller.identity]++
+	w.buildIDInFlight[poller.buildID]++
+	if w.nextExpiration == 0 || expiration < w.nextExpiration {
+		w.nextExpiration = expiration
+	}
+	return id
+}
+
+// setInFlightExpiration changes the expiration of an in-flight task once the timeout of the
+// started task is known. It returns true if the task is now the next one to expire.
+func (w *workerRateLimits) setInFlightExpiration(id string, expiration int64) bool {
+	task, ok := w.inFlight[id]
+	if !ok {
+		return false
+	}
+	task.expiration = expiration
+	w.inFlight[id] = task
+	if expiration < w.nextExpiration {
+		w.nextExpiration = expiration
+		return true
+	}
+	return false
+}
+
+// releaseInFlight removes an in-flight task, it returns false if the task was not found,
+// e.g. because it already expired.
+func (w *workerRateLimits) releaseInFlight(id string) bool {
+	task, ok := w.inFlight[id]
+	if !ok {
+		return false
+	}
+	delete(w.inFlight, id)
+	decrementInFlight(w.identityInFlight, task.identity)
+	decrementInFlight(w.buildIDInFlight, task.buildID)
+	if len(w.inFlight) == 0 {
+		w.nextExpiration = 0
+	}
+	return true
+}
+
+func decrementInFlight(counts map[string]int, key string) {
+	if counts[key] <= 1 {
+		delete(counts, key)
+	} else {
+		counts[key]--
+	}
+}
+
+// expireInFlight removes the in-flight tasks that workers didn't respond to in time. Workers
+// usually respond, so this scans all in-flight tasks only when one of them expired.
+func (w *workerRateLimits) expireInFlight(now int64) {
+	if w.nextExpiration == 0 || now < w.nextExpiration {
+		return
+	}
+	w.nextExpiration = 0
+	for id, task := range w.inFlight {
+		if task.expiration <= now {
+			w.releaseInFlight(id)
+		} else if w.nextExpiration == 0 || task.expiration < w.nextExpiration {
+			w.nextExpiration = task.expiration
+		}
+	}
+}
+
+// gc removes the limiters whose burst is fully refilled, they're equivalent to new ones.
+func (w *workerRateLimits) gc(now int64) {
+	for _, limiters := range []map[string]*simpleLimiter{w.identities, w.buildIDs} {
+		for key, lim := range limiters {
+			if lim.ready+lim.burst.Nanoseconds() <= now {
+				delete(limiters, key)
+			}
+		}
+	}
+}
@@ -0,0 +1,183 @@
+package matching
+
+import (
+	"math"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	commonpb "go.temporal.io/api/common/v1"
+	deploymentpb "go.temporal.io/api/deployment/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+)
+
+func TestWorkerRateLimits_Rate(t *testing.T) {
+	w := &workerRateLimits{}
+	rates := map[string]float64{"a": 2, "b": 0, workerRateLimitDefaultKey: 5}
+
+	rate, ok := w.rate(rates, "a")
+	require.True(t, ok)
+	require.Equal(t, float64(2), rate)
+	rate, ok = w.rate(rates, "c")
+	require.True(t, ok)
+	require.Equal(t, float64(5), rate)
+	_, ok = w.rate(rates, "b")
+	require.False(t, ok)
+	_, ok = w.rate(rates, "")
+	require.False(t, ok)
+	_, ok = w.rate(nil, "a")
+	require.False(t, ok)
+}
+
+func TestWorkerRateLimits_GC(t *testing.T) {
+	w := &workerRateLimits{
+		identities: map[string]*simpleLimiter{
+			"idle": {burst: time.Second, ready: 10},
+			"busy": {burst: time.Second, ready: 5 * time.Second.Nanoseconds()},
+		},
+		buildIDs: map[string]*simpleLimiter{},
+	}
+	w.gc(2 * time.Second.Nanoseconds())
+	require.Len(t, w.identities, 1)
+	require.Contains(t, w.identities, "busy")
+}
+
+func TestWorkerRateLimits_MaxInFlight(t *testing.T) {
+	w := &workerRateLimits{config: &taskQueueConfig{NumReadPartitions: func() int { return 4 }}}
+	limits := map[string]int{"a": 10, "b": 2, "c": 0}
+
+	limit, ok := w.maxInFlight(limits, "a")
+	require.True(t, ok)
+	require.Equal(t, 2, limit)
+	// at least one task per partition
+	limit, ok = w.maxInFlight(limits, "b")
+	require.True(t, ok)
+	require.Equal(t, 1, limit)
+	_, ok = w.maxInFlight(limits, "c")
+	require.False(t, ok)
+	_, ok = w.maxInFlight(limits, "d")
+	require.False(t, ok)
+}
+
+func TestWorkerRateLimits_InFlight(t *testing.T) {
+	w := newWorkerRateLimits(&taskQueueConfig{
+		NumReadPartitions:          func() int { return 1 },
+		WorkerIdentityDispatchRate: func() map[string]float64 { return nil },
+		WorkerBuildIDDispatchRate:  func() map[string]float64 { return nil },
+		WorkerIdentityMaxInFlight:  func() map[string]int { return map[string]int{"w": 2} },
+		WorkerBuildIDMaxInFlight:   func() map[string]int { return nil },
+		WorkerInFlightTimeout:      func() time.Duration { return time.Second },
+	})
+	poller := &waitingPoller{identity: "w", buildID: "v1"}
+	now := time.Second.Nanoseconds()
+	w.refreshLimits(now)
+
+	id1 := w.addInFlight(now, poller)
+	require.NotEmpty(t, id1)
+	require.Zero(t, w.readyTimeForPoller(poller))
+	id2 := w.addInFlight(now+10, poller)
+	require.Equal(t, now+time.Second.Nanoseconds(), w.readyTimeForPoller(poller))
+	require.Empty(t, w.addInFlight(now, &waitingPoller{identity: "other"}))
+
+	require.True(t, w.releaseInFlight(id1))
+	require.False(t, w.releaseInFlight(id1))
+	require.Zero(t, w.readyTimeForPoller(poller))
+
+	// in-flight tasks expire after the timeout
+	w.refreshLimits(now + 10 + time.Second.Nanoseconds())
+	require.False(t, w.releaseInFlight(id2))
+	require.Empty(t, w.inFlight)
+	require.Empty(t, w.identityInFlight)
+	require.Empty(t, w.buildIDInFlight)
+	require.Zero(t, w.nextExpiration)
+}
+
+func TestWorkerRateLimits_InFlightExpiration(t *testing.T) {
+	w := newWorkerRateLimits(&taskQueueConfig{
+		NumReadPartitions:          func() int { return 1 },
+		WorkerIdentityDispatchRate: func() map[string]float64 { return nil },
+		WorkerBuildIDDispatchRate:  func() map[string]float64 { return nil },
+		WorkerIdentityMaxInFlight:  func() map[string]int { return map[string]int{"w": 1} },
+		WorkerBuildIDMaxInFlight:   func() map[string]int { return nil },
+		WorkerInFlightTimeout:      func() time.Duration { return time.Minute },
+	})
+	poller := &waitingPoller{identity: "w"}
+	now := time.Second.Nanoseconds()
+	w.refreshLimits(now)
+
+	id := w.addInFlight(now, poller)
+	require.Equal(t, now+time.Minute.Nanoseconds(), w.readyTimeForPoller(poller))
+	require.False(t, w.setInFlightExpiration("unknown", now))
+
+	// the task expires after its own timeout once started
+	require.True(t, w.setInFlightExpiration(id, now+time.Second.Nanoseconds()))
+	require.Equal(t, now+time.Second.Nanoseconds(), w.readyTimeForPoller(poller))
+	w.refreshLimits(now + time.Second.Nanoseconds())
+	require.False(t, w.releaseInFlight(id))
+	require.Zero(t, w.readyTimeForPoller(poller))
+}
+
+func TestWorkerRateLimits_UserDataLimits(t *testing.T) {
+	w := newWorkerRateLimits(&taskQueueConfig{
+		WorkerIdentityDispatchRate: func() map[string]float64 { return map[string]float64{"w": 1} },
+		WorkerBuildIDDispatchRate:  func() map[string]float64 { return map[string]float64{"v1": 1} },
+		WorkerIdentityMaxInFlight:  func() map[string]int { return map[string]int{"w": 1} },
+		WorkerBuildIDMaxInFlight:   func() map[string]int { return map[string]int{"v1": 1} },
+	})
+	w.setUserDataLimits(&persistencespb.WorkerDispatchLimits{
+		ByIdentity: map[string]*persistencespb.WorkerDispatchLimit{
+			workerRateLimitDefaultKey: {MaxTasksPerSecond: 5, MaxInFlight: 3},
+		},
+	})
+	w.refreshLimits(0)
+
+	// identity limits come from the user data, build ID limits from dynamic config
+	require.Equal(t, map[string]float64{workerRateLimitDefaultKey: 5}, w.current.identityRates)
+	require.Equal(t, map[string]int{workerRateLimitDefaultKey: 3}, w.current.identityMaxInFlight)
+	require.Equal(t, map[string]float64{"v1": 1}, w.current.buildIDRates)
+	require.Equal(t, map[string]int{"v1": 1}, w.current.buildIDMaxInFlight)
+
+	w.setUserDataLimits(nil)
+	w.refreshLimits(0)
+	require.Equal(t, map[string]float64{"w": 1}, w.current.identityRates)
+	require.Equal(t, map[string]int{"w": 1}, w.current.identityMaxInFlight)
+}
+
+func TestValidateWorkerDispatchLimits(t *testing.T) {
+	require.NoError(t, validateWorkerDispatchLimits(nil))
+	require.NoError(t, validateWorkerDispatchLimits(&persistencespb.WorkerDispatchLimits{
+		ByIdentity: map[string]*persistencespb.WorkerDispatchLimit{"w": {MaxTasksPerSecond: 1.5, MaxInFlight: 10}},
+		ByBuildId:  map[string]*persistencespb.WorkerDispatchLimit{workerRateLimitDefaultKey: {MaxInFlight: 1}},
+	}))
+
+	for _, limit := range []*persistencespb.WorkerDispatchLimit{
+		{MaxTasksPerSecond: -1},
+		{MaxTasksPerSecond: math.Inf(1)},
+		{MaxInFlight: -1},
+	} {
+		require.Error(t, validateWorkerDispatchLimits(&persistencespb.WorkerDispatchLimits{
+			ByIdentity: map[string]*persistencespb.WorkerDispatchLimit{"w": limit},
+		}))
+	}
+	require.Error(t, validateWorkerDispatchLimits(&persistencespb.WorkerDispatchLimits{
+		ByBuildId: map[string]*persistencespb.WorkerDispatchLimit{"": {MaxInFlight: 1}},
+	}))
+
+	tooMany := make(map[string]*persistencespb.WorkerDispatchLimit)
+	for i := range maxWorkerDispatchLimitKeys + 1 {
+		tooMany[strconv.Itoa(i)] = &persistencespb.WorkerDispatchLimit{MaxInFlight: 1}
+	}
+	require.Error(t, validateWorkerDispatchLimits(&persistencespb.WorkerDispatchLimits{ByIdentity: tooMany}))
+}
+
+func TestWorkerBuildID(t *testing.T) {
+	require.Empty(t, workerBuildID(&pollMetadata{}))
+	require.Equal(t, "v1", workerBuildID(&pollMetadata{
+		workerVersionCapabilities: &commonpb.WorkerVersionCapabilities{BuildId: "v1"},
+	}))
+	require.Equal(t, "v2", workerBuildID(&pollMetadata{
+		workerVersionCapabilities: &commonpb.WorkerVersionCapabilities{BuildId: "v1"},
+		deploymentOptions:         &deploymentpb.WorkerDeploymentOptions{BuildId: "v2"},
+	}))
+}
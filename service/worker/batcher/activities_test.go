@@ -12,12 +12,20 @@ import (
 	commonpb "go.temporal.io/api/common/v1"
 	enumspb "go.temporal.io/api/enums/v1"
 	historypb "go.temporal.io/api/history/v1"
+	"go.temporal.io/api/serviceerror"
 	workflowpb "go.temporal.io/api/workflow/v1"
 	"go.temporal.io/api/workflowservice/v1"
 	"go.temporal.io/sdk/testsuite"
+	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/api/historyservicemock/v1"
+	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/primitives/timestamp"
+	"go.temporal.io/server/common/sdk"
 	"go.temporal.io/server/common/testing/mockapi/workflowservicemock/v1"
+	"go.temporal.io/server/common/testing/mocksdk"
+	"go.temporal.io/server/common/testing/protomock"
 	"go.uber.org/mock/gomock"
 )
 
@@ -332,6 +340,18 @@ func (s *activitiesSuite) TestAdjustQuery() {
 			expectedResult: fmt.Sprintf("(A=B OR ExecutionStatus='Completed') AND (%s)", statusRunningQueryFilter),
 			batchType:      BatchTypeTerminate,
 		},
+		{
+			name:           "Pause workflow",
+			query:          "A=B",
+			expectedResult: fmt.Sprintf("(A=B) AND (%s)", statusRunningQueryFilter),
+			batchType:      BatchTypePauseWorkflow,
+		},
+		{
+			name:           "Unpause workflow",
+			query:          "A=B",
+			expectedResult: fmt.Sprintf("(A=B) AND (%s)", statusRunningQueryFilter),
+			batchType:      BatchTypeUnpauseWorkflow,
+		},
 		{
 			name:           "Not supported batch type",
 			query:          "A=B",
@@ -348,3 +368,49 @@ func (s *activitiesSuite) TestAdjustQuery() {
 		})
 	}
 }
+
+func (s *activitiesSuite) TestBatchActivity_PauseWorkflow() {
+	mockHistoryClient := historyservicemock.NewMockHistoryServiceClient(s.controller)
+	mockClientFactory := sdk.NewMockClientFactory(s.controller)
+	mockClientFactory.EXPECT().NewClient(gomock.Any()).Return(mocksdk.NewMockClient(s.controller))
+	a := &activities{
+		activityDeps: activityDeps{
+			MetricsHandler: metrics.NoopMetricsHandler,
+			Logger:         log.NewTestLogger(),
+			ClientFactory:  mockClientFactory,
+			FrontendClient: s.mockFrontendClient,
+			HistoryClient:  mockHistoryClient,
+		},
+		namespace:   "test-namespace",
+		namespaceID: "test-namespace-id",
+		rps:         dynamicconfig.GetIntPropertyFnFilteredByNamespace(100),
+		concurrency: dynamicconfig.GetIntPropertyFnFilteredByNamespace(1),
+	}
+
+	mockHistoryClient.EXPECT().PauseWorkflowExecution(gomock.Any(), protomock.Eq(&historyservice.PauseWorkflowExecutionRequest{
+		NamespaceId: "test-namespace-id",
+		Execution:   &commonpb.WorkflowExecution{WorkflowId: "wf1", RunId: "run1"},
+	})).Return(&historyservice.PauseWorkflowExecutionResponse{}, nil)
+	mockHistoryClient.EXPECT().PauseWorkflowExecution(gomock.Any(), protomock.Eq(&historyservice.PauseWorkflowExecutionRequest{
+		NamespaceId: "test-namespace-id",
+		Execution:   &commonpb.WorkflowExecution{WorkflowId: "wf2"},
+	})).Return(nil, serviceerror.NewNotFound("workflow not found"))
+
+	env := s.NewTestActivityEnvironment()
+	env.RegisterActivity(a)
+	result, err := env.ExecuteActivity(a.BatchActivity, BatchParams{
+		Namespace: "test-namespace",
+		Executions: []*commonpb.WorkflowExecution{
+			{WorkflowId: "wf1", RunId: "run1"},
+			{WorkflowId: "wf2"},
+		},
+		Reason:                   "test",
+		BatchType:                BatchTypePauseWorkflow,
+		AttemptsOnRetryableError: 1,
+	})
+	s.NoError(err)
+	var hbd HeartBeatDetails
+	s.NoError(result.Get(&hbd))
+	s.Equal(2, hbd.SuccessCount)
+	s.Equal(0, hbd.ErrorCount)
+}
@@ -42,6 +42,10 @@ const (
 	BatchTypeUpdateOptions = "update_options"
 	// BatchTypePauseActivities is batch type for unpausing activities
 	BatchTypeUnpauseActivities = "unpause_activities"
+	// BatchTypePauseWorkflow is batch type for pausing workflows
+	BatchTypePauseWorkflow = "pause_workflow"
+	// BatchTypeUnpauseWorkflow is batch type for unpausing workflows
+	BatchTypeUnpauseWorkflow = "unpause_workflow"
 )
 
 var (
@@ -108,7 +112,7 @@ type (
 		Executions []*commonpb.WorkflowExecution
 		// Reason for the operation
 		Reason string
-		// Supporting: signal,cancel,terminate,delete,reset
+		// Supporting: signal,cancel,terminate,delete,reset,update_options,unpause_activities,pause_workflow,unpause_workflow
 		BatchType string
 
 		// Below are all optional
@@ -245,7 +249,7 @@ func validateParams(params BatchParams) error {
 			return fmt.Errorf("must provide UpdateMask")
 		}
 		return worker_versioning.ValidateVersioningOverride(params.UpdateOptionsParams.WorkflowExecutionOptions.VersioningOverride)
-	case BatchTypeCancel, BatchTypeTerminate, BatchTypeDelete, BatchTypeReset:
+	case BatchTypeCancel, BatchTypeTerminate, BatchTypeDelete, BatchTypeReset, BatchTypePauseWorkflow, BatchTypeUnpauseWorkflow:
 		return nil
 	case BatchTypeUnpauseActivities:
 		if params.UnpauseActivitiesParams.ActivityType == "" && !params.UnpauseActivitiesParams.MatchAll {
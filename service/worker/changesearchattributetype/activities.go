@@ -0,0 +1,435 @@
+package changesearchattributetype
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	enumspb "go.temporal.io/api/enums/v1"
+	namespacepb "go.temporal.io/api/namespace/v1"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/api/workflowservice/v1"
+	"go.temporal.io/sdk/activity"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/common/headers"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence/visibility"
+	"go.temporal.io/server/common/persistence/visibility/manager"
+	"go.temporal.io/server/common/persistence/visibility/store/elasticsearch"
+	"go.temporal.io/server/common/quotas"
+	"go.temporal.io/server/common/searchattribute"
+	"go.temporal.io/server/common/util"
+)
+
+const (
+	errTypeInvalidArgument = "InvalidArgument"
+
+	// reservedNewFieldAliasPrefix and reservedOldFieldAliasPrefix prefix the alias of a search attribute to hold the
+	// SQL columns of a type change. They start with searchattribute.ReservedPrefix, so they can't be used by a custom
+	// search attribute.
+	reservedNewFieldAliasPrefix = searchattribute.ReservedPrefix + "NewFieldOf"
+	reservedOldFieldAliasPrefix = searchattribute.ReservedPrefix + "OldFieldOf"
+)
+
+type (
+	activities struct {
+		sqlVisibility     bool
+		frontendClient    workflowservice.WorkflowServiceClient
+		historyClient     historyservice.HistoryServiceClient
+		saManager         searchattribute.Manager
+		visibilityManager manager.VisibilityManager
+		metricsHandler    metrics.Handler
+		logger            log.Logger
+	}
+
+	// valuesHeartbeatDetails are the heartbeat details of CopyValues and ClearValues, a retried activity continues
+	// from the page of its last heartbeat.
+	valuesHeartbeatDetails struct {
+		NextPageToken []byte
+		Result        valuesResult
+	}
+
+	// rewriteHeartbeatDetails are the heartbeat details of RewriteValues
+	rewriteHeartbeatDetails struct {
+		NextPageToken []byte
+		Result        rewriteValuesResult
+	}
+)
+
+// PrepareMigration resolves the fields of the type change of a search attribute and validates it
+func (a *activities) PrepareMigration(ctx context.Context, request prepareRequest) (migrationPlan, error) {
+	resp, err := a.frontendClient.DescribeNamespace(ctx, &workflowservice.DescribeNamespaceRequest{
+		Namespace: request.Namespace,
+	})
+	if err != nil {
+		return migrationPlan{}, err
+	}
+	visibilityManagers := a.visibilityManagers()
+	saTypeMap, err := a.saManager.GetSearchAttributes(visibilityManagers[0].GetIndexName(), true)
+	if err != nil {
+		return migrationPlan{}, err
+	}
+
+	plan := migrationPlan{
+		Namespace:   request.Namespace,
+		NamespaceID: resp.GetNamespaceInfo().GetId(),
+		Alias:       request.SearchAttribute,
+		NewType:     request.NewType,
+	}
+	aliases := resp.GetConfig().GetCustomSearchAttributeAliases()
+	if a.sqlVisibility {
+		err = a.prepareSQL(&plan, saTypeMap.Custom(), aliases)
+	} else {
+		err = a.prepareElasticsearch(&plan, saTypeMap.Custom(), aliases, request.NewFieldName)
+	}
+	if err != nil {
+		return migrationPlan{}, err
+	}
+
+	if !searchattribute.IsTypeConversionSupported(plan.OldType, plan.NewType) {
+		return migrationPlan{}, newInvalidArgumentError(fmt.Sprintf("type of search attribute %s can't be changed from %s to %s", plan.Alias, plan.OldType, plan.NewType))
+	}
+
+	// the new field is added to the index of every Elasticsearch store, it's shared by the stores of dual
+	// visibility since the namespace aliases are
+	for _, visibilityManager := range visibilityManagers {
+		if visibilityManager.GetStoreNames()[0] != elasticsearch.PersistenceName {
+			continue
+		}
+		indexName := visibilityManager.GetIndexName()
+		indexTypeMap, err := a.saManager.GetSearchAttributes(indexName, true)
+		if err != nil {
+			return migrationPlan{}, err
+		}
+		fieldType, ok := indexTypeMap.Custom()[plan.NewField]
+		if !ok {
+			plan.IndicesToUpdate = append(plan.IndicesToUpdate, indexName)
+		} else if fieldType != plan.NewType {
+			return migrationPlan{}, newInvalidArgumentError(fmt.Sprintf("search attribute field %s already exists with type %s in index %s", plan.NewField, fieldType, indexName))
+		}
+	}
+	return plan, nil
+}
+
+// visibilityManagers returns the primary and secondary visibility managers with dual visibility
+func (a *activities) visibilityManagers() []manager.VisibilityManager {
+	if visibilityManagerDual, ok := a.visibilityManager.(*visibility.VisibilityManagerDual); ok {
+		return []manager.VisibilityManager{
+			visibilityManagerDual.GetPrimaryVisibility(),
+			visibilityManagerDual.GetSecondaryVisibility(),
+		}
+	}
+	return []manager.VisibilityManager{a.visibilityManager}
+}
+
+// prepareSQL finds the column of the search attribute and a free pre-allocated column of the new type. The column
+// reserved by a previous attempt of the type change is reused.
+func (a *activities) prepareSQL(
+	plan *migrationPlan,
+	customTypes map[string]enumspb.IndexedValueType,
+	fieldToAlias map[string]string,
+) error {
+	aliasToField := util.InverseMap(fieldToAlias)
+	oldField, ok := aliasToField[plan.Alias]
+	if !ok {
+		return newInvalidArgumentError(fmt.Sprintf("search attribute %s doesn't exist in namespace %s", plan.Alias, plan.Namespace))
+	}
+	plan.OldField = oldField
+	plan.OldType = customTypes[oldField]
+
+	if field, ok := aliasToField[reservedNewFieldAlias(plan.Alias)]; ok && customTypes[field] == plan.NewType {
+		plan.NewField = field
+		return nil
+	}
+	var fields []string
+	for field, fieldType := range searchattribute.GetSqlDbIndexSearchAttributes().GetCustomSearchAttributes() {
+		if fieldType != plan.NewType {
+			continue
+		}
+		// make sure the pre-allocated custom search attributes are created in cluster metadata
+		if _, ok := customTypes[field]; !ok {
+			continue
+		}
+		if _, ok := fieldToAlias[field]; ok {
+			continue
+		}
+		fields = append(fields, field)
+	}
+	if len(fields) == 0 {
+		return newInvalidArgumentError(fmt.Sprintf("no free search attribute field of type %s in namespace %s", plan.NewType, plan.Namespace))
+	}
+	sort.Strings(fields)
+	plan.NewField = fields[0]
+	return nil
+}
+
+// prepareElasticsearch finds the field of the search attribute and names the field of the new type: search attribute
+// names are field names with Elasticsearch, unless the namespace aliases them, e.g. after a previous type change.
+// The field reserved by a previous attempt of the type change is reused.
+func (a *activities) prepareElasticsearch(
+	plan *migrationPlan,
+	customTypes map[string]enumspb.IndexedValueType,
+	fieldToAlias map[string]string,
+	newFieldName string,
+) error {
+	aliasToField := util.InverseMap(fieldToAlias)
+	oldField, ok := aliasToField[plan.Alias]
+	if !ok {
+		if _, aliased := fieldToAlias[plan.Alias]; !aliased {
+			oldField = plan.Alias
+		}
+	}
+	oldType, ok := customTypes[oldField]
+	if !ok {
+		return newInvalidArgumentError(fmt.Sprintf("search attribute %s doesn't exist in namespace %s", plan.Alias, plan.Namespace))
+	}
+	plan.OldField = oldField
+	plan.OldType = oldType
+
+	if field, ok := aliasToField[reservedNewFieldAlias(plan.Alias)]; ok && customTypes[field] == plan.NewType {
+		plan.NewField = field
+		return nil
+	}
+	if newFieldName == "" {
+		newFieldName = plan.Alias + plan.NewType.String()
+	}
+	if searchattribute.IsReserved(newFieldName) {
+		return newInvalidArgumentError(fmt.Sprintf("search attribute field %s is reserved by system", newFieldName))
+	}
+	if _, ok := customTypes[newFieldName]; ok {
+		return newInvalidArgumentError(fmt.Sprintf("search attribute field %s already exists", newFieldName))
+	}
+	if _, ok := aliasToField[newFieldName]; ok {
+		return newInvalidArgumentError(fmt.Sprintf("search attribute field %s is used as alias in namespace %s", newFieldName, plan.Namespace))
+	}
+	plan.NewField = newFieldName
+	return nil
+}
+
+// ReserveField holds the field of the new type with a reserved alias, so that it's not allocated to another search
+// attribute during the backfill.
+func (a *activities) ReserveField(ctx context.Context, plan migrationPlan) error {
+	return a.updateAliases(ctx, plan, plan.NewField, reservedNewFieldAlias(plan.Alias), map[string]string{
+		plan.NewField: reservedNewFieldAlias(plan.Alias),
+	})
+}
+
+// SwapAlias maps the search attribute to the field of the new type and holds the old field until its values are
+// removed, in a single namespace update.
+func (a *activities) SwapAlias(ctx context.Context, plan migrationPlan) error {
+	return a.updateAliases(ctx, plan, plan.NewField, plan.Alias, map[string]string{
+		plan.NewField: plan.Alias,
+		plan.OldField: reservedOldFieldAlias(plan.Alias),
+	})
+}
+
+// ReleaseField frees the old field once its values are removed. An Elasticsearch field can't be removed from the
+// index, it's left unused.
+func (a *activities) ReleaseField(ctx context.Context, plan migrationPlan) error {
+	resp, err := a.frontendClient.DescribeNamespace(ctx, &workflowservice.DescribeNamespaceRequest{
+		Namespace: plan.Namespace,
+	})
+	if err != nil {
+		return err
+	}
+	if resp.GetConfig().GetCustomSearchAttributeAliases()[plan.OldField] != reservedOldFieldAlias(plan.Alias) {
+		return nil
+	}
+	_, err = a.frontendClient.UpdateNamespace(ctx, &workflowservice.UpdateNamespaceRequest{
+		Namespace: plan.Namespace,
+		Config: &namespacepb.NamespaceConfig{
+			CustomSearchAttributeAliases: map[string]string{plan.OldField: ""},
+		},
+	})
+	return err
+}
+
+// updateAliases upserts the aliases of the namespace unless field is already mapped to alias by a previous attempt
+func (a *activities) updateAliases(
+	ctx context.Context,
+	plan migrationPlan,
+	field string,
+	alias string,
+	upsert map[string]string,
+) error {
+	resp, err := a.frontendClient.DescribeNamespace(ctx, &workflowservice.DescribeNamespaceRequest{
+		Namespace: plan.Namespace,
+	})
+	if err != nil {
+		return err
+	}
+	currentAlias, ok := resp.GetConfig().GetCustomSearchAttributeAliases()[field]
+	if currentAlias == alias {
+		return nil
+	}
+	if ok && !searchattribute.IsReserved(currentAlias) {
+		return newInvalidArgumentError(fmt.Sprintf("search attribute field %s was allocated to %s", field, currentAlias))
+	}
+	_, err = a.frontendClient.UpdateNamespace(ctx, &workflowservice.UpdateNamespaceRequest{
+		Namespace: plan.Namespace,
+		Config: &namespacepb.NamespaceConfig{
+			CustomSearchAttributeAliases: upsert,
+		},
+	})
+	return err
+}
+
+// CopyValues copies the values of the old field into the new field of the executions which don't have a value in the
+// new field yet.
+func (a *activities) CopyValues(ctx context.Context, request copyValuesRequest) (valuesResult, error) {
+	return a.processValues(ctx, request.Namespace, func(nextPageToken []byte) (*manager.SearchAttributeValuesResponse, error) {
+		return a.visibilityManager.CopySearchAttributeValues(ctx, &manager.CopySearchAttributeValuesRequest{
+			NamespaceID:   namespace.ID(request.NamespaceID),
+			SourceField:   request.SourceField,
+			SourceType:    request.SourceType,
+			TargetField:   request.TargetField,
+			TargetType:    request.TargetType,
+			PageSize:      request.PageSize,
+			NextPageToken: nextPageToken,
+		})
+	})
+}
+
+// ClearValues removes the values of the old field
+func (a *activities) ClearValues(ctx context.Context, request clearValuesRequest) (valuesResult, error) {
+	return a.processValues(ctx, request.Namespace, func(nextPageToken []byte) (*manager.SearchAttributeValuesResponse, error) {
+		return a.visibilityManager.ClearSearchAttributeValues(ctx, &manager.ClearSearchAttributeValuesRequest{
+			NamespaceID:   namespace.ID(request.NamespaceID),
+			Field:         request.Field,
+			PageSize:      request.PageSize,
+			NextPageToken: nextPageToken,
+		})
+	})
+}
+
+// RewriteValues moves the value of the old field into the new field in the mutable state of the running executions
+// which still have a value in the old field, so that they don't reference the old field anymore once it's released.
+// The executions are listed by the reserved alias of the old field. It's one pass over the executions: those whose
+// visibility record isn't updated yet are listed again by the next pass.
+func (a *activities) RewriteValues(ctx context.Context, request rewriteValuesRequest) (rewriteValuesResult, error) {
+	ctx = headers.SetCallerName(ctx, request.Namespace)
+	var details rewriteHeartbeatDetails
+	if activity.HasHeartbeatDetails(ctx) {
+		if err := activity.GetHeartbeatDetails(ctx, &details); err != nil {
+			return rewriteValuesResult{}, err
+		}
+	}
+	rateLimiter := quotas.NewRateLimiter(float64(request.RPS), request.RPS)
+	query := fmt.Sprintf("%s = '%s' AND %s IS NOT NULL",
+		searchattribute.ExecutionStatus,
+		enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING.String(),
+		reservedOldFieldAlias(request.Alias),
+	)
+
+	for {
+		resp, err := a.visibilityManager.ListWorkflowExecutions(ctx, &manager.ListWorkflowExecutionsRequestV2{
+			NamespaceID:   namespace.ID(request.NamespaceID),
+			Namespace:     namespace.Name(request.Namespace),
+			PageSize:      request.PageSize,
+			NextPageToken: details.NextPageToken,
+			Query:         searchattribute.QueryWithAnyNamespaceDivision(query),
+		})
+		if err != nil {
+			return details.Result, err
+		}
+		pageResult := details.Result
+		for _, execution := range resp.Executions {
+			if err := rateLimiter.Wait(ctx); err != nil {
+				return details.Result, err
+			}
+			details.Result.ExecutionCount++
+			moveResp, err := a.historyClient.MoveSearchAttributeValue(ctx, &historyservice.MoveSearchAttributeValueRequest{
+				NamespaceId: request.NamespaceID,
+				Execution:   execution.GetExecution(),
+				SourceField: request.SourceField,
+				SourceType:  request.SourceType,
+				TargetField: request.TargetField,
+				TargetType:  request.TargetType,
+			})
+			if err != nil {
+				var notFound *serviceerror.NotFound
+				if errors.As(err, &notFound) {
+					// deleted since it was listed
+					continue
+				}
+				return details.Result, err
+			}
+			if moveResp.GetMoved() {
+				details.Result.UpdatedCount++
+			}
+			if moveResp.GetConversionFailed() {
+				details.Result.FailedCount++
+			}
+		}
+		metrics.ChangeSearchAttributeTypeUpdatedRecords.With(a.metricsHandler).Record(details.Result.UpdatedCount-pageResult.UpdatedCount, metrics.NamespaceTag(request.Namespace))
+		metrics.ChangeSearchAttributeTypeFailedRecords.With(a.metricsHandler).Record(details.Result.FailedCount-pageResult.FailedCount, metrics.NamespaceTag(request.Namespace))
+
+		details.NextPageToken = resp.NextPageToken
+		activity.RecordHeartbeat(ctx, details)
+		if len(details.NextPageToken) == 0 {
+			break
+		}
+	}
+
+	a.logger.Info("Search attribute values of running executions moved.",
+		tag.WorkflowNamespace(request.Namespace),
+		tag.Counter(int(details.Result.UpdatedCount)),
+		tag.NewInt64("executions", details.Result.ExecutionCount),
+		tag.NewInt64("failed", details.Result.FailedCount),
+	)
+	return details.Result, nil
+}
+
+func (a *activities) processValues(
+	ctx context.Context,
+	nsName string,
+	processPage func(nextPageToken []byte) (*manager.SearchAttributeValuesResponse, error),
+) (valuesResult, error) {
+	var details valuesHeartbeatDetails
+	if activity.HasHeartbeatDetails(ctx) {
+		if err := activity.GetHeartbeatDetails(ctx, &details); err != nil {
+			return valuesResult{}, err
+		}
+	}
+
+	for {
+		resp, err := processPage(details.NextPageToken)
+		if err != nil {
+			return details.Result, err
+		}
+		details.Result.UpdatedCount += resp.UpdatedCount
+		details.Result.FailedCount += resp.FailedCount
+		metrics.ChangeSearchAttributeTypeUpdatedRecords.With(a.metricsHandler).Record(resp.UpdatedCount, metrics.NamespaceTag(nsName))
+		metrics.ChangeSearchAttributeTypeFailedRecords.With(a.metricsHandler).Record(resp.FailedCount, metrics.NamespaceTag(nsName))
+
+		details.NextPageToken = resp.NextPageToken
+		activity.RecordHeartbeat(ctx, details)
+		if len(details.NextPageToken) == 0 {
+			break
+		}
+	}
+
+	a.logger.Info("Search attribute values updated.",
+		tag.WorkflowNamespace(nsName),
+		tag.Counter(int(details.Result.UpdatedCount)),
+		tag.NewInt64("failed", details.Result.FailedCount),
+	)
+	return details.Result, nil
+}
+
+func reservedNewFieldAlias(alias string) string {
+	return reservedNewFieldAliasPrefix + alias
+}
+
+func reservedOldFieldAlias(alias string) string {
+	return reservedOldFieldAliasPrefix + alias
+}
+
+func newInvalidArgumentError(msg string) error {
+	return temporal.NewNonRetryableApplicationError(msg, errTypeInvalidArgument, nil)
+}
@@ -0,0 +1,308 @@
+package changesearchattributetype
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	namespacepb "go.temporal.io/api/namespace/v1"
+	"go.temporal.io/api/serviceerror"
+	workflowpb "go.temporal.io/api/workflow/v1"
+	"go.temporal.io/api/workflowservice/v1"
+	"go.temporal.io/api/workflowservicemock/v1"
+	"go.temporal.io/sdk/testsuite"
+	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/api/historyservicemock/v1"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence/visibility/manager"
+	"go.temporal.io/server/common/persistence/visibility/store/elasticsearch"
+	"go.temporal.io/server/common/searchattribute"
+	"go.uber.org/mock/gomock"
+)
+
+func newDescribeNamespaceResponse(aliases map[string]string) *workflowservice.DescribeNamespaceResponse {
+	return &workflowservice.DescribeNamespaceResponse{
+		NamespaceInfo: &namespacepb.NamespaceInfo{Name: "namespace", Id: "namespace-id"},
+		Config:        &namespacepb.NamespaceConfig{CustomSearchAttributeAliases: aliases},
+	}
+}
+
+func Test_PrepareMigration_SQL(t *testing.T) {
+	controller := gomock.NewController(t)
+	frontendClient := workflowservicemock.NewMockWorkflowServiceClient(controller)
+	saManager := searchattribute.NewMockManager(controller)
+	visibilityManager := manager.NewMockVisibilityManager(controller)
+	a := &activities{
+		sqlVisibility:     true,
+		frontendClient:    frontendClient,
+		saManager:         saManager,
+		visibilityManager: visibilityManager,
+		metricsHandler:    metrics.NoopMetricsHandler,
+		logger:            log.NewTestLogger(),
+	}
+
+	visibilityManager.EXPECT().GetStoreNames().Return([]string{"postgres12"})
+	visibilityManager.EXPECT().GetIndexName().Return("temporal_visibility")
+	frontendClient.EXPECT().DescribeNamespace(gomock.Any(), gomock.Any()).Return(newDescribeNamespaceResponse(map[string]string{
+		"Keyword01": "CustomField",
+		"Int01":     "CustomInt",
+	}), nil)
+	saManager.EXPECT().GetSearchAttributes("temporal_visibility", true).Return(searchattribute.NewNameTypeMapStub(map[string]enumspb.IndexedValueType{
+		"Keyword01": enumspb.INDEXED_VALUE_TYPE_KEYWORD,
+		"Int01":     enumspb.INDEXED_VALUE_TYPE_INT,
+		"Int02":     enumspb.INDEXED_VALUE_TYPE_INT,
+		"Int03":     enumspb.INDEXED_VALUE_TYPE_INT,
+	}), nil)
+
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestActivityEnvironment()
+	env.RegisterActivity(a)
+	result, err := env.ExecuteActivity(a.PrepareMigration, prepareRequest{
+		Namespace:       "namespace",
+		SearchAttribute: "CustomField",
+		NewType:         enumspb.INDEXED_VALUE_TYPE_INT,
+	})
+	require.NoError(t, err)
+	var plan migrationPlan
+	require.NoError(t, result.Get(&plan))
+	require.Equal(t, migrationPlan{
+		Namespace:   "namespace",
+		NamespaceID: "namespace-id",
+		Alias:       "CustomField",
+		OldField:    "Keyword01",
+		OldType:     enumspb.INDEXED_VALUE_TYPE_KEYWORD,
+		NewField:    "Int02",
+		NewType:     enumspb.INDEXED_VALUE_TYPE_INT,
+	}, plan)
+}
+
+func Test_PrepareMigration_Elasticsearch(t *testing.T) {
+	controller := gomock.NewController(t)
+	frontendClient := workflowservicemock.NewMockWorkflowServiceClient(controller)
+	saManager := searchattribute.NewMockManager(controller)
+	visibilityManager := manager.NewMockVisibilityManager(controller)
+	a := &activities{
+		frontendClient:    frontendClient,
+		saManager:         saManager,
+		visibilityManager: visibilityManager,
+		metricsHandler:    metrics.NoopMetricsHandler,
+		logger:            log.NewTestLogger(),
+	}
+
+	visibilityManager.EXPECT().GetStoreNames().Return([]string{elasticsearch.PersistenceName})
+	visibilityManager.EXPECT().GetIndexName().Return("index").Times(2)
+	frontendClient.EXPECT().DescribeNamespace(gomock.Any(), gomock.Any()).Return(newDescribeNamespaceResponse(nil), nil)
+	saManager.EXPECT().GetSearchAttributes("index", true).Return(searchattribute.NewNameTypeMapStub(map[string]enumspb.IndexedValueType{
+		"CustomField": enumspb.INDEXED_VALUE_TYPE_KEYWORD,
+	}), nil).Times(2)
+
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestActivityEnvironment()
+	env.RegisterActivity(a)
+	result, err := env.ExecuteActivity(a.PrepareMigration, prepareRequest{
+		Namespace:       "namespace",
+		SearchAttribute: "CustomField",
+		NewType:         enumspb.INDEXED_VALUE_TYPE_INT,
+	})
+	require.NoError(t, err)
+	var plan migrationPlan
+	require.NoError(t, result.Get(&plan))
+	require.Equal(t, migrationPlan{
+		Namespace:       "namespace",
+		NamespaceID:     "namespace-id",
+		Alias:           "CustomField",
+		OldField:        "CustomField",
+		OldType:         enumspb.INDEXED_VALUE_TYPE_KEYWORD,
+		NewField:        "CustomFieldInt",
+		NewType:         enumspb.INDEXED_VALUE_TYPE_INT,
+		IndicesToUpdate: []string{"index"},
+	}, plan)
+}
+
+func Test_PrepareMigration_UnsupportedConversion(t *testing.T) {
+	controller := gomock.NewController(t)
+	frontendClient := workflowservicemock.NewMockWorkflowServiceClient(controller)
+	saManager := searchattribute.NewMockManager(controller)
+	visibilityManager := manager.NewMockVisibilityManager(controller)
+	a := &activities{
+		frontendClient:    frontendClient,
+		saManager:         saManager,
+		visibilityManager: visibilityManager,
+		metricsHandler:    metrics.NoopMetricsHandler,
+		logger:            log.NewTestLogger(),
+	}
+
+	visibilityManager.EXPECT().GetIndexName().Return("index")
+	frontendClient.EXPECT().DescribeNamespace(gomock.Any(), gomock.Any()).Return(newDescribeNamespaceResponse(nil), nil)
+	saManager.EXPECT().GetSearchAttributes("index", true).Return(searchattribute.NewNameTypeMapStub(map[string]enumspb.IndexedValueType{
+		"CustomField": enumspb.INDEXED_VALUE_TYPE_BOOL,
+	}), nil)
+
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestActivityEnvironment()
+	env.RegisterActivity(a)
+	_, err := env.ExecuteActivity(a.PrepareMigration, prepareRequest{
+		Namespace:       "namespace",
+		SearchAttribute: "CustomField",
+		NewType:         enumspb.INDEXED_VALUE_TYPE_INT,
+	})
+	require.ErrorContains(t, err, "can't be changed")
+}
+
+func Test_SwapAlias(t *testing.T) {
+	controller := gomock.NewController(t)
+	frontendClient := workflowservicemock.NewMockWorkflowServiceClient(controller)
+	a := &activities{
+		frontendClient: frontendClient,
+		metricsHandler: metrics.NoopMetricsHandler,
+		logger:         log.NewTestLogger(),
+	}
+	plan := migrationPlan{
+		Namespace: "namespace",
+		Alias:     "CustomField",
+		OldField:  "Keyword01",
+		NewField:  "Int02",
+	}
+
+	frontendClient.EXPECT().DescribeNamespace(gomock.Any(), gomock.Any()).Return(newDescribeNamespaceResponse(map[string]string{
+		"Keyword01": "CustomField",
+		"Int02":     "TemporalNewFieldOfCustomField",
+	}), nil)
+	frontendClient.EXPECT().UpdateNamespace(gomock.Any(), &workflowservice.UpdateNamespaceRequest{
+		Namespace: "namespace",
+		Config: &namespacepb.NamespaceConfig{
+			CustomSearchAttributeAliases: map[string]string{
+				"Int02":     "CustomField",
+				"Keyword01": "TemporalOldFieldOfCustomField",
+			},
+		},
+	}).Return(&workflowservice.UpdateNamespaceResponse{}, nil)
+
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestActivityEnvironment()
+	env.RegisterActivity(a)
+	_, err := env.ExecuteActivity(a.SwapAlias, plan)
+	require.NoError(t, err)
+
+	// a retried swap doesn't update the namespace again
+	frontendClient.EXPECT().DescribeNamespace(gomock.Any(), gomock.Any()).Return(newDescribeNamespaceResponse(map[string]string{
+		"Keyword01": "TemporalOldFieldOfCustomField",
+		"Int02":     "CustomField",
+	}), nil)
+	_, err = env.ExecuteActivity(a.SwapAlias, plan)
+	require.NoError(t, err)
+}
+
+func Test_CopyValues(t *testing.T) {
+	controller := gomock.NewController(t)
+	visibilityManager := manager.NewMockVisibilityManager(controller)
+	a := &activities{
+		visibilityManager: visibilityManager,
+		metricsHandler:    metrics.NoopMetricsHandler,
+		logger:            log.NewTestLogger(),
+	}
+
+	request := &manager.CopySearchAttributeValuesRequest{
+		NamespaceID: namespace.ID("namespace-id"),
+		SourceField: "Keyword01",
+		SourceType:  enumspb.INDEXED_VALUE_TYPE_KEYWORD,
+		TargetField: "Int02",
+		TargetType:  enumspb.INDEXED_VALUE_TYPE_INT,
+		PageSize:    2,
+	}
+	visibilityManager.EXPECT().CopySearchAttributeValues(gomock.Any(), request).Return(&manager.SearchAttributeValuesResponse{
+		UpdatedCount:  1,
+		FailedCount:   1,
+		NextPageToken: []byte("page-2"),
+	}, nil)
+	secondRequest := *request
+	secondRequest.NextPageToken = []byte("page-2")
+	visibilityManager.EXPECT().CopySearchAttributeValues(gomock.Any(), &secondRequest).Return(&manager.SearchAttributeValuesResponse{
+		UpdatedCount: 1,
+	}, nil)
+
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestActivityEnvironment()
+	env.RegisterActivity(a)
+	result, err := env.ExecuteActivity(a.CopyValues, copyValuesRequest{
+		Namespace:   "namespace",
+		NamespaceID: "namespace-id",
+		SourceField: "Keyword01",
+		SourceType:  enumspb.INDEXED_VALUE_TYPE_KEYWORD,
+		TargetField: "Int02",
+		TargetType:  enumspb.INDEXED_VALUE_TYPE_INT,
+		PageSize:    2,
+	})
+	require.NoError(t, err)
+	var copyResult valuesResult
+	require.NoError(t, result.Get(&copyResult))
+	require.Equal(t, valuesResult{UpdatedCount: 2, FailedCount: 1}, copyResult)
+}
+
+func Test_RewriteValues(t *testing.T) {
+	controller := gomock.NewController(t)
+	visibilityManager := manager.NewMockVisibilityManager(controller)
+	historyClient := historyservicemock.NewMockHistoryServiceClient(controller)
+	a := &activities{
+		historyClient:     historyClient,
+		visibilityManager: visibilityManager,
+		metricsHandler:    metrics.NoopMetricsHandler,
+		logger:            log.NewTestLogger(),
+	}
+
+	executions := []*commonpb.WorkflowExecution{
+		{WorkflowId: "workflow-1", RunId: "run-1"},
+		{WorkflowId: "workflow-2", RunId: "run-2"},
+		{WorkflowId: "workflow-3", RunId: "run-3"},
+	}
+	visibilityManager.EXPECT().ListWorkflowExecutions(gomock.Any(), &manager.ListWorkflowExecutionsRequestV2{
+		NamespaceID: "namespace-id",
+		Namespace:   "namespace",
+		PageSize:    10,
+		Query:       searchattribute.QueryWithAnyNamespaceDivision("ExecutionStatus = 'Running' AND TemporalOldFieldOfCustomField IS NOT NULL"),
+	}).Return(&manager.ListWorkflowExecutionsResponse{
+		Executions: []*workflowpb.WorkflowExecutionInfo{
+			{Execution: executions[0]},
+			{Execution: executions[1]},
+			{Execution: executions[2]},
+		},
+	}, nil)
+	moveRequest := func(execution *commonpb.WorkflowExecution) *historyservice.MoveSearchAttributeValueRequest {
+		return &historyservice.MoveSearchAttributeValueRequest{
+			NamespaceId: "namespace-id",
+			Execution:   execution,
+			SourceField: "Keyword01",
+			SourceType:  enumspb.INDEXED_VALUE_TYPE_KEYWORD,
+			TargetField: "Int02",
+			TargetType:  enumspb.INDEXED_VALUE_TYPE_INT,
+		}
+	}
+	historyClient.EXPECT().MoveSearchAttributeValue(gomock.Any(), moveRequest(executions[0])).
+		Return(&historyservice.MoveSearchAttributeValueResponse{Moved: true}, nil)
+	historyClient.EXPECT().MoveSearchAttributeValue(gomock.Any(), moveRequest(executions[1])).
+		Return(&historyservice.MoveSearchAttributeValueResponse{ConversionFailed: true}, nil)
+	historyClient.EXPECT().MoveSearchAttributeValue(gomock.Any(), moveRequest(executions[2])).
+		Return(nil, serviceerror.NewNotFound("workflow not found"))
+
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestActivityEnvironment()
+	env.RegisterActivity(a)
+	result, err := env.ExecuteActivity(a.RewriteValues, rewriteValuesRequest{
+		Namespace:   "namespace",
+		NamespaceID: "namespace-id",
+		Alias:       "CustomField",
+		SourceField: "Keyword01",
+		SourceType:  enumspb.INDEXED_VALUE_TYPE_KEYWORD,
+		TargetField: "Int02",
+		TargetType:  enumspb.INDEXED_VALUE_TYPE_INT,
+		PageSize:    10,
+		RPS:         100,
+	})
+	require.NoError(t, err)
+	var rewriteResult rewriteValuesResult
+	require.NoError(t, result.Get(&rewriteResult))
+	require.Equal(t, rewriteValuesResult{ExecutionCount: 3, UpdatedCount: 1, FailedCount: 1}, rewriteResult)
+}
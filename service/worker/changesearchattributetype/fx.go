@@ -0,0 +1,79 @@
+package changesearchattributetype
+
+import (
+	"context"
+
+	"go.temporal.io/api/workflowservice/v1"
+	sdkworker "go.temporal.io/sdk/worker"
+	"go.temporal.io/sdk/workflow"
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/headers"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/persistence/visibility/manager"
+	"go.temporal.io/server/common/primitives"
+	"go.temporal.io/server/common/resource"
+	"go.temporal.io/server/common/searchattribute"
+	workercommon "go.temporal.io/server/service/worker/common"
+	"go.uber.org/fx"
+)
+
+type (
+	// changeSearchAttributeTypeComponent represent background work needed for changing the type of a custom search
+	// attribute
+	changeSearchAttributeTypeComponent struct {
+		initParams
+	}
+
+	initParams struct {
+		fx.In
+		PersistenceConfig *config.Persistence
+		FrontendClient    workflowservice.WorkflowServiceClient
+		HistoryClient     resource.HistoryClient
+		SaManager         searchattribute.Manager
+		VisibilityManager manager.VisibilityManager
+		MetricsHandler    metrics.Handler
+		Logger            log.Logger
+	}
+)
+
+var Module = workercommon.AnnotateWorkerComponentProvider(newComponent)
+
+func newComponent(params initParams) workercommon.WorkerComponent {
+	return &changeSearchAttributeTypeComponent{initParams: params}
+}
+
+func (wc *changeSearchAttributeTypeComponent) RegisterWorkflow(registry sdkworker.Registry) {
+	registry.RegisterWorkflowWithOptions(ChangeSearchAttributeTypeWorkflow, workflow.RegisterOptions{Name: WorkflowName})
+	registry.RegisterActivity(wc.activities())
+}
+
+func (wc *changeSearchAttributeTypeComponent) DedicatedWorkflowWorkerOptions() *workercommon.DedicatedWorkerOptions {
+	// use default worker
+	return nil
+}
+
+func (wc *changeSearchAttributeTypeComponent) RegisterActivities(registry sdkworker.Registry) {
+	registry.RegisterActivity(wc.activities())
+}
+
+func (wc *changeSearchAttributeTypeComponent) DedicatedActivityWorkerOptions() *workercommon.DedicatedWorkerOptions {
+	return &workercommon.DedicatedWorkerOptions{
+		TaskQueue: primitives.ChangeSearchAttributeTypeActivityTQ,
+		Options: sdkworker.Options{
+			BackgroundActivityContext: headers.SetCallerType(context.Background(), headers.CallerTypePreemptable),
+		},
+	}
+}
+
+func (wc *changeSearchAttributeTypeComponent) activities() *activities {
+	return &activities{
+		sqlVisibility:     wc.PersistenceConfig.IsSQLVisibilityStore(),
+		frontendClient:    wc.FrontendClient,
+		historyClient:     wc.HistoryClient,
+		saManager:         wc.SaManager,
+		visibilityManager: wc.VisibilityManager,
+		metricsHandler:    wc.MetricsHandler.WithTags(metrics.OperationTag(metrics.ChangeSearchAttributeTypeWorkflowScope)),
+		logger:            wc.Logger,
+	}
+}
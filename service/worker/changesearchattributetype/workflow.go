@@ -0,0 +1,350 @@
+package changesearchattributetype
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+	"go.temporal.io/server/common/primitives"
+	"go.temporal.io/server/service/worker/addsearchattributes"
+)
+
+const (
+	// WorkflowName is the workflow type of the system workflow changing the type of a custom search attribute
+	WorkflowName = "temporal-sys-change-search-attribute-type-workflow"
+	// WorkflowIDPrefix is the prefix of the workflow ID of the type change of a namespace, one type change per
+	// namespace can run at a time.
+	WorkflowIDPrefix = "temporal-sys-change-search-attribute-type-"
+	// ProgressQueryType is the query type returning the ChangeSearchAttributeTypeProgress of a type change
+	ProgressQueryType = "change-search-attribute-type-progress"
+
+	// PhaseAllocate allocates the field of the new type
+	PhaseAllocate = "allocate"
+	// PhaseBackfill copies the values of the old field into the new field
+	PhaseBackfill = "backfill"
+	// PhaseSwap maps the search attribute to the new field
+	PhaseSwap = "swap"
+	// PhaseRewrite moves the values of the old field in the mutable state of the running executions
+	PhaseRewrite = "rewrite"
+	// PhaseCatchUp copies the values written to the old field during the backfill
+	PhaseCatchUp = "catch-up"
+	// PhaseCleanup removes the values of the old field and releases it
+	PhaseCleanup = "cleanup"
+	// PhaseDone is the phase of a completed type change
+	PhaseDone = "done"
+
+	defaultPageSize = 100
+	// defaultRewriteRPS is the rate of the executions rewritten by the rewrite phase
+	defaultRewriteRPS = 100
+	// rewriteInterval is the wait between two passes of the rewrite phase, so that the visibility records of the
+	// rewritten executions are updated.
+	rewriteInterval = 30 * time.Second
+)
+
+type (
+	// ChangeSearchAttributeTypeParams are the parameters of the change search attribute type workflow
+	ChangeSearchAttributeTypeParams struct {
+		// Namespace is the name of the namespace of the search attribute
+		Namespace string
+		// SearchAttribute is the name of the custom search attribute to change
+		SearchAttribute string
+		// NewType is the new type of the search attribute
+		NewType enumspb.IndexedValueType
+		// NewFieldName is the name of the Elasticsearch field of the new type, the type of an Elasticsearch field
+		// can't be changed. It defaults to the name of the search attribute followed by the new type, e.g.
+		// CustomerIdInt. It's ignored with SQL visibility where a pre-allocated column is used.
+		NewFieldName string
+		// PageSize is the number of executions updated per visibility store request
+		PageSize int
+		// RewriteRPS is the rate of the executions rewritten by the rewrite phase
+		RewriteRPS int
+	}
+
+	// ChangeSearchAttributeTypeProgress is the progress of a type change, it's returned by the ProgressQueryType query
+	ChangeSearchAttributeTypeProgress struct {
+		Phase           string
+		SearchAttribute string
+		NamespaceID     string
+		OldField        string
+		OldType         enumspb.IndexedValueType
+		NewField        string
+		NewType         enumspb.IndexedValueType
+		CopiedValues    int64
+		RewrittenValues int64
+		FailedValues    int64
+		ClearedValues   int64
+		StartTime       time.Time
+	}
+
+	prepareRequest struct {
+		Namespace       string
+		SearchAttribute string
+		NewType         enumspb.IndexedValueType
+		NewFieldName    string
+	}
+
+	// migrationPlan describes the fields of a type change, field names are the names in the visibility store.
+	migrationPlan struct {
+		Namespace   string
+		NamespaceID string
+		Alias       string
+		OldField    string
+		OldType     enumspb.IndexedValueType
+		NewField    string
+		NewType     enumspb.IndexedValueType
+		// IndicesToUpdate are the Elasticsearch indices which don't have the field of the new type yet.
+		IndicesToUpdate []string
+	}
+
+	copyValuesRequest struct {
+		Namespace   string
+		NamespaceID string
+		SourceField string
+		SourceType  enumspb.IndexedValueType
+		TargetField string
+		TargetType  enumspb.IndexedValueType
+		PageSize    int
+	}
+
+	clearValuesRequest struct {
+		Namespace   string
+		NamespaceID string
+		Field       string
+		PageSize    int
+	}
+
+	valuesResult struct {
+		UpdatedCount int64
+		FailedCount  int64
+	}
+
+	rewriteValuesRequest struct {
+		Namespace   string
+		NamespaceID string
+		Alias       string
+		SourceField string
+		SourceType  enumspb.IndexedValueType
+		TargetField string
+		TargetType  enumspb.IndexedValueType
+		PageSize    int
+		RPS         int
+	}
+
+	rewriteValuesResult struct {
+		// ExecutionCount is the number of running executions listed with a value in the old field
+		ExecutionCount int64
+		UpdatedCount   int64
+		FailedCount    int64
+	}
+)
+
+var (
+	errNamespaceRequired       = errors.New("namespace is required")
+	errSearchAttributeRequired = errors.New("search attribute is required")
+	errNewTypeRequired         = errors.New("new type is required")
+
+	// metadataActivityOptions are the options of the activities reading and updating the namespace and cluster
+	// metadata.
+	metadataActivityOptions = workflow.ActivityOptions{
+		StartToCloseTimeout: time.Minute,
+		RetryPolicy: &temporal.RetryPolicy{
+			InitialInterval:        time.Second,
+			MaximumInterval:        10 * time.Second,
+			MaximumAttempts:        5,
+			NonRetryableErrorTypes: []string{errTypeInvalidArgument},
+		},
+	}
+
+	// valuesActivityOptions are the options of the activities updating the values of a field, they continue from
+	// their last heartbeat when retried.
+	valuesActivityOptions = workflow.ActivityOptions{
+		StartToCloseTimeout: 24 * time.Hour,
+		HeartbeatTimeout:    time.Minute,
+		RetryPolicy: &temporal.RetryPolicy{
+			InitialInterval:        time.Second,
+			MaximumInterval:        time.Minute,
+			NonRetryableErrorTypes: []string{errTypeInvalidArgument},
+		},
+	}
+)
+
+// ChangeSearchAttributeTypeWorkflow changes the type of a custom search attribute of a namespace without losing its
+// values. It allocates a field of the new type, copies the values of the old field into it, maps the search
+// attribute to the new field, moves the values of the old field in the mutable state of the running executions,
+// copies the values written in the meantime and finally removes the values of the old field and releases it. Values
+// which can't be converted to the new type are skipped and counted as failed.
+//
+// The fields are held by reserved aliases of the namespace: the field of the new type until the search attribute is
+// mapped to it, and the old field until its values are removed. With SQL visibility the fields are pre-allocated
+// columns; with Elasticsearch visibility the field of the new type is added to the indices and the search attribute
+// is aliased to it, the old field is left unused in the indices.
+func ChangeSearchAttributeTypeWorkflow(ctx workflow.Context, params ChangeSearchAttributeTypeParams) error {
+	var progress ChangeSearchAttributeTypeProgress
+	_ = workflow.SetQueryHandler(ctx, ProgressQueryType, func() (ChangeSearchAttributeTypeProgress, error) {
+		return progress, nil
+	})
+
+	if err := validateAndSetParams(&params); err != nil {
+		return temporal.NewNonRetryableApplicationError(err.Error(), errTypeInvalidArgument, nil)
+	}
+	progress.SearchAttribute = params.SearchAttribute
+	progress.StartTime = workflow.Now(ctx)
+
+	var a *activities
+	actx := workflow.WithTaskQueue(ctx, primitives.ChangeSearchAttributeTypeActivityTQ)
+	metadataCtx := workflow.WithActivityOptions(actx, metadataActivityOptions)
+	valuesCtx := workflow.WithActivityOptions(actx, valuesActivityOptions)
+
+	var plan migrationPlan
+	err := workflow.ExecuteActivity(metadataCtx, a.PrepareMigration, prepareRequest{
+		Namespace:       params.Namespace,
+		SearchAttribute: params.SearchAttribute,
+		NewType:         params.NewType,
+		NewFieldName:    params.NewFieldName,
+	}).Get(ctx, &plan)
+	if err != nil {
+		return fmt.Errorf("unable to prepare search attribute type change: %w", err)
+	}
+	progress.NamespaceID = plan.NamespaceID
+	progress.OldField = plan.OldField
+	progress.OldType = plan.OldType
+	progress.NewField = plan.NewField
+	progress.NewType = plan.NewType
+
+	progress.Phase = PhaseAllocate
+	if err := allocateField(ctx, metadataCtx, plan); err != nil {
+		return fmt.Errorf("unable to allocate field %s: %w", plan.NewField, err)
+	}
+
+	copyRequest := copyValuesRequest{
+		Namespace:   params.Namespace,
+		NamespaceID: plan.NamespaceID,
+		SourceField: plan.OldField,
+		SourceType:  plan.OldType,
+		TargetField: plan.NewField,
+		TargetType:  plan.NewType,
+		PageSize:    params.PageSize,
+	}
+	progress.Phase = PhaseBackfill
+	var result valuesResult
+	if err := workflow.ExecuteActivity(valuesCtx, a.CopyValues, copyRequest).Get(ctx, &result); err != nil {
+		return fmt.Errorf("unable to copy values of %s to %s: %w", plan.OldField, plan.NewField, err)
+	}
+	progress.CopiedValues += result.UpdatedCount
+	progress.FailedValues += result.FailedCount
+
+	progress.Phase = PhaseSwap
+	if err := workflow.ExecuteActivity(metadataCtx, a.SwapAlias, plan).Get(ctx, nil); err != nil {
+		return fmt.Errorf("unable to map search attribute %s to %s: %w", plan.Alias, plan.NewField, err)
+	}
+
+	// Running executions keep the old field in their mutable state, it would be written back to visibility and
+	// referenced by a future owner of the field after it's released.
+	progress.Phase = PhaseRewrite
+	rewriteRequest := rewriteValuesRequest{
+		Namespace:   params.Namespace,
+		NamespaceID: plan.NamespaceID,
+		Alias:       plan.Alias,
+		SourceField: plan.OldField,
+		SourceType:  plan.OldType,
+		TargetField: plan.NewField,
+		TargetType:  plan.NewType,
+		PageSize:    params.PageSize,
+		RPS:         params.RewriteRPS,
+	}
+	for {
+		var rewriteResult rewriteValuesResult
+		if err := workflow.ExecuteActivity(valuesCtx, a.RewriteValues, rewriteRequest).Get(ctx, &rewriteResult); err != nil {
+			return fmt.Errorf("unable to move values of %s to %s in running executions: %w", plan.OldField, plan.NewField, err)
+		}
+		progress.RewrittenValues += rewriteResult.UpdatedCount
+		progress.FailedValues += rewriteResult.FailedCount
+		if rewriteResult.ExecutionCount == 0 {
+			break
+		}
+		// listed executions are listed again until their visibility records are updated, and new runs of
+		// executions continued as new may still carry the old field
+		if err := workflow.Sleep(ctx, rewriteInterval); err != nil {
+			return err
+		}
+	}
+
+	progress.Phase = PhaseCatchUp
+	result = valuesResult{}
+	if err := workflow.ExecuteActivity(valuesCtx, a.CopyValues, copyRequest).Get(ctx, &result); err != nil {
+		return fmt.Errorf("unable to copy values of %s to %s: %w", plan.OldField, plan.NewField, err)
+	}
+	progress.CopiedValues += result.UpdatedCount
+	progress.FailedValues += result.FailedCount
+
+	progress.Phase = PhaseCleanup
+	result = valuesResult{}
+	err = workflow.ExecuteActivity(valuesCtx, a.ClearValues, clearValuesRequest{
+		Namespace:   params.Namespace,
+		NamespaceID: plan.NamespaceID,
+		Field:       plan.OldField,
+		PageSize:    params.PageSize,
+	}).Get(ctx, &result)
+	if err != nil {
+		return fmt.Errorf("unable to clear values of %s: %w", plan.OldField, err)
+	}
+	progress.ClearedValues += result.UpdatedCount
+	if err := workflow.ExecuteActivity(metadataCtx, a.ReleaseField, plan).Get(ctx, nil); err != nil {
+		return fmt.Errorf("unable to release field %s: %w", plan.OldField, err)
+	}
+
+	progress.Phase = PhaseDone
+	workflow.GetLogger(ctx).Info("Search attribute type changed.",
+		"Namespace", params.Namespace,
+		"SearchAttribute", params.SearchAttribute,
+		"NewField", plan.NewField,
+		"CopiedValues", progress.CopiedValues,
+		"RewrittenValues", progress.RewrittenValues,
+		"FailedValues", progress.FailedValues,
+	)
+	return nil
+}
+
+// allocateField reserves the field of the new type so that it's not allocated to another search attribute during the
+// backfill, and adds it to the Elasticsearch indices which don't have it yet.
+func allocateField(ctx workflow.Context, metadataCtx workflow.Context, plan migrationPlan) error {
+	var a *activities
+	if err := workflow.ExecuteActivity(metadataCtx, a.ReserveField, plan).Get(ctx, nil); err != nil {
+		return err
+	}
+	cctx := workflow.WithChildOptions(ctx, workflow.ChildWorkflowOptions{
+		TaskQueue: primitives.DefaultWorkerTaskQueue,
+	})
+	for _, indexName := range plan.IndicesToUpdate {
+		err := workflow.ExecuteChildWorkflow(cctx, addsearchattributes.WorkflowName, addsearchattributes.WorkflowParams{
+			CustomAttributesToAdd: map[string]enumspb.IndexedValueType{plan.NewField: plan.NewType},
+			IndexName:             indexName,
+		}).Get(ctx, nil)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func validateAndSetParams(params *ChangeSearchAttributeTypeParams) error {
+	if params.Namespace == "" {
+		return errNamespaceRequired
+	}
+	if params.SearchAttribute == "" {
+		return errSearchAttributeRequired
+	}
+	if params.NewType == enumspb.INDEXED_VALUE_TYPE_UNSPECIFIED {
+		return errNewTypeRequired
+	}
+	if params.PageSize <= 0 {
+		params.PageSize = defaultPageSize
+	}
+	if params.RewriteRPS <= 0 {
+		params.RewriteRPS = defaultRewriteRPS
+	}
+	return nil
+}
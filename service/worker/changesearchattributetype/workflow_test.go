@@ -0,0 +1,152 @@
+package changesearchattributetype
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/sdk/testsuite"
+	"go.temporal.io/sdk/workflow"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/service/worker/addsearchattributes"
+)
+
+func Test_ChangeSearchAttributeTypeWorkflow_SQL(t *testing.T) {
+	testSuite := &testsuite.WorkflowTestSuite{}
+	testSuite.SetLogger(log.NewSdkLogger(log.NewTestLogger()))
+	env := testSuite.NewTestWorkflowEnvironment()
+
+	plan := migrationPlan{
+		Namespace:   "namespace",
+		NamespaceID: "namespace-id",
+		Alias:       "CustomField",
+		OldField:    "Keyword01",
+		OldType:     enumspb.INDEXED_VALUE_TYPE_KEYWORD,
+		NewField:    "Int01",
+		NewType:     enumspb.INDEXED_VALUE_TYPE_INT,
+	}
+	copyRequest := copyValuesRequest{
+		Namespace:   "namespace",
+		NamespaceID: "namespace-id",
+		SourceField: "Keyword01",
+		SourceType:  enumspb.INDEXED_VALUE_TYPE_KEYWORD,
+		TargetField: "Int01",
+		TargetType:  enumspb.INDEXED_VALUE_TYPE_INT,
+		PageSize:    defaultPageSize,
+	}
+
+	var a *activities
+	env.OnActivity(a.PrepareMigration, mock.Anything, prepareRequest{
+		Namespace:       "namespace",
+		SearchAttribute: "CustomField",
+		NewType:         enumspb.INDEXED_VALUE_TYPE_INT,
+	}).Return(plan, nil).Once()
+	env.OnActivity(a.ReserveField, mock.Anything, plan).Return(nil).Once()
+	env.OnActivity(a.CopyValues, mock.Anything, copyRequest).Return(valuesResult{UpdatedCount: 5, FailedCount: 1}, nil).Once()
+	env.OnActivity(a.SwapAlias, mock.Anything, plan).Return(nil).Once()
+	rewriteRequest := rewriteValuesRequest{
+		Namespace:   "namespace",
+		NamespaceID: "namespace-id",
+		Alias:       "CustomField",
+		SourceField: "Keyword01",
+		SourceType:  enumspb.INDEXED_VALUE_TYPE_KEYWORD,
+		TargetField: "Int01",
+		TargetType:  enumspb.INDEXED_VALUE_TYPE_INT,
+		PageSize:    defaultPageSize,
+		RPS:         defaultRewriteRPS,
+	}
+	// the executions listed by the first pass are listed again until their visibility records are updated
+	env.OnActivity(a.RewriteValues, mock.Anything, rewriteRequest).Return(rewriteValuesResult{ExecutionCount: 3, UpdatedCount: 2, FailedCount: 1}, nil).Once()
+	env.OnActivity(a.RewriteValues, mock.Anything, rewriteRequest).Return(rewriteValuesResult{ExecutionCount: 3}, nil).Once()
+	env.OnActivity(a.RewriteValues, mock.Anything, rewriteRequest).Return(rewriteValuesResult{}, nil).Once()
+	env.OnActivity(a.CopyValues, mock.Anything, copyRequest).Return(valuesResult{UpdatedCount: 1}, nil).Once()
+	env.OnActivity(a.ClearValues, mock.Anything, clearValuesRequest{
+		Namespace:   "namespace",
+		NamespaceID: "namespace-id",
+		Field:       "Keyword01",
+		PageSize:    defaultPageSize,
+	}).Return(valuesResult{UpdatedCount: 7}, nil).Once()
+	env.OnActivity(a.ReleaseField, mock.Anything, plan).Return(nil).Once()
+
+	env.ExecuteWorkflow(ChangeSearchAttributeTypeWorkflow, ChangeSearchAttributeTypeParams{
+		Namespace:       "namespace",
+		SearchAttribute: "CustomField",
+		NewType:         enumspb.INDEXED_VALUE_TYPE_INT,
+	})
+
+	require.True(t, env.IsWorkflowCompleted())
+	require.NoError(t, env.GetWorkflowError())
+	env.AssertExpectations(t)
+
+	result, err := env.QueryWorkflow(ProgressQueryType)
+	require.NoError(t, err)
+	var progress ChangeSearchAttributeTypeProgress
+	require.NoError(t, result.Get(&progress))
+	require.Equal(t, PhaseDone, progress.Phase)
+	require.Equal(t, "Int01", progress.NewField)
+	require.Equal(t, int64(6), progress.CopiedValues)
+	require.Equal(t, int64(2), progress.RewrittenValues)
+	require.Equal(t, int64(2), progress.FailedValues)
+	require.Equal(t, int64(7), progress.ClearedValues)
+}
+
+func Test_ChangeSearchAttributeTypeWorkflow_Elasticsearch(t *testing.T) {
+	testSuite := &testsuite.WorkflowTestSuite{}
+	testSuite.SetLogger(log.NewSdkLogger(log.NewTestLogger()))
+	env := testSuite.NewTestWorkflowEnvironment()
+
+	plan := migrationPlan{
+		Namespace:       "namespace",
+		NamespaceID:     "namespace-id",
+		Alias:           "CustomField",
+		OldField:        "CustomField",
+		OldType:         enumspb.INDEXED_VALUE_TYPE_KEYWORD,
+		NewField:        "CustomFieldInt",
+		NewType:         enumspb.INDEXED_VALUE_TYPE_INT,
+		IndicesToUpdate: []string{"primary-index", "secondary-index"},
+	}
+
+	var a *activities
+	env.OnActivity(a.PrepareMigration, mock.Anything, mock.Anything).Return(plan, nil).Once()
+	env.OnActivity(a.ReserveField, mock.Anything, plan).Return(nil).Once()
+	env.RegisterWorkflowWithOptions(
+		func(ctx workflow.Context, params addsearchattributes.WorkflowParams) error { return nil },
+		workflow.RegisterOptions{Name: addsearchattributes.WorkflowName},
+	)
+	for _, indexName := range plan.IndicesToUpdate {
+		env.OnWorkflow(addsearchattributes.WorkflowName, mock.Anything, addsearchattributes.WorkflowParams{
+			CustomAttributesToAdd: map[string]enumspb.IndexedValueType{"CustomFieldInt": enumspb.INDEXED_VALUE_TYPE_INT},
+			IndexName:             indexName,
+		}).Return(nil).Once()
+	}
+	env.OnActivity(a.CopyValues, mock.Anything, mock.Anything).Return(valuesResult{UpdatedCount: 2}, nil).Twice()
+	env.OnActivity(a.SwapAlias, mock.Anything, plan).Return(nil).Once()
+	env.OnActivity(a.RewriteValues, mock.Anything, mock.Anything).Return(rewriteValuesResult{}, nil).Once()
+	env.OnActivity(a.ClearValues, mock.Anything, mock.Anything).Return(valuesResult{UpdatedCount: 2}, nil).Once()
+	env.OnActivity(a.ReleaseField, mock.Anything, plan).Return(nil).Once()
+
+	env.ExecuteWorkflow(ChangeSearchAttributeTypeWorkflow, ChangeSearchAttributeTypeParams{
+		Namespace:       "namespace",
+		SearchAttribute: "CustomField",
+		NewType:         enumspb.INDEXED_VALUE_TYPE_INT,
+	})
+
+	require.True(t, env.IsWorkflowCompleted())
+	require.NoError(t, env.GetWorkflowError())
+	env.AssertExpectations(t)
+}
+
+func Test_ChangeSearchAttributeTypeWorkflow_NewTypeRequired(t *testing.T) {
+	testSuite := &testsuite.WorkflowTestSuite{}
+	testSuite.SetLogger(log.NewSdkLogger(log.NewTestLogger()))
+	env := testSuite.NewTestWorkflowEnvironment()
+
+	env.ExecuteWorkflow(ChangeSearchAttributeTypeWorkflow, ChangeSearchAttributeTypeParams{
+		Namespace:       "namespace",
+		SearchAttribute: "CustomField",
+	})
+
+	require.True(t, env.IsWorkflowCompleted())
+	require.ErrorContains(t, env.GetWorkflowError(), errNewTypeRequired.Error())
+}
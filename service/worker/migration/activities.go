@@ -28,6 +28,7 @@ import (
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/quotas"
 	"go.temporal.io/server/common/rpc/interceptor"
+	"go.temporal.io/server/common/shardmap"
 	"google.golang.org/grpc/metadata"
 )
 
@@ -100,7 +101,7 @@ type (
 	}
 
 	activities struct {
-		historyShardCount              int32
+		shardMapProvider               *shardmap.Provider
 		executionManager               persistence.ExecutionManager
 		taskManager                    persistence.TaskManager
 		namespaceRegistry              namespace.Registry
@@ -144,7 +145,7 @@ func (a *activities) GetMetadata(_ context.Context, request metadataRequest) (*m
 	}
 
 	return &metadataResponse{
-		ShardCount:  a.historyShardCount,
+		ShardCount:  a.shardMapProvider.TotalShardCount(),
 		NamespaceID: string(nsEntry.ID()),
 	}, nil
 }
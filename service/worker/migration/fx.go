@@ -7,7 +7,6 @@ import (
 	sdkworker "go.temporal.io/sdk/worker"
 	"go.temporal.io/sdk/workflow"
 	serverClient "go.temporal.io/server/client"
-	"go.temporal.io/server/common/config"
 	"go.temporal.io/server/common/headers"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/metrics"
@@ -15,6 +14,7 @@ import (
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/primitives"
 	"go.temporal.io/server/common/resource"
+	"go.temporal.io/server/common/shardmap"
 	workercommon "go.temporal.io/server/service/worker/common"
 	"go.uber.org/fx"
 )
@@ -22,7 +22,7 @@ import (
 type (
 	initParams struct {
 		fx.In
-		PersistenceConfig         *config.Persistence
+		ShardMapProvider          *shardmap.Provider
 		ExecutionManager          persistence.ExecutionManager
 		NamespaceRegistry         namespace.Registry
 		HistoryClient             resource.HistoryClient
@@ -85,7 +85,7 @@ func (wc *replicationWorkerComponent) DedicatedActivityWorkerOptions() *workerco
 
 func (wc *replicationWorkerComponent) activities() *activities {
 	return &activities{
-		historyShardCount:              wc.PersistenceConfig.NumHistoryShards,
+		shardMapProvider:               wc.ShardMapProvider,
 		executionManager:               wc.ExecutionManager,
 		namespaceRegistry:              wc.NamespaceRegistry,
 		historyClient:                  wc.HistoryClient,
@@ -0,0 +1,201 @@
+package rebuildvisibility
+
+import (
+	"context"
+	"errors"
+	"math"
+	"time"
+
+	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/sdk/activity"
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/visibility/manager"
+	"go.temporal.io/server/common/primitives/timestamp"
+	"go.temporal.io/server/common/quotas"
+	"go.temporal.io/server/common/shardmap"
+)
+
+type (
+	activities struct {
+		shardMapProvider  *shardmap.Provider
+		executionManager  persistence.ExecutionManager
+		visibilityManager manager.VisibilityManager
+		namespaceRegistry namespace.Registry
+		metricsHandler    metrics.Handler
+		logger            log.Logger
+	}
+
+	// shardHeartbeatDetails are the heartbeat details of RebuildShardVisibility, a retried activity continues from
+	// the page of its last heartbeat.
+	shardHeartbeatDetails struct {
+		NextPageToken []byte
+		Result        rebuildShardResult
+	}
+)
+
+// GetRebuildMetadata returns the ID of the namespace and the number of history shards of the cluster
+func (a *activities) GetRebuildMetadata(_ context.Context, nsName string) (rebuildMetadata, error) {
+	nsEntry, err := a.namespaceRegistry.GetNamespace(namespace.Name(nsName))
+	if err != nil {
+		return rebuildMetadata{}, err
+	}
+	return rebuildMetadata{
+		NamespaceID: nsEntry.ID().String(),
+		ShardCount:  a.shardMapProvider.TotalShardCount(),
+	}, nil
+}
+
+// RebuildShardVisibility writes the visibility records of the executions of a namespace in a history shard
+func (a *activities) RebuildShardVisibility(ctx context.Context, request rebuildShardRequest) (rebuildShardResult, error) {
+	var details shardHeartbeatDetails
+	if activity.HasHeartbeatDetails(ctx) {
+		if err := activity.GetHeartbeatDetails(ctx, &details); err != nil {
+			return rebuildShardResult{}, err
+		}
+	}
+
+	logger := log.With(a.logger, tag.WorkflowNamespace(request.Namespace), tag.ShardID(request.ShardID))
+	rateLimiter := quotas.NewRateLimiter(request.RPS, int(math.Ceil(request.RPS)))
+	for {
+		resp, err := a.executionManager.ListConcreteExecutions(ctx, &persistence.ListConcreteExecutionsRequest{
+			ShardID:   request.ShardID,
+			PageSize:  request.PageSize,
+			PageToken: details.NextPageToken,
+		})
+		if err != nil {
+			return details.Result, err
+		}
+
+		for _, state := range resp.States {
+			if state.GetExecutionInfo().GetNamespaceId() != request.NamespaceID {
+				continue
+			}
+			switch state.GetExecutionState().GetState() {
+			case enumsspb.WORKFLOW_EXECUTION_STATE_VOID, enumsspb.WORKFLOW_EXECUTION_STATE_ZOMBIE:
+				// zombie workflows are not visible, they are replaced by the current run
+				continue
+			}
+			details.Result.ScannedExecutions++
+
+			if err := rateLimiter.Wait(ctx); err != nil {
+				return details.Result, err
+			}
+			err := a.rebuildExecution(ctx, request, state)
+			var invalidArgument *serviceerror.InvalidArgument
+			switch {
+			case err == nil:
+				details.Result.RebuiltExecutions++
+			case errors.As(err, &invalidArgument):
+				// the record is rejected by the visibility store, retrying won't help
+				details.Result.FailedExecutions++
+				metrics.RebuildVisibilityFailures.With(a.metricsHandler).Record(1, metrics.NamespaceTag(request.Namespace))
+				logger.Warn("Unable to rebuild visibility record.",
+					tag.WorkflowID(state.GetExecutionInfo().GetWorkflowId()),
+					tag.WorkflowRunID(state.GetExecutionState().GetRunId()),
+					tag.Error(err),
+				)
+			default:
+				return details.Result, err
+			}
+		}
+
+		details.NextPageToken = resp.PageToken
+		activity.RecordHeartbeat(ctx, details)
+		if len(details.NextPageToken) == 0 {
+			break
+		}
+	}
+
+	metrics.RebuildVisibilityRecords.With(a.metricsHandler).Record(details.Result.RebuiltExecutions, metrics.NamespaceTag(request.Namespace))
+	logger.Info("Visibility of shard rebuilt.",
+		tag.Counter(int(details.Result.RebuiltExecutions)),
+		tag.NewInt64("failed", details.Result.FailedExecutions),
+	)
+	return details.Result, nil
+}
+
+func (a *activities) rebuildExecution(
+	ctx context.Context,
+	request rebuildShardRequest,
+	state *persistencespb.WorkflowMutableState,
+) error {
+	requestBase := newVisibilityRequestBase(request, state)
+	if state.GetExecutionState().GetState() != enumsspb.WORKFLOW_EXECUTION_STATE_COMPLETED {
+		return a.visibilityManager.UpsertWorkflowExecution(ctx, &manager.UpsertWorkflowExecutionRequest{
+			VisibilityRequestBase: requestBase,
+		})
+	}
+
+	executionInfo := state.GetExecutionInfo()
+	closeTime := timestamp.TimeValue(executionInfo.GetCloseTime())
+	var executionDuration time.Duration
+	if !closeTime.IsZero() && executionInfo.GetExecutionTime() != nil {
+		executionDuration = closeTime.Sub(executionInfo.GetExecutionTime().AsTime())
+	}
+	return a.visibilityManager.RecordWorkflowExecutionClosed(ctx, &manager.RecordWorkflowExecutionClosedRequest{
+		VisibilityRequestBase: requestBase,
+		CloseTime:             closeTime,
+		ExecutionDuration:     executionDuration,
+		HistoryLength:         state.GetNextEventId() - 1,
+		HistorySizeBytes:      executionInfo.GetExecutionStats().GetHistorySize(),
+		StateTransitionCount:  executionInfo.GetStateTransitionCount(),
+	})
+}
+
+// newVisibilityRequestBase derives the visibility record of an execution from its mutable state, like the visibility
+// queue of the history service does.
+func newVisibilityRequestBase(
+	request rebuildShardRequest,
+	state *persistencespb.WorkflowMutableState,
+) *manager.VisibilityRequestBase {
+	executionInfo := state.GetExecutionInfo()
+	executionState := state.GetExecutionState()
+
+	var memo *commonpb.Memo
+	if executionInfo.GetMemo() != nil {
+		memo = &commonpb.Memo{Fields: executionInfo.GetMemo()}
+	}
+	var searchAttributes *commonpb.SearchAttributes
+	if executionInfo.GetSearchAttributes() != nil {
+		searchAttributes = &commonpb.SearchAttributes{IndexedFields: executionInfo.GetSearchAttributes()}
+	}
+	var parentExecution *commonpb.WorkflowExecution
+	if executionInfo.GetParentWorkflowId() != "" && executionInfo.GetParentRunId() != "" {
+		parentExecution = &commonpb.WorkflowExecution{
+			WorkflowId: executionInfo.GetParentWorkflowId(),
+			RunId:      executionInfo.GetParentRunId(),
+		}
+	}
+
+	return &manager.VisibilityRequestBase{
+		NamespaceID: namespace.ID(request.NamespaceID),
+		Namespace:   namespace.Name(request.Namespace),
+		Execution: &commonpb.WorkflowExecution{
+			WorkflowId: executionInfo.GetWorkflowId(),
+			RunId:      executionState.GetRunId(),
+		},
+		WorkflowTypeName: executionInfo.GetWorkflowTypeName(),
+		StartTime:        timestamp.TimeValue(executionState.GetStartTime()),
+		Status:           executionState.GetStatus(),
+		ExecutionTime:    timestamp.TimeValue(executionInfo.GetExecutionTime()),
+		// The task ID is used as the version of the record by Elasticsearch. The transaction ID of the last event
+		// batch is allocated like task IDs, so a record written by the visibility queue after this one wins.
+		TaskID:           executionInfo.GetLastFirstEventTxnId(),
+		ShardID:          request.ShardID,
+		Memo:             memo,
+		TaskQueue:        executionInfo.GetTaskQueue(),
+		SearchAttributes: searchAttributes,
+		ParentExecution:  parentExecution,
+		RootExecution: &commonpb.WorkflowExecution{
+			WorkflowId: executionInfo.GetRootWorkflowId(),
+			RunId:      executionInfo.GetRootRunId(),
+		},
+	}
+}
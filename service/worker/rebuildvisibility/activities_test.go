@@ -0,0 +1,157 @@
+package rebuildvisibility
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/sdk/testsuite"
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/visibility/manager"
+	"go.temporal.io/server/common/shardmap"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func newMutableState(
+	namespaceID string,
+	workflowID string,
+	state enumsspb.WorkflowExecutionState,
+	status enumspb.WorkflowExecutionStatus,
+) *persistencespb.WorkflowMutableState {
+	return &persistencespb.WorkflowMutableState{
+		ExecutionInfo: &persistencespb.WorkflowExecutionInfo{
+			NamespaceId:         namespaceID,
+			WorkflowId:          workflowID,
+			WorkflowTypeName:    "workflow-type",
+			TaskQueue:           "task-queue",
+			LastFirstEventTxnId: 42,
+			Memo:                map[string]*commonpb.Payload{"memo": {Data: []byte(`"value"`)}},
+		},
+		ExecutionState: &persistencespb.WorkflowExecutionState{
+			RunId:  workflowID + "-run",
+			State:  state,
+			Status: status,
+		},
+		NextEventId: 11,
+	}
+}
+
+func Test_RebuildShardVisibility(t *testing.T) {
+	controller := gomock.NewController(t)
+	executionManager := persistence.NewMockExecutionManager(controller)
+	visibilityManager := manager.NewMockVisibilityManager(controller)
+	a := &activities{
+		shardMapProvider:  shardmap.NewProvider(1),
+		executionManager:  executionManager,
+		visibilityManager: visibilityManager,
+		metricsHandler:    metrics.NoopMetricsHandler,
+		logger:            log.NewTestLogger(),
+	}
+
+	closed := newMutableState("namespace-id", "closed", enumsspb.WORKFLOW_EXECUTION_STATE_COMPLETED, enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED)
+	executionTime := time.Now().UTC().Add(-time.Minute)
+	closed.ExecutionInfo.ExecutionTime = timestamppb.New(executionTime)
+	closed.ExecutionInfo.CloseTime = timestamppb.New(executionTime.Add(time.Minute))
+
+	executionManager.EXPECT().ListConcreteExecutions(gomock.Any(), &persistence.ListConcreteExecutionsRequest{
+		ShardID:  1,
+		PageSize: 2,
+	}).Return(&persistence.ListConcreteExecutionsResponse{
+		States: []*persistencespb.WorkflowMutableState{
+			newMutableState("namespace-id", "running", enumsspb.WORKFLOW_EXECUTION_STATE_RUNNING, enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING),
+			newMutableState("other-namespace-id", "other", enumsspb.WORKFLOW_EXECUTION_STATE_RUNNING, enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING),
+		},
+		PageToken: []byte("page-2"),
+	}, nil)
+	executionManager.EXPECT().ListConcreteExecutions(gomock.Any(), &persistence.ListConcreteExecutionsRequest{
+		ShardID:   1,
+		PageSize:  2,
+		PageToken: []byte("page-2"),
+	}).Return(&persistence.ListConcreteExecutionsResponse{
+		States: []*persistencespb.WorkflowMutableState{
+			closed,
+			newMutableState("namespace-id", "zombie", enumsspb.WORKFLOW_EXECUTION_STATE_ZOMBIE, enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING),
+			newMutableState("namespace-id", "invalid", enumsspb.WORKFLOW_EXECUTION_STATE_RUNNING, enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING),
+		},
+	}, nil)
+
+	visibilityManager.EXPECT().UpsertWorkflowExecution(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ any, request *manager.UpsertWorkflowExecutionRequest) error {
+			require.Equal(t, "running", request.Execution.GetWorkflowId())
+			require.Equal(t, "running-run", request.Execution.GetRunId())
+			require.Equal(t, "namespace", request.Namespace.String())
+			require.Equal(t, int64(42), request.TaskID)
+			require.Equal(t, int32(1), request.ShardID)
+			require.Equal(t, []byte(`"value"`), request.Memo.GetFields()["memo"].GetData())
+			return nil
+		})
+	visibilityManager.EXPECT().RecordWorkflowExecutionClosed(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ any, request *manager.RecordWorkflowExecutionClosedRequest) error {
+			require.Equal(t, "closed", request.Execution.GetWorkflowId())
+			require.Equal(t, enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED, request.Status)
+			require.Equal(t, time.Minute, request.ExecutionDuration)
+			require.Equal(t, int64(10), request.HistoryLength)
+			return nil
+		})
+	visibilityManager.EXPECT().UpsertWorkflowExecution(gomock.Any(), gomock.Any()).
+		Return(serviceerror.NewInvalidArgument("invalid search attribute"))
+
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestActivityEnvironment()
+	env.RegisterActivity(a)
+	result, err := env.ExecuteActivity(a.RebuildShardVisibility, rebuildShardRequest{
+		Namespace:   "namespace",
+		NamespaceID: "namespace-id",
+		ShardID:     1,
+		RPS:         1000,
+		PageSize:    2,
+	})
+	require.NoError(t, err)
+	var shardResult rebuildShardResult
+	require.NoError(t, result.Get(&shardResult))
+	require.Equal(t, rebuildShardResult{ScannedExecutions: 3, RebuiltExecutions: 2, FailedExecutions: 1}, shardResult)
+}
+
+func Test_RebuildShardVisibility_ResumeFromHeartbeat(t *testing.T) {
+	controller := gomock.NewController(t)
+	executionManager := persistence.NewMockExecutionManager(controller)
+	a := &activities{
+		shardMapProvider: shardmap.NewProvider(1),
+		executionManager: executionManager,
+		metricsHandler:   metrics.NoopMetricsHandler,
+		logger:           log.NewTestLogger(),
+	}
+
+	executionManager.EXPECT().ListConcreteExecutions(gomock.Any(), &persistence.ListConcreteExecutionsRequest{
+		ShardID:   1,
+		PageSize:  2,
+		PageToken: []byte("page-2"),
+	}).Return(&persistence.ListConcreteExecutionsResponse{}, nil)
+
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestActivityEnvironment()
+	env.RegisterActivity(a)
+	env.SetHeartbeatDetails(shardHeartbeatDetails{
+		NextPageToken: []byte("page-2"),
+		Result:        rebuildShardResult{ScannedExecutions: 5, RebuiltExecutions: 5},
+	})
+	result, err := env.ExecuteActivity(a.RebuildShardVisibility, rebuildShardRequest{
+		Namespace:   "namespace",
+		NamespaceID: "namespace-id",
+		ShardID:     1,
+		RPS:         1000,
+		PageSize:    2,
+	})
+	require.NoError(t, err)
+	var shardResult rebuildShardResult
+	require.NoError(t, result.Get(&shardResult))
+	require.Equal(t, rebuildShardResult{ScannedExecutions: 5, RebuiltExecutions: 5}, shardResult)
+}
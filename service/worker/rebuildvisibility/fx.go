@@ -0,0 +1,75 @@
+package rebuildvisibility
+
+import (
+	"context"
+
+	sdkworker "go.temporal.io/sdk/worker"
+	"go.temporal.io/sdk/workflow"
+	"go.temporal.io/server/common/headers"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/visibility/manager"
+	"go.temporal.io/server/common/primitives"
+	"go.temporal.io/server/common/shardmap"
+	workercommon "go.temporal.io/server/service/worker/common"
+	"go.uber.org/fx"
+)
+
+type (
+	// rebuildVisibilityComponent represent background work needed for rebuilding the visibility of a namespace
+	rebuildVisibilityComponent struct {
+		initParams
+	}
+
+	initParams struct {
+		fx.In
+		ShardMapProvider  *shardmap.Provider
+		ExecutionManager  persistence.ExecutionManager
+		VisibilityManager manager.VisibilityManager
+		NamespaceRegistry namespace.Registry
+		MetricsHandler    metrics.Handler
+		Logger            log.Logger
+	}
+)
+
+var Module = workercommon.AnnotateWorkerComponentProvider(newComponent)
+
+func newComponent(params initParams) workercommon.WorkerComponent {
+	return &rebuildVisibilityComponent{initParams: params}
+}
+
+func (wc *rebuildVisibilityComponent) RegisterWorkflow(registry sdkworker.Registry) {
+	registry.RegisterWorkflowWithOptions(RebuildVisibilityWorkflow, workflow.RegisterOptions{Name: WorkflowName})
+	registry.RegisterActivity(wc.activities())
+}
+
+func (wc *rebuildVisibilityComponent) DedicatedWorkflowWorkerOptions() *workercommon.DedicatedWorkerOptions {
+	// use default worker
+	return nil
+}
+
+func (wc *rebuildVisibilityComponent) RegisterActivities(registry sdkworker.Registry) {
+	registry.RegisterActivity(wc.activities())
+}
+
+func (wc *rebuildVisibilityComponent) DedicatedActivityWorkerOptions() *workercommon.DedicatedWorkerOptions {
+	return &workercommon.DedicatedWorkerOptions{
+		TaskQueue: primitives.RebuildVisibilityActivityTQ,
+		Options: sdkworker.Options{
+			BackgroundActivityContext: headers.SetCallerType(context.Background(), headers.CallerTypePreemptable),
+		},
+	}
+}
+
+func (wc *rebuildVisibilityComponent) activities() *activities {
+	return &activities{
+		shardMapProvider:  wc.ShardMapProvider,
+		executionManager:  wc.ExecutionManager,
+		visibilityManager: wc.VisibilityManager,
+		namespaceRegistry: wc.NamespaceRegistry,
+		metricsHandler:    wc.MetricsHandler.WithTags(metrics.OperationTag(metrics.RebuildVisibilityWorkflowScope)),
+		logger:            wc.Logger,
+	}
+}
@@ -0,0 +1,177 @@
+package rebuildvisibility
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+	"go.temporal.io/server/common/primitives"
+)
+
+const (
+	// WorkflowName is the workflow type of the system workflow rebuilding the visibility records of a namespace
+	WorkflowName = "temporal-sys-rebuild-visibility-workflow"
+	// WorkflowIDPrefix is the prefix of the workflow ID of the rebuild of a namespace, one rebuild per namespace can
+	// run at a time.
+	WorkflowIDPrefix = "temporal-sys-rebuild-visibility-"
+	// ProgressQueryType is the query type returning the RebuildVisibilityProgress of a rebuild
+	ProgressQueryType = "rebuild-visibility-progress"
+
+	defaultRPS                = 100
+	defaultPageSize           = 100
+	defaultShardsPerExecution = 100
+	maxShardsPerExecution     = 1000
+)
+
+type (
+	// RebuildVisibilityParams are the parameters of the rebuild visibility workflow
+	RebuildVisibilityParams struct {
+		// Namespace is the name of the namespace whose visibility records are rebuilt
+		Namespace string
+		// RPS limits the number of visibility records written per second
+		RPS float64
+		// PageSize is the number of executions read from the execution store per request
+		PageSize int
+		// ShardsPerExecution is the number of history shards rebuilt before continue-as-new
+		ShardsPerExecution int
+
+		// NextShardID and Progress are carried over by continue-as-new
+		NextShardID int32
+		Progress    RebuildVisibilityProgress
+	}
+
+	// RebuildVisibilityProgress is the progress of a rebuild, it's returned by the ProgressQueryType query
+	RebuildVisibilityProgress struct {
+		NamespaceID         string
+		TotalShards         int32
+		CompletedShards     int32
+		ScannedExecutions   int64
+		RebuiltExecutions   int64
+		FailedExecutions    int64
+		ContinuedAsNewCount int
+		StartTime           time.Time
+	}
+
+	rebuildMetadata struct {
+		NamespaceID string
+		ShardCount  int32
+	}
+
+	rebuildShardRequest struct {
+		Namespace   string
+		NamespaceID string
+		ShardID     int32
+		RPS         float64
+		PageSize    int
+	}
+
+	rebuildShardResult struct {
+		ScannedExecutions int64
+		RebuiltExecutions int64
+		FailedExecutions  int64
+	}
+)
+
+var (
+	errNamespaceRequired = errors.New("namespace is required")
+
+	rebuildShardActivityOptions = workflow.ActivityOptions{
+		StartToCloseTimeout: 24 * time.Hour,
+		HeartbeatTimeout:    time.Minute,
+		RetryPolicy: &temporal.RetryPolicy{
+			InitialInterval: time.Second,
+			MaximumInterval: time.Minute,
+		},
+	}
+
+	localActivityOptions = workflow.LocalActivityOptions{
+		StartToCloseTimeout: 10 * time.Second,
+		RetryPolicy: &temporal.RetryPolicy{
+			InitialInterval: time.Second,
+			MaximumInterval: 10 * time.Second,
+			MaximumAttempts: 5,
+			NonRetryableErrorTypes: []string{
+				"NamespaceNotFound",
+			},
+		},
+	}
+)
+
+// RebuildVisibilityWorkflow rebuilds the visibility records of a namespace from the mutable state of its executions.
+// The history shards are rebuilt one after the other, an interrupted shard continues from its last heartbeat.
+func RebuildVisibilityWorkflow(ctx workflow.Context, params RebuildVisibilityParams) error {
+	_ = workflow.SetQueryHandler(ctx, ProgressQueryType, func() (RebuildVisibilityProgress, error) {
+		return params.Progress, nil
+	})
+
+	if err := validateAndSetParams(&params); err != nil {
+		return temporal.NewNonRetryableApplicationError(err.Error(), "InvalidArgument", nil)
+	}
+
+	var a *activities
+	if params.Progress.NamespaceID == "" {
+		var metadata rebuildMetadata
+		lctx := workflow.WithLocalActivityOptions(ctx, localActivityOptions)
+		if err := workflow.ExecuteLocalActivity(lctx, a.GetRebuildMetadata, params.Namespace).Get(ctx, &metadata); err != nil {
+			return fmt.Errorf("unable to get rebuild metadata: %w", err)
+		}
+		params.Progress.NamespaceID = metadata.NamespaceID
+		params.Progress.TotalShards = metadata.ShardCount
+		params.Progress.StartTime = workflow.Now(ctx)
+		params.NextShardID = 1
+	}
+
+	actx := workflow.WithTaskQueue(ctx, primitives.RebuildVisibilityActivityTQ)
+	actx = workflow.WithActivityOptions(actx, rebuildShardActivityOptions)
+	for i := 0; i < params.ShardsPerExecution && params.NextShardID <= params.Progress.TotalShards; i++ {
+		var result rebuildShardResult
+		err := workflow.ExecuteActivity(actx, a.RebuildShardVisibility, rebuildShardRequest{
+			Namespace:   params.Namespace,
+			NamespaceID: params.Progress.NamespaceID,
+			ShardID:     params.NextShardID,
+			RPS:         params.RPS,
+			PageSize:    params.PageSize,
+		}).Get(ctx, &result)
+		if err != nil {
+			return fmt.Errorf("unable to rebuild visibility of shard %d: %w", params.NextShardID, err)
+		}
+		params.Progress.CompletedShards++
+		params.Progress.ScannedExecutions += result.ScannedExecutions
+		params.Progress.RebuiltExecutions += result.RebuiltExecutions
+		params.Progress.FailedExecutions += result.FailedExecutions
+		params.NextShardID++
+	}
+
+	if params.NextShardID > params.Progress.TotalShards {
+		workflow.GetLogger(ctx).Info("Visibility rebuild finished.",
+			"Namespace", params.Namespace,
+			"RebuiltExecutions", params.Progress.RebuiltExecutions,
+			"FailedExecutions", params.Progress.FailedExecutions,
+		)
+		return nil
+	}
+
+	params.Progress.ContinuedAsNewCount++
+	return workflow.NewContinueAsNewError(ctx, RebuildVisibilityWorkflow, params)
+}
+
+func validateAndSetParams(params *RebuildVisibilityParams) error {
+	if params.Namespace == "" {
+		return errNamespaceRequired
+	}
+	if params.RPS <= 0 {
+		params.RPS = defaultRPS
+	}
+	if params.PageSize <= 0 {
+		params.PageSize = defaultPageSize
+	}
+	if params.ShardsPerExecution <= 0 {
+		params.ShardsPerExecution = defaultShardsPerExecution
+	}
+	if params.ShardsPerExecution > maxShardsPerExecution {
+		params.ShardsPerExecution = maxShardsPerExecution
+	}
+	return nil
+}
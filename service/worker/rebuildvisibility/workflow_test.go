@@ -0,0 +1,86 @@
+package rebuildvisibility
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/sdk/testsuite"
+	"go.temporal.io/sdk/workflow"
+	"go.temporal.io/server/common/log"
+)
+
+func Test_RebuildVisibilityWorkflow_Success(t *testing.T) {
+	testSuite := &testsuite.WorkflowTestSuite{}
+	testSuite.SetLogger(log.NewSdkLogger(log.NewTestLogger()))
+	env := testSuite.NewTestWorkflowEnvironment()
+
+	var a *activities
+	env.OnActivity(a.GetRebuildMetadata, mock.Anything, "namespace").
+		Return(rebuildMetadata{NamespaceID: "namespace-id", ShardCount: 2}, nil).Once()
+	for shardID := int32(1); shardID <= 2; shardID++ {
+		env.OnActivity(a.RebuildShardVisibility, mock.Anything, rebuildShardRequest{
+			Namespace:   "namespace",
+			NamespaceID: "namespace-id",
+			ShardID:     shardID,
+			RPS:         10,
+			PageSize:    defaultPageSize,
+		}).Return(rebuildShardResult{ScannedExecutions: 3, RebuiltExecutions: 2, FailedExecutions: 1}, nil).Once()
+	}
+
+	env.ExecuteWorkflow(RebuildVisibilityWorkflow, RebuildVisibilityParams{Namespace: "namespace", RPS: 10})
+
+	require.True(t, env.IsWorkflowCompleted())
+	require.NoError(t, env.GetWorkflowError())
+	env.AssertExpectations(t)
+
+	result, err := env.QueryWorkflow(ProgressQueryType)
+	require.NoError(t, err)
+	var progress RebuildVisibilityProgress
+	require.NoError(t, result.Get(&progress))
+	require.Equal(t, "namespace-id", progress.NamespaceID)
+	require.Equal(t, int32(2), progress.TotalShards)
+	require.Equal(t, int32(2), progress.CompletedShards)
+	require.Equal(t, int64(6), progress.ScannedExecutions)
+	require.Equal(t, int64(4), progress.RebuiltExecutions)
+	require.Equal(t, int64(2), progress.FailedExecutions)
+}
+
+func Test_RebuildVisibilityWorkflow_ContinueAsNew(t *testing.T) {
+	testSuite := &testsuite.WorkflowTestSuite{}
+	testSuite.SetLogger(log.NewSdkLogger(log.NewTestLogger()))
+	env := testSuite.NewTestWorkflowEnvironment()
+
+	var a *activities
+	env.OnActivity(a.RebuildShardVisibility, mock.Anything, mock.MatchedBy(func(request rebuildShardRequest) bool {
+		return request.ShardID == 2
+	})).Return(rebuildShardResult{ScannedExecutions: 1, RebuiltExecutions: 1}, nil).Once()
+
+	env.ExecuteWorkflow(RebuildVisibilityWorkflow, RebuildVisibilityParams{
+		Namespace:          "namespace",
+		ShardsPerExecution: 1,
+		NextShardID:        2,
+		Progress: RebuildVisibilityProgress{
+			NamespaceID:     "namespace-id",
+			TotalShards:     3,
+			CompletedShards: 1,
+		},
+	})
+
+	require.True(t, env.IsWorkflowCompleted())
+	var continueAsNewErr *workflow.ContinueAsNewError
+	require.True(t, errors.As(env.GetWorkflowError(), &continueAsNewErr))
+	env.AssertExpectations(t)
+}
+
+func Test_RebuildVisibilityWorkflow_NamespaceRequired(t *testing.T) {
+	testSuite := &testsuite.WorkflowTestSuite{}
+	testSuite.SetLogger(log.NewSdkLogger(log.NewTestLogger()))
+	env := testSuite.NewTestWorkflowEnvironment()
+
+	env.ExecuteWorkflow(RebuildVisibilityWorkflow, RebuildVisibilityParams{})
+
+	require.True(t, env.IsWorkflowCompleted())
+	require.ErrorContains(t, env.GetWorkflowError(), errNamespaceRequired.Error())
+}
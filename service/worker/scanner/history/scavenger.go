@@ -12,7 +12,6 @@ import (
 	enumsspb "go.temporal.io/server/api/enums/v1"
 	"go.temporal.io/server/api/historyservice/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
-	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/collection"
 	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/log"
@@ -23,6 +22,7 @@ import (
 	"go.temporal.io/server/common/persistence/serialization"
 	"go.temporal.io/server/common/primitives/timestamp"
 	"go.temporal.io/server/common/quotas"
+	"go.temporal.io/server/common/shardmap"
 )
 
 type (
@@ -31,6 +31,7 @@ type (
 		SuccessCount int
 		ErrorCount   int
 		SkipCount    int
+		OrphanCount  int
 		CurrentPage  int
 
 		NextPageToken []byte
@@ -38,7 +39,7 @@ type (
 
 	// Scavenger is the type that holds the state for history scavenger daemon
 	Scavenger struct {
-		numShards      int32
+		shardMap       *shardmap.ShardMap
 		db             persistence.ExecutionManager
 		client         historyservice.HistoryServiceClient
 		adminClient    adminservice.AdminServiceClient
@@ -52,6 +53,8 @@ type (
 		historyDataMinAge           dynamicconfig.DurationPropertyFn
 		executionDataDurationBuffer dynamicconfig.DurationPropertyFn
 		enableRetentionVerification dynamicconfig.BoolPropertyFn
+		// orphaned history branches are only reported if this is false
+		orphanDeletionEnabled dynamicconfig.BoolPropertyFn
 
 		sync.WaitGroup
 		sync.Mutex
@@ -78,9 +81,11 @@ const (
 // complete iteration over all of the history branches in the system. For
 // each branch, the scavenger will attempt
 //   - describe the corresponding workflow execution
-//   - deletion of history itself, if there are no workflow execution
+//   - deletion of history itself, if there are no workflow execution and orphanDeletionEnabled is true
+//
+// History branches without a workflow execution are counted in the OrphanCount of the returned details.
 func NewScavenger(
-	numShards int32,
+	shardMap *shardmap.ShardMap,
 	db persistence.ExecutionManager,
 	rps int,
 	client historyservice.HistoryServiceClient,
@@ -90,12 +95,13 @@ func NewScavenger(
 	historyDataMinAge dynamicconfig.DurationPropertyFn,
 	executionDataDurationBuffer dynamicconfig.DurationPropertyFn,
 	enableRetentionVerification dynamicconfig.BoolPropertyFn,
+	orphanDeletionEnabled dynamicconfig.BoolPropertyFn,
 	metricsHandler metrics.Handler,
 	logger log.Logger,
 ) *Scavenger {
 
 	return &Scavenger{
-		numShards:   numShards,
+		shardMap:    shardMap,
 		db:          db,
 		client:      client,
 		adminClient: adminClient,
@@ -106,6 +112,7 @@ func NewScavenger(
 		historyDataMinAge:           historyDataMinAge,
 		executionDataDurationBuffer: executionDataDurationBuffer,
 		enableRetentionVerification: enableRetentionVerification,
+		orphanDeletionEnabled:       orphanDeletionEnabled,
 		metricsHandler:              metricsHandler.WithTags(metrics.OperationTag(metrics.HistoryScavengerScope)),
 		logger:                      logger,
 
@@ -224,7 +231,7 @@ func (s *Scavenger) filterTask(
 		s.hbd.ErrorCount++
 		return nil
 	}
-	shardID := common.WorkflowIDToHistoryShard(namespaceID, workflowID, s.numShards)
+	shardID := s.shardMap.WorkflowIDToHistoryShard(namespaceID, workflowID)
 
 	branchToken, err := serialization.HistoryBranchToBlob(branch.BranchInfo)
 	if err != nil {
@@ -272,6 +279,12 @@ func (s *Scavenger) handleTask(
 		return err
 	}
 
+	s.orphaned()
+	if !s.orphanDeletionEnabled() {
+		s.logger.Warn("found history garbage", getTaskLoggingTags(nil, task)...)
+		return nil
+	}
+
 	// deleting history branch
 	err = s.db.DeleteHistoryBranch(ctx, &persistence.DeleteHistoryBranchRequest{
 		ShardID:     task.shardID,
@@ -300,6 +313,13 @@ func (s *Scavenger) handleErr(
 	s.hbd.SuccessCount++
 }
 
+func (s *Scavenger) orphaned() {
+	s.Lock()
+	defer s.Unlock()
+	metrics.HistoryScavengerOrphanCount.With(s.metricsHandler).Record(1)
+	s.hbd.OrphanCount++
+}
+
 func (s *Scavenger) getPaginationFn(
 	ctx context.Context,
 ) collection.PaginationFn[persistence.HistoryBranchDetail] {
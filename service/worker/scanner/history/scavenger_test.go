@@ -24,6 +24,7 @@ import (
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/primitives"
 	"go.temporal.io/server/common/primitives/timestamp"
+	"go.temporal.io/server/common/shardmap"
 	"go.temporal.io/server/common/testing/protomock"
 	"go.uber.org/mock/gomock"
 	"google.golang.org/protobuf/types/known/durationpb"
@@ -90,7 +91,7 @@ func (s *ScavengerTestSuite) createTestScavenger(
 	executionDataAge := dynamicconfig.GetDurationPropertyFn(time.Second)
 	enableRetentionVerification := dynamicconfig.GetBoolPropertyFn(true)
 	s.scavenger = NewScavenger(
-		s.numShards,
+		shardmap.NewShardMap(s.numShards),
 		s.mockExecutionManager,
 		rps,
 		s.mockHistoryClient,
@@ -100,6 +101,7 @@ func (s *ScavengerTestSuite) createTestScavenger(
 		dataAge,
 		executionDataAge,
 		enableRetentionVerification,
+		dynamicconfig.GetBoolPropertyFn(true),
 		s.metricHandler,
 		s.logger,
 	)
@@ -429,10 +431,42 @@ func (s *ScavengerTestSuite) TestDeletingBranchesTwoPages() {
 	s.Equal(0, hbd.SkipCount)
 	s.Equal(4, hbd.SuccessCount)
 	s.Equal(0, hbd.ErrorCount)
+	s.Equal(4, hbd.OrphanCount)
 	s.Equal(2, hbd.CurrentPage)
 	s.Equal(0, len(hbd.NextPageToken))
 }
 
+func (s *ScavengerTestSuite) TestOrphanedBranchesNotDeleted() {
+	s.scavenger.orphanDeletionEnabled = dynamicconfig.GetBoolPropertyFn(false)
+	s.mockExecutionManager.EXPECT().GetAllHistoryTreeBranches(gomock.Any(), protomock.Eq(&persistence.GetAllHistoryTreeBranchesRequest{
+		PageSize: pageSize,
+	})).Return(&persistence.GetAllHistoryTreeBranchesResponse{
+		Branches: []persistence.HistoryBranchDetail{
+			{
+				BranchInfo: &persistencespb.HistoryBranch{
+					TreeId:   treeID1,
+					BranchId: branchID1,
+				},
+				ForkTime: timestamp.TimeNowPtrUtcAddDuration(-s.scavenger.historyDataMinAge() * 2),
+				Info:     persistence.BuildHistoryGarbageCleanupInfo("namespaceID1", "workflowID1", "runID1"),
+			},
+		},
+	}, nil)
+	s.mockHistoryClient.EXPECT().DescribeMutableState(gomock.Any(), protomock.Eq(&historyservice.DescribeMutableStateRequest{
+		NamespaceId: "namespaceID1",
+		Execution: &commonpb.WorkflowExecution{
+			WorkflowId: "workflowID1",
+			RunId:      "runID1",
+		},
+	})).Return(nil, serviceerror.NewNotFound(""))
+
+	hbd, err := s.scavenger.Run(context.Background())
+	s.Nil(err)
+	s.Equal(1, hbd.SuccessCount)
+	s.Equal(0, hbd.ErrorCount)
+	s.Equal(1, hbd.OrphanCount)
+}
+
 func (s *ScavengerTestSuite) TestMixesTwoPages() {
 	s.mockExecutionManager.EXPECT().GetAllHistoryTreeBranches(gomock.Any(), protomock.Eq(&persistence.GetAllHistoryTreeBranchesRequest{
 		PageSize: pageSize,
@@ -551,6 +585,7 @@ func (s *ScavengerTestSuite) TestMixesTwoPages() {
 	s.Equal(1, hbd.SkipCount)
 	s.Equal(2, hbd.SuccessCount)
 	s.Equal(2, hbd.ErrorCount)
+	s.Equal(2, hbd.OrphanCount)
 	s.Equal(2, hbd.CurrentPage)
 	s.Equal(0, len(hbd.NextPageToken))
 }
@@ -0,0 +1,372 @@
+package orphans
+
+import (
+	"context"
+	"errors"
+
+	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/sdk/activity"
+	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/quotas"
+	"go.temporal.io/server/common/shardmap"
+	"go.temporal.io/server/service/history/tasks"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type (
+	// Stage is a step of a scavenger run. Every stage looks for one type of orphaned data.
+	Stage string
+
+	// Counts are the numbers of records a stage has looked at, found orphaned, repaired and failed to process
+	Counts struct {
+		Scanned  int64
+		Orphaned int64
+		Repaired int64
+		Errors   int64
+	}
+
+	// Summary is the result of a scavenger run
+	Summary struct {
+		CurrentExecutions Counts
+		HistoryTasks      Counts
+	}
+
+	// ScavengerHeartbeatDetails is the heartbeat detail for OrphanScavengerActivity. It's the position of the
+	// scavenger in its run, so that a retried activity continues where the previous attempt stopped.
+	ScavengerHeartbeatDetails struct {
+		Stage         Stage
+		ShardID       int32
+		CategoryIndex int32
+		NextPageToken []byte
+
+		Summary Summary
+	}
+
+	// Scavenger is the type that holds the state for orphan scavenger daemon
+	Scavenger struct {
+		shardMap       *shardmap.ShardMap
+		db             persistence.ExecutionManager
+		historyClient  historyservice.HistoryServiceClient
+		rateLimiter    quotas.RateLimiter
+		repairEnabled  dynamicconfig.BoolPropertyFn
+		metricsHandler metrics.Handler
+		logger         log.Logger
+		isInTest       bool
+
+		hbd ScavengerHeartbeatDetails
+	}
+
+	executionKey struct {
+		namespaceID string
+		workflowID  string
+		runID       string
+	}
+)
+
+const (
+	// StageCurrentExecutions looks for current execution records that point to a run which doesn't exist
+	StageCurrentExecutions Stage = "current_executions"
+	// StageHistoryTasks looks for transfer and timer tasks of runs which don't exist
+	StageHistoryTasks Stage = "history_tasks"
+
+	pageSize = 100
+)
+
+var (
+	// taskCategories are the history task categories checked for orphaned tasks. Visibility tasks aren't checked,
+	// the deletion of a workflow's visibility record is a visibility task of a workflow that doesn't exist anymore.
+	taskCategories = []tasks.Category{tasks.CategoryTransfer, tasks.CategoryTimer}
+)
+
+// NewScavenger returns an instance of orphan scavenger daemon
+// The Scavenger can be started by calling the Run() method on the
+// returned object. Calling the Run() method will result in one
+// complete iteration over
+//   - the current execution records of every shard, to check that the run they point to exists
+//   - the transfer and timer tasks of every shard, to check that the execution of the task exists
+//
+// Orphaned data is logged and counted in metrics and in the returned Summary. It is deleted by the owner of its shard
+// if repairEnabled is true. History branches without an execution are found by the history scavenger.
+func NewScavenger(
+	shardMap *shardmap.ShardMap,
+	db persistence.ExecutionManager,
+	historyClient historyservice.HistoryServiceClient,
+	rps dynamicconfig.IntPropertyFn,
+	repairEnabled dynamicconfig.BoolPropertyFn,
+	hbd ScavengerHeartbeatDetails,
+	metricsHandler metrics.Handler,
+	logger log.Logger,
+) *Scavenger {
+	if hbd.Stage == "" {
+		hbd.Stage = StageCurrentExecutions
+		hbd.ShardID = 1
+	}
+	return &Scavenger{
+		shardMap:      shardMap,
+		db:            db,
+		historyClient: historyClient,
+		rateLimiter: quotas.NewDefaultOutgoingRateLimiter(
+			func() float64 { return float64(rps()) },
+		),
+		repairEnabled:  repairEnabled,
+		metricsHandler: metricsHandler.WithTags(metrics.OperationTag(metrics.OrphanScavengerScope)),
+		logger:         logger,
+
+		hbd: hbd,
+	}
+}
+
+// Run runs the scavenger
+func (s *Scavenger) Run(ctx context.Context) (Summary, error) {
+	for {
+		var err error
+		switch s.hbd.Stage {
+		case StageCurrentExecutions:
+			err = s.scanCurrentExecutions(ctx)
+		case StageHistoryTasks:
+			err = s.scanHistoryTasks(ctx)
+		default:
+			s.logSummary()
+			return s.hbd.Summary, nil
+		}
+		if err != nil {
+			return s.hbd.Summary, err
+		}
+	}
+}
+
+func (s *Scavenger) scanCurrentExecutions(ctx context.Context) error {
+	for ; s.hbd.ShardID <= s.shardMap.TotalShardCount(); s.hbd.ShardID++ {
+		for {
+			if err := s.rateLimiter.Wait(ctx); err != nil {
+				return err
+			}
+			resp, err := s.db.ListCurrentExecutions(ctx, &persistence.ListCurrentExecutionsRequest{
+				ShardID:   s.hbd.ShardID,
+				PageSize:  pageSize,
+				PageToken: s.hbd.NextPageToken,
+			})
+			if err != nil {
+				return err
+			}
+			for _, current := range resp.Executions {
+				orphaned, err := s.checkCurrentExecution(ctx, executionKey{
+					namespaceID: current.NamespaceID,
+					workflowID:  current.WorkflowID,
+					runID:       current.RunID,
+				})
+				s.record(StageCurrentExecutions, orphaned, err)
+			}
+			s.hbd.NextPageToken = resp.PageToken
+			s.heartbeat(ctx)
+			if len(resp.PageToken) == 0 {
+				break
+			}
+		}
+	}
+	s.nextStage(StageHistoryTasks)
+	return nil
+}
+
+// checkCurrentExecution checks the run a current execution record points to and deletes the record if the run doesn't
+// exist and repair is enabled
+func (s *Scavenger) checkCurrentExecution(ctx context.Context, key executionKey) (bool, error) {
+	if exists, err := s.executionExists(ctx, s.hbd.ShardID, key); exists || err != nil {
+		return false, err
+	}
+
+	s.logger.Warn("found orphaned current execution", getLoggingTags(s.hbd.ShardID, key)...)
+	if !s.repairEnabled() {
+		return true, nil
+	}
+	// the owner of the shard checks the record again while it holds the workflow lock
+	resp, err := s.historyClient.DeleteOrphanedCurrentExecution(ctx, &historyservice.DeleteOrphanedCurrentExecutionRequest{
+		NamespaceId: key.namespaceID,
+		Execution: &commonpb.WorkflowExecution{
+			WorkflowId: key.workflowID,
+			RunId:      key.runID,
+		},
+	})
+	if err != nil {
+		return true, err
+	}
+	if resp.GetDeleted() {
+		s.repaired(StageCurrentExecutions)
+	}
+	return true, nil
+}
+
+func (s *Scavenger) scanHistoryTasks(ctx context.Context) error {
+	for ; s.hbd.ShardID <= s.shardMap.TotalShardCount(); s.hbd.ShardID++ {
+		for ; int(s.hbd.CategoryIndex) < len(taskCategories); s.hbd.CategoryIndex++ {
+			category := taskCategories[s.hbd.CategoryIndex]
+			for {
+				if err := s.rateLimiter.Wait(ctx); err != nil {
+					return err
+				}
+				resp, err := s.db.GetHistoryTasks(ctx, &persistence.GetHistoryTasksRequest{
+					ShardID:             s.hbd.ShardID,
+					TaskCategory:        category,
+					InclusiveMinTaskKey: tasks.MinimumKey,
+					ExclusiveMaxTaskKey: tasks.MaximumKey,
+					BatchSize:           pageSize,
+					NextPageToken:       s.hbd.NextPageToken,
+				})
+				if err != nil {
+					return err
+				}
+				checked := make(map[executionKey]bool)
+				for _, task := range resp.Tasks {
+					key := executionKey{
+						namespaceID: task.GetNamespaceID(),
+						workflowID:  task.GetWorkflowID(),
+						runID:       task.GetRunID(),
+					}
+					exists, ok := checked[key]
+					if !ok {
+						exists, err = s.executionExists(ctx, s.hbd.ShardID, key)
+						if err != nil {
+							s.record(StageHistoryTasks, false, err)
+							continue
+						}
+						checked[key] = exists
+					}
+					if exists {
+						s.record(StageHistoryTasks, false, nil)
+						continue
+					}
+					s.record(StageHistoryTasks, true, s.handleOrphanedTask(ctx, category, task, key))
+				}
+				s.hbd.NextPageToken = resp.NextPageToken
+				s.heartbeat(ctx)
+				if len(resp.NextPageToken) == 0 {
+					break
+				}
+			}
+		}
+		s.hbd.CategoryIndex = 0
+	}
+	s.nextStage("")
+	return nil
+}
+
+func (s *Scavenger) handleOrphanedTask(ctx context.Context, category tasks.Category, task tasks.Task, key executionKey) error {
+	s.logger.Warn("found orphaned history task", append(getLoggingTags(s.hbd.ShardID, key),
+		tag.TaskType(task.GetType()),
+		tag.TaskID(task.GetTaskID()),
+		tag.Timestamp(task.GetVisibilityTime()),
+	)...)
+	if !s.repairEnabled() {
+		return nil
+	}
+	// the owner of the shard checks the run again while it holds the workflow lock
+	resp, err := s.historyClient.DeleteOrphanedHistoryTask(ctx, &historyservice.DeleteOrphanedHistoryTaskRequest{
+		ShardId:     s.hbd.ShardID,
+		NamespaceId: key.namespaceID,
+		Execution: &commonpb.WorkflowExecution{
+			WorkflowId: key.workflowID,
+			RunId:      key.runID,
+		},
+		Category:       int32(category.ID()),
+		TaskId:         task.GetKey().TaskID,
+		VisibilityTime: timestamppb.New(task.GetKey().FireTime),
+	})
+	if err != nil {
+		return err
+	}
+	if resp.GetDeleted() {
+		s.repaired(StageHistoryTasks)
+	}
+	return nil
+}
+
+func (s *Scavenger) executionExists(ctx context.Context, shardID int32, key executionKey) (bool, error) {
+	if err := s.rateLimiter.Wait(ctx); err != nil {
+		return false, err
+	}
+	_, err := s.db.GetWorkflowExecution(ctx, &persistence.GetWorkflowExecutionRequest{
+		ShardID:     shardID,
+		NamespaceID: key.namespaceID,
+		WorkflowID:  key.workflowID,
+		RunID:       key.runID,
+	})
+	if err == nil {
+		return true, nil
+	}
+	var notFound *serviceerror.NotFound
+	if errors.As(err, &notFound) {
+		return false, nil
+	}
+	return false, err
+}
+
+// record counts the result of checking a record for orphaned data and of repairing it
+func (s *Scavenger) record(stage Stage, orphaned bool, err error) {
+	counts := s.counts(stage)
+	handler := s.metricsHandler.WithTags(metrics.StringTag("orphan_stage", string(stage)))
+	counts.Scanned++
+	metrics.OrphanScavengerScannedCount.With(handler).Record(1)
+	if orphaned {
+		counts.Orphaned++
+		metrics.OrphanScavengerOrphanedCount.With(handler).Record(1)
+	}
+	if err != nil {
+		counts.Errors++
+		metrics.OrphanScavengerErrorCount.With(handler).Record(1)
+		s.logger.Error("unable to check or repair orphaned data",
+			tag.ShardID(s.hbd.ShardID), tag.NewStringTag("stage", string(stage)), tag.Error(err))
+	}
+}
+
+func (s *Scavenger) repaired(stage Stage) {
+	s.counts(stage).Repaired++
+	metrics.OrphanScavengerRepairedCount.With(
+		s.metricsHandler.WithTags(metrics.StringTag("orphan_stage", string(stage))),
+	).Record(1)
+}
+
+func (s *Scavenger) counts(stage Stage) *Counts {
+	switch stage {
+	case StageCurrentExecutions:
+		return &s.hbd.Summary.CurrentExecutions
+	default:
+		return &s.hbd.Summary.HistoryTasks
+	}
+}
+
+func (s *Scavenger) nextStage(stage Stage) {
+	s.hbd.Stage = stage
+	s.hbd.ShardID = 1
+	s.hbd.CategoryIndex = 0
+	s.hbd.NextPageToken = nil
+}
+
+func (s *Scavenger) heartbeat(ctx context.Context) {
+	if !s.isInTest {
+		activity.RecordHeartbeat(ctx, s.hbd)
+	}
+}
+
+func (s *Scavenger) logSummary() {
+	summary := s.hbd.Summary
+	s.logger.Info("orphan scavenger finished",
+		tag.NewAnyTag("current-executions", summary.CurrentExecutions),
+		tag.NewAnyTag("history-tasks", summary.HistoryTasks),
+		tag.NewBoolTag("repair-enabled", s.repairEnabled()),
+	)
+}
+
+func getLoggingTags(shardID int32, key executionKey) []tag.Tag {
+	return []tag.Tag{
+		tag.ShardID(shardID),
+		tag.WorkflowNamespaceID(key.namespaceID),
+		tag.WorkflowID(key.workflowID),
+		tag.WorkflowRunID(key.runID),
+	}
+}
@@ -0,0 +1,175 @@
+package orphans
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/api/historyservicemock/v1"
+	"go.temporal.io/server/common/definition"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/shardmap"
+	"go.temporal.io/server/common/testing/protomock"
+	"go.temporal.io/server/service/history/tasks"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type (
+	ScavengerTestSuite struct {
+		suite.Suite
+		controller *gomock.Controller
+
+		mockExecutionManager *persistence.MockExecutionManager
+		mockHistoryClient    *historyservicemock.MockHistoryServiceClient
+	}
+)
+
+func TestScavengerTestSuite(t *testing.T) {
+	suite.Run(t, new(ScavengerTestSuite))
+}
+
+func (s *ScavengerTestSuite) SetupTest() {
+	s.controller = gomock.NewController(s.T())
+	s.mockExecutionManager = persistence.NewMockExecutionManager(s.controller)
+	s.mockHistoryClient = historyservicemock.NewMockHistoryServiceClient(s.controller)
+}
+
+func (s *ScavengerTestSuite) newScavenger(repair bool, hbd ScavengerHeartbeatDetails) *Scavenger {
+	scavenger := NewScavenger(
+		shardmap.NewShardMap(1),
+		s.mockExecutionManager,
+		s.mockHistoryClient,
+		dynamicconfig.GetIntPropertyFn(10000),
+		dynamicconfig.GetBoolPropertyFn(repair),
+		hbd,
+		metrics.NoopMetricsHandler,
+		log.NewTestLogger(),
+	)
+	scavenger.isInTest = true
+	return scavenger
+}
+
+func (s *ScavengerTestSuite) expectExecution(namespaceID string, workflowID string, runID string, exists bool) {
+	call := s.mockExecutionManager.EXPECT().GetWorkflowExecution(gomock.Any(), &persistence.GetWorkflowExecutionRequest{
+		ShardID:     1,
+		NamespaceID: namespaceID,
+		WorkflowID:  workflowID,
+		RunID:       runID,
+	})
+	if exists {
+		call.Return(&persistence.GetWorkflowExecutionResponse{}, nil)
+	} else {
+		call.Return(nil, serviceerror.NewNotFound("workflow not found"))
+	}
+}
+
+func (s *ScavengerTestSuite) expectNoTasks(category tasks.Category) {
+	s.mockExecutionManager.EXPECT().GetHistoryTasks(gomock.Any(), &persistence.GetHistoryTasksRequest{
+		ShardID:             1,
+		TaskCategory:        category,
+		InclusiveMinTaskKey: tasks.MinimumKey,
+		ExclusiveMaxTaskKey: tasks.MaximumKey,
+		BatchSize:           pageSize,
+	}).Return(&persistence.GetHistoryTasksResponse{}, nil)
+}
+
+func (s *ScavengerTestSuite) TestCurrentExecutions() {
+	s.mockExecutionManager.EXPECT().ListCurrentExecutions(gomock.Any(), &persistence.ListCurrentExecutionsRequest{
+		ShardID:  1,
+		PageSize: pageSize,
+	}).Return(&persistence.ListCurrentExecutionsResponse{
+		Executions: []*persistence.CurrentWorkflowExecution{
+			{NamespaceID: "namespace", WorkflowID: "current", RunID: "run1"},
+			{NamespaceID: "namespace", WorkflowID: "orphaned", RunID: "run2"},
+			{NamespaceID: "namespace", WorkflowID: "restarted", RunID: "run3"},
+		},
+		PageToken: []byte("page1"),
+	}, nil)
+	s.mockExecutionManager.EXPECT().ListCurrentExecutions(gomock.Any(), &persistence.ListCurrentExecutionsRequest{
+		ShardID:   1,
+		PageSize:  pageSize,
+		PageToken: []byte("page1"),
+	}).Return(&persistence.ListCurrentExecutionsResponse{}, nil)
+	s.expectExecution("namespace", "current", "run1", true)
+	s.expectExecution("namespace", "orphaned", "run2", false)
+	s.expectExecution("namespace", "restarted", "run3", false)
+	s.mockHistoryClient.EXPECT().DeleteOrphanedCurrentExecution(gomock.Any(), protomock.Eq(&historyservice.DeleteOrphanedCurrentExecutionRequest{
+		NamespaceId: "namespace",
+		Execution:   &commonpb.WorkflowExecution{WorkflowId: "orphaned", RunId: "run2"},
+	})).Return(&historyservice.DeleteOrphanedCurrentExecutionResponse{Deleted: true}, nil)
+	// a new run was started before the owner of the shard checked the record
+	s.mockHistoryClient.EXPECT().DeleteOrphanedCurrentExecution(gomock.Any(), protomock.Eq(&historyservice.DeleteOrphanedCurrentExecutionRequest{
+		NamespaceId: "namespace",
+		Execution:   &commonpb.WorkflowExecution{WorkflowId: "restarted", RunId: "run3"},
+	})).Return(&historyservice.DeleteOrphanedCurrentExecutionResponse{}, nil)
+	s.expectNoTasks(tasks.CategoryTransfer)
+	s.expectNoTasks(tasks.CategoryTimer)
+
+	summary, err := s.newScavenger(true, ScavengerHeartbeatDetails{}).Run(context.Background())
+	s.NoError(err)
+	s.Equal(Summary{CurrentExecutions: Counts{Scanned: 3, Orphaned: 2, Repaired: 1}}, summary)
+}
+
+func (s *ScavengerTestSuite) TestHistoryTasks_DetectOnly() {
+	now := time.Now().UTC()
+	s.mockExecutionManager.EXPECT().GetHistoryTasks(gomock.Any(), &persistence.GetHistoryTasksRequest{
+		ShardID:             1,
+		TaskCategory:        tasks.CategoryTimer,
+		InclusiveMinTaskKey: tasks.MinimumKey,
+		ExclusiveMaxTaskKey: tasks.MaximumKey,
+		BatchSize:           pageSize,
+	}).Return(&persistence.GetHistoryTasksResponse{
+		Tasks: []tasks.Task{
+			&tasks.UserTimerTask{WorkflowKey: definition.NewWorkflowKey("namespace", "wf1", "run1"), VisibilityTimestamp: now, TaskID: 1},
+			&tasks.UserTimerTask{WorkflowKey: definition.NewWorkflowKey("namespace", "wf2", "run1"), VisibilityTimestamp: now, TaskID: 2},
+			&tasks.UserTimerTask{WorkflowKey: definition.NewWorkflowKey("namespace", "wf2", "run1"), VisibilityTimestamp: now, TaskID: 3},
+		},
+	}, nil)
+	s.expectExecution("namespace", "wf1", "run1", true)
+	s.expectExecution("namespace", "wf2", "run1", false)
+
+	// resume from the timer tasks of the first shard
+	summary, err := s.newScavenger(false, ScavengerHeartbeatDetails{
+		Stage:         StageHistoryTasks,
+		ShardID:       1,
+		CategoryIndex: 1,
+	}).Run(context.Background())
+	s.NoError(err)
+	s.Equal(Summary{HistoryTasks: Counts{Scanned: 3, Orphaned: 2}}, summary)
+}
+
+func (s *ScavengerTestSuite) TestHistoryTasks_Repair() {
+	s.mockExecutionManager.EXPECT().GetHistoryTasks(gomock.Any(), &persistence.GetHistoryTasksRequest{
+		ShardID:             1,
+		TaskCategory:        tasks.CategoryTransfer,
+		InclusiveMinTaskKey: tasks.MinimumKey,
+		ExclusiveMaxTaskKey: tasks.MaximumKey,
+		BatchSize:           pageSize,
+	}).Return(&persistence.GetHistoryTasksResponse{
+		Tasks: []tasks.Task{
+			&tasks.ActivityTask{WorkflowKey: definition.NewWorkflowKey("namespace", "wf1", "run1"), TaskID: 1},
+		},
+	}, nil)
+	s.expectExecution("namespace", "wf1", "run1", false)
+	s.mockHistoryClient.EXPECT().DeleteOrphanedHistoryTask(gomock.Any(), protomock.Eq(&historyservice.DeleteOrphanedHistoryTaskRequest{
+		ShardId:        1,
+		NamespaceId:    "namespace",
+		Execution:      &commonpb.WorkflowExecution{WorkflowId: "wf1", RunId: "run1"},
+		Category:       int32(tasks.CategoryIDTransfer),
+		TaskId:         1,
+		VisibilityTime: timestamppb.New(tasks.DefaultFireTime),
+	})).Return(&historyservice.DeleteOrphanedHistoryTaskResponse{Deleted: true}, nil)
+	s.expectNoTasks(tasks.CategoryTimer)
+
+	summary, err := s.newScavenger(true, ScavengerHeartbeatDetails{Stage: StageHistoryTasks, ShardID: 1}).Run(context.Background())
+	s.NoError(err)
+	s.Equal(Summary{HistoryTasks: Counts{Scanned: 1, Orphaned: 1, Repaired: 1}}, summary)
+}
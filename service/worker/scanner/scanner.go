@@ -26,6 +26,7 @@ import (
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/persistence/visibility/manager"
 	"go.temporal.io/server/common/sdk"
+	"go.temporal.io/server/common/shardmap"
 	"go.temporal.io/server/service/worker/scanner/build_ids"
 )
 
@@ -49,11 +50,17 @@ type (
 		HistoryScannerEnabled dynamicconfig.BoolPropertyFn
 		// ExecutionsScannerEnabled indicates if executions scanner should be started as part of scanner
 		ExecutionsScannerEnabled dynamicconfig.BoolPropertyFn
+		// OrphanScannerEnabled indicates if orphan scanner should be started as part of scanner
+		OrphanScannerEnabled dynamicconfig.BoolPropertyFn
+		// OrphanScannerRepairEnabled indicates if orphan scanner deletes the orphaned data it finds
+		OrphanScannerRepairEnabled dynamicconfig.BoolPropertyFn
 		// HistoryScannerDataMinAge indicates the cleanup threshold of history branch data
 		// Only clean up history branches that older than this threshold
 		HistoryScannerDataMinAge dynamicconfig.DurationPropertyFn
 		// HistoryScannerVerifyRetention indicates if the history scavenger to do retention verification
 		HistoryScannerVerifyRetention dynamicconfig.BoolPropertyFn
+		// HistoryScannerOrphanDeletionEnabled indicates if the history scavenger deletes history branches without a workflow execution
+		HistoryScannerOrphanDeletionEnabled dynamicconfig.BoolPropertyFn
 		// ExecutionScannerPerHostQPS the max rate of calls to scan execution data per host
 		ExecutionScannerPerHostQPS dynamicconfig.IntPropertyFn
 		// ExecutionScannerPerShardQPS the max rate of calls to scan execution data per shard
@@ -89,6 +96,7 @@ type (
 		namespaceRegistry  namespace.Registry
 		currentClusterName string
 		hostInfo           membership.HostInfo
+		shardMapProvider   *shardmap.Provider
 	}
 
 	// Scanner is the background sub-system that does full scans
@@ -121,6 +129,7 @@ func New(
 	registry namespace.Registry,
 	currentClusterName string,
 	hostInfo membership.HostInfo,
+	shardMapProvider *shardmap.Provider,
 ) *Scanner {
 	return &Scanner{
 		context: scannerContext{
@@ -138,6 +147,7 @@ func New(
 			namespaceRegistry:  registry,
 			currentClusterName: currentClusterName,
 			hostInfo:           hostInfo,
+			shardMapProvider:   shardMapProvider,
 		},
 	}
 }
@@ -177,6 +187,12 @@ func (s *Scanner) Start() error {
 		workerTaskQueueNames = append(workerTaskQueueNames, historyScannerTaskQueueName)
 	}
 
+	if s.context.cfg.OrphanScannerEnabled() {
+		s.wg.Add(1)
+		go s.startWorkflowWithRetry(ctx, orphanScannerWFStartOptions, orphanScannerWFTypeName)
+		workerTaskQueueNames = append(workerTaskQueueNames, orphanScannerTaskQueueName)
+	}
+
 	if s.context.cfg.BuildIdScavengerEnabled() {
 		s.wg.Add(1)
 		go s.startWorkflowWithRetry(ctx, build_ids.BuildIdScavengerWFStartOptions, build_ids.BuildIdScavangerWorkflowName)
@@ -210,9 +226,11 @@ func (s *Scanner) Start() error {
 		work.RegisterWorkflowWithOptions(TaskQueueScannerWorkflow, workflow.RegisterOptions{Name: tqScannerWFTypeName})
 		work.RegisterWorkflowWithOptions(HistoryScannerWorkflow, workflow.RegisterOptions{Name: historyScannerWFTypeName})
 		work.RegisterWorkflowWithOptions(ExecutionsScannerWorkflow, workflow.RegisterOptions{Name: executionsScannerWFTypeName})
+		work.RegisterWorkflowWithOptions(OrphanScannerWorkflow, workflow.RegisterOptions{Name: orphanScannerWFTypeName})
 		work.RegisterActivityWithOptions(TaskQueueScavengerActivity, activity.RegisterOptions{Name: taskQueueScavengerActivityName})
 		work.RegisterActivityWithOptions(HistoryScavengerActivity, activity.RegisterOptions{Name: historyScavengerActivityName})
 		work.RegisterActivityWithOptions(ExecutionsScavengerActivity, activity.RegisterOptions{Name: executionsScavengerActivityName})
+		work.RegisterActivityWithOptions(OrphanScavengerActivity, activity.RegisterOptions{Name: orphanScavengerActivityName})
 
 		// TODO: Nothing is gracefully stopping these workers or listening for fatal errors.
 		if err := work.Start(); err != nil {
@@ -17,6 +17,7 @@ import (
 	"go.temporal.io/server/common/namespace"
 	p "go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/sdk"
+	"go.temporal.io/server/common/shardmap"
 	"go.temporal.io/server/common/testing/mocksdk"
 	"go.temporal.io/server/service/worker/scanner/build_ids"
 	"go.uber.org/mock/gomock"
@@ -48,6 +49,10 @@ func (s *scannerTestSuite) TestScannerEnabled() {
 		WFTypeName:    historyScannerWFTypeName,
 		TaskQueueName: historyScannerTaskQueueName,
 	}
+	orphanScanner := expectedScanner{
+		WFTypeName:    orphanScannerWFTypeName,
+		TaskQueueName: orphanScannerTaskQueueName,
+	}
 	buildIdScavenger := expectedScanner{
 		WFTypeName:    build_ids.BuildIdScavangerWorkflowName,
 		TaskQueueName: build_ids.BuildIdScavengerTaskQueueName,
@@ -59,6 +64,7 @@ func (s *scannerTestSuite) TestScannerEnabled() {
 		TaskQueueScannerEnabled  bool
 		HistoryScannerEnabled    bool
 		BuildIdScavengerEnabled  bool
+		OrphanScannerEnabled     bool
 		DefaultStore             string
 		ExpectedScanners         []expectedScanner
 	}
@@ -154,14 +160,21 @@ func (s *scannerTestSuite) TestScannerEnabled() {
 			DefaultStore:             config.StoreTypeSQL,
 			ExpectedScanners:         []expectedScanner{buildIdScavenger},
 		},
+		{
+			Name:                 "OrphanScannerNoSQL",
+			OrphanScannerEnabled: true,
+			DefaultStore:         config.StoreTypeNoSQL,
+			ExpectedScanners:     []expectedScanner{orphanScanner},
+		},
 		{
 			Name:                     "AllScannersSQL",
 			ExecutionsScannerEnabled: true,
 			TaskQueueScannerEnabled:  true,
 			HistoryScannerEnabled:    true,
 			BuildIdScavengerEnabled:  true,
+			OrphanScannerEnabled:     true,
 			DefaultStore:             config.StoreTypeSQL,
-			ExpectedScanners:         []expectedScanner{historyScanner, taskQueueScanner, executionScanner, buildIdScavenger},
+			ExpectedScanners:         []expectedScanner{historyScanner, taskQueueScanner, executionScanner, orphanScanner, buildIdScavenger},
 		},
 	} {
 		s.Run(c.Name, func() {
@@ -181,6 +194,7 @@ func (s *scannerTestSuite) TestScannerEnabled() {
 					BuildIdScavengerEnabled:                dynamicconfig.GetBoolPropertyFn(c.BuildIdScavengerEnabled),
 					ExecutionsScannerEnabled:               dynamicconfig.GetBoolPropertyFn(c.ExecutionsScannerEnabled),
 					TaskQueueScannerEnabled:                dynamicconfig.GetBoolPropertyFn(c.TaskQueueScannerEnabled),
+					OrphanScannerEnabled:                   dynamicconfig.GetBoolPropertyFn(c.OrphanScannerEnabled),
 					Persistence: &config.Persistence{
 						DefaultStore: c.DefaultStore,
 						DataStores: map[string]config.DataStore{
@@ -204,6 +218,7 @@ func (s *scannerTestSuite) TestScannerEnabled() {
 				mockNamespaceRegistry,
 				"active-cluster",
 				membership.NewHostInfoFromAddress("localhost"),
+				shardmap.NewProvider(1),
 			)
 			var wg sync.WaitGroup
 			for _, sc := range c.ExpectedScanners {
@@ -260,6 +275,7 @@ func (s *scannerTestSuite) TestScannerShutdown() {
 			ExecutionsScannerEnabled:               dynamicconfig.GetBoolPropertyFn(false),
 			TaskQueueScannerEnabled:                dynamicconfig.GetBoolPropertyFn(false),
 			BuildIdScavengerEnabled:                dynamicconfig.GetBoolPropertyFn(false),
+			OrphanScannerEnabled:                   dynamicconfig.GetBoolPropertyFn(false),
 			Persistence: &config.Persistence{
 				DefaultStore: config.StoreTypeNoSQL,
 				DataStores: map[string]config.DataStore{
@@ -280,6 +296,7 @@ func (s *scannerTestSuite) TestScannerShutdown() {
 		mockNamespaceRegistry,
 		"active-cluster",
 		membership.NewHostInfoFromAddress("localhost"),
+		shardmap.NewProvider(1),
 	)
 	mockSdkClientFactory.EXPECT().GetSystemClient().Return(mockSdkClient).AnyTimes()
 	worker.EXPECT().RegisterActivityWithOptions(gomock.Any(), gomock.Any()).AnyTimes()
@@ -12,6 +12,7 @@ import (
 	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/service/worker/scanner/executions"
 	"go.temporal.io/server/service/worker/scanner/history"
+	"go.temporal.io/server/service/worker/scanner/orphans"
 	"go.temporal.io/server/service/worker/scanner/taskqueue"
 )
 
@@ -32,6 +33,11 @@ const (
 	executionsScannerWFTypeName     = "temporal-sys-executions-scanner-workflow"
 	executionsScannerTaskQueueName  = "temporal-sys-executions-scanner-taskqueue-0"
 	executionsScavengerActivityName = "temporal-sys-executions-scanner-scvg-activity"
+
+	orphanScannerWFID           = "temporal-sys-orphan-scanner"
+	orphanScannerWFTypeName     = "temporal-sys-orphan-scanner-workflow"
+	orphanScannerTaskQueueName  = "temporal-sys-orphan-scanner-taskqueue-0"
+	orphanScavengerActivityName = "temporal-sys-orphan-scanner-scvg-activity"
 )
 
 type (
@@ -73,6 +79,12 @@ var (
 		WorkflowIDReusePolicy: enumspb.WORKFLOW_ID_REUSE_POLICY_ALLOW_DUPLICATE,
 		CronSchedule:          "0 */12 * * *",
 	}
+	orphanScannerWFStartOptions = client.StartWorkflowOptions{
+		ID:                    orphanScannerWFID,
+		TaskQueue:             orphanScannerTaskQueueName,
+		WorkflowIDReusePolicy: enumspb.WORKFLOW_ID_REUSE_POLICY_ALLOW_DUPLICATE,
+		CronSchedule:          "0 0 * * 0",
+	}
 )
 
 // TaskQueueScannerWorkflow is the workflow that runs the task queue scanner background daemon
@@ -104,6 +116,14 @@ func ExecutionsScannerWorkflow(
 	return future.Get(ctx, nil)
 }
 
+// OrphanScannerWorkflow is the workflow that runs the orphan scanner background daemon
+func OrphanScannerWorkflow(
+	ctx workflow.Context,
+) error {
+	future := workflow.ExecuteActivity(workflow.WithActivityOptions(ctx, activityOptions), orphanScavengerActivityName)
+	return future.Get(ctx, nil)
+}
+
 // HistoryScavengerActivity is the activity that runs history scavenger
 func HistoryScavengerActivity(
 	activityCtx context.Context,
@@ -111,8 +131,6 @@ func HistoryScavengerActivity(
 
 	ctx := activityCtx.Value(scannerContextKey).(scannerContext)
 	rps := ctx.cfg.PersistenceMaxQPS()
-	numShards := ctx.cfg.Persistence.NumHistoryShards
-
 	hbd := history.ScavengerHeartbeatDetails{}
 	if activity.HasHeartbeatDetails(activityCtx) {
 		if err := activity.GetHeartbeatDetails(activityCtx, &hbd); err != nil {
@@ -121,7 +139,7 @@ func HistoryScavengerActivity(
 	}
 
 	scavenger := history.NewScavenger(
-		numShards,
+		ctx.shardMapProvider.ShardMap(),
 		ctx.executionManager,
 		rps,
 		ctx.historyClient,
@@ -131,6 +149,7 @@ func HistoryScavengerActivity(
 		ctx.cfg.HistoryScannerDataMinAge,
 		ctx.cfg.ExecutionDataDurationBuffer,
 		ctx.cfg.HistoryScannerVerifyRetention,
+		ctx.cfg.HistoryScannerOrphanDeletionEnabled,
 		ctx.metricsHandler,
 		ctx.logger,
 	)
@@ -166,7 +185,7 @@ func ExecutionsScavengerActivity(
 	metricsHandler := ctx.metricsHandler
 	scavenger := executions.NewScavenger(
 		activityCtx,
-		ctx.cfg.Persistence.NumHistoryShards,
+		ctx.shardMapProvider.TotalShardCount(),
 		ctx.cfg.ExecutionScannerPerHostQPS,
 		ctx.cfg.ExecutionScannerPerShardQPS,
 		ctx.cfg.ExecutionDataDurationBuffer,
@@ -191,3 +210,35 @@ func ExecutionsScavengerActivity(
 	}
 	return nil
 }
+
+// OrphanScavengerActivity is the activity that runs orphan scavenger
+func OrphanScavengerActivity(
+	activityCtx context.Context,
+) (orphans.Summary, error) {
+	ctx := activityCtx.Value(scannerContextKey).(scannerContext)
+
+	hbd := orphans.ScavengerHeartbeatDetails{}
+	if activity.HasHeartbeatDetails(activityCtx) {
+		if err := activity.GetHeartbeatDetails(activityCtx, &hbd); err != nil {
+			ctx.logger.Error("Failed to recover from last heartbeat, start over from beginning", tag.Error(err))
+		}
+	}
+
+	shardMap := ctx.shardMapProvider.ShardMap()
+	if shardMap.IsSplitting() {
+		// Executions which are being moved to their child shards would be reported as orphaned.
+		ctx.logger.Info("Skipping orphan scavenger while history shards are being split")
+		return hbd.Summary, nil
+	}
+	scavenger := orphans.NewScavenger(
+		shardMap,
+		ctx.executionManager,
+		ctx.historyClient,
+		ctx.cfg.PersistenceMaxQPS,
+		ctx.cfg.OrphanScannerRepairEnabled,
+		hbd,
+		ctx.metricsHandler,
+		ctx.logger,
+	)
+	return scavenger.Run(activityCtx)
+}
@@ -22,6 +22,7 @@ import (
 	"go.temporal.io/server/common/primitives"
 	"go.temporal.io/server/common/resource"
 	"go.temporal.io/server/common/sdk"
+	"go.temporal.io/server/common/shardmap"
 	"go.temporal.io/server/service/worker/parentclosepolicy"
 	"go.temporal.io/server/service/worker/replicator"
 	"go.temporal.io/server/service/worker/scanner"
@@ -39,6 +40,7 @@ type (
 		metadataManager        persistence.MetadataManager
 		membershipMonitor      membership.Monitor
 		hostInfo               membership.HostInfo
+		shardMapProvider       *shardmap.Provider
 		executionManager       persistence.ExecutionManager
 		taskManager            persistence.TaskManager
 		historyClient          resource.HistoryClient
@@ -87,6 +89,7 @@ type (
 		VisibilityPersistenceSlowQueryThreshold dynamicconfig.DurationPropertyFn
 		EnableReadFromSecondaryVisibility       dynamicconfig.BoolPropertyFnWithNamespaceFilter
 		VisibilityEnableShadowReadMode          dynamicconfig.BoolPropertyFn
+		VisibilityShadowReadVerificationRate    dynamicconfig.FloatPropertyFnWithNamespaceFilter
 		VisibilityDisableOrderByClause          dynamicconfig.BoolPropertyFnWithNamespaceFilter
 		VisibilityEnableManualPagination        dynamicconfig.BoolPropertyFnWithNamespaceFilter
 	}
@@ -114,6 +117,7 @@ func NewService(
 	visibilityManager manager.VisibilityManager,
 	matchingClient resource.MatchingClient,
 	namespaceReplicationTaskExecutor nsreplication.TaskExecutor,
+	shardMapProvider *shardmap.Provider,
 ) (*Service, error) {
 	workerServiceResolver, err := membershipMonitor.GetResolver(primitives.WorkerService)
 	if err != nil {
@@ -133,6 +137,7 @@ func NewService(
 		workerServiceResolver:     workerServiceResolver,
 		membershipMonitor:         membershipMonitor,
 		hostInfo:                  hostInfoProvider.HostInfo(),
+		shardMapProvider:          shardMapProvider,
 		namespaceReplicationQueue: namespaceReplicationQueue,
 		metricsHandler:            metricsHandler,
 		metadataManager:           metadataManager,
@@ -176,8 +181,11 @@ func NewConfig(
 			BuildIdScavengerEnabled:                 dynamicconfig.BuildIdScavengerEnabled.Get(dc),
 			HistoryScannerEnabled:                   dynamicconfig.HistoryScannerEnabled.Get(dc),
 			ExecutionsScannerEnabled:                dynamicconfig.ExecutionsScannerEnabled.Get(dc),
+			OrphanScannerEnabled:                    dynamicconfig.OrphanScannerEnabled.Get(dc),
+			OrphanScannerRepairEnabled:              dynamicconfig.OrphanScannerRepairEnabled.Get(dc),
 			HistoryScannerDataMinAge:                dynamicconfig.HistoryScannerDataMinAge.Get(dc),
 			HistoryScannerVerifyRetention:           dynamicconfig.HistoryScannerVerifyRetention.Get(dc),
+			HistoryScannerOrphanDeletionEnabled:     dynamicconfig.HistoryScannerOrphanDeletionEnabled.Get(dc),
 			ExecutionScannerPerHostQPS:              dynamicconfig.ExecutionScannerPerHostQPS.Get(dc),
 			ExecutionScannerPerShardQPS:             dynamicconfig.ExecutionScannerPerShardQPS.Get(dc),
 			ExecutionDataDurationBuffer:             dynamicconfig.ExecutionDataDurationBuffer.Get(dc),
@@ -207,6 +215,7 @@ func NewConfig(
 		VisibilityPersistenceSlowQueryThreshold: dynamicconfig.VisibilityPersistenceSlowQueryThreshold.Get(dc),
 		EnableReadFromSecondaryVisibility:       dynamicconfig.EnableReadFromSecondaryVisibility.Get(dc),
 		VisibilityEnableShadowReadMode:          dynamicconfig.VisibilityEnableShadowReadMode.Get(dc),
+		VisibilityShadowReadVerificationRate:    dynamicconfig.VisibilityShadowReadVerificationRate.Get(dc),
 		VisibilityDisableOrderByClause:          dynamicconfig.VisibilityDisableOrderByClause.Get(dc),
 		VisibilityEnableManualPagination:        dynamicconfig.VisibilityEnableManualPagination.Get(dc),
 	}
@@ -307,6 +316,7 @@ func (s *Service) initScanner() error {
 		s.namespaceRegistry,
 		currentCluster,
 		s.hostInfo,
+		s.shardMapProvider,
 	)
 	return nil
 }
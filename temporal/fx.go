@@ -43,6 +43,7 @@ import (
 	"go.temporal.io/server/common/resource"
 	"go.temporal.io/server/common/rpc/encryption"
 	"go.temporal.io/server/common/searchattribute"
+	"go.temporal.io/server/common/shardmap"
 	"go.temporal.io/server/common/telemetry"
 	"go.temporal.io/server/service/frontend"
 	"go.temporal.io/server/service/history"
@@ -585,6 +586,7 @@ func ApplyClusterMetadataConfigProvider(
 		persistenceServiceResolver,
 		&svc.Persistence,
 		customDataStoreFactory,
+		dynamicconfig.NewNoopCollection(),
 		logger,
 		metricsHandler,
 		telemetry.NoopTracerProvider,
@@ -746,8 +748,17 @@ func updateCurrentClusterMetadataRecord(
 		currentClusterDBRecord.HttpAddress = currentCLusterInfo.HTTPAddress
 		updateDBRecord = true
 	}
-	if !maps.Equal(currentClusterDBRecord.Tags, svc.ClusterMetadata.Tags) {
-		currentClusterDBRecord.Tags = svc.ClusterMetadata.Tags
+	tags := svc.ClusterMetadata.Tags
+	if shardMap, ok := currentClusterDBRecord.Tags[shardmap.ClusterMetadataTagKey]; ok {
+		// The shard map is not part of the static config, it's updated by shard splits.
+		tags = maps.Clone(tags)
+		if tags == nil {
+			tags = make(map[string]string, 1)
+		}
+		tags[shardmap.ClusterMetadataTagKey] = shardMap
+	}
+	if !maps.Equal(currentClusterDBRecord.Tags, tags) {
+		currentClusterDBRecord.Tags = tags
 		updateDBRecord = true
 	}
 
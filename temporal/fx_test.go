@@ -11,6 +11,7 @@ import (
 	"go.temporal.io/server/common/config"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/shardmap"
 	"go.temporal.io/server/service/history/tasks"
 	"go.temporal.io/server/tests/testutils"
 	"go.uber.org/mock/gomock"
@@ -80,6 +81,40 @@ func TestUpdateCurrentClusterMetadataRecord(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestUpdateCurrentClusterMetadataRecord_KeepShardMap(t *testing.T) {
+	configDir := path.Join(testutils.GetRepoRootDirectory(), "config")
+	cfg, err := config.LoadConfig("development-cluster-a", configDir, "")
+	require.NoError(t, err)
+	controller := gomock.NewController(t)
+
+	mockClusterMetadataManager := persistence.NewMockClusterMetadataManager(controller)
+	mockClusterMetadataManager.EXPECT().SaveClusterMetadata(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *persistence.SaveClusterMetadataRequest) (bool, error) {
+			require.Equal(t, `{"version":1,"shardCount":4,"splitFactor":2}`, request.Tags[shardmap.ClusterMetadataTagKey])
+			for key, value := range cfg.ClusterMetadata.Tags {
+				require.Equal(t, value, request.Tags[key])
+			}
+			return true, nil
+		},
+	)
+	updateRecord := &persistence.GetClusterMetadataResponse{
+		ClusterMetadata: &persistencespb.ClusterMetadata{
+			Tags: map[string]string{
+				shardmap.ClusterMetadataTagKey: `{"version":1,"shardCount":4,"splitFactor":2}`,
+			},
+		},
+		Version: 1,
+	}
+	err = updateCurrentClusterMetadataRecord(
+		context.TODO(),
+		mockClusterMetadataManager,
+		cfg,
+		nil,
+		updateRecord,
+	)
+	require.NoError(t, err)
+}
+
 func TestOverwriteCurrentClusterMetadataWithDBRecord(t *testing.T) {
 	configDir := path.Join(testutils.GetRepoRootDirectory(), "config")
 	cfg, err := config.LoadConfig("development-cass-es", configDir, "")
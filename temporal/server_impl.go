@@ -10,6 +10,7 @@ import (
 	"go.opentelemetry.io/otel/trace"
 	"go.temporal.io/server/common/cluster"
 	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/headers"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
@@ -156,6 +157,7 @@ func initSystemNamespaces(
 		persistenceServiceResolver,
 		cfg,
 		customDataStoreFactory,
+		dynamicconfig.NewNoopCollection(),
 		logger,
 		metricsHandler,
 		telemetry.NoopTracerProvider,
@@ -41,6 +41,7 @@ var (
 		dynamicconfig.HistoryScannerEnabled.Key():                               false,
 		dynamicconfig.TaskQueueScannerEnabled.Key():                             false,
 		dynamicconfig.ExecutionsScannerEnabled.Key():                            false,
+		dynamicconfig.OrphanScannerEnabled.Key():                                false,
 		dynamicconfig.BuildIdScavengerEnabled.Key():                             false,
 
 		// Better to read through in tests than add artificial sleeps (which is what we previously had).
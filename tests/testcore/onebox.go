@@ -46,6 +46,7 @@ import (
 	"go.temporal.io/server/common/rpc/encryption"
 	"go.temporal.io/server/common/sdk"
 	"go.temporal.io/server/common/searchattribute"
+	"go.temporal.io/server/common/shardmap"
 	"go.temporal.io/server/common/telemetry"
 	"go.temporal.io/server/common/testing/testhooks"
 	"go.temporal.io/server/service/frontend"
@@ -716,7 +717,7 @@ func (p *clientFactoryProvider) NewFactory(
 	metricsHandler metrics.Handler,
 	dc *dynamicconfig.Collection,
 	testHooks testhooks.TestHooks,
-	numberOfHistoryShards int32,
+	shardMapProvider *shardmap.Provider,
 	logger log.Logger,
 	throttledLogger log.Logger,
 ) client.Factory {
@@ -726,7 +727,7 @@ func (p *clientFactoryProvider) NewFactory(
 		metricsHandler,
 		dc,
 		testHooks,
-		numberOfHistoryShards,
+		shardMapProvider,
 		logger,
 		throttledLogger,
 	)
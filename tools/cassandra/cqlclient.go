@@ -53,6 +53,9 @@ const (
 	readSchemaVersionCQL        = `SELECT curr_version from schema_version where keyspace_name=?`
 	listTablesCQL               = `SELECT table_name from system_schema.tables where keyspace_name=?`
 	listTypesCQL                = `SELECT type_name from system_schema.types where keyspace_name=?`
+	listColumnsCQL              = `SELECT table_name, column_name, type, kind, position, clustering_order from system_schema.columns where keyspace_name=?`
+	listIndexesCQL              = `SELECT table_name, index_name, kind, options from system_schema.indexes where keyspace_name=?`
+	listTypeFieldsCQL           = `SELECT type_name, field_names, field_types from system_schema.types where keyspace_name=?`
 	writeSchemaVersionCQL       = `INSERT into schema_version(keyspace_name, creation_time, curr_version, min_compatible_version) VALUES (?,?,?,?)`
 	writeSchemaUpdateHistoryCQL = `INSERT into schema_update_history(year, month, update_time, old_version, new_version, manifest_md5, description) VALUES(?,?,?,?,?,?,?)`
 
@@ -78,7 +81,7 @@ const (
 		`WITH replication = { 'class' : 'NetworkTopologyStrategy', '%v' : %v};`
 )
 
-var _ schema.DB = (*cqlClient)(nil)
+var _ schema.InspectableDB = (*cqlClient)(nil)
 
 // newCQLClient returns a new instance of CQLClient
 func newCQLClient(cfg *CQLClientConfig, logger log.Logger) (*cqlClient, error) {
@@ -244,6 +247,57 @@ func (client *cqlClient) listTypes() ([]string, error) {
 	return names, nil
 }
 
+// Dialect returns the Cassandra dialect
+func (client *cqlClient) Dialect() *schema.Dialect {
+	return schema.CassandraDialect
+}
+
+// InspectSchema returns the columns and indexes of the tables and the
+// fields of the User defined types in a Keyspace
+func (client *cqlClient) InspectSchema() (*schema.Schema, error) {
+	s := schema.NewSchema()
+
+	iter := client.session.Query(listColumnsCQL, client.keyspace).Iter()
+	var table, column, columnType, kind, clusteringOrder string
+	var position int
+	for iter.Scan(&table, &column, &columnType, &kind, &position, &clusteringOrder) {
+		definition := columnType + " " + kind
+		switch kind {
+		case "partition_key":
+			definition = fmt.Sprintf("%v %v", definition, position)
+		case "clustering":
+			definition = fmt.Sprintf("%v %v %v", definition, position, clusteringOrder)
+		}
+		s.AddColumn(table, column, definition)
+	}
+	if err := iter.Close(); err != nil {
+		return nil, err
+	}
+
+	iter = client.session.Query(listIndexesCQL, client.keyspace).Iter()
+	var index string
+	var options map[string]string
+	for iter.Scan(&table, &index, &kind, &options) {
+		s.AddIndex(table, index, kind+" "+options["target"])
+	}
+	if err := iter.Close(); err != nil {
+		return nil, err
+	}
+
+	iter = client.session.Query(listTypeFieldsCQL, client.keyspace).Iter()
+	var typeName string
+	var fieldNames, fieldTypes []string
+	for iter.Scan(&typeName, &fieldNames, &fieldTypes) {
+		for i, field := range fieldNames {
+			s.AddField(typeName, field, fieldTypes[i])
+		}
+	}
+	if err := iter.Close(); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
 // dropTable drops a given table from the Keyspace
 func (client *cqlClient) dropTable(name string) error {
 	return client.Exec(fmt.Sprintf("DROP TABLE %v", name))
@@ -64,6 +64,27 @@ func updateSchema(cli *cli.Context, logger log.Logger) error {
 	return nil
 }
 
+// verifySchema compares the schema of the keyspace with the versioned schema
+// of its current version
+func verifySchema(cli *cli.Context, logger log.Logger) error {
+	config, err := newCQLClientConfig(cli)
+	if err != nil {
+		logger.Error("Unable to read config.", tag.Error(schema.NewConfigError(err.Error())))
+		return err
+	}
+	client, err := newCQLClient(config, logger)
+	if err != nil {
+		logger.Error("Unable to establish CQL session.", tag.Error(err))
+		return err
+	}
+	defer client.Close()
+	if err := schema.Verify(cli, client, config.Keyspace, logger); err != nil {
+		logger.Error("Unable to verify CQL schema.", tag.Error(err))
+		return err
+	}
+	return nil
+}
+
 func createKeyspace(cli *cli.Context, logger log.Logger) error {
 	config, err := newCQLClientConfig(cli)
 	if err != nil {
@@ -194,6 +194,25 @@ func buildCLIOptions() *cli.App {
 				cliHandler(c, updateSchema, logger)
 			},
 		},
+		{
+			Name:    "verify-schema",
+			Aliases: []string{"verify"},
+			Usage:   "compare the schema of the keyspace with the versioned schema of its current version and print the differences as JSON",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  schema.CLIFlagSchemaDir,
+					Usage: "path to directory containing versioned schema",
+				},
+				cli.StringFlag{
+					Name: schema.CLIFlagSchemaName,
+					Usage: fmt.Sprintf("name of embedded versioned schema, one of: %v",
+						dbschemas.PathsByDB("cassandra")),
+				},
+			},
+			Action: func(c *cli.Context) {
+				cliHandler(c, verifySchema, logger)
+			},
+		},
 		{
 			Name:    "create-keyspace",
 			Aliases: []string{"create", "create-Keyspace"},
@@ -0,0 +1,764 @@
+package schema
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+type (
+	// Dialect describes how a database reports the tables, columns and indexes that DDL statements create, so that
+	// the schema parsed from the versioned schema files can be compared with the schema read from the database
+	Dialect struct {
+		// Name of the database, used in errors
+		Name string
+		// CQL is set for Cassandra, whose columns describe the primary key instead of an index
+		CQL bool
+		// PrimaryKeyNotNull is set if the columns of the primary key are NOT NULL even if they aren't declared so
+		PrimaryKeyNotNull bool
+		// LowerCaseIdentifiers is set if the database stores identifiers that aren't quoted in lower case
+		LowerCaseIdentifiers bool
+		// ColumnType returns the type that the database reports for the type of a column definition
+		ColumnType func(ddlType string) string
+		// ConstraintIndex returns the name of the index that the database creates for the n-th primary key or
+		// unique constraint of a table, counting from 1. name is the name of the constraint, if it has one.
+		ConstraintIndex func(table string, name string, columns []string, primaryKey bool, n int) string
+	}
+
+	// ddlParser parses the tokens of a single DDL statement
+	ddlParser struct {
+		dialect *Dialect
+		schema  *Schema
+		tokens  []string
+		pos     int
+		// constraints counts the primary key and unique constraints of the tables
+		constraints map[string]int
+	}
+
+	// columnDef is a column definition of a CREATE TABLE or ALTER TABLE statement
+	columnDef struct {
+		name       string
+		ddlType    string
+		notNull    bool
+		primaryKey bool
+		unique     bool
+		static     bool
+	}
+)
+
+var (
+	// MySQLDialect is the dialect of MySQL 8
+	MySQLDialect = &Dialect{
+		Name:              "mysql",
+		PrimaryKeyNotNull: true,
+		ColumnType:        mysqlColumnType,
+		ConstraintIndex: func(table string, name string, columns []string, primaryKey bool, n int) string {
+			switch {
+			case primaryKey:
+				return "PRIMARY"
+			case name != "" || len(columns) == 0:
+				return name
+			}
+			return columns[0]
+		},
+	}
+
+	// PostgreSQLDialect is the dialect of PostgreSQL 12 and later
+	PostgreSQLDialect = &Dialect{
+		Name:                 "postgresql",
+		PrimaryKeyNotNull:    true,
+		LowerCaseIdentifiers: true,
+		ColumnType:           postgresqlColumnType,
+		ConstraintIndex: func(table string, name string, columns []string, primaryKey bool, n int) string {
+			switch {
+			case name != "":
+				return name
+			case primaryKey:
+				return table + "_pkey"
+			}
+			return table + "_" + strings.Join(columns, "_") + "_key"
+		},
+	}
+
+	// SQLiteDialect is the dialect of SQLite 3, which reports column types as they are declared
+	SQLiteDialect = &Dialect{
+		Name:       "sqlite",
+		ColumnType: func(ddlType string) string { return ddlType },
+		ConstraintIndex: func(table string, name string, columns []string, primaryKey bool, n int) string {
+			return fmt.Sprintf("sqlite_autoindex_%v_%d", table, n)
+		},
+	}
+
+	// CassandraDialect is the dialect of Cassandra 3 and later
+	CassandraDialect = &Dialect{
+		Name:                 "cassandra",
+		CQL:                  true,
+		LowerCaseIdentifiers: true,
+		ColumnType:           cassandraColumnType,
+	}
+
+	ddlTokenRegex       = regexp.MustCompile("`[^`]*`|\"[^\"]*\"|'[^']*'|[A-Za-z0-9_$.]+|\\S")
+	ddlWordRegex        = regexp.MustCompile(`^[A-Za-z0-9_$.]+$`)
+	mysqlIntWidthRegex  = regexp.MustCompile(`^(bigint|int|mediumint|smallint)\(\d+\)`)
+	cassandraTextRegex  = regexp.MustCompile(`\bvarchar\b`)
+	postgresqlTypeNames = map[string]string{
+		"int":         "integer",
+		"int4":        "integer",
+		"serial":      "integer",
+		"int8":        "bigint",
+		"bigserial":   "bigint",
+		"int2":        "smallint",
+		"smallserial": "smallint",
+		"bool":        "boolean",
+		"varchar":     "character varying",
+		"char":        "character",
+		"timestamp":   "timestamp without time zone",
+		"timestamptz": "timestamp with time zone",
+		"float8":      "double precision",
+		"float4":      "real",
+	}
+)
+
+// ParseSchema returns the schema that the DDL statements create when they are executed in order. CREATE, ALTER and
+// DROP statements of tables, indexes and types change the schema, other statements are ignored.
+func ParseSchema(dialect *Dialect, stmts []string) (*Schema, error) {
+	s := NewSchema()
+	constraints := make(map[string]int)
+	for _, stmt := range stmts {
+		p := &ddlParser{
+			dialect:     dialect,
+			schema:      s,
+			tokens:      ddlTokenRegex.FindAllString(strings.TrimSuffix(strings.TrimSpace(stmt), ";"), -1),
+			constraints: constraints,
+		}
+		if err := p.parse(); err != nil {
+			return nil, fmt.Errorf("error parsing %v statement %q: %w", dialect.Name, rmspaceRegex.ReplaceAllString(stmt, " "), err)
+		}
+	}
+	return s, nil
+}
+
+func (p *ddlParser) parse() error {
+	switch {
+	case p.accept("CREATE", "TABLE"):
+		return p.parseCreateTable()
+	case p.accept("CREATE", "TYPE"):
+		return p.parseCreateType()
+	case p.accept("CREATE", "INDEX"):
+		return p.parseCreateIndex(false, "")
+	case p.accept("CREATE", "UNIQUE", "INDEX"):
+		return p.parseCreateIndex(true, "")
+	case p.accept("CREATE", "CUSTOM", "INDEX"):
+		return p.parseCreateIndex(false, "CUSTOM")
+	case p.accept("ALTER", "TABLE"):
+		return p.parseAlterTable()
+	case p.accept("ALTER", "TYPE"):
+		return p.parseAlterType()
+	case p.accept("DROP", "TABLE"):
+		p.accept("IF", "EXISTS")
+		delete(p.schema.Tables, p.name())
+	case p.accept("DROP", "TYPE"):
+		p.accept("IF", "EXISTS")
+		delete(p.schema.Types, p.name())
+	case p.accept("DROP", "INDEX"):
+		p.accept("IF", "EXISTS")
+		index := p.name()
+		for _, table := range p.schema.Tables {
+			delete(table.Indexes, index)
+		}
+	}
+	return nil
+}
+
+func (p *ddlParser) parseCreateTable() error {
+	p.accept("IF", "NOT", "EXISTS")
+	name := p.name()
+	items, err := p.list()
+	if err != nil {
+		return err
+	}
+	table := getOrCreateTable(p.schema.Tables, name)
+	if p.dialect.CQL {
+		return p.createCQLTable(name, table, items)
+	}
+
+	var primaryKey []string
+	for _, item := range items {
+		switch {
+		case matchKeywords(item, "PRIMARY", "KEY"):
+			primaryKey = p.indexColumns(item[2:])
+			p.addConstraintIndex(name, "", primaryKey, true)
+		case matchKeywords(item, "CONSTRAINT"):
+			if err := p.addConstraint(name, p.identifier(item[1]), item[2:]); err != nil {
+				return err
+			}
+			if matchKeywords(item[2:], "PRIMARY", "KEY") {
+				primaryKey = p.indexColumns(item[4:])
+			}
+		case matchKeywords(item, "UNIQUE"):
+			if err := p.addConstraint(name, "", item); err != nil {
+				return err
+			}
+		case matchKeywords(item, "INDEX"), matchKeywords(item, "KEY"):
+			p.addIndex(name, item[1:], false)
+		case matchKeywords(item, "CHECK"), matchKeywords(item, "FOREIGN"):
+		default:
+			col := p.parseColumnDef(item)
+			p.addColumn(name, col)
+			if col.primaryKey {
+				primaryKey = []string{col.name}
+				p.addConstraintIndex(name, "", primaryKey, true)
+			}
+			if col.unique {
+				p.addConstraintIndex(name, "", []string{col.name}, false)
+			}
+		}
+	}
+	if p.dialect.PrimaryKeyNotNull {
+		for _, column := range primaryKey {
+			if definition, ok := table.Columns[column]; ok {
+				table.Columns[column] = columnType(definition) + " NOT NULL"
+			}
+		}
+	}
+	return nil
+}
+
+// addConstraint adds the index of a table level PRIMARY KEY or UNIQUE constraint, other constraints don't create an
+// index
+func (p *ddlParser) addConstraint(table string, name string, item []string) error {
+	switch {
+	case matchKeywords(item, "PRIMARY", "KEY"):
+		p.addConstraintIndex(table, name, p.indexColumns(item[2:]), true)
+	case matchKeywords(item, "UNIQUE"):
+		rest := item[1:]
+		if matchKeywords(rest, "KEY") || matchKeywords(rest, "INDEX") {
+			rest = rest[1:]
+		}
+		if len(rest) > 0 && rest[0] != "(" {
+			name, rest = p.identifier(rest[0]), rest[1:]
+		}
+		p.addConstraintIndex(table, name, p.indexColumns(rest), false)
+	case matchKeywords(item, "CHECK"), matchKeywords(item, "FOREIGN"):
+	default:
+		return fmt.Errorf("unsupported constraint %v", strings.Join(item, " "))
+	}
+	return nil
+}
+
+func (p *ddlParser) addConstraintIndex(table string, name string, columns []string, primaryKey bool) {
+	p.constraints[table]++
+	name = p.dialect.ConstraintIndex(table, name, columns, primaryKey, p.constraints[table])
+	p.schema.AddIndex(table, p.uniqueIndexName(table, name), indexDefinition(columns, true))
+}
+
+// addIndex adds an INDEX or KEY item of a MySQL table definition, which is named after its first column if it has
+// no name
+func (p *ddlParser) addIndex(table string, item []string, unique bool) {
+	name := ""
+	if len(item) > 0 && item[0] != "(" {
+		name, item = p.identifier(item[0]), item[1:]
+	}
+	columns := p.indexColumns(item)
+	if name == "" && len(columns) > 0 {
+		name = p.uniqueIndexName(table, columns[0])
+	}
+	p.schema.AddIndex(table, name, indexDefinition(columns, unique))
+}
+
+// uniqueIndexName appends _2, _3, ... to the name of an index until it is unique in the table, like MySQL does for
+// the indexes it names
+func (p *ddlParser) uniqueIndexName(table string, name string) string {
+	indexes := getOrCreateTable(p.schema.Tables, table).Indexes
+	if _, ok := indexes[name]; !ok {
+		return name
+	}
+	for i := 2; ; i++ {
+		candidate := fmt.Sprintf("%v_%d", name, i)
+		if _, ok := indexes[candidate]; !ok {
+			return candidate
+		}
+	}
+}
+
+func (p *ddlParser) addColumn(table string, col columnDef) {
+	if p.dialect.CQL {
+		kind := "regular"
+		if col.static {
+			kind = "static"
+		}
+		p.schema.AddColumn(table, col.name, p.dialect.ColumnType(col.ddlType)+" "+kind)
+		return
+	}
+	nullability := " NULL"
+	if col.notNull {
+		nullability = " NOT NULL"
+	}
+	p.schema.AddColumn(table, col.name, p.dialect.ColumnType(col.ddlType)+nullability)
+}
+
+func (p *ddlParser) createCQLTable(name string, table *Table, items [][]string) error {
+	var partitionKey, clustering []string
+	for _, item := range items {
+		if matchKeywords(item, "PRIMARY", "KEY") {
+			key := p.splitList(item[3 : len(item)-1])
+			if len(key) == 0 {
+				return fmt.Errorf("empty primary key")
+			}
+			if key[0][0] == "(" {
+				partitionKey = p.indexColumns(key[0])
+			} else {
+				partitionKey = []string{p.identifier(key[0][0])}
+			}
+			for _, column := range key[1:] {
+				clustering = append(clustering, p.identifier(column[0]))
+			}
+			continue
+		}
+		col := p.parseColumnDef(item)
+		p.addColumn(name, col)
+		if col.primaryKey {
+			partitionKey = []string{col.name}
+		}
+	}
+
+	order := make(map[string]string)
+	for ; p.pos < len(p.tokens); p.pos++ {
+		if p.accept("CLUSTERING", "ORDER", "BY") {
+			items, err := p.list()
+			if err != nil {
+				return err
+			}
+			for _, item := range items {
+				order[p.identifier(item[0])] = strings.ToLower(item[len(item)-1])
+			}
+			break
+		}
+	}
+
+	for i, column := range partitionKey {
+		table.Columns[column] = fmt.Sprintf("%v partition_key %d", columnType(table.Columns[column]), i)
+	}
+	for i, column := range clustering {
+		columnOrder := "asc"
+		if o, ok := order[column]; ok {
+			columnOrder = o
+		}
+		table.Columns[column] = fmt.Sprintf("%v clustering %d %v", columnType(table.Columns[column]), i, columnOrder)
+	}
+	return nil
+}
+
+func (p *ddlParser) parseCreateType() error {
+	p.accept("IF", "NOT", "EXISTS")
+	name := p.name()
+	items, err := p.list()
+	if err != nil {
+		return err
+	}
+	for _, item := range items {
+		p.schema.AddField(name, p.identifier(item[0]), p.dialect.ColumnType(renderType(item[1:])))
+	}
+	return nil
+}
+
+func (p *ddlParser) parseAlterType() error {
+	name := p.name()
+	if !p.accept("ADD") {
+		return fmt.Errorf("unsupported ALTER TYPE")
+	}
+	field := p.identifier(p.next())
+	p.schema.AddField(name, field, p.dialect.ColumnType(renderType(p.tokens[p.pos:])))
+	return nil
+}
+
+func (p *ddlParser) parseCreateIndex(unique bool, kind string) error {
+	p.accept("IF", "NOT", "EXISTS")
+	name := ""
+	if !p.accept("ON") {
+		name = p.name()
+		if !p.accept("ON") {
+			return fmt.Errorf("expected ON")
+		}
+	}
+	table := p.name()
+	if p.accept("USING") {
+		p.next()
+	}
+	items, err := p.list()
+	if err != nil {
+		return err
+	}
+	if !p.dialect.CQL {
+		columns := p.itemColumns(items)
+		if name == "" && len(columns) > 0 {
+			name = p.uniqueIndexName(table, columns[0])
+		}
+		p.schema.AddIndex(table, name, indexDefinition(columns, unique))
+		return nil
+	}
+
+	if len(items) != 1 {
+		return fmt.Errorf("expected a single index target")
+	}
+	target := renderType(items[0])
+	if len(items[0]) == 1 {
+		target = p.identifier(target)
+		definition := getOrCreateTable(p.schema.Tables, table).Columns[target]
+		if strings.HasPrefix(definition, "map<") || strings.HasPrefix(definition, "set<") || strings.HasPrefix(definition, "list<") {
+			target = "values(" + target + ")"
+		}
+	}
+	if name == "" {
+		name = table + "_" + strings.Trim(target, "()") + "_idx"
+	}
+	if kind == "" {
+		kind = "COMPOSITES"
+	}
+	p.schema.AddIndex(table, name, kind+" "+target)
+	return nil
+}
+
+func (p *ddlParser) parseAlterTable() error {
+	name := p.name()
+	table := getOrCreateTable(p.schema.Tables, name)
+	for _, action := range p.splitList(p.tokens[p.pos:]) {
+		switch {
+		case matchKeywords(action, "ADD", "INDEX"), matchKeywords(action, "ADD", "KEY"):
+			p.addIndex(name, action[2:], false)
+		case matchKeywords(action, "ADD", "UNIQUE"), matchKeywords(action, "ADD", "PRIMARY"):
+			if err := p.addConstraint(name, "", action[1:]); err != nil {
+				return err
+			}
+		case matchKeywords(action, "ADD", "CONSTRAINT"):
+			if err := p.addConstraint(name, p.identifier(action[2]), action[3:]); err != nil {
+				return err
+			}
+		case matchKeywords(action, "ADD"):
+			columns := action[1:]
+			if matchKeywords(columns, "COLUMN") {
+				columns = columns[1:]
+			}
+			if matchKeywords(columns, "IF", "NOT", "EXISTS") {
+				columns = columns[3:]
+			}
+			if columns[0] == "(" {
+				for _, item := range p.splitList(columns[1 : len(columns)-1]) {
+					p.addColumn(name, p.parseColumnDef(item))
+				}
+			} else {
+				p.addColumn(name, p.parseColumnDef(columns))
+			}
+		case matchKeywords(action, "DROP", "INDEX"), matchKeywords(action, "DROP", "KEY"):
+			delete(table.Indexes, p.identifier(action[2]))
+		case matchKeywords(action, "DROP", "PRIMARY", "KEY"):
+			delete(table.Indexes, p.dialect.ConstraintIndex(name, "", nil, true, 1))
+		case matchKeywords(action, "DROP", "CONSTRAINT"):
+			delete(table.Indexes, p.identifier(action[2]))
+		case matchKeywords(action, "DROP"):
+			column := action[1:]
+			if matchKeywords(column, "COLUMN") {
+				column = column[1:]
+			}
+			if matchKeywords(column, "IF", "EXISTS") {
+				column = column[2:]
+			}
+			delete(table.Columns, p.identifier(column[0]))
+		case matchKeywords(action, "MODIFY"):
+			column := action[1:]
+			if matchKeywords(column, "COLUMN") {
+				column = column[1:]
+			}
+			p.addColumn(name, p.parseColumnDef(column))
+		case matchKeywords(action, "ALTER"):
+			if err := p.alterColumn(name, table, action[1:]); err != nil {
+				return err
+			}
+		case matchKeywords(action, "WITH"):
+		default:
+			return fmt.Errorf("unsupported ALTER TABLE action %v", strings.Join(action, " "))
+		}
+	}
+	return nil
+}
+
+// alterColumn applies an ALTER COLUMN action, which changes the type or the nullability of a column
+func (p *ddlParser) alterColumn(tableName string, table *Table, action []string) error {
+	if matchKeywords(action, "COLUMN") {
+		action = action[1:]
+	}
+	column := p.identifier(action[0])
+	definition, ok := table.Columns[column]
+	if !ok {
+		return fmt.Errorf("unknown column %v", column)
+	}
+	action = action[1:]
+	switch {
+	case matchKeywords(action, "TYPE"), matchKeywords(action, "SET", "DATA", "TYPE"):
+		if !matchKeywords(action, "TYPE") {
+			action = action[2:]
+		}
+		col := p.parseColumnDef(append([]string{column}, action[1:]...))
+		if p.dialect.CQL {
+			p.schema.AddColumn(tableName, column, strings.Replace(definition, columnType(definition), p.dialect.ColumnType(col.ddlType), 1))
+		} else {
+			p.schema.AddColumn(tableName, column, p.dialect.ColumnType(col.ddlType)+strings.TrimPrefix(definition, columnType(definition)))
+		}
+	case matchKeywords(action, "SET", "NOT", "NULL"):
+		table.Columns[column] = columnType(definition) + " NOT NULL"
+	case matchKeywords(action, "DROP", "NOT", "NULL"):
+		table.Columns[column] = columnType(definition) + " NULL"
+	case matchKeywords(action, "SET", "DEFAULT"), matchKeywords(action, "DROP", "DEFAULT"):
+	default:
+		return fmt.Errorf("unsupported ALTER COLUMN action %v", strings.Join(action, " "))
+	}
+	return nil
+}
+
+// parseColumnDef parses the name, the type and the constraints of a column definition
+func (p *ddlParser) parseColumnDef(item []string) columnDef {
+	col := columnDef{name: p.identifier(item[0])}
+	depth := 0
+	end := len(item)
+	for i := 1; i < len(item); i++ {
+		depth += p.nesting(item[i])
+		if depth == 0 && isColumnConstraint(item[i]) {
+			end = i
+			break
+		}
+	}
+	col.ddlType = renderType(item[1:end])
+
+	depth = 0
+	for i := end; i < len(item); i++ {
+		depth += p.nesting(item[i])
+		if depth > 0 {
+			continue
+		}
+		switch {
+		case matchKeywords(item[i:], "NOT", "NULL"):
+			col.notNull = true
+		case matchKeywords(item[i:], "PRIMARY", "KEY"):
+			col.primaryKey = true
+		case matchKeywords(item[i:], "UNIQUE"):
+			col.unique = true
+		case matchKeywords(item[i:], "STATIC"):
+			col.static = true
+		}
+	}
+	return col
+}
+
+func isColumnConstraint(token string) bool {
+	switch strings.ToUpper(token) {
+	case "NOT", "NULL", "DEFAULT", "PRIMARY", "UNIQUE", "AUTO_INCREMENT", "AUTOINCREMENT", "CHECK", "CONSTRAINT",
+		"REFERENCES", "GENERATED", "COLLATE", "COMMENT", "STATIC", "ON", "USING":
+		return true
+	}
+	return false
+}
+
+// renderType joins the tokens of a type, e.g. "map<bigint, blob>" or "VARCHAR(255)"
+func renderType(tokens []string) string {
+	var sb strings.Builder
+	for i, token := range tokens {
+		if i > 0 && isWord(token) && isWord(tokens[i-1]) {
+			sb.WriteString(" ")
+		}
+		sb.WriteString(token)
+		if token == "," {
+			sb.WriteString(" ")
+		}
+	}
+	return sb.String()
+}
+
+// columnType returns the type of a column definition without its nullability or its kind
+func columnType(definition string) string {
+	for _, suffix := range []string{" NOT NULL", " NULL", " regular", " static"} {
+		if t, ok := strings.CutSuffix(definition, suffix); ok {
+			return t
+		}
+	}
+	return definition
+}
+
+func indexDefinition(columns []string, unique bool) string {
+	definition := "(" + strings.Join(columns, ", ") + ")"
+	if unique {
+		return "UNIQUE " + definition
+	}
+	return definition
+}
+
+// name consumes a table, index or type name, without its keyspace or schema
+func (p *ddlParser) name() string {
+	name := p.identifier(p.next())
+	if i := strings.LastIndex(name, "."); i >= 0 {
+		name = name[i+1:]
+	}
+	return name
+}
+
+func (p *ddlParser) next() string {
+	if p.pos >= len(p.tokens) {
+		return ""
+	}
+	p.pos++
+	return p.tokens[p.pos-1]
+}
+
+// accept consumes the keywords if the next tokens match them
+func (p *ddlParser) accept(keywords ...string) bool {
+	if !matchKeywords(p.tokens[p.pos:], keywords...) {
+		return false
+	}
+	p.pos += len(keywords)
+	return true
+}
+
+// list consumes a parenthesized, comma separated list and returns its items
+func (p *ddlParser) list() ([][]string, error) {
+	if p.next() != "(" {
+		return nil, fmt.Errorf("expected (")
+	}
+	start := p.pos
+	depth := 1
+	for ; p.pos < len(p.tokens); p.pos++ {
+		switch p.tokens[p.pos] {
+		case "(":
+			depth++
+		case ")":
+			depth--
+		}
+		if depth == 0 {
+			p.pos++
+			return p.splitList(p.tokens[start : p.pos-1]), nil
+		}
+	}
+	return nil, fmt.Errorf("unmatched (")
+}
+
+// splitList splits tokens at the commas that aren't nested in parentheses, or in the angle brackets of CQL types
+func (p *ddlParser) splitList(tokens []string) [][]string {
+	var items [][]string
+	depth := 0
+	start := 0
+	for i, token := range tokens {
+		depth += p.nesting(token)
+		if token == "," && depth == 0 {
+			items = append(items, tokens[start:i])
+			start = i + 1
+		}
+	}
+	if start < len(tokens) {
+		items = append(items, tokens[start:])
+	}
+	return items
+}
+
+// nesting returns 1 if a token opens a nested part of a statement, -1 if it closes one and 0 otherwise
+func (p *ddlParser) nesting(token string) int {
+	switch {
+	case token == "(", p.dialect.CQL && token == "<":
+		return 1
+	case token == ")", p.dialect.CQL && token == ">":
+		return -1
+	}
+	return 0
+}
+
+// indexColumns returns the columns of the first parenthesized list of tokens. Expressions are skipped, because the
+// databases don't report them as columns of an index.
+func (p *ddlParser) indexColumns(tokens []string) []string {
+	start := slices.Index(tokens, "(")
+	if start < 0 {
+		return nil
+	}
+	end := start + 1
+	for depth := 1; end < len(tokens) && depth > 0; end++ {
+		depth += p.nesting(tokens[end])
+	}
+	return p.itemColumns(p.splitList(tokens[start+1 : end-1]))
+}
+
+// itemColumns returns the columns of the items of an index definition, skipping expressions
+func (p *ddlParser) itemColumns(items [][]string) []string {
+	var columns []string
+	for _, item := range items {
+		if len(item) == 0 || !isWord(item[0]) {
+			continue
+		}
+		// a column can be followed by its length, sort order or operator class, an expression by its arguments
+		if len(item) > 2 && item[1] == "(" && !isNumber(item[2]) {
+			continue
+		}
+		columns = append(columns, p.identifier(item[0]))
+	}
+	return columns
+}
+
+func matchKeywords(tokens []string, keywords ...string) bool {
+	if len(tokens) < len(keywords) {
+		return false
+	}
+	for i, keyword := range keywords {
+		if !strings.EqualFold(tokens[i], keyword) {
+			return false
+		}
+	}
+	return true
+}
+
+func isWord(token string) bool {
+	return token != "" && (ddlWordRegex.MatchString(token) || token[0] == '`' || token[0] == '"')
+}
+
+func isNumber(token string) bool {
+	_, err := strconv.Atoi(token)
+	return err == nil
+}
+
+// identifier returns the name of a table, column, index or type as the database stores it
+func (p *ddlParser) identifier(token string) string {
+	if len(token) >= 2 && (token[0] == '`' || token[0] == '"') {
+		return token[1 : len(token)-1]
+	}
+	if p.dialect.LowerCaseIdentifiers {
+		return strings.ToLower(token)
+	}
+	return token
+}
+
+func mysqlColumnType(ddlType string) string {
+	t := strings.ToLower(ddlType)
+	switch {
+	case t == "bool" || t == "boolean":
+		return "tinyint(1)"
+	case strings.HasPrefix(t, "integer"):
+		t = "int" + strings.TrimPrefix(t, "integer")
+	}
+	// MySQL 8 doesn't report the display width of integer types
+	t = mysqlIntWidthRegex.ReplaceAllString(t, "$1")
+	return strings.ReplaceAll(t, ", ", ",")
+}
+
+func postgresqlColumnType(ddlType string) string {
+	t := strings.ToLower(ddlType)
+	base, args, _ := strings.Cut(t, "(")
+	base = strings.TrimSpace(base)
+	if name, ok := postgresqlTypeNames[base]; ok {
+		base = name
+	}
+	// Only the length of character types is reported
+	if args != "" && strings.HasPrefix(base, "character") {
+		return base + "(" + args
+	}
+	return base
+}
+
+func cassandraColumnType(ddlType string) string {
+	return cassandraTextRegex.ReplaceAllString(strings.ToLower(ddlType), "text")
+}
@@ -0,0 +1,194 @@
+package schema
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"go.temporal.io/server/common/log"
+)
+
+type (
+	DDLTestSuite struct {
+		*require.Assertions // override suite.Suite.Assertions with require.Assertions; this means that s.NotNil(nil) will stop the test, not merely log an error
+		suite.Suite
+	}
+)
+
+func TestDDLTestSuite(t *testing.T) {
+	suite.Run(t, new(DDLTestSuite))
+}
+
+func (s *DDLTestSuite) SetupTest() {
+	s.Assertions = require.New(s.T()) // Have to define our overridden assertions in the test setup. If we did it earlier, s.T() will return nil
+}
+
+func (s *DDLTestSuite) TestParseSchema_MySQL() {
+	schema, err := ParseSchema(MySQLDialect, []string{
+		`CREATE TABLE namespaces(
+  partition_id INT NOT NULL,
+  id BINARY(16) NOT NULL,
+  name VARCHAR(255) UNIQUE NOT NULL,
+  data BLOB NOT NULL,
+  is_global TINYINT(1) NOT NULL,
+  PRIMARY KEY(partition_id, id)
+);`,
+		`INSERT INTO namespace_metadata (partition_id, notification_version) VALUES (54321, 1);`,
+		`CREATE TABLE cluster_membership (
+  membership_partition INT NOT NULL,
+  host_id BINARY(16) NOT NULL,
+  role TINYINT NOT NULL,
+  last_heartbeat TIMESTAMP DEFAULT '1970-01-02 00:00:01',
+  PRIMARY KEY (membership_partition, host_id),
+  INDEX (role, host_id),
+  INDEX (role, last_heartbeat)
+);`,
+		`CREATE TABLE dropped (id INT);`,
+		`ALTER TABLE namespaces MODIFY COLUMN data MEDIUMBLOB;`,
+		`ALTER TABLE namespaces ADD version BIGINT NOT NULL DEFAULT 1, DROP is_global;`,
+		`CREATE INDEX by_role ON cluster_membership (role, (CAST(last_heartbeat AS DATE)) DESC);`,
+		`DROP TABLE dropped;`,
+	})
+	s.NoError(err)
+
+	expected := NewSchema()
+	expected.AddColumn("namespaces", "partition_id", "int NOT NULL")
+	expected.AddColumn("namespaces", "id", "binary(16) NOT NULL")
+	expected.AddColumn("namespaces", "name", "varchar(255) NOT NULL")
+	expected.AddColumn("namespaces", "data", "mediumblob NULL")
+	expected.AddColumn("namespaces", "version", "bigint NOT NULL")
+	expected.AddIndex("namespaces", "name", "UNIQUE (name)")
+	expected.AddIndex("namespaces", "PRIMARY", "UNIQUE (partition_id, id)")
+	expected.AddColumn("cluster_membership", "membership_partition", "int NOT NULL")
+	expected.AddColumn("cluster_membership", "host_id", "binary(16) NOT NULL")
+	expected.AddColumn("cluster_membership", "role", "tinyint NOT NULL")
+	expected.AddColumn("cluster_membership", "last_heartbeat", "timestamp NULL")
+	expected.AddIndex("cluster_membership", "PRIMARY", "UNIQUE (membership_partition, host_id)")
+	expected.AddIndex("cluster_membership", "role", "(role, host_id)")
+	expected.AddIndex("cluster_membership", "role_2", "(role, last_heartbeat)")
+	expected.AddIndex("cluster_membership", "by_role", "(role)")
+	s.Equal(expected, schema)
+}
+
+func (s *DDLTestSuite) TestParseSchema_PostgreSQL() {
+	schema, err := ParseSchema(PostgreSQLDialect, []string{
+		`CREATE TABLE buffered_events (
+  shard_id INTEGER NOT NULL,
+  id BIGSERIAL NOT NULL UNIQUE,
+  BatcherUser VARCHAR(255),
+  start_time TIMESTAMP NOT NULL,
+  PRIMARY KEY (shard_id, id)
+);`,
+		`CREATE TABLE nexus_endpoints_partition_status (
+  id INT NOT NULL DEFAULT 0,
+  version BIGINT NOT NULL,
+  CONSTRAINT only_one_row CHECK (id = 0)
+);`,
+		`ALTER TABLE buffered_events ALTER COLUMN BatcherUser TYPE VARCHAR(128);`,
+		`CREATE UNIQUE INDEX by_user ON buffered_events (shard_id, BatcherUser, (COALESCE(start_time, '9999-12-31 23:59:59')) DESC);`,
+	})
+	s.NoError(err)
+
+	expected := NewSchema()
+	expected.AddColumn("buffered_events", "shard_id", "integer NOT NULL")
+	expected.AddColumn("buffered_events", "id", "bigint NOT NULL")
+	expected.AddColumn("buffered_events", "batcheruser", "character varying(128) NULL")
+	expected.AddColumn("buffered_events", "start_time", "timestamp without time zone NOT NULL")
+	expected.AddIndex("buffered_events", "buffered_events_id_key", "UNIQUE (id)")
+	expected.AddIndex("buffered_events", "buffered_events_pkey", "UNIQUE (shard_id, id)")
+	expected.AddIndex("buffered_events", "by_user", "UNIQUE (shard_id, batcheruser)")
+	expected.AddColumn("nexus_endpoints_partition_status", "id", "integer NOT NULL")
+	expected.AddColumn("nexus_endpoints_partition_status", "version", "bigint NOT NULL")
+	s.Equal(expected, schema)
+}
+
+func (s *DDLTestSuite) TestParseSchema_SQLite() {
+	schema, err := ParseSchema(SQLiteDialect, []string{
+		`CREATE TABLE namespaces(
+  partition_id INT NOT NULL,
+  name VARCHAR(255) UNIQUE NOT NULL,
+  PRIMARY KEY(partition_id)
+);`,
+	})
+	s.NoError(err)
+
+	expected := NewSchema()
+	expected.AddColumn("namespaces", "partition_id", "INT NOT NULL")
+	expected.AddColumn("namespaces", "name", "VARCHAR(255) NOT NULL")
+	expected.AddIndex("namespaces", "sqlite_autoindex_namespaces_1", "UNIQUE (name)")
+	expected.AddIndex("namespaces", "sqlite_autoindex_namespaces_2", "UNIQUE (partition_id)")
+	s.Equal(expected, schema)
+}
+
+func (s *DDLTestSuite) TestParseSchema_Cassandra() {
+	schema, err := ParseSchema(CassandraDialect, []string{
+		`CREATE TYPE serialized_event_batch (
+  encoding_type text,
+  data          blob
+);`,
+		`CREATE TABLE history_node (
+  tree_id     uuid,
+  branch_id   uuid,
+  txn_id      bigint,
+  data        blob,
+  batches     list<frozen<serialized_event_batch>>,
+  PRIMARY KEY ((tree_id), branch_id, txn_id )
+) WITH CLUSTERING ORDER BY (branch_id ASC, txn_id DESC)
+  AND COMPACTION = {
+     'class': 'org.apache.cassandra.db.compaction.LeveledCompactionStrategy'
+};`,
+		`CREATE TABLE cluster_metadata (
+  metadata_partition int PRIMARY KEY,
+  signals map<bigint, blob>
+);`,
+		`ALTER TABLE history_node ADD prev_txn_id bigint;`,
+		`ALTER TYPE serialized_event_batch ADD version int;`,
+		`CREATE INDEX cm_signals_idx on cluster_metadata (signals);`,
+	})
+	s.NoError(err)
+
+	expected := NewSchema()
+	expected.AddField("serialized_event_batch", "encoding_type", "text")
+	expected.AddField("serialized_event_batch", "data", "blob")
+	expected.AddField("serialized_event_batch", "version", "int")
+	expected.AddColumn("history_node", "tree_id", "uuid partition_key 0")
+	expected.AddColumn("history_node", "branch_id", "uuid clustering 0 asc")
+	expected.AddColumn("history_node", "txn_id", "bigint clustering 1 desc")
+	expected.AddColumn("history_node", "data", "blob regular")
+	expected.AddColumn("history_node", "batches", "list<frozen<serialized_event_batch>> regular")
+	expected.AddColumn("history_node", "prev_txn_id", "bigint regular")
+	expected.AddColumn("cluster_metadata", "metadata_partition", "int partition_key 0")
+	expected.AddColumn("cluster_metadata", "signals", "map<bigint, blob> regular")
+	expected.AddIndex("cluster_metadata", "cm_signals_idx", "COMPOSITES values(signals)")
+	s.Equal(expected, schema)
+}
+
+func (s *DDLTestSuite) TestParseSchema_UnsupportedStatement() {
+	_, err := ParseSchema(MySQLDialect, []string{
+		`CREATE TABLE shards (shard_id INT NOT NULL);`,
+		`ALTER TABLE shards RENAME TO shards_v2;`,
+	})
+	s.ErrorContains(err, "unsupported ALTER TABLE action")
+}
+
+func (s *DDLTestSuite) TestParseSchema_EmbeddedSchemas() {
+	for schemaName, dialect := range map[string]*Dialect{
+		"mysql/v8/temporal":         MySQLDialect,
+		"mysql/v8/visibility":       MySQLDialect,
+		"postgresql/v12/temporal":   PostgreSQLDialect,
+		"postgresql/v12/visibility": PostgreSQLDialect,
+		"sqlite/v3/temporal":        SQLiteDialect,
+		"cassandra/temporal":        CassandraDialect,
+	} {
+		updateTask := NewUpdateSchemaTask(nil, &UpdateConfig{SchemaName: schemaName}, log.NewNoopLogger())
+		changes, err := updateTask.buildChangeSet("0.0")
+		s.NoError(err, schemaName)
+		var stmts []string
+		for _, cs := range changes {
+			stmts = append(stmts, cs.cqlStmts...)
+		}
+		schema, err := ParseSchema(dialect, stmts)
+		s.NoError(err, schemaName)
+		s.NotEmpty(schema.Tables, schemaName)
+	}
+}
@@ -1,6 +1,7 @@
 package schema
 
 import (
+	"encoding/json"
 	"fmt"
 	"slices"
 	"strings"
@@ -28,6 +29,39 @@ func Update(cli *cli.Context, db DB, logger log.Logger) error {
 	return NewUpdateSchemaTask(db, cfg, logger).Run()
 }
 
+// Verify compares the schema of the database with the embedded schema of its recorded version and writes the
+// report as JSON to the output of the cli. It returns an error if the schema differs.
+func Verify(cli *cli.Context, db InspectableDB, dbName string, logger log.Logger) error {
+	cfg, err := newVerifyConfig(cli, db)
+	if err != nil {
+		return err
+	}
+	report, err := NewVerifySchemaTask(db, dbName, cfg, logger).Run()
+	if err != nil {
+		return err
+	}
+	encoder := json.NewEncoder(cli.App.Writer)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(report); err != nil {
+		return err
+	}
+	if !report.InSync {
+		return fmt.Errorf("schema of %v differs from version %v in %d places", dbName, report.SchemaVersion, len(report.Differences))
+	}
+	return nil
+}
+
+func newVerifyConfig(cli *cli.Context, db DB) (*VerifyConfig, error) {
+	config := new(VerifyConfig)
+	config.SchemaDir = cli.String(CLIOptSchemaDir)
+	config.SchemaName = cli.String(CLIOptSchemaName)
+
+	if err := validateUpdateConfig(&UpdateConfig{SchemaDir: config.SchemaDir, SchemaName: config.SchemaName}, db); err != nil {
+		return nil, err
+	}
+	return config, nil
+}
+
 func newUpdateConfig(cli *cli.Context, db DB) (*UpdateConfig, error) {
 	config := new(UpdateConfig)
 	config.SchemaDir = cli.String(CLIOptSchemaDir)
@@ -0,0 +1,255 @@
+package schema
+
+import (
+	"fmt"
+	"slices"
+
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+)
+
+type (
+	// VerifyConfig holds the config
+	// params for executing a VerifyTask
+	VerifyConfig struct {
+		SchemaDir  string
+		SchemaName string
+	}
+
+	// Schema is the structure of a database as reported by the database itself
+	Schema struct {
+		Tables map[string]*Table
+		// Types are the user defined types of the database, only Cassandra has them
+		Types map[string]*Table
+	}
+
+	// Table is the structure of a table or user defined type. Columns and Indexes map their names to their
+	// database specific definitions.
+	Table struct {
+		Columns map[string]string
+		Indexes map[string]string
+	}
+
+	// InspectableDB is a DB whose live schema can be read from the database
+	InspectableDB interface {
+		DB
+		// Dialect returns how the database reports the objects that DDL statements create
+		Dialect() *Dialect
+		// InspectSchema returns the tables, columns, indexes and types of the database
+		InspectSchema() (*Schema, error)
+	}
+
+	// SchemaDifference is a single difference between the expected and the actual schema of a database
+	SchemaDifference struct {
+		// Object is the kind of the object that differs: table, column, index, type or field
+		Object string `json:"object"`
+		// Change is one of missing, unexpected or changed
+		Change string `json:"change"`
+		// Table is the table or type of the object
+		Table string `json:"table"`
+		// Name is the name of the column, index or field, empty for tables and types
+		Name     string `json:"name,omitempty"`
+		Expected string `json:"expected,omitempty"`
+		Actual   string `json:"actual,omitempty"`
+	}
+
+	// VerifyReport is the result of a VerifyTask
+	VerifyReport struct {
+		Database      string             `json:"database"`
+		SchemaVersion string             `json:"schemaVersion"`
+		InSync        bool               `json:"inSync"`
+		Differences   []SchemaDifference `json:"differences"`
+	}
+
+	// VerifyTask compares the schema of a database with the embedded schema of the version that is recorded in
+	// the database. The expected schema is parsed from the statements of the versioned schema up to that version.
+	VerifyTask struct {
+		db     InspectableDB
+		dbName string
+		config *VerifyConfig
+		logger log.Logger
+	}
+)
+
+const (
+	objectTable  = "table"
+	objectColumn = "column"
+	objectIndex  = "index"
+	objectType   = "type"
+	objectField  = "field"
+
+	changeMissing    = "missing"
+	changeUnexpected = "unexpected"
+	changeChanged    = "changed"
+)
+
+// schemaVersionTables are created by CreateSchemaVersionTables instead of the versioned schema
+var schemaVersionTables = []string{"schema_version", "schema_update_history"}
+
+// NewVerifySchemaTask returns a new instance of VerifyTask
+func NewVerifySchemaTask(db InspectableDB, dbName string, config *VerifyConfig, logger log.Logger) *VerifyTask {
+	return &VerifyTask{
+		db:     db,
+		dbName: dbName,
+		config: config,
+		logger: logger,
+	}
+}
+
+// Run executes the task
+func (task *VerifyTask) Run() (*VerifyReport, error) {
+	task.logger.Info("VerifySchemaTask started", tag.NewAnyTag("config", task.config))
+
+	version, err := task.db.ReadSchemaVersion()
+	if err != nil {
+		return nil, fmt.Errorf("error reading current schema version:%v", err.Error())
+	}
+
+	expected, err := task.expectedSchema(version)
+	if err != nil {
+		return nil, err
+	}
+	actual, err := task.db.InspectSchema()
+	if err != nil {
+		return nil, fmt.Errorf("error reading schema of database:%v", err.Error())
+	}
+	for _, table := range schemaVersionTables {
+		delete(actual.Tables, table)
+	}
+
+	differences := DiffSchemas(expected, actual)
+	task.logger.Info("VerifySchemaTask done", tag.NewInt("differences", len(differences)))
+	return &VerifyReport{
+		Database:      task.dbName,
+		SchemaVersion: version,
+		InSync:        len(differences) == 0,
+		Differences:   differences,
+	}, nil
+}
+
+// expectedSchema parses the statements of the versioned schema up to the given version
+func (task *VerifyTask) expectedSchema(version string) (*Schema, error) {
+	updateTask := NewUpdateSchemaTask(task.db, &UpdateConfig{
+		SchemaDir:     task.config.SchemaDir,
+		SchemaName:    task.config.SchemaName,
+		TargetVersion: version,
+	}, task.logger)
+	changes, err := updateTask.buildChangeSet("0.0")
+	if err != nil {
+		return nil, err
+	}
+	var stmts []string
+	for _, cs := range changes {
+		stmts = append(stmts, cs.cqlStmts...)
+	}
+	expected, err := ParseSchema(task.db.Dialect(), stmts)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing schema version %v:%v", version, err.Error())
+	}
+	return expected, nil
+}
+
+// NewSchema returns an empty Schema
+func NewSchema() *Schema {
+	return &Schema{
+		Tables: make(map[string]*Table),
+		Types:  make(map[string]*Table),
+	}
+}
+
+// AddColumn adds a column to a table of the schema
+func (s *Schema) AddColumn(table string, column string, definition string) {
+	getOrCreateTable(s.Tables, table).Columns[column] = definition
+}
+
+// AddIndex adds an index to a table of the schema
+func (s *Schema) AddIndex(table string, index string, definition string) {
+	getOrCreateTable(s.Tables, table).Indexes[index] = definition
+}
+
+// AddField adds a field to a user defined type of the schema
+func (s *Schema) AddField(typeName string, field string, definition string) {
+	getOrCreateTable(s.Types, typeName).Columns[field] = definition
+}
+
+func getOrCreateTable(tables map[string]*Table, name string) *Table {
+	t, ok := tables[name]
+	if !ok {
+		t = &Table{
+			Columns: make(map[string]string),
+			Indexes: make(map[string]string),
+		}
+		tables[name] = t
+	}
+	return t
+}
+
+// DiffSchemas returns the differences of the actual schema to the expected one, ordered by table and name
+func DiffSchemas(expected *Schema, actual *Schema) []SchemaDifference {
+	differences := []SchemaDifference{}
+	differences = diffTables(differences, objectTable, objectColumn, expected.Tables, actual.Tables)
+	differences = diffTables(differences, objectType, objectField, expected.Types, actual.Types)
+	return differences
+}
+
+func diffTables(
+	differences []SchemaDifference,
+	tableObject string,
+	columnObject string,
+	expected map[string]*Table,
+	actual map[string]*Table,
+) []SchemaDifference {
+	for _, name := range sortedKeys(expected, actual) {
+		expectedTable, inExpected := expected[name]
+		actualTable, inActual := actual[name]
+		switch {
+		case !inActual:
+			differences = append(differences, SchemaDifference{Object: tableObject, Change: changeMissing, Table: name})
+		case !inExpected:
+			differences = append(differences, SchemaDifference{Object: tableObject, Change: changeUnexpected, Table: name})
+		default:
+			differences = diffDefinitions(differences, columnObject, name, expectedTable.Columns, actualTable.Columns)
+			differences = diffDefinitions(differences, objectIndex, name, expectedTable.Indexes, actualTable.Indexes)
+		}
+	}
+	return differences
+}
+
+func diffDefinitions(
+	differences []SchemaDifference,
+	object string,
+	table string,
+	expected map[string]string,
+	actual map[string]string,
+) []SchemaDifference {
+	for _, name := range sortedKeys(expected, actual) {
+		expectedDef, inExpected := expected[name]
+		actualDef, inActual := actual[name]
+		diff := SchemaDifference{Object: object, Table: table, Name: name, Expected: expectedDef, Actual: actualDef}
+		switch {
+		case !inActual:
+			diff.Change = changeMissing
+		case !inExpected:
+			diff.Change = changeUnexpected
+		case expectedDef != actualDef:
+			diff.Change = changeChanged
+		default:
+			continue
+		}
+		differences = append(differences, diff)
+	}
+	return differences
+}
+
+func sortedKeys[V any](maps ...map[string]V) []string {
+	var keys []string
+	for _, m := range maps {
+		for key := range m {
+			if !slices.Contains(keys, key) {
+				keys = append(keys, key)
+			}
+		}
+	}
+	slices.Sort(keys)
+	return keys
+}
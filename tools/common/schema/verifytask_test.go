@@ -0,0 +1,108 @@
+package schema
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"go.temporal.io/server/common/log"
+)
+
+type (
+	VerifyTaskTestSuite struct {
+		*require.Assertions // override suite.Suite.Assertions with require.Assertions; this means that s.NotNil(nil) will stop the test, not merely log an error
+		suite.Suite
+	}
+)
+
+func TestVerifyTaskTestSuite(t *testing.T) {
+	suite.Run(t, new(VerifyTaskTestSuite))
+}
+
+func (s *VerifyTaskTestSuite) SetupTest() {
+	s.Assertions = require.New(s.T()) // Have to define our overridden assertions in the test setup. If we did it earlier, s.T() will return nil
+}
+
+func (s *VerifyTaskTestSuite) newSchema() *Schema {
+	schema := NewSchema()
+	schema.AddColumn("executions", "shard_id", "int NOT NULL")
+	schema.AddColumn("executions", "data", "mediumblob NOT NULL")
+	schema.AddIndex("executions", "PRIMARY", "UNIQUE (shard_id)")
+	schema.AddColumn("shards", "range_id", "bigint NOT NULL")
+	schema.AddField("serialized_event_batch", "data", "blob")
+	return schema
+}
+
+func (s *VerifyTaskTestSuite) TestDiffSchemas_InSync() {
+	s.Empty(DiffSchemas(s.newSchema(), s.newSchema()))
+	s.NotNil(DiffSchemas(NewSchema(), NewSchema()))
+}
+
+func (s *VerifyTaskTestSuite) TestDiffSchemas() {
+	actual := s.newSchema()
+	delete(actual.Tables, "shards")
+	actual.AddColumn("executions", "data", "blob NOT NULL")
+	actual.AddColumn("executions", "extra", "int NULL")
+	delete(actual.Tables["executions"].Indexes, "PRIMARY")
+	actual.AddIndex("executions", "by_data", "(data)")
+	actual.AddColumn("manual_backup", "id", "int NOT NULL")
+	actual.AddField("serialized_event_batch", "encoding", "text")
+
+	s.Equal([]SchemaDifference{
+		{Object: objectColumn, Change: changeChanged, Table: "executions", Name: "data", Expected: "mediumblob NOT NULL", Actual: "blob NOT NULL"},
+		{Object: objectColumn, Change: changeUnexpected, Table: "executions", Name: "extra", Actual: "int NULL"},
+		{Object: objectIndex, Change: changeMissing, Table: "executions", Name: "PRIMARY", Expected: "UNIQUE (shard_id)"},
+		{Object: objectIndex, Change: changeUnexpected, Table: "executions", Name: "by_data", Actual: "(data)"},
+		{Object: objectTable, Change: changeUnexpected, Table: "manual_backup"},
+		{Object: objectTable, Change: changeMissing, Table: "shards"},
+		{Object: objectField, Change: changeUnexpected, Table: "serialized_event_batch", Name: "encoding", Actual: "text"},
+	}, DiffSchemas(s.newSchema(), actual))
+}
+
+type verifyTestDB struct {
+	mockSQLDB
+	version string
+	schema  *Schema
+}
+
+func (db *verifyTestDB) ReadSchemaVersion() (string, error) {
+	return db.version, nil
+}
+
+func (db *verifyTestDB) Dialect() *Dialect {
+	return MySQLDialect
+}
+
+func (db *verifyTestDB) InspectSchema() (*Schema, error) {
+	return db.schema, nil
+}
+
+func (s *VerifyTaskTestSuite) TestRun() {
+	config := &VerifyConfig{SchemaName: "mysql/v8/temporal"}
+	db := &verifyTestDB{version: "1.1"}
+	expected, err := NewVerifySchemaTask(db, "temporal", config, log.NewNoopLogger()).expectedSchema("1.1")
+	s.NoError(err)
+	s.Equal("blob NOT NULL", expected.Tables["executions"].Columns["data"])
+
+	db.schema, err = NewVerifySchemaTask(db, "temporal", config, log.NewNoopLogger()).expectedSchema("1.1")
+	s.NoError(err)
+	db.schema.AddColumn("schema_version", "curr_version", "varchar(64) NULL")
+
+	report, err := NewVerifySchemaTask(db, "temporal", config, log.NewNoopLogger()).Run()
+	s.NoError(err)
+	s.Equal(&VerifyReport{Database: "temporal", SchemaVersion: "1.1", InSync: true, Differences: []SchemaDifference{}}, report)
+
+	delete(db.schema.Tables["cluster_metadata"].Columns, "version")
+	report, err = NewVerifySchemaTask(db, "temporal", config, log.NewNoopLogger()).Run()
+	s.NoError(err)
+	s.False(report.InSync)
+	s.Equal([]SchemaDifference{
+		{Object: objectColumn, Change: changeMissing, Table: "cluster_metadata", Name: "version", Expected: "bigint NOT NULL"},
+	}, report.Differences)
+}
+
+func (s *VerifyTaskTestSuite) TestRun_UnknownVersion() {
+	db := &verifyTestDB{version: "0.99", schema: NewSchema()}
+	_, err := NewVerifySchemaTask(db, "temporal", &VerifyConfig{SchemaName: "mysql/v8/temporal"}, log.NewNoopLogger()).Run()
+	s.Error(err)
+}
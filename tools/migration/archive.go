@@ -0,0 +1,182 @@
+package migration
+
+import (
+	"bufio"
+	"compress/gzip"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+)
+
+const (
+	archiveVersion       = 1
+	manifestFileName     = "manifest.json"
+	importCheckpointFile = "import-checkpoint.json"
+	runningWorkflowsFile = "running-workflows.jsonl"
+	sectionFileExtension = ".jsonl.gz"
+)
+
+type (
+	// manifest describes an archive. It's rewritten after every page of exported records, so that an interrupted
+	// export can continue from its checkpoint.
+	manifest struct {
+		Version           int                `json:"version"`
+		SourceCluster     string             `json:"sourceCluster"`
+		HistoryShardCount int32              `json:"historyShardCount"`
+		Sections          map[section]digest `json:"sections"`
+		Complete          bool               `json:"complete"`
+		Checkpoint        *exportCheckpoint  `json:"checkpoint,omitempty"`
+	}
+
+	// exportCheckpoint is where an export continues from: the cursor of the next page to read and the size of the
+	// section files after the last page that was written. Anything written to the files after that is discarded.
+	exportCheckpoint struct {
+		Cursor    cursor            `json:"cursor"`
+		FileSizes map[section]int64 `json:"fileSizes"`
+	}
+
+	// importCheckpoint is where an import continues from: the section and the number of its records that were
+	// imported.
+	importCheckpoint struct {
+		Section section `json:"section"`
+		Records int64   `json:"records"`
+	}
+)
+
+func sectionPath(dir string, s section) string {
+	return filepath.Join(dir, string(s)+sectionFileExtension)
+}
+
+func readManifest(dir string) (*manifest, error) {
+	var m manifest
+	if err := readJSONFile(filepath.Join(dir, manifestFileName), &m); err != nil {
+		return nil, err
+	}
+	if m.Version != archiveVersion {
+		return nil, fmt.Errorf("unsupported archive version %d", m.Version)
+	}
+	return &m, nil
+}
+
+func writeManifest(dir string, m *manifest) error {
+	return writeJSONFile(filepath.Join(dir, manifestFileName), m)
+}
+
+func readImportCheckpoint(dir string) (*importCheckpoint, error) {
+	var c importCheckpoint
+	err := readJSONFile(filepath.Join(dir, importCheckpointFile), &c)
+	if errors.Is(err, os.ErrNotExist) {
+		return &importCheckpoint{Section: sections[0]}, nil
+	}
+	return &c, err
+}
+
+func writeImportCheckpoint(dir string, c *importCheckpoint) error {
+	return writeJSONFile(filepath.Join(dir, importCheckpointFile), c)
+}
+
+func readJSONFile(path string, v any) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal(data, v); err != nil {
+		return fmt.Errorf("unable to parse %s: %w", path, err)
+	}
+	return nil
+}
+
+// writeJSONFile replaces the file atomically, so that a crash never leaves a partially written file behind.
+func writeJSONFile(path string, v any) error {
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return err
+	}
+	tmpPath := path + ".tmp"
+	if err := os.WriteFile(tmpPath, data, 0644); err != nil {
+		return err
+	}
+	return os.Rename(tmpPath, path)
+}
+
+// appendRecords appends the records as a new gzip member to the section file and returns the size of the file.
+// Readers of gzip concatenate the members, so a section file can be continued after a checkpoint.
+func appendRecords(dir string, s section, records [][]byte) (int64, error) {
+	f, err := os.OpenFile(sectionPath(dir, s), os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		return 0, err
+	}
+	defer func() { _ = f.Close() }()
+
+	if len(records) > 0 {
+		w := gzip.NewWriter(f)
+		for _, record := range records {
+			if _, err := w.Write(record); err != nil {
+				return 0, err
+			}
+			if _, err := w.Write([]byte{'\n'}); err != nil {
+				return 0, err
+			}
+		}
+		if err := w.Close(); err != nil {
+			return 0, err
+		}
+		if err := f.Sync(); err != nil {
+			return 0, err
+		}
+	}
+	info, err := f.Stat()
+	if err != nil {
+		return 0, err
+	}
+	return info.Size(), nil
+}
+
+// truncateSections discards what was written to the section files after the checkpoint.
+func truncateSections(dir string, fileSizes map[section]int64) error {
+	for _, s := range sections {
+		err := os.Truncate(sectionPath(dir, s), fileSizes[s])
+		if err != nil && !(errors.Is(err, os.ErrNotExist) && fileSizes[s] == 0) {
+			return err
+		}
+	}
+	return nil
+}
+
+// readRecords calls fn with every record of the section file, in the order they were written.
+func readRecords(dir string, s section, fn func(record []byte) error) error {
+	f, err := os.Open(sectionPath(dir, s))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	defer func() { _ = f.Close() }()
+
+	info, err := f.Stat()
+	if err != nil || info.Size() == 0 {
+		return err
+	}
+	gz, err := gzip.NewReader(f)
+	if err != nil {
+		return fmt.Errorf("unable to read %s: %w", f.Name(), err)
+	}
+	r := bufio.NewReader(gz)
+	for {
+		line, err := r.ReadBytes('\n')
+		if errors.Is(err, io.EOF) {
+			if len(line) > 0 {
+				return fmt.Errorf("unable to read %s: truncated record", f.Name())
+			}
+			return nil
+		} else if err != nil {
+			return fmt.Errorf("unable to read %s: %w", f.Name(), err)
+		}
+		if err := fn(line[:len(line)-1]); err != nil {
+			return err
+		}
+	}
+}
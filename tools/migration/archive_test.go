@@ -0,0 +1,100 @@
+package migration
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func readAllRecords(t *testing.T, dir string, s section) []string {
+	var records []string
+	require.NoError(t, readRecords(dir, s, func(record []byte) error {
+		records = append(records, string(record))
+		return nil
+	}))
+	return records
+}
+
+func TestArchive_AppendAndRead(t *testing.T) {
+	dir := t.TempDir()
+
+	require.Empty(t, readAllRecords(t, dir, sectionShards))
+
+	size, err := appendRecords(dir, sectionShards, [][]byte{[]byte(`{"a":1}`), []byte(`{"a":2}`)})
+	require.NoError(t, err)
+	require.Positive(t, size)
+	size2, err := appendRecords(dir, sectionShards, nil)
+	require.NoError(t, err)
+	require.Equal(t, size, size2)
+	_, err = appendRecords(dir, sectionShards, [][]byte{[]byte(`{"a":3}`)})
+	require.NoError(t, err)
+
+	require.Equal(t, []string{`{"a":1}`, `{"a":2}`, `{"a":3}`}, readAllRecords(t, dir, sectionShards))
+	require.Empty(t, readAllRecords(t, dir, sectionHistory))
+}
+
+func TestArchive_TruncateToCheckpoint(t *testing.T) {
+	dir := t.TempDir()
+
+	size, err := appendRecords(dir, sectionHistory, [][]byte{[]byte(`{"node":1}`)})
+	require.NoError(t, err)
+	_, err = appendRecords(dir, sectionHistory, [][]byte{[]byte(`{"node":2}`)})
+	require.NoError(t, err)
+
+	// the second page was written after the last checkpoint
+	require.NoError(t, truncateSections(dir, map[section]int64{sectionHistory: size}))
+	require.Equal(t, []string{`{"node":1}`}, readAllRecords(t, dir, sectionHistory))
+
+	_, err = appendRecords(dir, sectionHistory, [][]byte{[]byte(`{"node":3}`)})
+	require.NoError(t, err)
+	require.Equal(t, []string{`{"node":1}`, `{"node":3}`}, readAllRecords(t, dir, sectionHistory))
+}
+
+func TestArchive_Manifest(t *testing.T) {
+	dir := t.TempDir()
+
+	_, err := readManifest(dir)
+	require.Error(t, err)
+
+	m := &manifest{
+		Version:           archiveVersion,
+		SourceCluster:     "active",
+		HistoryShardCount: 4,
+		Sections:          map[section]digest{sectionShards: {Count: 4, Checksum: "00"}},
+		Checkpoint: &exportCheckpoint{
+			Cursor:    cursor{Section: sectionExecutions, ShardID: 2, PageToken: []byte("token")},
+			FileSizes: map[section]int64{sectionShards: 42},
+		},
+	}
+	require.NoError(t, writeManifest(dir, m))
+	read, err := readManifest(dir)
+	require.NoError(t, err)
+	require.Equal(t, m, read)
+
+	m.Version = archiveVersion + 1
+	require.NoError(t, writeManifest(dir, m))
+	_, err = readManifest(dir)
+	require.Error(t, err)
+}
+
+func TestArchive_ImportCheckpoint(t *testing.T) {
+	dir := t.TempDir()
+
+	c, err := readImportCheckpoint(dir)
+	require.NoError(t, err)
+	require.Equal(t, &importCheckpoint{Section: sectionNamespaces}, c)
+
+	require.NoError(t, writeImportCheckpoint(dir, &importCheckpoint{Section: sectionExecutions, Records: 300}))
+	c, err = readImportCheckpoint(dir)
+	require.NoError(t, err)
+	require.Equal(t, &importCheckpoint{Section: sectionExecutions, Records: 300}, c)
+}
+
+func TestScanner_Cursor(t *testing.T) {
+	require.Equal(t, sectionClusterMetadata, nextSection(sectionNamespaces))
+	require.Equal(t, section(""), nextSection(sectionTaskQueueUserData))
+
+	c := cursor{Section: sectionHistory, PageToken: []byte("1")}
+	require.Equal(t, cursor{Section: sectionHistory, PageToken: []byte("2")}, pageCursor(c, []byte("2")))
+	require.Equal(t, cursor{Section: sectionExecutions}, pageCursor(c, nil))
+}
@@ -0,0 +1,54 @@
+package migration
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+)
+
+type (
+	// digest summarizes the records of a section by their count and a checksum. The checksum is the sum modulo
+	// 2^256 of the SHA-256 hashes of the records, so it doesn't depend on the order in which the records were read,
+	// which differs between datastores.
+	digest struct {
+		Count    int64  `json:"count"`
+		Checksum string `json:"checksum"`
+	}
+
+	digester struct {
+		count int64
+		sum   [sha256.Size]byte
+	}
+)
+
+func (d *digester) add(record []byte) {
+	hash := sha256.Sum256(record)
+	carry := 0
+	for i := len(d.sum) - 1; i >= 0; i-- {
+		v := int(d.sum[i]) + int(hash[i]) + carry
+		d.sum[i] = byte(v)
+		carry = v >> 8
+	}
+	d.count++
+}
+
+func (d *digester) digest() digest {
+	return digest{
+		Count:    d.count,
+		Checksum: hex.EncodeToString(d.sum[:]),
+	}
+}
+
+func digesterFrom(d digest) (*digester, error) {
+	sum, err := hex.DecodeString(d.Checksum)
+	if err != nil || len(sum) != sha256.Size {
+		return nil, fmt.Errorf("invalid checksum %q", d.Checksum)
+	}
+	result := &digester{count: d.Count}
+	copy(result.sum[:], sum)
+	return result, nil
+}
+
+func (d digest) String() string {
+	return fmt.Sprintf("%d records, checksum %s", d.Count, d.Checksum)
+}
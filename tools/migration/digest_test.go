@@ -0,0 +1,30 @@
+package migration
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDigest_OrderIndependent(t *testing.T) {
+	d1 := &digester{}
+	d2 := &digester{}
+	for i := range 1000 {
+		d1.add(fmt.Appendf(nil, "record-%d", i))
+		d2.add(fmt.Appendf(nil, "record-%d", 999-i))
+	}
+	require.Equal(t, d1.digest(), d2.digest())
+	require.Equal(t, int64(1000), d1.digest().Count)
+
+	d2.add([]byte("record-1000"))
+	require.NotEqual(t, d1.digest(), d2.digest())
+
+	restored, err := digesterFrom(d1.digest())
+	require.NoError(t, err)
+	restored.add([]byte("record-1000"))
+	require.Equal(t, d2.digest(), restored.digest())
+
+	_, err = digesterFrom(digest{Checksum: "not hex"})
+	require.Error(t, err)
+}
@@ -0,0 +1,91 @@
+package migration
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+)
+
+// exportArchive writes the data of the datastore to the archive directory. An export that was interrupted
+// continues from its last checkpoint.
+func exportArchive(ctx context.Context, st *stores, dir string, pageSize int, logger log.Logger) error {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+
+	m, err := readManifest(dir)
+	switch {
+	case errors.Is(err, os.ErrNotExist):
+		m = &manifest{
+			Version:           archiveVersion,
+			SourceCluster:     st.clusterName,
+			HistoryShardCount: st.shardCount,
+			Sections:          make(map[section]digest, len(sections)),
+			Checkpoint: &exportCheckpoint{
+				Cursor:    cursor{Section: sections[0]},
+				FileSizes: make(map[section]int64, len(sections)),
+			},
+		}
+		for _, s := range sections {
+			m.Sections[s] = (&digester{}).digest()
+		}
+	case err != nil:
+		return err
+	case m.Complete:
+		return fmt.Errorf("the archive in %s is already complete", dir)
+	case m.SourceCluster != st.clusterName || m.HistoryShardCount != st.shardCount:
+		return fmt.Errorf("the archive in %s was started from cluster %s with %d shards",
+			dir, m.SourceCluster, m.HistoryShardCount)
+	default:
+		logger.Info("Continuing export from checkpoint.", tag.NewStringTag("section", string(m.Checkpoint.Cursor.Section)))
+	}
+
+	if err := truncateSections(dir, m.Checkpoint.FileSizes); err != nil {
+		return err
+	}
+	digesters := make(map[section]*digester, len(sections))
+	for _, s := range sections {
+		if digesters[s], err = digesterFrom(m.Sections[s]); err != nil {
+			return err
+		}
+	}
+
+	sc := &scanner{stores: st, pageSize: pageSize, logger: logger}
+	err = sc.scan(ctx, m.Checkpoint.Cursor, func(s section, records []any, next cursor) error {
+		lines := make([][]byte, 0, len(records))
+		for _, record := range records {
+			line, err := json.Marshal(record)
+			if err != nil {
+				return err
+			}
+			digesters[s].add(line)
+			lines = append(lines, line)
+		}
+		size, err := appendRecords(dir, s, lines)
+		if err != nil {
+			return err
+		}
+		m.Sections[s] = digesters[s].digest()
+		m.Checkpoint.FileSizes[s] = size
+		m.Checkpoint.Cursor = next
+		return writeManifest(dir, m)
+	})
+	if err != nil {
+		return err
+	}
+
+	m.Complete = true
+	m.Checkpoint = nil
+	if err := writeManifest(dir, m); err != nil {
+		return err
+	}
+	for _, s := range sections {
+		logger.Info("Exported section.", tag.NewStringTag("section", string(s)), tag.NewInt64("records", m.Sections[s].Count))
+	}
+	return nil
+}
@@ -0,0 +1,456 @@
+package migration
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/persistence"
+	"google.golang.org/protobuf/proto"
+)
+
+const importCheckpointInterval = 100
+
+type (
+	importer struct {
+		stores *stores
+		dir    string
+		logger log.Logger
+
+		// range IDs of the destination shards, executions are written with them
+		rangeIDs map[int32]int64
+		// names of the namespaces by ID, for the list of running workflows
+		namespaceNames   map[string]string
+		runningWorkflows *os.File
+	}
+
+	// runningWorkflow is a workflow that was running when it was exported. It was imported without its timer and
+	// transfer tasks, which need to be regenerated before it can make progress.
+	runningWorkflow struct {
+		Namespace   string `json:"namespace"`
+		NamespaceID string `json:"namespaceID"`
+		WorkflowID  string `json:"workflowID"`
+		RunID       string `json:"runID"`
+	}
+)
+
+// importArchive writes the data of a complete archive to the datastore. An import that was interrupted continues
+// from its last checkpoint, the records it imported after the checkpoint are imported again, which succeeds if
+// their data is unchanged in the datastore.
+func importArchive(ctx context.Context, st *stores, dir string, logger log.Logger) error {
+	m, err := readManifest(dir)
+	if err != nil {
+		return err
+	}
+	if !m.Complete {
+		return fmt.Errorf("the archive in %s is incomplete, its export needs to be continued first", dir)
+	}
+	if m.HistoryShardCount != st.shardCount {
+		return fmt.Errorf("the archive has %d history shards but the destination is configured with %d",
+			m.HistoryShardCount, st.shardCount)
+	}
+	checkpoint, err := readImportCheckpoint(dir)
+	if err != nil {
+		return err
+	}
+	if checkpoint.Section == "" {
+		logger.Info("The archive was already imported.")
+		return nil
+	}
+
+	runningWorkflows, err := os.OpenFile(filepath.Join(dir, runningWorkflowsFile), os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = runningWorkflows.Close() }()
+
+	i := &importer{
+		stores:           st,
+		dir:              dir,
+		logger:           logger,
+		rangeIDs:         make(map[int32]int64),
+		namespaceNames:   make(map[string]string),
+		runningWorkflows: runningWorkflows,
+	}
+	for s := checkpoint.Section; s != ""; s = nextSection(s) {
+		if err := i.importSection(ctx, s, checkpoint); err != nil {
+			return fmt.Errorf("unable to import %s: %w", s, err)
+		}
+		checkpoint = &importCheckpoint{Section: nextSection(s)}
+		if err := writeImportCheckpoint(dir, checkpoint); err != nil {
+			return err
+		}
+		logger.Info("Imported section.", tag.NewStringTag("section", string(s)), tag.NewInt64("records", m.Sections[s].Count))
+	}
+	return nil
+}
+
+func (i *importer) importSection(ctx context.Context, s section, checkpoint *importCheckpoint) error {
+	var count int64
+	return readRecords(i.dir, s, func(line []byte) error {
+		count++
+		if s == checkpoint.Section && count <= checkpoint.Records {
+			return nil
+		}
+
+		var err error
+		switch s {
+		case sectionNamespaces:
+			err = importRecord(ctx, line, i.importNamespace)
+		case sectionClusterMetadata:
+			err = importRecord(ctx, line, i.importClusterMetadata)
+		case sectionShards:
+			err = importRecord(ctx, line, i.importShard)
+		case sectionHistory:
+			err = importRecord(ctx, line, i.importHistoryNode)
+		case sectionExecutions:
+			err = importRecord(ctx, line, i.importExecution)
+		case sectionTaskQueueUserData:
+			err = importRecord(ctx, line, i.importUserData)
+		default:
+			err = fmt.Errorf("unknown section %q", s)
+		}
+		if err != nil {
+			return fmt.Errorf("record %d: %w", count, err)
+		}
+
+		if count%importCheckpointInterval == 0 {
+			return writeImportCheckpoint(i.dir, &importCheckpoint{Section: s, Records: count})
+		}
+		return nil
+	})
+}
+
+func importRecord[T any](ctx context.Context, line []byte, fn func(context.Context, *T) error) error {
+	var record T
+	if err := json.Unmarshal(line, &record); err != nil {
+		return err
+	}
+	return fn(ctx, &record)
+}
+
+func (i *importer) importNamespace(ctx context.Context, record *namespaceRecord) error {
+	detail := &persistencespb.NamespaceDetail{}
+	if err := proto.Unmarshal(record.Namespace, detail); err != nil {
+		return err
+	}
+	_, err := i.stores.metadata.CreateNamespace(ctx, &persistence.CreateNamespaceRequest{
+		Namespace:         detail,
+		IsGlobalNamespace: record.IsGlobal,
+	})
+	var alreadyExists *serviceerror.NamespaceAlreadyExists
+	if !errors.As(err, &alreadyExists) {
+		return err
+	}
+
+	existing, getErr := i.stores.metadata.GetNamespace(ctx, &persistence.GetNamespaceRequest{ID: detail.GetInfo().GetId()})
+	if getErr == nil && existing.IsGlobalNamespace == record.IsGlobal && proto.Equal(existing.Namespace, detail) {
+		return nil
+	}
+	return fmt.Errorf("namespace %s already exists with different data: %w", detail.GetInfo().GetName(), err)
+}
+
+func (i *importer) importClusterMetadata(ctx context.Context, record *clusterMetadataRecord) error {
+	metadata := &persistencespb.ClusterMetadata{}
+	if err := proto.Unmarshal(record.ClusterMetadata, metadata); err != nil {
+		return err
+	}
+	applied, err := i.stores.clusterMetadata.SaveClusterMetadata(ctx, &persistence.SaveClusterMetadataRequest{
+		ClusterMetadata: metadata,
+	})
+	if err == nil && applied {
+		return nil
+	}
+
+	existing, getErr := i.stores.clusterMetadata.GetClusterMetadata(ctx, &persistence.GetClusterMetadataRequest{
+		ClusterName: metadata.GetClusterName(),
+	})
+	if getErr == nil && proto.Equal(existing.ClusterMetadata, metadata) {
+		return nil
+	}
+	if err == nil {
+		err = getErr
+	}
+	return fmt.Errorf("cluster metadata of %s already exists with different data: %v", metadata.GetClusterName(), err)
+}
+
+func (i *importer) importShard(ctx context.Context, record *shardRecord) error {
+	shardInfo := &persistencespb.ShardInfo{}
+	if err := proto.Unmarshal(record.ShardInfo, shardInfo); err != nil {
+		return err
+	}
+	resp, err := i.stores.shard.GetOrCreateShard(ctx, &persistence.GetOrCreateShardRequest{
+		ShardID:          shardInfo.GetShardId(),
+		InitialShardInfo: proto.Clone(shardInfo).(*persistencespb.ShardInfo),
+	})
+	if err != nil {
+		return err
+	}
+	resp.ShardInfo.UpdateTime = nil
+	if !proto.Equal(resp.ShardInfo, shardInfo) {
+		return fmt.Errorf("shard %d already exists with different data", shardInfo.GetShardId())
+	}
+	i.rangeIDs[shardInfo.GetShardId()] = shardInfo.GetRangeId()
+	return nil
+}
+
+func (i *importer) importHistoryNode(ctx context.Context, record *historyNodeRecord) error {
+	// the namespace selects the compression and encryption of the events, trees with an info that can't be
+	// parsed fall back to the defaults
+	namespaceID, _, _, _ := persistence.SplitHistoryGarbageCleanupInfo(record.Info)
+	_, err := i.stores.execution.AppendRawHistoryNodes(ctx, &persistence.AppendRawHistoryNodesRequest{
+		ShardID:     record.ShardID,
+		NamespaceID: namespaceID,
+		IsNewBranch: record.IsNewBranch,
+		Info:        record.Info,
+		BranchToken: record.BranchToken,
+		History: &commonpb.DataBlob{
+			EncodingType: enumspb.ENCODING_TYPE_PROTO3,
+			Data:         record.Events,
+		},
+		PrevTransactionID: record.PrevTransactionID,
+		TransactionID:     record.TransactionID,
+		NodeID:            record.NodeID,
+	})
+	// nodes are identified by their branch, ID and transaction ID, so a node that already exists was imported
+	// before, either by an earlier attempt or as the ancestor of another branch
+	var conditionFailed *persistence.ConditionFailedError
+	if errors.As(err, &conditionFailed) {
+		return nil
+	}
+	return err
+}
+
+func (i *importer) importExecution(ctx context.Context, record *executionRecord) error {
+	state := &persistencespb.WorkflowMutableState{}
+	if err := proto.Unmarshal(record.State, state); err != nil {
+		return err
+	}
+	executionInfo := state.GetExecutionInfo()
+	executionState := state.GetExecutionState()
+	running := executionState.GetState() == enumsspb.WORKFLOW_EXECUTION_STATE_CREATED ||
+		executionState.GetState() == enumsspb.WORKFLOW_EXECUTION_STATE_RUNNING
+
+	mode := persistence.CreateWorkflowModeBrandNew
+	if !record.IsCurrent {
+		if running {
+			i.logger.Warn("Skipping running workflow that isn't the current run of its workflow ID.",
+				tag.WorkflowNamespaceID(executionInfo.GetNamespaceId()),
+				tag.WorkflowID(executionInfo.GetWorkflowId()),
+				tag.WorkflowRunID(executionState.GetRunId()),
+			)
+			return nil
+		}
+		mode = persistence.CreateWorkflowModeBypassCurrent
+	}
+	rangeID, err := i.rangeID(ctx, record.ShardID)
+	if err != nil {
+		return err
+	}
+
+	// buffered events can only be written by an update, so the execution is created with the version before it
+	createVersion := record.DBRecordVersion
+	if len(state.BufferedEvents) > 0 && createVersion > 0 {
+		createVersion--
+	}
+	signalRequestedIDs := make(map[string]struct{}, len(state.SignalRequestedIds))
+	for _, id := range state.SignalRequestedIds {
+		signalRequestedIDs[id] = struct{}{}
+	}
+	_, err = i.stores.execution.CreateWorkflowExecution(ctx, &persistence.CreateWorkflowExecutionRequest{
+		ShardID: record.ShardID,
+		RangeID: rangeID,
+		Mode:    mode,
+		NewWorkflowSnapshot: persistence.WorkflowSnapshot{
+			ExecutionInfo:       executionInfo,
+			ExecutionState:      executionState,
+			NextEventID:         state.NextEventId,
+			ActivityInfos:       state.ActivityInfos,
+			TimerInfos:          state.TimerInfos,
+			ChildExecutionInfos: state.ChildExecutionInfos,
+			RequestCancelInfos:  state.RequestCancelInfos,
+			SignalInfos:         state.SignalInfos,
+			SignalRequestedIDs:  signalRequestedIDs,
+			ChasmNodes:          state.ChasmNodes,
+			DBRecordVersion:     createVersion,
+			Checksum:            state.Checksum,
+		},
+	})
+	if err != nil {
+		imported, compareErr := i.executionImported(ctx, record, state)
+		if compareErr != nil {
+			return compareErr
+		}
+		if !imported {
+			return fmt.Errorf("unable to create workflow %s/%s: %w", executionInfo.GetWorkflowId(), executionState.GetRunId(), err)
+		}
+		return nil
+	}
+
+	if err := i.writeBufferedEvents(ctx, record, state, rangeID); err != nil {
+		return err
+	}
+	if running {
+		return i.addRunningWorkflow(ctx, executionInfo.GetNamespaceId(), executionInfo.GetWorkflowId(), executionState.GetRunId())
+	}
+	return nil
+}
+
+func (i *importer) writeBufferedEvents(
+	ctx context.Context,
+	record *executionRecord,
+	state *persistencespb.WorkflowMutableState,
+	rangeID int64,
+) error {
+	if len(state.BufferedEvents) == 0 {
+		return nil
+	}
+	_, err := i.stores.execution.UpdateWorkflowExecution(ctx, &persistence.UpdateWorkflowExecutionRequest{
+		ShardID: record.ShardID,
+		RangeID: rangeID,
+		Mode:    persistence.UpdateWorkflowModeIgnoreCurrent,
+		UpdateWorkflowMutation: persistence.WorkflowMutation{
+			ExecutionInfo:     state.ExecutionInfo,
+			ExecutionState:    state.ExecutionState,
+			NextEventID:       state.NextEventId,
+			NewBufferedEvents: state.BufferedEvents,
+			Condition:         state.NextEventId,
+			DBRecordVersion:   record.DBRecordVersion,
+			Checksum:          state.Checksum,
+		},
+	})
+	return err
+}
+
+// executionImported returns whether the execution of the record already exists in the datastore with the same
+// data. An execution that was created by an earlier attempt without its buffered events is completed.
+func (i *importer) executionImported(
+	ctx context.Context,
+	record *executionRecord,
+	state *persistencespb.WorkflowMutableState,
+) (bool, error) {
+	resp, err := i.stores.execution.GetWorkflowExecution(ctx, &persistence.GetWorkflowExecutionRequest{
+		ShardID:     record.ShardID,
+		NamespaceID: state.GetExecutionInfo().GetNamespaceId(),
+		WorkflowID:  state.GetExecutionInfo().GetWorkflowId(),
+		RunID:       state.GetExecutionState().GetRunId(),
+	})
+	var notFound *serviceerror.NotFound
+	if errors.As(err, &notFound) {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+	normalizeMutableState(resp.State)
+	existing, err := marshalDeterministic(resp.State)
+	if err != nil {
+		return false, err
+	}
+	if bytes.Equal(existing, record.State) {
+		return true, nil
+	}
+
+	if len(state.BufferedEvents) == 0 || len(resp.State.BufferedEvents) > 0 {
+		return false, nil
+	}
+	withoutBufferedEvents := proto.Clone(state).(*persistencespb.WorkflowMutableState)
+	withoutBufferedEvents.BufferedEvents = nil
+	if !proto.Equal(resp.State, withoutBufferedEvents) {
+		return false, nil
+	}
+	rangeID, err := i.rangeID(ctx, record.ShardID)
+	if err != nil {
+		return false, err
+	}
+	return true, i.writeBufferedEvents(ctx, record, state, rangeID)
+}
+
+func (i *importer) rangeID(ctx context.Context, shardID int32) (int64, error) {
+	if rangeID, ok := i.rangeIDs[shardID]; ok {
+		return rangeID, nil
+	}
+	resp, err := i.stores.shard.GetOrCreateShard(ctx, &persistence.GetOrCreateShardRequest{ShardID: shardID})
+	if err != nil {
+		return 0, err
+	}
+	i.rangeIDs[shardID] = resp.ShardInfo.GetRangeId()
+	return resp.ShardInfo.GetRangeId(), nil
+}
+
+func (i *importer) addRunningWorkflow(ctx context.Context, namespaceID, workflowID, runID string) error {
+	name, ok := i.namespaceNames[namespaceID]
+	if !ok {
+		resp, err := i.stores.metadata.GetNamespace(ctx, &persistence.GetNamespaceRequest{ID: namespaceID})
+		if err != nil {
+			return err
+		}
+		name = resp.Namespace.GetInfo().GetName()
+		i.namespaceNames[namespaceID] = name
+	}
+	line, err := json.Marshal(runningWorkflow{
+		Namespace:   name,
+		NamespaceID: namespaceID,
+		WorkflowID:  workflowID,
+		RunID:       runID,
+	})
+	if err != nil {
+		return err
+	}
+	_, err = i.runningWorkflows.Write(append(line, '\n'))
+	return err
+}
+
+func (i *importer) importUserData(ctx context.Context, record *userDataRecord) error {
+	data := &persistencespb.TaskQueueUserData{}
+	if err := proto.Unmarshal(record.UserData, data); err != nil {
+		return err
+	}
+	err := i.stores.task.UpdateTaskQueueUserData(ctx, &persistence.UpdateTaskQueueUserDataRequest{
+		NamespaceID: record.NamespaceID,
+		Updates: map[string]*persistence.SingleTaskQueueUserDataUpdate{
+			record.TaskQueue: {
+				UserData:      &persistencespb.VersionedTaskQueueUserData{Data: data},
+				BuildIdsAdded: activeBuildIDs(data.GetVersioningData()),
+			},
+		},
+	})
+	if err == nil {
+		return nil
+	}
+
+	existing, getErr := i.stores.task.GetTaskQueueUserData(ctx, &persistence.GetTaskQueueUserDataRequest{
+		NamespaceID: record.NamespaceID,
+		TaskQueue:   record.TaskQueue,
+	})
+	if getErr == nil && proto.Equal(existing.UserData.GetData(), data) {
+		return nil
+	}
+	return fmt.Errorf("unable to write user data of task queue %s: %w", record.TaskQueue, err)
+}
+
+// activeBuildIDs returns the build IDs of the versioning data that the build ID index of the task queues has to
+// contain.
+func activeBuildIDs(data *persistencespb.VersioningData) []string {
+	var ids []string
+	for _, set := range data.GetVersionSets() {
+		for _, buildID := range set.GetBuildIds() {
+			if buildID.GetState() == persistencespb.STATE_ACTIVE {
+				ids = append(ids, buildID.GetId())
+			}
+		}
+	}
+	slices.Sort(ids)
+	return slices.Compact(ids)
+}
@@ -0,0 +1,175 @@
+package migration
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/urfave/cli"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+)
+
+const (
+	flagConfigDir   = "config-dir"
+	flagEnv         = "env"
+	flagZone        = "zone"
+	flagArchive     = "archive"
+	flagPageSize    = "page-size"
+	flagArchiveOnly = "archive-only"
+	flagQuiet       = "quiet"
+
+	defaultPageSize = 100
+)
+
+// RunTool runs the temporal-migration-tool command line tool
+func RunTool(args []string) error {
+	app := BuildCLIOptions()
+	return app.Run(args)
+}
+
+// root handler for all cli commands
+func cliHandler(c *cli.Context, handler func(ctx context.Context, c *cli.Context, logger log.Logger) error, logger log.Logger) {
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	err := handler(newCallerContext(ctx), c, logger)
+	cancel()
+	if err != nil {
+		logger.Error("Command failed.", tag.Error(err))
+		if !c.GlobalBool(flagQuiet) {
+			os.Exit(1)
+		}
+	}
+}
+
+// BuildCLIOptions builds the options for cli
+func BuildCLIOptions() *cli.App {
+	app := cli.NewApp()
+	app.Name = "temporal-migration-tool"
+	app.Usage = "Command line tool to move the data of a cluster between persistence backends"
+	app.Version = "0.0.1"
+
+	logger := log.NewCLILogger()
+
+	app.Flags = []cli.Flag{
+		cli.StringFlag{
+			Name:   flagConfigDir,
+			Value:  "config",
+			Usage:  "directory of the server config of the cluster whose datastore is read or written",
+			EnvVar: "TEMPORAL_CONFIG_DIR",
+		},
+		cli.StringFlag{
+			Name:   flagEnv,
+			Value:  "development",
+			Usage:  "environment of the server config",
+			EnvVar: "TEMPORAL_ENVIRONMENT",
+		},
+		cli.StringFlag{
+			Name:   flagZone,
+			Usage:  "availability zone of the server config",
+			EnvVar: "TEMPORAL_AVAILABILITY_ZONE",
+		},
+		cli.BoolFlag{
+			Name:  flagQuiet,
+			Usage: "Don't set exit status to 1 on error",
+		},
+	}
+
+	archiveFlag := cli.StringFlag{
+		Name:  flagArchive,
+		Usage: "directory of the archive",
+	}
+	pageSizeFlag := cli.IntFlag{
+		Name:  flagPageSize,
+		Value: defaultPageSize,
+		Usage: "number of records read from the datastore per request",
+	}
+
+	app.Commands = []cli.Command{
+		{
+			Name:  "export",
+			Usage: "export the data of the datastore to an archive, or continue an interrupted export",
+			Flags: []cli.Flag{archiveFlag, pageSizeFlag},
+			Action: func(c *cli.Context) {
+				cliHandler(c, exportCommand, logger)
+			},
+		},
+		{
+			Name:  "import",
+			Usage: "import an archive into the datastore, or continue an interrupted import",
+			Flags: []cli.Flag{archiveFlag},
+			Action: func(c *cli.Context) {
+				cliHandler(c, importCommand, logger)
+			},
+		},
+		{
+			Name:  "verify",
+			Usage: "compare the counts and checksums of an archive with its records and the data of the datastore",
+			Flags: []cli.Flag{
+				archiveFlag,
+				pageSizeFlag,
+				cli.BoolFlag{
+					Name:  flagArchiveOnly,
+					Usage: "only verify the records of the archive, without reading the datastore",
+				},
+			},
+			Action: func(c *cli.Context) {
+				cliHandler(c, verifyCommand, logger)
+			},
+		},
+	}
+
+	return app
+}
+
+func exportCommand(ctx context.Context, c *cli.Context, logger log.Logger) error {
+	dir, err := requiredString(c, flagArchive)
+	if err != nil {
+		return err
+	}
+	st, err := newStoresFromCLI(c, logger)
+	if err != nil {
+		return err
+	}
+	defer st.close()
+	return exportArchive(ctx, st, dir, c.Int(flagPageSize), logger)
+}
+
+func importCommand(ctx context.Context, c *cli.Context, logger log.Logger) error {
+	dir, err := requiredString(c, flagArchive)
+	if err != nil {
+		return err
+	}
+	st, err := newStoresFromCLI(c, logger)
+	if err != nil {
+		return err
+	}
+	defer st.close()
+	return importArchive(ctx, st, dir, logger)
+}
+
+func verifyCommand(ctx context.Context, c *cli.Context, logger log.Logger) error {
+	dir, err := requiredString(c, flagArchive)
+	if err != nil {
+		return err
+	}
+	m, err := verifyArchive(dir, logger)
+	if err != nil || c.Bool(flagArchiveOnly) {
+		return err
+	}
+	st, err := newStoresFromCLI(c, logger)
+	if err != nil {
+		return err
+	}
+	defer st.close()
+	return verifyDatastore(ctx, st, m, c.Int(flagPageSize), logger)
+}
+
+func requiredString(c *cli.Context, flag string) (string, error) {
+	value := c.String(flag)
+	if value == "" {
+		return "", fmt.Errorf("option %s is required", flag)
+	}
+	return value, nil
+}
@@ -0,0 +1,84 @@
+package migration
+
+import (
+	"slices"
+
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+type section string
+
+// The sections of an archive, in the order they are exported and imported. Executions are imported after the
+// shards they belong to and the history they point to.
+const (
+	sectionNamespaces        section = "namespaces"
+	sectionClusterMetadata   section = "cluster_metadata"
+	sectionShards            section = "shards"
+	sectionHistory           section = "history"
+	sectionExecutions        section = "executions"
+	sectionTaskQueueUserData section = "task_queue_user_data"
+)
+
+var sections = []section{
+	sectionNamespaces,
+	sectionClusterMetadata,
+	sectionShards,
+	sectionHistory,
+	sectionExecutions,
+	sectionTaskQueueUserData,
+}
+
+type (
+	// The records of an archive. Proto messages are stored in their deterministic wire format, so that the same
+	// data read from two datastores results in the same record and the records can be compared by checksum.
+
+	namespaceRecord struct {
+		Namespace []byte // persistencespb.NamespaceDetail
+		IsGlobal  bool
+	}
+
+	clusterMetadataRecord struct {
+		ClusterMetadata []byte // persistencespb.ClusterMetadata
+	}
+
+	shardRecord struct {
+		ShardInfo []byte // persistencespb.ShardInfo
+	}
+
+	historyNodeRecord struct {
+		ShardID           int32
+		BranchToken       []byte
+		Info              string
+		IsNewBranch       bool
+		NodeID            int64
+		TransactionID     int64
+		PrevTransactionID int64
+		Events            []byte // historypb.History
+	}
+
+	executionRecord struct {
+		ShardID         int32
+		IsCurrent       bool
+		State           []byte // persistencespb.WorkflowMutableState
+		DBRecordVersion int64
+	}
+
+	userDataRecord struct {
+		NamespaceID string
+		TaskQueue   string
+		UserData    []byte // persistencespb.TaskQueueUserData, without the version which is specific to a datastore
+	}
+)
+
+func marshalDeterministic(m proto.Message) ([]byte, error) {
+	return proto.MarshalOptions{Deterministic: true}.Marshal(m)
+}
+
+// normalizeMutableState removes the differences between equal mutable states read from different datastores.
+func normalizeMutableState(state *persistencespb.WorkflowMutableState) {
+	slices.Sort(state.SignalRequestedIds)
+	if state.ExecutionInfo.GetExecutionStats() == nil && state.ExecutionInfo != nil {
+		state.ExecutionInfo.ExecutionStats = &persistencespb.ExecutionStats{}
+	}
+}
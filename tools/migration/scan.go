@@ -0,0 +1,415 @@
+package migration
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"go.temporal.io/api/serviceerror"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/serialization"
+)
+
+type (
+	// cursor is the position of a scan: the section and where in it to continue reading from. A cursor with an
+	// empty section is at the end of the scan.
+	cursor struct {
+		Section     section `json:"section"`
+		ShardID     int32   `json:"shardID,omitempty"`
+		NamespaceID string  `json:"namespaceID,omitempty"`
+		PageToken   []byte  `json:"pageToken,omitempty"`
+	}
+
+	// scanner reads all the data to migrate from a datastore, as archive records.
+	scanner struct {
+		stores   *stores
+		pageSize int
+		logger   log.Logger
+
+		// sorted IDs of all namespaces, read once for the task queue user data
+		namespaceIDs []string
+	}
+
+	// pageFn is called with every page of records that was read and the cursor to continue reading from after it.
+	pageFn func(s section, records []any, next cursor) error
+)
+
+func nextSection(s section) section {
+	i := slices.Index(sections, s)
+	if i < 0 || i == len(sections)-1 {
+		return ""
+	}
+	return sections[i+1]
+}
+
+// pageCursor returns the cursor to continue a paged read with, or the start of the next section if the read is done.
+func pageCursor(c cursor, pageToken []byte) cursor {
+	if len(pageToken) == 0 {
+		return cursor{Section: nextSection(c.Section)}
+	}
+	c.PageToken = pageToken
+	return c
+}
+
+// scan reads the sections from the cursor on and calls fn with every page of records.
+func (s *scanner) scan(ctx context.Context, c cursor, fn pageFn) error {
+	for c.Section != "" {
+		current := c.Section
+		var err error
+		switch current {
+		case sectionNamespaces:
+			c, err = s.scanNamespaces(ctx, c, fn)
+		case sectionClusterMetadata:
+			c, err = s.scanClusterMetadata(ctx, c, fn)
+		case sectionShards:
+			c, err = s.scanShards(ctx, c, fn)
+		case sectionHistory:
+			c, err = s.scanHistory(ctx, c, fn)
+		case sectionExecutions:
+			c, err = s.scanExecutions(ctx, c, fn)
+		case sectionTaskQueueUserData:
+			c, err = s.scanTaskQueueUserData(ctx, c, fn)
+		default:
+			err = fmt.Errorf("unknown section %q", current)
+		}
+		if err != nil {
+			return fmt.Errorf("unable to read %s: %w", current, err)
+		}
+	}
+	return nil
+}
+
+func (s *scanner) scanNamespaces(ctx context.Context, c cursor, fn pageFn) (cursor, error) {
+	resp, err := s.stores.metadata.ListNamespaces(ctx, &persistence.ListNamespacesRequest{
+		PageSize:       s.pageSize,
+		NextPageToken:  c.PageToken,
+		IncludeDeleted: true,
+	})
+	if err != nil {
+		return c, err
+	}
+	records := make([]any, 0, len(resp.Namespaces))
+	for _, ns := range resp.Namespaces {
+		data, err := marshalDeterministic(ns.Namespace)
+		if err != nil {
+			return c, err
+		}
+		records = append(records, namespaceRecord{Namespace: data, IsGlobal: ns.IsGlobalNamespace})
+	}
+	next := pageCursor(c, resp.NextPageToken)
+	return next, fn(c.Section, records, next)
+}
+
+func (s *scanner) scanClusterMetadata(ctx context.Context, c cursor, fn pageFn) (cursor, error) {
+	resp, err := s.stores.clusterMetadata.ListClusterMetadata(ctx, &persistence.ListClusterMetadataRequest{
+		PageSize:      s.pageSize,
+		NextPageToken: c.PageToken,
+	})
+	if err != nil {
+		return c, err
+	}
+	records := make([]any, 0, len(resp.ClusterMetadata))
+	for _, metadata := range resp.ClusterMetadata {
+		data, err := marshalDeterministic(metadata.ClusterMetadata)
+		if err != nil {
+			return c, err
+		}
+		records = append(records, clusterMetadataRecord{ClusterMetadata: data})
+	}
+	next := pageCursor(c, resp.NextPageToken)
+	return next, fn(c.Section, records, next)
+}
+
+func (s *scanner) scanShards(ctx context.Context, c cursor, fn pageFn) (cursor, error) {
+	first := max(c.ShardID, 1)
+	last := min(first+int32(s.pageSize)-1, s.stores.shardCount)
+	records := make([]any, 0, last-first+1)
+	for shardID := first; shardID <= last; shardID++ {
+		resp, err := s.stores.shard.GetOrCreateShard(ctx, &persistence.GetOrCreateShardRequest{ShardID: shardID})
+		if err != nil {
+			return c, err
+		}
+		// the update time is set by every write of the shard, it isn't migrated
+		resp.ShardInfo.UpdateTime = nil
+		data, err := marshalDeterministic(resp.ShardInfo)
+		if err != nil {
+			return c, err
+		}
+		records = append(records, shardRecord{ShardInfo: data})
+	}
+	next := cursor{Section: c.Section, ShardID: last + 1}
+	if last == s.stores.shardCount {
+		next = cursor{Section: nextSection(c.Section)}
+	}
+	return next, fn(c.Section, records, next)
+}
+
+func (s *scanner) scanHistory(ctx context.Context, c cursor, fn pageFn) (cursor, error) {
+	resp, err := s.stores.execution.GetAllHistoryTreeBranches(ctx, &persistence.GetAllHistoryTreeBranchesRequest{
+		PageSize:      s.pageSize,
+		NextPageToken: c.PageToken,
+	})
+	if err != nil {
+		return c, err
+	}
+	var records []any
+	for _, branch := range resp.Branches {
+		nodes, err := s.readBranch(ctx, branch)
+		if err != nil {
+			return c, err
+		}
+		records = append(records, nodes...)
+	}
+	next := pageCursor(c, resp.NextPageToken)
+	return next, fn(c.Section, records, next)
+}
+
+// readBranch returns the history nodes of a branch. Nodes of the ancestors of the branch are returned as well,
+// with the token of the ancestor branch, because the tree entry of an ancestor is gone once its own workflow is
+// deleted while the nodes the branch shares with it are kept.
+func (s *scanner) readBranch(ctx context.Context, branch persistence.HistoryBranchDetail) ([]any, error) {
+	namespaceID, workflowID, _, err := persistence.SplitHistoryGarbageCleanupInfo(branch.Info)
+	if err != nil {
+		return nil, err
+	}
+	shardID := common.WorkflowIDToHistoryShard(namespaceID, workflowID, s.stores.shardCount)
+	branchToken, err := serialization.HistoryBranchToBlob(branch.BranchInfo)
+	if err != nil {
+		return nil, err
+	}
+
+	// the token of the branch that owns every node, in the order of the nodes
+	ancestors := branch.BranchInfo.GetAncestors()
+	ancestorTokens := make([][]byte, len(ancestors))
+	for i, ancestor := range ancestors {
+		blob, err := serialization.HistoryBranchToBlob(&persistencespb.HistoryBranch{
+			TreeId:    branch.BranchInfo.GetTreeId(),
+			BranchId:  ancestor.GetBranchId(),
+			Ancestors: ancestors[:i],
+		})
+		if err != nil {
+			return nil, err
+		}
+		ancestorTokens[i] = blob.Data
+	}
+	tokenOfNode := func(nodeID int64) ([]byte, bool) {
+		for i, ancestor := range ancestors {
+			if nodeID < ancestor.GetEndNodeId() {
+				return ancestorTokens[i], false
+			}
+		}
+		return branchToken.Data, true
+	}
+
+	var records []any
+	var prevTransactionID int64
+	isNewBranch := true
+	request := &persistence.ReadHistoryBranchRequest{
+		ShardID:     shardID,
+		BranchToken: branchToken.Data,
+		MinEventID:  common.FirstEventID,
+		MaxEventID:  common.EndEventID,
+		PageSize:    s.pageSize,
+	}
+	for {
+		resp, err := s.stores.execution.ReadHistoryBranchByBatch(ctx, request)
+		var notFound *serviceerror.NotFound
+		if errors.As(err, &notFound) && len(request.NextPageToken) == 0 {
+			s.logger.Warn("Skipping history branch without events",
+				tag.WorkflowNamespaceID(namespaceID),
+				tag.WorkflowID(workflowID),
+				tag.WorkflowTreeID(branch.BranchInfo.GetTreeId()),
+				tag.WorkflowBranchID(branch.BranchInfo.GetBranchId()),
+			)
+			return nil, nil
+		} else if err != nil {
+			return nil, err
+		}
+
+		for i, batch := range resp.History {
+			nodeID := batch.Events[0].GetEventId()
+			token, own := tokenOfNode(nodeID)
+			events, err := marshalDeterministic(batch)
+			if err != nil {
+				return nil, err
+			}
+			records = append(records, historyNodeRecord{
+				ShardID:           shardID,
+				BranchToken:       token,
+				Info:              branch.Info,
+				IsNewBranch:       own && isNewBranch,
+				NodeID:            nodeID,
+				TransactionID:     resp.TransactionIDs[i],
+				PrevTransactionID: prevTransactionID,
+				Events:            events,
+			})
+			if own {
+				isNewBranch = false
+			}
+			prevTransactionID = resp.TransactionIDs[i]
+		}
+
+		if len(resp.NextPageToken) == 0 {
+			return records, nil
+		}
+		request.NextPageToken = resp.NextPageToken
+	}
+}
+
+func (s *scanner) scanExecutions(ctx context.Context, c cursor, fn pageFn) (cursor, error) {
+	shardID := max(c.ShardID, 1)
+	resp, err := s.stores.execution.ListConcreteExecutions(ctx, &persistence.ListConcreteExecutionsRequest{
+		ShardID:   shardID,
+		PageSize:  s.pageSize,
+		PageToken: c.PageToken,
+	})
+	if err != nil {
+		return c, err
+	}
+
+	records := make([]any, 0, len(resp.States))
+	for _, listed := range resp.States {
+		record, err := s.readExecution(ctx, shardID, listed)
+		if err != nil {
+			return c, err
+		}
+		if record != nil {
+			records = append(records, *record)
+		}
+	}
+
+	next := cursor{Section: c.Section, ShardID: shardID, PageToken: resp.PageToken}
+	if len(resp.PageToken) == 0 {
+		next = cursor{Section: c.Section, ShardID: shardID + 1}
+		if shardID == s.stores.shardCount {
+			next = cursor{Section: nextSection(c.Section)}
+		}
+	}
+	return next, fn(c.Section, records, next)
+}
+
+func (s *scanner) readExecution(
+	ctx context.Context,
+	shardID int32,
+	listed *persistencespb.WorkflowMutableState,
+) (*executionRecord, error) {
+	namespaceID := listed.GetExecutionInfo().GetNamespaceId()
+	workflowID := listed.GetExecutionInfo().GetWorkflowId()
+	runID := listed.GetExecutionState().GetRunId()
+
+	resp, err := s.stores.execution.GetWorkflowExecution(ctx, &persistence.GetWorkflowExecutionRequest{
+		ShardID:     shardID,
+		NamespaceID: namespaceID,
+		WorkflowID:  workflowID,
+		RunID:       runID,
+	})
+	var notFound *serviceerror.NotFound
+	if errors.As(err, &notFound) {
+		// deleted after it was listed
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	current, err := s.stores.execution.GetCurrentExecution(ctx, &persistence.GetCurrentExecutionRequest{
+		ShardID:     shardID,
+		NamespaceID: namespaceID,
+		WorkflowID:  workflowID,
+	})
+	if err != nil && !errors.As(err, &notFound) {
+		return nil, err
+	}
+
+	normalizeMutableState(resp.State)
+	state, err := marshalDeterministic(resp.State)
+	if err != nil {
+		return nil, err
+	}
+	return &executionRecord{
+		ShardID:         shardID,
+		IsCurrent:       current != nil && current.RunID == runID,
+		State:           state,
+		DBRecordVersion: resp.DBRecordVersion,
+	}, nil
+}
+
+func (s *scanner) scanTaskQueueUserData(ctx context.Context, c cursor, fn pageFn) (cursor, error) {
+	if s.namespaceIDs == nil {
+		ids, err := s.listNamespaceIDs(ctx)
+		if err != nil {
+			return c, err
+		}
+		s.namespaceIDs = ids
+	}
+	namespaceIDs := s.namespaceIDs
+	// namespaces are read in the order of their IDs, so that a scan can continue with the namespace of its cursor
+	i, _ := slices.BinarySearch(namespaceIDs, c.NamespaceID)
+	if i == len(namespaceIDs) {
+		next := cursor{Section: nextSection(c.Section)}
+		return next, fn(c.Section, nil, next)
+	}
+	namespaceID := namespaceIDs[i]
+	pageToken := c.PageToken
+	if namespaceID != c.NamespaceID {
+		pageToken = nil
+	}
+
+	resp, err := s.stores.task.ListTaskQueueUserDataEntries(ctx, &persistence.ListTaskQueueUserDataEntriesRequest{
+		NamespaceID:   namespaceID,
+		PageSize:      s.pageSize,
+		NextPageToken: pageToken,
+	})
+	if err != nil {
+		return c, err
+	}
+	records := make([]any, 0, len(resp.Entries))
+	for _, entry := range resp.Entries {
+		data, err := marshalDeterministic(entry.UserData.GetData())
+		if err != nil {
+			return c, err
+		}
+		records = append(records, userDataRecord{
+			NamespaceID: namespaceID,
+			TaskQueue:   entry.TaskQueue,
+			UserData:    data,
+		})
+	}
+
+	next := cursor{Section: c.Section, NamespaceID: namespaceID, PageToken: resp.NextPageToken}
+	if len(resp.NextPageToken) == 0 {
+		next = cursor{Section: nextSection(c.Section)}
+		if i+1 < len(namespaceIDs) {
+			next = cursor{Section: c.Section, NamespaceID: namespaceIDs[i+1]}
+		}
+	}
+	return next, fn(c.Section, records, next)
+}
+
+// listNamespaceIDs returns the sorted IDs of all namespaces, including deleted ones.
+func (s *scanner) listNamespaceIDs(ctx context.Context) ([]string, error) {
+	ids := []string{}
+	var pageToken []byte
+	for {
+		resp, err := s.stores.metadata.ListNamespaces(ctx, &persistence.ListNamespacesRequest{
+			PageSize:       s.pageSize,
+			NextPageToken:  pageToken,
+			IncludeDeleted: true,
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, ns := range resp.Namespaces {
+			ids = append(ids, ns.Namespace.GetInfo().GetId())
+		}
+		if len(resp.NextPageToken) == 0 {
+			slices.Sort(ids)
+			return ids, nil
+		}
+		pageToken = resp.NextPageToken
+	}
+}
@@ -0,0 +1,130 @@
+package migration
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/urfave/cli"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/headers"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/persistence"
+	persistenceClient "go.temporal.io/server/common/persistence/client"
+	"go.temporal.io/server/common/persistence/serialization"
+	"go.temporal.io/server/common/quotas"
+	"go.temporal.io/server/common/resolver"
+	"go.temporal.io/server/common/shardmap"
+	"go.temporal.io/server/common/telemetry"
+)
+
+// stores are the persistence managers of the datastore of a cluster, created from its server config.
+type stores struct {
+	clusterName     string
+	shardCount      int32
+	metadata        persistence.MetadataManager
+	clusterMetadata persistence.ClusterMetadataManager
+	shard           persistence.ShardManager
+	execution       persistence.ExecutionManager
+	task            persistence.TaskManager
+	factory         persistenceClient.Factory
+}
+
+func newStoresFromCLI(c *cli.Context, logger log.Logger) (*stores, error) {
+	cfg, err := config.LoadConfig(c.GlobalString(flagEnv), c.GlobalString(flagConfigDir), c.GlobalString(flagZone))
+	if err != nil {
+		return nil, fmt.Errorf("unable to load server config: %w", err)
+	}
+	return newStores(&cfg.Persistence, cfg.ClusterMetadata.CurrentClusterName, logger)
+}
+
+func newStores(cfg *config.Persistence, clusterName string, logger log.Logger) (*stores, error) {
+	dataStoreFactory := persistenceClient.DataStoreFactoryProvider(
+		persistenceClient.ClusterName(clusterName),
+		resolver.NewNoopResolver(),
+		cfg,
+		nil,
+		dynamicconfig.NewNoopCollection(),
+		logger,
+		metrics.NoopMetricsHandler,
+		telemetry.NoopTracerProvider,
+	)
+	factory := persistenceClient.NewFactory(
+		dataStoreFactory,
+		cfg,
+		nil,
+		quotas.NoopRequestRateLimiter,
+		quotas.NoopRequestRateLimiter,
+		serialization.NewSerializer(),
+		nil,
+		clusterName,
+		metrics.NoopMetricsHandler,
+		logger,
+		persistence.NoopHealthSignalAggregator,
+	)
+
+	s := &stores{
+		clusterName: clusterName,
+		factory:     factory,
+	}
+	var err error
+	if s.metadata, err = factory.NewMetadataManager(); err != nil {
+		factory.Close()
+		return nil, err
+	}
+	if s.clusterMetadata, err = factory.NewClusterMetadataManager(); err != nil {
+		factory.Close()
+		return nil, err
+	}
+	if s.shardCount, err = loadShardCount(s.clusterMetadata, cfg.NumHistoryShards); err != nil {
+		factory.Close()
+		return nil, err
+	}
+	if s.shard, err = factory.NewShardManager(); err != nil {
+		factory.Close()
+		return nil, err
+	}
+	if s.execution, err = factory.NewExecutionManager(); err != nil {
+		factory.Close()
+		return nil, err
+	}
+	if s.task, err = factory.NewTaskManager(); err != nil {
+		factory.Close()
+		return nil, err
+	}
+	return s, nil
+}
+
+// loadShardCount returns the shard count of the shard map of the cluster, which is greater than the shard count of the
+// config once the shards of the cluster were split. Workflows can't be routed to their shard while a split is in
+// progress, the shards are moved by the history hosts.
+func loadShardCount(clusterMetadata persistence.ClusterMetadataManager, configShardCount int32) (int32, error) {
+	shardMap, _, err := shardmap.Load(newCallerContext(context.Background()), clusterMetadata)
+	switch err.(type) {
+	case nil:
+	case *serviceerror.NotFound:
+		// the cluster metadata is created when the server is started for the first time
+		return configShardCount, nil
+	default:
+		return 0, fmt.Errorf("unable to load shard map: %w", err)
+	}
+	if shardMap.IsSplitting() {
+		return 0, fmt.Errorf("shards are being split into %d shards, retry once the split is finished", shardMap.TotalShardCount())
+	}
+	return shardMap.ShardCount, nil
+}
+
+func (s *stores) close() {
+	s.metadata.Close()
+	s.clusterMetadata.Close()
+	s.shard.Close()
+	s.execution.Close()
+	s.task.Close()
+	s.factory.Close()
+}
+
+func newCallerContext(ctx context.Context) context.Context {
+	return headers.SetCallerInfo(ctx, headers.SystemBackgroundCallerInfo)
+}
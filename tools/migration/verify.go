@@ -0,0 +1,86 @@
+package migration
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+)
+
+// verifyArchive checks that the records of a complete archive match the counts and checksums of its manifest.
+func verifyArchive(dir string, logger log.Logger) (*manifest, error) {
+	m, err := readManifest(dir)
+	if err != nil {
+		return nil, err
+	}
+	if !m.Complete {
+		return nil, fmt.Errorf("the archive in %s is incomplete", dir)
+	}
+
+	digests := make(map[section]digest, len(sections))
+	for _, s := range sections {
+		d := &digester{}
+		if err := readRecords(dir, s, func(record []byte) error {
+			d.add(record)
+			return nil
+		}); err != nil {
+			return nil, err
+		}
+		digests[s] = d.digest()
+	}
+	return m, compareDigests("archive", m.Sections, digests, logger)
+}
+
+// verifyDatastore reads the data of the datastore like an export does and checks that it matches the counts and
+// checksums of the archive.
+func verifyDatastore(ctx context.Context, st *stores, m *manifest, pageSize int, logger log.Logger) error {
+	if m.HistoryShardCount != st.shardCount {
+		return fmt.Errorf("the archive has %d history shards but the datastore is configured with %d",
+			m.HistoryShardCount, st.shardCount)
+	}
+
+	digesters := make(map[section]*digester, len(sections))
+	for _, s := range sections {
+		digesters[s] = &digester{}
+	}
+	sc := &scanner{stores: st, pageSize: pageSize, logger: logger}
+	err := sc.scan(ctx, cursor{Section: sections[0]}, func(s section, records []any, _ cursor) error {
+		for _, record := range records {
+			line, err := json.Marshal(record)
+			if err != nil {
+				return err
+			}
+			digesters[s].add(line)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	digests := make(map[section]digest, len(sections))
+	for _, s := range sections {
+		digests[s] = digesters[s].digest()
+	}
+	return compareDigests("datastore", m.Sections, digests, logger)
+}
+
+func compareDigests(source string, expected map[section]digest, actual map[section]digest, logger log.Logger) error {
+	var errs []error
+	for _, s := range sections {
+		if expected[s] == actual[s] {
+			logger.Info("Section matches the manifest.",
+				tag.NewStringTag("source", source),
+				tag.NewStringTag("section", string(s)),
+				tag.NewInt64("records", actual[s].Count),
+			)
+			continue
+		}
+		errs = append(errs, fmt.Errorf("%s of the %s don't match the manifest: expected %v, got %v",
+			s, source, expected[s], actual[s]))
+	}
+	return errors.Join(errs...)
+}
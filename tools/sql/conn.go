@@ -6,6 +6,8 @@ import (
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/persistence/sql"
 	"go.temporal.io/server/common/persistence/sql/sqlplugin"
+	"go.temporal.io/server/common/persistence/sql/sqlplugin/postgresql"
+	"go.temporal.io/server/common/persistence/sql/sqlplugin/sqlite"
 	"go.temporal.io/server/common/resolver"
 	"go.temporal.io/server/tools/common/schema"
 )
@@ -13,14 +15,15 @@ import (
 type (
 	// Connection is the connection to database
 	Connection struct {
-		dbName  string
-		adminDb sqlplugin.AdminDB
+		dbName     string
+		pluginName string
+		adminDb    sqlplugin.AdminDB
 	}
 )
 
 const dbType = "sql"
 
-var _ schema.DB = (*Connection)(nil)
+var _ schema.InspectableDB = (*Connection)(nil)
 
 // NewConnection creates a new connection to database
 func NewConnection(cfg *config.SQL, logger log.Logger) (*Connection, error) {
@@ -30,8 +33,9 @@ func NewConnection(cfg *config.SQL, logger log.Logger) (*Connection, error) {
 	}
 
 	return &Connection{
-		adminDb: db,
-		dbName:  cfg.DatabaseName,
+		adminDb:    db,
+		dbName:     cfg.DatabaseName,
+		pluginName: cfg.PluginName,
 	}, nil
 }
 
@@ -65,6 +69,43 @@ func (c *Connection) ListTables() ([]string, error) {
 	return c.adminDb.ListTables(c.dbName)
 }
 
+// Dialect returns the dialect of the plugin of the connection
+func (c *Connection) Dialect() *schema.Dialect {
+	switch c.pluginName {
+	case postgresql.PluginName, postgresql.PluginNamePGX:
+		return schema.PostgreSQLDialect
+	case sqlite.PluginName:
+		return schema.SQLiteDialect
+	default:
+		return schema.MySQLDialect
+	}
+}
+
+// InspectSchema returns the columns and indexes of the tables of this database
+func (c *Connection) InspectSchema() (*schema.Schema, error) {
+	columns, err := c.adminDb.ListColumns(c.dbName)
+	if err != nil {
+		return nil, err
+	}
+	indexes, err := c.adminDb.ListIndexes(c.dbName)
+	if err != nil {
+		return nil, err
+	}
+
+	s := schema.NewSchema()
+	for _, col := range columns {
+		definition := col.ColumnType + " NOT NULL"
+		if col.Nullable {
+			definition = col.ColumnType + " NULL"
+		}
+		s.AddColumn(col.TableName, col.ColumnName, definition)
+	}
+	for _, idx := range indexes {
+		s.AddIndex(idx.TableName, idx.IndexName, idx.Definition)
+	}
+	return s, nil
+}
+
 // DropTable drops a given table from the database
 func (c *Connection) DropTable(name string) error {
 	return c.adminDb.DropTable(name)
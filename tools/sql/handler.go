@@ -56,6 +56,27 @@ func updateSchema(cli *cli.Context, logger log.Logger) error {
 	return nil
 }
 
+// verifySchema compares the schema of the database with the versioned schema
+// of its current version
+func verifySchema(cli *cli.Context, logger log.Logger) error {
+	cfg, err := parseConnectConfig(cli)
+	if err != nil {
+		logger.Error("Unable to read config.", tag.Error(schema.NewConfigError(err.Error())))
+		return err
+	}
+	conn, err := NewConnection(cfg, logger)
+	if err != nil {
+		logger.Error("Unable to connect to SQL database.", tag.Error(err))
+		return err
+	}
+	defer conn.Close()
+	if err := schema.Verify(cli, conn, cfg.DatabaseName, logger); err != nil {
+		logger.Error("Unable to verify SQL schema.", tag.Error(err))
+		return err
+	}
+	return nil
+}
+
 // createDatabase creates a sql database
 func createDatabase(cli *cli.Context, logger log.Logger) error {
 	cfg, err := parseConnectConfig(cli)
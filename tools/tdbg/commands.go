@@ -16,13 +16,13 @@ import (
 	enumsspb "go.temporal.io/server/api/enums/v1"
 	historyspb "go.temporal.io/server/api/history/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
-	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/codec"
 	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/persistence/serialization"
 	"go.temporal.io/server/common/persistence/versionhistory"
 	"go.temporal.io/server/common/primitives"
 	"go.temporal.io/server/common/primitives/timestamp"
+	"go.temporal.io/server/common/shardmap"
 	"go.temporal.io/server/service/history/tasks"
 	"google.golang.org/protobuf/encoding/prototext"
 	"google.golang.org/protobuf/types/known/durationpb"
@@ -351,7 +351,7 @@ func AdminDeleteWorkflow(c *cli.Context, clientFactory ClientFactory, prompter *
 }
 
 // AdminGetShardID get shardID
-func AdminGetShardID(c *cli.Context) error {
+func AdminGetShardID(c *cli.Context, clientFactory ClientFactory) error {
 	namespaceID := c.String(FlagNamespaceID)
 	wid, err := getRequiredOption(c, FlagWorkflowID)
 	if err != nil {
@@ -359,11 +359,27 @@ func AdminGetShardID(c *cli.Context) error {
 	}
 	numberOfShards := int32(c.Int(FlagNumberOfShards))
 
+	shardMap := shardmap.NewShardMap(numberOfShards)
 	if numberOfShards <= 0 {
-		return fmt.Errorf("missing required parameter number of Shards")
+		// The shard count of the cluster changes when its shards are split, read its current shard map.
+		ctx, cancel := newContext(c)
+		defer cancel()
+		resp, err := clientFactory.AdminClient(c).DescribeCluster(ctx, &adminservice.DescribeClusterRequest{})
+		if err != nil {
+			return fmt.Errorf("unable to describe cluster: %w", err)
+		}
+		shardMap = shardmap.NewShardMap(resp.GetHistoryShardCount())
+		if data, ok := resp.GetTags()[shardmap.ClusterMetadataTagKey]; ok {
+			if shardMap, err = shardmap.Decode(data); err != nil {
+				return err
+			}
+		}
 	}
-	shardID := common.WorkflowIDToHistoryShard(namespaceID, wid, numberOfShards)
+	shardID := shardMap.WorkflowIDToHistoryShard(namespaceID, wid)
 	fmt.Fprintf(c.App.Writer, "ShardId for namespace, workflowId: %v, %v is %v \n", namespaceID, wid, shardID)
+	if childShardID := shardMap.WorkflowIDToChildShard(namespaceID, wid); childShardID != shardID {
+		fmt.Fprintf(c.App.Writer, "The shard is being split, the workflow is in shard %v if it was already moved\n", childShardID)
+	}
 	return nil
 }
 
@@ -688,6 +704,161 @@ func AdminRebuildMutableState(c *cli.Context, clientFactory ClientFactory) error
 	return nil
 }
 
+// AdminPauseWorkflow pauses, or unpauses, a workflow, or starts a batch operation pausing the workflows selected by a query
+func AdminPauseWorkflow(c *cli.Context, clientFactory ClientFactory, unpause bool) error {
+	adminClient := clientFactory.AdminClient(c)
+
+	namespace, err := getRequiredOption(c, FlagNamespace)
+	if err != nil {
+		return err
+	}
+	operation := "pause"
+	if unpause {
+		operation = "unpause"
+	}
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	if c.IsSet(FlagQuery) {
+		if c.IsSet(FlagWorkflowID) {
+			return fmt.Errorf("--%s and --%s are mutually exclusive", FlagQuery, FlagWorkflowID)
+		}
+		jobID, err := getRequiredOption(c, FlagJobID)
+		if err != nil {
+			return err
+		}
+		reason, err := getRequiredOption(c, FlagReason)
+		if err != nil {
+			return err
+		}
+		_, err = adminClient.BatchPauseWorkflowExecutions(ctx, &adminservice.BatchPauseWorkflowExecutionsRequest{
+			Namespace:              namespace,
+			JobId:                  jobID,
+			VisibilityQuery:        c.String(FlagQuery),
+			Reason:                 reason,
+			Unpause:                unpause,
+			MaxOperationsPerSecond: float32(c.Float64(FlagRPS)),
+		})
+		if err != nil {
+			return fmt.Errorf("unable to start batch %s: %w", operation, err)
+		}
+		fmt.Fprintf(c.App.Writer, "Batch %s started with job ID %s.\n", operation, jobID)
+		return nil
+	}
+
+	wid, err := getRequiredOption(c, FlagWorkflowID)
+	if err != nil {
+		return err
+	}
+	execution := &commonpb.WorkflowExecution{
+		WorkflowId: wid,
+		RunId:      c.String(FlagRunID),
+	}
+	if unpause {
+		_, err = adminClient.UnpauseWorkflowExecution(ctx, &adminservice.UnpauseWorkflowExecutionRequest{
+			Namespace: namespace,
+			Execution: execution,
+		})
+	} else {
+		_, err = adminClient.PauseWorkflowExecution(ctx, &adminservice.PauseWorkflowExecutionRequest{
+			Namespace: namespace,
+			Execution: execution,
+		})
+	}
+	if err != nil {
+		return fmt.Errorf("unable to %s workflow: %w", operation, err)
+	}
+	fmt.Fprintf(c.App.Writer, "Workflow %s succeeded.\n", operation)
+	return nil
+}
+
+// AdminForkWorkflow creates a new workflow from the history of a workflow up to an event
+func AdminForkWorkflow(c *cli.Context, clientFactory ClientFactory) error {
+	adminClient := clientFactory.AdminClient(c)
+
+	namespace, err := getRequiredOption(c, FlagNamespace)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	resp, err := adminClient.ForkWorkflowExecution(ctx, &adminservice.ForkWorkflowExecutionRequest{
+		Namespace:       namespace,
+		WorkflowId:      c.String(FlagNewWorkflowID),
+		SourceNamespace: c.String(FlagSourceNamespace),
+		SourceExecution: &commonpb.WorkflowExecution{
+			WorkflowId: c.String(FlagWorkflowID),
+			RunId:      c.String(FlagRunID),
+		},
+		ForkEventId: c.Int64(FlagEventID),
+		TaskQueue:   c.String(FlagTaskQueue),
+		Reason:      c.String(FlagReason),
+	})
+	if err != nil {
+		return fmt.Errorf("unable to fork workflow: %w", err)
+	}
+	fmt.Fprintf(c.App.Writer, "Workflow forked, run ID: %s\n", resp.GetRunId())
+	return nil
+}
+
+// AdminPreviewResetWorkflow shows what resetting a workflow would do, without resetting it
+func AdminPreviewResetWorkflow(c *cli.Context, clientFactory ClientFactory) error {
+	adminClient := clientFactory.AdminClient(c)
+
+	namespace, err := getRequiredOption(c, FlagNamespace)
+	if err != nil {
+		return err
+	}
+
+	var excludeTypes []enumspb.ResetReapplyExcludeType
+	for _, name := range c.StringSlice(FlagReapplyExclude) {
+		excludeType, err := enumspb.ResetReapplyExcludeTypeFromString(name)
+		if err != nil {
+			return fmt.Errorf("invalid reapply exclude type %q: %w", name, err)
+		}
+		excludeTypes = append(excludeTypes, excludeType)
+	}
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	resp, err := adminClient.PreviewResetWorkflowExecution(ctx, &adminservice.PreviewResetWorkflowExecutionRequest{
+		Namespace: namespace,
+		Execution: &commonpb.WorkflowExecution{
+			WorkflowId: c.String(FlagWorkflowID),
+			RunId:      c.String(FlagRunID),
+		},
+		WorkflowTaskFinishEventId: c.Int64(FlagEventID),
+		ResetReapplyExcludeTypes:  excludeTypes,
+	})
+	if err != nil {
+		return fmt.Errorf("unable to preview workflow reset: %w", err)
+	}
+
+	fmt.Fprintln(c.App.Writer, color.Green(c, "Reapplied events:"))
+	for _, event := range resp.GetReappliedEvents() {
+		fmt.Fprintf(c.App.Writer, "%d %s\n", event.GetEventId(), event.GetEventType())
+	}
+	fmt.Fprintln(c.App.Writer, color.Green(c, "Dropped events:"))
+	for _, event := range resp.GetDroppedEvents() {
+		fmt.Fprintf(c.App.Writer, "%d %s\n", event.GetEventId(), event.GetEventType())
+	}
+	fmt.Fprintln(c.App.Writer, color.Green(c, "Abandoned activities:"))
+	for _, activity := range resp.GetAbandonedActivities() {
+		fmt.Fprintf(c.App.Writer, "%s (scheduled event %d)\n", activity.GetActivityId(), activity.GetScheduledEventId())
+	}
+	fmt.Fprintln(c.App.Writer, color.Green(c, "Abandoned child workflows:"))
+	for _, child := range resp.GetAbandonedChildren() {
+		fmt.Fprintf(c.App.Writer, "%s (initiated event %d)\n", child.GetStartedWorkflowId(), child.GetInitiatedEventId())
+	}
+	fmt.Fprintln(c.App.Writer, color.Green(c, "Reset mutable state:"))
+	prettyPrintJSONObject(c, resp.GetResetMutableState())
+	return nil
+}
+
 // AdminReplicateWorkflow force replicates a workflow by generating replication tasks
 func AdminReplicateWorkflow(
 	c *cli.Context,
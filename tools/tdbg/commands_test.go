@@ -1,10 +1,15 @@
 package tdbg
 
 import (
+	"bytes"
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	enumspb "go.temporal.io/api/enums/v1"
+	historypb "go.temporal.io/api/history/v1"
+	"go.temporal.io/server/api/adminservice/v1"
 	"go.temporal.io/server/service/history/tasks"
+	"google.golang.org/protobuf/proto"
 )
 
 func TestGetCategory(t *testing.T) {
@@ -49,3 +54,116 @@ func TestGetCategory(t *testing.T) {
 		})
 	}
 }
+
+func TestAdminPauseWorkflow(t *testing.T) {
+	var pauseRequests []*adminservice.PauseWorkflowExecutionRequest
+	var unpauseRequests []*adminservice.UnpauseWorkflowExecutionRequest
+	var batchRequests []*adminservice.BatchPauseWorkflowExecutionsRequest
+	client := &testClient{
+		pauseWorkflowExecutionFn: func(request *adminservice.PauseWorkflowExecutionRequest) (*adminservice.PauseWorkflowExecutionResponse, error) {
+			pauseRequests = append(pauseRequests, proto.Clone(request).(*adminservice.PauseWorkflowExecutionRequest))
+			return &adminservice.PauseWorkflowExecutionResponse{}, nil
+		},
+		unpauseWorkflowExecutionFn: func(request *adminservice.UnpauseWorkflowExecutionRequest) (*adminservice.UnpauseWorkflowExecutionResponse, error) {
+			unpauseRequests = append(unpauseRequests, proto.Clone(request).(*adminservice.UnpauseWorkflowExecutionRequest))
+			return &adminservice.UnpauseWorkflowExecutionResponse{}, nil
+		},
+		batchPauseWorkflowExecutionsFn: func(request *adminservice.BatchPauseWorkflowExecutionsRequest) (*adminservice.BatchPauseWorkflowExecutionsResponse, error) {
+			batchRequests = append(batchRequests, proto.Clone(request).(*adminservice.BatchPauseWorkflowExecutionsRequest))
+			return &adminservice.BatchPauseWorkflowExecutionsResponse{}, nil
+		},
+	}
+	var out, errOut bytes.Buffer
+	app := NewCliApp(func(params *Params) {
+		params.ClientFactory = client
+		params.Writer = &out
+		params.ErrWriter = &errOut
+	})
+
+	err := app.Run([]string{"tdbg", "--namespace", "test-namespace", "workflow", "pause",
+		"--workflow-id", "wf-id", "--run-id", "run-id"})
+	require.NoError(t, err)
+	require.Len(t, pauseRequests, 1)
+	require.Equal(t, "test-namespace", pauseRequests[0].GetNamespace())
+	require.Equal(t, "wf-id", pauseRequests[0].GetExecution().GetWorkflowId())
+	require.Equal(t, "run-id", pauseRequests[0].GetExecution().GetRunId())
+
+	err = app.Run([]string{"tdbg", "--namespace", "test-namespace", "workflow", "unpause", "--workflow-id", "wf-id"})
+	require.NoError(t, err)
+	require.Len(t, unpauseRequests, 1)
+	require.Equal(t, "wf-id", unpauseRequests[0].GetExecution().GetWorkflowId())
+
+	err = app.Run([]string{"tdbg", "--namespace", "test-namespace", "workflow", "unpause",
+		"--query", "WorkflowType='test'", "--job-id", "job-id", "--reason", "test", "--rps", "10"})
+	require.NoError(t, err)
+	require.Len(t, batchRequests, 1)
+	require.Equal(t, "job-id", batchRequests[0].GetJobId())
+	require.Equal(t, "WorkflowType='test'", batchRequests[0].GetVisibilityQuery())
+	require.Equal(t, "test", batchRequests[0].GetReason())
+	require.True(t, batchRequests[0].GetUnpause())
+	require.EqualValues(t, 10, batchRequests[0].GetMaxOperationsPerSecond())
+
+	err = app.Run([]string{"tdbg", "--namespace", "test-namespace", "workflow", "pause",
+		"--query", "WorkflowType='test'", "--job-id", "job-id"})
+	require.ErrorContains(t, err, FlagReason)
+	require.Len(t, batchRequests, 1)
+}
+
+func TestAdminForkWorkflow(t *testing.T) {
+	var requests []*adminservice.ForkWorkflowExecutionRequest
+	client := &testClient{
+		forkWorkflowExecutionFn: func(request *adminservice.ForkWorkflowExecutionRequest) (*adminservice.ForkWorkflowExecutionResponse, error) {
+			requests = append(requests, proto.Clone(request).(*adminservice.ForkWorkflowExecutionRequest))
+			return &adminservice.ForkWorkflowExecutionResponse{RunId: "fork-run-id"}, nil
+		},
+	}
+	var out, errOut bytes.Buffer
+	app := NewCliApp(func(params *Params) {
+		params.ClientFactory = client
+		params.Writer = &out
+		params.ErrWriter = &errOut
+	})
+
+	err := app.Run([]string{"tdbg", "--namespace", "test-namespace", "workflow", "fork",
+		"--workflow-id", "wf-id", "--run-id", "run-id", "--event-id", "5", "--new-workflow-id", "fork-wf-id",
+		"--task-queue", "fork-tq", "--source-namespace", "source-namespace"})
+	require.NoError(t, err)
+	require.Len(t, requests, 1)
+	require.Equal(t, "test-namespace", requests[0].GetNamespace())
+	require.Equal(t, "fork-wf-id", requests[0].GetWorkflowId())
+	require.Equal(t, "source-namespace", requests[0].GetSourceNamespace())
+	require.Equal(t, "wf-id", requests[0].GetSourceExecution().GetWorkflowId())
+	require.Equal(t, "run-id", requests[0].GetSourceExecution().GetRunId())
+	require.EqualValues(t, 5, requests[0].GetForkEventId())
+	require.Equal(t, "fork-tq", requests[0].GetTaskQueue())
+	require.Contains(t, out.String(), "run ID: fork-run-id")
+}
+
+func TestAdminPreviewResetWorkflow(t *testing.T) {
+	var requests []*adminservice.PreviewResetWorkflowExecutionRequest
+	client := &testClient{
+		previewResetWorkflowFn: func(request *adminservice.PreviewResetWorkflowExecutionRequest) (*adminservice.PreviewResetWorkflowExecutionResponse, error) {
+			requests = append(requests, proto.Clone(request).(*adminservice.PreviewResetWorkflowExecutionRequest))
+			return &adminservice.PreviewResetWorkflowExecutionResponse{
+				DroppedEvents: []*historypb.HistoryEvent{{EventId: 7, EventType: enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_SIGNALED}},
+			}, nil
+		},
+	}
+	var out, errOut bytes.Buffer
+	app := NewCliApp(func(params *Params) {
+		params.ClientFactory = client
+		params.Writer = &out
+		params.ErrWriter = &errOut
+	})
+
+	err := app.Run([]string{"tdbg", "--namespace", "test-namespace", "workflow", "reset-preview",
+		"--workflow-id", "wf-id", "--run-id", "run-id", "--event-id", "4", "--reapply-exclude", "Signal"})
+	require.NoError(t, err)
+	require.Len(t, requests, 1)
+	require.Equal(t, "test-namespace", requests[0].GetNamespace())
+	require.Equal(t, "wf-id", requests[0].GetExecution().GetWorkflowId())
+	require.Equal(t, "run-id", requests[0].GetExecution().GetRunId())
+	require.EqualValues(t, 4, requests[0].GetWorkflowTaskFinishEventId())
+	require.Equal(t, []enumspb.ResetReapplyExcludeType{enumspb.RESET_REAPPLY_EXCLUDE_TYPE_SIGNAL}, requests[0].GetResetReapplyExcludeTypes())
+	require.Contains(t, out.String(), "7 WorkflowExecutionSignaled")
+}
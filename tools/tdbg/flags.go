@@ -68,4 +68,26 @@ var (
 	FlagBuildIDs                   = "select-build-id"
 	FlagUnversioned                = "select-unversioned"
 	FlagAllActive                  = "select-all-active"
+	FlagRPS                        = "rps"
+	FlagSearchAttribute            = "search-attribute"
+	FlagSearchAttributeType        = "search-attribute-type"
+	FlagNewFieldName               = "new-field-name"
+	FlagQuery                      = "query"
+	FlagIncludePlan                = "include-plan"
+	FlagDuration                   = "duration"
+	FlagWorkflowIDPrefix           = "workflow-id-prefix"
+	FlagMinTaskAge                 = "min-task-age"
+	FlagBuildID                    = "build-id"
+	FlagPartitions                 = "partitions"
+	FlagDestinationTaskQueue       = "destination-task-queue"
+	FlagSourceBuildID              = "source-build-id"
+	FlagSourceVersion              = "source-version"
+	FlagCopy                       = "copy"
+	FlagDryRun                     = "dry-run"
+	FlagWorkerLimits               = "limits"
+	FlagJobID                      = "job-id"
+	FlagEventID                    = "event-id"
+	FlagNewWorkflowID              = "new-workflow-id"
+	FlagSourceNamespace            = "source-namespace"
+	FlagReapplyExclude             = "reapply-exclude"
 )
@@ -0,0 +1,38 @@
+package tdbg
+
+import (
+	"fmt"
+
+	"github.com/urfave/cli/v2"
+	"go.temporal.io/server/api/adminservice/v1"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// AdminAdvanceNamespaceClock advances the virtual clock of a test namespace. The history service refreshes the tasks
+// of the running workflows of the namespace so that their timers fire by the new virtual time.
+func AdminAdvanceNamespaceClock(c *cli.Context, clientFactory ClientFactory) error {
+	adminClient := clientFactory.AdminClient(c)
+
+	nsName, err := getRequiredOption(c, FlagNamespace)
+	if err != nil {
+		return err
+	}
+	duration := c.Duration(FlagDuration)
+	if duration <= 0 {
+		return fmt.Errorf("option %s must be a positive duration", FlagDuration)
+	}
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	clockResponse, err := adminClient.AdvanceNamespaceClock(ctx, &adminservice.AdvanceNamespaceClockRequest{
+		Namespace: nsName,
+		Duration:  durationpb.New(duration),
+	})
+	if err != nil {
+		return fmt.Errorf("unable to advance virtual clock: %s", err)
+	}
+	fmt.Fprintf(c.App.Writer, "Virtual clock of namespace %s advanced to %s ahead of the real clock.\n",
+		nsName, clockResponse.GetOffset().AsDuration())
+	return nil
+}
@@ -3,12 +3,30 @@ package tdbg
 import (
 	"errors"
 	"fmt"
+	"math"
+	"strings"
+	"time"
 
 	"github.com/urfave/cli/v2"
 	enumspb "go.temporal.io/api/enums/v1"
 	taskqueuepb "go.temporal.io/api/taskqueue/v1"
 	"go.temporal.io/server/api/adminservice/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
 	taskqueuespb "go.temporal.io/server/api/taskqueue/v1"
+	"go.temporal.io/server/common/tqid"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+type (
+	// taskQueueTaskFilter selects backlog tasks by workflow ID prefix, age and build ID.
+	// Empty fields select all tasks.
+	taskQueueTaskFilter struct {
+		workflowIDPrefix string
+		minAge           time.Duration
+		buildID          string
+		now              time.Time
+	}
 )
 
 // AdminListTaskQueueTasks displays task information
@@ -245,3 +263,277 @@ func AdminForceUnloadTaskQueuePartition(c *cli.Context, clientFactory ClientFact
 	}
 	return nil
 }
+
+// AdminFindTaskQueueTasks scans the backlog of the partitions of a task queue and reports the tasks selected by the
+// filters, e.g. to find the tasks to re-route after a task queue was misconfigured or a worker fleet was retired
+func AdminFindTaskQueueTasks(c *cli.Context, clientFactory ClientFactory) error {
+	namespace, err := getRequiredOption(c, FlagNamespace)
+	if err != nil {
+		return err
+	}
+	tqName, err := getRequiredOption(c, FlagTaskQueue)
+	if err != nil {
+		return err
+	}
+	tlTypeInt, err := StringToEnum(c.String(FlagTaskQueueType), enumspb.TaskQueueType_value)
+	if err != nil {
+		return fmt.Errorf("invalid task queue type: %w", err)
+	}
+	tqType := enumspb.TaskQueueType(tlTypeInt)
+	if tqType == enumspb.TASK_QUEUE_TYPE_UNSPECIFIED {
+		return errors.New("invalid task queue type") // nolint
+	}
+	partitions := c.Int(FlagPartitions)
+	if partitions < 1 {
+		return fmt.Errorf("invalid number of partitions: %d", partitions)
+	}
+	filter := taskQueueTaskFilter{
+		workflowIDPrefix: c.String(FlagWorkflowIDPrefix),
+		minAge:           c.Duration(FlagMinTaskAge),
+		buildID:          c.String(FlagBuildID),
+		now:              time.Now(),
+	}
+
+	client := clientFactory.AdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	taskQueue := tqid.UnsafeTaskQueueFamily("", tqName).TaskQueue(tqType)
+	var totalScanned, totalSelected int
+	var selectedTasks []*persistencespb.AllocatedTaskInfo
+	for partitionID := range partitions {
+		partitionName := taskQueue.NormalPartition(partitionID).RpcName()
+		req := &adminservice.GetTaskQueueTasksRequest{
+			Namespace:     namespace,
+			TaskQueue:     partitionName,
+			TaskQueueType: tqType,
+			MinTaskId:     c.Int64(FlagMinTaskID),
+			MaxTaskId:     math.MaxInt64,
+			BatchSize:     int32(c.Int(FlagPageSize)),
+			Subqueue:      int32(c.Int(FlagSubqueue)),
+		}
+		var scanned, selected int
+		for {
+			resp, err := client.GetTaskQueueTasks(ctx, req)
+			if err != nil {
+				return fmt.Errorf("unable to get tasks of task queue partition %s: %w", partitionName, err)
+			}
+			for _, task := range resp.Tasks {
+				scanned++
+				if filter.matches(task) {
+					selected++
+					selectedTasks = append(selectedTasks, task)
+				}
+			}
+			if len(resp.NextPageToken) == 0 {
+				break
+			}
+			req.NextPageToken = resp.NextPageToken
+		}
+		totalScanned += scanned
+		totalSelected += selected
+		fmt.Fprintf(c.App.ErrWriter, "Partition %s: scanned %d tasks, selected %d\n", partitionName, scanned, selected)
+	}
+
+	if c.Bool(FlagPrintJSON) {
+		prettyPrintJSONObject(c, selectedTasks)
+	}
+	fmt.Fprintf(c.App.Writer, "Scanned %d tasks in %d partitions, selected %d\n", totalScanned, partitions, totalSelected)
+	return nil
+}
+
+// AdminMoveTaskQueueTasks moves the backlog tasks of the partitions of a task queue which are selected by the filters
+// to another task queue, page by page, and reports the progress per partition
+func AdminMoveTaskQueueTasks(c *cli.Context, clientFactory ClientFactory) error {
+	namespace, err := getRequiredOption(c, FlagNamespace)
+	if err != nil {
+		return err
+	}
+	tqName, err := getRequiredOption(c, FlagTaskQueue)
+	if err != nil {
+		return err
+	}
+	destination, err := getRequiredOption(c, FlagDestinationTaskQueue)
+	if err != nil {
+		return err
+	}
+	tlTypeInt, err := StringToEnum(c.String(FlagTaskQueueType), enumspb.TaskQueueType_value)
+	if err != nil {
+		return fmt.Errorf("invalid task queue type: %w", err)
+	}
+	tqType := enumspb.TaskQueueType(tlTypeInt)
+	if tqType == enumspb.TASK_QUEUE_TYPE_UNSPECIFIED {
+		return errors.New("invalid task queue type") // nolint
+	}
+	partitions := c.Int(FlagPartitions)
+	if partitions < 1 {
+		return fmt.Errorf("invalid number of partitions: %d", partitions)
+	}
+	var minTaskAge *durationpb.Duration
+	if c.IsSet(FlagMinTaskAge) {
+		minTaskAge = durationpb.New(c.Duration(FlagMinTaskAge))
+	}
+
+	client := clientFactory.AdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	dryRun := c.Bool(FlagDryRun)
+	var totalScanned, totalSelected, totalMoved int64
+	for partitionID := range partitions {
+		req := &adminservice.MoveTaskQueueTasksRequest{
+			Namespace: namespace,
+			SourcePartition: &taskqueuespb.TaskQueuePartition{
+				TaskQueue:     tqName,
+				TaskQueueType: tqType,
+				PartitionId:   &taskqueuespb.TaskQueuePartition_NormalPartitionId{NormalPartitionId: int32(partitionID)},
+			},
+			DestinationTaskQueue:    destination,
+			Subqueue:                int32(c.Int(FlagSubqueue)),
+			WorkflowIdPrefix:        c.String(FlagWorkflowIDPrefix),
+			MinTaskAge:              minTaskAge,
+			BuildId:                 c.String(FlagBuildID),
+			Copy:                    c.Bool(FlagCopy),
+			DryRun:                  dryRun,
+			BatchSize:               int32(c.Int(FlagPageSize)),
+			SourceBuildId:           c.String(FlagSourceBuildID),
+			SourceDeploymentVersion: c.String(FlagSourceVersion),
+		}
+		var scanned, selected, moved int64
+		for {
+			resp, err := client.MoveTaskQueueTasks(ctx, req)
+			if err != nil {
+				return fmt.Errorf("unable to move tasks of task queue partition %d after moving %d tasks: %w", partitionID, moved, err)
+			}
+			scanned += resp.ScannedCount
+			selected += resp.SelectedCount
+			moved += resp.MovedCount
+			if len(resp.NextPageToken) == 0 {
+				break
+			}
+			req.NextPageToken = resp.NextPageToken
+			fmt.Fprintf(c.App.ErrWriter, "Partition %d: scanned %d tasks, selected %d, moved %d so far\n", partitionID, scanned, selected, moved)
+		}
+		totalScanned += scanned
+		totalSelected += selected
+		totalMoved += moved
+		fmt.Fprintf(c.App.ErrWriter, "Partition %d: scanned %d tasks, selected %d, moved %d\n", partitionID, scanned, selected, moved)
+	}
+
+	if dryRun {
+		fmt.Fprintf(c.App.Writer, "Dry run: scanned %d tasks in %d partitions, selected %d\n", totalScanned, partitions, totalSelected)
+		return nil
+	}
+	fmt.Fprintf(c.App.Writer, "Scanned %d tasks in %d partitions, selected %d, moved %d to %s\n", totalScanned, partitions, totalSelected, totalMoved, destination)
+	return nil
+}
+
+// AdminSetWorkerDispatchLimits replaces the per-worker dispatch limits of a task queue type
+func AdminSetWorkerDispatchLimits(c *cli.Context, clientFactory ClientFactory) error {
+	namespace, err := getRequiredOption(c, FlagNamespace)
+	if err != nil {
+		return err
+	}
+	tqName, err := getRequiredOption(c, FlagTaskQueue)
+	if err != nil {
+		return err
+	}
+	tqType, err := parseWorkerLimitsTaskQueueType(c)
+	if err != nil {
+		return err
+	}
+	limitsJSON, err := getRequiredOption(c, FlagWorkerLimits)
+	if err != nil {
+		return err
+	}
+	limits := &persistencespb.WorkerDispatchLimits{}
+	if err := protojson.Unmarshal([]byte(limitsJSON), limits); err != nil {
+		return fmt.Errorf("invalid worker limits: %w", err)
+	}
+
+	client := clientFactory.AdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+	_, err = client.UpdateWorkerDispatchLimits(ctx, &adminservice.UpdateWorkerDispatchLimitsRequest{
+		Namespace:     namespace,
+		TaskQueue:     tqName,
+		TaskQueueType: tqType,
+		Limits:        limits,
+	})
+	if err != nil {
+		return fmt.Errorf("unable to set worker limits: %w", err)
+	}
+	fmt.Fprintf(c.App.Writer, "Set the worker limits of %s task queue %s\n", c.String(FlagTaskQueueType), tqName)
+	return nil
+}
+
+// AdminDescribeWorkerDispatchLimits prints the per-worker dispatch limits of a task queue type stored in the task
+// queue user data
+func AdminDescribeWorkerDispatchLimits(c *cli.Context, clientFactory ClientFactory) error {
+	namespace, err := getRequiredOption(c, FlagNamespace)
+	if err != nil {
+		return err
+	}
+	tqName, err := getRequiredOption(c, FlagTaskQueue)
+	if err != nil {
+		return err
+	}
+	tqType, err := parseWorkerLimitsTaskQueueType(c)
+	if err != nil {
+		return err
+	}
+
+	client := clientFactory.AdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+	resp, err := client.DescribeWorkerDispatchLimits(ctx, &adminservice.DescribeWorkerDispatchLimitsRequest{
+		Namespace:     namespace,
+		TaskQueue:     tqName,
+		TaskQueueType: tqType,
+	})
+	if err != nil {
+		return fmt.Errorf("unable to describe worker limits: %w", err)
+	}
+	if resp.GetLimits() == nil {
+		fmt.Fprintf(c.App.Writer, "No worker limits set on %s task queue %s, dynamic config limits apply\n", c.String(FlagTaskQueueType), tqName)
+		return nil
+	}
+	prettyPrintJSONObject(c, resp.GetLimits())
+	return nil
+}
+
+func parseWorkerLimitsTaskQueueType(c *cli.Context) (enumspb.TaskQueueType, error) {
+	tlTypeInt, err := StringToEnum(c.String(FlagTaskQueueType), enumspb.TaskQueueType_value)
+	if err != nil {
+		return enumspb.TASK_QUEUE_TYPE_UNSPECIFIED, fmt.Errorf("invalid task queue type: %w", err)
+	}
+	tqType := enumspb.TaskQueueType(tlTypeInt)
+	if tqType != enumspb.TASK_QUEUE_TYPE_WORKFLOW && tqType != enumspb.TASK_QUEUE_TYPE_ACTIVITY {
+		return enumspb.TASK_QUEUE_TYPE_UNSPECIFIED, errors.New("invalid task queue type, must be activity or workflow") // nolint
+	}
+	return tqType, nil
+}
+
+func (f taskQueueTaskFilter) matches(task *persistencespb.AllocatedTaskInfo) bool {
+	data := task.GetData()
+	if !strings.HasPrefix(data.GetWorkflowId(), f.workflowIDPrefix) {
+		return false
+	}
+	if f.minAge > 0 && f.now.Sub(data.GetCreateTime().AsTime()) < f.minAge {
+		return false
+	}
+	if f.buildID != "" && taskBuildID(data.GetVersionDirective()) != f.buildID {
+		return false
+	}
+	return true
+}
+
+func taskBuildID(directive *taskqueuespb.TaskVersionDirective) string {
+	if buildID := directive.GetAssignedBuildId(); buildID != "" {
+		return buildID
+	}
+	if buildID := directive.GetDeploymentVersion().GetBuildId(); buildID != "" {
+		return buildID
+	}
+	return directive.GetDeployment().GetBuildId()
+}
@@ -1,17 +1,24 @@
 package tdbg
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 	"github.com/urfave/cli/v2"
+	enumspb "go.temporal.io/api/enums/v1"
 	"go.temporal.io/api/workflowservice/v1"
 	"go.temporal.io/server/api/adminservice/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	taskqueuespb "go.temporal.io/server/api/taskqueue/v1"
 	"go.uber.org/mock/gomock"
 	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type (
@@ -19,6 +26,19 @@ type (
 		adminservice.AdminServiceClient
 		describeTaskQueuePartitionFn    func(request *adminservice.DescribeTaskQueuePartitionRequest) (*adminservice.DescribeTaskQueuePartitionResponse, error)
 		forceUnloadTaskQueuePartitionFn func(request *adminservice.ForceUnloadTaskQueuePartitionRequest) (*adminservice.ForceUnloadTaskQueuePartitionResponse, error)
+		getTaskQueueTasksFn             func(request *adminservice.GetTaskQueueTasksRequest) (*adminservice.GetTaskQueueTasksResponse, error)
+		moveTaskQueueTasksFn            func(request *adminservice.MoveTaskQueueTasksRequest) (*adminservice.MoveTaskQueueTasksResponse, error)
+		changeSearchAttributeTypeFn     func(request *adminservice.ChangeSearchAttributeTypeRequest) (*adminservice.ChangeSearchAttributeTypeResponse, error)
+		pauseWorkflowExecutionFn        func(request *adminservice.PauseWorkflowExecutionRequest) (*adminservice.PauseWorkflowExecutionResponse, error)
+		unpauseWorkflowExecutionFn      func(request *adminservice.UnpauseWorkflowExecutionRequest) (*adminservice.UnpauseWorkflowExecutionResponse, error)
+		batchPauseWorkflowExecutionsFn  func(request *adminservice.BatchPauseWorkflowExecutionsRequest) (*adminservice.BatchPauseWorkflowExecutionsResponse, error)
+		forkWorkflowExecutionFn         func(request *adminservice.ForkWorkflowExecutionRequest) (*adminservice.ForkWorkflowExecutionResponse, error)
+		previewResetWorkflowFn          func(request *adminservice.PreviewResetWorkflowExecutionRequest) (*adminservice.PreviewResetWorkflowExecutionResponse, error)
+		rebuildVisibilityFn             func(request *adminservice.RebuildVisibilityRequest) (*adminservice.RebuildVisibilityResponse, error)
+		describeVisibilityRebuildFn     func(request *adminservice.DescribeVisibilityRebuildRequest) (*adminservice.DescribeVisibilityRebuildResponse, error)
+		explainVisibilityQueryFn        func(request *adminservice.ExplainVisibilityQueryRequest) (*adminservice.ExplainVisibilityQueryResponse, error)
+		updateWorkerDispatchLimitsFn    func(request *adminservice.UpdateWorkerDispatchLimitsRequest) (*adminservice.UpdateWorkerDispatchLimitsResponse, error)
+		describeWorkerDispatchLimitsFn  func(request *adminservice.DescribeWorkerDispatchLimitsRequest) (*adminservice.DescribeWorkerDispatchLimitsResponse, error)
 	}
 )
 
@@ -82,6 +102,58 @@ func (t *testClient) ForceUnloadTaskQueuePartition(_ context.Context, request *a
 	return t.forceUnloadTaskQueuePartitionFn(request)
 }
 
+func (t *testClient) GetTaskQueueTasks(_ context.Context, request *adminservice.GetTaskQueueTasksRequest, opts ...grpc.CallOption) (*adminservice.GetTaskQueueTasksResponse, error) {
+	return t.getTaskQueueTasksFn(request)
+}
+
+func (t *testClient) MoveTaskQueueTasks(_ context.Context, request *adminservice.MoveTaskQueueTasksRequest, opts ...grpc.CallOption) (*adminservice.MoveTaskQueueTasksResponse, error) {
+	return t.moveTaskQueueTasksFn(request)
+}
+
+func (t *testClient) ChangeSearchAttributeType(_ context.Context, request *adminservice.ChangeSearchAttributeTypeRequest, opts ...grpc.CallOption) (*adminservice.ChangeSearchAttributeTypeResponse, error) {
+	return t.changeSearchAttributeTypeFn(request)
+}
+
+func (t *testClient) PauseWorkflowExecution(_ context.Context, request *adminservice.PauseWorkflowExecutionRequest, opts ...grpc.CallOption) (*adminservice.PauseWorkflowExecutionResponse, error) {
+	return t.pauseWorkflowExecutionFn(request)
+}
+
+func (t *testClient) UnpauseWorkflowExecution(_ context.Context, request *adminservice.UnpauseWorkflowExecutionRequest, opts ...grpc.CallOption) (*adminservice.UnpauseWorkflowExecutionResponse, error) {
+	return t.unpauseWorkflowExecutionFn(request)
+}
+
+func (t *testClient) BatchPauseWorkflowExecutions(_ context.Context, request *adminservice.BatchPauseWorkflowExecutionsRequest, opts ...grpc.CallOption) (*adminservice.BatchPauseWorkflowExecutionsResponse, error) {
+	return t.batchPauseWorkflowExecutionsFn(request)
+}
+
+func (t *testClient) ForkWorkflowExecution(_ context.Context, request *adminservice.ForkWorkflowExecutionRequest, opts ...grpc.CallOption) (*adminservice.ForkWorkflowExecutionResponse, error) {
+	return t.forkWorkflowExecutionFn(request)
+}
+
+func (t *testClient) PreviewResetWorkflowExecution(_ context.Context, request *adminservice.PreviewResetWorkflowExecutionRequest, opts ...grpc.CallOption) (*adminservice.PreviewResetWorkflowExecutionResponse, error) {
+	return t.previewResetWorkflowFn(request)
+}
+
+func (t *testClient) RebuildVisibility(_ context.Context, request *adminservice.RebuildVisibilityRequest, opts ...grpc.CallOption) (*adminservice.RebuildVisibilityResponse, error) {
+	return t.rebuildVisibilityFn(request)
+}
+
+func (t *testClient) DescribeVisibilityRebuild(_ context.Context, request *adminservice.DescribeVisibilityRebuildRequest, opts ...grpc.CallOption) (*adminservice.DescribeVisibilityRebuildResponse, error) {
+	return t.describeVisibilityRebuildFn(request)
+}
+
+func (t *testClient) ExplainVisibilityQuery(_ context.Context, request *adminservice.ExplainVisibilityQueryRequest, opts ...grpc.CallOption) (*adminservice.ExplainVisibilityQueryResponse, error) {
+	return t.explainVisibilityQueryFn(request)
+}
+
+func (t *testClient) UpdateWorkerDispatchLimits(_ context.Context, request *adminservice.UpdateWorkerDispatchLimitsRequest, opts ...grpc.CallOption) (*adminservice.UpdateWorkerDispatchLimitsResponse, error) {
+	return t.updateWorkerDispatchLimitsFn(request)
+}
+
+func (t *testClient) DescribeWorkerDispatchLimits(_ context.Context, request *adminservice.DescribeWorkerDispatchLimitsRequest, opts ...grpc.CallOption) (*adminservice.DescribeWorkerDispatchLimitsResponse, error) {
+	return t.describeWorkerDispatchLimitsFn(request)
+}
+
 func (s *taskQueueCommandTestSuite) SetupTest() {
 	s.Assertions = require.New(s.T())
 	s.controller = gomock.NewController(s.T())
@@ -163,3 +235,177 @@ func (s *taskQueueCommandTestSuite) TestForceUnloadTaskQueuePartition() {
 		}
 	}
 }
+
+// TestFindTasks tests that find-tasks scans all pages of all partitions and applies the filters
+func (s *taskQueueCommandTestSuite) TestFindTasks() {
+	now := time.Now()
+	newTask := func(taskID int64, workflowID string, age time.Duration, buildID string) *persistencespb.AllocatedTaskInfo {
+		return &persistencespb.AllocatedTaskInfo{
+			TaskId: taskID,
+			Data: &persistencespb.TaskInfo{
+				WorkflowId:       workflowID,
+				CreateTime:       timestamppb.New(now.Add(-age)),
+				VersionDirective: &taskqueuespb.TaskVersionDirective{BuildId: &taskqueuespb.TaskVersionDirective_AssignedBuildId{AssignedBuildId: buildID}},
+			},
+		}
+	}
+
+	var partitions []string
+	client := &testClient{
+		getTaskQueueTasksFn: func(request *adminservice.GetTaskQueueTasksRequest) (*adminservice.GetTaskQueueTasksResponse, error) {
+			if len(request.NextPageToken) == 0 {
+				partitions = append(partitions, request.TaskQueue)
+				return &adminservice.GetTaskQueueTasksResponse{
+					Tasks: []*persistencespb.AllocatedTaskInfo{
+						newTask(1, "orders-1", time.Hour, "v1"),
+						newTask(2, "payments-1", time.Hour, "v1"),
+					},
+					NextPageToken: []byte("next"),
+				}, nil
+			}
+			return &adminservice.GetTaskQueueTasksResponse{
+				Tasks: []*persistencespb.AllocatedTaskInfo{
+					newTask(3, "orders-2", time.Minute, "v1"),
+					newTask(4, "orders-3", time.Hour, "v2"),
+				},
+			}, nil
+		},
+	}
+	var out, errOut bytes.Buffer
+	app := NewCliApp(func(params *Params) {
+		params.ClientFactory = client
+		params.Writer = &out
+		params.ErrWriter = &errOut
+	})
+
+	err := app.Run([]string{"tdbg", "taskqueue", "find-tasks", "--task-queue", "test", "--partitions", "2",
+		"--workflow-id-prefix", "orders-", "--min-task-age", "10m", "--build-id", "v1"})
+	s.NoError(err)
+	s.Equal([]string{"test", "/_sys/test/1"}, partitions)
+	s.Contains(errOut.String(), "Partition /_sys/test/1: scanned 4 tasks, selected 1")
+	s.Contains(out.String(), "Scanned 8 tasks in 2 partitions, selected 2")
+}
+
+// TestMoveTasks tests that move-tasks moves all pages of all partitions and passes the filters to the server
+func (s *taskQueueCommandTestSuite) TestMoveTasks() {
+	var requests []*adminservice.MoveTaskQueueTasksRequest
+	client := &testClient{
+		moveTaskQueueTasksFn: func(request *adminservice.MoveTaskQueueTasksRequest) (*adminservice.MoveTaskQueueTasksResponse, error) {
+			requests = append(requests, proto.Clone(request).(*adminservice.MoveTaskQueueTasksRequest))
+			if len(request.NextPageToken) == 0 {
+				return &adminservice.MoveTaskQueueTasksResponse{
+					ScannedCount:  3,
+					SelectedCount: 2,
+					MovedCount:    2,
+					NextPageToken: []byte("next"),
+				}, nil
+			}
+			return &adminservice.MoveTaskQueueTasksResponse{
+				ScannedCount:  1,
+				SelectedCount: 1,
+				MovedCount:    1,
+			}, nil
+		},
+	}
+	var out, errOut bytes.Buffer
+	app := NewCliApp(func(params *Params) {
+		params.ClientFactory = client
+		params.Writer = &out
+		params.ErrWriter = &errOut
+	})
+
+	err := app.Run([]string{"tdbg", "taskqueue", "move-tasks", "--task-queue", "test", "--destination-task-queue", "dest",
+		"--partitions", "2", "--workflow-id-prefix", "orders-", "--min-task-age", "10m", "--build-id", "v1"})
+	s.NoError(err)
+	s.Len(requests, 4)
+	for i, request := range requests {
+		s.Equal("test", request.SourcePartition.TaskQueue)
+		s.EqualValues(i/2, request.SourcePartition.GetNormalPartitionId())
+		s.Equal("dest", request.DestinationTaskQueue)
+		s.Equal("orders-", request.WorkflowIdPrefix)
+		s.Equal(10*time.Minute, request.MinTaskAge.AsDuration())
+		s.Equal("v1", request.BuildId)
+		s.False(request.DryRun)
+	}
+	s.Empty(requests[0].NextPageToken)
+	s.Equal([]byte("next"), requests[1].NextPageToken)
+	s.Contains(errOut.String(), "Partition 1: scanned 4 tasks, selected 3, moved 3")
+	s.Contains(out.String(), "Scanned 8 tasks in 2 partitions, selected 6, moved 6 to dest")
+
+	out.Reset()
+	requests = nil
+	err = app.Run([]string{"tdbg", "taskqueue", "move-tasks", "--task-queue", "test", "--destination-task-queue", "dest", "--dry-run",
+		"--source-version", "deployment.v1"})
+	s.NoError(err)
+	s.Len(requests, 2)
+	s.True(requests[0].DryRun)
+	s.Empty(requests[0].SourceBuildId)
+	s.Equal("deployment.v1", requests[0].SourceDeploymentVersion)
+	s.Contains(out.String(), "Dry run: scanned 4 tasks in 1 partitions, selected 3")
+}
+
+func (s *taskQueueCommandTestSuite) TestSetWorkerLimits() {
+	var request *adminservice.UpdateWorkerDispatchLimitsRequest
+	client := &testClient{
+		updateWorkerDispatchLimitsFn: func(r *adminservice.UpdateWorkerDispatchLimitsRequest) (*adminservice.UpdateWorkerDispatchLimitsResponse, error) {
+			request = r
+			return &adminservice.UpdateWorkerDispatchLimitsResponse{}, nil
+		},
+	}
+	var out bytes.Buffer
+	app := NewCliApp(func(params *Params) {
+		params.ClientFactory = client
+		params.Writer = &out
+	})
+
+	err := app.Run([]string{"tdbg", "taskqueue", "set-worker-limits", "--task-queue", "test", "--task-queue-type", "workflow",
+		"--limits", `{"byIdentity": {"*": {"maxTasksPerSecond": 10, "maxInFlight": 5}}, "byBuildId": {"v1": {"maxInFlight": 100}}}`})
+	s.NoError(err)
+	s.Equal("test", request.TaskQueue)
+	s.Equal(enumspb.TASK_QUEUE_TYPE_WORKFLOW, request.TaskQueueType)
+	s.True(proto.Equal(&persistencespb.WorkerDispatchLimits{
+		ByIdentity: map[string]*persistencespb.WorkerDispatchLimit{"*": {MaxTasksPerSecond: 10, MaxInFlight: 5}},
+		ByBuildId:  map[string]*persistencespb.WorkerDispatchLimit{"v1": {MaxInFlight: 100}},
+	}, request.Limits))
+	s.Contains(out.String(), "Set the worker limits of workflow task queue test")
+
+	err = app.Run([]string{"tdbg", "taskqueue", "set-worker-limits", "--task-queue", "test", "--limits", "{}"})
+	s.NoError(err)
+	s.Equal(enumspb.TASK_QUEUE_TYPE_ACTIVITY, request.TaskQueueType)
+	s.Empty(request.Limits.GetByIdentity())
+
+	request = nil
+	err = app.Run([]string{"tdbg", "taskqueue", "set-worker-limits", "--task-queue", "test", "--limits", "not json"})
+	s.ErrorContains(err, "invalid worker limits")
+	err = app.Run([]string{"tdbg", "taskqueue", "set-worker-limits", "--task-queue", "test", "--task-queue-type", "nexus", "--limits", "{}"})
+	s.ErrorContains(err, "invalid task queue type")
+	s.Nil(request)
+}
+
+func (s *taskQueueCommandTestSuite) TestDescribeWorkerLimits() {
+	var limits *persistencespb.WorkerDispatchLimits
+	client := &testClient{
+		describeWorkerDispatchLimitsFn: func(r *adminservice.DescribeWorkerDispatchLimitsRequest) (*adminservice.DescribeWorkerDispatchLimitsResponse, error) {
+			s.Equal("test", r.TaskQueue)
+			s.Equal(enumspb.TASK_QUEUE_TYPE_ACTIVITY, r.TaskQueueType)
+			return &adminservice.DescribeWorkerDispatchLimitsResponse{Limits: limits}, nil
+		},
+	}
+	var out bytes.Buffer
+	app := NewCliApp(func(params *Params) {
+		params.ClientFactory = client
+		params.Writer = &out
+	})
+
+	err := app.Run([]string{"tdbg", "taskqueue", "describe-worker-limits", "--task-queue", "test"})
+	s.NoError(err)
+	s.Contains(out.String(), "No worker limits set on activity task queue test")
+
+	out.Reset()
+	limits = &persistencespb.WorkerDispatchLimits{
+		ByBuildId: map[string]*persistencespb.WorkerDispatchLimit{"v1": {MaxInFlight: 100}},
+	}
+	err = app.Run([]string{"tdbg", "taskqueue", "describe-worker-limits", "--task-queue", "test"})
+	s.NoError(err)
+	s.Contains(out.String(), `"maxInFlight": 100`)
+}
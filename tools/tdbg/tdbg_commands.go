@@ -51,6 +51,18 @@ func getCommands(
 			Usage:       "Run admin operation on membership",
 			Subcommands: newAdminMembershipCommands(clientFactory),
 		},
+		{
+			Name:        "visibility",
+			Aliases:     []string{"vis"},
+			Usage:       "Run admin operation on visibility",
+			Subcommands: newAdminVisibilityCommands(clientFactory),
+		},
+		{
+			Name:        "namespace",
+			Aliases:     []string{"ns"},
+			Usage:       "Run admin operation on namespace",
+			Subcommands: newAdminNamespaceCommands(clientFactory),
+		},
 		{
 			Name:        "dlq",
 			Usage:       "Run admin operation on DLQ",
@@ -234,6 +246,147 @@ func newAdminWorkflowCommands(clientFactory ClientFactory, prompterFactory Promp
 				return AdminDeleteWorkflow(c, clientFactory, prompterFactory(c))
 			},
 		},
+		{
+			Name:  "pause",
+			Usage: "Pause a workflow: workflow tasks, activities and timers are not dispatched, signals and updates are buffered",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:    FlagWorkflowID,
+					Aliases: FlagWorkflowIDAlias,
+					Usage:   "Workflow ID",
+				},
+				&cli.StringFlag{
+					Name:    FlagRunID,
+					Aliases: FlagRunIDAlias,
+					Usage:   "Run ID",
+				},
+				&cli.StringFlag{
+					Name:  FlagQuery,
+					Usage: "Visibility query selecting the workflows, the workflows are paused by a batch operation",
+				},
+				&cli.StringFlag{
+					Name:  FlagJobID,
+					Usage: "Batch operation job ID, required with --query",
+				},
+				&cli.StringFlag{
+					Name:  FlagReason,
+					Usage: "Batch operation reason, required with --query",
+				},
+				&cli.Float64Flag{
+					Name:  FlagRPS,
+					Usage: "Maximum number of workflows paused per second by the batch operation",
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminPauseWorkflow(c, clientFactory, false)
+			},
+		},
+		{
+			Name:  "unpause",
+			Usage: "Unpause a paused workflow",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:    FlagWorkflowID,
+					Aliases: FlagWorkflowIDAlias,
+					Usage:   "Workflow ID",
+				},
+				&cli.StringFlag{
+					Name:    FlagRunID,
+					Aliases: FlagRunIDAlias,
+					Usage:   "Run ID",
+				},
+				&cli.StringFlag{
+					Name:  FlagQuery,
+					Usage: "Visibility query selecting the workflows, the workflows are unpaused by a batch operation",
+				},
+				&cli.StringFlag{
+					Name:  FlagJobID,
+					Usage: "Batch operation job ID, required with --query",
+				},
+				&cli.StringFlag{
+					Name:  FlagReason,
+					Usage: "Batch operation reason, required with --query",
+				},
+				&cli.Float64Flag{
+					Name:  FlagRPS,
+					Usage: "Maximum number of workflows unpaused per second by the batch operation",
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminPauseWorkflow(c, clientFactory, true)
+			},
+		},
+		{
+			Name:  "fork",
+			Usage: "Create a new workflow in the namespace from the history of a workflow up to an event",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:     FlagWorkflowID,
+					Aliases:  FlagWorkflowIDAlias,
+					Usage:    "Workflow ID of the source workflow",
+					Required: true,
+				},
+				&cli.StringFlag{
+					Name:    FlagRunID,
+					Aliases: FlagRunIDAlias,
+					Usage:   "Run ID of the source workflow, defaults to the current run",
+				},
+				&cli.StringFlag{
+					Name:  FlagSourceNamespace,
+					Usage: "Namespace of the source workflow, defaults to the namespace",
+				},
+				&cli.Int64Flag{
+					Name:     FlagEventID,
+					Usage:    "The last event copied to the new workflow, a workflow task scheduled or started event ending a batch of events",
+					Required: true,
+				},
+				&cli.StringFlag{
+					Name:     FlagNewWorkflowID,
+					Usage:    "Workflow ID of the new workflow",
+					Required: true,
+				},
+				&cli.StringFlag{
+					Name:  FlagTaskQueue,
+					Usage: "Task queue of the new workflow and of its activities, defaults to the task queues of the source workflow",
+				},
+				&cli.StringFlag{
+					Name:  FlagReason,
+					Usage: "Reason of the fork",
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminForkWorkflow(c, clientFactory)
+			},
+		},
+		{
+			Name:  "reset-preview",
+			Usage: "Show what resetting a workflow to an event would do, without resetting it",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:     FlagWorkflowID,
+					Aliases:  FlagWorkflowIDAlias,
+					Usage:    "Workflow ID",
+					Required: true,
+				},
+				&cli.StringFlag{
+					Name:    FlagRunID,
+					Aliases: FlagRunIDAlias,
+					Usage:   "Run ID, defaults to the current run",
+				},
+				&cli.Int64Flag{
+					Name:     FlagEventID,
+					Usage:    "The workflow task completed, failed or timed out event to reset to",
+					Required: true,
+				},
+				&cli.StringSliceFlag{
+					Name:  FlagReapplyExclude,
+					Usage: "Event types not reapplied after the reset: Signal, Update, Nexus or CancelRequest",
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminPreviewResetWorkflow(c, clientFactory)
+			},
+		},
 	}
 }
 
@@ -448,11 +601,118 @@ func newAdminHistoryHostCommands(clientFactory ClientFactory) []*cli.Command {
 				},
 				&cli.IntFlag{
 					Name:  FlagNumberOfShards,
-					Usage: "NumberOfShards for the temporal cluster(see config for numHistoryShards)",
+					Usage: "NumberOfShards for the temporal cluster(see config for numHistoryShards), read from the cluster if not set",
 				},
 			},
 			Action: func(c *cli.Context) error {
-				return AdminGetShardID(c)
+				return AdminGetShardID(c, clientFactory)
+			},
+		},
+	}
+}
+
+func newAdminVisibilityCommands(clientFactory ClientFactory) []*cli.Command {
+	return []*cli.Command{
+		{
+			Name:  "rebuild",
+			Usage: "Start rebuilding the visibility records of a namespace from the mutable state of its workflows",
+			Flags: []cli.Flag{
+				&cli.Float64Flag{
+					Name:  FlagRPS,
+					Usage: "Maximum number of visibility records written per second",
+					Value: 100,
+				},
+				&cli.IntFlag{
+					Name:  FlagPageSize,
+					Usage: "Number of workflows read from the execution store per request",
+					Value: 100,
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminRebuildVisibility(c, clientFactory)
+			},
+		},
+		{
+			Name:  "describe-rebuild",
+			Usage: "Show the progress of the visibility rebuild of a namespace",
+			Action: func(c *cli.Context) error {
+				return AdminDescribeVisibilityRebuild(c, clientFactory)
+			},
+		},
+		{
+			Name:  "change-search-attribute-type",
+			Usage: "Start changing the type of a custom search attribute of a namespace, keeping its values",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:     FlagSearchAttribute,
+					Usage:    "Name of the custom search attribute",
+					Required: true,
+				},
+				&cli.StringFlag{
+					Name:     FlagSearchAttributeType,
+					Usage:    "New type of the search attribute: Keyword, Text, Int, Double, Bool, Datetime or KeywordList",
+					Required: true,
+				},
+				&cli.StringFlag{
+					Name:  FlagNewFieldName,
+					Usage: "Name of the Elasticsearch field of the new type, defaults to the search attribute name followed by the type",
+				},
+				&cli.IntFlag{
+					Name:  FlagPageSize,
+					Usage: "Number of workflows updated per visibility store request",
+					Value: 100,
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminChangeSearchAttributeType(c, clientFactory)
+			},
+		},
+		{
+			Name:  "describe-search-attribute-type-change",
+			Usage: "Show the progress of the search attribute type change of a namespace",
+			Action: func(c *cli.Context) error {
+				return AdminDescribeSearchAttributeTypeChange(c, clientFactory)
+			},
+		},
+		{
+			Name:  "explain-query",
+			Usage: "Show the query of the visibility store for a list filter of a namespace",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  FlagQuery,
+					Usage: "List filter, as used to list workflows",
+				},
+				&cli.IntFlag{
+					Name:  FlagPageSize,
+					Usage: "Page size of the explained list request",
+					Value: 1000,
+				},
+				&cli.BoolFlag{
+					Name:  FlagIncludePlan,
+					Usage: "Include the execution plan of the database, only supported by SQL visibility",
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminExplainVisibilityQuery(c, clientFactory)
+			},
+		},
+	}
+}
+
+func newAdminNamespaceCommands(clientFactory ClientFactory) []*cli.Command {
+	return []*cli.Command{
+		{
+			Name:  "advance-clock",
+			Usage: "Advance the virtual clock of a test namespace",
+			Flags: []cli.Flag{
+				&cli.DurationFlag{
+					Name:     FlagDuration,
+					Usage:    "Duration the virtual clock is advanced by, e.g. 24h",
+					Required: true,
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminAdvanceNamespaceClock(c, clientFactory)
 			},
 		},
 	}
@@ -505,6 +765,128 @@ func newAdminTaskQueueCommands(clientFactory ClientFactory) []*cli.Command {
 				return AdminListTaskQueueTasks(c, clientFactory)
 			},
 		},
+		{
+			Name:  "find-tasks",
+			Usage: "Find the backlog tasks of the partitions of a task queue selected by workflow ID prefix, task age or build ID",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:     FlagTaskQueue,
+					Usage:    "Task Queue name",
+					Required: true,
+				},
+				&cli.StringFlag{
+					Name:  FlagTaskQueueType,
+					Value: "activity",
+					Usage: "Task Queue type: activity, workflow, nexus",
+				},
+				&cli.IntFlag{
+					Name:  FlagPartitions,
+					Value: 1,
+					Usage: "Number of normal partitions of the task queue to scan",
+				},
+				&cli.IntFlag{
+					Name:  FlagSubqueue,
+					Usage: "Subqueue to scan",
+					Value: 0,
+				},
+				&cli.Int64Flag{
+					Name:  FlagMinTaskID,
+					Usage: "Minimum task ID",
+					Value: -12346, // include default task id
+				},
+				&cli.StringFlag{
+					Name:  FlagWorkflowIDPrefix,
+					Usage: "Select the tasks of workflows with this workflow ID prefix",
+				},
+				&cli.DurationFlag{
+					Name:  FlagMinTaskAge,
+					Usage: "Select the tasks created at least this long ago",
+				},
+				&cli.StringFlag{
+					Name:  FlagBuildID,
+					Usage: "Select the tasks directed to this build ID",
+				},
+				&cli.IntFlag{
+					Name:  FlagPageSize,
+					Value: 100,
+					Usage: "Number of tasks to read per request",
+				},
+				&cli.BoolFlag{
+					Name:  FlagPrintJSON,
+					Usage: "Print the selected tasks in raw json format",
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminFindTaskQueueTasks(c, clientFactory)
+			},
+		},
+		{
+			Name:  "move-tasks",
+			Usage: "Move the backlog tasks of the partitions of a task queue selected by workflow ID prefix, task age or build ID to another task queue",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:     FlagTaskQueue,
+					Usage:    "Task Queue name",
+					Required: true,
+				},
+				&cli.StringFlag{
+					Name:  FlagTaskQueueType,
+					Value: "activity",
+					Usage: "Task Queue type: activity, workflow",
+				},
+				&cli.StringFlag{
+					Name:     FlagDestinationTaskQueue,
+					Usage:    "Task Queue to move the selected tasks to",
+					Required: true,
+				},
+				&cli.IntFlag{
+					Name:  FlagPartitions,
+					Value: 1,
+					Usage: "Number of normal partitions of the task queue to scan",
+				},
+				&cli.IntFlag{
+					Name:  FlagSubqueue,
+					Usage: "Subqueue to scan",
+					Value: 0,
+				},
+				&cli.StringFlag{
+					Name:  FlagSourceBuildID,
+					Usage: "Move the tasks of the versioned queue of this build ID instead of the unversioned queue",
+				},
+				&cli.StringFlag{
+					Name:  FlagSourceVersion,
+					Usage: "Move the tasks of the versioned queue of this worker deployment version, in the <deployment name>.<build ID> form, instead of the unversioned queue",
+				},
+				&cli.StringFlag{
+					Name:  FlagWorkflowIDPrefix,
+					Usage: "Select the tasks of workflows with this workflow ID prefix",
+				},
+				&cli.DurationFlag{
+					Name:  FlagMinTaskAge,
+					Usage: "Select the tasks created at least this long ago",
+				},
+				&cli.StringFlag{
+					Name:  FlagBuildID,
+					Usage: "Select the tasks directed to this build ID",
+				},
+				&cli.BoolFlag{
+					Name:  FlagCopy,
+					Usage: "Copy the selected tasks instead of moving them, i.e. keep them in the source task queue",
+				},
+				&cli.BoolFlag{
+					Name:  FlagDryRun,
+					Usage: "Only count the selected tasks, don't move them",
+				},
+				&cli.IntFlag{
+					Name:  FlagPageSize,
+					Value: 100,
+					Usage: "Number of tasks to scan per request",
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminMoveTaskQueueTasks(c, clientFactory)
+			},
+		},
 		{
 			Name:  "describe-task-queue-partition",
 			Usage: "Describe information related to a task queue partition",
@@ -588,6 +970,51 @@ func newAdminTaskQueueCommands(clientFactory ClientFactory) []*cli.Command {
 				return AdminForceUnloadTaskQueuePartition(c, clientFactory)
 			},
 		},
+		{
+			Name:  "set-worker-limits",
+			Usage: "Set the dispatch limits per worker identity and build ID of a task queue, replacing the ones in dynamic config",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:     FlagTaskQueue,
+					Usage:    "Task Queue name",
+					Required: true,
+				},
+				&cli.StringFlag{
+					Name:  FlagTaskQueueType,
+					Value: "activity",
+					Usage: "Task Queue type: activity, workflow",
+				},
+				&cli.StringFlag{
+					Name: FlagWorkerLimits,
+					Usage: `Limits in JSON, e.g. '{"byIdentity": {"*": {"maxTasksPerSecond": 10, "maxInFlight": 5}}, ` +
+						`"byBuildId": {"v1": {"maxInFlight": 100}}}'. The "*" key sets the limits of the workers not listed, ` +
+						`and '{}' clears the limits`,
+					Required: true,
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminSetWorkerDispatchLimits(c, clientFactory)
+			},
+		},
+		{
+			Name:  "describe-worker-limits",
+			Usage: "Describe the dispatch limits per worker identity and build ID set on a task queue",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:     FlagTaskQueue,
+					Usage:    "Task Queue name",
+					Required: true,
+				},
+				&cli.StringFlag{
+					Name:  FlagTaskQueueType,
+					Value: "activity",
+					Usage: "Task Queue type: activity, workflow",
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminDescribeWorkerDispatchLimits(c, clientFactory)
+			},
+		},
 	}
 }
 
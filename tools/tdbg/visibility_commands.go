@@ -0,0 +1,142 @@
+package tdbg
+
+import (
+	"fmt"
+
+	"github.com/urfave/cli/v2"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/server/api/adminservice/v1"
+)
+
+// AdminRebuildVisibility starts rebuilding the visibility records of a namespace
+func AdminRebuildVisibility(c *cli.Context, clientFactory ClientFactory) error {
+	adminClient := clientFactory.AdminClient(c)
+
+	nsName, err := getRequiredOption(c, FlagNamespace)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	resp, err := adminClient.RebuildVisibility(ctx, &adminservice.RebuildVisibilityRequest{
+		Namespace: nsName,
+		Rps:       c.Float64(FlagRPS),
+		PageSize:  int32(c.Int(FlagPageSize)),
+	})
+	if err != nil {
+		return fmt.Errorf("unable to start visibility rebuild: %s", err)
+	}
+	fmt.Fprintf(c.App.Writer, "Visibility rebuild of namespace %s started, workflow ID: %s, run ID: %s\n",
+		nsName, resp.GetWorkflowId(), resp.GetRunId())
+	return nil
+}
+
+// AdminDescribeVisibilityRebuild prints the progress of the visibility rebuild of a namespace
+func AdminDescribeVisibilityRebuild(c *cli.Context, clientFactory ClientFactory) error {
+	adminClient := clientFactory.AdminClient(c)
+
+	nsName, err := getRequiredOption(c, FlagNamespace)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	resp, err := adminClient.DescribeVisibilityRebuild(ctx, &adminservice.DescribeVisibilityRebuildRequest{
+		Namespace: nsName,
+	})
+	if err != nil {
+		return fmt.Errorf("unable to describe visibility rebuild: %s", err)
+	}
+	prettyPrintJSONObject(c, resp)
+	return nil
+}
+
+// AdminChangeSearchAttributeType starts changing the type of a custom search attribute of a namespace
+func AdminChangeSearchAttributeType(c *cli.Context, clientFactory ClientFactory) error {
+	adminClient := clientFactory.AdminClient(c)
+
+	nsName, err := getRequiredOption(c, FlagNamespace)
+	if err != nil {
+		return err
+	}
+	saName, err := getRequiredOption(c, FlagSearchAttribute)
+	if err != nil {
+		return err
+	}
+	typeName, err := getRequiredOption(c, FlagSearchAttributeType)
+	if err != nil {
+		return err
+	}
+	newType, err := enumspb.IndexedValueTypeFromString(typeName)
+	if err != nil {
+		return fmt.Errorf("invalid search attribute type %s: %s", typeName, err)
+	}
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	resp, err := adminClient.ChangeSearchAttributeType(ctx, &adminservice.ChangeSearchAttributeTypeRequest{
+		Namespace:       nsName,
+		SearchAttribute: saName,
+		NewType:         newType,
+		NewFieldName:    c.String(FlagNewFieldName),
+		PageSize:        int32(c.Int(FlagPageSize)),
+	})
+	if err != nil {
+		return fmt.Errorf("unable to start search attribute type change: %s", err)
+	}
+	fmt.Fprintf(c.App.Writer, "Type change of search attribute %s of namespace %s started, workflow ID: %s, run ID: %s\n",
+		saName, nsName, resp.GetWorkflowId(), resp.GetRunId())
+	return nil
+}
+
+// AdminDescribeSearchAttributeTypeChange prints the progress of the search attribute type change of a namespace
+func AdminDescribeSearchAttributeTypeChange(c *cli.Context, clientFactory ClientFactory) error {
+	adminClient := clientFactory.AdminClient(c)
+
+	nsName, err := getRequiredOption(c, FlagNamespace)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	resp, err := adminClient.DescribeSearchAttributeTypeChange(ctx, &adminservice.DescribeSearchAttributeTypeChangeRequest{
+		Namespace: nsName,
+	})
+	if err != nil {
+		return fmt.Errorf("unable to describe search attribute type change: %s", err)
+	}
+	prettyPrintJSONObject(c, resp)
+	return nil
+}
+
+// AdminExplainVisibilityQuery prints the query of the visibility store for a list filter of a namespace
+func AdminExplainVisibilityQuery(c *cli.Context, clientFactory ClientFactory) error {
+	adminClient := clientFactory.AdminClient(c)
+
+	nsName, err := getRequiredOption(c, FlagNamespace)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	resp, err := adminClient.ExplainVisibilityQuery(ctx, &adminservice.ExplainVisibilityQueryRequest{
+		Namespace:   nsName,
+		Query:       c.String(FlagQuery),
+		PageSize:    int32(c.Int(FlagPageSize)),
+		IncludePlan: c.Bool(FlagIncludePlan),
+	})
+	if err != nil {
+		return fmt.Errorf("unable to explain query: %s", err)
+	}
+	prettyPrintJSONObject(c, resp)
+	return nil
+}
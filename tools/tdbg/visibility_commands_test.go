@@ -0,0 +1,113 @@
+package tdbg
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/server/api/adminservice/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestChangeSearchAttributeType(t *testing.T) {
+	var requests []*adminservice.ChangeSearchAttributeTypeRequest
+	client := &testClient{
+		changeSearchAttributeTypeFn: func(request *adminservice.ChangeSearchAttributeTypeRequest) (*adminservice.ChangeSearchAttributeTypeResponse, error) {
+			requests = append(requests, proto.Clone(request).(*adminservice.ChangeSearchAttributeTypeRequest))
+			return &adminservice.ChangeSearchAttributeTypeResponse{
+				WorkflowId: "temporal-sys-change-search-attribute-type-test-namespace",
+				RunId:      "run-id",
+			}, nil
+		},
+	}
+	var out, errOut bytes.Buffer
+	app := NewCliApp(func(params *Params) {
+		params.ClientFactory = client
+		params.Writer = &out
+		params.ErrWriter = &errOut
+	})
+
+	err := app.Run([]string{"tdbg", "--namespace", "test-namespace", "visibility", "change-search-attribute-type",
+		"--search-attribute", "CustomerId", "--search-attribute-type", "Int", "--page-size", "50"})
+	require.NoError(t, err)
+	require.Len(t, requests, 1)
+	require.Equal(t, "test-namespace", requests[0].Namespace)
+	require.Equal(t, "CustomerId", requests[0].SearchAttribute)
+	require.Equal(t, enumspb.INDEXED_VALUE_TYPE_INT, requests[0].NewType)
+	require.EqualValues(t, 50, requests[0].PageSize)
+	require.Contains(t, out.String(), "run ID: run-id")
+
+	err = app.Run([]string{"tdbg", "--namespace", "test-namespace", "visibility", "change-search-attribute-type",
+		"--search-attribute", "CustomerId", "--search-attribute-type", "Number"})
+	require.ErrorContains(t, err, "invalid search attribute type")
+	require.Len(t, requests, 1)
+}
+
+func TestRebuildVisibility(t *testing.T) {
+	var requests []*adminservice.RebuildVisibilityRequest
+	client := &testClient{
+		rebuildVisibilityFn: func(request *adminservice.RebuildVisibilityRequest) (*adminservice.RebuildVisibilityResponse, error) {
+			requests = append(requests, proto.Clone(request).(*adminservice.RebuildVisibilityRequest))
+			return &adminservice.RebuildVisibilityResponse{
+				WorkflowId: "temporal-sys-rebuild-visibility-test-namespace",
+				RunId:      "run-id",
+			}, nil
+		},
+		describeVisibilityRebuildFn: func(request *adminservice.DescribeVisibilityRebuildRequest) (*adminservice.DescribeVisibilityRebuildResponse, error) {
+			require.Equal(t, "test-namespace", request.Namespace)
+			return &adminservice.DescribeVisibilityRebuildResponse{
+				TotalShards:     4,
+				CompletedShards: 2,
+			}, nil
+		},
+	}
+	var out, errOut bytes.Buffer
+	app := NewCliApp(func(params *Params) {
+		params.ClientFactory = client
+		params.Writer = &out
+		params.ErrWriter = &errOut
+	})
+
+	err := app.Run([]string{"tdbg", "--namespace", "test-namespace", "visibility", "rebuild",
+		"--rps", "20", "--page-size", "50"})
+	require.NoError(t, err)
+	require.Len(t, requests, 1)
+	require.Equal(t, "test-namespace", requests[0].Namespace)
+	require.EqualValues(t, 20, requests[0].Rps)
+	require.EqualValues(t, 50, requests[0].PageSize)
+	require.Contains(t, out.String(), "run ID: run-id")
+
+	out.Reset()
+	err = app.Run([]string{"tdbg", "--namespace", "test-namespace", "visibility", "describe-rebuild"})
+	require.NoError(t, err)
+	require.Contains(t, out.String(), "completedShards")
+}
+
+func TestExplainVisibilityQuery(t *testing.T) {
+	var requests []*adminservice.ExplainVisibilityQueryRequest
+	client := &testClient{
+		explainVisibilityQueryFn: func(request *adminservice.ExplainVisibilityQueryRequest) (*adminservice.ExplainVisibilityQueryResponse, error) {
+			requests = append(requests, proto.Clone(request).(*adminservice.ExplainVisibilityQueryRequest))
+			return &adminservice.ExplainVisibilityQueryResponse{
+				StoreName: "postgres12",
+				Query:     "SELECT * FROM executions_visibility",
+			}, nil
+		},
+	}
+	var out, errOut bytes.Buffer
+	app := NewCliApp(func(params *Params) {
+		params.ClientFactory = client
+		params.Writer = &out
+		params.ErrWriter = &errOut
+	})
+
+	err := app.Run([]string{"tdbg", "--namespace", "test-namespace", "visibility", "explain-query",
+		"--query", "WorkflowType = 'test-type'", "--include-plan"})
+	require.NoError(t, err)
+	require.Len(t, requests, 1)
+	require.Equal(t, "test-namespace", requests[0].Namespace)
+	require.Equal(t, "WorkflowType = 'test-type'", requests[0].Query)
+	require.True(t, requests[0].IncludePlan)
+	require.Contains(t, out.String(), "SELECT * FROM executions_visibility")
+}